@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/headless"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/spf13/cobra"
+)
+
+// headlessFlags are the connection and output flags of the commands that
+// print resources instead of starting the TUI
+type headlessFlags struct {
+	kubeconfigPath        string
+	contextName           string
+	namespace             string
+	output                string
+	insecureSkipTLSVerify bool
+	certificateAuthority  string
+}
+
+// register adds the flags to a command
+func (f *headlessFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&f.kubeconfigPath, "kubeconfig", "", "Path to kubeconfig file (defaults to $HOME/.kube/config)")
+	cmd.Flags().StringVar(&f.contextName, "context", "", "Kubeconfig context to use (defaults to the current context)")
+	cmd.Flags().StringVarP(&f.namespace, "namespace", "n", "", "Namespace to list (defaults to the kubeconfig context's)")
+	cmd.Flags().StringVarP(&f.output, "output", "o", string(headless.JSON), "Output format, json or yaml")
+	cmd.Flags().BoolVar(&f.insecureSkipTLSVerify, "insecure-skip-tls-verify", false, "Do not verify the API server's certificate (insecure, prefer --certificate-authority)")
+	cmd.Flags().StringVar(&f.certificateAuthority, "certificate-authority", "", "PEM file of the CA trusted for the API server instead of the kubeconfig's")
+}
+
+// connect checks the flags and connects to the cluster
+func (f *headlessFlags) connect(ctx context.Context) (*headless.Clients, headless.Format, error) {
+	format, err := headless.ParseFormat(f.output)
+	if err != nil {
+		return nil, "", err
+	}
+	if f.insecureSkipTLSVerify && f.certificateAuthority != "" {
+		return nil, "", fmt.Errorf("--insecure-skip-tls-verify and --certificate-authority cannot be used together")
+	}
+	if f.certificateAuthority != "" {
+		if err := auth.ValidateCAFile(f.certificateAuthority); err != nil {
+			return nil, "", fmt.Errorf("invalid --certificate-authority: %w", err)
+		}
+	}
+	tlsOverrides := auth.TLSOverrides{CAFile: f.certificateAuthority, Insecure: f.insecureSkipTLSVerify}
+
+	clients, err := headless.Connect(ctx, f.kubeconfigPath, f.contextName, f.namespace, tlsOverrides)
+	if err != nil {
+		return nil, "", err
+	}
+	return clients, format, nil
+}
+
+// newGetCommand creates lazyoc get, printing the resources of a type
+func newGetCommand() *cobra.Command {
+	var flags headlessFlags
+	var allNamespaces bool
+	var selector string
+
+	cmd := &cobra.Command{
+		Use:   "get <type>",
+		Short: "Print the resources of a type as JSON or YAML",
+		Long: `Print the resources of a type as the TUI lists them, as JSON or YAML,
+for scripts and CI. The types are: ` + strings.Join(headless.KindNames(), ", ") + `.`,
+		Example: `  lazyoc get pods -o json
+  lazyoc get deploy -n shop -o yaml
+  lazyoc get po -A -l app=web`,
+		Args:          cobra.ExactArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clients, format, err := flags.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(cmd.Context(), constants.HeadlessTimeout)
+			defer cancel()
+			items, err := headless.Get(ctx, clients, args[0], selector, allNamespaces)
+			if err != nil {
+				return err
+			}
+			return headless.Write(os.Stdout, items, format)
+		},
+	}
+	flags.register(cmd)
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "List the resources of every namespace")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "Label selector, e.g. app=web")
+	return cmd
+}
+
+// newSnapshotCommand creates lazyoc snapshot, printing every resource of a
+// namespace
+func newSnapshotCommand() *cobra.Command {
+	var flags headlessFlags
+
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Print every resource of a namespace as JSON or YAML",
+		Long: `Print every namespaced resource of a namespace as the TUI lists them, as
+JSON or YAML, e.g. to keep the state of a namespace after a CI run. A type that
+cannot be listed, e.g. forbidden secrets, is named under errors and the
+snapshot has the others.`,
+		Example: `  lazyoc snapshot --namespace shop > shop.json
+  lazyoc snapshot -n shop -o yaml`,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clients, format, err := flags.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(cmd.Context(), constants.HeadlessTimeout)
+			defer cancel()
+			return headless.Write(os.Stdout, headless.TakeSnapshot(ctx, clients, time.Now()), format)
+		},
+	}
+	flags.register(cmd)
+	return cmd
+}
+
+// newApplyCommand creates lazyoc apply, server-side applying manifests
+func newApplyCommand() *cobra.Command {
+	var flags headlessFlags
+	var files []string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <file|directory|->",
+		Short: "Server-side apply manifests from files or stdin",
+		Long: `Server-side apply the objects of YAML or JSON manifests, like kubectl apply
+--server-side, and print whether each object was created, configured or left
+unchanged. -f takes a file, a directory of .yaml, .yml and .json files, or -
+for stdin, and may be repeated. Objects without a namespace go to --namespace.
+Exits with an error when an object fails to apply.`,
+		Example: `  lazyoc apply -f deploy.yaml
+  lazyoc apply -f manifests/ -n shop --dry-run
+  helm template web ./chart | lazyoc apply -f -`,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(files) == 0 {
+				return fmt.Errorf("give the manifests to apply with -f")
+			}
+			manifest, err := resources.ReadManifestFiles(files, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			clients, format, err := flags.connect(cmd.Context())
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(cmd.Context(), constants.HeadlessTimeout)
+			defer cancel()
+			objects, err := headless.Apply(ctx, clients, manifest, dryRun)
+			if err != nil {
+				return err
+			}
+
+			if cmd.Flags().Changed("output") {
+				err = headless.Write(os.Stdout, objects, format)
+			} else {
+				err = headless.WriteApplied(os.Stdout, objects, dryRun)
+			}
+			if err != nil {
+				return err
+			}
+			failed := 0
+			for _, object := range objects {
+				if object.Error != "" {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d of %d objects failed to apply", failed, len(objects))
+			}
+			return nil
+		},
+	}
+	flags.register(cmd)
+	cmd.Flags().Lookup("namespace").Usage = "Namespace of the objects without one (defaults to the kubeconfig context's)"
+	cmd.Flags().Lookup("output").Usage = "Print the outcomes as json or yaml instead of a line per object"
+	cmd.Flags().Lookup("output").DefValue = ""
+	cmd.Flags().StringArrayVarP(&files, "filename", "f", nil, "Manifest file, directory or - for stdin (repeatable)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the objects on the server without saving them")
+	return cmd
+}
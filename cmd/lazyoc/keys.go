@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// newKeysCommand creates lazyoc keys, printing the keybinding cheat sheet
+func newKeysCommand() *cobra.Command {
+	var format string
+	var outputPath string
+
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Print a keybinding cheat sheet for team wikis",
+		Long: `Print the keys of the help overlay as a cheat sheet, followed by the macros
+recorded in the configuration. md is Markdown for wikis, pdf-ready is an HTML
+page laid out for A4 paper to print to PDF.`,
+		Example: `  lazyoc keys > KEYS.md
+  lazyoc keys --format pdf-ready --output keys.html`,
+		Args:          cobra.NoArgs,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sheetFormat, err := ui.ParseCheatSheetFormat(format)
+			if err != nil {
+				return err
+			}
+
+			// The macros are optional, the cheat sheet is printed without them
+			var macros map[string][]string
+			if path, err := config.DefaultPath(); err == nil {
+				if cfg, err := config.Load(path); err == nil {
+					macros = cfg.Macros
+				}
+			}
+			sheet := ui.CheatSheet(sheetFormat, macros)
+
+			if outputPath == "" {
+				_, err = fmt.Fprint(os.Stdout, sheet)
+				return err
+			}
+			if err := os.WriteFile(outputPath, []byte(sheet), constants.CheatSheetFilePermissions); err != nil {
+				return fmt.Errorf("failed to write the cheat sheet: %w", err)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", string(ui.CheatSheetMarkdown), "Document format, md or pdf-ready")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "File to write the cheat sheet to (defaults to stdout)")
+	return cmd
+}
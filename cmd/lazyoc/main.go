@@ -5,7 +5,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
 	"github.com/katyella/lazyoc/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +29,27 @@ func main() {
 	var kubeconfigPath string
 	var mouseSupport bool
 	var showFullClusterInfo bool
+	var dryRun bool
+	var safeMode bool
+	var maxFPS int
+	var theme string
+	var palette string
+	var timeFormat string
+	var namespace string
+	var podRefresh time.Duration
+	var resourceRefresh time.Duration
+	var logTail int
+	var logBufferMB int
+	var logSpill bool
+	var kubeconfigNamespace bool
+	var postmortemDir string
+	var diffTool string
+	var debugImage string
+	var authHook string
+	var profile string
+	var hibernateAfter time.Duration
+	var insecureSkipTLSVerify bool
+	var certificateAuthority string
 
 	rootCmd := &cobra.Command{
 		Use:   "lazyoc",
@@ -34,7 +60,54 @@ It provides an intuitive, vim-like interface for viewing and managing cluster re
 Press ? for help once inside the application.`,
 		Version: fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, date),
 		Run: func(cmd *cobra.Command, args []string) {
-			runTUI(debugMode, !noAltScreen, kubeconfigPath, mouseSupport, showFullClusterInfo)
+			if theme != "" && theme != "dark" && theme != "light" {
+				log.Fatalf("Invalid theme %q, use dark or light", theme)
+			}
+			if palette != "" && palette != constants.StatusPaletteDefault && palette != constants.StatusPaletteColorBlind {
+				log.Fatalf("Invalid status palette %q, use %s or %s", palette, constants.StatusPaletteDefault, constants.StatusPaletteColorBlind)
+			}
+			if timeFormat != "" && timeFormat != constants.TimeFormatRelative && timeFormat != constants.TimeFormatAbsolute && timeFormat != constants.TimeFormatISO8601 {
+				log.Fatalf("Invalid time format %q, use %s, %s or %s", timeFormat, constants.TimeFormatRelative, constants.TimeFormatAbsolute, constants.TimeFormatISO8601)
+			}
+
+			// Flags override the saved preferences for this session only
+			overrides := config.Preferences{
+				Theme:                  theme,
+				StatusPalette:          palette,
+				TimeFormat:             timeFormat,
+				DefaultNamespace:       namespace,
+				PodRefreshSeconds:      int(podRefresh.Seconds()),
+				ResourceRefreshSeconds: int(resourceRefresh.Seconds()),
+				LogTailLines:           logTail,
+				LogBufferMB:            logBufferMB,
+				PostmortemDir:          postmortemDir,
+				DiffTool:               diffTool,
+				DebugImage:             debugImage,
+				AuthHook:               authHook,
+				HibernateMinutes:       hibernateMinutes(hibernateAfter),
+			}
+			if cmd.Flags().Changed("mouse") {
+				overrides.Mouse = &mouseSupport
+			}
+			if cmd.Flags().Changed("log-spill") {
+				overrides.LogSpill = &logSpill
+			}
+			if cmd.Flags().Changed("kubeconfig-namespace") {
+				overrides.KubeconfigNamespace = &kubeconfigNamespace
+			}
+			if profile != "" {
+				checkProfile(profile)
+			}
+			if insecureSkipTLSVerify && certificateAuthority != "" {
+				log.Fatalf("--insecure-skip-tls-verify and --certificate-authority cannot be used together")
+			}
+			if certificateAuthority != "" {
+				if err := auth.ValidateCAFile(certificateAuthority); err != nil {
+					log.Fatalf("Invalid --certificate-authority: %v", err)
+				}
+			}
+			tlsOverrides := auth.TLSOverrides{CAFile: certificateAuthority, Insecure: insecureSkipTLSVerify}
+			runTUI(debugMode, !noAltScreen, kubeconfigPath, mouseSupport, showFullClusterInfo, dryRun, safeMode, maxFPS, overrides, profile, tlsOverrides)
 		},
 	}
 
@@ -45,6 +118,29 @@ Press ? for help once inside the application.`,
 	rootCmd.Flags().StringVar(&kubeconfigPath, "kubeconfig", "", "Path to kubeconfig file (defaults to $HOME/.kube/config)")
 	rootCmd.Flags().BoolVar(&mouseSupport, "mouse", true, "Enable mouse support (click tabs, select resources, scroll)")
 	rootCmd.Flags().BoolVar(&showFullClusterInfo, "show-full-cluster-info", false, "Show full cluster URLs without obfuscation (security risk)")
+	rootCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Start with server-side dry run enabled, so changes are validated but not saved")
+	rootCmd.Flags().BoolVar(&safeMode, "safe-mode", false, "Start without auto refresh, latency probes or followed log streams, for fragile or rate-limited API servers (refresh with r)")
+	rootCmd.Flags().IntVar(&maxFPS, "max-fps", constants.MaxRenderFPS, "Maximum screen redraws per second")
+	rootCmd.Flags().StringVar(&theme, "theme", "", "UI theme, dark or light (defaults to the saved setting)")
+	rootCmd.Flags().StringVar(&palette, "palette", "", "Status palette, default or colorblind for shapes and colors that stay apart with deuteranopia (defaults to the saved setting)")
+	rootCmd.Flags().StringVar(&timeFormat, "time-format", "", "Times in lists and details: relative ages, absolute local timestamps or iso8601 (defaults to the saved setting or relative)")
+	rootCmd.Flags().StringVarP(&namespace, "namespace", "n", "", "Namespace to open (defaults to the saved setting or the kubeconfig context's)")
+	rootCmd.Flags().DurationVar(&podRefresh, "pod-refresh", 0, "Auto refresh interval of the pods tab (defaults to the saved setting or 30s)")
+	rootCmd.Flags().DurationVar(&resourceRefresh, "refresh", 0, "Auto refresh interval of the other tabs (defaults to the saved setting or 1m)")
+	rootCmd.Flags().IntVar(&logTail, "log-tail", 0, "Log lines loaded when a pod's logs open (defaults to the saved setting or 1000)")
+	rootCmd.Flags().IntVar(&logBufferMB, "log-buffer-mb", 0, "Memory cap of the pod log buffer in MiB (defaults to the saved setting or 4)")
+	rootCmd.Flags().BoolVar(&logSpill, "log-spill", false, "Spill pod log lines beyond the buffer to a temporary file instead of dropping them (defaults to the saved setting, off)")
+	rootCmd.Flags().BoolVar(&kubeconfigNamespace, "kubeconfig-namespace", false, "Write the namespace of project switches into the kubeconfig's current context, as oc project does (defaults to the saved setting, off)")
+	rootCmd.Flags().StringVar(&postmortemDir, "postmortem-dir", "", "Save the YAML, events and last logs of pods that fail or crash loop to this directory (defaults to the saved setting, off)")
+	rootCmd.Flags().StringVar(&diffTool, "diff-tool", "", "Command previewing the changes of an edit or apply before they are saved, e.g. delta or meld (defaults to the saved setting, off)")
+	rootCmd.Flags().StringVar(&debugImage, "debug-image", "", "Image of the ephemeral debug containers attached to pods, e.g. nicolaka/netshoot (defaults to the saved setting or busybox)")
+	rootCmd.Flags().StringVar(&authHook, "auth-hook", "", "Command printing the API server and credentials as JSON in place of the kubeconfig's, e.g. a company SSO helper (defaults to the saved setting, off)")
+	rootCmd.Flags().DurationVar(&hibernateAfter, "hibernate-after", 0, "Stop refreshes and log streams after this long without input, negative to never hibernate (defaults to the saved setting or 30m)")
+	rootCmd.Flags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", false, "Do not verify the API server's certificate for this session (insecure, prefer --certificate-authority)")
+	rootCmd.Flags().StringVar(&certificateAuthority, "certificate-authority", "", "PEM file of the CA trusted for the API server instead of the kubeconfig's")
+	rootCmd.Flags().StringVar(&profile, "profile", "", "Open a profile from the configuration: its context, namespace, tab and filter")
+
+	rootCmd.AddCommand(newGetCommand(), newSnapshotCommand(), newApplyCommand(), newKeysCommand())
 
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		log.Fatalf("Error executing command: %v", err)
@@ -52,8 +148,35 @@ Press ? for help once inside the application.`,
 	}
 }
 
+// hibernateMinutes converts the --hibernate-after flag to the preference,
+// -1 for never and at least a minute otherwise
+func hibernateMinutes(after time.Duration) int {
+	switch {
+	case after < 0:
+		return -1
+	case after == 0:
+		return 0
+	}
+	return max(int(after.Minutes()), 1)
+}
+
+// checkProfile exits when the profile is not in the configuration
+func checkProfile(name string) {
+	path, err := config.DefaultPath()
+	if err != nil {
+		log.Fatalf("Cannot open profile %q: %v", name, err)
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		log.Fatalf("Cannot open profile %q: %v", name, err)
+	}
+	if _, ok := cfg.Profile(name); !ok {
+		log.Fatalf("No profile %q in %s, the profiles are: %s", name, path, strings.Join(cfg.ProfileNames(), ", "))
+	}
+}
+
 // runTUI starts the terminal user interface
-func runTUI(debug bool, altScreen bool, kubeconfigPath string, mouseSupport bool, showFullClusterInfo bool) {
+func runTUI(debug bool, altScreen bool, kubeconfigPath string, mouseSupport bool, showFullClusterInfo bool, dryRun bool, safeMode bool, maxFPS int, preferences config.Preferences, profile string, tlsOverrides auth.TLSOverrides) {
 	opts := ui.ProgramOptions{
 		Version:            fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, date),
 		Debug:              debug,
@@ -61,6 +184,12 @@ func runTUI(debug bool, altScreen bool, kubeconfigPath string, mouseSupport bool
 		MouseSupport:       mouseSupport,
 		KubeConfig:         kubeconfigPath,
 		ShowFullClusterInfo: showFullClusterInfo,
+		DryRun:             dryRun,
+		SafeMode:           safeMode,
+		MaxFPS:             maxFPS,
+		Preferences:        preferences,
+		Profile:            profile,
+		TLS:                tlsOverrides,
 	}
 
 	if err := ui.RunTUI(opts); err != nil {
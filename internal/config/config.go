@@ -0,0 +1,526 @@
+// Package config handles loading and saving the persistent LazyOC user
+// configuration stored in ~/.lazyoc/config.json.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// Config is the persistent user configuration
+type Config struct {
+	// Views holds saved views keyed by tab name (e.g. "Pods")
+	Views map[string][]SavedView `json:"views,omitempty"`
+
+	// Retry controls how resource loaders retry failed API calls
+	Retry RetrySettings `json:"retry,omitempty"`
+
+	// Macros holds recorded keyboard macros keyed by register (a-z). Each
+	// macro is the list of keys to replay, e.g. ["/", "w", "e", "b", "enter"].
+	Macros map[string][]string `json:"macros,omitempty"`
+
+	// Preferences holds the settings restored at startup
+	Preferences Preferences `json:"preferences,omitempty"`
+
+	// StartupChecks controls the cluster checks run on connect
+	StartupChecks StartupCheckSettings `json:"startupChecks,omitempty"`
+
+	// Profiles holds named working sets keyed by name, e.g. "payments-prod"
+	Profiles map[string]Profile `json:"profiles,omitempty"`
+
+	// Projects holds the favorite and recent projects of the project switcher
+	Projects ProjectSettings `json:"projects,omitempty"`
+}
+
+// ProjectSettings holds the projects the project switcher lists first
+type ProjectSettings struct {
+	// Favorites are the starred projects
+	Favorites []string `json:"favorites,omitempty"`
+
+	// Recent are the projects last switched to, most recent first
+	Recent []string `json:"recent,omitempty"`
+}
+
+// Profile is a named working set opened with --profile or :profile. Empty
+// fields keep the current value.
+type Profile struct {
+	// Context is the kubeconfig context to connect to
+	Context string `json:"context,omitempty"`
+
+	// Namespace is the namespace or project to open
+	Namespace string `json:"namespace,omitempty"`
+
+	// Tab is the name of the tab to open, e.g. "Deployments"
+	Tab string `json:"tab,omitempty"`
+
+	// Filter is a view filter applied to the tab, e.g. "labels:app=payments"
+	Filter string `json:"filter,omitempty"`
+}
+
+// StartupCheckSettings configures the checks run on connect. Every check runs
+// unless disabled.
+type StartupCheckSettings struct {
+	// Off turns every startup check off
+	Off bool `json:"off,omitempty"`
+
+	// Disabled lists the checks not to run, e.g. ["image-pull-secrets"]
+	Disabled []string `json:"disabled,omitempty"`
+}
+
+// Preferences are the user settings that persist across sessions. Zero
+// values fall back to the defaults.
+type Preferences struct {
+	// Theme is the UI theme, "dark" or "light"
+	Theme string `json:"theme,omitempty"`
+
+	// StatusPalette is the palette of health indicators, "default" or
+	// "colorblind"
+	StatusPalette string `json:"statusPalette,omitempty"`
+
+	// TimeFormat is how lists and details show times: "relative" ages such
+	// as 3d, "absolute" local timestamps or "iso8601"
+	TimeFormat string `json:"timeFormat,omitempty"`
+
+	// TimeLayout is the Go layout of absolute timestamps, e.g.
+	// "02.01.2006 15:04" for the day first order of many locales
+	TimeLayout string `json:"timeLayout,omitempty"`
+
+	// DefaultNamespace is the namespace opened at startup instead of the
+	// kubeconfig context's namespace
+	DefaultNamespace string `json:"defaultNamespace,omitempty"`
+
+	// PodRefreshSeconds is the auto refresh interval of the pods tab
+	PodRefreshSeconds int `json:"podRefreshSeconds,omitempty"`
+
+	// ResourceRefreshSeconds is the auto refresh interval of the other tabs
+	ResourceRefreshSeconds int `json:"resourceRefreshSeconds,omitempty"`
+
+	// Mouse enables mouse support, nil leaves it on
+	Mouse *bool `json:"mouse,omitempty"`
+
+	// LogTailLines is the number of existing log lines loaded when a pod's
+	// logs are opened
+	LogTailLines int `json:"logTailLines,omitempty"`
+
+	// LogBufferMB caps the memory of the pod log buffer in MiB
+	LogBufferMB int `json:"logBufferMB,omitempty"`
+
+	// LogSpill keeps the pod log lines trimmed from the buffer in a temporary
+	// file, where they can still be searched and saved. Nil leaves it off.
+	LogSpill *bool `json:"logSpill,omitempty"`
+
+	// PostmortemDir is the directory the YAML, events and last logs of pods
+	// that fail or start crash looping are saved to, empty turns it off
+	PostmortemDir string `json:"postmortemDir,omitempty"`
+
+	// DiffTool is the command showing the changes of an edit or apply before
+	// they are saved, e.g. "delta" or "meld". It is run with the old and new
+	// file as its last arguments. Empty saves changes without a preview.
+	DiffTool string `json:"diffTool,omitempty"`
+
+	// DebugImage is the image of the ephemeral debug containers attached to
+	// pods, e.g. "nicolaka/netshoot". Empty uses busybox.
+	DebugImage string `json:"debugImage,omitempty"`
+
+	// AuthHook is the command supplying the connection in place of the
+	// kubeconfig's credentials, e.g. a company SSO helper issuing
+	// short-lived certificates. It prints the server and credentials as
+	// JSON. Empty connects with the kubeconfig alone.
+	AuthHook string `json:"authHook,omitempty"`
+
+	// HibernateMinutes is how long the terminal may go without input before
+	// refreshes and log streams stop until a key is pressed. Zero uses the
+	// default, a negative value never hibernates.
+	HibernateMinutes int `json:"hibernateMinutes,omitempty"`
+
+	// KubeconfigNamespace writes the namespace of a project switch into the
+	// kubeconfig's current context, as oc project does. Off, LazyOC switches
+	// only its own namespace and leaves kubectl in other terminals alone.
+	// Nil leaves it off.
+	KubeconfigNamespace *bool `json:"kubeconfigNamespace,omitempty"`
+
+	// LastTab is the name of the tab active when LazyOC was last closed
+	LastTab string `json:"lastTab,omitempty"`
+}
+
+// RetrySettings configures loader retries. Zero values fall back to the defaults.
+type RetrySettings struct {
+	// MaxAttempts is the total number of attempts per load, including the first
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+
+	// CircuitBreakerThreshold is the number of consecutive failed loads before
+	// a resource's loader is paused
+	CircuitBreakerThreshold int `json:"circuitBreakerThreshold,omitempty"`
+}
+
+// SavedView is a named combination of filter, sort, grouping and columns for a tab
+type SavedView struct {
+	Name     string   `json:"name"`
+	Filter   string   `json:"filter,omitempty"`
+	SortBy   string   `json:"sortBy,omitempty"`
+	SortDesc bool     `json:"sortDesc,omitempty"`
+	GroupBy  string   `json:"groupBy,omitempty"`
+	Columns  []string `json:"columns,omitempty"`
+}
+
+// DefaultPath returns the default configuration file path
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory: %w", err)
+	}
+	return filepath.Join(home, constants.LazyOCConfigDir, constants.ConfigFileName), nil
+}
+
+// Load reads the configuration from path. A missing file yields an empty configuration.
+func Load(path string) (*Config, error) {
+	cfg := &Config{Views: make(map[string][]SavedView)}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return cfg, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	if cfg.Views == nil {
+		cfg.Views = make(map[string][]SavedView)
+	}
+
+	return cfg, nil
+}
+
+// Save writes the configuration to path, creating the parent directory if needed
+func (c *Config) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), constants.ConfigDirPermissions); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, constants.ConfigFilePermissions); err != nil {
+		return fmt.Errorf("failed to write config %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// RetryAttempts returns the configured loader attempt count or the default
+func (c *Config) RetryAttempts() int {
+	if c.Retry.MaxAttempts > 0 {
+		return c.Retry.MaxAttempts
+	}
+	return constants.DefaultRetryAttempts
+}
+
+// CircuitBreakerThreshold returns the configured circuit breaker threshold or the default
+func (c *Config) CircuitBreakerThreshold() int {
+	if c.Retry.CircuitBreakerThreshold > 0 {
+		return c.Retry.CircuitBreakerThreshold
+	}
+	return constants.CircuitBreakerThreshold
+}
+
+// EnabledStartupChecks returns the checks of all that are not disabled
+func (c *Config) EnabledStartupChecks(all []string) []string {
+	if c.StartupChecks.Off {
+		return nil
+	}
+
+	var enabled []string
+	for _, name := range all {
+		if !slices.Contains(c.StartupChecks.Disabled, name) {
+			enabled = append(enabled, name)
+		}
+	}
+	return enabled
+}
+
+// DisableStartupCheck stops a check from running on connect
+func (c *Config) DisableStartupCheck(name string) {
+	if !slices.Contains(c.StartupChecks.Disabled, name) {
+		c.StartupChecks.Disabled = append(c.StartupChecks.Disabled, name)
+	}
+}
+
+// Profile returns the named profile
+func (c *Config) Profile(name string) (Profile, bool) {
+	profile, ok := c.Profiles[name]
+	return profile, ok
+}
+
+// ProfileNames returns the names of the profiles in sorted order
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ThemeName returns the configured theme or the default
+func (p Preferences) ThemeName() string {
+	if p.Theme == "dark" || p.Theme == "light" {
+		return p.Theme
+	}
+	return constants.DefaultTheme
+}
+
+// StatusPaletteName returns the configured status palette or the default
+func (p Preferences) StatusPaletteName() string {
+	if p.StatusPalette == constants.StatusPaletteColorBlind {
+		return p.StatusPalette
+	}
+	return constants.StatusPaletteDefault
+}
+
+// TimeFormatName returns the configured time format or the default, relative
+func (p Preferences) TimeFormatName() string {
+	switch p.TimeFormat {
+	case constants.TimeFormatAbsolute, constants.TimeFormatISO8601:
+		return p.TimeFormat
+	}
+	return constants.TimeFormatRelative
+}
+
+// AbsoluteTimeLayout returns the configured layout of absolute timestamps or the default
+func (p Preferences) AbsoluteTimeLayout() string {
+	if p.TimeLayout != "" {
+		return p.TimeLayout
+	}
+	return constants.DefaultTimeLayout
+}
+
+// DebugImageName returns the configured debug container image or the default
+func (p Preferences) DebugImageName() string {
+	if p.DebugImage != "" {
+		return p.DebugImage
+	}
+	return constants.DefaultDebugImage
+}
+
+// PodRefreshInterval returns the configured pods tab refresh interval or the default
+func (p Preferences) PodRefreshInterval() time.Duration {
+	if p.PodRefreshSeconds > 0 {
+		return time.Duration(p.PodRefreshSeconds) * time.Second
+	}
+	return constants.PodRefreshInterval
+}
+
+// ResourceRefreshInterval returns the configured refresh interval of the
+// other tabs or the default
+func (p Preferences) ResourceRefreshInterval() time.Duration {
+	if p.ResourceRefreshSeconds > 0 {
+		return time.Duration(p.ResourceRefreshSeconds) * time.Second
+	}
+	return constants.ResourceRefreshInterval
+}
+
+// HibernateAfter returns how long the terminal may go without input before
+// hibernating, 0 when hibernation is off
+func (p Preferences) HibernateAfter() time.Duration {
+	switch {
+	case p.HibernateMinutes > 0:
+		return time.Duration(p.HibernateMinutes) * time.Minute
+	case p.HibernateMinutes < 0:
+		return 0
+	}
+	return constants.DefaultHibernateAfter
+}
+
+// MouseEnabled reports whether mouse support is enabled
+func (p Preferences) MouseEnabled() bool {
+	return p.Mouse == nil || *p.Mouse
+}
+
+// LogSpillEnabled reports whether trimmed pod log lines are spilled to disk
+func (p Preferences) LogSpillEnabled() bool {
+	return p.LogSpill != nil && *p.LogSpill
+}
+
+// KubeconfigNamespaceEnabled reports whether project switches are written
+// into the kubeconfig
+func (p Preferences) KubeconfigNamespaceEnabled() bool {
+	return p.KubeconfigNamespace != nil && *p.KubeconfigNamespace
+}
+
+// LogTail returns the configured log tail size or the default, at most the
+// lines kept in the log buffer
+func (p Preferences) LogTail() int {
+	if p.LogTailLines > 0 {
+		return min(p.LogTailLines, constants.MaxLogLines)
+	}
+	return constants.MaxLogLines
+}
+
+// LogBufferBytes returns the configured memory cap of the pod log buffer or the default
+func (p Preferences) LogBufferBytes() int {
+	if p.LogBufferMB > 0 {
+		return min(p.LogBufferMB, constants.MaxLogBufferMB) << 20
+	}
+	return constants.DefaultLogBufferMB << 20
+}
+
+// Merge returns the preferences with the set fields of overrides applied,
+// e.g. the command line flags of a session
+func (p Preferences) Merge(overrides Preferences) Preferences {
+	if overrides.Theme != "" {
+		p.Theme = overrides.Theme
+	}
+	if overrides.StatusPalette != "" {
+		p.StatusPalette = overrides.StatusPalette
+	}
+	if overrides.TimeFormat != "" {
+		p.TimeFormat = overrides.TimeFormat
+	}
+	if overrides.TimeLayout != "" {
+		p.TimeLayout = overrides.TimeLayout
+	}
+	if overrides.DefaultNamespace != "" {
+		p.DefaultNamespace = overrides.DefaultNamespace
+	}
+	if overrides.PodRefreshSeconds > 0 {
+		p.PodRefreshSeconds = overrides.PodRefreshSeconds
+	}
+	if overrides.ResourceRefreshSeconds > 0 {
+		p.ResourceRefreshSeconds = overrides.ResourceRefreshSeconds
+	}
+	if overrides.Mouse != nil {
+		p.Mouse = overrides.Mouse
+	}
+	if overrides.LogTailLines > 0 {
+		p.LogTailLines = overrides.LogTailLines
+	}
+	if overrides.LogBufferMB > 0 {
+		p.LogBufferMB = overrides.LogBufferMB
+	}
+	if overrides.LogSpill != nil {
+		p.LogSpill = overrides.LogSpill
+	}
+	if overrides.PostmortemDir != "" {
+		p.PostmortemDir = overrides.PostmortemDir
+	}
+	if overrides.DiffTool != "" {
+		p.DiffTool = overrides.DiffTool
+	}
+	if overrides.DebugImage != "" {
+		p.DebugImage = overrides.DebugImage
+	}
+	if overrides.AuthHook != "" {
+		p.AuthHook = overrides.AuthHook
+	}
+	if overrides.HibernateMinutes != 0 {
+		p.HibernateMinutes = overrides.HibernateMinutes
+	}
+	if overrides.KubeconfigNamespace != nil {
+		p.KubeconfigNamespace = overrides.KubeconfigNamespace
+	}
+	if overrides.LastTab != "" {
+		p.LastTab = overrides.LastTab
+	}
+	return p
+}
+
+// ViewsFor returns the saved views for a tab
+func (c *Config) ViewsFor(tab string) []SavedView {
+	return c.Views[tab]
+}
+
+// SetView adds a view to a tab, replacing any existing view with the same name
+func (c *Config) SetView(tab string, view SavedView) {
+	if c.Views == nil {
+		c.Views = make(map[string][]SavedView)
+	}
+
+	views := c.Views[tab]
+	for i := range views {
+		if views[i].Name == view.Name {
+			views[i] = view
+			return
+		}
+	}
+	c.Views[tab] = append(views, view)
+}
+
+// DeleteView removes the named view from a tab. It reports whether a view was removed.
+func (c *Config) DeleteView(tab, name string) bool {
+	views := c.Views[tab]
+	for i := range views {
+		if views[i].Name == name {
+			c.Views[tab] = append(views[:i], views[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Macro returns the keys recorded in a macro register
+func (c *Config) Macro(register string) ([]string, bool) {
+	keys, ok := c.Macros[register]
+	return keys, ok && len(keys) > 0
+}
+
+// SetMacro stores keys in a macro register. An empty key list clears it.
+func (c *Config) SetMacro(register string, keys []string) {
+	if len(keys) == 0 {
+		delete(c.Macros, register)
+		return
+	}
+	if c.Macros == nil {
+		c.Macros = make(map[string][]string)
+	}
+	c.Macros[register] = keys
+}
+
+// IsFavoriteProject reports whether a project is starred
+func (c *Config) IsFavoriteProject(name string) bool {
+	return slices.Contains(c.Projects.Favorites, name)
+}
+
+// ToggleFavoriteProject stars or unstars a project. It reports whether the
+// project is a favorite now.
+func (c *Config) ToggleFavoriteProject(name string) bool {
+	if i := slices.Index(c.Projects.Favorites, name); i >= 0 {
+		c.Projects.Favorites = slices.Delete(c.Projects.Favorites, i, i+1)
+		return false
+	}
+	c.Projects.Favorites = append(c.Projects.Favorites, name)
+	return true
+}
+
+// AddRecentProject moves a project to the front of the recent projects,
+// keeping the most recent constants.MaxRecentProjects
+func (c *Config) AddRecentProject(name string) {
+	recent := []string{name}
+	for _, project := range c.Projects.Recent {
+		if project != name && len(recent) < constants.MaxRecentProjects {
+			recent = append(recent, project)
+		}
+	}
+	c.Projects.Recent = recent
+}
+
+// ForgetProject removes a deleted project from the favorites and recent
+// projects. It reports whether either listed it.
+func (c *Config) ForgetProject(name string) bool {
+	favorites := len(c.Projects.Favorites)
+	recent := len(c.Projects.Recent)
+	c.Projects.Favorites = slices.DeleteFunc(c.Projects.Favorites, func(project string) bool { return project == name })
+	c.Projects.Recent = slices.DeleteFunc(c.Projects.Recent, func(project string) bool { return project == name })
+	return len(c.Projects.Favorites) != favorites || len(c.Projects.Recent) != recent
+}
@@ -0,0 +1,241 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Load() of missing file returned error: %v", err)
+	}
+	if cfg.Views == nil {
+		t.Errorf("Expected initialized views map")
+	}
+}
+
+func TestSaveAndLoadViews(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "config.json")
+
+	cfg := &Config{}
+	cfg.SetView("Pods", SavedView{Name: "crashlooping", Filter: "status:CrashLoopBackOff"})
+	cfg.SetView("Pods", SavedView{Name: "by-node", GroupBy: "node", Columns: []string{"name", "node"}})
+	cfg.SetView("Pods", SavedView{Name: "crashlooping", Filter: "restarts>0", SortBy: "restarts", SortDesc: true})
+
+	if err := cfg.Save(path); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+
+	views := loaded.ViewsFor("Pods")
+	if len(views) != 2 {
+		t.Fatalf("Expected 2 views, got %d", len(views))
+	}
+	if views[0].Filter != "restarts>0" || !views[0].SortDesc {
+		t.Errorf("Expected replaced view, got %+v", views[0])
+	}
+	if len(views[1].Columns) != 2 {
+		t.Errorf("Expected 2 columns, got %v", views[1].Columns)
+	}
+
+	if !loaded.DeleteView("Pods", "by-node") {
+		t.Errorf("Expected DeleteView to remove existing view")
+	}
+	if loaded.DeleteView("Pods", "by-node") {
+		t.Errorf("Expected DeleteView to report missing view")
+	}
+	if len(loaded.ViewsFor("Pods")) != 1 {
+		t.Errorf("Expected 1 view after delete, got %d", len(loaded.ViewsFor("Pods")))
+	}
+}
+
+func TestSaveAndLoadMacros(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	cfg := &Config{}
+	cfg.SetMacro("a", []string{"/", "w", "e", "b", "enter", "l"})
+	cfg.SetMacro("b", []string{"j"})
+	cfg.SetMacro("b", nil)
+
+	if err := cfg.Save(path); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+
+	keys, ok := loaded.Macro("a")
+	if !ok || len(keys) != 6 || keys[4] != "enter" {
+		t.Errorf("Expected macro a to round-trip, got %v", keys)
+	}
+	if _, ok := loaded.Macro("b"); ok {
+		t.Errorf("Expected an empty macro to clear the register")
+	}
+}
+
+func TestPreferences(t *testing.T) {
+	var prefs Preferences
+	if prefs.ThemeName() != constants.DefaultTheme || prefs.PodRefreshInterval() != constants.PodRefreshInterval ||
+		!prefs.MouseEnabled() || prefs.LogTail() != constants.MaxLogLines || prefs.LogBufferBytes() != constants.DefaultLogBufferMB<<20 {
+		t.Errorf("Expected the defaults for unset preferences")
+	}
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	mouse := false
+	cfg := &Config{Preferences: Preferences{Theme: "light", DefaultNamespace: "shop", ResourceRefreshSeconds: 120, Mouse: &mouse, LogTailLines: 200, LastTab: "Routes"}}
+	if err := cfg.Save(path); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+
+	prefs = loaded.Preferences
+	if prefs.ThemeName() != "light" || prefs.DefaultNamespace != "shop" || prefs.LastTab != "Routes" {
+		t.Errorf("Expected preferences to round-trip, got %+v", prefs)
+	}
+	if prefs.ResourceRefreshInterval() != 2*time.Minute || prefs.PodRefreshInterval() != constants.PodRefreshInterval {
+		t.Errorf("Unexpected refresh intervals %s and %s", prefs.PodRefreshInterval(), prefs.ResourceRefreshInterval())
+	}
+	if prefs.MouseEnabled() || prefs.LogTail() != 200 {
+		t.Errorf("Expected mouse off and a tail of 200 lines")
+	}
+
+	mouse = true
+	merged := prefs.Merge(Preferences{Theme: "dark", LogTailLines: constants.MaxLogLines * 2, Mouse: &mouse})
+	if merged.ThemeName() != "dark" || !merged.MouseEnabled() || merged.DefaultNamespace != "shop" {
+		t.Errorf("Expected overrides to replace only the set fields, got %+v", merged)
+	}
+	if merged.StatusPaletteName() != constants.StatusPaletteDefault {
+		t.Errorf("Expected the default status palette, got %q", merged.StatusPaletteName())
+	}
+	if merged = merged.Merge(Preferences{StatusPalette: constants.StatusPaletteColorBlind}); merged.StatusPaletteName() != constants.StatusPaletteColorBlind {
+		t.Errorf("Expected the color-blind status palette, got %q", merged.StatusPaletteName())
+	}
+	if merged = merged.Merge(Preferences{PostmortemDir: "~/postmortems"}); merged.PostmortemDir != "~/postmortems" || merged.ThemeName() != "dark" {
+		t.Errorf("Expected the postmortem directory to be set, got %+v", merged)
+	}
+	if merged.LogTail() != constants.MaxLogLines {
+		t.Errorf("Expected the tail to be capped at the log buffer, got %d", merged.LogTail())
+	}
+	if merged.HibernateAfter() != constants.DefaultHibernateAfter {
+		t.Errorf("Expected the default hibernation period, got %s", merged.HibernateAfter())
+	}
+	if merged = merged.Merge(Preferences{HibernateMinutes: 5}); merged.HibernateAfter() != 5*time.Minute {
+		t.Errorf("Expected hibernation after 5 minutes, got %s", merged.HibernateAfter())
+	}
+	if merged = merged.Merge(Preferences{HibernateMinutes: -1}); merged.HibernateAfter() != 0 {
+		t.Errorf("Expected hibernation to be off, got %s", merged.HibernateAfter())
+	}
+	spill, noSpill := true, false
+	if merged = merged.Merge(Preferences{LogSpill: &spill}); !merged.LogSpillEnabled() {
+		t.Error("Expected log spilling to be on")
+	}
+	if merged = merged.Merge(Preferences{LogSpill: &noSpill}); merged.LogSpillEnabled() {
+		t.Error("Expected an override to turn saved log spilling off")
+	}
+	if merged = merged.Merge(Preferences{KubeconfigNamespace: &spill}); !merged.KubeconfigNamespaceEnabled() {
+		t.Error("Expected project switches to be written into the kubeconfig")
+	}
+	if merged = merged.Merge(Preferences{KubeconfigNamespace: &noSpill}); merged.KubeconfigNamespaceEnabled() {
+		t.Error("Expected an override to keep project switches in memory")
+	}
+	if merged.DebugImageName() != constants.DefaultDebugImage {
+		t.Errorf("Expected the default debug image, got %q", merged.DebugImageName())
+	}
+	if merged = merged.Merge(Preferences{DebugImage: "nicolaka/netshoot"}); merged.DebugImageName() != "nicolaka/netshoot" {
+		t.Errorf("Expected the netshoot debug image, got %q", merged.DebugImageName())
+	}
+	if merged.TimeFormatName() != constants.TimeFormatRelative || merged.AbsoluteTimeLayout() != constants.DefaultTimeLayout {
+		t.Errorf("Expected relative times by default, got %q", merged.TimeFormatName())
+	}
+	if merged = merged.Merge(Preferences{TimeFormat: "absolute", TimeLayout: "02.01.2006 15:04"}); merged.TimeFormatName() != constants.TimeFormatAbsolute || merged.AbsoluteTimeLayout() != "02.01.2006 15:04" {
+		t.Errorf("Expected absolute times in the day first layout, got %+v", merged)
+	}
+	if merged = merged.Merge(Preferences{TimeFormat: "unix"}); merged.TimeFormatName() != constants.TimeFormatRelative {
+		t.Errorf("Expected an unknown time format to be relative, got %q", merged.TimeFormatName())
+	}
+}
+
+func TestStartupChecks(t *testing.T) {
+	all := []string{"metrics-server", "default-storage-class", "image-pull-secrets"}
+	cfg := &Config{}
+	if got := cfg.EnabledStartupChecks(all); len(got) != 3 {
+		t.Errorf("Expected every check to be enabled by default, got %v", got)
+	}
+
+	cfg.DisableStartupCheck("image-pull-secrets")
+	cfg.DisableStartupCheck("image-pull-secrets")
+	if got := cfg.EnabledStartupChecks(all); len(got) != 2 || len(cfg.StartupChecks.Disabled) != 1 {
+		t.Errorf("Expected the check to be disabled once, got %v and %v", got, cfg.StartupChecks.Disabled)
+	}
+
+	cfg.StartupChecks.Off = true
+	if got := cfg.EnabledStartupChecks(all); len(got) != 0 {
+		t.Errorf("Expected no checks when they are off, got %v", got)
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{"profiles": {"payments-prod": {"context": "prod", "namespace": "payments", "tab": "Deployments", "filter": "labels:app=payments"}, "dev": {"namespace": "dev"}}}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	profile, ok := cfg.Profile("payments-prod")
+	if !ok || profile.Context != "prod" || profile.Namespace != "payments" || profile.Tab != "Deployments" || profile.Filter != "labels:app=payments" {
+		t.Errorf("Expected the payments-prod profile to be loaded, got %+v", profile)
+	}
+	if _, ok := cfg.Profile("missing"); ok {
+		t.Error("Expected no missing profile")
+	}
+	if names := cfg.ProfileNames(); len(names) != 2 || names[0] != "dev" || names[1] != "payments-prod" {
+		t.Errorf("Expected sorted profile names, got %v", names)
+	}
+}
+
+func TestProjectFavoritesAndRecents(t *testing.T) {
+	cfg := &Config{}
+
+	if !cfg.ToggleFavoriteProject("shop") || !cfg.IsFavoriteProject("shop") {
+		t.Errorf("Expected the first toggle to star the project")
+	}
+	if cfg.ToggleFavoriteProject("shop") || cfg.IsFavoriteProject("shop") {
+		t.Errorf("Expected the second toggle to unstar the project")
+	}
+
+	for i := 0; i < constants.MaxRecentProjects+2; i++ {
+		cfg.AddRecentProject(fmt.Sprintf("p%d", i))
+	}
+	cfg.AddRecentProject("p3")
+	recent := cfg.Projects.Recent
+	if len(recent) != constants.MaxRecentProjects || recent[0] != "p3" || recent[1] != fmt.Sprintf("p%d", constants.MaxRecentProjects+1) {
+		t.Errorf("Expected the most recent projects first without duplicates, got %v", recent)
+	}
+
+	cfg.ToggleFavoriteProject("p3")
+	if !cfg.ForgetProject("p3") || cfg.IsFavoriteProject("p3") || slices.Contains(cfg.Projects.Recent, "p3") {
+		t.Errorf("Expected a deleted project to be forgotten")
+	}
+	if cfg.ForgetProject("p3") {
+		t.Errorf("Expected nothing to forget the second time")
+	}
+}
@@ -13,4 +13,42 @@ const (
 
 	// ReadyEndpoint is the endpoint for readiness checks
 	ReadyEndpoint = "/ready"
+
+	// ReadyzEndpoint is the API server aggregated readiness endpoint
+	ReadyzEndpoint = "/readyz"
+
+	// VersionEndpoint is the API server version endpoint, used as a cheap latency probe
+	VersionEndpoint = "/version"
+
+	// MetricsAPIPath is the base path of the resource metrics API served by metrics-server
+	MetricsAPIPath = "/apis/metrics.k8s.io/v1beta1"
+
+	// APIServerMetricsPath is the API server's Prometheus metrics endpoint
+	APIServerMetricsPath = "/metrics"
+
+	// OAuthMetadataPath is where OpenShift publishes the endpoints of its OAuth server
+	OAuthMetadataPath = "/.well-known/oauth-authorization-server"
+)
+
+// ControlPlaneOperators lists the OpenShift ClusterOperators that manage
+// control-plane components, in display order
+var ControlPlaneOperators = []string{"kube-apiserver", "kube-scheduler", "kube-controller-manager", "etcd"}
+
+// OpenShift build settings
+const (
+	// ManualBuildTriggerMessage is recorded as the trigger cause for builds started from LazyOC
+	ManualBuildTriggerMessage = "Manually triggered from LazyOC"
+)
+
+// OpenShift OAuth settings
+const (
+	// OAuthChallengingClientID is the OAuth client that trades a username and
+	// password for a token, as used by 'oc login'
+	OAuthChallengingClientID = "openshift-challenging-client"
+)
+
+// OpenShift machine API settings
+const (
+	// MachineAPINamespace holds the MachineSets and Machines of an OpenShift cluster
+	MachineAPINamespace = "openshift-machine-api"
 )
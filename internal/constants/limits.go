@@ -5,8 +5,26 @@ const (
 	// DefaultListLimit is the default number of items to retrieve in list operations
 	DefaultListLimit = 100
 
+	// ListPageSize is the number of resources fetched per request when a
+	// resource list is loaded page by page
+	ListPageSize = 500
+
+	// MaxListItems caps how many resources of one type are loaded into a list
+	MaxListItems = 20000
+
 	// DefaultPageSize is the default number of items per page in paginated views
 	DefaultPageSize = 20
+
+	// ProjectPageSize is the number of projects fetched per page by the project switcher
+	ProjectPageSize = 250
+
+	// ProjectPrefetchThreshold is how close to the end of the loaded projects the
+	// selection may get before the next page is fetched
+	ProjectPrefetchThreshold = 20
+
+	// MaxRecentProjects is how many recently opened projects the project
+	// switcher lists first
+	MaxRecentProjects = 5
 )
 
 // Resource limits
@@ -19,6 +37,9 @@ const (
 
 	// DefaultMaxEvents is the maximum number of events to retain
 	DefaultMaxEvents = 100
+
+	// MaxRelatedEvents is the number of related events shown in the detail pane
+	MaxRelatedEvents = 5
 )
 
 // Buffer and channel sizes
@@ -29,8 +50,77 @@ const (
 	// MaxLogLines is the maximum number of log lines to keep in memory per pod
 	MaxLogLines = 1000
 
+	// DefaultLogBufferMB is the default memory cap of the pod log buffer in MiB
+	DefaultLogBufferMB = 4
+
+	// MaxLogBufferMB is the largest memory cap of the pod log buffer that can be set
+	MaxLogBufferMB = 1024
+
 	// MaxAppLogEntries is the maximum number of application log entries to keep
 	MaxAppLogEntries = 500
+
+	// LogStreamBatchSize is the maximum number of streamed log lines delivered to the UI in one message
+	LogStreamBatchSize = 200
+
+	// LogResumeOverlapLines is the number of recent log lines compared to drop duplicates after a stream reconnects
+	LogResumeOverlapLines = 50
+
+	// LogStreamErrorBudget is the number of consecutive log stream failures before reconnects stop
+	LogStreamErrorBudget = 5
+
+	// MaxFinishedTasks is the maximum number of finished background tasks kept in the task panel
+	MaxFinishedTasks = 20
+
+	// MaxMacroKeys is the maximum number of keys a keyboard macro can record
+	MaxMacroKeys = 500
+
+	// MaxDrainPodsShown is the maximum number of pods listed in the drain confirmation
+	MaxDrainPodsShown = 10
+
+	// MaxUsageSamples is the number of usage samples kept per container for right-sizing
+	MaxUsageSamples = 120
+
+	// PostmortemLogLines is the number of log lines saved per container in a postmortem
+	PostmortemLogLines = 500
+
+	// MaxNotifications is the number of pod and deployment notifications kept in the history
+	MaxNotifications = 100
+
+	// TopSparklineWidth is the number of usage samples drawn in a Top view sparkline
+	TopSparklineWidth = 20
+
+	// MaxRestartSamples is the number of restart counts kept per pod for its restart trend
+	MaxRestartSamples = 60
+
+	// RestartSparklineWidth is the number of restart counts drawn in a pod's restart trend
+	RestartSparklineWidth = 30
+
+	// MaxScalingPodsShown is the maximum number of pods waiting for a scale up listed on the Nodes tab
+	MaxScalingPodsShown = 5
+
+	// MaxStoredObjectsShown is the number of resources listed from the API server's etcd object counts
+	MaxStoredObjectsShown = 10
+
+	// MaxLargestResourcesShown is the number of resource types named per namespace in the object count report
+	MaxLargestResourcesShown = 3
+
+	// MaxCascadeLinesShown is the number of dependents listed in a delete preview
+	MaxCascadeLinesShown = 15
+
+	// MaxManagedFieldsShown is the number of fields listed per field manager
+	MaxManagedFieldsShown = 20
+
+	// MaxTopologyPodsShown is the number of pods drawn in a service topology
+	MaxTopologyPodsShown = 10
+
+	// MaxConfigMapKeysShown is the number of keys listed at once in the configmap data modal
+	MaxConfigMapKeysShown = 8
+
+	// MaxBatchItemsShown is the number of marked items listed in a batch confirmation
+	MaxBatchItemsShown = 15
+
+	// MaxCommandHistory is the number of ':' commands kept for recall
+	MaxCommandHistory = 50
 )
 
 // Retry configuration
@@ -38,6 +128,9 @@ const (
 	// DefaultRetryAttempts is the standard number of retry attempts
 	DefaultRetryAttempts = 3
 
+	// CircuitBreakerThreshold is the number of consecutive failed loads that opens a resource's circuit
+	CircuitBreakerThreshold = 3
+
 	// MinOpenShiftAPIsThreshold is the minimum number of OpenShift APIs required for detection
 	MinOpenShiftAPIsThreshold = 3
 )
@@ -22,4 +22,44 @@ const (
 
 	// LogFilePermissions defines the permissions for log files
 	LogFilePermissions = 0666
+
+	// ConfigDirPermissions defines the permissions for the LazyOC configuration directory
+	ConfigDirPermissions = 0755
+
+	// ConfigFilePermissions defines the permissions for the LazyOC configuration file
+	ConfigFilePermissions = 0600
+
+	// EditTempFilePattern is the temporary file name pattern used for $EDITOR round trips
+	EditTempFilePattern = "lazyoc-edit-*.yaml"
+
+	// ConfigMapValueTempFilePattern is the temporary file name pattern used to
+	// edit a configmap value, the key is appended so editors recognize its format
+	ConfigMapValueTempFilePattern = "lazyoc-configmap-*-"
+
+	// SecretValueTempFilePattern is the temporary file name pattern used to
+	// edit a decoded secret value, the key is appended like for configmaps
+	SecretValueTempFilePattern = "lazyoc-secret-*-"
+
+	// DiffTempFilePattern is the temporary file name pattern of the two sides
+	// given to the diff tool, the side is appended so they are told apart
+	DiffTempFilePattern = "lazyoc-diff-*-"
+
+	// ApplyTempFilePattern is the temporary file name pattern used when writing manifests to apply
+	ApplyTempFilePattern = "lazyoc-apply-*.yaml"
+
+	// LogSpillFilePattern is the temporary file name pattern for pod log lines
+	// spilled from the log buffer
+	LogSpillFilePattern = "lazyoc-podlogs-*.log"
+
+	// LogExportFilePermissions defines the permissions for log files saved from the log panel
+	LogExportFilePermissions = 0644
+
+	// CheatSheetFilePermissions defines the permissions for the keybinding cheat sheet written by lazyoc keys
+	CheatSheetFilePermissions = 0644
+
+	// DefaultEditor is the editor used when neither $KUBE_EDITOR nor $EDITOR is set
+	DefaultEditor = "vi"
+
+	// DefaultDebugImage is the image of ephemeral debug containers when none is configured
+	DefaultDebugImage = "busybox"
 )
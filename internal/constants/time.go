@@ -7,6 +7,10 @@ const (
 	// AuthenticationTimeout is the maximum time allowed for authentication operations
 	AuthenticationTimeout = 5 * time.Second
 
+	// AuthHookTimeout is the maximum time an auth hook may take to supply the
+	// connection, SSO flows may wait for a login in the browser
+	AuthHookTimeout = 2 * time.Minute
+
 	// ConnectionTestTimeout is the maximum time allowed for testing connections
 	ConnectionTestTimeout = 3 * time.Second
 
@@ -21,6 +25,21 @@ const (
 
 	// DefaultRequestTimeout is the standard timeout for API requests
 	DefaultRequestTimeout = 10 * time.Second
+
+	// URLHealthCheckTimeout is the maximum time the health check of a route or
+	// ingress URL waits for the response
+	URLHealthCheckTimeout = 10 * time.Second
+
+	// HeadlessTimeout is the maximum time lazyoc get and lazyoc snapshot may
+	// list resources, a snapshot lists every resource type of a namespace
+	HeadlessTimeout = 2 * time.Minute
+
+	// BackgroundTaskTimeout is the maximum time a background action may run
+	BackgroundTaskTimeout = 5 * time.Minute
+
+	// ScalingEventWindow is how recent a cluster autoscaler event about a pending
+	// pod must be for the pod to be reported as waiting for capacity
+	ScalingEventWindow = 10 * time.Minute
 )
 
 // Interval constants define refresh and check intervals
@@ -28,6 +47,23 @@ const (
 	// PodRefreshInterval is the time between automatic pod list refreshes
 	PodRefreshInterval = 30 * time.Second
 
+	// ResourceRefreshInterval is the time between automatic refreshes of non-pod tabs
+	ResourceRefreshInterval = 60 * time.Second
+
+	// MinAutoRefreshInterval is the shortest refresh interval that can be set
+	MinAutoRefreshInterval = 5 * time.Second
+
+	// DefaultHibernateAfter is how long the terminal may go without input
+	// before refreshes and log streams stop
+	DefaultHibernateAfter = 30 * time.Minute
+
+	// TabSummaryInterval is how often the tab bar's counts, kept by watches,
+	// are updated at most
+	TabSummaryInterval = 2 * time.Second
+
+	// AutoRefreshTickInterval is how often the auto-refresh countdown is updated
+	AutoRefreshTickInterval = 1 * time.Second
+
 	// PodLogRefreshInterval is the time between automatic pod log refreshes
 	PodLogRefreshInterval = 500 * time.Millisecond
 
@@ -36,6 +72,70 @@ const (
 
 	// DefaultRetryDelay is the standard delay between retry attempts
 	DefaultRetryDelay = 5 * time.Second
+
+	// LogStreamRetryBaseDelay is the initial delay before reconnecting a failed pod log stream
+	LogStreamRetryBaseDelay = 2 * time.Second
+
+	// LogStreamRetryMaxDelay caps the backoff between pod log stream reconnects
+	LogStreamRetryMaxDelay = 60 * time.Second
+
+	// LogStreamHealthyAfter is how long a log stream must stay up before its failure count is reset
+	LogStreamHealthyAfter = 30 * time.Second
+
+	// RolloutStatusPollInterval is the time between rollout status refreshes on the Deployments tab
+	RolloutStatusPollInterval = 2 * time.Second
+
+	// APILatencyProbeInterval is the time between API server latency probes
+	APILatencyProbeInterval = 15 * time.Second
+
+	// TopRefreshInterval is the time between usage samples while the Top view is open
+	TopRefreshInterval = 5 * time.Second
+
+	// NotificationToastDuration is how long a notification stays in the status bar
+	NotificationToastDuration = 8 * time.Second
+
+	// ResizeDebounceInterval is how long the terminal size must stay unchanged
+	// before the screen is laid out again
+	ResizeDebounceInterval = 80 * time.Millisecond
+)
+
+// API latency thresholds used to color the status bar indicator
+const (
+	// APILatencyWarnThreshold is the latency above which the indicator turns yellow
+	APILatencyWarnThreshold = 300 * time.Millisecond
+
+	// APILatencyCriticalThreshold is the latency above which the indicator turns red
+	APILatencyCriticalThreshold = 1 * time.Second
+)
+
+// Loader retry backoff and circuit breaking
+const (
+	// RetryBaseDelay is the initial backoff delay before the first loader retry
+	RetryBaseDelay = 500 * time.Millisecond
+
+	// RetryMaxDelay caps the exponential backoff delay between loader retries
+	RetryMaxDelay = 10 * time.Second
+
+	// CircuitBreakerCooldown is how long a tripped circuit stays open before a probe request is allowed
+	CircuitBreakerCooldown = 30 * time.Second
+)
+
+// Token expiry thresholds
+const (
+	// TokenExpiryWarning is how long before the bearer token expires that LazyOC prompts for re-login
+	TokenExpiryWarning = 10 * time.Minute
+
+	// TokenExpiryCaution is the remaining token lifetime below which the status bar countdown turns yellow
+	TokenExpiryCaution = 1 * time.Hour
+)
+
+// Keyboard macro replay pacing
+const (
+	// MacroStepInterval is the pause between replayed macro keys
+	MacroStepInterval = 50 * time.Millisecond
+
+	// MacroStepTimeout is how long a macro replay waits for loading to finish before it is aborted
+	MacroStepTimeout = 15 * time.Second
 )
 
 // Cache duration constants
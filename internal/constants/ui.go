@@ -7,8 +7,32 @@ const (
 	// DefaultTheme is the default UI theme
 	DefaultTheme = "dark"
 
+	// StatusPaletteDefault colors statuses green, yellow and red
+	StatusPaletteDefault = "default"
+
+	// StatusPaletteColorBlind marks statuses with shapes and colors that stay
+	// apart with deuteranopia: blue, yellow and vermillion
+	StatusPaletteColorBlind = "colorblind"
+
+	// TimeFormatRelative shows times as ages, e.g. 3d
+	TimeFormatRelative = "relative"
+
+	// TimeFormatAbsolute shows times as local timestamps in the configured layout
+	TimeFormatAbsolute = "absolute"
+
+	// TimeFormatISO8601 shows times as local ISO 8601 timestamps, e.g.
+	// 2024-03-05T14:07:00+01:00
+	TimeFormatISO8601 = "iso8601"
+
+	// DefaultTimeLayout is the Go layout of absolute timestamps
+	DefaultTimeLayout = "2006-01-02 15:04"
+
 	// DefaultNamespace is the default Kubernetes namespace
 	DefaultNamespace = "default"
+
+	// DefaultServiceAccount is the service account pods run as unless they
+	// name another one
+	DefaultServiceAccount = "default"
 )
 
 // UI dimensions
@@ -24,7 +48,7 @@ const (
 )
 
 // ResourceTabs defines the available resource tabs in the UI
-var ResourceTabs = []string{"Pods", "Services", "Deployments", "ConfigMaps", "Secrets", "BuildConfigs", "ImageStreams", "Routes"}
+var ResourceTabs = []string{"Pods", "Services", "Deployments", "ConfigMaps", "Secrets", "BuildConfigs", "ImageStreams", "Routes", "Builds", "Events", "StatefulSets", "DaemonSets", "ReplicaSets", "Jobs", "CronJobs", "Nodes", "Storage", "Ingresses", "NetworkPolicies", "DeploymentConfigs"}
 
 // PanelNames defines the available panels in the UI
 var PanelNames = []string{"Main", "Details", "Logs"}
@@ -39,6 +63,9 @@ const (
 	
 	// ServiceLogViewMode shows aggregated logs from all pods behind a service  
 	ServiceLogViewMode = "service"
+
+	// BuildLogViewMode shows the streamed logs of an OpenShift build
+	BuildLogViewMode = "build"
 	
 	// DefaultLogViewMode is the default log view mode
 	DefaultLogViewMode = PodLogViewMode
@@ -59,7 +86,7 @@ const (
 	ConnectingStatus = "⟳ Connecting..."
 
 	// NotConnectedMessage is shown when not connected
-	NotConnectedMessage = "○ Not connected - Run 'oc login' or use --kubeconfig"
+	NotConnectedMessage = "○ Not connected - Press ctrl+l to log in, run 'oc login' or use --kubeconfig"
 
 	// LoadingPodsMessage is shown when loading pods
 	LoadingPodsMessage = "📦 Pods\n\nLoading pods..."
@@ -83,7 +110,7 @@ const (
 	ProjectModalMinHeight = 6
 
 	// ProjectModalMaxHeight is the maximum height for project modal
-	ProjectModalMaxHeight = 15
+	ProjectModalMaxHeight = 20
 
 	// ProjectModalMinWidth is the minimum width for project modal
 	ProjectModalMinWidth = 4
@@ -99,6 +126,9 @@ const (
 
 	// InitialTickDelay is the initial delay before ticking
 	InitialTickDelay = 100 * time.Millisecond
+
+	// MaxRenderFPS caps how often the interface is redrawn
+	MaxRenderFPS = 30
 )
 
 // Layout constants
@@ -136,6 +166,9 @@ const (
 	// LastNAppLogEntries is the number of recent app log entries to show
 	LastNAppLogEntries = 100
 
+	// ShortCommitLength is the number of characters shown for git commit hashes
+	ShortCommitLength = 7
+
 	// PodNameTruncateLength is the length to truncate pod names
 	PodNameTruncateLength = 38
 
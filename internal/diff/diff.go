@@ -0,0 +1,232 @@
+// Package diff compares texts line by line and renders the differences as a
+// unified diff
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Op is what happened to a line from the old text to the new one
+type Op int
+
+const (
+	Equal Op = iota
+	Delete
+	Insert
+)
+
+// Line is a line of the old or new text
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// maxTrace bounds the memory of the edit graph search. Texts differing more
+// are diffed as the middle replaced in whole.
+const maxTrace = 1 << 22
+
+// Lines returns the shortest edit turning a into b, with the Myers algorithm
+func Lines(a, b []string) []Line {
+	// The common prefix and suffix need no search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]Line, 0, len(a)+len(b))
+	for _, text := range a[:prefix] {
+		lines = append(lines, Line{Op: Equal, Text: text})
+	}
+	lines = append(lines, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, Line{Op: Equal, Text: text})
+	}
+	return lines
+}
+
+// myers searches the edit graph of a and b for the shortest edit
+func myers(a, b []string) []Line {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return replaced(a, b)
+	}
+	offset := n + m
+	v := make([]int, 2*offset+2)
+	var trace [][]int
+
+	for d := 0; d <= n+m; d++ {
+		if (len(trace)+1)*len(v) > maxTrace {
+			return replaced(a, b)
+		}
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, a, b, offset)
+			}
+		}
+	}
+	return replaced(a, b)
+}
+
+// backtrack follows the search back from the end of both texts
+func backtrack(trace [][]int, a, b []string, offset int) []Line {
+	var lines []Line
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			lines = append(lines, Line{Op: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				lines = append(lines, Line{Op: Insert, Text: b[y-1]})
+			} else {
+				lines = append(lines, Line{Op: Delete, Text: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+	return lines
+}
+
+// replaced returns every line of a deleted and every line of b inserted
+func replaced(a, b []string) []Line {
+	lines := make([]Line, 0, len(a)+len(b))
+	for _, text := range a {
+		lines = append(lines, Line{Op: Delete, Text: text})
+	}
+	for _, text := range b {
+		lines = append(lines, Line{Op: Insert, Text: text})
+	}
+	return lines
+}
+
+// Hunk is a run of changed lines with the unchanged lines around them
+type Hunk struct {
+	OldStart, OldLines int
+	NewStart, NewLines int
+	Lines              []Line
+}
+
+// Header returns the range line of the hunk, e.g. "@@ -3,7 +3,8 @@"
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%s +%s @@", hunkRange(h.OldStart, h.OldLines), hunkRange(h.NewStart, h.NewLines))
+}
+
+// hunkRange renders the start and length of a hunk side like diff -u, which
+// starts an empty side at the line before it
+func hunkRange(start, lines int) string {
+	switch lines {
+	case 0:
+		return fmt.Sprintf("%d,0", start-1)
+	case 1:
+		return fmt.Sprint(start)
+	}
+	return fmt.Sprintf("%d,%d", start, lines)
+}
+
+// Hunks groups the changes of an edit with up to context unchanged lines
+// around them, merging changes closer than twice that
+func Hunks(lines []Line, context int) []Hunk {
+	// oldAt and newAt number the line each side is at before lines[i]
+	oldAt, newAt := make([]int, len(lines)+1), make([]int, len(lines)+1)
+	oldAt[0], newAt[0] = 1, 1
+	var changes []int
+	for i, line := range lines {
+		oldAt[i+1], newAt[i+1] = oldAt[i], newAt[i]
+		if line.Op != Insert {
+			oldAt[i+1]++
+		}
+		if line.Op != Delete {
+			newAt[i+1]++
+		}
+		if line.Op != Equal {
+			changes = append(changes, i)
+		}
+	}
+
+	var hunks []Hunk
+	for first := 0; first < len(changes); {
+		last := first
+		for last+1 < len(changes) && changes[last+1]-changes[last]-1 <= 2*context {
+			last++
+		}
+		start := max(changes[first]-context, 0)
+		end := min(changes[last]+context+1, len(lines))
+		hunks = append(hunks, Hunk{
+			OldStart: oldAt[start], OldLines: oldAt[end] - oldAt[start],
+			NewStart: newAt[start], NewLines: newAt[end] - newAt[start],
+			Lines: lines[start:end],
+		})
+		first = last + 1
+	}
+	return hunks
+}
+
+// Unified returns the unified diff of two texts with context lines around
+// each change, "" when they are equal
+func Unified(oldName, newName, a, b string, context int) string {
+	hunks := Hunks(Lines(splitLines(a), splitLines(b)), context)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	out.WriteString("--- " + oldName + "\n")
+	out.WriteString("+++ " + newName + "\n")
+	for _, hunk := range hunks {
+		out.WriteString(hunk.Header() + "\n")
+		for _, line := range hunk.Lines {
+			switch line.Op {
+			case Equal:
+				out.WriteString(" ")
+			case Delete:
+				out.WriteString("-")
+			case Insert:
+				out.WriteString("+")
+			}
+			out.WriteString(line.Text + "\n")
+		}
+	}
+	return out.String()
+}
+
+// splitLines splits a text into lines, without the empty line after a final
+// newline
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,89 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// apply rebuilds both texts from an edit
+func apply(lines []Line) (old, new []string) {
+	for _, line := range lines {
+		if line.Op != Insert {
+			old = append(old, line.Text)
+		}
+		if line.Op != Delete {
+			new = append(new, line.Text)
+		}
+	}
+	return old, new
+}
+
+func TestLines(t *testing.T) {
+	for _, tc := range []struct {
+		a, b  string
+		edits int
+	}{
+		{"a b c a b b a", "c b a b a c", 5},
+		{"", "x y", 2},
+		{"x y", "", 2},
+		{"same", "same", 0},
+		{"a b c d", "a x c d", 2},
+	} {
+		a, b := strings.Fields(tc.a), strings.Fields(tc.b)
+		lines := Lines(a, b)
+		old, new := apply(lines)
+		if strings.Join(old, " ") != tc.a || strings.Join(new, " ") != tc.b {
+			t.Errorf("Lines(%q, %q) does not rebuild both texts: %+v", tc.a, tc.b, lines)
+		}
+		edits := 0
+		for _, line := range lines {
+			if line.Op != Equal {
+				edits++
+			}
+		}
+		if edits != tc.edits {
+			t.Errorf("Lines(%q, %q) took %d edits, want %d", tc.a, tc.b, edits, tc.edits)
+		}
+	}
+}
+
+func TestUnified(t *testing.T) {
+	var old, new []string
+	for i := 1; i <= 20; i++ {
+		old = append(old, fmt.Sprintf("line %d", i))
+	}
+	new = append(new, old...)
+	new[1] = "line two"
+	new = append(new[:15], new[16:]...)
+
+	got := Unified("a.yaml", "b.yaml", strings.Join(old, "\n")+"\n", strings.Join(new, "\n")+"\n", 3)
+	want := `--- a.yaml
++++ b.yaml
+@@ -1,5 +1,5 @@
+ line 1
+-line 2
++line two
+ line 3
+ line 4
+ line 5
+@@ -13,7 +13,6 @@
+ line 13
+ line 14
+ line 15
+-line 16
+ line 17
+ line 18
+ line 19
+`
+	if got != want {
+		t.Errorf("Unified() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := Unified("a", "b", "x\n", "x\n", 3); got != "" {
+		t.Errorf("Expected no diff of equal texts, got %q", got)
+	}
+	if got := Unified("a", "b", "", "x\n", 3); !strings.Contains(got, "@@ -0,0 +1 @@\n+x\n") {
+		t.Errorf("Expected an insertion into an empty text, got %q", got)
+	}
+}
@@ -0,0 +1,303 @@
+// Package headless prints cluster resources without the terminal UI, as the
+// same structures the TUI lists, for scripts and CI
+package headless
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// Format is an output format of the headless commands
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+)
+
+// ParseFormat parses the -o flag of the headless commands
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(value)) {
+	case JSON:
+		return JSON, nil
+	case YAML:
+		return YAML, nil
+	}
+	return "", fmt.Errorf("unknown output format %q, use json or yaml", value)
+}
+
+// Clients are the resource clients of a headless command. OpenShift is nil
+// when the cluster is plain Kubernetes.
+type Clients struct {
+	Resources resources.ResourceClient
+	OpenShift *resources.OpenShiftResourceClient
+	Namespace string
+}
+
+// Connect creates the resource clients from a kubeconfig the way the TUI
+// does. Empty context and namespace use the kubeconfig's current ones.
+func Connect(ctx context.Context, kubeconfigPath, contextName, namespace string, tls auth.TLSOverrides) (*Clients, error) {
+	provider := auth.NewKubeconfigProvider(kubeconfigPath)
+	if contextName != "" {
+		provider = auth.NewKubeconfigProviderWithContext(kubeconfigPath, contextName)
+	}
+
+	authCtx, cancel := context.WithTimeout(ctx, constants.AuthenticationTimeout)
+	defer cancel()
+	config, err := provider.Authenticate(authCtx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", err)
+	}
+	tls.Apply(config)
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("clientset creation failed: %w", err)
+	}
+	if namespace == "" {
+		namespace = provider.GetNamespace()
+	}
+
+	clients := &Clients{
+		Resources: resources.NewK8sResourceClientWithConfig(clientset, config, namespace),
+		Namespace: namespace,
+	}
+
+	factory := k8s.NewClientFactory()
+	factory.SetClientset(clientset)
+	factory.SetConfig(config)
+	if err := factory.InitializeOpenShiftAfterSetup(); err == nil && factory.IsOpenShift() {
+		clients.OpenShift = resources.NewOpenShiftResourceClient(factory)
+	}
+	return clients, nil
+}
+
+// kind is a resource type the headless commands list
+type kind struct {
+	name       string
+	aliases    []string
+	namespaced bool
+	openShift  bool
+	list       func(ctx context.Context, clients *Clients, opts resources.ListOptions) (any, error)
+}
+
+// kinds are the resource types of lazyoc get, the namespaced ones are also
+// saved by lazyoc snapshot
+var kinds = []kind{
+	{"pods", []string{"pod", "po"}, true, false, pages(resources.ResourceClient.ListPods)},
+	{"services", []string{"service", "svc"}, true, false, pages(resources.ResourceClient.ListServices)},
+	{"deployments", []string{"deployment", "deploy"}, true, false, pages(resources.ResourceClient.ListDeployments)},
+	{"statefulsets", []string{"statefulset", "sts"}, true, false, pages(resources.ResourceClient.ListStatefulSets)},
+	{"daemonsets", []string{"daemonset", "ds"}, true, false, pages(resources.ResourceClient.ListDaemonSets)},
+	{"replicasets", []string{"replicaset", "rs"}, true, false, pages(resources.ResourceClient.ListReplicaSets)},
+	{"jobs", []string{"job"}, true, false, pages(resources.ResourceClient.ListJobs)},
+	{"cronjobs", []string{"cronjob", "cj"}, true, false, pages(resources.ResourceClient.ListCronJobs)},
+	{"configmaps", []string{"configmap", "cm"}, true, false, pages(resources.ResourceClient.ListConfigMaps)},
+	{"secrets", []string{"secret"}, true, false, pages(resources.ResourceClient.ListSecrets)},
+	{"persistentvolumeclaims", []string{"persistentvolumeclaim", "pvc"}, true, false, pages(resources.ResourceClient.ListPersistentVolumeClaims)},
+	{"ingresses", []string{"ingress", "ing"}, true, false, pages(resources.ResourceClient.ListIngresses)},
+	{"networkpolicies", []string{"networkpolicy", "netpol"}, true, false, pages(resources.ResourceClient.ListNetworkPolicies)},
+	{"events", []string{"event", "ev"}, true, false, pages(resources.ResourceClient.ListEvents)},
+	{"routes", []string{"route"}, true, true, openShiftPages((*resources.OpenShiftResourceClient).ListRoutes)},
+	{"buildconfigs", []string{"buildconfig", "bc"}, true, true, openShiftPages((*resources.OpenShiftResourceClient).ListBuildConfigs)},
+	{"builds", []string{"build"}, true, true, openShiftPages((*resources.OpenShiftResourceClient).ListBuilds)},
+	{"imagestreams", []string{"imagestream", "is"}, true, true, openShiftPages((*resources.OpenShiftResourceClient).ListImageStreams)},
+	{"deploymentconfigs", []string{"deploymentconfig", "dc"}, true, true, openShiftPages((*resources.OpenShiftResourceClient).ListDeploymentConfigs)},
+	{"nodes", []string{"node", "no"}, false, false, pages(resources.ResourceClient.ListNodes)},
+	{"persistentvolumes", []string{"persistentvolume", "pv"}, false, false, pages(resources.ResourceClient.ListPersistentVolumes)},
+	{"storageclasses", []string{"storageclass", "sc"}, false, false, pages(resources.ResourceClient.ListStorageClasses)},
+}
+
+// pages lists every page of a resource of the resource client
+func pages[T any](list func(resources.ResourceClient, context.Context, resources.ListOptions) (*resources.ResourceList[T], error)) func(context.Context, *Clients, resources.ListOptions) (any, error) {
+	return func(ctx context.Context, clients *Clients, opts resources.ListOptions) (any, error) {
+		return listAll(ctx, opts, func(ctx context.Context, opts resources.ListOptions) (*resources.ResourceList[T], error) {
+			return list(clients.Resources, ctx, opts)
+		})
+	}
+}
+
+// openShiftPages lists every page of a resource of the OpenShift client
+func openShiftPages[T any](list func(*resources.OpenShiftResourceClient, context.Context, resources.ListOptions) (*resources.ResourceList[T], error)) func(context.Context, *Clients, resources.ListOptions) (any, error) {
+	return func(ctx context.Context, clients *Clients, opts resources.ListOptions) (any, error) {
+		return listAll(ctx, opts, func(ctx context.Context, opts resources.ListOptions) (*resources.ResourceList[T], error) {
+			return list(clients.OpenShift, ctx, opts)
+		})
+	}
+}
+
+// listAll follows the continue token of each page until the list is
+// complete. Unlike the TUI, scripts get every item.
+func listAll[T any](ctx context.Context, opts resources.ListOptions, list func(context.Context, resources.ListOptions) (*resources.ResourceList[T], error)) ([]T, error) {
+	opts.Limit = constants.ListPageSize
+	opts.Continue = ""
+
+	items := []T{}
+	for {
+		page, err := list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		if page.Continue == "" {
+			return items, nil
+		}
+		opts.Continue = page.Continue
+	}
+}
+
+// lookupKind finds a resource type by its name or an alias
+func lookupKind(name string) (kind, bool) {
+	name = strings.ToLower(name)
+	for _, k := range kinds {
+		if k.name == name || slices.Contains(k.aliases, name) {
+			return k, true
+		}
+	}
+	return kind{}, false
+}
+
+// KindNames returns the names of the resource types lazyoc get lists
+func KindNames() []string {
+	names := make([]string, len(kinds))
+	for i, k := range kinds {
+		names[i] = k.name
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get lists the resources of a type in the clients' namespace, or in every
+// namespace with allNamespaces
+func Get(ctx context.Context, clients *Clients, kindName, labelSelector string, allNamespaces bool) (any, error) {
+	k, ok := lookupKind(kindName)
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %q, use one of: %s", kindName, strings.Join(KindNames(), ", "))
+	}
+	if k.openShift && clients.OpenShift == nil {
+		return nil, fmt.Errorf("%s are only available on OpenShift clusters", k.name)
+	}
+
+	opts := resources.ListOptions{Namespace: clients.Namespace, LabelSelector: labelSelector, AllNamespaces: allNamespaces && k.namespaced}
+	items, err := k.list(ctx, clients, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", k.name, err)
+	}
+	return items, nil
+}
+
+// Snapshot is every namespaced resource of a namespace at one point in time
+type Snapshot struct {
+	Namespace string    `json:"namespace"`
+	TakenAt   time.Time `json:"takenAt"`
+
+	// Resources holds the items of each resource type by its name, e.g. "pods"
+	Resources map[string]any `json:"resources"`
+
+	// Errors holds why a resource type could not be listed, e.g. when
+	// listing secrets is forbidden, the snapshot has the others
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// TakeSnapshot lists every namespaced resource type of the clients'
+// namespace, OpenShift ones on OpenShift clusters
+func TakeSnapshot(ctx context.Context, clients *Clients, now time.Time) *Snapshot {
+	snapshot := &Snapshot{Namespace: clients.Namespace, TakenAt: now, Resources: make(map[string]any)}
+	for _, k := range kinds {
+		if !k.namespaced || (k.openShift && clients.OpenShift == nil) {
+			continue
+		}
+		items, err := k.list(ctx, clients, resources.ListOptions{Namespace: clients.Namespace})
+		if err != nil {
+			if snapshot.Errors == nil {
+				snapshot.Errors = make(map[string]string)
+			}
+			snapshot.Errors[k.name] = err.Error()
+			continue
+		}
+		snapshot.Resources[k.name] = items
+	}
+	return snapshot
+}
+
+// Write prints a value as indented JSON or as YAML
+func Write(w io.Writer, value any, format Format) error {
+	var data []byte
+	var err error
+	if format == YAML {
+		data, err = yaml.Marshal(value)
+	} else {
+		data, err = json.MarshalIndent(value, "", "  ")
+		data = append(data, '\n')
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode the output: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// AppliedObject is the outcome of applying one object, with the error of a
+// failed one for the JSON and YAML output
+type AppliedObject struct {
+	resources.ApplyResult
+	Error string `json:"error,omitempty"`
+}
+
+// Apply server-side applies a multi-document manifest to the clients'
+// namespace, or validates it without saving with dryRun
+func Apply(ctx context.Context, clients *Clients, manifest []byte, dryRun bool) ([]AppliedObject, error) {
+	applier, ok := clients.Resources.(resources.ResourceApplier)
+	if !ok {
+		return nil, fmt.Errorf("the resource client does not support apply")
+	}
+	if dryRun {
+		ctx = resources.WithDryRun(ctx)
+	}
+
+	results, err := applier.ApplyManifests(ctx, clients.Namespace, manifest)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]AppliedObject, len(results))
+	for i, result := range results {
+		objects[i] = AppliedObject{ApplyResult: result}
+		if result.Err != nil {
+			objects[i].Error = result.Err.Error()
+		}
+	}
+	return objects, nil
+}
+
+// WriteApplied prints a line per applied object like kubectl apply, e.g.
+// "deployment/web configured"
+func WriteApplied(w io.Writer, objects []AppliedObject, dryRun bool) error {
+	suffix := ""
+	if dryRun {
+		suffix = " (server dry run)"
+	}
+	for _, object := range objects {
+		line := fmt.Sprintf("%s %s%s\n", object.Ref(), object.Action, suffix)
+		if object.Error != "" {
+			line = fmt.Sprintf("%s %s: %s\n", object.Ref(), object.Action, object.Error)
+		}
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
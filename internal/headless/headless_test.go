@@ -0,0 +1,102 @@
+package headless
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+func TestParseFormat(t *testing.T) {
+	for value, expected := range map[string]Format{"json": JSON, "YAML": YAML} {
+		if format, err := ParseFormat(value); err != nil || format != expected {
+			t.Errorf("ParseFormat(%q) = %q, %v, expected %q", value, format, err, expected)
+		}
+	}
+	if _, err := ParseFormat("wide"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+func TestLookupKind(t *testing.T) {
+	for name, expected := range map[string]string{"pods": "pods", "po": "pods", "SVC": "services", "dc": "deploymentconfigs"} {
+		if k, ok := lookupKind(name); !ok || k.name != expected {
+			t.Errorf("lookupKind(%q) = %q, expected %q", name, k.name, expected)
+		}
+	}
+	if _, ok := lookupKind("widgets"); ok {
+		t.Error("Expected no kind named widgets")
+	}
+}
+
+func TestListAll(t *testing.T) {
+	pages := map[string]*resources.ResourceList[string]{
+		"":  {Items: []string{"a", "b"}, Continue: "2"},
+		"2": {Items: []string{"c"}},
+	}
+	items, err := listAll(context.Background(), resources.ListOptions{Continue: "stale"}, func(_ context.Context, opts resources.ListOptions) (*resources.ResourceList[string], error) {
+		return pages[opts.Continue], nil
+	})
+	if err != nil || strings.Join(items, ",") != "a,b,c" {
+		t.Errorf("Expected every page, got %v, %v", items, err)
+	}
+
+	// An empty list prints as [] rather than null
+	items, _ = listAll(context.Background(), resources.ListOptions{}, func(context.Context, resources.ListOptions) (*resources.ResourceList[string], error) {
+		return &resources.ResourceList[string]{}, nil
+	})
+	var out bytes.Buffer
+	if err := Write(&out, items, JSON); err != nil || out.String() != "[]\n" {
+		t.Errorf("Expected an empty JSON list, got %q, %v", out.String(), err)
+	}
+
+	if _, err := listAll(context.Background(), resources.ListOptions{}, func(context.Context, resources.ListOptions) (*resources.ResourceList[string], error) {
+		return nil, errors.New("forbidden")
+	}); err == nil {
+		t.Error("Expected the error of a page")
+	}
+}
+
+func TestGetOpenShiftKindOnKubernetes(t *testing.T) {
+	if _, err := Get(context.Background(), &Clients{Namespace: "shop"}, "routes", "", false); err == nil || !strings.Contains(err.Error(), "OpenShift") {
+		t.Errorf("Expected routes to need OpenShift, got %v", err)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	pods := []resources.PodInfo{{ResourceInfo: resources.ResourceInfo{Name: "web-1", Namespace: "shop"}, Phase: "Running"}}
+
+	var out bytes.Buffer
+	if err := Write(&out, pods, YAML); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "name: web-1") || !strings.Contains(out.String(), "phase: Running") {
+		t.Errorf("Expected the pod as YAML, got %q", out.String())
+	}
+}
+
+func TestWriteApplied(t *testing.T) {
+	objects := []AppliedObject{
+		{ApplyResult: resources.ApplyResult{Kind: "Deployment", Namespace: "shop", Name: "web", Action: resources.ApplyConfigured}},
+		{ApplyResult: resources.ApplyResult{Kind: "Service", Namespace: "shop", Name: "web", Action: resources.ApplyFailed}, Error: "forbidden"},
+	}
+
+	var out bytes.Buffer
+	if err := WriteApplied(&out, objects, true); err != nil {
+		t.Fatal(err)
+	}
+	if want := "deployment/web configured (server dry run)\nservice/web error: forbidden\n"; out.String() != want {
+		t.Errorf("Expected %q, got %q", want, out.String())
+	}
+
+	out.Reset()
+	if err := Write(&out, objects, JSON); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `"action": "error"`) || !strings.Contains(out.String(), `"error": "forbidden"`) {
+		t.Errorf("Expected the outcome and error as JSON, got %s", out.String())
+	}
+}
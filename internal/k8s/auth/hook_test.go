@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestHookProvider(t *testing.T) {
+	kubeconfigPath := filepath.Join(t.TempDir(), "config")
+	kubeconfig := `
+apiVersion: v1
+kind: Config
+current-context: test-context
+contexts:
+- context:
+    cluster: test-cluster
+    namespace: test-namespace
+    user: test-user
+  name: test-context
+clusters:
+- cluster:
+    server: https://test-server:6443
+  name: test-cluster
+users:
+- name: test-user
+  user:
+    token: stale-token
+`
+	if err := os.WriteFile(kubeconfigPath, []byte(kubeconfig), 0600); err != nil {
+		t.Fatalf("Failed to create kubeconfig: %v", err)
+	}
+
+	var got HookRequest
+	provider := NewHookProvider(NewKubeconfigProvider(kubeconfigPath), ConfigHookFunc(
+		func(ctx context.Context, req HookRequest) (*HookResult, error) {
+			got = req
+			config := rest.CopyConfig(req.Base)
+			config.BearerToken = "sso-token"
+			return &HookResult{Config: config}, nil
+		}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	config, err := provider.Authenticate(ctx)
+	if err != nil {
+		t.Fatalf("Authentication failed: %v", err)
+	}
+	if got.Context != "test-context" || got.Base == nil || got.Base.BearerToken != "stale-token" {
+		t.Errorf("Expected the hook to be handed the kubeconfig context, got %+v", got)
+	}
+	if config.Host != "https://test-server:6443" || config.BearerToken != "sso-token" {
+		t.Errorf("Expected the hook's config, got %s with token %q", config.Host, config.BearerToken)
+	}
+	if provider.GetContext() != "test-context" || provider.GetNamespace() != "test-namespace" {
+		t.Errorf("Expected the kubeconfig context and namespace, got %s and %s", provider.GetContext(), provider.GetNamespace())
+	}
+	if err := provider.IsValid(ctx); err != nil {
+		t.Errorf("Expected the provider to be valid, got %v", err)
+	}
+}
+
+func TestHookProvider_WithoutKubeconfig(t *testing.T) {
+	provider := NewHookProvider(NewKubeconfigProvider("/nonexistent/kubeconfig"), ConfigHookFunc(
+		func(ctx context.Context, req HookRequest) (*HookResult, error) {
+			if req.Base != nil {
+				t.Errorf("Expected no base config without a kubeconfig")
+			}
+			return &HookResult{Config: &rest.Config{Host: "https://sso:6443"}, Context: "sso", Namespace: "team-a"}, nil
+		}))
+
+	config, err := provider.Authenticate(context.Background())
+	if err != nil {
+		t.Fatalf("Authentication failed: %v", err)
+	}
+	if config.Host != "https://sso:6443" || provider.GetContext() != "sso" || provider.GetNamespace() != "team-a" {
+		t.Errorf("Expected the hook's connection, got %s, %s and %s", config.Host, provider.GetContext(), provider.GetNamespace())
+	}
+
+	// A failing hook and one without a server are authentication errors
+	for name, hook := range map[string]ConfigHookFunc{
+		"auth_hook_failed": func(ctx context.Context, req HookRequest) (*HookResult, error) {
+			return nil, errors.New("login cancelled")
+		},
+		"auth_hook_invalid": func(ctx context.Context, req HookRequest) (*HookResult, error) {
+			return &HookResult{Config: &rest.Config{}}, nil
+		},
+	} {
+		_, err := NewHookProvider(NewKubeconfigProvider("/nonexistent/kubeconfig"), hook).Authenticate(context.Background())
+		var authErr *AuthError
+		if !errors.As(err, &authErr) || authErr.Type != name {
+			t.Errorf("Expected a %s error, got %v", name, err)
+		}
+	}
+}
+
+func TestExecHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The hook script needs a POSIX shell")
+	}
+
+	script := filepath.Join(t.TempDir(), "sso-login")
+	content := `#!/bin/sh
+[ "$LAZYOC_CONTEXT" = prod ] || { echo "unexpected context $LAZYOC_CONTEXT" >&2; exit 1; }
+echo '{"token": "sso-token", "namespace": "team-a", "certificateAuthorityData": "CA"}'
+`
+	if err := os.WriteFile(script, []byte(content), 0700); err != nil {
+		t.Fatalf("Failed to create hook script: %v", err)
+	}
+
+	base := &rest.Config{Host: "https://api:6443", BearerTokenFile: "/var/token"}
+	base.CAFile = "/etc/ca.crt"
+	result, err := NewExecHook(script).Config(context.Background(), HookRequest{Context: "prod", Base: base})
+	if err != nil {
+		t.Fatalf("Hook failed: %v", err)
+	}
+	config := result.Config
+	if config.Host != "https://api:6443" || config.BearerToken != "sso-token" || config.BearerTokenFile != "" {
+		t.Errorf("Expected the printed token on the kubeconfig's server, got %+v", config)
+	}
+	if string(config.CAData) != "CA" || config.CAFile != "" || result.Namespace != "team-a" {
+		t.Errorf("Expected the printed CA and namespace, got %+v", result)
+	}
+	if base.BearerTokenFile != "/var/token" {
+		t.Errorf("Expected the base config to be left alone")
+	}
+
+	// The command's stderr explains a failure
+	_, err = NewExecHook(script).Config(context.Background(), HookRequest{Context: "dev"})
+	if err == nil || !strings.Contains(err.Error(), "unexpected context dev") {
+		t.Errorf("Expected the script's error, got %v", err)
+	}
+}
@@ -237,3 +237,24 @@ func getDefaultKubeconfigPath() string {
 
 	return filepath.Join(home, constants.KubeConfigDir, constants.KubeConfigFile)
 }
+
+// CurrentAuthInfo returns the name of the user entry used by the kubeconfig's
+// current context. It identifies the credentials but not necessarily the
+// username the cluster maps them to.
+func CurrentAuthInfo(kubeconfigPath string) (string, error) {
+	if kubeconfigPath == "" {
+		kubeconfigPath = getDefaultKubeconfigPath()
+	}
+
+	rawConfig, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if err != nil {
+		return "", NewAuthError("kubeconfig_load_failed", "failed to load kubeconfig file", err)
+	}
+
+	kubeContext, ok := rawConfig.Contexts[rawConfig.CurrentContext]
+	if !ok || kubeContext.AuthInfo == "" {
+		return "", NewAuthError("context_not_found", "current context has no user", nil)
+	}
+
+	return kubeContext.AuthInfo, nil
+}
@@ -0,0 +1,307 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// LoginOptions are the credentials entered for an interactive login
+type LoginOptions struct {
+	Server                string
+	Token                 string
+	Username              string
+	Password              string
+	Namespace             string
+	InsecureSkipTLSVerify bool
+}
+
+// LoginProvider implements authentication with credentials entered in the
+// TUI, like 'oc login': a bearer token, or a username and password traded for
+// a token with the OpenShift OAuth server
+type LoginProvider struct {
+	options  LoginOptions
+	config   *rest.Config
+	username string
+	context  string
+}
+
+// NewLoginProvider creates a login provider for the given credentials
+func NewLoginProvider(options LoginOptions) *LoginProvider {
+	if options.Namespace == "" {
+		options.Namespace = "default"
+	}
+	return &LoginProvider{options: options}
+}
+
+// Authenticate logs in and verifies the connection to the cluster
+func (lp *LoginProvider) Authenticate(ctx context.Context) (*rest.Config, error) {
+	server, err := normalizeServerURL(lp.options.Server)
+	if err != nil {
+		return nil, NewAuthError("invalid_server", err.Error(), err)
+	}
+
+	config := &rest.Config{
+		Host:            server,
+		TLSClientConfig: rest.TLSClientConfig{Insecure: lp.options.InsecureSkipTLSVerify},
+	}
+
+	switch {
+	case lp.options.Token != "":
+		config.BearerToken = lp.options.Token
+	case lp.options.Username != "":
+		token, err := requestOAuthToken(ctx, config, lp.options.Username, lp.options.Password)
+		if err != nil {
+			return nil, err
+		}
+		config.BearerToken = token
+	default:
+		return nil, NewAuthError("missing_credentials", "a token or a username and password is required", nil)
+	}
+
+	validator, err := NewCredentialValidator(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := validator.ValidateConnection(ctx); err != nil {
+		return nil, err
+	}
+
+	lp.username = lp.options.Username
+	if lp.username == "" {
+		lp.username = reviewUsername(ctx, validator.clientset)
+	}
+	lp.context = loginContextName(lp.options.Namespace, server, lp.username)
+	lp.config = config
+	return config, nil
+}
+
+// IsValid checks that the logged in credentials still connect
+func (lp *LoginProvider) IsValid(ctx context.Context) error {
+	if lp.config == nil {
+		return NewAuthError("not_authenticated", "not logged in", nil)
+	}
+	validator, err := NewCredentialValidator(lp.config)
+	if err != nil {
+		return err
+	}
+	return validator.ValidateConnection(ctx)
+}
+
+// Refresh logs in again with the same credentials
+func (lp *LoginProvider) Refresh(ctx context.Context) error {
+	_, err := lp.Authenticate(ctx)
+	return err
+}
+
+// GetContext returns the kubeconfig context the login is saved as
+func (lp *LoginProvider) GetContext() string {
+	return lp.context
+}
+
+// GetNamespace returns the namespace selected at login
+func (lp *LoginProvider) GetNamespace() string {
+	return lp.options.Namespace
+}
+
+// GetUsername returns the logged in user
+func (lp *LoginProvider) GetUsername() string {
+	return lp.username
+}
+
+// Save persists the login to a kubeconfig file and makes it the current
+// context, keeping the clusters and users already in the file. It returns
+// the path written, the default kubeconfig when kubeconfigPath is empty.
+func (lp *LoginProvider) Save(kubeconfigPath string) (string, error) {
+	if lp.config == nil {
+		return "", NewAuthError("not_authenticated", "not logged in", nil)
+	}
+	if kubeconfigPath == "" {
+		kubeconfigPath = getDefaultKubeconfigPath()
+	}
+
+	kubeconfig := api.NewConfig()
+	if _, err := os.Stat(kubeconfigPath); err == nil {
+		loaded, err := clientcmd.LoadFromFile(kubeconfigPath)
+		if err != nil {
+			return "", NewAuthError("kubeconfig_load_failed", "failed to load kubeconfig file", err)
+		}
+		kubeconfig = loaded
+	}
+
+	addLogin(kubeconfig, lp.config, lp.options.Namespace, lp.username)
+
+	if err := os.MkdirAll(filepath.Dir(kubeconfigPath), 0o700); err != nil {
+		return "", NewAuthError("kubeconfig_write_failed", "failed to create kubeconfig directory", err)
+	}
+	if err := clientcmd.WriteToFile(*kubeconfig, kubeconfigPath); err != nil {
+		return "", NewAuthError("kubeconfig_write_failed", "failed to write kubeconfig file", err)
+	}
+	return kubeconfigPath, nil
+}
+
+// addLogin adds the cluster, user and context of a login to a kubeconfig and
+// selects the context, named like 'oc login' names them
+func addLogin(kubeconfig *api.Config, config *rest.Config, namespace, username string) {
+	clusterName := loginClusterName(config.Host)
+	userName := username + "/" + clusterName
+	contextName := loginContextName(namespace, config.Host, username)
+
+	cluster := api.NewCluster()
+	cluster.Server = config.Host
+	cluster.InsecureSkipTLSVerify = config.Insecure
+	cluster.CertificateAuthorityData = config.CAData
+	kubeconfig.Clusters[clusterName] = cluster
+
+	authInfo := api.NewAuthInfo()
+	authInfo.Token = config.BearerToken
+	kubeconfig.AuthInfos[userName] = authInfo
+
+	loginContext := api.NewContext()
+	loginContext.Cluster = clusterName
+	loginContext.AuthInfo = userName
+	loginContext.Namespace = namespace
+	kubeconfig.Contexts[contextName] = loginContext
+
+	kubeconfig.CurrentContext = contextName
+}
+
+// loginClusterName names a cluster after its API server, e.g.
+// api-example-com:6443 for https://api.example.com:6443
+func loginClusterName(server string) string {
+	host := server
+	if parsed, err := url.Parse(server); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+	return strings.ReplaceAll(host, ".", "-")
+}
+
+// loginContextName names the context of a login namespace/cluster/user
+func loginContextName(namespace, server, username string) string {
+	return namespace + "/" + loginClusterName(server) + "/" + username
+}
+
+// normalizeServerURL adds the https scheme to a bare API server address
+func normalizeServerURL(server string) (string, error) {
+	server = strings.TrimRight(strings.TrimSpace(server), "/")
+	if server == "" {
+		return "", fmt.Errorf("the API server URL is required")
+	}
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+
+	parsed, err := url.Parse(server)
+	if err != nil || parsed.Host == "" {
+		return "", fmt.Errorf("invalid API server URL %q", server)
+	}
+	return server, nil
+}
+
+// reviewUsername returns the user a token belongs to, or "user" when the
+// cluster does not tell
+func reviewUsername(ctx context.Context, clientset kubernetes.Interface) string {
+	review, err := clientset.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil || review.Status.UserInfo.Username == "" {
+		return "user"
+	}
+	return review.Status.UserInfo.Username
+}
+
+// requestOAuthToken trades a username and password for an access token with
+// the OpenShift OAuth server, using the challenging client like 'oc login'
+func requestOAuthToken(ctx context.Context, config *rest.Config, username, password string) (string, error) {
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return "", NewAuthError("oauth_failed", "failed to create HTTP transport", err)
+	}
+	client := &http.Client{
+		Transport: transport,
+		// The token is in the redirect, which must not be followed
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	authorizeURL, err := oauthAuthorizeEndpoint(ctx, client, config.Host)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type": {"token"},
+		"client_id":     {constants.OAuthChallengingClientID},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, authorizeURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", NewAuthError("oauth_failed", "failed to build OAuth request", err)
+	}
+	req.SetBasicAuth(username, password)
+	req.Header.Set("X-CSRF-Token", "1")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", NewAuthError("oauth_failed", "failed to reach the OAuth server", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return "", NewAuthError("invalid_credentials", "login failed: invalid username or password", nil)
+	}
+	location, err := resp.Location()
+	if err != nil {
+		return "", NewAuthError("oauth_failed", fmt.Sprintf("unexpected OAuth response: %s", resp.Status), err)
+	}
+
+	fragment, err := url.ParseQuery(location.Fragment)
+	if err != nil {
+		return "", NewAuthError("oauth_failed", "invalid OAuth redirect", err)
+	}
+	if oauthErr := fragment.Get("error"); oauthErr != "" {
+		return "", NewAuthError("oauth_failed", fmt.Sprintf("login failed: %s %s", oauthErr, fragment.Get("error_description")), nil)
+	}
+	token := fragment.Get("access_token")
+	if token == "" {
+		return "", NewAuthError("oauth_failed", "the OAuth server returned no token", nil)
+	}
+	return token, nil
+}
+
+// oauthAuthorizeEndpoint discovers the authorize endpoint of the OpenShift
+// OAuth server from the API server
+func oauthAuthorizeEndpoint(ctx context.Context, client *http.Client, server string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server+constants.OAuthMetadataPath, nil)
+	if err != nil {
+		return "", NewAuthError("oauth_failed", "failed to build OAuth discovery request", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", NewAuthError("oauth_failed", "failed to reach the API server", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", NewAuthError("oauth_unavailable", "the cluster has no OpenShift OAuth server, log in with a token", nil)
+	}
+
+	var metadata struct {
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil || metadata.AuthorizationEndpoint == "" {
+		return "", NewAuthError("oauth_unavailable", "invalid OAuth server metadata", err)
+	}
+	return metadata.AuthorizationEndpoint, nil
+}
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// newLoginServer serves the endpoints an OpenShift API server uses during
+// login, accepting developer/secret
+func newLoginServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	var server *httptest.Server
+
+	mux.HandleFunc("/.well-known/oauth-authorization-server", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"authorization_endpoint": server.URL + "/oauth/authorize"})
+	})
+	mux.HandleFunc("/oauth/authorize", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "developer" || password != "secret" || r.Header.Get("X-CSRF-Token") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		http.Redirect(w, r, server.URL+"/oauth/token/implicit#access_token=sha256~issued&token_type=Bearer", http.StatusFound)
+	})
+	mux.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"gitVersion": "v1.30.4"})
+	})
+	mux.HandleFunc("/apis/authentication.k8s.io/v1/selfsubjectreviews", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"apiVersion": "authentication.k8s.io/v1",
+			"kind":       "SelfSubjectReview",
+			"status":     map[string]interface{}{"userInfo": map[string]interface{}{"username": "robot"}},
+		})
+	})
+
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLoginWithPassword(t *testing.T) {
+	server := newLoginServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	provider := NewLoginProvider(LoginOptions{Server: server.URL, Username: "developer", Password: "secret", Namespace: "shop"})
+	config, err := provider.Authenticate(ctx)
+	if err != nil {
+		t.Fatalf("Expected login to succeed, got %v", err)
+	}
+	if config.BearerToken != "sha256~issued" {
+		t.Errorf("Expected the OAuth token, got %q", config.BearerToken)
+	}
+
+	kubeconfigPath := filepath.Join(t.TempDir(), ".kube", "config")
+	if path, err := provider.Save(kubeconfigPath); err != nil || path != kubeconfigPath {
+		t.Fatalf("Expected the login to be saved, got %v", err)
+	}
+
+	saved, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if err != nil {
+		t.Fatalf("Failed to load saved kubeconfig: %v", err)
+	}
+	current := saved.Contexts[saved.CurrentContext]
+	if saved.CurrentContext != provider.GetContext() || current == nil || current.Namespace != "shop" {
+		t.Fatalf("Expected the login to be the current context, got %q", saved.CurrentContext)
+	}
+	if saved.AuthInfos[current.AuthInfo].Token != "sha256~issued" || saved.Clusters[current.Cluster].Server != server.URL {
+		t.Errorf("Expected the token and server to be saved, got %+v", current)
+	}
+}
+
+func TestLoginRejectsWrongPassword(t *testing.T) {
+	server := newLoginServer(t)
+
+	_, err := NewLoginProvider(LoginOptions{Server: server.URL, Username: "developer", Password: "wrong"}).Authenticate(context.Background())
+	authErr, ok := err.(*AuthError)
+	if !ok || authErr.Type != "invalid_credentials" {
+		t.Errorf("Expected invalid credentials, got %v", err)
+	}
+}
+
+func TestLoginWithToken(t *testing.T) {
+	server := newLoginServer(t)
+
+	provider := NewLoginProvider(LoginOptions{Server: server.URL, Token: "sha256~pasted"})
+	if _, err := provider.Authenticate(context.Background()); err != nil {
+		t.Fatalf("Expected token login to succeed, got %v", err)
+	}
+	if provider.GetUsername() != "robot" || provider.GetNamespace() != "default" {
+		t.Errorf("Expected the reviewed user in the default namespace, got %s in %s", provider.GetUsername(), provider.GetNamespace())
+	}
+}
+
+func TestNormalizeServerURL(t *testing.T) {
+	tests := map[string]string{
+		"api.example.com:6443":          "https://api.example.com:6443",
+		"https://api.example.com:6443/": "https://api.example.com:6443",
+	}
+	for input, want := range tests {
+		if got, err := normalizeServerURL(input); err != nil || got != want {
+			t.Errorf("normalizeServerURL(%q) = %q, %v, want %q", input, got, err, want)
+		}
+	}
+	if _, err := normalizeServerURL(" "); err == nil {
+		t.Errorf("Expected an empty server to be rejected")
+	}
+
+	if name := loginContextName("shop", "https://api.example.com:6443", "developer"); name != "shop/api-example-com:6443/developer" {
+		t.Errorf("Unexpected context name %q", name)
+	}
+}
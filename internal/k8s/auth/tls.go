@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/client-go/rest"
+)
+
+// TLSOverrides replace the TLS verification settings of the kubeconfig for
+// a session, like the --certificate-authority and --insecure-skip-tls-verify
+// flags of oc and kubectl
+type TLSOverrides struct {
+	// CAFile is a PEM file of the certificate authorities trusted for the
+	// API server instead of the kubeconfig's
+	CAFile string
+
+	// Insecure skips verifying the API server's certificate
+	Insecure bool
+}
+
+// IsZero reports whether no override is set
+func (o TLSOverrides) IsZero() bool {
+	return o.CAFile == "" && !o.Insecure
+}
+
+// Apply sets the overrides on a client configuration. The kubeconfig's CA
+// is dropped, client-go refuses a CA together with skipped verification.
+func (o TLSOverrides) Apply(config *rest.Config) {
+	switch {
+	case o.Insecure:
+		config.TLSClientConfig.Insecure = true
+		config.TLSClientConfig.CAFile = ""
+		config.TLSClientConfig.CAData = nil
+	case o.CAFile != "":
+		config.TLSClientConfig.CAFile = o.CAFile
+		config.TLSClientConfig.CAData = nil
+	}
+}
+
+// ValidateCAFile checks that a file holds at least one PEM certificate
+func ValidateCAFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("cannot read the CA file: %w", err)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM(data) {
+		return fmt.Errorf("%s holds no PEM certificate", path)
+	}
+	return nil
+}
+
+// CertificateProblem explains why the API server's certificate was not
+// trusted. It reports false when err is not a certificate verification error.
+func CertificateProblem(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+	var verification *tls.CertificateVerificationError
+	switch {
+	case errors.As(err, &unknownAuthority):
+		return "The API server's certificate is signed by a certificate authority this machine does not trust, e.g. a self-signed or company CA.", true
+	case errors.As(err, &hostname):
+		return fmt.Sprintf("The API server's certificate is not valid for %s, the address in the kubeconfig.", hostname.Host), true
+	case errors.As(err, &invalid) && invalid.Reason == x509.Expired:
+		return "The API server's certificate has expired or is not valid yet. Check this machine's clock.", true
+	case errors.As(err, &invalid):
+		return "The API server's certificate is not valid: " + invalid.Error(), true
+	case errors.As(err, &verification):
+		return "The API server's certificate could not be verified: " + verification.Err.Error(), true
+	}
+
+	// Errors that went through a string, e.g. from a discovery client
+	message := err.Error()
+	switch {
+	case strings.Contains(message, "certificate signed by unknown authority"):
+		return "The API server's certificate is signed by a certificate authority this machine does not trust, e.g. a self-signed or company CA.", true
+	case strings.Contains(message, "x509: certificate is valid for"):
+		return "The API server's certificate is not valid for the address in the kubeconfig.", true
+	case strings.Contains(message, "x509: certificate has expired"):
+		return "The API server's certificate has expired or is not valid yet. Check this machine's clock.", true
+	case strings.Contains(message, "x509:") || strings.Contains(message, "tls: failed to verify certificate"):
+		return "The API server's certificate could not be verified.", true
+	}
+	return "", false
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestTLSOverridesApply(t *testing.T) {
+	config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca")}}
+	TLSOverrides{CAFile: "/tmp/ca.pem"}.Apply(config)
+	if config.CAFile != "/tmp/ca.pem" || config.CAData != nil || config.Insecure {
+		t.Errorf("Expected the CA file to replace the kubeconfig's CA, got %+v", config.TLSClientConfig)
+	}
+
+	TLSOverrides{Insecure: true}.Apply(config)
+	if !config.Insecure || config.CAFile != "" {
+		t.Errorf("Expected verification to be skipped without a CA, got %+v", config.TLSClientConfig)
+	}
+	if !(TLSOverrides{}).IsZero() || (TLSOverrides{Insecure: true}).IsZero() {
+		t.Error("Expected only empty overrides to be zero")
+	}
+}
+
+func TestValidateCAFile(t *testing.T) {
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caPath, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateCAFile(caPath); err != nil {
+		t.Errorf("Expected the PEM file to be valid, got %v", err)
+	}
+
+	notPEM := filepath.Join(dir, "key.txt")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateCAFile(notPEM); err == nil {
+		t.Error("Expected a file without certificates to be rejected")
+	}
+	if err := ValidateCAFile(filepath.Join(dir, "missing.pem")); err == nil {
+		t.Error("Expected a missing file to be rejected")
+	}
+}
+
+func TestCertificateProblem(t *testing.T) {
+	wrapped := fmt.Errorf("connection test failed: %w", x509.UnknownAuthorityError{})
+	if problem, ok := CertificateProblem(wrapped); !ok || !strings.Contains(problem, "does not trust") {
+		t.Errorf("Expected an unknown authority, got %q", problem)
+	}
+
+	hostname := fmt.Errorf("get: %w", x509.HostnameError{Certificate: &x509.Certificate{}, Host: "api.example.com"})
+	if problem, ok := CertificateProblem(hostname); !ok || !strings.Contains(problem, "api.example.com") {
+		t.Errorf("Expected a hostname mismatch, got %q", problem)
+	}
+
+	flattened := errors.New(`Get "https://api:6443/version": tls: failed to verify certificate: x509: certificate signed by unknown authority`)
+	if _, ok := CertificateProblem(flattened); !ok {
+		t.Error("Expected a certificate error in a message to be recognized")
+	}
+
+	if _, ok := CertificateProblem(errors.New("connection refused")); ok {
+		t.Error("Expected other errors not to be certificate errors")
+	}
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+)
+
+// OpenShiftTokenPrefix marks opaque OpenShift OAuth access tokens
+const OpenShiftTokenPrefix = "sha256~"
+
+// BearerToken returns the bearer token used by config, reading it from
+// BearerTokenFile when set. It returns "" for non-token auth methods.
+func BearerToken(config *rest.Config) string {
+	if config == nil {
+		return ""
+	}
+	if config.BearerTokenFile != "" {
+		if data, err := os.ReadFile(config.BearerTokenFile); err == nil {
+			return strings.TrimSpace(string(data))
+		}
+	}
+	return config.BearerToken
+}
+
+// JWTExpiry decodes the "exp" claim of a JWT bearer token without verifying
+// its signature. The bool is false for tokens that are not JWTs or carry no
+// expiry.
+func JWTExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp json.Number `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == "" {
+		return time.Time{}, false
+	}
+
+	exp, err := claims.Exp.Float64()
+	if err != nil || exp <= 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(exp), 0), true
+}
+
+// OpenShiftTokenObjectName returns the name of the UserOAuthAccessToken
+// object that stores an opaque "sha256~" token, which holds its expiry. The
+// bool is false for other tokens.
+func OpenShiftTokenObjectName(token string) (string, bool) {
+	if !strings.HasPrefix(token, OpenShiftTokenPrefix) || len(token) == len(OpenShiftTokenPrefix) {
+		return "", false
+	}
+
+	sum := sha256.Sum256([]byte(strings.TrimPrefix(token, OpenShiftTokenPrefix)))
+	return OpenShiftTokenPrefix + base64.RawURLEncoding.EncodeToString(sum[:]), true
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/openshift/client-go/apps/clientset/versioned"
 	buildclientset "github.com/openshift/client-go/build/clientset/versioned"
+	configclientset "github.com/openshift/client-go/config/clientset/versioned"
 	imageclientset "github.com/openshift/client-go/image/clientset/versioned"
+	machineclientset "github.com/openshift/client-go/machine/clientset/versioned"
 	routeclientset "github.com/openshift/client-go/route/clientset/versioned"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -30,6 +32,8 @@ type ClientFactory struct {
 	buildClient   buildclientset.Interface
 	imageClient   imageclientset.Interface
 	routeClient   routeclientset.Interface
+	configClient  configclientset.Interface
+	machineClient machineclientset.Interface
 	dynamicClient dynamic.Interface
 }
 
@@ -247,6 +251,18 @@ func (cf *ClientFactory) initializeOpenShiftClients() error {
 	}
 	cf.routeClient = routeClient
 
+	configClient, err := configclientset.NewForConfig(cf.config)
+	if err != nil {
+		return fmt.Errorf("failed to create OpenShift config client: %w", err)
+	}
+	cf.configClient = configClient
+
+	machineClient, err := machineclientset.NewForConfig(cf.config)
+	if err != nil {
+		return fmt.Errorf("failed to create OpenShift machine client: %w", err)
+	}
+	cf.machineClient = machineClient
+
 	dynamicClient, err := dynamic.NewForConfig(cf.config)
 	if err != nil {
 		return fmt.Errorf("failed to create dynamic client: %w", err)
@@ -284,6 +300,16 @@ func (cf *ClientFactory) GetRouteClient() routeclientset.Interface {
 	return cf.routeClient
 }
 
+// GetConfigClient returns the OpenShift config client
+func (cf *ClientFactory) GetConfigClient() configclientset.Interface {
+	return cf.configClient
+}
+
+// GetMachineClient returns the OpenShift machine API client
+func (cf *ClientFactory) GetMachineClient() machineclientset.Interface {
+	return cf.machineClient
+}
+
 // GetDynamicClient returns the dynamic client
 func (cf *ClientFactory) GetDynamicClient() dynamic.Interface {
 	return cf.dynamicClient
@@ -0,0 +1,106 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Labels Helm sets on its release secrets
+const (
+	ownerSelector = "owner=helm"
+	nameLabel     = "name"
+	revisionLabel = "version"
+)
+
+// Client reads the Helm releases of a cluster from their secrets
+type Client struct {
+	clientset kubernetes.Interface
+}
+
+// NewClient creates a client reading releases with clientset
+func NewClient(clientset kubernetes.Interface) *Client {
+	return &Client{clientset: clientset}
+}
+
+// ListReleases returns the latest revision of each release in a namespace,
+// by name like helm list, uninstalled releases kept with --keep-history
+// included
+func (c *Client) ListReleases(ctx context.Context, namespace string) ([]Release, error) {
+	secrets, err := c.releaseSecrets(ctx, namespace, ownerSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the latest revision of each release is decoded
+	latest := make(map[string]*corev1.Secret)
+	for i := range secrets {
+		secret := &secrets[i]
+		name := secret.Labels[nameLabel]
+		if current, ok := latest[name]; !ok || secretRevision(secret) > secretRevision(current) {
+			latest[name] = secret
+		}
+	}
+
+	releases := make([]Release, 0, len(latest))
+	for _, secret := range latest {
+		release, err := DecodeRelease(secret.Data["release"])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", secret.Name, err)
+		}
+		releases = append(releases, *release)
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Name < releases[j].Name
+	})
+	return releases, nil
+}
+
+// History returns every revision of a release kept in a namespace, the
+// latest first like helm history
+func (c *Client) History(ctx context.Context, namespace, name string) ([]Release, error) {
+	secrets, err := c.releaseSecrets(ctx, namespace, ownerSelector+","+nameLabel+"="+name)
+	if err != nil {
+		return nil, err
+	}
+
+	releases := make([]Release, 0, len(secrets))
+	for _, secret := range secrets {
+		release, err := DecodeRelease(secret.Data["release"])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", secret.Name, err)
+		}
+		releases = append(releases, *release)
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Revision > releases[j].Revision
+	})
+	return releases, nil
+}
+
+// releaseSecrets lists the release secrets matching a label selector
+func (c *Client) releaseSecrets(ctx context.Context, namespace, selector string) ([]corev1.Secret, error) {
+	list, err := c.clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Helm release secrets: %w", err)
+	}
+
+	secrets := make([]corev1.Secret, 0, len(list.Items))
+	for _, secret := range list.Items {
+		if secret.Type == SecretType {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets, nil
+}
+
+// secretRevision returns the revision a release secret stores
+func secretRevision(secret *corev1.Secret) int {
+	revision, _ := strconv.Atoi(secret.Labels[revisionLabel])
+	return revision
+}
@@ -0,0 +1,107 @@
+package helm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// releaseSecret returns the secret Helm stores a revision of a release in
+func releaseSecret(t *testing.T, name string, revision int, status string, compress bool) *corev1.Secret {
+	t.Helper()
+	release := fmt.Sprintf(`{"name": %q, "namespace": "shop", "version": %d,
+		"info": {"status": %q, "description": "Upgrade complete", "last_deployed": "2026-10-01T12:00:00Z"},
+		"chart": {"metadata": {"name": "postgresql", "version": "12.5.%d", "appVersion": "15.4.0"},
+			"values": {"image": {"tag": "15.4.0", "pullPolicy": "IfNotPresent"}, "replicas": 1, "metrics": {"enabled": false}}},
+		"config": {"image": {"tag": "15.5.0"}, "metrics": null},
+		"manifest": "---\n# Source: postgresql/templates/statefulset.yaml\nkind: StatefulSet\n"}`, name, revision, status, revision)
+
+	data := []byte(release)
+	if compress {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		writer.Write(data)
+		writer.Close()
+		data = buf.Bytes()
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", name, revision),
+			Namespace: "shop",
+			Labels:    map[string]string{"owner": "helm", "name": name, "version": fmt.Sprint(revision), "status": status},
+		},
+		Type: SecretType,
+		Data: map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString(data))},
+	}
+}
+
+func TestListReleases(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		releaseSecret(t, "db", 1, "superseded", true),
+		releaseSecret(t, "db", 2, "deployed", true),
+		releaseSecret(t, "cache", 1, "failed", false),
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "shop", Labels: map[string]string{"owner": "helm"}}},
+	)
+
+	releases, err := NewClient(clientset).ListReleases(context.Background(), "shop")
+	if err != nil {
+		t.Fatalf("ListReleases() returned error: %v", err)
+	}
+	if len(releases) != 2 || releases[0].Name != "cache" || releases[1].Name != "db" {
+		t.Fatalf("Expected cache and db, got %+v", releases)
+	}
+	db := releases[1]
+	if db.Revision != 2 || db.Status != "deployed" || db.ChartLabel() != "postgresql-12.5.2" || db.AppVersion != "15.4.0" {
+		t.Errorf("Expected the latest revision of db, got %+v", db)
+	}
+	if !strings.Contains(db.Manifest, "kind: StatefulSet") || db.LastDeployed.IsZero() {
+		t.Errorf("Expected the manifest and deploy time, got %+v", db)
+	}
+	if releases[0].Status != "failed" {
+		t.Errorf("Expected an uncompressed release to be decoded, got %+v", releases[0])
+	}
+
+	history, err := NewClient(clientset).History(context.Background(), "shop", "db")
+	if err != nil {
+		t.Fatalf("History() returned error: %v", err)
+	}
+	if len(history) != 2 || history[0].Revision != 2 || history[1].Status != "superseded" {
+		t.Errorf("Expected both revisions of db, latest first, got %+v", history)
+	}
+}
+
+func TestValuesYAML(t *testing.T) {
+	release, err := DecodeRelease(releaseSecret(t, "db", 1, "deployed", true).Data["release"])
+	if err != nil {
+		t.Fatalf("DecodeRelease() returned error: %v", err)
+	}
+
+	supplied, _ := release.ValuesYAML(false)
+	if !strings.Contains(supplied, "tag: 15.5.0") || strings.Contains(supplied, "replicas") {
+		t.Errorf("Expected the supplied values only, got:\n%s", supplied)
+	}
+
+	// Supplied values override the chart defaults, null removes them
+	all, _ := release.ValuesYAML(true)
+	for _, want := range []string{"tag: 15.5.0", "pullPolicy: IfNotPresent", "replicas: 1"} {
+		if !strings.Contains(all, want) {
+			t.Errorf("Expected %q in the computed values, got:\n%s", want, all)
+		}
+	}
+	if strings.Contains(all, "metrics") {
+		t.Errorf("Expected metrics removed by the null value, got:\n%s", all)
+	}
+
+	if _, err := DecodeRelease([]byte("not base64!")); err == nil {
+		t.Error("Expected invalid release data to fail")
+	}
+}
@@ -0,0 +1,160 @@
+// Package helm reads the releases Helm 3 stores in secrets of their
+// namespace, so that LazyOC lists them without the helm binary
+package helm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// SecretType is the type of the secrets Helm 3 stores a release revision in
+const SecretType = "helm.sh/release.v1"
+
+// gzipMagic starts the releases Helm compressed, which all but the earliest
+// Helm 3 versions do
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// Release is a revision of a Helm release
+type Release struct {
+	Name          string
+	Namespace     string
+	Revision      int
+	Status        string
+	Chart         string
+	ChartVersion  string
+	AppVersion    string
+	Description   string
+	FirstDeployed time.Time
+	LastDeployed  time.Time
+	Notes         string
+
+	// Manifest is the rendered YAML of the objects of the release
+	Manifest string
+
+	// Values are the values supplied on install or upgrade, ChartValues the
+	// defaults of the chart
+	Values      map[string]interface{}
+	ChartValues map[string]interface{}
+}
+
+// ChartLabel returns the chart and its version like helm list, e.g.
+// "postgresql-12.5.6"
+func (r *Release) ChartLabel() string {
+	if r.ChartVersion == "" {
+		return r.Chart
+	}
+	return r.Chart + "-" + r.ChartVersion
+}
+
+// ValuesYAML returns the supplied values as YAML like helm get values, or
+// all values computed from the chart defaults
+func (r *Release) ValuesYAML(all bool) (string, error) {
+	values := r.Values
+	if all {
+		values = coalesceValues(r.Values, r.ChartValues)
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	out, err := yaml.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("failed to render the values of %s: %w", r.Name, err)
+	}
+	return string(out), nil
+}
+
+// coalesceValues returns the chart defaults overridden by the supplied
+// values, merging nested maps and dropping keys supplied as null like Helm
+func coalesceValues(values, defaults map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(values))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range values {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		nested, isMap := value.(map[string]interface{})
+		nestedDefaults, defaultIsMap := merged[key].(map[string]interface{})
+		if isMap && defaultIsMap {
+			merged[key] = coalesceValues(nested, nestedDefaults)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// storedRelease is the part of the release JSON Helm stores that LazyOC shows
+type storedRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"`
+	Info      struct {
+		FirstDeployed time.Time `json:"first_deployed"`
+		LastDeployed  time.Time `json:"last_deployed"`
+		Description   string    `json:"description"`
+		Status        string    `json:"status"`
+		Notes         string    `json:"notes"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name"`
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+		Values map[string]interface{} `json:"values"`
+	} `json:"chart"`
+	Config   map[string]interface{} `json:"config"`
+	Manifest string                 `json:"manifest"`
+}
+
+// DecodeRelease decodes the release data of a release secret: base64 of the
+// release JSON, usually gzipped
+func DecodeRelease(data []byte) (*Release, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("release is not base64: %w", err)
+	}
+
+	if bytes.HasPrefix(decoded, gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress release: %w", err)
+		}
+		defer reader.Close()
+		if decoded, err = io.ReadAll(reader); err != nil {
+			return nil, fmt.Errorf("failed to decompress release: %w", err)
+		}
+	}
+
+	var stored storedRelease
+	if err := json.Unmarshal(decoded, &stored); err != nil {
+		return nil, fmt.Errorf("release is not valid JSON: %w", err)
+	}
+
+	return &Release{
+		Name:          stored.Name,
+		Namespace:     stored.Namespace,
+		Revision:      stored.Version,
+		Status:        stored.Info.Status,
+		Chart:         stored.Chart.Metadata.Name,
+		ChartVersion:  stored.Chart.Metadata.Version,
+		AppVersion:    stored.Chart.Metadata.AppVersion,
+		Description:   stored.Info.Description,
+		FirstDeployed: stored.Info.FirstDeployed,
+		LastDeployed:  stored.Info.LastDeployed,
+		Notes:         stored.Info.Notes,
+		Manifest:      stored.Manifest,
+		Values:        stored.Config,
+		ChartValues:   stored.Chart.Values,
+	}, nil
+}
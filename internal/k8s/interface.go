@@ -5,7 +5,9 @@ import (
 
 	"github.com/openshift/client-go/apps/clientset/versioned"
 	buildclientset "github.com/openshift/client-go/build/clientset/versioned"
+	configclientset "github.com/openshift/client-go/config/clientset/versioned"
 	imageclientset "github.com/openshift/client-go/image/clientset/versioned"
+	machineclientset "github.com/openshift/client-go/machine/clientset/versioned"
 	routeclientset "github.com/openshift/client-go/route/clientset/versioned"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -39,6 +41,8 @@ type OpenShiftClient interface {
 	GetBuildClient() buildclientset.Interface
 	GetImageClient() imageclientset.Interface
 	GetRouteClient() routeclientset.Interface
+	GetConfigClient() configclientset.Interface
+	GetMachineClient() machineclientset.Interface
 	GetDynamicClient() dynamic.Interface
 }
 
@@ -20,6 +20,11 @@ type DefaultProjectManagerFactory struct {
 	config         *rest.Config
 	kubeconfigPath string
 	detector       *k8s.ClusterTypeDetector
+
+	// inMemory makes the managers switch projects in memory, starting at
+	// namespace, instead of in the kubeconfig
+	inMemory  bool
+	namespace string
 }
 
 // NewProjectManagerFactory creates a new factory for project managers
@@ -54,19 +59,23 @@ func NewProjectManagerFactory(clientset kubernetes.Interface, config *rest.Confi
 func (f *DefaultProjectManagerFactory) CreateManager(ctx context.Context, clusterType k8s.ClusterType) (ProjectManager, error) {
 	switch clusterType {
 	case k8s.ClusterTypeOpenShift:
-		return NewOpenShiftProjectManager(
+		manager := NewOpenShiftProjectManager(
 			f.clientset,
 			f.dynamicClient,
 			f.config,
 			f.kubeconfigPath,
-		), nil
+		)
+		manager.SetInMemory(f.inMemory, f.namespace)
+		return manager, nil
 
 	case k8s.ClusterTypeKubernetes:
-		return NewKubernetesNamespaceManager(
+		manager := NewKubernetesNamespaceManager(
 			f.clientset,
 			f.config,
 			f.kubeconfigPath,
-		), nil
+		)
+		manager.SetInMemory(f.inMemory, f.namespace)
+		return manager, nil
 
 	default:
 		return nil, fmt.Errorf("unsupported cluster type: %s", clusterType)
@@ -104,6 +113,13 @@ func (f *DefaultProjectManagerFactory) SetKubeconfigPath(path string) {
 	f.kubeconfigPath = path
 }
 
+// SetInMemory makes the managers created next switch projects in memory,
+// starting at namespace, instead of writing the kubeconfig
+func (f *DefaultProjectManagerFactory) SetInMemory(enabled bool, namespace string) {
+	f.inMemory = enabled
+	f.namespace = namespace
+}
+
 // GetKubeconfigPath returns the current kubeconfig path
 func (f *DefaultProjectManagerFactory) GetKubeconfigPath() string {
 	return f.kubeconfigPath
@@ -179,7 +195,7 @@ func DeleteProject(ctx context.Context, factory ProjectManagerFactory, name stri
 		return fmt.Errorf("failed to create project manager: %w", err)
 	}
 
-	return manager.Delete(ctx, name)
+	return manager.Delete(ctx, name, DeleteOptions{})
 }
 
 // ProjectExists checks if a project/namespace exists
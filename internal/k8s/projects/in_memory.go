@@ -0,0 +1,78 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// memoryNamespace is the current namespace of a manager that switches
+// projects in memory, leaving the kubeconfig file alone so kubectl in other
+// terminals keeps its namespace. The zero value switches in the kubeconfig.
+type memoryNamespace struct {
+	mu      sync.RWMutex
+	enabled bool
+	name    string
+}
+
+// configure turns switching in memory on, starting at name, or off
+func (n *memoryNamespace) configure(enabled bool, name string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.enabled = enabled
+	n.name = name
+}
+
+// current returns the current namespace, "default" when none was set, and
+// whether projects are switched in memory
+func (n *memoryNamespace) current() (string, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.name == "" {
+		return "default", n.enabled
+	}
+	return n.name, n.enabled
+}
+
+// set switches the current namespace and returns the previous one
+func (n *memoryNamespace) set(name string) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	from := n.name
+	n.name = name
+	return from
+}
+
+// switchInMemory switches the project of a manager without writing the
+// kubeconfig
+func switchInMemory(ctx context.Context, m ProjectManager, current *memoryNamespace, name string) (*SwitchResult, error) {
+	kind := strings.ToLower(m.GetProjectType().String())
+
+	exists, err := m.Exists(ctx, name)
+	if err != nil {
+		return &SwitchResult{
+			Success: false,
+			Message: fmt.Sprintf("Failed to check if %s exists: %v", kind, err),
+		}, err
+	}
+	if !exists {
+		return &SwitchResult{
+			Success: false,
+			Message: fmt.Sprintf("%s '%s' does not exist", m.GetProjectType(), name),
+		}, fmt.Errorf("%s %s does not exist", kind, name)
+	}
+
+	from := current.set(name)
+
+	// Get project info for the result
+	projectInfo, _ := m.Get(ctx, name)
+
+	return &SwitchResult{
+		From:        from,
+		To:          name,
+		Success:     true,
+		Message:     fmt.Sprintf("Switched to %s '%s'", kind, name),
+		ProjectInfo: projectInfo,
+	}, nil
+}
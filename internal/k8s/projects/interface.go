@@ -87,6 +87,20 @@ type ListOptions struct {
 	// Include resource quotas and limits
 	IncludeQuotas bool
 	IncludeLimits bool
+
+	// Limit caps the number of results returned by ListPage (0 means no limit)
+	Limit int64
+
+	// Continue is the token returned by a previous ListPage call
+	Continue string
+}
+
+// ProjectPage is a single page of projects/namespaces
+type ProjectPage struct {
+	Items []ProjectInfo
+
+	// Continue is the token for the next page; empty when there are no more results
+	Continue string
 }
 
 // CreateOptions provides options for creating projects/namespaces
@@ -108,6 +122,16 @@ type CreateOptions struct {
 
 	// Limit ranges to create
 	LimitRanges []LimitRange
+
+	// DryRun validates the request on the server without creating anything.
+	// Quotas and limit ranges are skipped.
+	DryRun bool
+}
+
+// DeleteOptions contains options for deleting a project/namespace
+type DeleteOptions struct {
+	// DryRun validates the deletion on the server without deleting anything
+	DryRun bool
 }
 
 // SwitchResult contains information about a project/namespace switch
@@ -124,6 +148,9 @@ type ProjectManager interface {
 	// List all accessible projects/namespaces
 	List(ctx context.Context, opts ListOptions) ([]ProjectInfo, error)
 
+	// List a single page of projects/namespaces using opts.Limit and opts.Continue
+	ListPage(ctx context.Context, opts ListOptions) (*ProjectPage, error)
+
 	// Get detailed information about a specific project/namespace
 	Get(ctx context.Context, name string) (*ProjectInfo, error)
 
@@ -131,9 +158,10 @@ type ProjectManager interface {
 	Create(ctx context.Context, name string, opts CreateOptions) (*ProjectInfo, error)
 
 	// Delete a project/namespace
-	Delete(ctx context.Context, name string) error
+	Delete(ctx context.Context, name string, opts DeleteOptions) error
 
-	// Switch to a different project/namespace (updates kubeconfig current context)
+	// Switch to a different project/namespace (updates kubeconfig current
+	// context, unless the manager switches in memory)
 	SwitchTo(ctx context.Context, name string) (*SwitchResult, error)
 
 	// Get current project/namespace
@@ -152,6 +180,15 @@ type ProjectManager interface {
 	RefreshCache(ctx context.Context) error
 }
 
+// InMemorySwitcher is implemented by project managers that can switch
+// projects without writing the kubeconfig file
+type InMemorySwitcher interface {
+	// SetInMemory makes SwitchTo and GetCurrent use a namespace held in
+	// memory, starting at namespace, instead of the kubeconfig's current
+	// context. Disabling it reads and writes the kubeconfig again.
+	SetInMemory(enabled bool, namespace string)
+}
+
 // ProjectManagerFactory creates the appropriate ProjectManager based on cluster type
 type ProjectManagerFactory interface {
 	// Create a project manager for the given cluster type
@@ -3,6 +3,8 @@ package projects
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -13,6 +15,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 func TestProjectType_String(t *testing.T) {
@@ -192,7 +196,7 @@ func TestKubernetesNamespaceManager_Delete(t *testing.T) {
 	manager := NewKubernetesNamespaceManager(fakeClientset, nil, "")
 
 	ctx := context.Background()
-	err := manager.Delete(ctx, "to-delete")
+	err := manager.Delete(ctx, "to-delete", DeleteOptions{})
 	if err != nil {
 		t.Fatalf("Delete() failed: %v", err)
 	}
@@ -369,3 +373,81 @@ func TestKubernetesNamespaceManager_ErrorHandling(t *testing.T) {
 		t.Errorf("Expected Exists() to return error")
 	}
 }
+
+func TestKubernetesNamespaceManager_ListPage(t *testing.T) {
+	fakeClientset := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "team-a",
+			Annotations: map[string]string{"description": "Team A services"},
+		}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+	)
+
+	manager := NewKubernetesNamespaceManager(fakeClientset, nil, "")
+
+	page, err := manager.ListPage(context.Background(), ListOptions{Limit: 10})
+	if err != nil {
+		t.Fatalf("ListPage() failed: %v", err)
+	}
+
+	if len(page.Items) != 2 {
+		t.Errorf("Expected 2 projects, got %d", len(page.Items))
+	}
+	if page.Continue != "" {
+		t.Errorf("Expected no continue token on the last page, got %q", page.Continue)
+	}
+	if page.Items[0].Description != "Team A services" {
+		t.Errorf("Expected description to be populated, got %q", page.Items[0].Description)
+	}
+}
+
+func TestKubernetesNamespaceManager_SwitchInMemory(t *testing.T) {
+	kubeconfigPath := filepath.Join(t.TempDir(), "config")
+	kubeconfig := clientcmdapi.NewConfig()
+	kubeconfig.Clusters["dev"] = &clientcmdapi.Cluster{Server: "https://dev.example.com"}
+	kubeconfig.Contexts["dev"] = &clientcmdapi.Context{Cluster: "dev", Namespace: "team-a"}
+	kubeconfig.CurrentContext = "dev"
+	if err := clientcmd.WriteToFile(*kubeconfig, kubeconfigPath); err != nil {
+		t.Fatalf("WriteToFile() failed: %v", err)
+	}
+	before, err := os.ReadFile(kubeconfigPath)
+	if err != nil {
+		t.Fatalf("ReadFile() failed: %v", err)
+	}
+
+	fakeClientset := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+	)
+	manager := NewKubernetesNamespaceManager(fakeClientset, nil, kubeconfigPath)
+	manager.SetInMemory(true, "team-a")
+
+	ctx := context.Background()
+	result, err := manager.SwitchTo(ctx, "team-b")
+	if err != nil {
+		t.Fatalf("SwitchTo() failed: %v", err)
+	}
+	if !result.Success || result.From != "team-a" || result.To != "team-b" {
+		t.Errorf("Expected a switch from team-a to team-b, got %+v", result)
+	}
+	if current, err := manager.GetCurrent(ctx); err != nil || current.Name != "team-b" {
+		t.Errorf("Expected team-b to be current, got %v, %v", current, err)
+	}
+	if _, err := manager.SwitchTo(ctx, "missing"); err == nil {
+		t.Errorf("Expected switching to a missing namespace to fail")
+	}
+
+	after, err := os.ReadFile(kubeconfigPath)
+	if err != nil {
+		t.Fatalf("ReadFile() failed: %v", err)
+	}
+	if string(after) != string(before) {
+		t.Errorf("Expected the kubeconfig to be left alone, got:\n%s", after)
+	}
+
+	// Turned off, the kubeconfig's namespace is current again
+	manager.SetInMemory(false, "")
+	if current, err := manager.GetCurrent(ctx); err != nil || current.Name != "team-a" {
+		t.Errorf("Expected the kubeconfig's team-a to be current, got %v, %v", current, err)
+	}
+}
@@ -21,6 +21,10 @@ type KubernetesNamespaceManager struct {
 	config         *rest.Config
 	kubeconfigPath string
 	clusterType    k8s.ClusterType
+
+	// memory is the current namespace when projects are switched in
+	// memory instead of in the kubeconfig
+	memory memoryNamespace
 }
 
 // NewKubernetesNamespaceManager creates a new namespace manager for Kubernetes
@@ -73,6 +77,30 @@ func (m *KubernetesNamespaceManager) List(ctx context.Context, opts ListOptions)
 	return projects, nil
 }
 
+// ListPage lists a single page of accessible namespaces
+func (m *KubernetesNamespaceManager) ListPage(ctx context.Context, opts ListOptions) (*ProjectPage, error) {
+	namespaces, err := m.clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	page := &ProjectPage{Continue: namespaces.Continue}
+	for _, ns := range namespaces.Items {
+		page.Items = append(page.Items, m.convertNamespaceToProject(&ns))
+	}
+
+	sort.Slice(page.Items, func(i, j int) bool {
+		return page.Items[i].Name < page.Items[j].Name
+	})
+
+	return page, nil
+}
+
 // Get detailed information about a specific namespace
 func (m *KubernetesNamespaceManager) Get(ctx context.Context, name string) (*ProjectInfo, error) {
 	namespace, err := m.clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
@@ -110,13 +138,17 @@ func (m *KubernetesNamespaceManager) Create(ctx context.Context, name string, op
 		namespace.Annotations["description"] = opts.Description
 	}
 
-	createdNS, err := m.clientset.CoreV1().Namespaces().Create(ctx, namespace, metav1.CreateOptions{})
+	createdNS, err := m.clientset.CoreV1().Namespaces().Create(ctx, namespace, createOptions(opts))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create namespace %s: %w", name, err)
 	}
 
 	project := m.convertNamespaceToProject(createdNS)
 
+	if opts.DryRun {
+		return &project, nil
+	}
+
 	// Create resource quotas if specified
 	for _, quota := range opts.ResourceQuotas {
 		err := m.createResourceQuota(ctx, name, quota)
@@ -138,17 +170,44 @@ func (m *KubernetesNamespaceManager) Create(ctx context.Context, name string, op
 	return &project, nil
 }
 
+// createOptions returns the API options for a project or namespace create
+func createOptions(opts CreateOptions) metav1.CreateOptions {
+	if opts.DryRun {
+		return metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}}
+	}
+	return metav1.CreateOptions{}
+}
+
+// deleteOptions returns the API options for a project or namespace delete
+func deleteOptions(opts DeleteOptions) metav1.DeleteOptions {
+	if opts.DryRun {
+		return metav1.DeleteOptions{DryRun: []string{metav1.DryRunAll}}
+	}
+	return metav1.DeleteOptions{}
+}
+
 // Delete a namespace
-func (m *KubernetesNamespaceManager) Delete(ctx context.Context, name string) error {
-	err := m.clientset.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
+func (m *KubernetesNamespaceManager) Delete(ctx context.Context, name string, opts DeleteOptions) error {
+	err := m.clientset.CoreV1().Namespaces().Delete(ctx, name, deleteOptions(opts))
 	if err != nil {
 		return fmt.Errorf("failed to delete namespace %s: %w", name, err)
 	}
 	return nil
 }
 
-// SwitchTo switches to a different namespace by updating kubeconfig
+// SetInMemory turns switching namespaces in memory instead of in the kubeconfig
+// on, starting at namespace, or off
+func (m *KubernetesNamespaceManager) SetInMemory(enabled bool, namespace string) {
+	m.memory.configure(enabled, namespace)
+}
+
+// SwitchTo switches to a different namespace by updating kubeconfig, or only
+// in memory when SetInMemory enabled it
 func (m *KubernetesNamespaceManager) SwitchTo(ctx context.Context, name string) (*SwitchResult, error) {
+	if _, inMemory := m.memory.current(); inMemory {
+		return switchInMemory(ctx, m, &m.memory, name)
+	}
+
 	if m.kubeconfigPath == "" {
 		return &SwitchResult{
 			Success: false,
@@ -235,8 +294,13 @@ func (m *KubernetesNamespaceManager) SwitchTo(ctx context.Context, name string)
 	}, nil
 }
 
-// GetCurrent returns the current namespace from kubeconfig
+// GetCurrent returns the current namespace from kubeconfig, or from memory
+// when SetInMemory enabled it
 func (m *KubernetesNamespaceManager) GetCurrent(ctx context.Context) (*ProjectInfo, error) {
+	if namespace, inMemory := m.memory.current(); inMemory {
+		return m.Get(ctx, namespace)
+	}
+
 	if m.kubeconfigPath == "" {
 		return nil, fmt.Errorf("no kubeconfig path available")
 	}
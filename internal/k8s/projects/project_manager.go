@@ -27,6 +27,10 @@ type OpenShiftProjectManager struct {
 	kubeconfigPath string
 	clusterType    k8s.ClusterType
 
+	// memory is the current namespace when projects are switched in
+	// memory instead of in the kubeconfig
+	memory memoryNamespace
+
 	// OpenShift API resources
 	projectResource        schema.GroupVersionResource
 	projectRequestResource schema.GroupVersionResource
@@ -100,6 +104,37 @@ func (m *OpenShiftProjectManager) List(ctx context.Context, opts ListOptions) ([
 	return projects, nil
 }
 
+// ListPage lists a single page of accessible projects
+func (m *OpenShiftProjectManager) ListPage(ctx context.Context, opts ListOptions) (*ProjectPage, error) {
+	listOpts := metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	}
+
+	projectList, err := m.dynamicClient.Resource(m.projectResource).List(ctx, listOpts)
+	if err != nil {
+		// Fallback to namespaces if projects API is not available
+		return m.listNamespacePageAsFallback(ctx, listOpts)
+	}
+
+	page := &ProjectPage{Continue: projectList.GetContinue()}
+	for _, item := range projectList.Items {
+		project, err := m.convertUnstructuredToProject(&item)
+		if err != nil {
+			continue // Skip invalid projects
+		}
+		page.Items = append(page.Items, *project)
+	}
+
+	sort.Slice(page.Items, func(i, j int) bool {
+		return page.Items[i].Name < page.Items[j].Name
+	})
+
+	return page, nil
+}
+
 // Get detailed information about a specific project
 func (m *OpenShiftProjectManager) Get(ctx context.Context, name string) (*ProjectInfo, error) {
 	project, err := m.dynamicClient.Resource(m.projectResource).Get(ctx, name, metav1.GetOptions{})
@@ -200,8 +235,11 @@ func (m *OpenShiftProjectManager) Create(ctx context.Context, name string, opts
 	}
 
 	// Create the project
-	createdProject, err := m.dynamicClient.Resource(m.projectRequestResource).Create(ctx, projectRequest, metav1.CreateOptions{})
+	createdProject, err := m.dynamicClient.Resource(m.projectRequestResource).Create(ctx, projectRequest, createOptions(opts))
 	if err != nil {
+		if errors.IsForbidden(err) {
+			return nil, m.projectRequestDenied(ctx, name, err)
+		}
 		return nil, fmt.Errorf("failed to create project %s: %w", name, err)
 	}
 
@@ -211,6 +249,10 @@ func (m *OpenShiftProjectManager) Create(ctx context.Context, name string, opts
 		return nil, fmt.Errorf("failed to convert created project: %w", err)
 	}
 
+	if opts.DryRun {
+		return project, nil
+	}
+
 	// Create resource quotas if specified
 	for _, quota := range opts.ResourceQuotas {
 		err := m.createResourceQuota(ctx, name, quota)
@@ -233,16 +275,27 @@ func (m *OpenShiftProjectManager) Create(ctx context.Context, name string, opts
 }
 
 // Delete a project
-func (m *OpenShiftProjectManager) Delete(ctx context.Context, name string) error {
-	err := m.dynamicClient.Resource(m.projectResource).Delete(ctx, name, metav1.DeleteOptions{})
+func (m *OpenShiftProjectManager) Delete(ctx context.Context, name string, opts DeleteOptions) error {
+	err := m.dynamicClient.Resource(m.projectResource).Delete(ctx, name, deleteOptions(opts))
 	if err != nil {
 		return fmt.Errorf("failed to delete project %s: %w", name, err)
 	}
 	return nil
 }
 
-// SwitchTo switches to a different project by updating kubeconfig
+// SetInMemory turns switching projects in memory instead of in the kubeconfig
+// on, starting at namespace, or off
+func (m *OpenShiftProjectManager) SetInMemory(enabled bool, namespace string) {
+	m.memory.configure(enabled, namespace)
+}
+
+// SwitchTo switches to a different project by updating kubeconfig, or only
+// in memory when SetInMemory enabled it
 func (m *OpenShiftProjectManager) SwitchTo(ctx context.Context, name string) (*SwitchResult, error) {
+	if _, inMemory := m.memory.current(); inMemory {
+		return switchInMemory(ctx, m, &m.memory, name)
+	}
+
 	if m.kubeconfigPath == "" {
 		return &SwitchResult{
 			Success: false,
@@ -329,8 +382,13 @@ func (m *OpenShiftProjectManager) SwitchTo(ctx context.Context, name string) (*S
 	}, nil
 }
 
-// GetCurrent returns the current project from kubeconfig
+// GetCurrent returns the current project from kubeconfig, or from memory
+// when SetInMemory enabled it
 func (m *OpenShiftProjectManager) GetCurrent(ctx context.Context) (*ProjectInfo, error) {
+	if namespace, inMemory := m.memory.current(); inMemory {
+		return m.Get(ctx, namespace)
+	}
+
 	if m.kubeconfigPath == "" {
 		return nil, fmt.Errorf("no kubeconfig path available")
 	}
@@ -474,6 +532,25 @@ func (m *OpenShiftProjectManager) listNamespacesAsFallback(ctx context.Context,
 	return projects, nil
 }
 
+// listNamespacePageAsFallback lists a page of namespaces when projects API is not available
+func (m *OpenShiftProjectManager) listNamespacePageAsFallback(ctx context.Context, listOpts metav1.ListOptions) (*ProjectPage, error) {
+	namespaces, err := m.clientset.CoreV1().Namespaces().List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	page := &ProjectPage{Continue: namespaces.Continue}
+	for _, ns := range namespaces.Items {
+		page.Items = append(page.Items, m.convertNamespaceToProject(&ns))
+	}
+
+	sort.Slice(page.Items, func(i, j int) bool {
+		return page.Items[i].Name < page.Items[j].Name
+	})
+
+	return page, nil
+}
+
 // getNamespaceAsFallback gets namespace information when project API is not available
 func (m *OpenShiftProjectManager) getNamespaceAsFallback(ctx context.Context, name string) (*ProjectInfo, error) {
 	namespace, err := m.clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
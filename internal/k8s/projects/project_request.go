@@ -0,0 +1,121 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SelfProvisionerRole is the cluster role that grants permission to request projects
+const SelfProvisionerRole = "self-provisioner"
+
+// ProjectRequestContactAnnotation can be set by cluster administrators on the
+// cluster project configuration to tell users who to ask for new projects
+const ProjectRequestContactAnnotation = "lazyoc.io/project-request-contact"
+
+// projectConfigResource is the cluster-wide OpenShift project configuration
+var projectConfigResource = schema.GroupVersionResource{
+	Group:    "config.openshift.io",
+	Version:  "v1",
+	Resource: "projects",
+}
+
+// rbacDenialPattern matches the user named in an RBAC Forbidden message
+var rbacDenialPattern = regexp.MustCompile(`User "([^"]+)" cannot create resource "projectrequests"`)
+
+// ProjectRequestDeniedError is returned when the cluster refuses a project request,
+// typically because self-provisioning has been restricted
+type ProjectRequestDeniedError struct {
+	Project      string
+	User         string
+	RequiredRole string
+
+	// Message is the cluster's project request message, if configured
+	Message string
+
+	// Contact is who to ask for a project, if the cluster publishes it
+	Contact string
+
+	Err error
+}
+
+// Error implements the error interface
+func (e *ProjectRequestDeniedError) Error() string {
+	return fmt.Sprintf("not allowed to create project %s: the %s role is required", e.Project, e.RequiredRole)
+}
+
+// Unwrap returns the underlying API error
+func (e *ProjectRequestDeniedError) Unwrap() error {
+	return e.Err
+}
+
+// Details returns human readable lines explaining the denial and what to do next
+func (e *ProjectRequestDeniedError) Details() []string {
+	var lines []string
+	if e.User != "" {
+		lines = append(lines, fmt.Sprintf("User %s may not request projects on this cluster.", e.User))
+	} else {
+		lines = append(lines, "Project self-provisioning is restricted on this cluster.")
+	}
+	lines = append(lines, fmt.Sprintf("Required: the '%s' cluster role (create projectrequests).", e.RequiredRole))
+	if e.Message != "" {
+		lines = append(lines, e.Message)
+	}
+	if e.Contact != "" {
+		lines = append(lines, fmt.Sprintf("Contact: %s", e.Contact))
+	}
+	if e.Message == "" && e.Contact == "" {
+		lines = append(lines, "Ask a cluster administrator to create the project or grant the role.")
+	}
+	return lines
+}
+
+// newProjectRequestDeniedError builds a ProjectRequestDeniedError from a Forbidden API error.
+// A Forbidden status that is not an RBAC denial carries the cluster's configured
+// project request message, which usually says who to contact.
+func newProjectRequestDeniedError(name string, err error, clusterConfig *unstructured.Unstructured) *ProjectRequestDeniedError {
+	denied := &ProjectRequestDeniedError{
+		Project:      name,
+		RequiredRole: SelfProvisionerRole,
+		Err:          err,
+	}
+
+	message := err.Error()
+	if status, ok := err.(errors.APIStatus); ok {
+		message = status.Status().Message
+	}
+	if idx := strings.Index(message, "is forbidden: "); idx >= 0 {
+		message = message[idx+len("is forbidden: "):]
+	}
+	if match := rbacDenialPattern.FindStringSubmatch(message); match != nil {
+		denied.User = match[1]
+	} else {
+		denied.Message = strings.TrimSpace(message)
+	}
+
+	if clusterConfig != nil {
+		if denied.Message == "" {
+			requestMessage, _, _ := unstructured.NestedString(clusterConfig.Object, "spec", "projectRequestMessage")
+			denied.Message = strings.TrimSpace(requestMessage)
+		}
+		denied.Contact = clusterConfig.GetAnnotations()[ProjectRequestContactAnnotation]
+	}
+
+	return denied
+}
+
+// projectRequestDenied explains a Forbidden project request using the cluster's
+// project configuration when it is readable
+func (m *OpenShiftProjectManager) projectRequestDenied(ctx context.Context, name string, err error) error {
+	clusterConfig, configErr := m.dynamicClient.Resource(projectConfigResource).Get(ctx, "cluster", metav1.GetOptions{})
+	if configErr != nil {
+		clusterConfig = nil // Most users cannot read cluster configuration
+	}
+	return newProjectRequestDeniedError(name, err, clusterConfig)
+}
@@ -0,0 +1,53 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNewProjectRequestDeniedError(t *testing.T) {
+	gr := schema.GroupResource{Group: "project.openshift.io", Resource: "projectrequests"}
+
+	t.Run("rbac denial with cluster config", func(t *testing.T) {
+		apiErr := errors.NewForbidden(gr, "", fmt.Errorf(`User "alice" cannot create resource "projectrequests" in API group "project.openshift.io" at the cluster scope`))
+		config := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"projectRequestMessage": "Open a ticket with the platform team"},
+		}}
+		config.SetAnnotations(map[string]string{ProjectRequestContactAnnotation: "#platform-help"})
+
+		denied := newProjectRequestDeniedError("team-x", apiErr, config)
+		if denied.User != "alice" {
+			t.Errorf("Expected user alice, got %q", denied.User)
+		}
+		if denied.RequiredRole != SelfProvisionerRole {
+			t.Errorf("Expected role %s, got %q", SelfProvisionerRole, denied.RequiredRole)
+		}
+		if denied.Message != "Open a ticket with the platform team" || denied.Contact != "#platform-help" {
+			t.Errorf("Expected message and contact from cluster config, got %q / %q", denied.Message, denied.Contact)
+		}
+		if !errors.IsForbidden(denied) {
+			t.Errorf("Expected the Forbidden API error to be unwrappable")
+		}
+	})
+
+	t.Run("custom request message", func(t *testing.T) {
+		apiErr := errors.NewForbidden(gr, "team-x", fmt.Errorf("To request a project, email ops@example.com"))
+
+		denied := newProjectRequestDeniedError("team-x", apiErr, nil)
+		if denied.User != "" {
+			t.Errorf("Expected no user for a non-RBAC denial, got %q", denied.User)
+		}
+		if denied.Message != "To request a project, email ops@example.com" {
+			t.Errorf("Expected the Forbidden prefix to be stripped, got %q", denied.Message)
+		}
+		details := strings.Join(denied.Details(), "\n")
+		if !strings.Contains(details, "ops@example.com") || !strings.Contains(details, SelfProvisionerRole) {
+			t.Errorf("Expected details to include the role and the request message, got %q", details)
+		}
+	})
+}
@@ -0,0 +1,300 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/yaml"
+)
+
+// ApplyFieldManager is the field manager recorded for server-side applies
+const ApplyFieldManager = "lazyoc"
+
+// Apply outcomes, matching the wording of 'kubectl apply'
+const (
+	ApplyCreated    = "created"
+	ApplyConfigured = "configured"
+	ApplyUnchanged  = "unchanged"
+	ApplyFailed     = "error"
+)
+
+// ApplyResult is the outcome of applying one object of a manifest
+type ApplyResult struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Action    string `json:"action"` // created, configured, unchanged or error
+	Err       error  `json:"-"`
+}
+
+// Ref returns the object in 'kind/name' form, as printed by kubectl
+func (r ApplyResult) Ref() string {
+	return strings.ToLower(r.Kind) + "/" + r.Name
+}
+
+// ResourceApplier applies manifests that may contain several objects
+type ResourceApplier interface {
+	// ApplyManifests server-side applies every object in a multi-document
+	// manifest and reports a result per object. Objects without a namespace
+	// are applied to namespace when they are namespaced.
+	ApplyManifests(ctx context.Context, namespace string, manifest []byte) ([]ApplyResult, error)
+}
+
+// ManifestPreview is an object of a manifest as it is live and as applying
+// the manifest would leave it, both as YAML
+type ManifestPreview struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Live      string // Empty when applying creates the object
+	Applied   string
+	Err       error
+}
+
+// Ref returns the object in 'kind/name' form, as printed by kubectl
+func (p ManifestPreview) Ref() string {
+	return strings.ToLower(p.Kind) + "/" + p.Name
+}
+
+// ManifestPreviewer previews what applying a manifest would change, like
+// 'kubectl diff'
+type ManifestPreviewer interface {
+	// PreviewManifests dry-run applies every object in a multi-document
+	// manifest and returns each object before and after. Objects without a
+	// namespace are previewed in namespace when they are namespaced.
+	PreviewManifests(ctx context.Context, namespace string, manifest []byte) ([]ManifestPreview, error)
+}
+
+// DecodeManifests splits a multi-document YAML or JSON manifest into objects.
+// Empty documents are skipped and List kinds are expanded into their items.
+func DecodeManifests(manifest []byte) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+
+	var objects []*unstructured.Unstructured
+	for i := 1; ; i++ {
+		var doc map[string]interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("invalid YAML in document %d: %w", i, err)
+		}
+		if len(doc) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: doc}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("invalid list in document %d: %w", i, err)
+			}
+			for j := range list.Items {
+				objects = append(objects, &list.Items[j])
+			}
+			continue
+		}
+
+		if obj.GetKind() == "" || obj.GetAPIVersion() == "" {
+			return nil, fmt.Errorf("document %d is missing apiVersion or kind", i)
+		}
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// ApplyManifests server-side applies every object in manifest
+func (c *K8sResourceClient) ApplyManifests(ctx context.Context, namespace string, manifest []byte) ([]ApplyResult, error) {
+	if c.restConfig == nil {
+		return nil, fmt.Errorf("REST config not available for apply operations")
+	}
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	objects, err := DecodeManifests(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(c.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.clientset.Discovery()))
+
+	return applyObjects(ctx, dynamicClient, mapper, namespace, objects), nil
+}
+
+// PreviewManifests dry-run applies every object in manifest
+func (c *K8sResourceClient) PreviewManifests(ctx context.Context, namespace string, manifest []byte) ([]ManifestPreview, error) {
+	if c.restConfig == nil {
+		return nil, fmt.Errorf("REST config not available for apply operations")
+	}
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	objects, err := DecodeManifests(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(c.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.clientset.Discovery()))
+
+	previews := make([]ManifestPreview, 0, len(objects))
+	for _, obj := range objects {
+		preview := ManifestPreview{Kind: obj.GetKind(), Name: obj.GetName()}
+		preview.Namespace, preview.Live, preview.Applied, preview.Err = previewObject(ctx, dynamicClient, mapper, namespace, obj)
+		previews = append(previews, preview)
+	}
+	return previews, nil
+}
+
+// previewObject dry-run applies a single object and returns it before and
+// after as YAML
+func previewObject(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, namespace string, obj *unstructured.Unstructured) (string, string, string, error) {
+	resource, namespace, err := objectResource(client, mapper, namespace, obj)
+	if err != nil {
+		return "", namespace, "", err
+	}
+
+	live := ""
+	existing, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	switch {
+	case err == nil:
+		if live, err = previewYAML(existing); err != nil {
+			return namespace, "", "", err
+		}
+	case !apierrors.IsNotFound(err):
+		return namespace, "", "", err
+	}
+
+	applied, err := resource.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{FieldManager: ApplyFieldManager, Force: true, DryRun: []string{metav1.DryRunAll}})
+	if err != nil {
+		return namespace, live, "", err
+	}
+	appliedYAML, err := previewYAML(applied)
+	return namespace, live, appliedYAML, err
+}
+
+// previewYAML renders an object for a diff: without the metadata the server
+// maintains and with Secret values redacted
+func previewYAML(obj *unstructured.Unstructured) (string, error) {
+	copied := obj.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "generation"} {
+		unstructured.RemoveNestedField(copied.Object, "metadata", field)
+	}
+	if copied.GetKind() == "Secret" {
+		for _, field := range []string{"data", "stringData"} {
+			values, found, _ := unstructured.NestedMap(copied.Object, field)
+			if !found {
+				continue
+			}
+			for key := range values {
+				values[key] = redactedValue
+			}
+			_ = unstructured.SetNestedMap(copied.Object, values, field)
+		}
+	}
+
+	out, err := yaml.Marshal(copied.Object)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return string(out), nil
+}
+
+// applyObjects applies objects one by one; a failure is recorded in the
+// object's result and does not stop the remaining objects
+func applyObjects(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, namespace string, objects []*unstructured.Unstructured) []ApplyResult {
+	results := make([]ApplyResult, 0, len(objects))
+	for _, obj := range objects {
+		result := ApplyResult{Kind: obj.GetKind(), Name: obj.GetName()}
+		result.Action, result.Namespace, result.Err = applyObject(ctx, client, mapper, namespace, obj)
+		if result.Err != nil {
+			result.Action = ApplyFailed
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// objectResource returns the client of the resource type of an object and
+// the namespace the object goes to, defaulting it to namespace for
+// namespaced types
+func objectResource(client dynamic.Interface, mapper meta.RESTMapper, namespace string, obj *unstructured.Unstructured) (dynamic.ResourceInterface, string, error) {
+	if obj.GetName() == "" {
+		return nil, "", fmt.Errorf("metadata.name is required")
+	}
+
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, "", fmt.Errorf("unknown resource type %s: %w", gvk.Kind, err)
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		namespace = obj.GetNamespace()
+		return client.Resource(mapping.Resource).Namespace(namespace), namespace, nil
+	}
+	obj.SetNamespace("")
+	return client.Resource(mapping.Resource), "", nil
+}
+
+// applyObject applies a single object and classifies the outcome by
+// comparing the resource version before and after the apply
+func applyObject(ctx context.Context, client dynamic.Interface, mapper meta.RESTMapper, namespace string, obj *unstructured.Unstructured) (string, string, error) {
+	resource, namespace, err := objectResource(client, mapper, namespace, obj)
+	if err != nil {
+		return "", namespace, err
+	}
+
+	previousVersion := ""
+	existing, err := resource.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	switch {
+	case err == nil:
+		previousVersion = existing.GetResourceVersion()
+	case !apierrors.IsNotFound(err):
+		return "", namespace, err
+	}
+
+	applied, err := resource.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{FieldManager: ApplyFieldManager, Force: true, DryRun: dryRunOption(ctx)})
+	if err != nil {
+		return "", namespace, err
+	}
+
+	switch {
+	case previousVersion == "":
+		return ApplyCreated, namespace, nil
+	case IsDryRun(ctx):
+		if dryRunChanged(existing, applied) {
+			return ApplyConfigured, namespace, nil
+		}
+		return ApplyUnchanged, namespace, nil
+	case applied.GetResourceVersion() == previousVersion:
+		return ApplyUnchanged, namespace, nil
+	default:
+		return ApplyConfigured, namespace, nil
+	}
+}
@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const multiDocManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  mode: fast
+---
+# comment-only documents are skipped
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: team-a
+---
+apiVersion: v1
+kind: Widget
+metadata:
+  name: unknown
+`
+
+func TestDecodeManifests(t *testing.T) {
+	objects, err := DecodeManifests([]byte(multiDocManifest))
+	if err != nil {
+		t.Fatalf("Failed to decode manifests: %v", err)
+	}
+	if len(objects) != 3 {
+		t.Fatalf("Expected 3 objects, got %d", len(objects))
+	}
+	if objects[0].GetKind() != "ConfigMap" || objects[1].GetName() != "team-a" {
+		t.Errorf("Unexpected objects: %s, %s", objects[0].GetKind(), objects[1].GetName())
+	}
+
+	list := `{"apiVersion": "v1", "kind": "List", "items": [
+		{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "a"}},
+		{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "b"}}]}`
+	objects, err = DecodeManifests([]byte(list))
+	if err != nil || len(objects) != 2 {
+		t.Fatalf("Expected list to expand to 2 objects, got %d (%v)", len(objects), err)
+	}
+
+	if _, err := DecodeManifests([]byte("metadata:\n  name: x\n")); err == nil {
+		t.Errorf("Expected an error for a document without apiVersion and kind")
+	}
+}
+
+func TestApplyObjects(t *testing.T) {
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	namespaces := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetAPIVersion("v1")
+	existing.SetKind("Namespace")
+	existing.SetName("team-a")
+	existing.SetResourceVersion("1")
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		configMaps: "ConfigMapList",
+		namespaces: "NamespaceList",
+	}, existing)
+
+	// The fake tracker does not implement server-side apply, so emulate it:
+	// create missing objects and bump the resource version of changed ones
+	client.PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stesting.PatchAction)
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(patch.GetPatch()); err != nil {
+			return true, nil, err
+		}
+
+		current, err := client.Tracker().Get(patch.GetResource(), patch.GetNamespace(), patch.GetName())
+		if err != nil {
+			obj.SetResourceVersion("1")
+			return true, obj, client.Tracker().Create(patch.GetResource(), obj, patch.GetNamespace())
+		}
+		obj.SetResourceVersion(current.(*unstructured.Unstructured).GetResourceVersion() + "1")
+		return true, obj, client.Tracker().Update(patch.GetResource(), obj, patch.GetNamespace())
+	})
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+
+	objects, err := DecodeManifests([]byte(multiDocManifest))
+	if err != nil {
+		t.Fatalf("Failed to decode manifests: %v", err)
+	}
+
+	results := applyObjects(context.Background(), client, mapper, "demo", objects)
+	if len(results) != 3 {
+		t.Fatalf("Expected a result per object, got %d", len(results))
+	}
+
+	if results[0].Action != ApplyCreated || results[0].Namespace != "demo" {
+		t.Errorf("Expected configmap/settings created in demo, got %s in %q (%v)", results[0].Action, results[0].Namespace, results[0].Err)
+	}
+	if results[1].Action != ApplyConfigured || results[1].Namespace != "" {
+		t.Errorf("Expected existing cluster-scoped namespace/team-a to be updated, got %s (%v)", results[1].Action, results[1].Err)
+	}
+	if results[2].Action != ApplyFailed || results[2].Err == nil {
+		t.Errorf("Expected unknown kind to fail, got %s", results[2].Action)
+	}
+	if results[2].Ref() != "widget/unknown" {
+		t.Errorf("Unexpected ref %q", results[2].Ref())
+	}
+
+	if _, err := client.Resource(configMaps).Namespace("demo").Get(context.Background(), "settings", metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected configmap to exist after apply: %v", err)
+	}
+}
+
+func TestPreviewYAML(t *testing.T) {
+	secret := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "db", "resourceVersion": "42", "managedFields": []interface{}{}},
+		"data":       map[string]interface{}{"password": "czNjcmV0"},
+	}}
+
+	out, err := previewYAML(secret)
+	if err != nil {
+		t.Fatalf("previewYAML() returned error: %v", err)
+	}
+	if strings.Contains(out, "czNjcmV0") || !strings.Contains(out, "password: <redacted>") {
+		t.Errorf("Expected the secret value to be redacted, got:\n%s", out)
+	}
+	if strings.Contains(out, "resourceVersion") || strings.Contains(out, "managedFields") {
+		t.Errorf("Expected server maintained metadata to be dropped, got:\n%s", out)
+	}
+	if secret.GetResourceVersion() != "42" {
+		t.Errorf("Expected the object not to be modified")
+	}
+}
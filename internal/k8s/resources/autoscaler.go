@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// autoscalerEventSource is the component the cluster autoscaler records events as
+const autoscalerEventSource = "cluster-autoscaler"
+
+// Event reasons recorded by the cluster autoscaler
+const (
+	reasonTriggeredScaleUp  = "TriggeredScaleUp"
+	reasonNotTriggerScaleUp = "NotTriggerScaleUp"
+	reasonScaledUpGroup     = "ScaledUpGroup"
+	reasonScaleDown         = "ScaleDown"
+	reasonScaleDownEmpty    = "ScaleDownEmpty"
+)
+
+// ListScalingEvents lists the events the cluster autoscaler recorded in all
+// namespaces, most recent first
+func (c *K8sResourceClient) ListScalingEvents(ctx context.Context) (*ResourceList[EventInfo], error) {
+	eventList, err := c.clientset.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "source=" + autoscalerEventSource,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster autoscaler events: %w", err)
+	}
+
+	events := make([]EventInfo, len(eventList.Items))
+	for i := range eventList.Items {
+		events[i] = convertEvent(&eventList.Items[i])
+	}
+	sortEventsByLastSeen(events)
+
+	return &ResourceList[EventInfo]{
+		Items:    events,
+		Total:    len(events),
+		Continue: eventList.Continue,
+	}, nil
+}
+
+// IsScalingEvent reports whether an event was recorded by the cluster autoscaler
+func IsScalingEvent(event EventInfo) bool {
+	return event.Source == autoscalerEventSource
+}
+
+// SummarizeScaling turns cluster autoscaler events, most recent first, into
+// the pods waiting for capacity and the latest scale ups and downs. Only pods
+// reported on within the window are considered, the autoscaler repeats its
+// events while a pod stays pending.
+func SummarizeScaling(events []EventInfo, window time.Duration, now time.Time) ScalingActivity {
+	var activity ScalingActivity
+	seen := make(map[string]bool)
+
+	for i := range events {
+		event := events[i]
+		if !IsScalingEvent(event) {
+			continue
+		}
+
+		switch event.Reason {
+		case reasonTriggeredScaleUp, reasonNotTriggerScaleUp:
+			key := event.Namespace + "/" + event.InvolvedName
+			if event.InvolvedKind != "Pod" || seen[key] || now.Sub(event.LastSeen) > window {
+				continue
+			}
+			seen[key] = true
+			pod := ScalingPod{Namespace: event.Namespace, Name: event.InvolvedName, Message: event.Message, LastSeen: event.LastSeen}
+			if event.Reason == reasonTriggeredScaleUp {
+				activity.WaitingPods = append(activity.WaitingPods, pod)
+			} else {
+				activity.BlockedPods = append(activity.BlockedPods, pod)
+			}
+
+		case reasonScaledUpGroup:
+			if activity.LastScaleUp == nil {
+				activity.LastScaleUp = &events[i]
+			}
+
+		case reasonScaleDown, reasonScaleDownEmpty:
+			if event.InvolvedKind == "Node" && activity.LastScaleDown == nil {
+				activity.LastScaleDown = &events[i]
+			}
+		}
+
+		if event.Type == corev1.EventTypeWarning && strings.Contains(event.Reason, "Failed") {
+			activity.Failures = append(activity.Failures, event)
+		}
+	}
+	return activity
+}
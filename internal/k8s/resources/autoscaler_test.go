@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeScaling(t *testing.T) {
+	now := time.Now()
+	event := func(reason, eventType, kind, namespace, name string, age time.Duration) EventInfo {
+		return EventInfo{
+			ResourceInfo: ResourceInfo{Namespace: namespace},
+			Type:         eventType,
+			Reason:       reason,
+			Message:      reason + " " + name,
+			InvolvedKind: kind,
+			InvolvedName: name,
+			Source:       autoscalerEventSource,
+			LastSeen:     now.Add(-age),
+		}
+	}
+
+	events := []EventInfo{
+		event("TriggeredScaleUp", "Normal", "Pod", "shop", "web-1", time.Minute),
+		event("ScaledUpGroup", "Normal", "ConfigMap", "kube-system", "cluster-autoscaler-status", 2*time.Minute),
+		event("TriggeredScaleUp", "Normal", "Pod", "shop", "web-1", 3*time.Minute),
+		event("NotTriggerScaleUp", "Normal", "Pod", "batch", "gpu-job", 4*time.Minute),
+		event("ScaleDownFailed", "Warning", "Node", "", "node-3", 5*time.Minute),
+		event("ScaleDown", "Normal", "Node", "", "node-2", 6*time.Minute),
+		event("TriggeredScaleUp", "Normal", "Pod", "shop", "old-1", time.Hour),
+		{Reason: "TriggeredScaleUp", InvolvedKind: "Pod", InvolvedName: "other", Source: "default-scheduler", LastSeen: now},
+	}
+
+	activity := SummarizeScaling(events, 10*time.Minute, now)
+	if len(activity.WaitingPods) != 1 || activity.WaitingPods[0].Name != "web-1" {
+		t.Errorf("Expected web-1 waiting once, got %+v", activity.WaitingPods)
+	}
+	if len(activity.BlockedPods) != 1 || activity.BlockedPods[0].Name != "gpu-job" {
+		t.Errorf("Expected gpu-job blocked, got %+v", activity.BlockedPods)
+	}
+	if activity.LastScaleUp == nil || activity.LastScaleUp.InvolvedName != "cluster-autoscaler-status" {
+		t.Errorf("Expected the latest scale up, got %+v", activity.LastScaleUp)
+	}
+	if activity.LastScaleDown == nil || activity.LastScaleDown.InvolvedName != "node-2" {
+		t.Errorf("Expected node-2 as the latest scale down, got %+v", activity.LastScaleDown)
+	}
+	if len(activity.Failures) != 1 || activity.Failures[0].Reason != "ScaleDownFailed" {
+		t.Errorf("Expected the failed scale down, got %+v", activity.Failures)
+	}
+}
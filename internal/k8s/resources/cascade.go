@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// cascadeResources maps the kinds that can be deleted with a cascade
+// preview to their resources
+var cascadeResources = map[string]schema.GroupVersionResource{
+	"pod":              {Version: "v1", Resource: "pods"},
+	"deployment":       {Group: "apps", Version: "v1", Resource: "deployments"},
+	"statefulset":      {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"daemonset":        {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"replicaset":       {Group: "apps", Version: "v1", Resource: "replicasets"},
+	"job":              {Group: "batch", Version: "v1", Resource: "jobs"},
+	"cronjob":          {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	"deploymentconfig": {Group: "apps.openshift.io", Version: "v1", Resource: "deploymentconfigs"},
+}
+
+// dependentResources are the resources searched for dependents of a deleted
+// object, the objects workload controllers create
+var dependentResources = []struct {
+	kind string
+	gvr  schema.GroupVersionResource
+}{
+	{"ReplicaSet", schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}},
+	{"ReplicationController", schema.GroupVersionResource{Version: "v1", Resource: "replicationcontrollers"}},
+	{"ControllerRevision", schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "controllerrevisions"}},
+	{"Job", schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}},
+	{"Pod", schema.GroupVersionResource{Version: "v1", Resource: "pods"}},
+}
+
+// ownedObject is a candidate dependent and its kind
+type ownedObject struct {
+	kind   string
+	object metav1.Object
+}
+
+// PlanCascade returns the tree of objects the garbage collector deletes
+// along with a resource, following owner references down from it
+func (c *K8sResourceClient) PlanCascade(ctx context.Context, kind, namespace, name string) (*DependentTree, error) {
+	gvr, ok := cascadeResources[strings.ToLower(kind)]
+	if !ok {
+		return nil, fmt.Errorf("deleting is not supported for kind %s", kind)
+	}
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	client, err := c.metadataClient()
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := client.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	var candidates []ownedObject
+	for _, dependent := range dependentResources {
+		list, err := client.Resource(dependent.gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s: %w", dependent.gvr.Resource, err)
+		}
+		for i := range list.Items {
+			candidates = append(candidates, ownedObject{kind: dependent.kind, object: &list.Items[i]})
+		}
+	}
+
+	tree := buildDependentTree(kindName(kind), root, candidates)
+	return &tree, nil
+}
+
+// buildDependentTree arranges the candidates owned, directly or through
+// other dependents, by root into a tree sorted by kind and name
+func buildDependentTree(kind string, root metav1.Object, candidates []ownedObject) DependentTree {
+	owned := make(map[types.UID][]ownedObject)
+	for _, candidate := range candidates {
+		for _, ref := range candidate.object.GetOwnerReferences() {
+			owned[ref.UID] = append(owned[ref.UID], candidate)
+		}
+	}
+
+	seen := make(map[types.UID]bool)
+	var walk func(kind string, object metav1.Object) DependentTree
+	walk = func(kind string, object metav1.Object) DependentTree {
+		seen[object.GetUID()] = true
+		node := DependentTree{Kind: kind, Name: object.GetName()}
+		for _, child := range owned[object.GetUID()] {
+			if seen[child.object.GetUID()] {
+				continue
+			}
+			node.Children = append(node.Children, walk(child.kind, child.object))
+		}
+		sort.Slice(node.Children, func(i, j int) bool {
+			if node.Children[i].Kind != node.Children[j].Kind {
+				return node.Children[i].Kind < node.Children[j].Kind
+			}
+			return node.Children[i].Name < node.Children[j].Name
+		})
+		return node
+	}
+	return walk(kind, root)
+}
+
+// DeleteResource deletes a resource with the given propagation policy:
+// Background and Foreground delete its dependents, Orphan leaves them
+// running without an owner
+func (c *K8sResourceClient) DeleteResource(ctx context.Context, kind, namespace, name string, propagation metav1.DeletionPropagation) error {
+	gvr, ok := cascadeResources[strings.ToLower(kind)]
+	if !ok {
+		return fmt.Errorf("deleting is not supported for kind %s", kind)
+	}
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	client, err := c.metadataClient()
+	if err != nil {
+		return err
+	}
+
+	err = client.Resource(gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+		DryRun:            dryRunOption(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete %s %s/%s: %w", strings.ToLower(kind), namespace, name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func ownedBy(name string, uid types.UID, owners ...types.UID) *metav1.ObjectMeta {
+	meta := &metav1.ObjectMeta{Name: name, UID: uid}
+	for _, owner := range owners {
+		meta.OwnerReferences = append(meta.OwnerReferences, metav1.OwnerReference{UID: owner})
+	}
+	return meta
+}
+
+func TestBuildDependentTree(t *testing.T) {
+	root := ownedBy("web", "d1")
+	candidates := []ownedObject{
+		{"ReplicaSet", ownedBy("web-7d4", "rs2", "d1")},
+		{"ReplicaSet", ownedBy("web-5c9", "rs1", "d1")},
+		{"Pod", ownedBy("web-5c9-a", "p1", "rs1")},
+		{"Pod", ownedBy("web-5c9-b", "p2", "rs1")},
+		{"Pod", ownedBy("other", "p3", "rs9")},
+	}
+
+	tree := buildDependentTree("Deployment", root, candidates)
+	if tree.Kind != "Deployment" || tree.Name != "web" || tree.Count() != 4 {
+		t.Fatalf("Expected web with 4 dependents, got %+v", tree)
+	}
+	if len(tree.Children) != 2 || tree.Children[0].Name != "web-5c9" || tree.Children[1].Name != "web-7d4" {
+		t.Fatalf("Expected the ReplicaSets sorted by name, got %+v", tree.Children)
+	}
+	if pods := tree.Children[0].Children; len(pods) != 2 || pods[0].Kind != "Pod" || pods[0].Name != "web-5c9-a" {
+		t.Errorf("Expected the pods of web-5c9, got %+v", pods)
+	}
+}
+
+func TestBuildDependentTreeStopsOnCycles(t *testing.T) {
+	root := ownedBy("a", "a", "b")
+	candidates := []ownedObject{{"Pod", ownedBy("b", "b", "a")}, {"Pod", ownedBy("a", "a", "b")}}
+
+	if tree := buildDependentTree("Pod", root, candidates); tree.Count() != 1 {
+		t.Errorf("Expected the cycle to be followed once, got %+v", tree)
+	}
+}
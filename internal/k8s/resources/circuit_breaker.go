@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CircuitOpenError is returned when requests for a resource are short-circuited
+// because the API has failed repeatedly
+type CircuitOpenError struct {
+	Resource string
+	RetryIn  time.Duration
+	LastErr  error
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s requests paused after repeated failures, retrying in %s: %v",
+		e.Resource, e.RetryIn.Round(time.Second), e.LastErr)
+}
+
+func (e *CircuitOpenError) Unwrap() error {
+	return e.LastErr
+}
+
+// circuitState tracks consecutive failures for a single resource
+type circuitState struct {
+	failures  int
+	openUntil time.Time
+	lastErr   error
+}
+
+// CircuitBreaker stops calling a resource's API after consecutive failures
+// and allows a single probe request once the cooldown elapses. It is safe for
+// concurrent use.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	states    map[string]*circuitState
+	now       func() time.Time
+}
+
+// NewCircuitBreaker creates a circuit breaker that opens after threshold
+// consecutive failures and stays open for cooldown
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		states:    make(map[string]*circuitState),
+		now:       time.Now,
+	}
+}
+
+// Allow returns a CircuitOpenError if the circuit for resource is open
+func (cb *CircuitBreaker) Allow(resource string) error {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	state, ok := cb.states[resource]
+	if !ok || state.openUntil.IsZero() {
+		return nil
+	}
+
+	now := cb.now()
+	if now.Before(state.openUntil) {
+		return &CircuitOpenError{Resource: resource, RetryIn: state.openUntil.Sub(now), LastErr: state.lastErr}
+	}
+
+	// Half-open: let one probe through and re-open immediately if it fails
+	state.openUntil = time.Time{}
+	state.failures = cb.threshold - 1
+	return nil
+}
+
+// Record updates the circuit for resource with the outcome of a request
+func (cb *CircuitBreaker) Record(resource string, err error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if err == nil {
+		delete(cb.states, resource)
+		return
+	}
+
+	state, ok := cb.states[resource]
+	if !ok {
+		state = &circuitState{}
+		cb.states[resource] = state
+	}
+	state.failures++
+	state.lastErr = err
+	if cb.threshold > 0 && state.failures >= cb.threshold {
+		state.openUntil = cb.now().Add(cb.cooldown)
+	}
+}
+
+// IsOpen reports whether the circuit for resource is currently open
+func (cb *CircuitBreaker) IsOpen(resource string) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	state, ok := cb.states[resource]
+	return ok && cb.now().Before(state.openUntil)
+}
+
+// Reset closes all circuits
+func (cb *CircuitBreaker) Reset() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.states = make(map[string]*circuitState)
+}
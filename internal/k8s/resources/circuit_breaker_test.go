@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	cb := NewCircuitBreaker(2, 30*time.Second)
+	cb.now = func() time.Time { return now }
+	failure := errors.New("connection refused")
+
+	cb.Record("pods", failure)
+	if err := cb.Allow("pods"); err != nil {
+		t.Fatalf("Expected circuit to stay closed below threshold, got %v", err)
+	}
+
+	cb.Record("pods", failure)
+	err := cb.Allow("pods")
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) {
+		t.Fatalf("Expected CircuitOpenError after threshold, got %v", err)
+	}
+	if !errors.Is(err, failure) {
+		t.Errorf("Expected CircuitOpenError to wrap the last failure")
+	}
+	if cb.Allow("services") != nil {
+		t.Errorf("Expected other resources to be unaffected")
+	}
+
+	// After the cooldown a single probe is allowed; another failure re-opens the circuit
+	now = now.Add(31 * time.Second)
+	if err := cb.Allow("pods"); err != nil {
+		t.Fatalf("Expected probe request after cooldown, got %v", err)
+	}
+	cb.Record("pods", failure)
+	if !cb.IsOpen("pods") {
+		t.Errorf("Expected failed probe to re-open the circuit")
+	}
+
+	now = now.Add(31 * time.Second)
+	_ = cb.Allow("pods")
+	cb.Record("pods", nil)
+	if cb.IsOpen("pods") || cb.Allow("pods") != nil {
+		t.Errorf("Expected successful probe to close the circuit")
+	}
+}
@@ -68,12 +68,21 @@ func NewK8sResourceClientWithProjectManagerAndConfig(clientset *kubernetes.Clien
 	}
 }
 
+// listNamespace resolves the namespace to list in: every namespace when
+// AllNamespaces is set, otherwise the given or the current one
+func (c *K8sResourceClient) listNamespace(opts ListOptions) string {
+	if opts.AllNamespaces {
+		return metav1.NamespaceAll
+	}
+	if opts.Namespace == "" {
+		return c.currentNamespace
+	}
+	return opts.Namespace
+}
+
 // ListPods lists pods in the specified namespace
 func (c *K8sResourceClient) ListPods(ctx context.Context, opts ListOptions) (*ResourceList[PodInfo], error) {
-	namespace := opts.Namespace
-	if namespace == "" {
-		namespace = c.currentNamespace
-	}
+	namespace := c.listNamespace(opts)
 
 	listOpts := metav1.ListOptions{
 		LabelSelector: opts.LabelSelector,
@@ -127,10 +136,7 @@ func (c *K8sResourceClient) GetPod(ctx context.Context, namespace, name string)
 
 // ListServices lists services in the specified namespace
 func (c *K8sResourceClient) ListServices(ctx context.Context, opts ListOptions) (*ResourceList[ServiceInfo], error) {
-	namespace := opts.Namespace
-	if namespace == "" {
-		namespace = c.currentNamespace
-	}
+	namespace := c.listNamespace(opts)
 
 	listOpts := metav1.ListOptions{
 		LabelSelector: opts.LabelSelector,
@@ -184,10 +190,7 @@ func (c *K8sResourceClient) GetService(ctx context.Context, namespace, name stri
 
 // ListDeployments lists deployments in the specified namespace
 func (c *K8sResourceClient) ListDeployments(ctx context.Context, opts ListOptions) (*ResourceList[DeploymentInfo], error) {
-	namespace := opts.Namespace
-	if namespace == "" {
-		namespace = c.currentNamespace
-	}
+	namespace := c.listNamespace(opts)
 
 	listOpts := metav1.ListOptions{
 		LabelSelector: opts.LabelSelector,
@@ -241,10 +244,7 @@ func (c *K8sResourceClient) GetDeployment(ctx context.Context, namespace, name s
 
 // ListConfigMaps lists configmaps in the specified namespace
 func (c *K8sResourceClient) ListConfigMaps(ctx context.Context, opts ListOptions) (*ResourceList[ConfigMapInfo], error) {
-	namespace := opts.Namespace
-	if namespace == "" {
-		namespace = c.currentNamespace
-	}
+	namespace := c.listNamespace(opts)
 
 	listOpts := metav1.ListOptions{
 		LabelSelector: opts.LabelSelector,
@@ -296,13 +296,28 @@ func (c *K8sResourceClient) GetConfigMap(ctx context.Context, namespace, name st
 	return &configMapInfo, nil
 }
 
-// ListSecrets lists secrets in the specified namespace
-func (c *K8sResourceClient) ListSecrets(ctx context.Context, opts ListOptions) (*ResourceList[SecretInfo], error) {
-	namespace := opts.Namespace
+// GetConfigMapData retrieves the data of a configmap. Binary data is left out.
+func (c *K8sResourceClient) GetConfigMapData(ctx context.Context, namespace, name string) (map[string]string, error) {
 	if namespace == "" {
 		namespace = c.currentNamespace
 	}
 
+	cm, err := c.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configmap data %s/%s: %w", namespace, name, err)
+	}
+
+	data := make(map[string]string, len(cm.Data))
+	for key, value := range cm.Data {
+		data[key] = value
+	}
+	return data, nil
+}
+
+// ListSecrets lists secrets in the specified namespace
+func (c *K8sResourceClient) ListSecrets(ctx context.Context, opts ListOptions) (*ResourceList[SecretInfo], error) {
+	namespace := c.listNamespace(opts)
+
 	listOpts := metav1.ListOptions{
 		LabelSelector: opts.LabelSelector,
 		FieldSelector: opts.FieldSelector,
@@ -417,6 +432,17 @@ func (c *K8sResourceClient) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+// MeasureLatency performs a lightweight API request and returns its round-trip time
+func (c *K8sResourceClient) MeasureLatency(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	_, err := c.clientset.Discovery().RESTClient().Get().AbsPath(constants.VersionEndpoint).DoRaw(ctx)
+	elapsed := time.Since(start)
+	if err != nil {
+		return elapsed, fmt.Errorf("latency probe failed: %w", err)
+	}
+	return elapsed, nil
+}
+
 // GetServerInfo returns server information
 func (c *K8sResourceClient) GetServerInfo(ctx context.Context) (map[string]interface{}, error) {
 	version, err := c.clientset.Discovery().ServerVersion()
@@ -549,29 +575,20 @@ func (c *K8sResourceClient) convertPod(pod *corev1.Pod) PodInfo {
 
 	for _, container := range pod.Spec.Containers {
 		containerInfo := ContainerInfo{
-			Name:  container.Name,
-			Image: container.Image,
-			Ready: false,
-			State: "Unknown",
+			Name:      container.Name,
+			Image:     container.Image,
+			Ready:     false,
+			State:     "Unknown",
+			Resources: containerResources(container.Resources),
 		}
 
 		// Find container status
 		for _, status := range pod.Status.ContainerStatuses {
 			if status.Name == container.Name {
-				containerInfo.Ready = status.Ready
+				applyContainerStatus(&containerInfo, status)
 				if status.Ready {
 					ready++
 				}
-
-				if status.State.Running != nil {
-					containerInfo.State = "Running"
-				} else if status.State.Waiting != nil {
-					containerInfo.State = "Waiting"
-					containerInfo.Reason = status.State.Waiting.Reason
-				} else if status.State.Terminated != nil {
-					containerInfo.State = "Terminated"
-					containerInfo.Reason = status.State.Terminated.Reason
-				}
 				break
 			}
 		}
@@ -579,6 +596,22 @@ func (c *K8sResourceClient) convertPod(pod *corev1.Pod) PodInfo {
 		containers = append(containers, containerInfo)
 	}
 
+	var initContainers []ContainerInfo
+	for _, container := range pod.Spec.InitContainers {
+		containerInfo := ContainerInfo{
+			Name:  container.Name,
+			Image: container.Image,
+			State: "Unknown",
+		}
+		for _, status := range pod.Status.InitContainerStatuses {
+			if status.Name == container.Name {
+				applyContainerStatus(&containerInfo, status)
+				break
+			}
+		}
+		initContainers = append(initContainers, containerInfo)
+	}
+
 	// Calculate total restarts
 	var restarts int32
 	for _, status := range pod.Status.ContainerStatuses {
@@ -596,13 +629,33 @@ func (c *K8sResourceClient) convertPod(pod *corev1.Pod) PodInfo {
 			CreatedAt:   pod.CreationTimestamp.Time,
 			Status:      string(pod.Status.Phase),
 		},
-		Phase:         string(pod.Status.Phase),
-		Ready:         fmt.Sprintf("%d/%d", ready, total),
-		Restarts:      restarts,
-		Age:           formatAge(pod.CreationTimestamp.Time),
-		Node:          pod.Spec.NodeName,
-		IP:            pod.Status.PodIP,
-		ContainerInfo: containers,
+		Phase:          string(pod.Status.Phase),
+		Ready:          fmt.Sprintf("%d/%d", ready, total),
+		Restarts:       restarts,
+		Age:            formatAge(pod.CreationTimestamp.Time),
+		Node:           pod.Spec.NodeName,
+		IP:             pod.Status.PodIP,
+		ContainerInfo:  containers,
+		InitContainers: initContainers,
+	}
+}
+
+// applyContainerStatus copies readiness, state and restarts from a container status
+func applyContainerStatus(info *ContainerInfo, status corev1.ContainerStatus) {
+	info.Ready = status.Ready
+	info.RestartCount = status.RestartCount
+
+	if status.State.Running != nil {
+		info.State = "Running"
+	} else if status.State.Waiting != nil {
+		info.State = "Waiting"
+		info.Reason = status.State.Waiting.Reason
+	} else if status.State.Terminated != nil {
+		info.State = "Terminated"
+		info.Reason = status.State.Terminated.Reason
+	}
+	if status.LastTerminationState.Terminated != nil {
+		info.LastTermination = status.LastTerminationState.Terminated.Reason
 	}
 }
 
@@ -751,23 +804,34 @@ func (c *K8sResourceClient) convertNamespace(ns *corev1.Namespace) NamespaceInfo
 	}
 }
 
-// formatAge formats a time duration as a human-readable age string
+// formatAge formats the age of something created at createdAt
 func formatAge(createdAt time.Time) string {
-	age := time.Since(createdAt)
-
-	days := int(age.Hours()) / 24
-	hours := int(age.Hours()) % 24
-	minutes := int(age.Minutes()) % 60
-
-	if days > 0 {
-		return fmt.Sprintf("%dd", days)
-	} else if hours > 0 {
-		return fmt.Sprintf("%dh", hours)
-	} else if minutes > 0 {
-		return fmt.Sprintf("%dm", minutes)
-	} else {
-		return fmt.Sprintf("%ds", int(age.Seconds()))
-	}
+	return FormatAge(time.Since(createdAt))
+}
+
+// FormatAge formats an age in its largest whole unit: 45s, 3h, 6d, then
+// weeks from two weeks, months from two months and years, e.g. 6w rather
+// than 45d. Ages under a second, or negative ones from clock skew between
+// the cluster and this machine, are 0s.
+func FormatAge(age time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case age < time.Second:
+		return "0s"
+	case age < time.Minute:
+		return fmt.Sprintf("%ds", age/time.Second)
+	case age < time.Hour:
+		return fmt.Sprintf("%dm", age/time.Minute)
+	case age < day:
+		return fmt.Sprintf("%dh", age/time.Hour)
+	case age < 14*day:
+		return fmt.Sprintf("%dd", age/day)
+	case age < 60*day:
+		return fmt.Sprintf("%dw", age/(7*day))
+	case age < 365*day:
+		return fmt.Sprintf("%dmo", age/(30*day))
+	}
+	return fmt.Sprintf("%dy", age/(365*day))
 }
 
 // convertProjectInfo converts projects.ProjectInfo to resources.ProjectInfo
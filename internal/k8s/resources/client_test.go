@@ -3,6 +3,9 @@ package resources
 import (
 	"testing"
 	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // All integration tests that connect to real clusters have been disabled
@@ -19,7 +22,11 @@ func TestFormatAge(t *testing.T) {
 		{now.Add(-time.Hour * 3), "3h"},
 		{now.Add(-time.Hour * 25), "1d"},
 		{now.Add(-time.Hour * 24 * 7), "7d"},
-		{now.Add(-time.Hour * 24 * 365), "365d"},
+		{now.Add(-time.Hour * 24 * 45), "6w"},
+		{now.Add(-time.Hour * 24 * 90), "3mo"},
+		{now.Add(-time.Hour * 24 * 365), "1y"},
+		{now.Add(-time.Millisecond * 400), "0s"},
+		{now.Add(time.Second * 5), "0s"},
 	}
 
 	for _, test := range tests {
@@ -46,6 +53,25 @@ func TestListOptions_Defaults(t *testing.T) {
 	}
 }
 
+func TestListNamespace(t *testing.T) {
+	client := &K8sResourceClient{currentNamespace: "current"}
+
+	tests := []struct {
+		opts     ListOptions
+		expected string
+	}{
+		{ListOptions{}, "current"},
+		{ListOptions{Namespace: "other"}, "other"},
+		{ListOptions{Namespace: "other", AllNamespaces: true}, metav1.NamespaceAll},
+	}
+
+	for _, test := range tests {
+		if got := client.listNamespace(test.opts); got != test.expected {
+			t.Errorf("listNamespace(%+v) = %q, expected %q", test.opts, got, test.expected)
+		}
+	}
+}
+
 // All other tests are disabled as they require real cluster connections:
 // - TestK8sResourceClient_ListNamespaces
 // - TestK8sResourceClient_ListPods
@@ -59,3 +85,39 @@ func TestListOptions_Defaults(t *testing.T) {
 // These tests should be rewritten as proper unit tests with mocked
 // Kubernetes clients if needed, but are currently disabled to prevent
 // network dependencies.
+
+func TestConvertPod_InitContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "demo"},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "migrate", Image: "migrate:1"}},
+			Containers:     []corev1.Container{{Name: "app", Image: "app:1"}},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "migrate",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "app",
+				Ready:        true,
+				RestartCount: 3,
+				State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"},
+				},
+			}},
+		},
+	}
+
+	info := (&K8sResourceClient{}).convertPod(pod)
+	if info.Ready != "1/1" {
+		t.Errorf("Expected init containers to be excluded from readiness, got %s", info.Ready)
+	}
+	if len(info.ContainerInfo) != 1 || info.ContainerInfo[0].RestartCount != 3 || info.ContainerInfo[0].LastTermination != "OOMKilled" {
+		t.Errorf("Expected app container with 3 restarts, got %+v", info.ContainerInfo)
+	}
+	if len(info.InitContainers) != 1 || info.InitContainers[0].State != "Terminated" || info.InitContainers[0].Reason != "Completed" {
+		t.Errorf("Expected completed init container, got %+v", info.InitContainers)
+	}
+}
@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configclientset "github.com/openshift/client-go/config/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+)
+
+// Names of the cluster-wide config.openshift.io singletons
+const (
+	clusterConfigName  = "cluster"
+	clusterVersionName = "version"
+)
+
+// AddClusterDetails adds the console URL, infrastructure platform, OpenShift
+// version and identity providers of the cluster to details. Each is read on
+// its own, so that what the user may not read is noted in Unavailable and the
+// rest is still added. Users who may not read the ClusterVersion get the
+// OpenShift version estimated from details.KubernetesVersion.
+func (c *OpenShiftResourceClient) AddClusterDetails(ctx context.Context, details *ClusterDetails) error {
+	if !c.client.IsOpenShift() {
+		return fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	configClient := c.client.GetConfigClient()
+	if configClient == nil {
+		return fmt.Errorf("OpenShift config client not initialized")
+	}
+	config := configClient.ConfigV1()
+	details.OpenShift = true
+
+	if infra, err := config.Infrastructures().Get(ctx, clusterConfigName, metav1.GetOptions{}); err != nil {
+		details.Unavailable = append(details.Unavailable, fmt.Sprintf("infrastructure: %v", err))
+	} else {
+		applyInfrastructure(details, infra)
+	}
+
+	if version, err := config.ClusterVersions().Get(ctx, clusterVersionName, metav1.GetOptions{}); err != nil {
+		details.Unavailable = append(details.Unavailable, fmt.Sprintf("cluster version: %v", err))
+		estimateOpenShiftVersion(configClient, details)
+	} else {
+		applyClusterVersion(details, version)
+	}
+
+	if console, err := config.Consoles().Get(ctx, clusterConfigName, metav1.GetOptions{}); err != nil {
+		details.Unavailable = append(details.Unavailable, fmt.Sprintf("console: %v", err))
+	} else {
+		details.ConsoleURL = console.Status.ConsoleURL
+	}
+
+	if oauth, err := config.OAuths().Get(ctx, clusterConfigName, metav1.GetOptions{}); err != nil {
+		details.Unavailable = append(details.Unavailable, fmt.Sprintf("identity providers: %v", err))
+	} else {
+		details.IdentityProviders = convertIdentityProviders(oauth)
+	}
+
+	return nil
+}
+
+// applyInfrastructure copies the platform of the Infrastructure, and its API
+// URL unless the URL the client connects to is known
+func applyInfrastructure(details *ClusterDetails, infra *configv1.Infrastructure) {
+	// The deprecated platform field is only set alone on clusters installed
+	// before platformStatus existed
+	details.Platform = string(infra.Status.Platform)
+	if infra.Status.PlatformStatus != nil && infra.Status.PlatformStatus.Type != "" {
+		details.Platform = string(infra.Status.PlatformStatus.Type)
+	}
+	details.InfrastructureName = infra.Status.InfrastructureName
+	details.ControlPlaneTopology = string(infra.Status.ControlPlaneTopology)
+	if details.APIURL == "" {
+		details.APIURL = infra.Status.APIServerURL
+	}
+}
+
+// applyClusterVersion copies the version the cluster runs and its channel.
+// While an update is in progress the version is the last one completed.
+func applyClusterVersion(details *ClusterDetails, version *configv1.ClusterVersion) {
+	details.Channel = version.Spec.Channel
+	details.OpenShiftVersion = version.Status.Desired.Version
+	for _, update := range version.Status.History {
+		if update.State == configv1.CompletedUpdate {
+			if update.Version != details.OpenShiftVersion {
+				details.OpenShiftVersion = fmt.Sprintf("%s (updating to %s)", update.Version, details.OpenShiftVersion)
+			}
+			break
+		}
+	}
+}
+
+// estimateOpenShiftVersion sets the OpenShift minor version matching the
+// Kubernetes version of the API server. Every authenticated user may read the
+// discovery of config.openshift.io, serving ClusterVersions tells OpenShift 4
+// from older clusters, whose versions do not match.
+func estimateOpenShiftVersion(configClient configclientset.Interface, details *ClusterDetails) {
+	resources, err := configClient.Discovery().ServerResourcesForGroupVersion(configv1.GroupVersion.String())
+	if err != nil {
+		return
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == "clusterversions" {
+			if minor := openShiftMinorVersion(details.KubernetesVersion); minor != "" {
+				details.OpenShiftVersion = minor
+				details.VersionEstimated = true
+			}
+			return
+		}
+	}
+}
+
+// openShiftMinorVersion returns the OpenShift 4 minor version shipping a
+// Kubernetes version, e.g. "4.16" for "v1.29.6+aba1e8d", or "" when none does
+func openShiftMinorVersion(kubernetesVersion string) string {
+	version, err := utilversion.ParseGeneric(kubernetesVersion)
+	if err != nil || version.Major() != 1 {
+		return ""
+	}
+	// OpenShift 4.3 shipped Kubernetes 1.16, every minor version since one
+	// Kubernetes minor version, while 4.1 and 4.2 shipped 1.13 and 1.14
+	switch minor := version.Minor(); {
+	case minor >= 16:
+		return fmt.Sprintf("4.%d", minor-13)
+	case minor == 13 || minor == 14:
+		return fmt.Sprintf("4.%d", minor-12)
+	}
+	return ""
+}
+
+// convertIdentityProviders returns the identity providers of the OAuth config
+func convertIdentityProviders(oauth *configv1.OAuth) []IdentityProviderInfo {
+	providers := make([]IdentityProviderInfo, 0, len(oauth.Spec.IdentityProviders))
+	for _, idp := range oauth.Spec.IdentityProviders {
+		providers = append(providers, IdentityProviderInfo{
+			Name:          idp.Name,
+			Type:          string(idp.Type),
+			MappingMethod: string(idp.MappingMethod),
+		})
+	}
+	return providers
+}
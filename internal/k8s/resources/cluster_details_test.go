@@ -0,0 +1,106 @@
+package resources
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+)
+
+func TestApplyInfrastructure(t *testing.T) {
+	infra := &configv1.Infrastructure{Status: configv1.InfrastructureStatus{
+		InfrastructureName:   "shop-x7k2p",
+		APIServerURL:         "https://api.shop.example.com:6443",
+		Platform:             configv1.AWSPlatformType,
+		PlatformStatus:       &configv1.PlatformStatus{Type: configv1.GCPPlatformType},
+		ControlPlaneTopology: configv1.HighlyAvailableTopologyMode,
+	}}
+
+	details := &ClusterDetails{}
+	applyInfrastructure(details, infra)
+	if details.Platform != "GCP" || details.InfrastructureName != "shop-x7k2p" || details.ControlPlaneTopology != "HighlyAvailable" {
+		t.Errorf("Expected the platform status to win, got %+v", details)
+	}
+	if details.APIURL != "https://api.shop.example.com:6443" {
+		t.Errorf("Expected the API URL of the infrastructure, got %q", details.APIURL)
+	}
+
+	// The API URL the client connects to is kept
+	details = &ClusterDetails{APIURL: "https://10.0.0.1:6443"}
+	infra.Status.PlatformStatus = nil
+	applyInfrastructure(details, infra)
+	if details.APIURL != "https://10.0.0.1:6443" || details.Platform != "AWS" {
+		t.Errorf("Expected the connected API URL and the deprecated platform, got %+v", details)
+	}
+}
+
+func TestApplyClusterVersion(t *testing.T) {
+	version := &configv1.ClusterVersion{
+		Spec: configv1.ClusterVersionSpec{Channel: "stable-4.16"},
+		Status: configv1.ClusterVersionStatus{
+			Desired: configv1.Release{Version: "4.16.3"},
+			History: []configv1.UpdateHistory{{State: configv1.CompletedUpdate, Version: "4.16.3"}},
+		},
+	}
+
+	details := &ClusterDetails{}
+	applyClusterVersion(details, version)
+	if details.OpenShiftVersion != "4.16.3" || details.Channel != "stable-4.16" {
+		t.Errorf("Expected 4.16.3 on stable-4.16, got %q on %q", details.OpenShiftVersion, details.Channel)
+	}
+
+	version.Status.Desired.Version = "4.16.5"
+	version.Status.History = append([]configv1.UpdateHistory{{State: configv1.PartialUpdate, Version: "4.16.5"}}, version.Status.History...)
+	applyClusterVersion(details, version)
+	if details.OpenShiftVersion != "4.16.3 (updating to 4.16.5)" {
+		t.Errorf("Expected an update in progress, got %q", details.OpenShiftVersion)
+	}
+}
+
+func TestConvertIdentityProviders(t *testing.T) {
+	oauth := &configv1.OAuth{Spec: configv1.OAuthSpec{IdentityProviders: []configv1.IdentityProvider{
+		{Name: "corp-sso", MappingMethod: configv1.MappingMethodClaim, IdentityProviderConfig: configv1.IdentityProviderConfig{Type: configv1.IdentityProviderTypeOpenID}},
+		{Name: "htpasswd", IdentityProviderConfig: configv1.IdentityProviderConfig{Type: configv1.IdentityProviderTypeHTPasswd}},
+	}}}
+
+	providers := convertIdentityProviders(oauth)
+	if len(providers) != 2 || providers[0].Type != "OpenID" || providers[0].MappingMethod != "claim" || providers[1].Name != "htpasswd" {
+		t.Errorf("Expected both identity providers, got %+v", providers)
+	}
+}
+
+func TestOpenShiftMinorVersion(t *testing.T) {
+	for kubernetes, openShift := range map[string]string{
+		"v1.29.6+aba1e8d": "4.16",
+		"v1.33.2":         "4.20",
+		"v1.16.2+283af84": "4.3",
+		"v1.13.4+c2a5caf": "4.1",
+		"v1.15.0":         "",
+		"v1.11.0+d4cacc0": "",
+		"unknown":         "",
+	} {
+		if got := openShiftMinorVersion(kubernetes); got != openShift {
+			t.Errorf("openShiftMinorVersion(%q) = %q, want %q", kubernetes, got, openShift)
+		}
+	}
+}
+
+func TestEstimateOpenShiftVersion(t *testing.T) {
+	client := configfake.NewSimpleClientset()
+	details := &ClusterDetails{KubernetesVersion: "v1.29.6+aba1e8d"}
+	estimateOpenShiftVersion(client, details)
+	if details.OpenShiftVersion != "" {
+		t.Fatalf("Expected no estimate without config.openshift.io, got %q", details.OpenShiftVersion)
+	}
+
+	client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: configv1.GroupVersion.String(),
+		APIResources: []metav1.APIResource{{Name: "clusterversions"}, {Name: "infrastructures"}},
+	}}
+	estimateOpenShiftVersion(client, details)
+	if details.OpenShiftVersion != "4.16" || !details.VersionEstimated {
+		t.Errorf("Expected 4.16 estimated, got %+v", details)
+	}
+}
@@ -0,0 +1,130 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// podTemplateHashLabel is set on the pod template of each ReplicaSet of a
+// Deployment, it differs between every revision
+const podTemplateHashLabel = "pod-template-hash"
+
+// volatileMetadata are the metadata fields the API server sets, which differ
+// between any two objects without telling them apart
+var volatileMetadata = []string{"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "selfLink", "ownerReferences"}
+
+// volatileAnnotations are the annotations controllers and kubectl maintain
+var volatileAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	revisionAnnotation,
+	"deployment.kubernetes.io/revision-history",
+	"deployment.kubernetes.io/desired-replicas",
+	"deployment.kubernetes.io/max-replicas",
+}
+
+// NormalizeManifest prepares a YAML manifest for comparing with another:
+// the status and the metadata the API server maintains are dropped, as is the
+// namespace when dropNamespace is set, and keys are sorted
+func NormalizeManifest(manifest string, dropNamespace bool) (string, error) {
+	var object map[string]interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &object); err != nil {
+		return "", fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	delete(object, "status")
+	if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+		for _, field := range volatileMetadata {
+			delete(metadata, field)
+		}
+		if dropNamespace {
+			delete(metadata, "namespace")
+		}
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			for _, annotation := range volatileAnnotations {
+				delete(annotations, annotation)
+			}
+			if len(annotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+
+	out, err := yaml.Marshal(object)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	return string(out), nil
+}
+
+// RevisionTemplate is the pod template a Deployment rolled out in a revision
+type RevisionTemplate struct {
+	Revision   int
+	ReplicaSet string
+	Template   string
+}
+
+// RevisionTemplateLister lists the pod templates of the revisions of a
+// Deployment, kept in its ReplicaSets
+type RevisionTemplateLister interface {
+	// ListRevisionTemplates returns the pod template of each revision of a
+	// Deployment, the latest first
+	ListRevisionTemplates(ctx context.Context, namespace, name string) ([]RevisionTemplate, error)
+}
+
+// ListRevisionTemplates returns the pod template of each revision of a
+// Deployment, the latest first. The pod-template-hash label is dropped as it
+// differs between every revision.
+func (c *K8sResourceClient) ListRevisionTemplates(ctx context.Context, namespace, name string) ([]RevisionTemplate, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	deploy, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment %s/%s: %w", namespace, name, err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment %s: %w", name, err)
+	}
+	rsList, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replicasets for %s: %w", name, err)
+	}
+
+	return revisionTemplates(deploy, rsList.Items)
+}
+
+// revisionTemplates renders the pod templates of the ReplicaSets a
+// Deployment controls, the latest revision first
+func revisionTemplates(deploy *appsv1.Deployment, replicaSets []appsv1.ReplicaSet) ([]RevisionTemplate, error) {
+	var templates []RevisionTemplate
+	for _, rs := range replicaSets {
+		if !metav1.IsControlledBy(&rs, deploy) {
+			continue
+		}
+		revision, err := strconv.Atoi(rs.Annotations[revisionAnnotation])
+		if err != nil {
+			continue
+		}
+
+		template := rs.Spec.Template.DeepCopy()
+		delete(template.Labels, podTemplateHashLabel)
+		out, err := yaml.Marshal(template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the template of %s: %w", rs.Name, err)
+		}
+		templates = append(templates, RevisionTemplate{Revision: revision, ReplicaSet: rs.Name, Template: string(out)})
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Revision > templates[j].Revision
+	})
+	return templates, nil
+}
@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNormalizeManifest(t *testing.T) {
+	manifest := `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: shop
+  uid: 1234
+  resourceVersion: "42"
+  generation: 3
+  creationTimestamp: "2026-10-01T12:00:00Z"
+  annotations:
+    deployment.kubernetes.io/revision: "3"
+spec:
+  replicas: 2
+status:
+  readyReplicas: 2
+`
+	normalized, err := NormalizeManifest(manifest, true)
+	if err != nil {
+		t.Fatalf("NormalizeManifest() returned error: %v", err)
+	}
+	want := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\nspec:\n  replicas: 2\n"
+	if normalized != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, normalized)
+	}
+
+	kept, _ := NormalizeManifest(manifest, false)
+	if !strings.Contains(kept, "namespace: shop") {
+		t.Errorf("Expected the namespace to be kept, got:\n%s", kept)
+	}
+
+	if _, err := NormalizeManifest("- not\n- a map", false); err == nil {
+		t.Error("Expected a manifest that is not an object to fail")
+	}
+}
+
+func TestRevisionTemplates(t *testing.T) {
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", UID: "deploy-uid"}}
+	isController := true
+	owner := []metav1.OwnerReference{{Kind: "Deployment", Name: "web", UID: "deploy-uid", Controller: &isController}}
+	replicaSet := func(name, revision, image string, owners []metav1.OwnerReference) appsv1.ReplicaSet {
+		return appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: owners, Annotations: map[string]string{revisionAnnotation: revision}},
+			Spec: appsv1.ReplicaSetSpec{Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web", podTemplateHashLabel: name}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: image}}},
+			}},
+		}
+	}
+
+	templates, err := revisionTemplates(deploy, []appsv1.ReplicaSet{
+		replicaSet("web-1", "1", "web:1.0", owner),
+		replicaSet("web-10", "10", "web:1.2", owner),
+		replicaSet("web-2", "2", "web:1.1", owner),
+		replicaSet("other", "3", "other:1.0", nil),
+	})
+	if err != nil {
+		t.Fatalf("revisionTemplates() returned error: %v", err)
+	}
+	if len(templates) != 3 || templates[0].Revision != 10 || templates[1].Revision != 2 || templates[2].ReplicaSet != "web-1" {
+		t.Fatalf("Expected revisions 10, 2 and 1 of web, got %+v", templates)
+	}
+	if !strings.Contains(templates[0].Template, "image: web:1.2") || strings.Contains(templates[0].Template, podTemplateHashLabel) {
+		t.Errorf("Expected the template without the hash label, got:\n%s", templates[0].Template)
+	}
+}
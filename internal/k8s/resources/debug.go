@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// debugContainerPrefix starts the names of ephemeral debug containers, as
+// kubectl debug names them
+const debugContainerPrefix = "debugger-"
+
+// debugContainerPollInterval is the time between checks of a starting debug
+// container
+const debugContainerPollInterval = time.Second
+
+// PodDebugger attaches ephemeral debug containers to running pods like
+// 'kubectl debug', for images without a shell such as distroless ones
+type PodDebugger interface {
+	// AddDebugContainer adds an ephemeral container running image to a pod,
+	// sharing the process namespace of target, waits until it runs and
+	// returns its name
+	AddDebugContainer(ctx context.Context, namespace, pod, target, image string) (string, error)
+}
+
+// AddDebugContainer adds an ephemeral debug container through the
+// ephemeralcontainers subresource. In dry run the server validates it and
+// nothing is waited for.
+func (c *K8sResourceClient) AddDebugContainer(ctx context.Context, namespace, podName, target, image string) (string, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	pods := c.clientset.CoreV1().Pods(namespace)
+	pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get pod %s/%s: %w", namespace, podName, err)
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return "", fmt.Errorf("pod %s/%s is %s, debug containers need a running pod", namespace, podName, pod.Status.Phase)
+	}
+
+	container := debugContainer(debugContainerName(pod), image, target)
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, container)
+	_, err = pods.UpdateEphemeralContainers(ctx, podName, pod, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	if apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to add a debug container to %s/%s: the cluster does not support ephemeral containers", namespace, podName)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to add a debug container to %s/%s: %w", namespace, podName, err)
+	}
+	if IsDryRun(ctx) {
+		return container.Name, nil
+	}
+
+	err = wait.PollUntilContextCancel(ctx, debugContainerPollInterval, true, func(ctx context.Context) (bool, error) {
+		pod, err := pods.Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return debugContainerRunning(pod, container.Name)
+	})
+	if err != nil {
+		return "", fmt.Errorf("debug container %s in %s/%s did not start: %w", container.Name, namespace, podName, err)
+	}
+	return container.Name, nil
+}
+
+// debugContainerName returns a name for a new debug container that no
+// container of the pod has yet
+func debugContainerName(pod *corev1.Pod) string {
+	taken := make(map[string]bool)
+	for _, container := range pod.Spec.Containers {
+		taken[container.Name] = true
+	}
+	for _, container := range pod.Spec.InitContainers {
+		taken[container.Name] = true
+	}
+	for _, container := range pod.Spec.EphemeralContainers {
+		taken[container.Name] = true
+	}
+
+	for {
+		name := debugContainerPrefix + utilrand.String(5)
+		if !taken[name] {
+			return name
+		}
+	}
+}
+
+// debugContainer returns an interactive ephemeral container sharing the
+// process namespace of target, so its processes and files under
+// /proc/1/root can be inspected
+func debugContainer(name, image, target string) corev1.EphemeralContainer {
+	return corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     name,
+			Image:                    image,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		},
+		TargetContainerName: target,
+	}
+}
+
+// debugContainerRunning reports whether a debug container runs, with an
+// error once it cannot start or has exited
+func debugContainerRunning(pod *corev1.Pod, name string) (bool, error) {
+	for _, status := range pod.Status.EphemeralContainerStatuses {
+		if status.Name != name {
+			continue
+		}
+		switch {
+		case status.State.Running != nil:
+			return true, nil
+		case status.State.Terminated != nil:
+			return false, fmt.Errorf("it exited with %s", status.State.Terminated.Reason)
+		case status.State.Waiting != nil:
+			switch reason := status.State.Waiting.Reason; reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerError", "CreateContainerConfigError":
+				return false, fmt.Errorf("%s: %s", reason, status.State.Waiting.Message)
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDebugContainer(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		Containers:          []corev1.Container{{Name: "app"}},
+		EphemeralContainers: []corev1.EphemeralContainer{{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger-abcde"}}},
+	}}
+	name := debugContainerName(pod)
+	if !strings.HasPrefix(name, debugContainerPrefix) || name == "debugger-abcde" || len(name) != len("debugger-abcde") {
+		t.Errorf("Expected a new debugger-xxxxx name, got %q", name)
+	}
+
+	container := debugContainer(name, "busybox", "app")
+	if container.Image != "busybox" || container.TargetContainerName != "app" || !container.Stdin || !container.TTY {
+		t.Errorf("Expected an interactive busybox container targeting app, got %+v", container)
+	}
+}
+
+func TestDebugContainerRunning(t *testing.T) {
+	status := func(state corev1.ContainerState) *corev1.Pod {
+		return &corev1.Pod{Status: corev1.PodStatus{EphemeralContainerStatuses: []corev1.ContainerStatus{
+			{Name: "debugger-other", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			{Name: "debugger-abcde", State: state},
+		}}}
+	}
+
+	if running, err := debugContainerRunning(&corev1.Pod{}, "debugger-abcde"); running || err != nil {
+		t.Errorf("Expected a container without status to be waited for, got %v, %v", running, err)
+	}
+	if running, err := debugContainerRunning(status(corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}), "debugger-abcde"); running || err != nil {
+		t.Errorf("Expected a creating container to be waited for, got %v, %v", running, err)
+	}
+	if running, err := debugContainerRunning(status(corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}), "debugger-abcde"); !running || err != nil {
+		t.Errorf("Expected the container to run, got %v, %v", running, err)
+	}
+
+	_, err := debugContainerRunning(status(corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "busybox:nope not found"}}), "debugger-abcde")
+	if err == nil || !strings.Contains(err.Error(), "ImagePullBackOff") {
+		t.Errorf("Expected a failed pull to stop the wait, got %v", err)
+	}
+	_, err = debugContainerRunning(status(corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}}), "debugger-abcde")
+	if err == nil || !strings.Contains(err.Error(), "Completed") {
+		t.Errorf("Expected an exited container to stop the wait, got %v", err)
+	}
+}
@@ -13,7 +13,7 @@ func (c *K8sResourceClient) DeletePod(ctx context.Context, namespace, name strin
 		namespace = c.currentNamespace
 	}
 
-	err := c.clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err := c.clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return fmt.Errorf("failed to delete pod %s/%s: %w", namespace, name, err)
 	}
@@ -27,7 +27,7 @@ func (c *K8sResourceClient) DeleteService(ctx context.Context, namespace, name s
 		namespace = c.currentNamespace
 	}
 
-	err := c.clientset.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err := c.clientset.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return fmt.Errorf("failed to delete service %s/%s: %w", namespace, name, err)
 	}
@@ -41,7 +41,7 @@ func (c *K8sResourceClient) DeleteDeployment(ctx context.Context, namespace, nam
 		namespace = c.currentNamespace
 	}
 
-	err := c.clientset.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err := c.clientset.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return fmt.Errorf("failed to delete deployment %s/%s: %w", namespace, name, err)
 	}
@@ -55,7 +55,7 @@ func (c *K8sResourceClient) DeleteConfigMap(ctx context.Context, namespace, name
 		namespace = c.currentNamespace
 	}
 
-	err := c.clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err := c.clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return fmt.Errorf("failed to delete configmap %s/%s: %w", namespace, name, err)
 	}
@@ -69,7 +69,7 @@ func (c *K8sResourceClient) DeleteSecret(ctx context.Context, namespace, name st
 		namespace = c.currentNamespace
 	}
 
-	err := c.clientset.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err := c.clientset.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return fmt.Errorf("failed to delete secret %s/%s: %w", namespace, name, err)
 	}
@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// dryRunKey marks contexts whose mutating requests are dry runs
+type dryRunKey struct{}
+
+// WithDryRun returns a context under which mutating requests are sent with
+// dryRun=All: the server validates and admits them but persists nothing
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx asks for server-side dry runs
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
+
+// dryRunOption returns the DryRun value of create, update, patch, apply and
+// delete options for ctx
+func dryRunOption(ctx context.Context) []string {
+	if IsDryRun(ctx) {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+// dryRunChanged reports whether a dry-run apply would modify the existing
+// object. The server does not bump the resource version of a dry run, so the
+// objects are compared without the metadata it maintains.
+func dryRunChanged(existing, applied *unstructured.Unstructured) bool {
+	strip := func(obj *unstructured.Unstructured) map[string]interface{} {
+		copied := obj.DeepCopy()
+		for _, field := range []string{"managedFields", "resourceVersion", "generation"} {
+			unstructured.RemoveNestedField(copied.Object, "metadata", field)
+		}
+		return copied.Object
+	}
+	return !reflect.DeepEqual(strip(existing), strip(applied))
+}
@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDryRunOption(t *testing.T) {
+	ctx := context.Background()
+	if IsDryRun(ctx) || dryRunOption(ctx) != nil {
+		t.Errorf("Expected a plain context not to be a dry run")
+	}
+
+	ctx = WithDryRun(ctx)
+	if !IsDryRun(ctx) {
+		t.Fatalf("Expected WithDryRun to mark the context")
+	}
+	if opt := dryRunOption(ctx); len(opt) != 1 || opt[0] != metav1.DryRunAll {
+		t.Errorf("Expected dryRun=All, got %v", opt)
+	}
+}
+
+func TestDryRunChanged(t *testing.T) {
+	existing := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "settings",
+			"resourceVersion": "10",
+			"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+		"data": map[string]interface{}{"mode": "fast"},
+	}}
+
+	applied := existing.DeepCopy()
+	unstructured.SetNestedField(applied.Object, []interface{}{map[string]interface{}{"manager": "lazyoc"}}, "metadata", "managedFields")
+	if dryRunChanged(existing, applied) {
+		t.Errorf("Expected server-maintained metadata to be ignored")
+	}
+
+	unstructured.SetNestedField(applied.Object, "safe", "data", "mode")
+	if !dryRunChanged(existing, applied) {
+		t.Errorf("Expected a data change to be detected")
+	}
+}
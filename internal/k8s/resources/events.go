@@ -0,0 +1,161 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListEvents lists events in the specified namespace, most recent first
+func (c *K8sResourceClient) ListEvents(ctx context.Context, opts ListOptions) (*ResourceList[EventInfo], error) {
+	namespace := c.listNamespace(opts)
+
+	listOpts := metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	}
+
+	eventList, err := c.clientset.CoreV1().Events(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	events := make([]EventInfo, len(eventList.Items))
+	for i, event := range eventList.Items {
+		events[i] = convertEvent(&event)
+	}
+	sortEventsByLastSeen(events)
+
+	return &ResourceList[EventInfo]{
+		Items:     events,
+		Total:     len(events),
+		Namespace: namespace,
+		Continue:  eventList.Continue,
+	}, nil
+}
+
+// GetEventsFor returns the events related to a resource, most recent first.
+// Deployment events include those of the ReplicaSets and Pods it owns.
+func (c *K8sResourceClient) GetEventsFor(ctx context.Context, namespace, kind, name string) ([]EventInfo, error) {
+	list, err := c.ListEvents(ctx, ListOptions{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+	return EventsFor(list.Items, kind, name), nil
+}
+
+// EventsFor filters events down to those related to a resource. ReplicaSets and
+// Pods created by a workload are matched on the generated name prefix.
+func EventsFor(events []EventInfo, kind, name string) []EventInfo {
+	var related []EventInfo
+	for _, event := range events {
+		if eventRelatesTo(event, kind, name) {
+			related = append(related, event)
+		}
+	}
+	return related
+}
+
+// eventRelatesTo reports whether an event concerns the given resource
+func eventRelatesTo(event EventInfo, kind, name string) bool {
+	if strings.EqualFold(event.InvolvedKind, kind) && event.InvolvedName == name {
+		return true
+	}
+	switch strings.ToLower(kind) {
+	case "deployment":
+		if event.InvolvedKind != "ReplicaSet" && event.InvolvedKind != "Pod" {
+			return false
+		}
+	case "cronjob":
+		if event.InvolvedKind != "Job" && event.InvolvedKind != "Pod" {
+			return false
+		}
+	case "statefulset", "daemonset", "replicaset", "job":
+		if event.InvolvedKind != "Pod" {
+			return false
+		}
+	default:
+		return false
+	}
+	return strings.HasPrefix(event.InvolvedName, name+"-")
+}
+
+// WarningEvents returns only the Warning events
+func WarningEvents(events []EventInfo) []EventInfo {
+	var warnings []EventInfo
+	for _, event := range events {
+		if event.Type == corev1.EventTypeWarning {
+			warnings = append(warnings, event)
+		}
+	}
+	return warnings
+}
+
+// sortEventsByLastSeen orders events with the most recent first
+func sortEventsByLastSeen(events []EventInfo) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeen.After(events[j].LastSeen)
+	})
+}
+
+// eventLastSeen returns the most recent time an event was observed
+func eventLastSeen(event *corev1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// convertEvent converts a Kubernetes Event to EventInfo
+func convertEvent(event *corev1.Event) EventInfo {
+	count := event.Count
+	if event.Series != nil && event.Series.Count > count {
+		count = event.Series.Count
+	}
+	if count == 0 {
+		count = 1
+	}
+
+	source := event.Source.Component
+	if source == "" {
+		source = event.ReportingController
+	}
+
+	lastSeen := eventLastSeen(event)
+	return EventInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        event.Name,
+			Namespace:   event.Namespace,
+			Kind:        "Event",
+			APIVersion:  "v1",
+			Labels:      event.Labels,
+			Annotations: event.Annotations,
+			CreatedAt:   event.CreationTimestamp.Time,
+			Status:      event.Type,
+		},
+		Type:         event.Type,
+		Reason:       event.Reason,
+		Message:      strings.TrimSpace(event.Message),
+		InvolvedKind: event.InvolvedObject.Kind,
+		InvolvedName: event.InvolvedObject.Name,
+		Source:       source,
+		Count:        count,
+		LastSeen:     lastSeen,
+		Age:          formatAge(lastSeen),
+	}
+}
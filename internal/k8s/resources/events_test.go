@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvertEvent(t *testing.T) {
+	last := time.Now().Add(-2 * time.Minute)
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "web-1.abc", Namespace: "demo"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "web-1"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "FailedScheduling",
+		Message:        "0/3 nodes are available\n",
+		Count:          4,
+		Source:         corev1.EventSource{Component: "default-scheduler"},
+		FirstTimestamp: metav1.NewTime(last.Add(-time.Hour)),
+		LastTimestamp:  metav1.NewTime(last),
+	}
+
+	info := convertEvent(event)
+	if info.InvolvedKind != "Pod" || info.InvolvedName != "web-1" {
+		t.Errorf("Expected involved object Pod/web-1, got %s/%s", info.InvolvedKind, info.InvolvedName)
+	}
+	if info.Count != 4 || info.Source != "default-scheduler" {
+		t.Errorf("Expected count 4 from default-scheduler, got %d from %q", info.Count, info.Source)
+	}
+	if !info.LastSeen.Equal(last) {
+		t.Errorf("Expected last seen %v, got %v", last, info.LastSeen)
+	}
+	if info.Message != "0/3 nodes are available" {
+		t.Errorf("Expected trimmed message, got %q", info.Message)
+	}
+}
+
+func TestEventsFor(t *testing.T) {
+	events := []EventInfo{
+		{Type: "Normal", InvolvedKind: "Deployment", InvolvedName: "web", Reason: "ScalingReplicaSet"},
+		{Type: "Normal", InvolvedKind: "ReplicaSet", InvolvedName: "web-7d9f", Reason: "SuccessfulCreate"},
+		{Type: "Warning", InvolvedKind: "Pod", InvolvedName: "web-7d9f-x2k4", Reason: "BackOff"},
+		{Type: "Warning", InvolvedKind: "Pod", InvolvedName: "webhook-1", Reason: "Failed"},
+		{Type: "Warning", InvolvedKind: "Pod", InvolvedName: "api-1", Reason: "FailedScheduling"},
+	}
+
+	if got := EventsFor(events, "Deployment", "web"); len(got) != 3 {
+		t.Errorf("Expected 3 events for deployment web, got %d", len(got))
+	}
+	if got := EventsFor(events, "Pod", "api-1"); len(got) != 1 || got[0].Reason != "FailedScheduling" {
+		t.Errorf("Expected the FailedScheduling event for pod api-1, got %v", got)
+	}
+	if got := WarningEvents(EventsFor(events, "Deployment", "web")); len(got) != 1 {
+		t.Errorf("Expected 1 warning for deployment web, got %d", len(got))
+	}
+	if got := EventsFor(events, "StatefulSet", "api"); len(got) != 1 || got[0].InvolvedName != "api-1" {
+		t.Errorf("Expected the pod event of statefulset api, got %v", got)
+	}
+}
@@ -0,0 +1,273 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// lastAppliedAnnotation holds the manifest of the last 'kubectl apply'
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// kindGroups pins the API group of the OpenShift kinds
+var kindGroups = map[string]string{
+	"Build":            "build.openshift.io",
+	"BuildConfig":      "build.openshift.io",
+	"ImageStream":      "image.openshift.io",
+	"Route":            "route.openshift.io",
+	"DeploymentConfig": "apps.openshift.io",
+}
+
+// GetFieldOwnership returns the field managers of a resource and the fields
+// that drifted from its last-applied-configuration
+func (c *K8sResourceClient) GetFieldOwnership(ctx context.Context, kind, namespace, name string) (*FieldOwnership, error) {
+	if c.restConfig == nil {
+		return nil, fmt.Errorf("REST config not available for field ownership")
+	}
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(c.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.clientset.Discovery()))
+
+	kind = kindName(kind)
+	gvk, err := mapper.KindFor(schema.GroupVersionResource{Group: kindGroups[kind], Resource: strings.ToLower(kind)})
+	if err != nil {
+		return nil, fmt.Errorf("unknown kind %s: %w", kind, err)
+	}
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("unknown kind %s: %w", kind, err)
+	}
+
+	var obj *unstructured.Unstructured
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		obj, err = dynamicClient.Resource(mapping.Resource).Get(ctx, name, metav1.GetOptions{})
+	} else {
+		obj, err = dynamicClient.Resource(mapping.Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return fieldOwnership(kind, obj)
+}
+
+// fieldOwnership summarizes the managed fields and last-applied drift of an object
+func fieldOwnership(kind string, obj *unstructured.Unstructured) (*FieldOwnership, error) {
+	ownership := &FieldOwnership{}
+	for _, entry := range obj.GetManagedFields() {
+		manager := FieldManager{
+			Manager:     entry.Manager,
+			Operation:   string(entry.Operation),
+			Subresource: entry.Subresource,
+		}
+		if entry.Time != nil {
+			manager.Age = formatAge(entry.Time.Time)
+		}
+		if entry.FieldsV1 != nil {
+			fields, err := managedFieldPaths(entry.FieldsV1.Raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid managed fields of %s: %w", entry.Manager, err)
+			}
+			manager.Fields = fields
+		}
+		ownership.Managers = append(ownership.Managers, manager)
+	}
+
+	applied, ok := obj.GetAnnotations()[lastAppliedAnnotation]
+	if !ok {
+		return ownership, nil
+	}
+	ownership.LastApplied = true
+
+	drift, err := lastAppliedDrift(applied, obj.Object)
+	if err != nil {
+		return nil, err
+	}
+	if kind == "Secret" {
+		redactSecretDrift(drift)
+	}
+	ownership.Drift = drift
+	return ownership, nil
+}
+
+// managedFieldPaths flattens a FieldsV1 set into the paths of the fields it
+// holds, such as spec.replicas or spec.template.spec.containers[name=web].image
+func managedFieldPaths(raw []byte) ([]string, error) {
+	var set map[string]interface{}
+	if err := json.Unmarshal(raw, &set); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	var walk func(prefix string, node map[string]interface{})
+	walk = func(prefix string, node map[string]interface{}) {
+		leaf := true
+		for key, child := range node {
+			if key == "." {
+				continue
+			}
+			leaf = false
+			next, ok := child.(map[string]interface{})
+			if !ok {
+				next = nil
+			}
+			walk(joinFieldPath(prefix, key), next)
+		}
+		if leaf && prefix != "" {
+			paths = append(paths, prefix)
+		}
+	}
+	walk("", set)
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// joinFieldPath appends a FieldsV1 key to a path: f:name is a field, k:{...}
+// a list item by its keys, v:value a set item and i:n a list index
+func joinFieldPath(prefix, key string) string {
+	switch {
+	case strings.HasPrefix(key, "f:"):
+		if prefix == "" {
+			return key[2:]
+		}
+		return prefix + "." + key[2:]
+	case strings.HasPrefix(key, "k:"):
+		var keys map[string]interface{}
+		if err := json.Unmarshal([]byte(key[2:]), &keys); err != nil {
+			return prefix + "[" + key[2:] + "]"
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		parts := make([]string, 0, len(names))
+		for _, name := range names {
+			parts = append(parts, fmt.Sprintf("%s=%v", name, keys[name]))
+		}
+		return prefix + "[" + strings.Join(parts, ",") + "]"
+	case strings.HasPrefix(key, "v:"):
+		return prefix + "[" + strings.Trim(key[2:], `"`) + "]"
+	case strings.HasPrefix(key, "i:"):
+		return prefix + "[" + key[2:] + "]"
+	}
+	return prefix + "." + key
+}
+
+// lastAppliedDrift compares an applied manifest with the live object and
+// returns the fields whose value changed since. Fields the manifest does not
+// set are not compared, and neither is the status.
+func lastAppliedDrift(applied string, live map[string]interface{}) ([]AppliedDrift, error) {
+	var manifest map[string]interface{}
+	if err := json.Unmarshal([]byte(applied), &manifest); err != nil {
+		return nil, fmt.Errorf("invalid last-applied-configuration: %w", err)
+	}
+	delete(manifest, "status")
+
+	var drift []AppliedDrift
+	var walk func(path string, applied, live interface{}, found bool)
+	walk = func(path string, applied, live interface{}, found bool) {
+		switch value := applied.(type) {
+		case map[string]interface{}:
+			liveMap, _ := live.(map[string]interface{})
+			for key, child := range value {
+				liveChild, ok := liveMap[key]
+				walk(joinDriftPath(path, key), child, liveChild, ok)
+			}
+			return
+		case []interface{}:
+			if liveList, ok := live.([]interface{}); ok && namedItems(value) && namedItems(liveList) {
+				for _, item := range value {
+					itemName := item.(map[string]interface{})["name"]
+					liveItem, ok := findNamedItem(liveList, itemName)
+					walk(fmt.Sprintf("%s[name=%v]", path, itemName), item, liveItem, ok)
+				}
+				return
+			}
+		}
+
+		appliedJSON, liveJSON := compactJSON(applied), "<unset>"
+		if found {
+			liveJSON = compactJSON(live)
+		}
+		if appliedJSON != liveJSON {
+			drift = append(drift, AppliedDrift{Path: path, Applied: appliedJSON, Live: liveJSON})
+		}
+	}
+	walk("", manifest, live, true)
+
+	sort.Slice(drift, func(i, j int) bool { return drift[i].Path < drift[j].Path })
+	return drift, nil
+}
+
+// joinDriftPath appends a map key to a path
+func joinDriftPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// namedItems reports whether every item of a list is an object with a name,
+// such as containers or ports, so items can be matched by name
+func namedItems(list []interface{}) bool {
+	for _, item := range list {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := object["name"]; !ok {
+			return false
+		}
+	}
+	return len(list) > 0
+}
+
+// findNamedItem returns the item of a list with the given name
+func findNamedItem(list []interface{}, name interface{}) (interface{}, bool) {
+	for _, item := range list {
+		if item.(map[string]interface{})["name"] == name {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// compactJSON renders a value as compact JSON, so numbers decoded as
+// float64 and int64 compare equal
+func compactJSON(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// redactSecretDrift hides the values of drifted Secret data
+func redactSecretDrift(drift []AppliedDrift) {
+	for i := range drift {
+		if strings.HasPrefix(drift[i].Path, "data") || strings.HasPrefix(drift[i].Path, "stringData") {
+			drift[i].Applied = redactedValue
+			if drift[i].Live != "<unset>" {
+				drift[i].Live = redactedValue
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestManagedFieldPaths(t *testing.T) {
+	raw := []byte(`{
+		"f:metadata": {"f:labels": {".": {}, "f:app": {}}},
+		"f:spec": {
+			"f:replicas": {},
+			"f:template": {"f:spec": {"f:containers": {"k:{\"name\":\"web\"}": {".": {}, "f:image": {}}}}},
+			"f:finalizers": {"v:\"example.com/guard\"": {}}
+		}
+	}`)
+
+	paths, err := managedFieldPaths(raw)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []string{
+		"metadata.labels.app",
+		"spec.finalizers[example.com/guard]",
+		"spec.replicas",
+		"spec.template.spec.containers[name=web].image",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("Expected %v, got %v", want, paths)
+	}
+}
+
+func TestLastAppliedDrift(t *testing.T) {
+	applied := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","labels":{"tier":"frontend"}},` +
+		`"spec":{"replicas":2,"template":{"spec":{"containers":[{"name":"web","image":"web:1"},{"name":"proxy","image":"envoy"}]}}}}`
+	live := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web"},
+		"spec": map[string]interface{}{
+			"replicas": int64(5),
+			"template": map[string]interface{}{"spec": map[string]interface{}{"containers": []interface{}{
+				map[string]interface{}{"name": "web", "image": "web:2"},
+				map[string]interface{}{"name": "proxy", "image": "envoy"},
+			}}},
+		},
+	}
+
+	drift, err := lastAppliedDrift(applied, live)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []AppliedDrift{
+		{Path: "metadata.labels.tier", Applied: `"frontend"`, Live: "<unset>"},
+		{Path: "spec.replicas", Applied: "2", Live: "5"},
+		{Path: "spec.template.spec.containers[name=web].image", Applied: `"web:1"`, Live: `"web:2"`},
+	}
+	if !reflect.DeepEqual(drift, want) {
+		t.Errorf("Expected %+v, got %+v", want, drift)
+	}
+
+	if _, err := lastAppliedDrift("not json", live); err == nil {
+		t.Errorf("Expected an error for an invalid annotation")
+	}
+}
+
+func TestFieldOwnershipRedactsSecrets(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "creds"},
+		"data":     map[string]interface{}{"password": "bmV3"},
+	}}
+	obj.SetAnnotations(map[string]string{lastAppliedAnnotation: `{"data":{"password":"b2xk"}}`})
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{{
+		Manager:   "kubectl-client-side-apply",
+		Operation: metav1.ManagedFieldsOperationUpdate,
+		FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:password":{}}}`)},
+	}})
+
+	ownership, err := fieldOwnership("Secret", obj)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ownership.Managers) != 1 || ownership.Managers[0].Operation != "Update" || ownership.Managers[0].Fields[0] != "data.password" {
+		t.Errorf("Expected the kubectl manager of data.password, got %+v", ownership.Managers)
+	}
+	if !ownership.LastApplied || len(ownership.Drift) != 1 || ownership.Drift[0].Applied != redactedValue || ownership.Drift[0].Live != redactedValue {
+		t.Errorf("Expected the drifted password to be redacted, got %+v", ownership.Drift)
+	}
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// Control-plane health sources
+const (
+	HealthSourceReadyz          = "readyz"
+	HealthSourceComponentStatus = "componentstatus"
+	HealthSourceClusterOperator = "clusteroperator"
+)
+
+// GetControlPlaneHealth probes the API server readiness checks and the
+// (deprecated) componentstatuses API. Probes that are forbidden or unavailable
+// are skipped; an error is returned only if every probe fails.
+func (c *K8sResourceClient) GetControlPlaneHealth(ctx context.Context) ([]ComponentHealthInfo, error) {
+	var components []ComponentHealthInfo
+	var errs []string
+
+	body, err := c.clientset.Discovery().RESTClient().Get().
+		AbsPath(constants.ReadyzEndpoint).
+		Param("verbose", "true").
+		DoRaw(ctx)
+	if err != nil && len(body) == 0 {
+		errs = append(errs, fmt.Sprintf("readyz: %v", err))
+	} else {
+		// A failing readyz returns 500 but still carries the verbose check list
+		components = append(components, parseReadyzOutput(string(body))...)
+	}
+
+	statuses, err := c.clientset.CoreV1().ComponentStatuses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		errs = append(errs, fmt.Sprintf("componentstatuses: %v", err))
+	} else {
+		for _, cs := range statuses.Items {
+			info := ComponentHealthInfo{
+				Name:   cs.Name,
+				Source: HealthSourceComponentStatus,
+				Status: "Unknown",
+			}
+			for _, cond := range cs.Conditions {
+				if cond.Type != "Healthy" {
+					continue
+				}
+				info.Healthy = cond.Status == "True"
+				if info.Healthy {
+					info.Status = "Healthy"
+				} else {
+					info.Status = "Unhealthy"
+				}
+				info.Message = cond.Message
+				if cond.Error != "" {
+					info.Message = cond.Error
+				}
+			}
+			components = append(components, info)
+		}
+	}
+
+	if len(components) == 0 && len(errs) > 0 {
+		return nil, fmt.Errorf("failed to probe control plane health: %s", strings.Join(errs, "; "))
+	}
+
+	return components, nil
+}
+
+// parseReadyzOutput parses the verbose output of the /readyz endpoint, e.g.
+//
+//	[+]ping ok
+//	[-]etcd failed: reason withheld
+func parseReadyzOutput(body string) []ComponentHealthInfo {
+	var components []ComponentHealthInfo
+
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) < 4 || line[0] != '[' || line[2] != ']' {
+			continue
+		}
+
+		healthy := line[1] == '+'
+		if !healthy && line[1] != '-' {
+			continue
+		}
+
+		name, rest, _ := strings.Cut(line[3:], " ")
+		info := ComponentHealthInfo{
+			Name:    name,
+			Source:  HealthSourceReadyz,
+			Healthy: healthy,
+			Status:  "Healthy",
+		}
+		if !healthy {
+			info.Status = "Unhealthy"
+			if msg, ok := strings.CutPrefix(rest, "failed:"); ok {
+				info.Message = strings.TrimSpace(msg)
+			}
+		}
+		components = append(components, info)
+	}
+
+	return components
+}
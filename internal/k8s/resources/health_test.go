@@ -0,0 +1,53 @@
+package resources
+
+import "testing"
+
+func TestParseReadyzOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []ComponentHealthInfo
+	}{
+		{
+			name:     "empty body",
+			body:     "",
+			expected: nil,
+		},
+		{
+			name: "all checks passing",
+			body: "[+]ping ok\n[+]etcd ok\nreadyz check passed\n",
+			expected: []ComponentHealthInfo{
+				{Name: "ping", Source: HealthSourceReadyz, Healthy: true, Status: "Healthy"},
+				{Name: "etcd", Source: HealthSourceReadyz, Healthy: true, Status: "Healthy"},
+			},
+		},
+		{
+			name: "failing check with reason",
+			body: "[+]ping ok\n[-]etcd failed: reason withheld\nreadyz check failed\n",
+			expected: []ComponentHealthInfo{
+				{Name: "ping", Source: HealthSourceReadyz, Healthy: true, Status: "Healthy"},
+				{Name: "etcd", Source: HealthSourceReadyz, Healthy: false, Status: "Unhealthy", Message: "reason withheld"},
+			},
+		},
+		{
+			name: "post-start hooks",
+			body: "[+]poststarthook/start-kube-apiserver-admission-initializer ok",
+			expected: []ComponentHealthInfo{
+				{Name: "poststarthook/start-kube-apiserver-admission-initializer", Source: HealthSourceReadyz, Healthy: true, Status: "Healthy"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		result := parseReadyzOutput(test.body)
+		if len(result) != len(test.expected) {
+			t.Errorf("%s: expected %d components, got %d", test.name, len(test.expected), len(result))
+			continue
+		}
+		for i := range result {
+			if result[i] != test.expected[i] {
+				t.Errorf("%s: component %d = %+v, expected %+v", test.name, i, result[i], test.expected[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Identity sources
+const (
+	IdentitySourceOpenShiftUser     = "user.openshift.io"
+	IdentitySourceSelfSubjectReview = "SelfSubjectReview"
+	IdentitySourceKubeconfig        = "kubeconfig"
+)
+
+// openShiftUserResource is the OpenShift user API; the user "~" is the caller
+var openShiftUserResource = schema.GroupVersionResource{
+	Group:    "user.openshift.io",
+	Version:  "v1",
+	Resource: "users",
+}
+
+// WhoAmI returns the authenticated identity using a SelfSubjectReview
+func (c *K8sResourceClient) WhoAmI(ctx context.Context) (*UserIdentity, error) {
+	review, err := c.clientset.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review own identity: %w", err)
+	}
+
+	return newUserIdentity(review.Status.UserInfo.Username, review.Status.UserInfo.Groups, IdentitySourceSelfSubjectReview), nil
+}
+
+// WhoAmI returns the authenticated OpenShift user, like 'oc whoami'
+func (c *OpenShiftResourceClient) WhoAmI(ctx context.Context) (*UserIdentity, error) {
+	if !c.client.IsOpenShift() {
+		return nil, fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	user, err := c.client.GetDynamicClient().Resource(openShiftUserResource).Get(ctx, "~", metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	groups, _, _ := unstructured.NestedStringSlice(user.Object, "groups")
+	return newUserIdentity(user.GetName(), groups, IdentitySourceOpenShiftUser), nil
+}
+
+// newUserIdentity builds a UserIdentity with sorted groups
+func newUserIdentity(username string, groups []string, source string) *UserIdentity {
+	sorted := append([]string(nil), groups...)
+	sort.Strings(sorted)
+	return &UserIdentity{
+		Username: username,
+		Groups:   sorted,
+		Source:   source,
+	}
+}
+
+// openShiftAccessTokenResource lists the OAuth access tokens of the caller
+var openShiftAccessTokenResource = schema.GroupVersionResource{
+	Group:    "oauth.openshift.io",
+	Version:  "v1",
+	Resource: "useroauthaccesstokens",
+}
+
+// TokenExpiry returns when the OAuth access token stored under objectName
+// expires. The bool is false for tokens that never expire.
+func (c *OpenShiftResourceClient) TokenExpiry(ctx context.Context, objectName string) (time.Time, bool, error) {
+	if !c.client.IsOpenShift() {
+		return time.Time{}, false, fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	token, err := c.client.GetDynamicClient().Resource(openShiftAccessTokenResource).Get(ctx, objectName, metav1.GetOptions{})
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	expiresAt, ok := accessTokenExpiry(token)
+	return expiresAt, ok, nil
+}
+
+// accessTokenExpiry computes the expiry of a UserOAuthAccessToken from its
+// creation time and expiresIn seconds; zero expiresIn means no expiry
+func accessTokenExpiry(token *unstructured.Unstructured) (time.Time, bool) {
+	expiresIn, found, err := unstructured.NestedInt64(token.Object, "expiresIn")
+	if err != nil || !found || expiresIn <= 0 {
+		return time.Time{}, false
+	}
+	return token.GetCreationTimestamp().Add(time.Duration(expiresIn) * time.Second), true
+}
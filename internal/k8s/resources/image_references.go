@@ -0,0 +1,199 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ImageTriggersAnnotation lists the ImageStreamTags whose images are set in
+// the containers of a Deployment, StatefulSet or DaemonSet
+const ImageTriggersAnnotation = "image.openshift.io/triggers"
+
+// imageStreamTagKind is the kind of the references checked, references to
+// DockerImage or ImageStreamImage are not
+const imageStreamTagKind = "ImageStreamTag"
+
+// String returns the reference as namespace/stream:tag
+func (r ImageStreamTagRef) String() string {
+	return fmt.Sprintf("%s/%s:%s", r.Namespace, r.Stream, r.Tag)
+}
+
+// parseImageStreamTag parses an ImageStreamTag name, "stream:tag" or
+// "stream" for its latest tag, defaulting to the namespace of the object
+// referencing it
+func parseImageStreamTag(name, namespace, defaultNamespace string) (ImageStreamTagRef, bool) {
+	if name == "" {
+		return ImageStreamTagRef{}, false
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	stream, tag, ok := strings.Cut(name, ":")
+	if !ok {
+		tag = "latest"
+	}
+	if stream == "" || tag == "" {
+		return ImageStreamTagRef{}, false
+	}
+	return ImageStreamTagRef{Namespace: namespace, Stream: stream, Tag: tag}, true
+}
+
+// BuildConfigImageRef returns the ImageStreamTag a BuildConfig pushes to
+func BuildConfigImageRef(bc BuildConfigInfo) (ImageStreamTagRef, bool) {
+	to := bc.Output.To
+	if to == nil || to.Kind != imageStreamTagKind {
+		return ImageStreamTagRef{}, false
+	}
+	return parseImageStreamTag(to.Name, to.Namespace, bc.Namespace)
+}
+
+// DeploymentImageRefs returns the ImageStreamTags of the image triggers
+// annotation of a Deployment. A malformed annotation has no references.
+func DeploymentImageRefs(deploy DeploymentInfo) []ImageStreamTagRef {
+	annotation := deploy.Annotations[ImageTriggersAnnotation]
+	if annotation == "" {
+		return nil
+	}
+
+	var triggers []struct {
+		From struct {
+			Kind      string `json:"kind"`
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"from"`
+	}
+	if err := json.Unmarshal([]byte(annotation), &triggers); err != nil {
+		return nil
+	}
+
+	var refs []ImageStreamTagRef
+	for _, trigger := range triggers {
+		if trigger.From.Kind != imageStreamTagKind {
+			continue
+		}
+		if ref, ok := parseImageStreamTag(trigger.From.Name, trigger.From.Namespace, deploy.Namespace); ok {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// DeploymentConfigImageRefs returns the ImageStreamTags of the image change
+// triggers of a DeploymentConfig
+func DeploymentConfigImageRefs(dc DeploymentConfigInfo) []ImageStreamTagRef {
+	var refs []ImageStreamTagRef
+	for _, trigger := range dc.Triggers {
+		if trigger.ImageChange == nil || trigger.ImageChange.From == nil || trigger.ImageChange.From.Kind != imageStreamTagKind {
+			continue
+		}
+		from := trigger.ImageChange.From
+		if ref, ok := parseImageStreamTag(from.Name, from.Namespace, dc.Namespace); ok {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// FindDanglingImageReferences lists the ImageStreams of every namespace the
+// BuildConfigs, Deployments and DeploymentConfigs reference, and returns the
+// references to ImageStreamTags that do not exist. References into namespaces
+// whose ImageStreams may not be listed are not checked; an error is returned
+// only if no namespace could be listed.
+func (c *OpenShiftResourceClient) FindDanglingImageReferences(ctx context.Context, buildConfigs []BuildConfigInfo, deployments []DeploymentInfo, deploymentConfigs []DeploymentConfigInfo) ([]DanglingImageReference, error) {
+	namespaces := make(map[string]bool)
+	for _, bc := range buildConfigs {
+		if ref, ok := BuildConfigImageRef(bc); ok {
+			namespaces[ref.Namespace] = true
+		}
+	}
+	for _, deploy := range deployments {
+		for _, ref := range DeploymentImageRefs(deploy) {
+			namespaces[ref.Namespace] = true
+		}
+	}
+	for _, dc := range deploymentConfigs {
+		for _, ref := range DeploymentConfigImageRefs(dc) {
+			namespaces[ref.Namespace] = true
+		}
+	}
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+
+	streams := make(map[string][]ImageStreamInfo, len(namespaces))
+	var errs []string
+	for namespace := range namespaces {
+		list, err := c.ListImageStreams(ctx, ListOptions{Namespace: namespace})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", namespace, err))
+			continue
+		}
+		streams[namespace] = list.Items
+	}
+	if len(streams) == 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+
+	return danglingImageReferences(streams, buildConfigs, deployments, deploymentConfigs), nil
+}
+
+// danglingImageReferences checks the references against the ImageStreams
+// listed per namespace. A BuildConfig creates the tag it pushes to, so its
+// missing tag is only dangling once a build succeeded; the ImageStream must
+// exist before the first build.
+func danglingImageReferences(streams map[string][]ImageStreamInfo, buildConfigs []BuildConfigInfo, deployments []DeploymentInfo, deploymentConfigs []DeploymentConfigInfo) []DanglingImageReference {
+	tags := make(map[string]map[string]bool)
+	for namespace, list := range streams {
+		for _, is := range list {
+			streamTags := make(map[string]bool, len(is.Tags))
+			for _, tag := range is.Tags {
+				streamTags[tag.Name] = true
+			}
+			tags[namespace+"/"+is.Name] = streamTags
+		}
+	}
+
+	// check returns why a reference dangles, or "" when it exists or its
+	// namespace was not listed
+	check := func(ref ImageStreamTagRef, tagCreated bool) string {
+		if _, listed := streams[ref.Namespace]; !listed {
+			return ""
+		}
+		streamTags, ok := tags[ref.Namespace+"/"+ref.Stream]
+		switch {
+		case !ok:
+			return fmt.Sprintf("imagestream %s/%s not found", ref.Namespace, ref.Stream)
+		case !streamTags[ref.Tag] && !tagCreated:
+			return fmt.Sprintf("tag %s not found in imagestream %s/%s", ref.Tag, ref.Namespace, ref.Stream)
+		}
+		return ""
+	}
+
+	var dangling []DanglingImageReference
+	add := func(kind, namespace, name string, ref ImageStreamTagRef, tagCreated bool) {
+		if reason := check(ref, tagCreated); reason != "" {
+			dangling = append(dangling, DanglingImageReference{Kind: kind, Namespace: namespace, Name: name, Ref: ref, Reason: reason})
+		}
+	}
+
+	for _, bc := range buildConfigs {
+		if ref, ok := BuildConfigImageRef(bc); ok {
+			add("BuildConfig", bc.Namespace, bc.Name, ref, bc.SuccessBuilds == 0)
+		}
+	}
+	for _, deploy := range deployments {
+		for _, ref := range DeploymentImageRefs(deploy) {
+			add("Deployment", deploy.Namespace, deploy.Name, ref, false)
+		}
+	}
+	for _, dc := range deploymentConfigs {
+		for _, ref := range DeploymentConfigImageRefs(dc) {
+			add("DeploymentConfig", dc.Namespace, dc.Name, ref, false)
+		}
+	}
+	return dangling
+}
@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"testing"
+)
+
+func TestDeploymentImageRefs(t *testing.T) {
+	deploy := DeploymentInfo{ResourceInfo: ResourceInfo{Name: "web", Namespace: "shop", Annotations: map[string]string{
+		ImageTriggersAnnotation: `[{"from":{"kind":"ImageStreamTag","name":"web:prod"},"fieldPath":"spec.template.spec.containers[?(@.name==\"web\")].image"},` +
+			`{"from":{"kind":"ImageStreamTag","name":"nginx","namespace":"openshift"},"fieldPath":"spec.template.spec.containers[0].image"},` +
+			`{"from":{"kind":"DockerImage","name":"quay.io/shop/web:1"}}]`,
+	}}}
+
+	refs := DeploymentImageRefs(deploy)
+	if len(refs) != 2 {
+		t.Fatalf("Expected the two ImageStreamTag triggers, got %v", refs)
+	}
+	if refs[0].String() != "shop/web:prod" || refs[1].String() != "openshift/nginx:latest" {
+		t.Errorf("Expected shop/web:prod and openshift/nginx:latest, got %v", refs)
+	}
+
+	deploy.Annotations[ImageTriggersAnnotation] = "not json"
+	if refs := DeploymentImageRefs(deploy); len(refs) != 0 {
+		t.Errorf("Expected no references from a malformed annotation, got %v", refs)
+	}
+}
+
+func TestDanglingImageReferences(t *testing.T) {
+	streams := map[string][]ImageStreamInfo{
+		"shop": {{ResourceInfo: ResourceInfo{Name: "web"}, Tags: []ImageStreamTag{{Name: "latest"}}}},
+	}
+	output := func(name, to string, successBuilds int) BuildConfigInfo {
+		return BuildConfigInfo{
+			ResourceInfo:  ResourceInfo{Name: name, Namespace: "shop"},
+			Output:        BuildOutput{To: &BuildOutputTo{Kind: "ImageStreamTag", Name: to}},
+			SuccessBuilds: successBuilds,
+		}
+	}
+	buildConfigs := []BuildConfigInfo{
+		output("web", "web:latest", 3),
+		// The first build creates the tag
+		output("web-prod", "web:prod", 0),
+		output("web-stale", "web:stale", 2),
+		output("api", "api:latest", 0),
+		{ResourceInfo: ResourceInfo{Name: "push", Namespace: "shop"}, Output: BuildOutput{To: &BuildOutputTo{Kind: "DockerImage", Name: "quay.io/shop/web"}}},
+	}
+	deploymentConfigs := []DeploymentConfigInfo{{
+		ResourceInfo: ResourceInfo{Name: "worker", Namespace: "shop"},
+		Triggers: []DeploymentTrigger{
+			{Type: "ConfigChange"},
+			{Type: "ImageChange", ImageChange: &DeploymentTriggerImageChange{From: &ImageStreamReference{Kind: "ImageStreamTag", Name: "web:canary"}}},
+			// The openshift namespace was not listed, its references are not checked
+			{Type: "ImageChange", ImageChange: &DeploymentTriggerImageChange{From: &ImageStreamReference{Kind: "ImageStreamTag", Namespace: "openshift", Name: "nginx:1.24"}}},
+		},
+	}}
+
+	dangling := danglingImageReferences(streams, buildConfigs, nil, deploymentConfigs)
+	want := []string{
+		"BuildConfig web-stale: tag stale not found in imagestream shop/web",
+		"BuildConfig api: imagestream shop/api not found",
+		"DeploymentConfig worker: tag canary not found in imagestream shop/web",
+	}
+	if len(dangling) != len(want) {
+		t.Fatalf("Expected %d dangling references, got %+v", len(want), dangling)
+	}
+	for i, ref := range dangling {
+		if got := ref.Kind + " " + ref.Name + ": " + ref.Reason; got != want[i] {
+			t.Errorf("Expected %q, got %q", want[i], got)
+		}
+	}
+}
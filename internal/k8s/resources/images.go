@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListImageInventory lists every unique container image running in a
+// namespace, or in all namespaces, with the workloads using it. Pods owned by
+// a ReplicaSet or Job are attributed to their Deployment or CronJob.
+func (c *K8sResourceClient) ListImageInventory(ctx context.Context, namespace string, allNamespaces bool) ([]ImageUsage, error) {
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	} else if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	return buildImageInventory(pods.Items, c.workloadOwners(ctx, namespace)), nil
+}
+
+// workloadOwners maps the ReplicaSets and Jobs of a namespace, as
+// namespace/Kind/name, to the Kind/name of their controller. Owners are best
+// effort, pods fall back to their direct controller when they cannot be listed.
+func (c *K8sResourceClient) workloadOwners(ctx context.Context, namespace string) map[string]string {
+	owners := make(map[string]string)
+	if replicaSets, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range replicaSets.Items {
+			if ref := metav1.GetControllerOf(&replicaSets.Items[i]); ref != nil {
+				owners[workloadKey(replicaSets.Items[i].Namespace, "ReplicaSet", replicaSets.Items[i].Name)] = ref.Kind + "/" + ref.Name
+			}
+		}
+	}
+	if jobs, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+		for i := range jobs.Items {
+			if ref := metav1.GetControllerOf(&jobs.Items[i]); ref != nil {
+				owners[workloadKey(jobs.Items[i].Namespace, "Job", jobs.Items[i].Name)] = ref.Kind + "/" + ref.Name
+			}
+		}
+	}
+	return owners
+}
+
+// workloadKey identifies a workload as namespace/Kind/name
+func workloadKey(namespace, kind, name string) string {
+	return namespace + "/" + kind + "/" + name
+}
+
+// podWorkload returns the workload a pod belongs to as namespace/Kind/name,
+// following ReplicaSets and Jobs up to the owners found in owners
+func podWorkload(pod *corev1.Pod, owners map[string]string) string {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return workloadKey(pod.Namespace, "Pod", pod.Name)
+	}
+	if owner, ok := owners[workloadKey(pod.Namespace, ref.Kind, ref.Name)]; ok {
+		return pod.Namespace + "/" + owner
+	}
+	return workloadKey(pod.Namespace, ref.Kind, ref.Name)
+}
+
+// buildImageInventory groups the containers of pods by image and resolved
+// digest. The same tag resolving to different digests is listed once per
+// digest, which shows pods running stale copies of a moving tag.
+func buildImageInventory(pods []corev1.Pod, owners map[string]string) []ImageUsage {
+	type key struct{ image, digest string }
+	usages := make(map[key]*ImageUsage)
+	workloads := make(map[key]map[string]bool)
+
+	for i := range pods {
+		pod := &pods[i]
+		workload := podWorkload(pod, owners)
+
+		// Image IDs report the digest each container actually runs
+		imageIDs := make(map[string]string)
+		for _, status := range append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
+			imageIDs[status.Name] = status.ImageID
+		}
+
+		seen := make(map[key]bool)
+		for _, container := range append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...) {
+			repository, tag, digest := parseImageReference(container.Image)
+			if _, runningDigest, ok := strings.Cut(imageIDs[container.Name], "@"); ok {
+				digest = runningDigest
+			}
+
+			k := key{container.Image, digest}
+			usage, ok := usages[k]
+			if !ok {
+				usage = &ImageUsage{Image: container.Image, Repository: repository, Tag: tag, Digest: digest}
+				usages[k] = usage
+				workloads[k] = make(map[string]bool)
+			}
+			if !seen[k] {
+				seen[k] = true
+				usage.Pods++
+			}
+			if !workloads[k][workload] {
+				workloads[k][workload] = true
+				usage.Workloads = append(usage.Workloads, workload)
+			}
+		}
+	}
+
+	inventory := make([]ImageUsage, 0, len(usages))
+	for _, usage := range usages {
+		sort.Strings(usage.Workloads)
+		inventory = append(inventory, *usage)
+	}
+	sort.Slice(inventory, func(i, j int) bool {
+		if inventory[i].Image != inventory[j].Image {
+			return inventory[i].Image < inventory[j].Image
+		}
+		return inventory[i].Digest < inventory[j].Digest
+	})
+	return inventory
+}
+
+// parseImageReference splits an image reference into repository, tag and
+// digest. References without either are implicitly tagged latest.
+func parseImageReference(image string) (repository, tag, digest string) {
+	repository, digest, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	if tag == "" && digest == "" {
+		tag = "latest"
+	}
+	return repository, tag, digest
+}
+
+// WriteImageInventoryCSV writes an image inventory as CSV with a header row.
+// Workloads are separated by semicolons.
+func WriteImageInventoryCSV(w io.Writer, inventory []ImageUsage) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"image", "repository", "tag", "digest", "pods", "workloads"}); err != nil {
+		return err
+	}
+	for _, usage := range inventory {
+		record := []string{
+			usage.Image,
+			usage.Repository,
+			usage.Tag,
+			usage.Digest,
+			strconv.Itoa(usage.Pods),
+			strings.Join(usage.Workloads, ";"),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
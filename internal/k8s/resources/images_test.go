@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseImageReference(t *testing.T) {
+	tests := []struct {
+		image, repository, tag, digest string
+	}{
+		{"nginx", "nginx", "latest", ""},
+		{"nginx:1.27", "nginx", "1.27", ""},
+		{"registry.local:5000/team/api", "registry.local:5000/team/api", "latest", ""},
+		{"registry.local:5000/team/api:v2", "registry.local:5000/team/api", "v2", ""},
+		{"quay.io/app@sha256:abc", "quay.io/app", "", "sha256:abc"},
+		{"quay.io/app:v1@sha256:abc", "quay.io/app", "v1", "sha256:abc"},
+	}
+	for _, tt := range tests {
+		repository, tag, digest := parseImageReference(tt.image)
+		if repository != tt.repository || tag != tt.tag || digest != tt.digest {
+			t.Errorf("parseImageReference(%q) = %q, %q, %q", tt.image, repository, tag, digest)
+		}
+	}
+}
+
+func TestBuildImageInventory(t *testing.T) {
+	controller := true
+	pod := func(name, owner, image, imageID string) corev1.Pod {
+		p := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: name},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+			Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{Name: "app", ImageID: imageID}}},
+		}
+		if owner != "" {
+			p.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", Name: owner, Controller: &controller}}
+		}
+		return p
+	}
+
+	pods := []corev1.Pod{
+		pod("web-abc-1", "web-abc", "nginx:1.27", "docker.io/library/nginx@sha256:new"),
+		pod("web-abc-2", "web-abc", "nginx:1.27", "docker.io/library/nginx@sha256:new"),
+		pod("web-old-1", "web-old", "nginx:1.27", "docker.io/library/nginx@sha256:old"),
+		pod("debug", "", "busybox", ""),
+	}
+	owners := map[string]string{"demo/ReplicaSet/web-abc": "Deployment/web"}
+
+	inventory := buildImageInventory(pods, owners)
+	if len(inventory) != 3 {
+		t.Fatalf("Expected 3 image entries, got %+v", inventory)
+	}
+	if inventory[0].Image != "busybox" || inventory[0].Tag != "latest" || !reflect.DeepEqual(inventory[0].Workloads, []string{"demo/Pod/debug"}) {
+		t.Errorf("Unexpected bare pod entry %+v", inventory[0])
+	}
+	if inventory[1].Digest != "sha256:new" || inventory[1].Pods != 2 || !reflect.DeepEqual(inventory[1].Workloads, []string{"demo/Deployment/web"}) {
+		t.Errorf("Expected both web pods under their Deployment, got %+v", inventory[1])
+	}
+	if inventory[2].Digest != "sha256:old" || !reflect.DeepEqual(inventory[2].Workloads, []string{"demo/ReplicaSet/web-old"}) {
+		t.Errorf("Expected the stale digest listed separately, got %+v", inventory[2])
+	}
+
+	var buf bytes.Buffer
+	if err := WriteImageInventoryCSV(&buf, inventory); err != nil {
+		t.Fatalf("WriteImageInventoryCSV failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || lines[0] != "image,repository,tag,digest,pods,workloads" {
+		t.Errorf("Unexpected CSV header or row count: %q", buf.String())
+	}
+	if lines[2] != "nginx:1.27,nginx,1.27,sha256:new,2,demo/Deployment/web" {
+		t.Errorf("Unexpected CSV row %q", lines[2])
+	}
+}
@@ -0,0 +1,81 @@
+//go:build integration
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/testenv"
+)
+
+func TestIntegrationListPods(t *testing.T) {
+	env := testenv.Start(t)
+	client := NewK8sResourceClientWithConfig(env.Clientset, env.Config, env.Namespace)
+	env.CreatePod(t, "web-1", map[string]string{"app": "web"})
+
+	pods, err := client.ListPods(context.Background(), ListOptions{})
+	if err != nil {
+		t.Fatalf("ListPods() returned error: %v", err)
+	}
+	if len(pods.Items) != 1 || pods.Items[0].Name != "web-1" {
+		t.Fatalf("Expected pod web-1 in %s, got %+v", env.Namespace, pods.Items)
+	}
+	if pods.Items[0].Labels["app"] != "web" {
+		t.Errorf("Expected labels to be converted, got %v", pods.Items[0].Labels)
+	}
+
+	if err := client.DeletePod(context.Background(), env.Namespace, "web-1"); err != nil {
+		t.Fatalf("DeletePod() returned error: %v", err)
+	}
+}
+
+func TestIntegrationApplyManifests(t *testing.T) {
+	env := testenv.Start(t)
+	client := NewK8sResourceClientWithConfig(env.Clientset, env.Config, env.Namespace)
+
+	manifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  mode: fast
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  ports:
+  - port: 80
+`
+	// The real API server decides the outcome the fake client only emulates
+	for _, want := range []string{ApplyCreated, ApplyUnchanged} {
+		results, err := client.ApplyManifests(context.Background(), "", []byte(manifest))
+		if err != nil {
+			t.Fatalf("ApplyManifests() returned error: %v", err)
+		}
+		for _, result := range results {
+			if result.Action != want || result.Namespace != env.Namespace {
+				t.Errorf("Expected %s to be %s in %s, got %s in %s (%v)",
+					result.Ref(), want, env.Namespace, result.Action, result.Namespace, result.Err)
+			}
+		}
+	}
+
+	results, err := client.ApplyManifests(context.Background(), "", []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+data:
+  mode: slow
+`))
+	if err != nil {
+		t.Fatalf("ApplyManifests() returned error: %v", err)
+	}
+	if len(results) != 1 || results[0].Action != ApplyConfigured {
+		t.Errorf("Expected the changed ConfigMap to be configured, got %+v", results)
+	}
+}
@@ -2,6 +2,10 @@ package resources
 
 import (
 	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // ResourceClient defines the interface for resource operations
@@ -9,6 +13,9 @@ type ResourceClient interface {
 	// Pod operations
 	ListPods(ctx context.Context, opts ListOptions) (*ResourceList[PodInfo], error)
 	GetPod(ctx context.Context, namespace, name string) (*PodInfo, error)
+	DeletePod(ctx context.Context, namespace, name string) error
+	PlanCascade(ctx context.Context, kind, namespace, name string) (*DependentTree, error)
+	DeleteResource(ctx context.Context, kind, namespace, name string, propagation metav1.DeletionPropagation) error
 
 	// Service operations
 	ListServices(ctx context.Context, opts ListOptions) (*ResourceList[ServiceInfo], error)
@@ -17,15 +24,63 @@ type ResourceClient interface {
 	// Deployment operations
 	ListDeployments(ctx context.Context, opts ListOptions) (*ResourceList[DeploymentInfo], error)
 	GetDeployment(ctx context.Context, namespace, name string) (*DeploymentInfo, error)
+	RolloutRestart(ctx context.Context, namespace, name string) error
+	GetRolloutStatus(ctx context.Context, namespace, name string) (*RolloutStatus, error)
+
+	// StatefulSet, DaemonSet and ReplicaSet operations
+	ListStatefulSets(ctx context.Context, opts ListOptions) (*ResourceList[StatefulSetInfo], error)
+	GetStatefulSet(ctx context.Context, namespace, name string) (*StatefulSetInfo, error)
+	ListDaemonSets(ctx context.Context, opts ListOptions) (*ResourceList[DaemonSetInfo], error)
+	GetDaemonSet(ctx context.Context, namespace, name string) (*DaemonSetInfo, error)
+	ListReplicaSets(ctx context.Context, opts ListOptions) (*ResourceList[ReplicaSetInfo], error)
+	GetReplicaSet(ctx context.Context, namespace, name string) (*ReplicaSetInfo, error)
+
+	// Job and CronJob operations
+	ListJobs(ctx context.Context, opts ListOptions) (*ResourceList[JobInfo], error)
+	ListCronJobs(ctx context.Context, opts ListOptions) (*ResourceList[CronJobInfo], error)
+	TriggerCronJob(ctx context.Context, namespace, name string) (*JobInfo, error)
+	SetCronJobSuspended(ctx context.Context, namespace, name string, suspend bool) error
+
+	// Node operations (cluster-scoped)
+	ListNodes(ctx context.Context, opts ListOptions) (*ResourceList[NodeInfo], error)
+	SetNodeUnschedulable(ctx context.Context, name string, unschedulable bool) error
+	PlanNodeDrain(ctx context.Context, name string) (*DrainPlan, error)
+	DrainNode(ctx context.Context, name string, progress func(done, total int)) error
+
+	// Storage operations, PersistentVolumes and StorageClasses are cluster-scoped
+	ListPersistentVolumeClaims(ctx context.Context, opts ListOptions) (*ResourceList[PersistentVolumeClaimInfo], error)
+	ListPersistentVolumes(ctx context.Context, opts ListOptions) (*ResourceList[PersistentVolumeInfo], error)
+	ListStorageClasses(ctx context.Context, opts ListOptions) (*ResourceList[StorageClassInfo], error)
+
+	// Networking operations
+	ListIngresses(ctx context.Context, opts ListOptions) (*ResourceList[IngressInfo], error)
+	ListNetworkPolicies(ctx context.Context, opts ListOptions) (*ResourceList[NetworkPolicyInfo], error)
+
+	// Resource usage from the metrics API
+	ListPodUsage(ctx context.Context, namespace string) ([]ContainerUsage, error)
+	ListNodeUsage(ctx context.Context) ([]NodeUsage, error)
+
+	// Image inventory
+	ListImageInventory(ctx context.Context, namespace string, allNamespaces bool) ([]ImageUsage, error)
+
+	// Object counts
+	CountObjects(ctx context.Context, namespace string, allNamespaces bool) ([]ObjectCount, error)
+	ListStoredObjectCounts(ctx context.Context) ([]StoredObjectCount, error)
 
 	// ConfigMap operations
 	ListConfigMaps(ctx context.Context, opts ListOptions) (*ResourceList[ConfigMapInfo], error)
 	GetConfigMap(ctx context.Context, namespace, name string) (*ConfigMapInfo, error)
+	GetConfigMapData(ctx context.Context, namespace, name string) (map[string]string, error)
 
 	// Secret operations
 	ListSecrets(ctx context.Context, opts ListOptions) (*ResourceList[SecretInfo], error)
 	GetSecret(ctx context.Context, namespace, name string) (*SecretInfo, error)
 
+	// Event operations
+	ListEvents(ctx context.Context, opts ListOptions) (*ResourceList[EventInfo], error)
+	GetEventsFor(ctx context.Context, namespace, kind, name string) ([]EventInfo, error)
+	ListScalingEvents(ctx context.Context) (*ResourceList[EventInfo], error)
+
 	// Project/Namespace operations (unified interface)
 	ListProjects(ctx context.Context) (*ResourceList[ProjectInfo], error)
 	GetCurrentProject() string
@@ -45,13 +100,31 @@ type ResourceClient interface {
 
 	// Service operations
 	GetPodsForService(ctx context.Context, namespace, serviceName string) ([]PodInfo, error)
+	GetServiceTopology(ctx context.Context, namespace, serviceName string) (*ServiceTopology, error)
 
 	// Secret operations
 	GetSecretData(ctx context.Context, namespace, secretName string) (map[string]string, error)
 
 	// Connection management
 	TestConnection(ctx context.Context) error
+	WhoAmI(ctx context.Context) (*UserIdentity, error)
+	MeasureLatency(ctx context.Context) (time.Duration, error)
 	GetServerInfo(ctx context.Context) (map[string]interface{}, error)
+	GetControlPlaneHealth(ctx context.Context) ([]ComponentHealthInfo, error)
+
+	// Manifest operations
+	GetYAML(ctx context.Context, kind, namespace, name string) (string, error)
+	GetFieldOwnership(ctx context.Context, kind, namespace, name string) (*FieldOwnership, error)
+}
+
+// ResourceWriter defines write operations that modify existing resources
+type ResourceWriter interface {
+	// UpdateFromYAML replaces a resource with the given manifest. The manifest must
+	// describe the same kind, namespace and name as the target.
+	UpdateFromYAML(ctx context.Context, kind, namespace, name string, manifest []byte) error
+
+	// Patch applies a patch of the given type to a resource
+	Patch(ctx context.Context, kind, namespace, name string, patchType types.PatchType, data []byte) error
 }
 
 // ResourceManager manages resource operations with error handling and retry logic
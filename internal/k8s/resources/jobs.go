@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// manualInstantiationAnnotation marks Jobs created by hand from a CronJob, the
+// same way `kubectl create job --from=cronjob/...` does
+const manualInstantiationAnnotation = "cronjob.kubernetes.io/instantiate"
+
+// maxJobNameLength is the longest Job name that still leaves room for the
+// pod name suffix added by the Job controller
+const maxJobNameLength = 52
+
+// ListJobs lists jobs in the specified namespace
+func (c *K8sResourceClient) ListJobs(ctx context.Context, opts ListOptions) (*ResourceList[JobInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	now := time.Now()
+	items := make([]JobInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertJob(&list.Items[i], now)
+	}
+
+	return &ResourceList[JobInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// ListCronJobs lists cronjobs in the specified namespace
+func (c *K8sResourceClient) ListCronJobs(ctx context.Context, opts ListOptions) (*ResourceList[CronJobInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.BatchV1().CronJobs(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs: %w", err)
+	}
+
+	items := make([]CronJobInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertCronJob(&list.Items[i])
+	}
+
+	return &ResourceList[CronJobInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// TriggerCronJob runs a CronJob now by creating a Job from its template
+func (c *K8sResourceClient) TriggerCronJob(ctx context.Context, namespace, name string) (*JobInfo, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	cronJob, err := c.clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cronjob %s/%s: %w", namespace, name, err)
+	}
+
+	job, err := c.clientset.BatchV1().Jobs(namespace).Create(ctx, JobFromCronJob(cronJob, time.Now()), metav1.CreateOptions{DryRun: dryRunOption(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job from cronjob %s/%s: %w", namespace, name, err)
+	}
+
+	info := convertJob(job, time.Now())
+	return &info, nil
+}
+
+// SetCronJobSuspended suspends or resumes the schedule of a CronJob
+func (c *K8sResourceClient) SetCronJobSuspended(ctx context.Context, namespace, name string, suspend bool) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"suspend": suspend},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build suspend patch: %w", err)
+	}
+	return c.Patch(ctx, "cronjob", namespace, name, types.StrategicMergePatchType, data)
+}
+
+// JobFromCronJob builds a Job from a CronJob's job template, named after the
+// CronJob and owned by it so that it is cleaned up with the CronJob's history
+func JobFromCronJob(cronJob *batchv1.CronJob, now time.Time) *batchv1.Job {
+	suffix := fmt.Sprintf("-manual-%d", now.Unix())
+	name := cronJob.Name
+	if len(name)+len(suffix) > maxJobNameLength {
+		name = name[:maxJobNameLength-len(suffix)]
+	}
+
+	annotations := map[string]string{manualInstantiationAnnotation: "manual"}
+	for key, value := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[key] = value
+	}
+
+	controller := true
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name + suffix,
+			Namespace:   cronJob.Namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "batch/v1",
+				Kind:       "CronJob",
+				Name:       cronJob.Name,
+				UID:        cronJob.UID,
+				Controller: &controller,
+			}},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+}
+
+// jobStatus summarizes the state of a Job from its conditions
+func jobStatus(job *batchv1.Job) string {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return "Complete"
+		case batchv1.JobFailed:
+			return "Failed"
+		case batchv1.JobSuspended:
+			return "Suspended"
+		}
+	}
+	if job.Status.Active > 0 {
+		return "Running"
+	}
+	return "Pending"
+}
+
+func convertJob(job *batchv1.Job, now time.Time) JobInfo {
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+
+	duration := ""
+	if job.Status.StartTime != nil {
+		end := now
+		if job.Status.CompletionTime != nil {
+			end = job.Status.CompletionTime.Time
+		}
+		duration = end.Sub(job.Status.StartTime.Time).Round(time.Second).String()
+	}
+
+	owner := ""
+	if ref := metav1.GetControllerOf(job); ref != nil && ref.Kind == "CronJob" {
+		owner = ref.Name
+	}
+
+	status := jobStatus(job)
+	return JobInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        job.Name,
+			Namespace:   job.Namespace,
+			Kind:        "Job",
+			APIVersion:  "batch/v1",
+			Labels:      job.Labels,
+			Annotations: job.Annotations,
+			CreatedAt:   job.CreationTimestamp.Time,
+			Status:      status,
+		},
+		Completions: completions,
+		Succeeded:   job.Status.Succeeded,
+		Failed:      job.Status.Failed,
+		Active:      job.Status.Active,
+		Duration:    duration,
+		CronJob:     owner,
+		Images:      templateImages(job.Spec.Template),
+		Age:         formatAge(job.CreationTimestamp.Time),
+	}
+}
+
+func convertCronJob(cronJob *batchv1.CronJob) CronJobInfo {
+	suspended := cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+
+	status := "Active"
+	if suspended {
+		status = "Suspended"
+	}
+
+	info := CronJobInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        cronJob.Name,
+			Namespace:   cronJob.Namespace,
+			Kind:        "CronJob",
+			APIVersion:  "batch/v1",
+			Labels:      cronJob.Labels,
+			Annotations: cronJob.Annotations,
+			CreatedAt:   cronJob.CreationTimestamp.Time,
+			Status:      status,
+		},
+		Schedule:   cronJob.Spec.Schedule,
+		Suspended:  suspended,
+		ActiveJobs: len(cronJob.Status.Active),
+		Images:     templateImages(cronJob.Spec.JobTemplate.Spec.Template),
+		Age:        formatAge(cronJob.CreationTimestamp.Time),
+	}
+	if cronJob.Spec.TimeZone != nil {
+		info.TimeZone = *cronJob.Spec.TimeZone
+	}
+	if cronJob.Status.LastScheduleTime != nil {
+		info.LastSchedule = cronJob.Status.LastScheduleTime.Time
+		info.LastRun = formatAge(info.LastSchedule)
+	}
+	if cronJob.Status.LastSuccessfulTime != nil {
+		info.LastSuccessful = cronJob.Status.LastSuccessfulTime.Time
+	}
+	return info
+}
@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestJobFromCronJob(t *testing.T) {
+	backoff := int32(2)
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-report", Namespace: "demo", UID: "abc"},
+		Spec: batchv1.CronJobSpec{
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "report"}},
+				Spec: batchv1.JobSpec{
+					BackoffLimit: &backoff,
+					Template:     podTemplate("report:1"),
+				},
+			},
+		},
+	}
+
+	now := time.Unix(1700000000, 0)
+	job := JobFromCronJob(cronJob, now)
+	if job.Name != "nightly-report-manual-1700000000" || job.Namespace != "demo" {
+		t.Errorf("Unexpected job name %s/%s", job.Namespace, job.Name)
+	}
+	if job.Annotations[manualInstantiationAnnotation] != "manual" || job.Labels["app"] != "report" {
+		t.Errorf("Expected manual annotation and template labels, got %v %v", job.Annotations, job.Labels)
+	}
+	if ref := metav1.GetControllerOf(job); ref == nil || ref.Kind != "CronJob" || ref.UID != "abc" {
+		t.Errorf("Expected the CronJob to own the job, got %+v", ref)
+	}
+	if *job.Spec.BackoffLimit != 2 || job.Spec.Template.Spec.Containers[0].Image != "report:1" {
+		t.Errorf("Expected the job template spec to be copied")
+	}
+	job.Spec.Template.Spec.Containers[0].Image = "changed"
+	if cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image != "report:1" {
+		t.Errorf("Expected the CronJob template to be left unmodified")
+	}
+
+	cronJob.Name = strings.Repeat("a", 60)
+	if job := JobFromCronJob(cronJob, now); len(job.Name) > maxJobNameLength {
+		t.Errorf("Expected job name to be at most %d characters, got %d", maxJobNameLength, len(job.Name))
+	}
+}
+
+func TestConvertJob(t *testing.T) {
+	now := time.Now()
+	start := metav1.NewTime(now.Add(-90 * time.Second))
+	done := metav1.NewTime(now.Add(-30 * time.Second))
+	controller := true
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "report-1",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "CronJob", Name: "report", Controller: &controller}},
+		},
+		Status: batchv1.JobStatus{
+			StartTime:      &start,
+			CompletionTime: &done,
+			Succeeded:      1,
+			Conditions:     []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+		},
+	}
+
+	info := convertJob(job, now)
+	if info.Status != "Complete" || info.Completions != 1 || info.Succeeded != 1 {
+		t.Errorf("Unexpected job info %+v", info)
+	}
+	if info.Duration != "1m0s" || info.CronJob != "report" {
+		t.Errorf("Expected duration 1m0s from cronjob report, got %q from %q", info.Duration, info.CronJob)
+	}
+
+	job.Status = batchv1.JobStatus{Active: 1, StartTime: &start}
+	if info := convertJob(job, now); info.Status != "Running" || info.Duration != "1m30s" {
+		t.Errorf("Expected a running job of 1m30s, got %s %s", info.Status, info.Duration)
+	}
+}
+
+func TestConvertCronJob(t *testing.T) {
+	suspend := true
+	last := metav1.NewTime(time.Now().Add(-time.Hour))
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "report"},
+		Spec:       batchv1.CronJobSpec{Schedule: "0 2 * * *", Suspend: &suspend},
+		Status: batchv1.CronJobStatus{
+			Active:           []corev1.ObjectReference{{Name: "report-1"}},
+			LastScheduleTime: &last,
+		},
+	}
+
+	info := convertCronJob(cronJob)
+	if !info.Suspended || info.Status != "Suspended" || info.ActiveJobs != 1 {
+		t.Errorf("Unexpected cronjob info %+v", info)
+	}
+	if !info.LastSchedule.Equal(last.Time) || info.LastRun != "1h" {
+		t.Errorf("Expected last schedule %v (1h), got %v (%s)", last.Time, info.LastSchedule, info.LastRun)
+	}
+}
@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"testing"
+
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvertMachineSet(t *testing.T) {
+	replicas := int32(3)
+	ms := &machinev1beta1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-abc-worker-us-east-1a",
+			Namespace: "openshift-machine-api",
+			Annotations: map[string]string{
+				autoscalerMinAnnotation: "1",
+				autoscalerMaxAnnotation: "6",
+			},
+		},
+		Spec: machinev1beta1.MachineSetSpec{
+			Replicas: &replicas,
+			Template: machinev1beta1.MachineTemplateSpec{
+				ObjectMeta: machinev1beta1.ObjectMeta{Labels: map[string]string{machineRoleLabel: "worker"}},
+			},
+		},
+		Status: machinev1beta1.MachineSetStatus{Replicas: 3, ReadyReplicas: 2, AvailableReplicas: 2},
+	}
+
+	info := convertMachineSet(ms)
+	if info.Replicas != 3 || info.ReadyReplicas != 2 || info.Status != "Progressing" {
+		t.Errorf("Expected 2/3 ready and progressing, got %d/%d %s", info.ReadyReplicas, info.Replicas, info.Status)
+	}
+	if info.Role != "worker" || info.AutoscaleMin != "1" || info.AutoscaleMax != "6" {
+		t.Errorf("Expected the worker role and autoscaler bounds, got %+v", info)
+	}
+
+	message := "invalid provider spec"
+	ms.Status.ErrorMessage = &message
+	if info := convertMachineSet(ms); info.Status != "Failed" || info.ErrorMessage != message {
+		t.Errorf("Expected a failed MachineSet, got %s %q", info.Status, info.ErrorMessage)
+	}
+}
+
+func TestConvertMachine(t *testing.T) {
+	running := machinev1beta1.PhaseRunning
+	providerID := "aws:///us-east-1a/i-0abc"
+	m := &machinev1beta1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-abc-worker-us-east-1a-x7k2p",
+			Labels: map[string]string{
+				machineSetLabel:      "cluster-abc-worker-us-east-1a",
+				machineInstanceLabel: "m6i.xlarge",
+				machineZoneLabel:     "us-east-1a",
+			},
+		},
+		Spec:   machinev1beta1.MachineSpec{ProviderID: &providerID},
+		Status: machinev1beta1.MachineStatus{Phase: &running, NodeRef: &corev1.ObjectReference{Name: "ip-10-0-1-20.ec2.internal"}},
+	}
+
+	info := convertMachine(m)
+	if info.Phase != "Running" || info.NodeName != "ip-10-0-1-20.ec2.internal" || info.ProviderID != providerID {
+		t.Errorf("Unexpected machine %+v", info)
+	}
+	if info.MachineSet != "cluster-abc-worker-us-east-1a" || info.InstanceType != "m6i.xlarge" || info.Zone != "us-east-1a" {
+		t.Errorf("Expected the machineset, instance type and zone labels, got %+v", info)
+	}
+
+	now := metav1.Now()
+	m.DeletionTimestamp = &now
+	if phase := convertMachine(m).Phase; phase != "Deleting" {
+		t.Errorf("Expected a machine being deleted to be Deleting, got %s", phase)
+	}
+
+	if phase := convertMachine(&machinev1beta1.Machine{}).Phase; phase != "Pending" {
+		t.Errorf("Expected a new machine to be Pending, got %s", phase)
+	}
+}
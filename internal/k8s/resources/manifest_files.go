@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// StdinPath reads manifests from standard input, like 'kubectl apply -f -'
+const StdinPath = "-"
+
+// IsManifestFile reports whether a file name has a manifest extension
+func IsManifestFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// ReadManifestFiles reads and joins the manifests of files and directories
+// into one multi-document manifest. The manifest files directly in a
+// directory are read in name order, and StdinPath reads stdin.
+func ReadManifestFiles(paths []string, stdin io.Reader) ([]byte, error) {
+	var files []string
+	for _, path := range paths {
+		if path == StdinPath {
+			files = append(files, path)
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, entry := range entries {
+			if !entry.IsDir() && IsManifestFile(entry.Name()) {
+				names = append(names, filepath.Join(path, entry.Name()))
+			}
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("no .yaml, .yml or .json files in %s", path)
+		}
+		sort.Strings(names)
+		files = append(files, names...)
+	}
+
+	var manifest bytes.Buffer
+	for _, file := range files {
+		var data []byte
+		var err error
+		if file == StdinPath {
+			data, err = io.ReadAll(stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Documents of different files must not run into each other. The
+		// separators also make a JSON file decode as YAML, of which JSON is
+		// a subset, when it is read with YAML files.
+		if len(files) > 1 {
+			manifest.WriteString("---\n")
+		}
+		manifest.Write(data)
+		if len(files) > 1 {
+			manifest.WriteString("\n")
+		}
+	}
+	return manifest.Bytes(), nil
+}
@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadManifestFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	write("b-service.yml", "apiVersion: v1\nkind: Service\nmetadata:\n  name: web")
+	write("a-config.json", `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "web"}}`)
+	write("README.md", "# not a manifest")
+	deploy := write("deploy.yaml", "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n")
+
+	// A directory reads its manifest files in name order, after stdin here
+	stdin := strings.NewReader("apiVersion: v1\nkind: Secret\nmetadata:\n  name: web\n")
+	manifest, err := ReadManifestFiles([]string{StdinPath, dir}, stdin)
+	if err != nil {
+		t.Fatalf("ReadManifestFiles() returned error: %v", err)
+	}
+	objects, err := DecodeManifests(manifest)
+	if err != nil {
+		t.Fatalf("Expected the joined manifests to decode, got %v in:\n%s", err, manifest)
+	}
+	var kinds []string
+	for _, obj := range objects {
+		kinds = append(kinds, obj.GetKind())
+	}
+	if got := strings.Join(kinds, ","); got != "Secret,ConfigMap,Service,Deployment" {
+		t.Errorf("Expected Secret,ConfigMap,Service,Deployment, got %s", got)
+	}
+
+	// A single file is read as it is
+	single, err := ReadManifestFiles([]string{deploy}, nil)
+	if err != nil || string(single) != "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: web\n" {
+		t.Errorf("Expected deploy.yaml as it is, got %q, %v", single, err)
+	}
+
+	if _, err := ReadManifestFiles([]string{filepath.Join(dir, "missing.yaml")}, nil); err == nil {
+		t.Error("Expected a missing file to fail")
+	}
+	if _, err := ReadManifestFiles([]string{t.TempDir()}, nil); err == nil {
+		t.Error("Expected a directory without manifests to fail")
+	}
+}
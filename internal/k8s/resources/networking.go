@@ -0,0 +1,239 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListIngresses lists ingresses in the specified namespace
+func (c *K8sResourceClient) ListIngresses(ctx context.Context, opts ListOptions) (*ResourceList[IngressInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.NetworkingV1().Ingresses(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	items := make([]IngressInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertIngress(&list.Items[i])
+	}
+
+	return &ResourceList[IngressInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// ListNetworkPolicies lists networkpolicies in the specified namespace
+func (c *K8sResourceClient) ListNetworkPolicies(ctx context.Context, opts ListOptions) (*ResourceList[NetworkPolicyInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.NetworkingV1().NetworkPolicies(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networkpolicies: %w", err)
+	}
+
+	items := make([]NetworkPolicyInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertNetworkPolicy(&list.Items[i])
+	}
+
+	return &ResourceList[NetworkPolicyInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+func convertIngress(ing *networkingv1.Ingress) IngressInfo {
+	info := IngressInfo{
+		ResourceInfo: networkingResourceInfo(ing.ObjectMeta, "Ingress", "Active"),
+		Age:          formatAge(ing.CreationTimestamp.Time),
+	}
+
+	if ing.Spec.IngressClassName != nil {
+		info.IngressClass = *ing.Spec.IngressClassName
+	} else if class := ing.Annotations["kubernetes.io/ingress.class"]; class != "" {
+		// Deprecated, still common on older manifests
+		info.IngressClass = class
+	}
+
+	if ing.Spec.DefaultBackend != nil {
+		info.DefaultBackend = ingressBackend(*ing.Spec.DefaultBackend)
+	}
+
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			info.Rules = append(info.Rules, IngressPath{Host: rule.Host, Backend: info.DefaultBackend})
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			ingressPath := IngressPath{
+				Host:    rule.Host,
+				Path:    path.Path,
+				Backend: ingressBackend(path.Backend),
+			}
+			if path.PathType != nil {
+				ingressPath.PathType = string(*path.PathType)
+			}
+			info.Rules = append(info.Rules, ingressPath)
+		}
+	}
+
+	for _, tls := range ing.Spec.TLS {
+		info.TLS = append(info.TLS, IngressTLS{Hosts: tls.Hosts, SecretName: tls.SecretName})
+	}
+
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			info.Addresses = append(info.Addresses, lb.IP)
+		} else if lb.Hostname != "" {
+			info.Addresses = append(info.Addresses, lb.Hostname)
+		}
+	}
+	if len(info.Addresses) == 0 {
+		info.Status = "Pending"
+	}
+
+	return info
+}
+
+// ingressBackend formats an ingress backend as service:port or Kind/name
+func ingressBackend(backend networkingv1.IngressBackend) string {
+	if backend.Resource != nil {
+		return backend.Resource.Kind + "/" + backend.Resource.Name
+	}
+	if backend.Service == nil {
+		return ""
+	}
+	port := backend.Service.Port.Name
+	if port == "" {
+		port = fmt.Sprintf("%d", backend.Service.Port.Number)
+	}
+	return backend.Service.Name + ":" + port
+}
+
+func convertNetworkPolicy(np *networkingv1.NetworkPolicy) NetworkPolicyInfo {
+	info := NetworkPolicyInfo{
+		ResourceInfo: networkingResourceInfo(np.ObjectMeta, "NetworkPolicy", "Active"),
+		PodSelector:  selectorSummary(np.Spec.PodSelector),
+		Age:          formatAge(np.CreationTimestamp.Time),
+	}
+
+	for _, policyType := range np.Spec.PolicyTypes {
+		info.PolicyTypes = append(info.PolicyTypes, string(policyType))
+	}
+	if len(info.PolicyTypes) == 0 {
+		// The API server defaults the types from the rules that are present
+		info.PolicyTypes = []string{string(networkingv1.PolicyTypeIngress)}
+		if len(np.Spec.Egress) > 0 {
+			info.PolicyTypes = append(info.PolicyTypes, string(networkingv1.PolicyTypeEgress))
+		}
+	}
+
+	for _, rule := range np.Spec.Ingress {
+		info.IngressRules = append(info.IngressRules, networkPolicyRule("from", rule.From, rule.Ports))
+	}
+	for _, rule := range np.Spec.Egress {
+		info.EgressRules = append(info.EgressRules, networkPolicyRule("to", rule.To, rule.Ports))
+	}
+
+	return info
+}
+
+// networkPolicyRule summarizes the peers and ports of a rule, e.g.
+// "from pods app=web in namespaces team=a; ports TCP/8080"
+func networkPolicyRule(direction string, peers []networkingv1.NetworkPolicyPeer, ports []networkingv1.NetworkPolicyPort) string {
+	var parts []string
+
+	if len(peers) == 0 {
+		parts = append(parts, direction+" anywhere")
+	} else {
+		described := make([]string, 0, len(peers))
+		for _, peer := range peers {
+			described = append(described, networkPolicyPeer(peer))
+		}
+		parts = append(parts, direction+" "+strings.Join(described, ", "))
+	}
+
+	if len(ports) > 0 {
+		described := make([]string, 0, len(ports))
+		for _, port := range ports {
+			described = append(described, networkPolicyPort(port))
+		}
+		parts = append(parts, "ports "+strings.Join(described, ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// networkPolicyPeer describes the pods, namespaces or IP block of a peer
+func networkPolicyPeer(peer networkingv1.NetworkPolicyPeer) string {
+	if peer.IPBlock != nil {
+		block := peer.IPBlock.CIDR
+		if len(peer.IPBlock.Except) > 0 {
+			block += " except " + strings.Join(peer.IPBlock.Except, ",")
+		}
+		return block
+	}
+
+	var parts []string
+	if peer.PodSelector != nil {
+		parts = append(parts, "pods "+selectorSummary(*peer.PodSelector))
+	}
+	if peer.NamespaceSelector != nil {
+		if peer.PodSelector != nil {
+			parts = append(parts, "in")
+		}
+		parts = append(parts, "namespaces "+selectorSummary(*peer.NamespaceSelector))
+	}
+	return strings.Join(parts, " ")
+}
+
+// networkPolicyPort formats a port as protocol/port or protocol/port-endPort
+func networkPolicyPort(port networkingv1.NetworkPolicyPort) string {
+	protocol := string(corev1.ProtocolTCP)
+	if port.Protocol != nil {
+		protocol = string(*port.Protocol)
+	}
+	if port.Port == nil {
+		return protocol
+	}
+	formatted := protocol + "/" + port.Port.String()
+	if port.EndPort != nil {
+		formatted += fmt.Sprintf("-%d", *port.EndPort)
+	}
+	return formatted
+}
+
+// selectorSummary formats a label selector, an empty selector matches everything
+func selectorSummary(selector metav1.LabelSelector) string {
+	if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		return "(all)"
+	}
+	return metav1.FormatLabelSelector(&selector)
+}
+
+func networkingResourceInfo(meta metav1.ObjectMeta, kind, status string) ResourceInfo {
+	return ResourceInfo{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Kind:        kind,
+		APIVersion:  "networking.k8s.io/v1",
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+		CreatedAt:   meta.CreationTimestamp.Time,
+		Status:      status,
+	}
+}
@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestConvertIngress(t *testing.T) {
+	class := "nginx"
+	prefix := networkingv1.PathTypePrefix
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "demo"},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: &class,
+			Rules: []networkingv1.IngressRule{{
+				Host: "shop.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{Paths: []networkingv1.HTTPIngressPath{
+					{Path: "/", PathType: &prefix, Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web", Port: networkingv1.ServiceBackendPort{Number: 8080}}}},
+					{Path: "/api", PathType: &prefix, Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "api", Port: networkingv1.ServiceBackendPort{Name: "http"}}}},
+				}}},
+			}},
+			TLS: []networkingv1.IngressTLS{{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"}},
+		},
+		Status: networkingv1.IngressStatus{LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+			Ingress: []networkingv1.IngressLoadBalancerIngress{{Hostname: "lb.example.com"}},
+		}},
+	}
+
+	info := convertIngress(ing)
+	if info.IngressClass != "nginx" || info.Status != "Active" || !reflect.DeepEqual(info.Addresses, []string{"lb.example.com"}) {
+		t.Errorf("Unexpected ingress %+v", info)
+	}
+	want := []IngressPath{
+		{Host: "shop.example.com", Path: "/", PathType: "Prefix", Backend: "web:8080"},
+		{Host: "shop.example.com", Path: "/api", PathType: "Prefix", Backend: "api:http"},
+	}
+	if !reflect.DeepEqual(info.Rules, want) {
+		t.Errorf("Expected rules %+v, got %+v", want, info.Rules)
+	}
+	if len(info.TLS) != 1 || info.TLS[0].SecretName != "shop-tls" {
+		t.Errorf("Unexpected TLS %+v", info.TLS)
+	}
+
+	// Without an address the ingress controller has not picked it up yet
+	if pending := convertIngress(&networkingv1.Ingress{}); pending.Status != "Pending" {
+		t.Errorf("Expected an ingress without address to be pending, got %q", pending.Status)
+	}
+}
+
+func TestConvertNetworkPolicy(t *testing.T) {
+	udp := corev1.ProtocolUDP
+	port := intstr.FromInt32(8080)
+	dns := intstr.FromInt32(53)
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-web", Namespace: "demo"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					NamespaceSelector: &metav1.LabelSelector{},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{{Port: &port}},
+			}},
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}}},
+				Ports: []networkingv1.NetworkPolicyPort{{Protocol: &udp, Port: &dns}},
+			}},
+		},
+	}
+
+	info := convertNetworkPolicy(np)
+	if info.PodSelector != "app=api" || !reflect.DeepEqual(info.PolicyTypes, []string{"Ingress", "Egress"}) {
+		t.Errorf("Unexpected policy %+v", info)
+	}
+	if !reflect.DeepEqual(info.IngressRules, []string{"from pods app=web in namespaces (all); ports TCP/8080"}) {
+		t.Errorf("Unexpected ingress rules %q", info.IngressRules)
+	}
+	if !reflect.DeepEqual(info.EgressRules, []string{"to 10.0.0.0/8 except 10.1.0.0/16; ports UDP/53"}) {
+		t.Errorf("Unexpected egress rules %q", info.EgressRules)
+	}
+
+	// A policy without rules denies all ingress to the selected pods
+	deny := convertNetworkPolicy(&networkingv1.NetworkPolicy{})
+	if deny.PodSelector != "(all)" || len(deny.IngressRules) != 0 || !reflect.DeepEqual(deny.PolicyTypes, []string{"Ingress"}) {
+		t.Errorf("Unexpected deny-all policy %+v", deny)
+	}
+}
@@ -0,0 +1,293 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// nodeRoleLabelPrefix marks node roles, e.g. node-role.kubernetes.io/worker
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+
+	// mirrorPodAnnotation marks static pods mirrored from a kubelet manifest,
+	// which cannot be evicted through the API
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+	// evictionRetryInterval is how long a drain waits before retrying an
+	// eviction refused by a PodDisruptionBudget
+	evictionRetryInterval = 5 * time.Second
+)
+
+// ListNodes lists the nodes of the cluster with the number of pods running
+// on each. Nodes are cluster-scoped, so opts.Namespace is ignored.
+func (c *K8sResourceClient) ListNodes(ctx context.Context, opts ListOptions) (*ResourceList[NodeInfo], error) {
+	listOpts := metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	}
+	if listOpts.Limit == 0 {
+		listOpts.Limit = c.defaultLimit
+	}
+
+	list, err := c.clientset.CoreV1().Nodes().List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	// Pod counts are best effort, listing pods in all namespaces needs
+	// broader permissions than listing nodes
+	podCounts, countErr := c.countPodsByNode(ctx)
+
+	items := make([]NodeInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertNode(&list.Items[i])
+		items[i].PodCount = -1
+		if countErr == nil {
+			items[i].PodCount = podCounts[items[i].Name]
+		}
+	}
+
+	return &ResourceList[NodeInfo]{
+		Items:     items,
+		Total:     len(items),
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// countPodsByNode counts the non-terminated pods scheduled on each node
+func (c *K8sResourceClient) countPodsByNode(ctx context.Context) (map[string]int, error) {
+	pods, err := c.clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	counts := make(map[string]int)
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != "" {
+			counts[pod.Spec.NodeName]++
+		}
+	}
+	return counts, nil
+}
+
+// SetNodeUnschedulable cordons or uncordons a node
+func (c *K8sResourceClient) SetNodeUnschedulable(ctx context.Context, name string, unschedulable bool) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"unschedulable": unschedulable},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build cordon patch: %w", err)
+	}
+
+	_, err = c.clientset.CoreV1().Nodes().Patch(ctx, name, types.StrategicMergePatchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	if err != nil {
+		return fmt.Errorf("failed to patch node %s: %w", name, err)
+	}
+	return nil
+}
+
+// PlanNodeDrain works out which pods draining a node would evict
+func (c *K8sResourceClient) PlanNodeDrain(ctx context.Context, name string) (*DrainPlan, error) {
+	pods, err := c.clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node %s: %w", name, err)
+	}
+	return planDrain(name, pods.Items), nil
+}
+
+// DrainNode cordons a node and evicts its pods. Evictions go through the
+// eviction API so PodDisruptionBudgets are honored; refused evictions are
+// retried until ctx is done. The drain is refused up front when a pod
+// without a controller or the data of an emptyDir volume would be lost.
+func (c *K8sResourceClient) DrainNode(ctx context.Context, name string, progress func(done, total int)) error {
+	plan, err := c.PlanNodeDrain(ctx, name)
+	if err != nil {
+		return err
+	}
+	if len(plan.Blocked) > 0 {
+		return fmt.Errorf("cannot drain node %s: %d pod(s) have no controller and would not be recreated: %s",
+			name, len(plan.Blocked), strings.Join(plan.Blocked, ", "))
+	}
+	if len(plan.LocalData) > 0 {
+		return fmt.Errorf("cannot drain node %s: %d pod(s) have emptyDir volumes whose data would be lost: %s",
+			name, len(plan.LocalData), strings.Join(plan.LocalData, ", "))
+	}
+
+	if err := c.SetNodeUnschedulable(ctx, name, true); err != nil {
+		return err
+	}
+
+	for i, ref := range plan.Evict {
+		namespace, podName, _ := strings.Cut(ref, "/")
+		if err := c.evictPod(ctx, namespace, podName); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(i+1, len(plan.Evict))
+		}
+	}
+	return nil
+}
+
+// evictPod evicts a pod, waiting while a PodDisruptionBudget refuses it
+func (c *K8sResourceClient) evictPod(ctx context.Context, namespace, name string) error {
+	eviction := &policyv1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: namespace},
+		DeleteOptions: &metav1.DeleteOptions{DryRun: dryRunOption(ctx)},
+	}
+
+	for {
+		err := c.clientset.CoreV1().Pods(namespace).EvictV1(ctx, eviction)
+		switch {
+		case err == nil, apierrors.IsNotFound(err):
+			return nil
+		case !apierrors.IsTooManyRequests(err):
+			return fmt.Errorf("failed to evict pod %s/%s: %w", namespace, name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("eviction of pod %s/%s still blocked by a disruption budget: %w", namespace, name, ctx.Err())
+		case <-time.After(evictionRetryInterval):
+		}
+	}
+}
+
+// planDrain sorts the pods of a node into those a drain evicts, skips or is
+// blocked by, the same way `kubectl drain` does without --force
+func planDrain(node string, pods []corev1.Pod) *DrainPlan {
+	plan := &DrainPlan{Node: node}
+	for _, pod := range pods {
+		ref := pod.Namespace + "/" + pod.Name
+		controller := metav1.GetControllerOf(&pod)
+		terminated := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+
+		switch {
+		case pod.Annotations[mirrorPodAnnotation] != "":
+			plan.Skipped = append(plan.Skipped, ref)
+		case controller != nil && controller.Kind == "DaemonSet":
+			plan.Skipped = append(plan.Skipped, ref)
+		case controller == nil && !terminated:
+			plan.Blocked = append(plan.Blocked, ref)
+		case hasEmptyDir(&pod) && !terminated:
+			plan.LocalData = append(plan.LocalData, ref)
+		default:
+			plan.Evict = append(plan.Evict, ref)
+		}
+	}
+	return plan
+}
+
+// hasEmptyDir reports whether a pod keeps data in an emptyDir volume
+func hasEmptyDir(pod *corev1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeStatus summarizes node readiness the way `kubectl get nodes` does
+func nodeStatus(node *corev1.Node) string {
+	status := "Unknown"
+	for _, cond := range node.Status.Conditions {
+		if cond.Type != corev1.NodeReady {
+			continue
+		}
+		switch cond.Status {
+		case corev1.ConditionTrue:
+			status = "Ready"
+		case corev1.ConditionFalse:
+			status = "NotReady"
+		}
+	}
+	if node.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+	return status
+}
+
+// nodeRoles returns the sorted roles of a node from its role labels
+func nodeRoles(labels map[string]string) []string {
+	var roles []string
+	for key, value := range labels {
+		switch {
+		case strings.HasPrefix(key, nodeRoleLabelPrefix) && len(key) > len(nodeRoleLabelPrefix):
+			roles = append(roles, strings.TrimPrefix(key, nodeRoleLabelPrefix))
+		case key == "kubernetes.io/role" && value != "":
+			roles = append(roles, value)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// formatMemory renders a memory quantity in GiB
+func formatMemory(bytes int64) string {
+	return fmt.Sprintf("%.1fGi", float64(bytes)/(1<<30))
+}
+
+func convertNode(node *corev1.Node) NodeInfo {
+	info := NodeInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        node.Name,
+			Kind:        "Node",
+			APIVersion:  "v1",
+			Labels:      node.Labels,
+			Annotations: node.Annotations,
+			CreatedAt:   node.CreationTimestamp.Time,
+			Status:      nodeStatus(node),
+		},
+		Roles:          nodeRoles(node.Labels),
+		Unschedulable:  node.Spec.Unschedulable,
+		KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+		OSImage:        node.Status.NodeInfo.OSImage,
+		Age:            formatAge(node.CreationTimestamp.Time),
+	}
+
+	if cpu, ok := node.Status.Allocatable[corev1.ResourceCPU]; ok {
+		info.CPUAllocatable = cpu.String()
+	}
+	if memory, ok := node.Status.Allocatable[corev1.ResourceMemory]; ok {
+		info.MemoryAllocatable = formatMemory(memory.Value())
+	}
+	if pods, ok := node.Status.Allocatable[corev1.ResourcePods]; ok {
+		info.PodCapacity = pods.Value()
+	}
+
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP {
+			info.InternalIP = addr.Address
+			break
+		}
+	}
+
+	for _, taint := range node.Spec.Taints {
+		t := taint.Key
+		if taint.Value != "" {
+			t += "=" + taint.Value
+		}
+		info.Taints = append(info.Taints, t+":"+string(taint.Effect))
+	}
+
+	return info
+}
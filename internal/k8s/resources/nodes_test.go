@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvertNode(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-1",
+			Labels: map[string]string{
+				"node-role.kubernetes.io/worker": "",
+				"node-role.kubernetes.io/infra":  "",
+				"kubernetes.io/hostname":         "worker-1",
+			},
+		},
+		Spec: corev1.NodeSpec{
+			Unschedulable: true,
+			Taints:        []corev1.Taint{{Key: "dedicated", Value: "infra", Effect: corev1.TaintEffectNoSchedule}},
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("3500m"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+				corev1.ResourcePods:   resource.MustParse("250"),
+			},
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeHostName, Address: "worker-1"},
+				{Type: corev1.NodeInternalIP, Address: "10.0.0.5"},
+			},
+			NodeInfo: corev1.NodeSystemInfo{KubeletVersion: "v1.30.4"},
+		},
+	}
+
+	info := convertNode(node)
+	if info.Status != "Ready,SchedulingDisabled" || !info.Unschedulable {
+		t.Errorf("Expected a cordoned ready node, got %q", info.Status)
+	}
+	if !reflect.DeepEqual(info.Roles, []string{"infra", "worker"}) {
+		t.Errorf("Expected sorted roles, got %v", info.Roles)
+	}
+	if info.CPUAllocatable != "3500m" || info.MemoryAllocatable != "16.0Gi" || info.PodCapacity != 250 {
+		t.Errorf("Unexpected allocatable resources %s %s %d", info.CPUAllocatable, info.MemoryAllocatable, info.PodCapacity)
+	}
+	if info.InternalIP != "10.0.0.5" || info.KubeletVersion != "v1.30.4" {
+		t.Errorf("Unexpected address or version %s %s", info.InternalIP, info.KubeletVersion)
+	}
+	if !reflect.DeepEqual(info.Taints, []string{"dedicated=infra:NoSchedule"}) {
+		t.Errorf("Unexpected taints %v", info.Taints)
+	}
+
+	node.Status.Conditions = nil
+	node.Spec.Unschedulable = false
+	if status := nodeStatus(node); status != "Unknown" {
+		t.Errorf("Expected Unknown without a Ready condition, got %q", status)
+	}
+}
+
+func TestPlanDrain(t *testing.T) {
+	controller := true
+	owned := func(kind string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: "owner", Controller: &controller}}
+	}
+
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "web-1", OwnerReferences: owned("ReplicaSet")}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-dns", Name: "dns-1", OwnerReferences: owned("DaemonSet")}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "etcd", Annotations: map[string]string{mirrorPodAnnotation: "hash"}}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "debug"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "done"}, Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "demo", Name: "cache-1", OwnerReferences: owned("ReplicaSet")},
+			Spec:       corev1.PodSpec{Volumes: []corev1.Volume{{Name: "scratch", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}},
+		},
+	}
+
+	plan := planDrain("worker-1", pods)
+	if !reflect.DeepEqual(plan.Evict, []string{"demo/web-1", "demo/done"}) {
+		t.Errorf("Unexpected pods to evict %v", plan.Evict)
+	}
+	if !reflect.DeepEqual(plan.Skipped, []string{"openshift-dns/dns-1", "kube-system/etcd"}) {
+		t.Errorf("Unexpected skipped pods %v", plan.Skipped)
+	}
+	if !reflect.DeepEqual(plan.Blocked, []string{"demo/debug"}) {
+		t.Errorf("Expected the bare pod to block the drain, got %v", plan.Blocked)
+	}
+	if !reflect.DeepEqual(plan.LocalData, []string{"demo/cache-1"}) {
+		t.Errorf("Expected the emptyDir pod to block the drain, got %v", plan.LocalData)
+	}
+}
@@ -0,0 +1,279 @@
+package resources
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// objectCountResources are the namespaced resource types counted by
+// CountObjects
+var objectCountResources = []schema.GroupVersionResource{
+	{Version: "v1", Resource: "pods"},
+	{Version: "v1", Resource: "services"},
+	{Version: "v1", Resource: "configmaps"},
+	{Version: "v1", Resource: "secrets"},
+	{Version: "v1", Resource: "serviceaccounts"},
+	{Version: "v1", Resource: "persistentvolumeclaims"},
+	{Version: "v1", Resource: "replicationcontrollers"},
+	{Version: "v1", Resource: "events"},
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "apps", Version: "v1", Resource: "replicasets"},
+	{Group: "apps", Version: "v1", Resource: "statefulsets"},
+	{Group: "apps", Version: "v1", Resource: "daemonsets"},
+	{Group: "batch", Version: "v1", Resource: "jobs"},
+	{Group: "batch", Version: "v1", Resource: "cronjobs"},
+}
+
+// storedObjectMetrics are the API server metrics holding the number of
+// objects stored in etcd per resource. Kubernetes 1.31 renamed the metric,
+// older servers only serve the first.
+var storedObjectMetrics = []string{"apiserver_storage_objects", "apiserver_resource_objects"}
+
+// CountObjects counts the objects of common namespaced resource types in a
+// namespace, or in every namespace. Only object metadata is listed. Types
+// the user may not list are skipped. Cleanup counts are best effort: finished
+// pods, completed or failed Jobs, ReplicaSets scaled to zero and legacy
+// service account token secrets.
+func (c *K8sResourceClient) CountObjects(ctx context.Context, namespace string, allNamespaces bool) ([]ObjectCount, error) {
+	if allNamespaces {
+		namespace = metav1.NamespaceAll
+	} else if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	metadataClient, err := c.metadataClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []ObjectCount
+	var firstErr error
+	for _, gvr := range objectCountResources {
+		perNamespace, err := c.countMetadata(ctx, metadataClient, gvr, namespace, "")
+		if err != nil {
+			if !apierrors.IsForbidden(err) && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to count %s: %w", gvr.Resource, err)
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		cleanup := c.countCleanup(ctx, metadataClient, gvr, namespace)
+		for ns, count := range perNamespace {
+			counts = append(counts, ObjectCount{Namespace: ns, Resource: gvr.Resource, Count: count, Cleanup: cleanup[ns]})
+		}
+	}
+	if len(counts) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+
+	sortObjectCounts(counts)
+	return counts, nil
+}
+
+// metadataClient creates a client that lists and deletes objects of any
+// resource by their metadata only
+func (c *K8sResourceClient) metadataClient() (metadata.Interface, error) {
+	if c.restConfig == nil {
+		return nil, fmt.Errorf("REST config not available for metadata operations")
+	}
+	client, err := metadata.NewForConfig(c.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metadata client: %w", err)
+	}
+	return client, nil
+}
+
+// countMetadata counts the objects of a resource matching fieldSelector per
+// namespace, listing metadata only, page by page
+func (c *K8sResourceClient) countMetadata(ctx context.Context, client metadata.Interface, gvr schema.GroupVersionResource, namespace, fieldSelector string) (map[string]int, error) {
+	counts := make(map[string]int)
+	opts := metav1.ListOptions{FieldSelector: fieldSelector, Limit: c.defaultLimit}
+	for {
+		list, err := client.Resource(gvr).Namespace(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			counts[list.Items[i].Namespace]++
+		}
+		if list.Continue == "" {
+			return counts, nil
+		}
+		opts.Continue = list.Continue
+	}
+}
+
+// countCleanup counts the objects of a resource that usually can be deleted,
+// per namespace. Failures leave the counts empty.
+func (c *K8sResourceClient) countCleanup(ctx context.Context, client metadata.Interface, gvr schema.GroupVersionResource, namespace string) map[string]int {
+	counts := make(map[string]int)
+	switch gvr.Resource {
+	case "pods":
+		selector := fmt.Sprintf("status.phase!=%s,status.phase!=%s", corev1.PodRunning, corev1.PodPending)
+		if finished, err := c.countMetadata(ctx, client, gvr, namespace, selector); err == nil {
+			counts = finished
+		}
+	case "secrets":
+		selector := "type=" + string(corev1.SecretTypeServiceAccountToken)
+		if tokens, err := c.countMetadata(ctx, client, gvr, namespace, selector); err == nil {
+			counts = tokens
+		}
+	case "jobs":
+		if jobs, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+			for i := range jobs.Items {
+				if status := jobStatus(&jobs.Items[i]); status == "Complete" || status == "Failed" {
+					counts[jobs.Items[i].Namespace]++
+				}
+			}
+		}
+	case "replicasets":
+		if replicaSets, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+			for i := range replicaSets.Items {
+				rs := &replicaSets.Items[i]
+				if rs.Spec.Replicas != nil && *rs.Spec.Replicas == 0 && rs.Status.Replicas == 0 {
+					counts[rs.Namespace]++
+				}
+			}
+		}
+	}
+	return counts
+}
+
+// sortObjectCounts orders counts by namespace, largest first within a namespace
+func sortObjectCounts(counts []ObjectCount) {
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Namespace != counts[j].Namespace {
+			return counts[i].Namespace < counts[j].Namespace
+		}
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Resource < counts[j].Resource
+	})
+}
+
+// SummarizeObjectCounts totals the counts per namespace, the namespaces with
+// the most objects first
+func SummarizeObjectCounts(counts []ObjectCount) []NamespaceObjects {
+	byNamespace := make(map[string]*NamespaceObjects)
+	for _, count := range counts {
+		summary, ok := byNamespace[count.Namespace]
+		if !ok {
+			summary = &NamespaceObjects{Namespace: count.Namespace}
+			byNamespace[count.Namespace] = summary
+		}
+		summary.Total += count.Count
+		summary.Cleanup += count.Cleanup
+		summary.Counts = append(summary.Counts, count)
+	}
+
+	summaries := make([]NamespaceObjects, 0, len(byNamespace))
+	for _, summary := range byNamespace {
+		sortObjectCounts(summary.Counts)
+		summaries = append(summaries, *summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Total != summaries[j].Total {
+			return summaries[i].Total > summaries[j].Total
+		}
+		return summaries[i].Namespace < summaries[j].Namespace
+	})
+	return summaries
+}
+
+// ListStoredObjectCounts returns the number of objects the API server keeps
+// in etcd per resource, the largest first. Reading the API server metrics
+// needs cluster-wide access.
+func (c *K8sResourceClient) ListStoredObjectCounts(ctx context.Context) ([]StoredObjectCount, error) {
+	body, err := c.clientset.Discovery().RESTClient().Get().
+		AbsPath(constants.APIServerMetricsPath).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API server metrics: %w", err)
+	}
+
+	return parseStoredObjectCounts(body), nil
+}
+
+// parseStoredObjectCounts reads the stored object gauges from API server
+// metrics in the Prometheus text format
+func parseStoredObjectCounts(body []byte) []StoredObjectCount {
+	byResource := make(map[string]int64)
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		name, rest, ok := strings.Cut(line, "{")
+		if !ok || !isStoredObjectMetric(name) {
+			continue
+		}
+		labels, value, ok := strings.Cut(rest, "} ")
+		if !ok {
+			continue
+		}
+		count, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || count < 0 {
+			// -1 marks resources whose count is unknown
+			continue
+		}
+
+		resource, group := metricLabel(labels, "resource"), metricLabel(labels, "group")
+		if resource == "" {
+			continue
+		}
+		if group != "" && !strings.Contains(resource, ".") {
+			resource += "." + group
+		}
+		byResource[resource] = int64(count)
+	}
+
+	stored := make([]StoredObjectCount, 0, len(byResource))
+	for resource, count := range byResource {
+		stored = append(stored, StoredObjectCount{Resource: resource, Count: count})
+	}
+	sort.Slice(stored, func(i, j int) bool {
+		if stored[i].Count != stored[j].Count {
+			return stored[i].Count > stored[j].Count
+		}
+		return stored[i].Resource < stored[j].Resource
+	})
+	return stored
+}
+
+// isStoredObjectMetric reports whether a metric counts stored objects
+func isStoredObjectMetric(name string) bool {
+	for _, metric := range storedObjectMetrics {
+		if name == metric {
+			return true
+		}
+	}
+	return false
+}
+
+// metricLabel returns the value of a label from the label set of a metric
+// sample, such as group="apps",resource="deployments"
+func metricLabel(labels, name string) string {
+	for _, pair := range strings.Split(labels, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if ok && strings.TrimSpace(key) == name {
+			return strings.Trim(value, `"`)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStoredObjectCounts(t *testing.T) {
+	body := []byte(`# HELP apiserver_storage_objects [STABLE] Number of stored objects at the time of last check split by kind.
+# TYPE apiserver_storage_objects gauge
+apiserver_storage_objects{resource="secrets"} 4210
+apiserver_storage_objects{resource="deployments.apps"} 120
+apiserver_storage_objects{resource="leases.coordination.k8s.io"} -1
+apiserver_resource_objects{group="batch",resource="jobs"} 1500
+apiserver_storage_size_bytes{resource="secrets"} 9000
+apiserver_request_total{code="200",resource="secrets"} 12
+`)
+
+	got := parseStoredObjectCounts(body)
+	want := []StoredObjectCount{
+		{Resource: "secrets", Count: 4210},
+		{Resource: "jobs.batch", Count: 1500},
+		{Resource: "deployments.apps", Count: 120},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseStoredObjectCounts() = %+v, expected %+v", got, want)
+	}
+}
+
+func TestSummarizeObjectCounts(t *testing.T) {
+	counts := []ObjectCount{
+		{Namespace: "demo", Resource: "pods", Count: 4},
+		{Namespace: "ci", Resource: "jobs", Count: 300, Cleanup: 295},
+		{Namespace: "ci", Resource: "secrets", Count: 40, Cleanup: 2},
+		{Namespace: "demo", Resource: "secrets", Count: 9},
+	}
+
+	summaries := SummarizeObjectCounts(counts)
+	if len(summaries) != 2 {
+		t.Fatalf("Expected 2 namespaces, got %+v", summaries)
+	}
+	ci := summaries[0]
+	if ci.Namespace != "ci" || ci.Total != 340 || ci.Cleanup != 297 || ci.Counts[0].Resource != "jobs" {
+		t.Errorf("Expected ci first with 340 objects led by jobs, got %+v", ci)
+	}
+	demo := summaries[1]
+	if demo.Total != 13 || demo.Counts[0].Resource != "secrets" {
+		t.Errorf("Expected demo with 13 objects led by secrets, got %+v", demo)
+	}
+}
@@ -1,18 +1,26 @@
 package resources
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/duration"
 
 	appsv1 "github.com/openshift/api/apps/v1"
 	buildv1 "github.com/openshift/api/build/v1"
+	configv1 "github.com/openshift/api/config/v1"
 	imagev1 "github.com/openshift/api/image/v1"
 	routev1 "github.com/openshift/api/route/v1"
 
+	"github.com/katyella/lazyoc/internal/constants"
 	"github.com/katyella/lazyoc/internal/k8s"
 )
 
@@ -83,8 +91,8 @@ func (c *OpenShiftResourceClient) GetBuildConfig(ctx context.Context, namespace,
 	return &info, nil
 }
 
-// TriggerBuild starts a new build from a BuildConfig
-func (c *OpenShiftResourceClient) TriggerBuild(ctx context.Context, namespace, name string) (*BuildInfo, error) {
+// StartBuild instantiates a new Build from a BuildConfig, like `oc start-build`
+func (c *OpenShiftResourceClient) StartBuild(ctx context.Context, namespace, name string) (*BuildInfo, error) {
 	if !c.client.IsOpenShift() {
 		return nil, fmt.Errorf("not connected to an OpenShift cluster")
 	}
@@ -97,9 +105,12 @@ func (c *OpenShiftResourceClient) TriggerBuild(ctx context.Context, namespace, n
 			Name:      name,
 			Namespace: namespace,
 		},
+		TriggeredBy: []buildv1.BuildTriggerCause{
+			{Message: constants.ManualBuildTriggerMessage},
+		},
 	}
 
-	build, err := buildClient.BuildV1().BuildConfigs(namespace).Instantiate(ctx, name, buildRequest, metav1.CreateOptions{})
+	build, err := buildClient.BuildV1().BuildConfigs(namespace).Instantiate(ctx, name, buildRequest, metav1.CreateOptions{DryRun: dryRunOption(ctx)})
 	if err != nil {
 		return nil, fmt.Errorf("failed to trigger build for BuildConfig %s: %w", name, err)
 	}
@@ -147,6 +158,50 @@ func (c *OpenShiftResourceClient) ListBuilds(ctx context.Context, opts ListOptio
 	}, nil
 }
 
+// StreamBuildLogs reads the logs of a Build, like `oc logs build/<name>`, and
+// with follow keeps streaming them until the build ends like `oc logs -f`
+func (c *OpenShiftResourceClient) StreamBuildLogs(ctx context.Context, namespace, name string, follow bool) (<-chan string, error) {
+	if !c.client.IsOpenShift() {
+		return nil, fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	stream, err := c.client.GetBuildClient().BuildV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource("builds").
+		Name(name).
+		SubResource("log").
+		Param("follow", strconv.FormatBool(follow)).
+		Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs for build %s: %w", name, err)
+	}
+
+	logChan := make(chan string, constants.LogChannelBufferSize)
+
+	go func() {
+		defer close(logChan)
+		defer stream.Close()
+
+		scanner := bufio.NewScanner(stream)
+		for scanner.Scan() {
+			select {
+			case <-ctx.Done():
+				return
+			case logChan <- scanner.Text():
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			select {
+			case <-ctx.Done():
+			case logChan <- fmt.Sprintf("Error reading build logs: %v", err):
+			}
+		}
+	}()
+
+	return logChan, nil
+}
+
 // ImageStreams
 
 // ListImageStreams retrieves ImageStreams from the specified namespace
@@ -225,6 +280,86 @@ func (c *OpenShiftResourceClient) ListDeploymentConfigs(ctx context.Context, opt
 	}, nil
 }
 
+// RolloutLatestDeploymentConfig starts a new rollout of a DeploymentConfig
+// from its current template, like `oc rollout latest`
+func (c *OpenShiftResourceClient) RolloutLatestDeploymentConfig(ctx context.Context, namespace, name string) (*DeploymentConfigInfo, error) {
+	if !c.client.IsOpenShift() {
+		return nil, fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	request := &appsv1.DeploymentRequest{
+		Name:   name,
+		Latest: true,
+		Force:  true,
+	}
+	dc, err := c.client.GetAppsClient().AppsV1().DeploymentConfigs(namespace).Instantiate(ctx, name, request, metav1.CreateOptions{DryRun: dryRunOption(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll out DeploymentConfig %s: %w", name, err)
+	}
+
+	info := deploymentConfigToInfo(dc)
+	return &info, nil
+}
+
+// RollbackDeploymentConfig rolls a DeploymentConfig back to the template of
+// an earlier version, like `oc rollout undo`. A version of 0 means the one
+// before the latest. Automatic image change triggers are disabled, as oc
+// does, so a new image does not immediately replace the rollback.
+func (c *OpenShiftResourceClient) RollbackDeploymentConfig(ctx context.Context, namespace, name string, version int64) (*DeploymentConfigInfo, error) {
+	if !c.client.IsOpenShift() {
+		return nil, fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	dcClient := c.client.GetAppsClient().AppsV1().DeploymentConfigs(namespace)
+	if version == 0 {
+		current, err := dcClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get DeploymentConfig %s: %w", name, err)
+		}
+		version = current.Status.LatestVersion - 1
+	}
+	if version < 1 {
+		return nil, fmt.Errorf("DeploymentConfig %s has no earlier version to roll back to", name)
+	}
+
+	rollback := &appsv1.DeploymentConfigRollback{
+		Name: name,
+		Spec: appsv1.DeploymentConfigRollbackSpec{
+			From:            corev1.ObjectReference{Name: deploymentConfigVersionName(name, version)},
+			IncludeTemplate: true,
+		},
+	}
+	// The rollback endpoint only generates the rolled back config, it is
+	// saved with an update
+	dc, err := dcClient.Rollback(ctx, name, rollback, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back DeploymentConfig %s to version %d: %w", name, version, err)
+	}
+	disableImageTriggers(dc)
+
+	updated, err := dcClient.Update(ctx, dc, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back DeploymentConfig %s to version %d: %w", name, version, err)
+	}
+
+	info := deploymentConfigToInfo(updated)
+	return &info, nil
+}
+
+// deploymentConfigVersionName names the ReplicationController of a DeploymentConfig version
+func deploymentConfigVersionName(name string, version int64) string {
+	return fmt.Sprintf("%s-%d", name, version)
+}
+
+// disableImageTriggers turns off automatic image change triggers
+func disableImageTriggers(dc *appsv1.DeploymentConfig) {
+	for i := range dc.Spec.Triggers {
+		if params := dc.Spec.Triggers[i].ImageChangeParams; params != nil {
+			params.Automatic = false
+		}
+	}
+}
+
 // Routes
 
 // ListRoutes retrieves Routes from the specified namespace
@@ -294,6 +429,182 @@ func (c *OpenShiftResourceClient) ListSubscriptions(ctx context.Context, opts Li
 	}, nil
 }
 
+// GetYAML returns the full YAML manifest of an OpenShift resource
+func (c *OpenShiftResourceClient) GetYAML(ctx context.Context, kind, namespace, name string) (string, error) {
+	if !c.client.IsOpenShift() {
+		return "", fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	var obj runtime.Object
+	var apiVersion string
+	var err error
+
+	switch strings.ToLower(kind) {
+	case "buildconfig":
+		obj, err = c.client.GetBuildClient().BuildV1().BuildConfigs(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "build.openshift.io/v1"
+	case "build":
+		obj, err = c.client.GetBuildClient().BuildV1().Builds(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "build.openshift.io/v1"
+	case "imagestream":
+		obj, err = c.client.GetImageClient().ImageV1().ImageStreams(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "image.openshift.io/v1"
+	case "route":
+		obj, err = c.client.GetRouteClient().RouteV1().Routes(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "route.openshift.io/v1"
+	case "deploymentconfig":
+		obj, err = c.client.GetAppsClient().AppsV1().DeploymentConfigs(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "apps.openshift.io/v1"
+	default:
+		return "", fmt.Errorf("YAML view not supported for kind %s", kind)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return marshalObjectYAML(obj, apiVersion, kindName(kind))
+}
+
+// UpdateFromYAML replaces an OpenShift resource with an edited manifest
+func (c *OpenShiftResourceClient) UpdateFromYAML(ctx context.Context, kind, namespace, name string, manifest []byte) error {
+	if !c.client.IsOpenShift() {
+		return fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	var err error
+	switch strings.ToLower(kind) {
+	case "buildconfig":
+		obj := &buildv1.BuildConfig{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.client.GetBuildClient().BuildV1().BuildConfigs(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	case "imagestream":
+		obj := &imagev1.ImageStream{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.client.GetImageClient().ImageV1().ImageStreams(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	case "route":
+		obj := &routev1.Route{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.client.GetRouteClient().RouteV1().Routes(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	case "deploymentconfig":
+		obj := &appsv1.DeploymentConfig{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.client.GetAppsClient().AppsV1().DeploymentConfigs(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	default:
+		return fmt.Errorf("editing is not supported for kind %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return nil
+}
+
+// Patch applies a patch to an OpenShift resource
+func (c *OpenShiftResourceClient) Patch(ctx context.Context, kind, namespace, name string, patchType types.PatchType, data []byte) error {
+	if !c.client.IsOpenShift() {
+		return fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	var err error
+	switch strings.ToLower(kind) {
+	case "buildconfig":
+		_, err = c.client.GetBuildClient().BuildV1().BuildConfigs(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "imagestream":
+		_, err = c.client.GetImageClient().ImageV1().ImageStreams(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "route":
+		_, err = c.client.GetRouteClient().RouteV1().Routes(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "deploymentconfig":
+		_, err = c.client.GetAppsClient().AppsV1().DeploymentConfigs(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	default:
+		return fmt.Errorf("patching is not supported for kind %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to patch %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return nil
+}
+
+// ClusterOperators
+
+// ListClusterOperators retrieves the cluster-scoped ClusterOperators
+func (c *OpenShiftResourceClient) ListClusterOperators(ctx context.Context, opts ListOptions) (*ResourceList[ClusterOperatorInfo], error) {
+	if !c.client.IsOpenShift() {
+		return nil, fmt.Errorf("not connected to an OpenShift cluster")
+	}
+
+	configClient := c.client.GetConfigClient()
+	if configClient == nil {
+		return nil, fmt.Errorf("OpenShift config client not initialized")
+	}
+
+	operators, err := configClient.ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ClusterOperators: %w", err)
+	}
+
+	items := make([]ClusterOperatorInfo, 0, len(operators.Items))
+	for _, co := range operators.Items {
+		items = append(items, clusterOperatorToInfo(&co))
+	}
+
+	return &ResourceList[ClusterOperatorInfo]{
+		Items:    items,
+		Total:    len(items),
+		Continue: operators.Continue,
+		Remaining: func() int64 {
+			if operators.RemainingItemCount != nil {
+				return *operators.RemainingItemCount
+			}
+			return 0
+		}(),
+	}, nil
+}
+
+// GetControlPlaneOperatorHealth reports the health of the operators that manage
+// the control plane (API server, scheduler, controller manager and etcd)
+func (c *OpenShiftResourceClient) GetControlPlaneOperatorHealth(ctx context.Context) ([]ComponentHealthInfo, error) {
+	operators, err := c.ListClusterOperators(ctx, ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]ClusterOperatorInfo, len(operators.Items))
+	for _, co := range operators.Items {
+		byName[co.Name] = co
+	}
+
+	components := make([]ComponentHealthInfo, 0, len(constants.ControlPlaneOperators))
+	for _, name := range constants.ControlPlaneOperators {
+		co, found := byName[name]
+		if !found {
+			components = append(components, ComponentHealthInfo{
+				Name:    name,
+				Source:  HealthSourceClusterOperator,
+				Status:  "Unknown",
+				Message: "operator not found",
+			})
+			continue
+		}
+		components = append(components, clusterOperatorToHealth(co))
+	}
+
+	return components, nil
+}
+
 // Helper conversion functions
 
 func buildConfigToInfo(bc *buildv1.BuildConfig) BuildConfigInfo {
@@ -308,7 +619,7 @@ func buildConfigToInfo(bc *buildv1.BuildConfig) BuildConfigInfo {
 			CreatedAt:   bc.CreationTimestamp.Time,
 			Status:      "Ready", // BuildConfigs don't have a phase
 		},
-		Age: duration.HumanDuration(time.Since(bc.CreationTimestamp.Time)),
+		Age: formatAge(bc.CreationTimestamp.Time),
 	}
 
 	// Set strategy
@@ -361,7 +672,7 @@ func buildToInfo(build *buildv1.Build) BuildInfo {
 		Message:     build.Status.Message,
 		StartTime:   build.Status.StartTimestamp.Time,
 		BuildConfig: build.Labels["buildconfig"],
-		Age:         duration.HumanDuration(time.Since(build.CreationTimestamp.Time)),
+		Age:         formatAge(build.CreationTimestamp.Time),
 	}
 
 	// Set completion time and duration
@@ -382,6 +693,11 @@ func buildToInfo(build *buildv1.Build) BuildInfo {
 		info.OutputImage = build.Status.OutputDockerImageReference
 	}
 
+	// Set source commit
+	if build.Spec.Revision != nil && build.Spec.Revision.Git != nil {
+		info.Commit = build.Spec.Revision.Git.Commit
+	}
+
 	return info
 }
 
@@ -399,7 +715,7 @@ func imageStreamToInfo(is *imagev1.ImageStream) ImageStreamInfo {
 		},
 		DockerImageRepository:       is.Status.DockerImageRepository,
 		PublicDockerImageRepository: is.Status.PublicDockerImageRepository,
-		Age:                         duration.HumanDuration(time.Since(is.CreationTimestamp.Time)),
+		Age:                         formatAge(is.CreationTimestamp.Time),
 	}
 
 	// Convert tags
@@ -431,28 +747,91 @@ func deploymentConfigToInfo(dc *appsv1.DeploymentConfig) DeploymentConfigInfo {
 			Name:        dc.Name,
 			Namespace:   dc.Namespace,
 			Kind:        "DeploymentConfig",
-			APIVersion:  dc.APIVersion,
+			APIVersion:  "apps.openshift.io/v1",
 			Labels:      dc.Labels,
 			Annotations: dc.Annotations,
 			CreatedAt:   dc.CreationTimestamp.Time,
-			Status:      "Ready", // DeploymentConfigs don't have a simple phase
+			Status:      deploymentConfigStatus(dc),
 		},
 		Replicas:          dc.Spec.Replicas,
 		ReadyReplicas:     dc.Status.ReadyReplicas,
 		UpdatedReplicas:   dc.Status.UpdatedReplicas,
 		AvailableReplicas: dc.Status.AvailableReplicas,
 		LatestVersion:     dc.Status.LatestVersion,
-		Age:               duration.HumanDuration(time.Since(dc.CreationTimestamp.Time)),
+		Paused:            dc.Spec.Paused,
+		Age:               formatAge(dc.CreationTimestamp.Time),
 	}
 
 	// Set strategy
 	info.Strategy = DeploymentStrategy{
 		Type: string(dc.Spec.Strategy.Type),
 	}
+	if params := dc.Spec.Strategy.RollingParams; params != nil {
+		info.Strategy.RollingParams = &RollingDeploymentParams{
+			UpdatePeriodSeconds: params.UpdatePeriodSeconds,
+			IntervalSeconds:     params.IntervalSeconds,
+			TimeoutSeconds:      params.TimeoutSeconds,
+		}
+		if params.MaxUnavailable != nil {
+			info.Strategy.RollingParams.MaxUnavailable = params.MaxUnavailable.String()
+		}
+		if params.MaxSurge != nil {
+			info.Strategy.RollingParams.MaxSurge = params.MaxSurge.String()
+		}
+	}
+	if params := dc.Spec.Strategy.RecreateParams; params != nil {
+		info.Strategy.RecreateParams = &RecreateDeploymentParams{TimeoutSeconds: params.TimeoutSeconds}
+	}
+
+	for _, trigger := range dc.Spec.Triggers {
+		t := DeploymentTrigger{Type: string(trigger.Type)}
+		if params := trigger.ImageChangeParams; params != nil {
+			t.ImageChange = &DeploymentTriggerImageChange{
+				From: &ImageStreamReference{
+					Kind:      params.From.Kind,
+					Namespace: params.From.Namespace,
+					Name:      params.From.Name,
+				},
+				LastTriggeredImage: params.LastTriggeredImage,
+				ContainerNames:     params.ContainerNames,
+				Automatic:          params.Automatic,
+			}
+		}
+		info.Triggers = append(info.Triggers, t)
+	}
+
+	for _, cond := range dc.Status.Conditions {
+		info.Conditions = append(info.Conditions, DeploymentCondition{
+			Type:               string(cond.Type),
+			Status:             string(cond.Status),
+			LastUpdateTime:     cond.LastUpdateTime.Time,
+			LastTransitionTime: cond.LastTransitionTime.Time,
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+		})
+	}
+
+	if dc.Spec.Template != nil {
+		info.Images = templateImages(*dc.Spec.Template)
+	}
 
 	return info
 }
 
+// deploymentConfigStatus summarizes a DeploymentConfig like a Deployment,
+// noting rollouts that failed to progress
+func deploymentConfigStatus(dc *appsv1.DeploymentConfig) string {
+	for _, cond := range dc.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse {
+			return "Failed"
+		}
+	}
+	if dc.Spec.Paused {
+		return "Paused"
+	}
+	return replicaStatus(dc.Spec.Replicas, dc.Status.ReadyReplicas)
+}
+
 func routeToInfo(route *routev1.Route) RouteInfo {
 	info := RouteInfo{
 		ResourceInfo: ResourceInfo{
@@ -463,7 +842,7 @@ func routeToInfo(route *routev1.Route) RouteInfo {
 			Labels:      route.Labels,
 			Annotations: route.Annotations,
 			CreatedAt:   route.CreationTimestamp.Time,
-			Status:      "Ready", // Routes don't have a simple phase
+			Status:      routeAdmissionStatus(route),
 		},
 		Host: route.Spec.Host,
 		Path: route.Spec.Path,
@@ -472,7 +851,7 @@ func routeToInfo(route *routev1.Route) RouteInfo {
 			Name:   route.Spec.To.Name,
 			Weight: route.Spec.To.Weight,
 		},
-		Age: duration.HumanDuration(time.Since(route.CreationTimestamp.Time)),
+		Age: formatAge(route.CreationTimestamp.Time),
 	}
 
 	// Set port
@@ -494,6 +873,132 @@ func routeToInfo(route *routev1.Route) RouteInfo {
 		}
 	}
 
+	// Each router shard that selects the route reports whether it admitted it
+	for _, ingress := range route.Status.Ingress {
+		admission := RouteAdmission{
+			RouterName:        ingress.RouterName,
+			CanonicalHostname: ingress.RouterCanonicalHostname,
+			Host:              ingress.Host,
+			Admitted:          string(corev1.ConditionUnknown),
+		}
+		for _, cond := range ingress.Conditions {
+			if cond.Type != routev1.RouteAdmitted {
+				continue
+			}
+			admission.Admitted = string(cond.Status)
+			admission.Reason = cond.Reason
+			admission.Message = cond.Message
+
+			condition := RouteCondition{
+				Type:    string(cond.Type),
+				Status:  string(cond.Status),
+				Reason:  cond.Reason,
+				Message: cond.Message,
+			}
+			if cond.LastTransitionTime != nil {
+				condition.LastTransitionTime = cond.LastTransitionTime.Time
+			}
+			info.AdmittedConditions = append(info.AdmittedConditions, condition)
+		}
+		info.Admissions = append(info.Admissions, admission)
+	}
+
 	return info
 }
 
+// routeAdmissionStatus summarizes the router admissions of a route: Admitted
+// when at least one router serves it, Rejected when every router refused it
+// and NoRouter when no router selected it at all, typically because no
+// IngressController shard matches its namespace or labels
+func routeAdmissionStatus(route *routev1.Route) string {
+	if len(route.Status.Ingress) == 0 {
+		return "NoRouter"
+	}
+
+	status := "Pending"
+	for _, ingress := range route.Status.Ingress {
+		for _, cond := range ingress.Conditions {
+			if cond.Type != routev1.RouteAdmitted {
+				continue
+			}
+			switch cond.Status {
+			case corev1.ConditionTrue:
+				return "Admitted"
+			case corev1.ConditionFalse:
+				status = "Rejected"
+			}
+		}
+	}
+	return status
+}
+
+func clusterOperatorToInfo(co *configv1.ClusterOperator) ClusterOperatorInfo {
+	info := ClusterOperatorInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        co.Name,
+			Kind:        "ClusterOperator",
+			APIVersion:  co.APIVersion,
+			Labels:      co.Labels,
+			Annotations: co.Annotations,
+			CreatedAt:   co.CreationTimestamp.Time,
+		},
+		Available:   string(configv1.ConditionUnknown),
+		Progressing: string(configv1.ConditionUnknown),
+		Degraded:    string(configv1.ConditionUnknown),
+		Conditions:  make([]OperatorCondition, 0, len(co.Status.Conditions)),
+		Age:         formatAge(co.CreationTimestamp.Time),
+	}
+
+	// The operator version is the entry named after the operator itself
+	for _, v := range co.Status.Versions {
+		if v.Name == "operator" {
+			info.Version = v.Version
+			break
+		}
+	}
+
+	for _, cond := range co.Status.Conditions {
+		info.Conditions = append(info.Conditions, OperatorCondition{
+			Type:               string(cond.Type),
+			Status:             string(cond.Status),
+			LastTransitionTime: cond.LastTransitionTime.Time,
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+		})
+
+		switch cond.Type {
+		case configv1.OperatorAvailable:
+			info.Available = string(cond.Status)
+		case configv1.OperatorProgressing:
+			info.Progressing = string(cond.Status)
+		case configv1.OperatorDegraded:
+			info.Degraded = string(cond.Status)
+			if cond.Status == configv1.ConditionTrue {
+				info.Message = cond.Message
+			}
+		}
+	}
+
+	switch {
+	case info.Degraded == string(configv1.ConditionTrue):
+		info.Status = "Degraded"
+	case info.Available != string(configv1.ConditionTrue):
+		info.Status = "Unavailable"
+	case info.Progressing == string(configv1.ConditionTrue):
+		info.Status = "Progressing"
+	default:
+		info.Status = "Available"
+	}
+
+	return info
+}
+
+func clusterOperatorToHealth(co ClusterOperatorInfo) ComponentHealthInfo {
+	return ComponentHealthInfo{
+		Name:    co.Name,
+		Source:  HealthSourceClusterOperator,
+		Healthy: co.Status == "Available" || co.Status == "Progressing",
+		Status:  co.Status,
+		Message: co.Message,
+	}
+}
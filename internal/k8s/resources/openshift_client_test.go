@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"testing"
+
+	appsv1 "github.com/openshift/api/apps/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRouteToInfo_Admission(t *testing.T) {
+	admitted := func(router string, status corev1.ConditionStatus, reason string) routev1.RouteIngress {
+		return routev1.RouteIngress{
+			Host:                    "shop.apps.example.com",
+			RouterName:              router,
+			RouterCanonicalHostname: "router-" + router + ".apps.example.com",
+			Conditions:              []routev1.RouteIngressCondition{{Type: routev1.RouteAdmitted, Status: status, Reason: reason}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ingress []routev1.RouteIngress
+		status  string
+	}{
+		{"no router", nil, "NoRouter"},
+		{"admitted by one shard", []routev1.RouteIngress{admitted("internal", corev1.ConditionFalse, "HostAlreadyClaimed"), admitted("default", corev1.ConditionTrue, "")}, "Admitted"},
+		{"rejected", []routev1.RouteIngress{admitted("default", corev1.ConditionFalse, "HostAlreadyClaimed")}, "Rejected"},
+		{"undecided", []routev1.RouteIngress{{RouterName: "default"}}, "Pending"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := &routev1.Route{
+				ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "demo"},
+				Status:     routev1.RouteStatus{Ingress: tt.ingress},
+			}
+			info := routeToInfo(route)
+			if info.Status != tt.status {
+				t.Errorf("Expected status %s, got %s", tt.status, info.Status)
+			}
+			if len(info.Admissions) != len(tt.ingress) {
+				t.Fatalf("Expected %d admissions, got %d", len(tt.ingress), len(info.Admissions))
+			}
+		})
+	}
+
+	info := routeToInfo(&routev1.Route{Status: routev1.RouteStatus{Ingress: []routev1.RouteIngress{admitted("default", corev1.ConditionFalse, "HostAlreadyClaimed")}}})
+	admission := info.Admissions[0]
+	if admission.RouterName != "default" || admission.CanonicalHostname != "router-default.apps.example.com" || admission.Reason != "HostAlreadyClaimed" {
+		t.Errorf("Unexpected admission %+v", admission)
+	}
+	if len(info.AdmittedConditions) != 1 || info.AdmittedConditions[0].Status != "False" {
+		t.Errorf("Expected the admitted condition to be kept, got %+v", info.AdmittedConditions)
+	}
+}
+
+func TestDeploymentConfigToInfo(t *testing.T) {
+	dc := &appsv1.DeploymentConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "demo"},
+		Spec: appsv1.DeploymentConfigSpec{
+			Replicas: 3,
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.DeploymentStrategyTypeRolling, RollingParams: &appsv1.RollingDeploymentStrategyParams{}},
+			Triggers: appsv1.DeploymentTriggerPolicies{
+				{Type: appsv1.DeploymentTriggerOnConfigChange},
+				{Type: appsv1.DeploymentTriggerOnImageChange, ImageChangeParams: &appsv1.DeploymentTriggerImageChangeParams{
+					Automatic:          true,
+					ContainerNames:     []string{"web"},
+					From:               corev1.ObjectReference{Kind: "ImageStreamTag", Name: "shop:latest"},
+					LastTriggeredImage: "image-registry/demo/shop@sha256:abc",
+				}},
+			},
+			Template: &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "image-registry/demo/shop@sha256:abc"}}}},
+		},
+		Status: appsv1.DeploymentConfigStatus{LatestVersion: 4, ReadyReplicas: 2},
+	}
+
+	info := deploymentConfigToInfo(dc)
+	if info.Status != "Progressing" || info.LatestVersion != 4 {
+		t.Errorf("Expected a progressing DeploymentConfig at version 4, got %s at %d", info.Status, info.LatestVersion)
+	}
+	if len(info.Triggers) != 2 || info.Triggers[1].ImageChange == nil || !info.Triggers[1].ImageChange.Automatic || info.Triggers[1].ImageChange.From.Name != "shop:latest" {
+		t.Errorf("Expected config and image change triggers, got %+v", info.Triggers)
+	}
+	if len(info.Images) != 1 || info.Strategy.RollingParams == nil {
+		t.Errorf("Expected the template image and rolling params, got %v %+v", info.Images, info.Strategy)
+	}
+
+	dc.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"}}
+	if status := deploymentConfigToInfo(dc).Status; status != "Failed" {
+		t.Errorf("Expected a stalled rollout to be Failed, got %s", status)
+	}
+
+	disableImageTriggers(dc)
+	if dc.Spec.Triggers[1].ImageChangeParams.Automatic {
+		t.Errorf("Expected the image change trigger to be disabled")
+	}
+	if name := deploymentConfigVersionName("shop", 3); name != "shop-3" {
+		t.Errorf("Expected shop-3, got %s", name)
+	}
+}
@@ -0,0 +1,119 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/restmapper"
+)
+
+// maxOwnerDepth bounds the ownership chains followed, real ones are at most
+// a few objects long
+const maxOwnerDepth = 10
+
+// OwnerLink is an object of an ownership chain
+type OwnerLink struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+
+	// Missing is set when the owner named by the owner reference below it
+	// no longer exists, the object is then left to the garbage collector
+	Missing bool `json:"missing,omitempty"`
+}
+
+// OwnerResolver finds the controllers that created an object
+type OwnerResolver interface {
+	// OwnerChain returns the ownership chain of an object from the top
+	// controller down to the object itself, e.g. Deployment, ReplicaSet, Pod
+	OwnerChain(ctx context.Context, kind, namespace, name string) ([]OwnerLink, error)
+}
+
+// OwnerChain follows the owner references of an object up to the object
+// nothing owns, reading the metadata of each owner
+func (c *K8sResourceClient) OwnerChain(ctx context.Context, kind, namespace, name string) ([]OwnerLink, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	client, err := c.metadataClient()
+	if err != nil {
+		return nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.clientset.Discovery()))
+
+	// get reads the metadata of an object of the kind and API group version
+	get := func(groupVersion schema.GroupVersion, kind, name string) (metav1.Object, error) {
+		mapping, err := mapper.RESTMapping(schema.GroupKind{Group: groupVersion.Group, Kind: kind}, groupVersion.Version)
+		if err != nil {
+			return nil, fmt.Errorf("unknown kind %s: %w", kind, err)
+		}
+		var resource metadata.ResourceInterface = client.Resource(mapping.Resource)
+		if mapping.Scope.Name() != meta.RESTScopeNameRoot {
+			resource = client.Resource(mapping.Resource).Namespace(namespace)
+		}
+		return resource.Get(ctx, name, metav1.GetOptions{})
+	}
+
+	kind = kindName(kind)
+	gvk, err := mapper.KindFor(schema.GroupVersionResource{Group: kindGroups[kind], Resource: strings.ToLower(kind)})
+	if err != nil {
+		return nil, fmt.Errorf("unknown kind %s: %w", kind, err)
+	}
+	object, err := get(gvk.GroupVersion(), kind, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return walkOwners(kind, object, func(ref metav1.OwnerReference) (metav1.Object, error) {
+		groupVersion, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		return get(groupVersion, ref.Kind, ref.Name)
+	}), nil
+}
+
+// walkOwners follows the controller reference of each object, or its first
+// owner reference when none is the controller, and returns the chain top
+// controller first. An owner that cannot be read ends the chain.
+func walkOwners(kind string, object metav1.Object, get func(ref metav1.OwnerReference) (metav1.Object, error)) []OwnerLink {
+	chain := []OwnerLink{{Kind: kind, Name: object.GetName()}}
+	seen := map[types.UID]bool{object.GetUID(): true}
+
+	for len(chain) < maxOwnerDepth {
+		ref := metav1.GetControllerOf(object)
+		if ref == nil {
+			refs := object.GetOwnerReferences()
+			if len(refs) == 0 {
+				break
+			}
+			ref = &refs[0]
+		}
+		// Owner references cannot form a cycle the garbage collector keeps,
+		// but a broken one must not hang the chain
+		if seen[ref.UID] {
+			break
+		}
+		seen[ref.UID] = true
+
+		owner, err := get(*ref)
+		if err != nil {
+			chain = append(chain, OwnerLink{Kind: ref.Kind, Name: ref.Name, Missing: apierrors.IsNotFound(err)})
+			break
+		}
+		chain = append(chain, OwnerLink{Kind: ref.Kind, Name: ref.Name})
+		object = owner
+	}
+
+	slices.Reverse(chain)
+	return chain
+}
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestWalkOwners(t *testing.T) {
+	controller := true
+	owned := func(name string, uid types.UID, refs ...metav1.OwnerReference) *metav1.ObjectMeta {
+		return &metav1.ObjectMeta{Name: name, UID: uid, OwnerReferences: refs}
+	}
+	ref := func(kind, name string, uid types.UID, isController bool) metav1.OwnerReference {
+		return metav1.OwnerReference{Kind: kind, Name: name, UID: uid, Controller: &isController}
+	}
+	objects := map[types.UID]metav1.Object{
+		"deploy":     owned("web", "deploy"),
+		"rs":         owned("web-7d9f", "rs", ref("Deployment", "web", "deploy", controller)),
+		"loop":       owned("loop", "loop", ref("ReplicaSet", "loop-owner", "loop-owner", controller)),
+		"loop-owner": owned("loop-owner", "loop-owner", ref("ReplicaSet", "loop", "loop", controller)),
+	}
+	get := func(ref metav1.OwnerReference) (metav1.Object, error) {
+		if object, ok := objects[ref.UID]; ok {
+			return object, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "jobs"}, ref.Name)
+	}
+
+	tests := []struct {
+		name     string
+		kind     string
+		object   metav1.Object
+		expected []OwnerLink
+	}{
+		{"unowned", "Pod", owned("debug", "debug"), []OwnerLink{{Kind: "Pod", Name: "debug"}}},
+		{"deployment pod", "Pod", owned("web-7d9f-x2", "pod", ref("Node", "worker-1", "node", false), ref("ReplicaSet", "web-7d9f", "rs", controller)),
+			[]OwnerLink{{Kind: "Deployment", Name: "web"}, {Kind: "ReplicaSet", Name: "web-7d9f"}, {Kind: "Pod", Name: "web-7d9f-x2"}}},
+		{"deleted owner", "Pod", owned("report-x", "pod", ref("Job", "report", "job", controller)),
+			[]OwnerLink{{Kind: "Job", Name: "report", Missing: true}, {Kind: "Pod", Name: "report-x"}}},
+		{"first owner without controller", "ConfigMap", owned("cfg", "cfg", ref("ReplicaSet", "web-7d9f", "rs", false)),
+			[]OwnerLink{{Kind: "Deployment", Name: "web"}, {Kind: "ReplicaSet", Name: "web-7d9f"}, {Kind: "ConfigMap", Name: "cfg"}}},
+		{"cycle", "ReplicaSet", objects["loop"], []OwnerLink{{Kind: "ReplicaSet", Name: "loop-owner"}, {Kind: "ReplicaSet", Name: "loop"}}},
+	}
+
+	for _, test := range tests {
+		if chain := walkOwners(test.kind, test.object, get); !reflect.DeepEqual(chain, test.expected) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, chain)
+		}
+	}
+}
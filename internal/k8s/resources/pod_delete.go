@@ -0,0 +1,144 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// PodDeleteMode selects how a pod is removed
+type PodDeleteMode int
+
+const (
+	// PodDelete deletes the pod, giving it its grace period to shut down
+	PodDelete PodDeleteMode = iota
+
+	// PodEvict evicts the pod through the eviction API, which is refused
+	// while a PodDisruptionBudget allows no more disruptions
+	PodEvict
+
+	// PodForceDelete removes the pod from the API at once without waiting
+	// for the kubelet to stop it, like --force --grace-period=0
+	PodForceDelete
+)
+
+// String returns the name of the mode shown in the delete dialog
+func (m PodDeleteMode) String() string {
+	switch m {
+	case PodEvict:
+		return "evict"
+	case PodForceDelete:
+		return "force delete"
+	}
+	return "delete"
+}
+
+// PodDeleteOptions controls how a pod is deleted
+type PodDeleteOptions struct {
+	Mode PodDeleteMode
+
+	// GracePeriodSeconds overrides the pod's terminationGracePeriodSeconds,
+	// nil keeps it. Force deletion always uses 0.
+	GracePeriodSeconds *int64
+}
+
+// DisruptionBudget is a PodDisruptionBudget covering a pod
+type DisruptionBudget struct {
+	Name               string `json:"name"`
+	CurrentHealthy     int32  `json:"currentHealthy"`
+	DesiredHealthy     int32  `json:"desiredHealthy"`
+	DisruptionsAllowed int32  `json:"disruptionsAllowed"`
+}
+
+// PodDeleter deletes pods with a grace period, by force or by eviction
+type PodDeleter interface {
+	// DeletePodWithOptions deletes or evicts a pod
+	DeletePodWithOptions(ctx context.Context, namespace, name string, opts PodDeleteOptions) error
+
+	// PodDisruptionBudgets returns the PodDisruptionBudgets selecting a pod
+	PodDisruptionBudgets(ctx context.Context, namespace, name string) ([]DisruptionBudget, error)
+}
+
+// DeletePodWithOptions deletes or evicts a pod. An eviction refused by a
+// PodDisruptionBudget is reported rather than retried.
+func (c *K8sResourceClient) DeletePodWithOptions(ctx context.Context, namespace, name string, opts PodDeleteOptions) error {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	deleteOptions := podDeleteOptions(ctx, opts)
+	if opts.Mode != PodEvict {
+		if err := c.clientset.CoreV1().Pods(namespace).Delete(ctx, name, deleteOptions); err != nil {
+			return fmt.Errorf("failed to %s pod %s/%s: %w", opts.Mode, namespace, name, err)
+		}
+		return nil
+	}
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta:    metav1.ObjectMeta{Name: name, Namespace: namespace},
+		DeleteOptions: &deleteOptions,
+	}
+	err := c.clientset.CoreV1().Pods(namespace).EvictV1(ctx, eviction)
+	switch {
+	case err == nil:
+		return nil
+	case apierrors.IsTooManyRequests(err):
+		return fmt.Errorf("eviction of pod %s/%s refused, a disruption budget allows no more disruptions: %w", namespace, name, err)
+	}
+	return fmt.Errorf("failed to evict pod %s/%s: %w", namespace, name, err)
+}
+
+// podDeleteOptions returns the delete options of a pod deletion
+func podDeleteOptions(ctx context.Context, opts PodDeleteOptions) metav1.DeleteOptions {
+	options := metav1.DeleteOptions{DryRun: dryRunOption(ctx), GracePeriodSeconds: opts.GracePeriodSeconds}
+	if opts.Mode == PodForceDelete {
+		immediate := int64(0)
+		options.GracePeriodSeconds = &immediate
+	}
+	return options
+}
+
+// PodDisruptionBudgets returns the PodDisruptionBudgets selecting a pod
+func (c *K8sResourceClient) PodDisruptionBudgets(ctx context.Context, namespace, name string) ([]DisruptionBudget, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s/%s: %w", namespace, name, err)
+	}
+	budgets, err := c.clientset.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disruption budgets in %s: %w", namespace, err)
+	}
+	return matchingBudgets(budgets.Items, pod.Labels), nil
+}
+
+// matchingBudgets returns the budgets whose selector matches the pod labels
+func matchingBudgets(budgets []policyv1.PodDisruptionBudget, podLabels map[string]string) []DisruptionBudget {
+	var matching []DisruptionBudget
+	for _, budget := range budgets {
+		// A budget without a selector selects no pods
+		if budget.Spec.Selector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(budget.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(podLabels)) {
+			continue
+		}
+		matching = append(matching, DisruptionBudget{
+			Name:               budget.Name,
+			CurrentHealthy:     budget.Status.CurrentHealthy,
+			DesiredHealthy:     budget.Status.DesiredHealthy,
+			DisruptionsAllowed: budget.Status.DisruptionsAllowed,
+		})
+	}
+	sort.Slice(matching, func(i, j int) bool { return matching[i].Name < matching[j].Name })
+	return matching
+}
@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodDeleteOptions(t *testing.T) {
+	grace := int64(5)
+	options := podDeleteOptions(context.Background(), PodDeleteOptions{GracePeriodSeconds: &grace})
+	if options.GracePeriodSeconds == nil || *options.GracePeriodSeconds != 5 || len(options.DryRun) != 0 {
+		t.Errorf("Expected the grace period to be passed on, got %+v", options)
+	}
+
+	options = podDeleteOptions(WithDryRun(context.Background()), PodDeleteOptions{Mode: PodForceDelete, GracePeriodSeconds: &grace})
+	if options.GracePeriodSeconds == nil || *options.GracePeriodSeconds != 0 || len(options.DryRun) != 1 {
+		t.Errorf("Expected a forced dry-run deletion without grace period, got %+v", options)
+	}
+
+	if options = podDeleteOptions(context.Background(), PodDeleteOptions{Mode: PodEvict}); options.GracePeriodSeconds != nil {
+		t.Errorf("Expected the pod's grace period to be kept, got %d", *options.GracePeriodSeconds)
+	}
+}
+
+func TestMatchingBudgets(t *testing.T) {
+	budget := func(name string, selector *metav1.LabelSelector, allowed int32) policyv1.PodDisruptionBudget {
+		return policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: selector},
+			Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed, CurrentHealthy: 2, DesiredHealthy: 2},
+		}
+	}
+	budgets := []policyv1.PodDisruptionBudget{
+		budget("web", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}, 0),
+		budget("all", &metav1.LabelSelector{}, 1),
+		budget("db", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}, 1),
+		budget("none", nil, 1),
+	}
+
+	matching := matchingBudgets(budgets, map[string]string{"app": "web"})
+	if len(matching) != 2 || matching[0].Name != "all" || matching[1].Name != "web" {
+		t.Fatalf("Expected the web and empty selector budgets, got %+v", matching)
+	}
+	if matching[1].DisruptionsAllowed != 0 || matching[1].CurrentHealthy != 2 {
+		t.Errorf("Expected the budget status, got %+v", matching[1])
+	}
+}
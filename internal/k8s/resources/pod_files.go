@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PodFileEntry is a file or directory in the filesystem of a container
+type PodFileEntry struct {
+	Name string
+	Mode string // As ls prints it, e.g. drwxr-xr-x
+	Size int64
+	Link string // Target of a symbolic link
+}
+
+// IsDir reports whether the entry is a directory
+func (e PodFileEntry) IsDir() bool {
+	return strings.HasPrefix(e.Mode, "d")
+}
+
+// IsLink reports whether the entry is a symbolic link
+func (e PodFileEntry) IsLink() bool {
+	return strings.HasPrefix(e.Mode, "l")
+}
+
+// PodFileBrowser lists and copies the files of a container like 'kubectl
+// cp', with ls and tar run in the container
+type PodFileBrowser interface {
+	// ListPodFiles returns the entries of a directory, directories first
+	ListPodFiles(ctx context.Context, namespace, pod, container, dir string) ([]PodFileEntry, error)
+	// DownloadPodFile copies a file or directory of the container into
+	// localDir and returns the local path
+	DownloadPodFile(ctx context.Context, namespace, pod, container, remotePath, localDir string) (string, error)
+	// UploadPodFile copies a local file or directory into remoteDir of the
+	// container
+	UploadPodFile(ctx context.Context, namespace, pod, container, localPath, remoteDir string) error
+}
+
+// execOutput runs a command in a container and returns its output, with the
+// error output of the command in the error
+func (c *K8sResourceClient) execOutput(ctx context.Context, namespace, pod, container string, command []string, stdin io.Reader, stdout io.Writer) error {
+	var stderr bytes.Buffer
+	err := c.ExecuteInPod(ctx, ExecOptions{
+		Namespace:     namespace,
+		PodName:       pod,
+		ContainerName: container,
+		Command:       command,
+		Stdin:         stdin,
+		Stdout:        stdout,
+		Stderr:        &stderr,
+	})
+	if err != nil && stderr.Len() > 0 {
+		return fmt.Errorf("%s: %s", command[0], strings.TrimSpace(stderr.String()))
+	}
+	return err
+}
+
+// ListPodFiles lists a directory of a container with ls
+func (c *K8sResourceClient) ListPodFiles(ctx context.Context, namespace, pod, container, dir string) ([]PodFileEntry, error) {
+	// The trailing slash lists the directory a symbolic link points to
+	if !strings.HasSuffix(dir, "/") {
+		dir += "/"
+	}
+
+	var stdout bytes.Buffer
+	if err := c.execOutput(ctx, namespace, pod, container, []string{"ls", "-lAn", dir}, nil, &stdout); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", dir, err)
+	}
+	return parseLsOutput(stdout.String()), nil
+}
+
+// parseLsOutput parses the output of 'ls -lAn', which GNU and busybox ls
+// print alike: mode, links, owner, group, size, three date fields and the
+// name, with "-> target" after symbolic links
+func parseLsOutput(output string) []PodFileEntry {
+	var entries []PodFileEntry
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 9 || fields[0] == "total" {
+			continue
+		}
+
+		entry := PodFileEntry{Mode: fields[0]}
+		nameField := 8
+		if strings.HasSuffix(fields[4], ",") {
+			// Devices print "major, minor" in place of the size
+			nameField++
+		} else {
+			entry.Size, _ = strconv.ParseInt(fields[4], 10, 64)
+		}
+		name := afterFields(line, nameField)
+		if name == "" {
+			continue
+		}
+		if entry.IsLink() {
+			name, entry.Link, _ = strings.Cut(name, " -> ")
+		}
+		entry.Name = name
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].IsDir() != entries[j].IsDir() {
+			return entries[i].IsDir()
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// afterFields returns the rest of a line after n whitespace separated
+// fields, keeping the spaces inside it
+func afterFields(line string, n int) string {
+	rest := line
+	for i := 0; i < n; i++ {
+		rest = strings.TrimLeft(rest, " \t")
+		end := strings.IndexAny(rest, " \t")
+		if end < 0 {
+			return ""
+		}
+		rest = rest[end:]
+	}
+	return strings.TrimLeft(rest, " \t")
+}
+
+// DownloadPodFile copies a file or directory out of a container with tar
+func (c *K8sResourceClient) DownloadPodFile(ctx context.Context, namespace, pod, container, remotePath, localDir string) (string, error) {
+	remotePath = path.Clean(remotePath)
+	base := path.Base(remotePath)
+	if base == "/" || base == "." {
+		return "", fmt.Errorf("cannot download %s, pick a file or directory in it", remotePath)
+	}
+	localPath := filepath.Join(localDir, base)
+	if _, err := os.Lstat(localPath); err == nil {
+		return "", fmt.Errorf("%s already exists", localPath)
+	}
+
+	reader, writer := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		err := c.execOutput(ctx, namespace, pod, container, []string{"tar", "cf", "-", "-C", path.Dir(remotePath), base}, nil, writer)
+		writer.CloseWithError(err)
+		done <- err
+	}()
+
+	untarErr := untar(reader, localDir)
+	// Unblock the command when extracting stopped early
+	reader.CloseWithError(untarErr)
+	if err := <-done; err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", remotePath, err)
+	}
+	if untarErr != nil {
+		return "", fmt.Errorf("failed to download %s: %w", remotePath, untarErr)
+	}
+	return localPath, nil
+}
+
+// UploadPodFile copies a local file or directory into a container with tar
+func (c *K8sResourceClient) UploadPodFile(ctx context.Context, namespace, pod, container, localPath, remoteDir string) error {
+	if _, err := os.Stat(localPath); err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(writeTar(writer, localPath))
+	}()
+
+	err := c.execOutput(ctx, namespace, pod, container, []string{"tar", "xf", "-", "-C", remoteDir}, reader, io.Discard)
+	reader.Close()
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %w", localPath, err)
+	}
+	return nil
+}
+
+// writeTar writes a file, or a directory with everything in it, as a tar
+// archive with paths relative to the parent of localPath
+func writeTar(w io.Writer, localPath string) error {
+	archive := tar.NewWriter(w)
+	parent := filepath.Dir(localPath)
+
+	err := filepath.WalkDir(localPath, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		// Only files and directories are copied, like kubectl cp
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(parent, file)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := archive.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(archive, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// untar extracts the files and directories of a tar archive into dir.
+// Entries leaving dir are refused and links are skipped, so an archive from
+// a container cannot write outside of dir.
+func untar(r io.Reader, dir string) error {
+	archive := tar.NewReader(r)
+	for {
+		header, err := archive.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := filepath.FromSlash(path.Clean(header.Name))
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("refusing to extract %s outside of %s", header.Name, dir)
+		}
+		target := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			if err := extractFile(archive, target, header.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// extractFile writes the current file of an archive to a new file
+func extractFile(archive io.Reader, target string, perm fs.FileMode) error {
+	file, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, archive); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
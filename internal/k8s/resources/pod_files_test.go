@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseLsOutput(t *testing.T) {
+	output := `total 12
+-rw-r--r--    1 0        0          1048576 Oct 17 09:12 heap dump.hprof
+drwxr-xr-x    2 1001     0             4096 Oct 16  2026 logs
+lrwxrwxrwx    1 0        0               11 Oct 17 09:00 current -> logs/app.log
+crw-rw-rw-    1 0        0           1,   3 Oct 17 09:00 null
+`
+	entries := parseLsOutput(output)
+	if len(entries) != 4 {
+		t.Fatalf("Expected 4 entries, got %+v", entries)
+	}
+	if entries[0].Name != "logs" || !entries[0].IsDir() {
+		t.Errorf("Expected the directory first, got %+v", entries[0])
+	}
+	if entries[1].Name != "current" || !entries[1].IsLink() || entries[1].Link != "logs/app.log" {
+		t.Errorf("Expected the link with its target, got %+v", entries[1])
+	}
+	if entries[2].Name != "heap dump.hprof" || entries[2].Size != 1048576 {
+		t.Errorf("Expected the name with its space and the size, got %+v", entries[2])
+	}
+	if entries[3].Name != "null" || entries[3].Size != 0 {
+		t.Errorf("Expected the device without a size, got %+v", entries[3])
+	}
+}
+
+func TestTarRoundTrip(t *testing.T) {
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "dumps", "old"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "dumps", "old", "heap.hprof"), []byte("heap"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var archive bytes.Buffer
+	if err := writeTar(&archive, filepath.Join(src, "dumps")); err != nil {
+		t.Fatalf("writeTar() returned error: %v", err)
+	}
+
+	dst := t.TempDir()
+	if err := untar(&archive, dst); err != nil {
+		t.Fatalf("untar() returned error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dst, "dumps", "old", "heap.hprof"))
+	if err != nil || string(data) != "heap" {
+		t.Errorf("Expected the file to be extracted, got %q, %v", data, err)
+	}
+}
+
+func TestUntarRefusesEscapes(t *testing.T) {
+	for _, name := range []string{"../evil", "/etc/evil", "dir/../../evil"} {
+		var archive bytes.Buffer
+		writer := tar.NewWriter(&archive)
+		writer.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: 4})
+		writer.Write([]byte("evil"))
+		writer.Close()
+
+		if err := untar(&archive, t.TempDir()); err == nil || !strings.Contains(err.Error(), "refusing") {
+			t.Errorf("Expected %s to be refused, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// routeResource is the OpenShift Route resource, read without depending on
+// the OpenShift client
+var routeResource = schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+
+// Relation is a set of resources related to another one, e.g. the pods a
+// service selects
+type Relation struct {
+	// Kind of the related resources, e.g. "Pod"
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+
+	// Selector is the label selector the related resources are found by,
+	// empty when they are related by name or owner
+	Selector string `json:"selector,omitempty"`
+
+	// Names of the related resources found, sorted
+	Names []string `json:"names"`
+}
+
+// RelationResolver finds the resources related to a resource: the pods of
+// a service, the ReplicaSets and pods of a deployment and the services of
+// a route
+type RelationResolver interface {
+	RelatedResources(ctx context.Context, kind, namespace, name string) ([]Relation, error)
+}
+
+// RelatedResources returns the resources related to a Service, Deployment
+// or Route
+func (c *K8sResourceClient) RelatedResources(ctx context.Context, kind, namespace, name string) ([]Relation, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	switch kind {
+	case "Service":
+		return c.serviceRelations(ctx, namespace, name)
+	case "Deployment":
+		return c.deploymentRelations(ctx, namespace, name)
+	case "Route":
+		return c.routeRelations(ctx, namespace, name)
+	}
+	return nil, fmt.Errorf("no related resources are known for %s", kind)
+}
+
+// serviceRelations returns the pods a service selects
+func (c *K8sResourceClient) serviceRelations(ctx context.Context, namespace, name string) ([]Relation, error) {
+	svc, err := c.clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s/%s: %w", namespace, name, err)
+	}
+	// A service without a selector has its endpoints managed by hand
+	if len(svc.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("service %s/%s has no selector", namespace, name)
+	}
+
+	selector := labels.SelectorFromSet(svc.Spec.Selector).String()
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for service %s/%s: %w", namespace, name, err)
+	}
+	objects := make([]metav1.Object, len(pods.Items))
+	for i := range pods.Items {
+		objects[i] = &pods.Items[i]
+	}
+	return []Relation{{Kind: "Pod", Namespace: namespace, Selector: selector, Names: objectNames(objects)}}, nil
+}
+
+// deploymentRelations returns the ReplicaSets a deployment controls and the
+// pods its selector matches
+func (c *K8sResourceClient) deploymentRelations(ctx context.Context, namespace, name string) ([]Relation, error) {
+	deploy, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment %s/%s: %w", namespace, name, err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("deployment %s/%s has an invalid selector: %w", namespace, name, err)
+	}
+	listOptions := metav1.ListOptions{LabelSelector: selector.String()}
+
+	replicaSets, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ReplicaSets for deployment %s/%s: %w", namespace, name, err)
+	}
+	owned := make([]metav1.Object, len(replicaSets.Items))
+	for i := range replicaSets.Items {
+		owned[i] = &replicaSets.Items[i]
+	}
+
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for deployment %s/%s: %w", namespace, name, err)
+	}
+	objects := make([]metav1.Object, len(pods.Items))
+	for i := range pods.Items {
+		objects[i] = &pods.Items[i]
+	}
+
+	return []Relation{
+		{Kind: "ReplicaSet", Namespace: namespace, Names: objectNames(controlledBy(owned, deploy))},
+		{Kind: "Pod", Namespace: namespace, Selector: selector.String(), Names: objectNames(objects)},
+	}, nil
+}
+
+// routeRelations returns the services a route sends traffic to
+func (c *K8sResourceClient) routeRelations(ctx context.Context, namespace, name string) ([]Relation, error) {
+	if c.restConfig == nil {
+		return nil, fmt.Errorf("REST config not available for dynamic operations")
+	}
+	dynamicClient, err := dynamic.NewForConfig(c.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	route, err := dynamicClient.Resource(routeResource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get route %s/%s: %w", namespace, name, err)
+	}
+	return []Relation{{Kind: "Service", Namespace: namespace, Names: routeBackends(route)}}, nil
+}
+
+// routeBackends returns the services a route sends traffic to, its target
+// and its alternate backends
+func routeBackends(route *unstructured.Unstructured) []string {
+	backends, _, _ := unstructured.NestedSlice(route.Object, "spec", "alternateBackends")
+	if to, ok, _ := unstructured.NestedMap(route.Object, "spec", "to"); ok {
+		backends = append([]interface{}{to}, backends...)
+	}
+
+	seen := make(map[string]bool)
+	var names []string
+	for _, backend := range backends {
+		ref, ok := backend.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(ref, "kind")
+		name, _, _ := unstructured.NestedString(ref, "name")
+		if name == "" || (kind != "" && kind != "Service") || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// controlledBy returns the objects whose controller is owner
+func controlledBy(objects []metav1.Object, owner metav1.Object) []metav1.Object {
+	var controlled []metav1.Object
+	for _, object := range objects {
+		if metav1.IsControlledBy(object, owner) {
+			controlled = append(controlled, object)
+		}
+	}
+	return controlled
+}
+
+// objectNames returns the sorted names of objects
+func objectNames(objects []metav1.Object) []string {
+	names := make([]string, 0, len(objects))
+	for _, object := range objects {
+		names = append(names, object.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
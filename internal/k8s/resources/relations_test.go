@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRouteBackends(t *testing.T) {
+	route := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"to": map[string]interface{}{"kind": "Service", "name": "shop"},
+			"alternateBackends": []interface{}{
+				map[string]interface{}{"kind": "Service", "name": "shop-canary"},
+				map[string]interface{}{"name": "shop"},
+				map[string]interface{}{"kind": "Other", "name": "elsewhere"},
+			},
+		},
+	}}
+
+	if backends := routeBackends(route); !reflect.DeepEqual(backends, []string{"shop", "shop-canary"}) {
+		t.Errorf("Expected the target and alternate services, got %v", backends)
+	}
+	if backends := routeBackends(&unstructured.Unstructured{Object: map[string]interface{}{}}); len(backends) != 0 {
+		t.Errorf("Expected no services for a route without a target, got %v", backends)
+	}
+}
+
+func TestControlledBy(t *testing.T) {
+	controller := true
+	deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", UID: types.UID("web-uid")}}
+	replicaSet := func(name string, uid types.UID) metav1.Object {
+		return &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "web", UID: uid, Controller: &controller}},
+		}}
+	}
+	objects := []metav1.Object{
+		replicaSet("web-2", "web-uid"),
+		replicaSet("web-1", "web-uid"),
+		replicaSet("other-1", "other-uid"),
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "orphan"}},
+	}
+
+	if names := objectNames(controlledBy(objects, deploy)); !reflect.DeepEqual(names, []string{"web-1", "web-2"}) {
+		t.Errorf("Expected the deployment's ReplicaSets, got %v", names)
+	}
+}
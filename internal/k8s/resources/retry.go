@@ -2,11 +2,13 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"time"
 
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/katyella/lazyoc/internal/constants"
 )
@@ -26,22 +28,26 @@ func (e *RetryableError) Unwrap() error {
 	return e.Err
 }
 
-// IsRetryable determines if an error is retryable
+// IsRetryable determines if an error is retryable. Loaders wrap the errors
+// of the API, so wrapped errors are unwrapped.
 func IsRetryable(err error) bool {
 	if err == nil {
 		return false
 	}
 
 	// Network errors are generally retryable
-	if _, ok := err.(net.Error); ok {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
 		return true
 	}
 
 	// Kubernetes API errors
-	if statusErr, ok := err.(*errors.StatusError); ok {
-		switch statusErr.ErrStatus.Code {
-		case 429: // Too Many Requests
-			return true
+	if apierrors.IsTooManyRequests(err) || apierrors.IsServiceUnavailable(err) || apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) {
+		return true
+	}
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		switch status.Status().Code {
 		case constants.HTTPStatusInternalServerError,
 			constants.HTTPStatusBadGateway,
 			constants.HTTPStatusServiceUnavailable,
@@ -54,24 +60,17 @@ func IsRetryable(err error) bool {
 		}
 	}
 
-	// Context errors (except cancellation)
-	if err == context.DeadlineExceeded {
-		return true
-	}
-
-	return false
+	// Context errors (except cancellation), e.g. an attempt's own timeout
+	return errors.Is(err, context.DeadlineExceeded)
 }
 
 // GetRetryDelay returns appropriate delay for retry based on error type
 func GetRetryDelay(err error, attempt int) time.Duration {
 	baseDelay := time.Duration(attempt) * time.Second
 
-	// Check for rate limiting
-	if statusErr, ok := err.(*errors.StatusError); ok {
-		if statusErr.ErrStatus.Code == 429 {
-			// For rate limiting, use exponential backoff with jitter
-			return time.Duration(1<<uint(attempt)) * time.Second
-		}
+	// For rate limiting, use exponential backoff
+	if apierrors.IsTooManyRequests(err) {
+		return time.Duration(1<<uint(attempt)) * time.Second
 	}
 
 	// For other errors, use linear backoff with max
@@ -120,6 +119,71 @@ func RetryOperation[T any](ctx context.Context, maxRetries int, operation func(c
 	return result, fmt.Errorf("operation failed after %d attempts: %w", maxRetries+1, lastErr)
 }
 
+// BackoffPolicy configures jittered exponential backoff
+type BackoffPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultBackoffPolicy returns the standard loader backoff policy
+func DefaultBackoffPolicy() BackoffPolicy {
+	return BackoffPolicy{
+		MaxAttempts: constants.DefaultRetryAttempts,
+		BaseDelay:   constants.RetryBaseDelay,
+		MaxDelay:    constants.RetryMaxDelay,
+	}
+}
+
+// Delay returns the backoff delay before the given retry attempt (1-based).
+// The exponential delay is capped at MaxDelay and jittered into [delay/2, delay].
+func (p BackoffPolicy) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// RetryWithBackoff runs operation until it succeeds, returns a non-retryable
+// error, or MaxAttempts is reached, sleeping with jittered exponential backoff
+// between attempts.
+func RetryWithBackoff[T any](ctx context.Context, policy BackoffPolicy, operation func(ctx context.Context) (T, error)) (T, error) {
+	attempts := policy.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var result T
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		result, err = operation(ctx)
+		if err == nil || !IsRetryable(err) || attempt == attempts {
+			return result, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-time.After(policy.Delay(attempt)):
+		}
+	}
+
+	return result, err
+}
+
 // WithRetry wraps a ResourceClient with retry logic
 type RetryWrapper struct {
 	client     ResourceClient
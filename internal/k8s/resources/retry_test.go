@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestBackoffPolicyDelay(t *testing.T) {
+	policy := BackoffPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: 100 * time.Millisecond},
+		{attempt: 2, max: 200 * time.Millisecond},
+		{attempt: 3, max: 400 * time.Millisecond},
+		{attempt: 10, max: time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			delay := policy.Delay(tt.attempt)
+			if delay < tt.max/2 || delay > tt.max {
+				t.Fatalf("Delay(%d) = %v, expected within [%v, %v]", tt.attempt, delay, tt.max/2, tt.max)
+			}
+		}
+	}
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	policy := BackoffPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	unavailable := errors.NewServiceUnavailable("try later")
+	notFound := errors.NewNotFound(schema.GroupResource{Resource: "pods"}, "web")
+
+	t.Run("retries transient errors until success", func(t *testing.T) {
+		calls := 0
+		result, err := RetryWithBackoff(context.Background(), policy, func(ctx context.Context) (string, error) {
+			calls++
+			if calls < 3 {
+				return "", unavailable
+			}
+			return "ok", nil
+		})
+		if err != nil || result != "ok" || calls != 3 {
+			t.Errorf("Expected success on third call, got result=%q err=%v calls=%d", result, err, calls)
+		}
+	})
+
+	t.Run("stops after max attempts", func(t *testing.T) {
+		calls := 0
+		_, err := RetryWithBackoff(context.Background(), policy, func(ctx context.Context) (string, error) {
+			calls++
+			return "", unavailable
+		})
+		if err != unavailable || calls != 3 {
+			t.Errorf("Expected 3 calls and the last error, got calls=%d err=%v", calls, err)
+		}
+	})
+
+	t.Run("retries wrapped transient errors", func(t *testing.T) {
+		calls := 0
+		result, err := RetryWithBackoff(context.Background(), policy, func(ctx context.Context) (string, error) {
+			calls++
+			if calls < 3 {
+				return "", fmt.Errorf("failed to list pods: %w", unavailable)
+			}
+			return "ok", nil
+		})
+		if err != nil || result != "ok" || calls != 3 {
+			t.Errorf("Expected a wrapped 503 to be retried, got result=%q err=%v calls=%d", result, err, calls)
+		}
+	})
+
+	t.Run("retries an attempt's own timeout", func(t *testing.T) {
+		calls := 0
+		_, err := RetryWithBackoff(context.Background(), policy, func(ctx context.Context) (string, error) {
+			calls++
+			return "", fmt.Errorf("failed to list pods: %w", context.DeadlineExceeded)
+		})
+		if err == nil || calls != 3 {
+			t.Errorf("Expected a wrapped deadline to be retried, got calls=%d err=%v", calls, err)
+		}
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		calls := 0
+		_, err := RetryWithBackoff(context.Background(), policy, func(ctx context.Context) (string, error) {
+			calls++
+			return "", fmt.Errorf("wrapped: %w", notFound)
+		})
+		if err == nil || calls != 1 {
+			t.Errorf("Expected a single call, got calls=%d err=%v", calls, err)
+		}
+	})
+}
+
+func TestIsRetryableWrapped(t *testing.T) {
+	tooMany := fmt.Errorf("failed to list pods: %w", errors.NewTooManyRequests("slow down", 1))
+	if !IsRetryable(tooMany) || GetRetryDelay(tooMany, 2) != 4*time.Second {
+		t.Errorf("Expected a wrapped 429 to be retried with exponential backoff")
+	}
+	if IsRetryable(fmt.Errorf("failed to list pods: %w", context.Canceled)) {
+		t.Error("Expected cancellation not to be retried")
+	}
+	if IsRetryable(fmt.Errorf("failed to get pod: %w", errors.NewForbidden(schema.GroupResource{Resource: "pods"}, "web", nil))) {
+		t.Error("Expected a 403 not to be retried")
+	}
+}
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Right-sizing verdicts for a container's CPU or memory
+const (
+	SizingUnknown = "unknown" // no usage samples yet
+	SizingUnset   = "unset"   // no request set
+	SizingOK      = "ok"
+	SizingOver    = "over"  // request far above observed usage
+	SizingUnder   = "under" // usage above the request or close to the limit
+)
+
+const (
+	// sizingHeadroom is added on top of observed usage when suggesting requests
+	sizingHeadroom = 1.2
+
+	// memoryLimitHeadroom is added on top of peak memory when suggesting limits
+	memoryLimitHeadroom = 1.5
+
+	// overProvisionFactor is how many times larger than the suggestion a
+	// request must be before it is flagged as over-provisioned
+	overProvisionFactor = 2
+
+	// memoryLimitPressure is the share of the memory limit that flags a
+	// container at risk of being OOM killed
+	memoryLimitPressure = 0.9
+
+	minCPUSuggestion     = 10       // millicores
+	cpuSuggestionStep    = 10       // millicores
+	minMemorySuggestion  = 32 << 20 // bytes
+	memorySuggestionStep = 16 << 20 // bytes
+)
+
+// RightSizing compares a container's requests and limits with its observed
+// usage and suggests new values
+type RightSizing struct {
+	Container     string
+	Current       ContainerResources
+	Suggested     ContainerResources
+	Samples       int
+	CPUP95        int64 // millicores
+	MemoryPeak    int64 // bytes
+	CPUVerdict    string
+	MemoryVerdict string
+}
+
+// SuggestRightSizing sizes CPU requests from the 95th percentile of usage,
+// which tolerates short spikes, and memory from the peak, since running out
+// of memory kills the container. Limits are suggested only for memory.
+func SuggestRightSizing(container string, current ContainerResources, samples []ContainerUsage) RightSizing {
+	sizing := RightSizing{
+		Container:     container,
+		Current:       current,
+		Samples:       len(samples),
+		CPUVerdict:    SizingUnknown,
+		MemoryVerdict: SizingUnknown,
+	}
+	if len(samples) == 0 {
+		return sizing
+	}
+
+	cpu := make([]int64, len(samples))
+	for i, sample := range samples {
+		cpu[i] = sample.CPU
+		sizing.MemoryPeak = max(sizing.MemoryPeak, sample.Memory)
+	}
+	sizing.CPUP95 = percentile(cpu, 95)
+
+	sizing.Suggested = ContainerResources{
+		CPURequest:    roundUp(max(int64(float64(sizing.CPUP95)*sizingHeadroom), minCPUSuggestion), cpuSuggestionStep),
+		CPULimit:      current.CPULimit,
+		MemoryRequest: roundUp(max(int64(float64(sizing.MemoryPeak)*sizingHeadroom), minMemorySuggestion), memorySuggestionStep),
+		MemoryLimit:   roundUp(max(int64(float64(sizing.MemoryPeak)*memoryLimitHeadroom), minMemorySuggestion), memorySuggestionStep),
+	}
+
+	sizing.CPUVerdict = sizingVerdict(current.CPURequest, sizing.Suggested.CPURequest, sizing.CPUP95)
+	sizing.MemoryVerdict = sizingVerdict(current.MemoryRequest, sizing.Suggested.MemoryRequest, sizing.MemoryPeak)
+	if current.MemoryLimit > 0 && float64(sizing.MemoryPeak) > float64(current.MemoryLimit)*memoryLimitPressure {
+		sizing.MemoryVerdict = SizingUnder
+	}
+
+	return sizing
+}
+
+// NeedsAttention reports whether either resource is significantly mis-sized
+func (s RightSizing) NeedsAttention() bool {
+	return s.CPUVerdict == SizingOver || s.CPUVerdict == SizingUnder ||
+		s.MemoryVerdict == SizingOver || s.MemoryVerdict == SizingUnder
+}
+
+// sizingVerdict compares a request with the suggestion and observed usage
+func sizingVerdict(request, suggested, used int64) string {
+	switch {
+	case request == 0:
+		return SizingUnset
+	case used > request:
+		return SizingUnder
+	case request > suggested*overProvisionFactor:
+		return SizingOver
+	default:
+		return SizingOK
+	}
+}
+
+// percentile returns the p-th percentile of values using the nearest rank
+func percentile(values []int64, p int) int64 {
+	sorted := append([]int64(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank-1, 0)]
+}
+
+// roundUp rounds value up to a multiple of step
+func roundUp(value, step int64) int64 {
+	return (value + step - 1) / step * step
+}
+
+// FormatMillicores renders a CPU amount the way it is written in a manifest,
+// e.g. 250m or 2
+func FormatMillicores(millis int64) string {
+	return resource.NewMilliQuantity(millis, resource.DecimalSI).String()
+}
+
+// FormatBytes renders a memory amount the way it is written in a manifest,
+// e.g. 256Mi
+func FormatBytes(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
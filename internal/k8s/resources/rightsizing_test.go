@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"testing"
+)
+
+func TestParsePodMetrics(t *testing.T) {
+	body := []byte(`{"items":[{"metadata":{"name":"web-1","namespace":"demo"},"timestamp":"2025-01-02T15:04:05Z",
+		"containers":[{"name":"app","usage":{"cpu":"12345678n","memory":"131072Ki"}}]}]}`)
+
+	usage, err := parsePodMetrics(body)
+	if err != nil {
+		t.Fatalf("parsePodMetrics failed: %v", err)
+	}
+	if len(usage) != 1 {
+		t.Fatalf("Expected one sample, got %d", len(usage))
+	}
+	sample := usage[0]
+	if sample.Pod != "web-1" || sample.Container != "app" || sample.Namespace != "demo" || sample.Timestamp.IsZero() {
+		t.Errorf("Unexpected sample identity %+v", sample)
+	}
+	if sample.CPU != 13 || sample.Memory != 128<<20 {
+		t.Errorf("Expected 13m CPU and 128Mi memory, got %dm %d", sample.CPU, sample.Memory)
+	}
+}
+
+func TestParseNodeMetrics(t *testing.T) {
+	body := []byte(`{"items":[{"metadata":{"name":"worker-1"},"timestamp":"2025-01-02T15:04:05Z",
+		"usage":{"cpu":"1500m","memory":"4Gi"}}]}`)
+
+	usage, err := parseNodeMetrics(body)
+	if err != nil {
+		t.Fatalf("parseNodeMetrics failed: %v", err)
+	}
+	if len(usage) != 1 || usage[0].Name != "worker-1" || usage[0].Timestamp.IsZero() {
+		t.Fatalf("Unexpected samples %+v", usage)
+	}
+	if usage[0].CPU != 1500 || usage[0].Memory != 4<<30 {
+		t.Errorf("Expected 1500m CPU and 4Gi memory, got %dm %d", usage[0].CPU, usage[0].Memory)
+	}
+}
+
+func TestSuggestRightSizing(t *testing.T) {
+	samples := func(cpu, memory int64) []ContainerUsage {
+		out := make([]ContainerUsage, 20)
+		for i := range out {
+			out[i] = ContainerUsage{CPU: cpu, Memory: memory}
+		}
+		return out
+	}
+
+	if sizing := SuggestRightSizing("app", ContainerResources{CPURequest: 100}, nil); sizing.CPUVerdict != SizingUnknown {
+		t.Errorf("Expected no verdict without samples, got %q", sizing.CPUVerdict)
+	}
+
+	// Requests far above usage
+	over := SuggestRightSizing("app", ContainerResources{CPURequest: 1000, MemoryRequest: 2 << 30}, samples(50, 100<<20))
+	if over.CPUVerdict != SizingOver || over.MemoryVerdict != SizingOver || !over.NeedsAttention() {
+		t.Errorf("Expected over-provisioned CPU and memory, got %q %q", over.CPUVerdict, over.MemoryVerdict)
+	}
+	if over.Suggested.CPURequest != 60 || FormatMillicores(over.Suggested.CPURequest) != "60m" {
+		t.Errorf("Expected a 60m CPU request, got %s", FormatMillicores(over.Suggested.CPURequest))
+	}
+	if FormatBytes(over.Suggested.MemoryRequest) != "128Mi" || FormatBytes(over.Suggested.MemoryLimit) != "160Mi" {
+		t.Errorf("Expected 128Mi request and 160Mi limit, got %s %s",
+			FormatBytes(over.Suggested.MemoryRequest), FormatBytes(over.Suggested.MemoryLimit))
+	}
+
+	// Memory close to the limit and CPU above the request
+	under := SuggestRightSizing("app", ContainerResources{CPURequest: 100, MemoryRequest: 200 << 20, MemoryLimit: 256 << 20}, samples(150, 240<<20))
+	if under.CPUVerdict != SizingUnder || under.MemoryVerdict != SizingUnder {
+		t.Errorf("Expected under-provisioned CPU and memory, got %q %q", under.CPUVerdict, under.MemoryVerdict)
+	}
+
+	ok := SuggestRightSizing("app", ContainerResources{}, samples(50, 100<<20))
+	if ok.CPUVerdict != SizingUnset || ok.NeedsAttention() {
+		t.Errorf("Expected missing requests to be reported as unset, got %q", ok.CPUVerdict)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := make([]int64, 100)
+	for i := range values {
+		values[i] = int64(100 - i)
+	}
+	if got := percentile(values, 95); got != 95 {
+		t.Errorf("Expected p95 of 1..100 to be 95, got %d", got)
+	}
+	if got := percentile([]int64{7}, 95); got != 7 {
+		t.Errorf("Expected p95 of a single value to be the value, got %d", got)
+	}
+}
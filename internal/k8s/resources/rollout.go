@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// restartedAtAnnotation is the pod template annotation used by `kubectl rollout restart`
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+	// revisionAnnotation holds the rollout revision on Deployments and ReplicaSets
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
+// restartPatch builds the strategic merge patch that triggers a rollout restart
+func restartPatch(now time.Time) ([]byte, error) {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						restartedAtAnnotation: now.Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	}
+	return json.Marshal(patch)
+}
+
+// RolloutRestart restarts a Deployment's pods by patching its pod template
+// annotation, the same way `kubectl rollout restart` does
+func (c *K8sResourceClient) RolloutRestart(ctx context.Context, namespace, name string) error {
+	data, err := restartPatch(time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to build restart patch: %w", err)
+	}
+	return c.Patch(ctx, "deployment", namespace, name, types.StrategicMergePatchType, data)
+}
+
+// GetRolloutStatus reports the rollout progress of a Deployment, including
+// pod counts for the new and old ReplicaSets
+func (c *K8sResourceClient) GetRolloutStatus(ctx context.Context, namespace, name string) (*RolloutStatus, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	deploy, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment %s/%s: %w", namespace, name, err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment %s: %w", name, err)
+	}
+
+	rsList, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replicasets for %s: %w", name, err)
+	}
+
+	return rolloutStatusFor(deploy, rsList.Items), nil
+}
+
+// rolloutStatusFor summarizes a Deployment's rollout from its ReplicaSets
+func rolloutStatusFor(deploy *appsv1.Deployment, replicaSets []appsv1.ReplicaSet) *RolloutStatus {
+	status := &RolloutStatus{
+		Deployment:  deploy.Name,
+		Revision:    deploy.Annotations[revisionAnnotation],
+		Progressing: "Unknown",
+		Available:   "Unknown",
+		Desired:     1,
+	}
+	if deploy.Spec.Replicas != nil {
+		status.Desired = *deploy.Spec.Replicas
+	}
+
+	for _, cond := range deploy.Status.Conditions {
+		switch cond.Type {
+		case appsv1.DeploymentProgressing:
+			status.Progressing = conditionSummary(cond.Status, cond.Reason)
+			status.Message = cond.Message
+		case appsv1.DeploymentAvailable:
+			status.Available = conditionSummary(cond.Status, cond.Reason)
+		}
+	}
+
+	for _, rs := range replicaSets {
+		if !metav1.IsControlledBy(&rs, deploy) {
+			continue
+		}
+		if rs.Annotations[revisionAnnotation] == status.Revision {
+			status.NewReplicaSet = rs.Name
+			status.NewReplicas = rs.Status.Replicas
+			status.NewReady = rs.Status.ReadyReplicas
+			continue
+		}
+		status.OldReplicas += rs.Status.Replicas
+	}
+
+	status.Complete = deploy.Status.ObservedGeneration >= deploy.Generation &&
+		deploy.Status.UpdatedReplicas == status.Desired &&
+		deploy.Status.AvailableReplicas == status.Desired &&
+		status.OldReplicas == 0
+
+	return status
+}
+
+// conditionSummary renders a condition status with its reason
+func conditionSummary(status corev1.ConditionStatus, reason string) string {
+	if reason == "" {
+		return string(status)
+	}
+	return fmt.Sprintf("%s (%s)", status, reason)
+}
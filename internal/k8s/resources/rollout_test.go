@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRestartPatch(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	data, err := restartPatch(now)
+	if err != nil {
+		t.Fatalf("restartPatch() returned error: %v", err)
+	}
+
+	var patch struct {
+		Spec struct {
+			Template struct {
+				Metadata struct {
+					Annotations map[string]string `json:"annotations"`
+				} `json:"metadata"`
+			} `json:"template"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		t.Fatalf("Failed to decode patch: %v", err)
+	}
+	if got := patch.Spec.Template.Metadata.Annotations[restartedAtAnnotation]; got != "2025-01-02T03:04:05Z" {
+		t.Errorf("Expected restartedAt annotation, got %q", got)
+	}
+}
+
+func TestRolloutStatusFor(t *testing.T) {
+	replicas := int32(3)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "web",
+			UID:         "deploy-uid",
+			Generation:  2,
+			Annotations: map[string]string{revisionAnnotation: "2"},
+		},
+		Spec: appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			UpdatedReplicas:    2,
+			AvailableReplicas:  3,
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated"},
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue, Reason: "MinimumReplicasAvailable"},
+			},
+		},
+	}
+
+	isController := true
+	owner := []metav1.OwnerReference{{Kind: "Deployment", Name: "web", UID: "deploy-uid", Controller: &isController}}
+	replicaSets := []appsv1.ReplicaSet{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-new", OwnerReferences: owner, Annotations: map[string]string{revisionAnnotation: "2"}},
+			Status:     appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 1},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-old", OwnerReferences: owner, Annotations: map[string]string{revisionAnnotation: "1"}},
+			Status:     appsv1.ReplicaSetStatus{Replicas: 1, ReadyReplicas: 1},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Annotations: map[string]string{revisionAnnotation: "1"}},
+			Status:     appsv1.ReplicaSetStatus{Replicas: 5},
+		},
+	}
+
+	status := rolloutStatusFor(deploy, replicaSets)
+
+	if status.NewReplicaSet != "web-new" || status.NewReplicas != 2 || status.NewReady != 1 {
+		t.Errorf("Unexpected new ReplicaSet summary: %+v", status)
+	}
+	if status.OldReplicas != 1 {
+		t.Errorf("Expected 1 old pod, got %d", status.OldReplicas)
+	}
+	if status.Complete {
+		t.Errorf("Expected rollout to be in progress")
+	}
+	if status.Progressing != "True (ReplicaSetUpdated)" {
+		t.Errorf("Unexpected progressing summary %q", status.Progressing)
+	}
+
+	replicaSets[1].Status.Replicas = 0
+	deploy.Status.UpdatedReplicas = 3
+	if !rolloutStatusFor(deploy, replicaSets).Complete {
+		t.Errorf("Expected rollout to be complete once old pods are gone")
+	}
+}
@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ListAccessibleRoutes lists the Routes of every namespace, or, when the
+// user may not list them cluster-wide, of each of the given namespaces.
+// Namespaces whose Routes may not be listed are skipped; an error is returned
+// only if none could be listed.
+func (c *OpenShiftResourceClient) ListAccessibleRoutes(ctx context.Context, namespaces []string) ([]RouteInfo, error) {
+	list, err := c.ListRoutes(ctx, ListOptions{})
+	if err == nil {
+		return list.Items, nil
+	}
+
+	var routes []RouteInfo
+	errs := []string{fmt.Sprintf("all namespaces: %v", err)}
+	listed := false
+	for _, namespace := range namespaces {
+		list, err := c.ListRoutes(ctx, ListOptions{Namespace: namespace})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", namespace, err))
+			continue
+		}
+		listed = true
+		routes = append(routes, list.Items...)
+	}
+	if !listed {
+		return nil, fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return routes, nil
+}
+
+// routesConflict reports whether two Routes of the same host conflict: Routes
+// of a namespace may share a host with different paths, but by default the
+// router only lets the namespace of the oldest Route claim a host. A path
+// with a trailing slash claims the same URLs.
+func routesConflict(a, b RouteInfo) bool {
+	if a.Namespace != b.Namespace {
+		return true
+	}
+	return strings.TrimSuffix(a.Path, "/") == strings.TrimSuffix(b.Path, "/")
+}
+
+// RouteHostConflicts returns, by namespace/name, the other Routes claiming
+// the host and path of each Route, oldest first. The router admits the
+// oldest Route and rejects the others as HostAlreadyClaimed.
+func RouteHostConflicts(routes []RouteInfo) map[string][]RouteInfo {
+	hosts := make(map[string][]RouteInfo)
+	for _, route := range routes {
+		if route.Host == "" {
+			continue
+		}
+		host := strings.ToLower(route.Host)
+		hosts[host] = append(hosts[host], route)
+	}
+
+	conflicts := make(map[string][]RouteInfo)
+	for _, claimants := range hosts {
+		if len(claimants) < 2 {
+			continue
+		}
+		sort.SliceStable(claimants, func(i, j int) bool {
+			return claimants[i].CreatedAt.Before(claimants[j].CreatedAt)
+		})
+		for i, route := range claimants {
+			key := route.Namespace + "/" + route.Name
+			for j, other := range claimants {
+				if i != j && routesConflict(route, other) {
+					conflicts[key] = append(conflicts[key], other)
+				}
+			}
+		}
+	}
+	return conflicts
+}
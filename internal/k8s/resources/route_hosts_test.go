@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRouteHostConflicts(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	route := func(namespace, name, host, path string, age int) RouteInfo {
+		return RouteInfo{
+			ResourceInfo: ResourceInfo{Name: name, Namespace: namespace, CreatedAt: created.Add(-time.Duration(age) * time.Hour)},
+			Host:         host,
+			Path:         path,
+		}
+	}
+
+	conflicts := RouteHostConflicts([]RouteInfo{
+		route("shop", "web", "shop.apps.example.com", "", 1),
+		// Paths of the same namespace share the host
+		route("shop", "api", "shop.apps.example.com", "/api", 2),
+		route("shop", "api-v2", "Shop.apps.example.com", "/api/", 0),
+		// Another namespace may not claim the host, whatever the path
+		route("dev", "web", "shop.apps.example.com", "/dev", 5),
+		route("dev", "docs", "docs.apps.example.com", "", 1),
+	})
+
+	if others := conflicts["shop/api-v2"]; len(others) != 2 || others[0].Namespace != "dev" || others[1].Name != "api" {
+		t.Errorf("Expected shop/api-v2 to conflict with dev/web then shop/api, got %+v", others)
+	}
+	if others := conflicts["dev/web"]; len(others) != 3 {
+		t.Errorf("Expected dev/web to conflict with every shop route, got %+v", others)
+	}
+	if others := conflicts["shop/web"]; len(others) != 1 || others[0].Namespace != "dev" {
+		t.Errorf("Expected shop/web to conflict with dev/web only, got %+v", others)
+	}
+	if _, ok := conflicts["dev/docs"]; ok {
+		t.Errorf("Expected no conflict for a host claimed once")
+	}
+}
@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+// SecretWriter creates secrets and updates their data with server-side apply.
+// Values are the decoded bytes, they are base64 encoded on the wire.
+type SecretWriter interface {
+	// CreateSecret creates a secret of the given type, it fails if a secret
+	// of that name already exists
+	CreateSecret(ctx context.Context, namespace, name string, secretType corev1.SecretType, data map[string][]byte) error
+
+	// ApplySecretData sets the data of an existing secret. Keys lazyoc
+	// applied before and that are missing from data are removed.
+	ApplySecretData(ctx context.Context, namespace, name string, data map[string][]byte) error
+}
+
+// CreateSecret creates a secret of the given type with server-side apply
+func (c *K8sResourceClient) CreateSecret(ctx context.Context, namespace, name string, secretType corev1.SecretType, data map[string][]byte) error {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	// Apply would silently take over an existing secret
+	_, err := c.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		return fmt.Errorf("secret %s/%s already exists", namespace, name)
+	case !apierrors.IsNotFound(err):
+		return fmt.Errorf("failed to check secret %s/%s: %w", namespace, name, err)
+	}
+
+	return c.applySecret(ctx, corev1ac.Secret(name, namespace).WithType(secretType).WithData(data))
+}
+
+// ApplySecretData sets the data of an existing secret with server-side apply
+func (c *K8sResourceClient) ApplySecretData(ctx context.Context, namespace, name string, data map[string][]byte) error {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+	return c.applySecret(ctx, corev1ac.Secret(name, namespace).WithData(data))
+}
+
+// applySecret server-side applies a secret as lazyoc
+func (c *K8sResourceClient) applySecret(ctx context.Context, secret *corev1ac.SecretApplyConfiguration) error {
+	_, err := c.clientset.CoreV1().Secrets(*secret.Namespace).Apply(ctx, secret, metav1.ApplyOptions{
+		FieldManager: ApplyFieldManager,
+		Force:        true,
+		DryRun:       dryRunOption(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to apply secret %s/%s: %w", *secret.Namespace, *secret.Name, err)
+	}
+	return nil
+}
+
+// DockerConfigJSON returns the .dockerconfigjson of a docker-registry secret
+// holding the credentials of one registry, as 'oc create secret
+// docker-registry' writes it
+func DockerConfigJSON(server, username, password, email string) ([]byte, error) {
+	entry := map[string]string{
+		"username": username,
+		"password": password,
+		"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+	}
+	if email != "" {
+		entry["email"] = email
+	}
+	return json.Marshal(map[string]interface{}{"auths": map[string]interface{}{server: entry}})
+}
@@ -0,0 +1,21 @@
+package resources
+
+import (
+	"testing"
+)
+
+func TestDockerConfigJSON(t *testing.T) {
+	config, err := DockerConfigJSON("quay.io", "robot", "s3cret", "")
+	if err != nil {
+		t.Fatalf("Expected a docker config, got %v", err)
+	}
+	want := `{"auths":{"quay.io":{"auth":"cm9ib3Q6czNjcmV0","password":"s3cret","username":"robot"}}}`
+	if string(config) != want {
+		t.Errorf("Expected %s, got %s", want, config)
+	}
+
+	config, _ = DockerConfigJSON("quay.io", "robot", "s3cret", "ops@example.com")
+	if want := `{"auths":{"quay.io":{"auth":"cm9ib3Q6czNjcmV0","email":"ops@example.com","password":"s3cret","username":"robot"}}}`; string(config) != want {
+		t.Errorf("Expected the email in the entry, got %s", config)
+	}
+}
@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// Startup checks run on connect, as named in the configuration
+const (
+	StartupCheckMetricsServer       = "metrics-server"
+	StartupCheckDefaultStorageClass = "default-storage-class"
+	StartupCheckImagePullSecrets    = "image-pull-secrets"
+)
+
+// StartupChecks lists every startup check, in the order they are reported
+var StartupChecks = []string{StartupCheckMetricsServer, StartupCheckDefaultStorageClass, StartupCheckImagePullSecrets}
+
+// StartupCheckResult is the outcome of a startup check
+type StartupCheckResult struct {
+	Name    string // One of StartupChecks
+	Title   string // e.g. "Default storage class"
+	Passed  bool
+	Message string // What was found
+	Hint    string // What to do about a failure
+}
+
+// StartupChecker runs the checks flagging clusters that are not ready for
+// everyday use, e.g. a new cluster being onboarded
+type StartupChecker interface {
+	// RunStartupChecks runs the named checks against the cluster and a
+	// namespace. A check that cannot run, e.g. for lack of permissions, fails
+	// with the reason.
+	RunStartupChecks(ctx context.Context, namespace string, names []string) []StartupCheckResult
+}
+
+// RunStartupChecks runs the named startup checks in the order of StartupChecks
+func (c *K8sResourceClient) RunStartupChecks(ctx context.Context, namespace string, names []string) []StartupCheckResult {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	enabled := make(map[string]bool, len(names))
+	for _, name := range names {
+		enabled[name] = true
+	}
+
+	var results []StartupCheckResult
+	for _, name := range StartupChecks {
+		if !enabled[name] {
+			continue
+		}
+		switch name {
+		case StartupCheckMetricsServer:
+			results = append(results, c.checkMetricsServer(ctx))
+		case StartupCheckDefaultStorageClass:
+			results = append(results, c.checkDefaultStorageClass(ctx))
+		case StartupCheckImagePullSecrets:
+			results = append(results, c.checkImagePullSecrets(ctx, namespace))
+		}
+	}
+	return results
+}
+
+// checkMetricsServer checks that the resource metrics API is served
+func (c *K8sResourceClient) checkMetricsServer(ctx context.Context) StartupCheckResult {
+	result := StartupCheckResult{Name: StartupCheckMetricsServer, Title: "Metrics server"}
+
+	_, err := c.clientset.Discovery().RESTClient().Get().AbsPath(constants.MetricsAPIPath).DoRaw(ctx)
+	switch {
+	case err == nil:
+		result.Passed = true
+		result.Message = "The resource metrics API is available"
+	case apierrors.IsNotFound(err):
+		result.Message = "The resource metrics API (metrics.k8s.io) is not served"
+		result.Hint = "Install metrics-server to see pod and node usage and to use the Top view and HPAs"
+	default:
+		result.Message = fmt.Sprintf("The resource metrics API is unavailable: %v", err)
+		result.Hint = "Check that the metrics-server pods are running and its APIService is available"
+	}
+	return result
+}
+
+// checkDefaultStorageClass checks that claims without a storage class get one
+func (c *K8sResourceClient) checkDefaultStorageClass(ctx context.Context) StartupCheckResult {
+	list, err := c.clientset.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return StartupCheckResult{
+			Name:    StartupCheckDefaultStorageClass,
+			Title:   "Default storage class",
+			Message: fmt.Sprintf("Failed to list storage classes: %v", err),
+			Hint:    "Listing storage classes needs cluster-wide read access",
+		}
+	}
+
+	classes := make([]StorageClassInfo, len(list.Items))
+	for i := range list.Items {
+		classes[i] = convertStorageClass(&list.Items[i])
+	}
+	return defaultStorageClassResult(classes)
+}
+
+// defaultStorageClassResult checks that exactly one storage class is the default
+func defaultStorageClassResult(classes []StorageClassInfo) StartupCheckResult {
+	result := StartupCheckResult{Name: StartupCheckDefaultStorageClass, Title: "Default storage class"}
+
+	var defaults []string
+	for _, class := range classes {
+		if class.Default {
+			defaults = append(defaults, class.Name)
+		}
+	}
+
+	switch {
+	case len(classes) == 0:
+		result.Message = "No storage class is defined"
+		result.Hint = "Install a storage provisioner, claims cannot be bound until a persistent volume is created by hand"
+	case len(defaults) == 0:
+		result.Message = fmt.Sprintf("None of the %d storage classes is the default", len(classes))
+		result.Hint = "Claims without a storageClassName stay Pending, annotate a class with storageclass.kubernetes.io/is-default-class=true"
+	case len(defaults) > 1:
+		result.Message = fmt.Sprintf("%d storage classes are marked default: %s", len(defaults), strings.Join(defaults, ", "))
+		result.Hint = "Only keep the is-default-class annotation on one class, the newest one is used"
+	default:
+		result.Passed = true
+		result.Message = fmt.Sprintf("%s is the default storage class", defaults[0])
+	}
+	return result
+}
+
+// checkImagePullSecrets checks that the default service account of a
+// namespace has pull secrets and that they exist
+func (c *K8sResourceClient) checkImagePullSecrets(ctx context.Context, namespace string) StartupCheckResult {
+	sa, err := c.clientset.CoreV1().ServiceAccounts(namespace).Get(ctx, constants.DefaultServiceAccount, metav1.GetOptions{})
+	if err != nil {
+		return StartupCheckResult{
+			Name:    StartupCheckImagePullSecrets,
+			Title:   "Image pull secrets",
+			Message: fmt.Sprintf("Failed to get service account %s/%s: %v", namespace, constants.DefaultServiceAccount, err),
+		}
+	}
+
+	var missing []string
+	for _, ref := range sa.ImagePullSecrets {
+		_, err := c.clientset.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, ref.Name)
+		}
+	}
+	return imagePullSecretsResult(sa, missing)
+}
+
+// imagePullSecretsResult checks the pull secrets of a service account, given
+// those of its pull secrets that do not exist
+func imagePullSecretsResult(sa *corev1.ServiceAccount, missing []string) StartupCheckResult {
+	result := StartupCheckResult{Name: StartupCheckImagePullSecrets, Title: "Image pull secrets"}
+	link := fmt.Sprintf("oc secrets link %s <secret> --for=pull -n %s", sa.Name, sa.Namespace)
+
+	switch {
+	case len(sa.ImagePullSecrets) == 0:
+		result.Message = fmt.Sprintf("Service account %s/%s has no image pull secrets", sa.Namespace, sa.Name)
+		result.Hint = "Images from private registries will fail to pull, create a docker-registry secret and run " + link
+	case len(missing) > 0:
+		result.Message = fmt.Sprintf("Service account %s/%s references missing pull secrets: %s", sa.Namespace, sa.Name, strings.Join(missing, ", "))
+		result.Hint = "Recreate the secrets, or unlink them with oc secrets unlink " + sa.Name + " <secret> -n " + sa.Namespace
+	default:
+		result.Passed = true
+		names := make([]string, len(sa.ImagePullSecrets))
+		for i, ref := range sa.ImagePullSecrets {
+			names[i] = ref.Name
+		}
+		result.Message = fmt.Sprintf("Service account %s/%s pulls with %s", sa.Namespace, sa.Name, strings.Join(names, ", "))
+	}
+	return result
+}
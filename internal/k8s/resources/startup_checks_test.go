@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDefaultStorageClassResult(t *testing.T) {
+	class := func(name string, isDefault bool) StorageClassInfo {
+		return StorageClassInfo{ResourceInfo: ResourceInfo{Name: name}, Default: isDefault}
+	}
+
+	tests := []struct {
+		name    string
+		classes []StorageClassInfo
+		passed  bool
+		message string
+	}{
+		{"no classes", nil, false, "No storage class"},
+		{"no default", []StorageClassInfo{class("gp3", false)}, false, "None of the 1"},
+		{"two defaults", []StorageClassInfo{class("gp2", true), class("gp3", true)}, false, "gp2, gp3"},
+		{"one default", []StorageClassInfo{class("gp2", false), class("gp3", true)}, true, "gp3 is the default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := defaultStorageClassResult(tt.classes)
+			if result.Passed != tt.passed || !strings.Contains(result.Message, tt.message) {
+				t.Errorf("Expected passed=%v with %q, got %+v", tt.passed, tt.message, result)
+			}
+			if !result.Passed && result.Hint == "" {
+				t.Errorf("Expected a hint for a failure")
+			}
+		})
+	}
+}
+
+func TestImagePullSecretsResult(t *testing.T) {
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "shop"}}
+	if result := imagePullSecretsResult(sa, nil); result.Passed || !strings.Contains(result.Hint, "oc secrets link default <secret> --for=pull -n shop") {
+		t.Errorf("Expected a failure telling how to link a secret, got %+v", result)
+	}
+
+	sa.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "quay"}, {Name: "default-dockercfg-x7k2p"}}
+	if result := imagePullSecretsResult(sa, []string{"quay"}); result.Passed || !strings.Contains(result.Message, "missing pull secrets: quay") {
+		t.Errorf("Expected the missing secret to be reported, got %+v", result)
+	}
+	if result := imagePullSecretsResult(sa, nil); !result.Passed {
+		t.Errorf("Expected the check to pass, got %+v", result)
+	}
+}
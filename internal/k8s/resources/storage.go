@@ -0,0 +1,205 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// defaultStorageClassAnnotations mark the default StorageClass, the beta
+// annotation is still set by some installers
+var defaultStorageClassAnnotations = []string{
+	"storageclass.kubernetes.io/is-default-class",
+	"storageclass.beta.kubernetes.io/is-default-class",
+}
+
+// ListPersistentVolumeClaims lists persistentvolumeclaims in the specified namespace
+func (c *K8sResourceClient) ListPersistentVolumeClaims(ctx context.Context, opts ListOptions) (*ResourceList[PersistentVolumeClaimInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumeclaims: %w", err)
+	}
+
+	items := make([]PersistentVolumeClaimInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertPersistentVolumeClaim(&list.Items[i])
+	}
+
+	return &ResourceList[PersistentVolumeClaimInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// ListPersistentVolumes lists the persistentvolumes of the cluster.
+// PersistentVolumes are cluster-scoped, so opts.Namespace is ignored.
+func (c *K8sResourceClient) ListPersistentVolumes(ctx context.Context, opts ListOptions) (*ResourceList[PersistentVolumeInfo], error) {
+	_, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.CoreV1().PersistentVolumes().List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumes: %w", err)
+	}
+
+	items := make([]PersistentVolumeInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertPersistentVolume(&list.Items[i])
+	}
+
+	return &ResourceList[PersistentVolumeInfo]{
+		Items:     items,
+		Total:     len(items),
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// ListStorageClasses lists the storageclasses of the cluster.
+// StorageClasses are cluster-scoped, so opts.Namespace is ignored.
+func (c *K8sResourceClient) ListStorageClasses(ctx context.Context, opts ListOptions) (*ResourceList[StorageClassInfo], error) {
+	_, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.StorageV1().StorageClasses().List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storageclasses: %w", err)
+	}
+
+	items := make([]StorageClassInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertStorageClass(&list.Items[i])
+	}
+
+	return &ResourceList[StorageClassInfo]{
+		Items:     items,
+		Total:     len(items),
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+func convertPersistentVolumeClaim(pvc *corev1.PersistentVolumeClaim) PersistentVolumeClaimInfo {
+	info := PersistentVolumeClaimInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        pvc.Name,
+			Namespace:   pvc.Namespace,
+			Kind:        "PersistentVolumeClaim",
+			APIVersion:  "v1",
+			Labels:      pvc.Labels,
+			Annotations: pvc.Annotations,
+			CreatedAt:   pvc.CreationTimestamp.Time,
+			Status:      string(pvc.Status.Phase),
+		},
+		Phase:       string(pvc.Status.Phase),
+		Volume:      pvc.Spec.VolumeName,
+		AccessModes: accessModes(pvc.Spec.AccessModes),
+		Age:         formatAge(pvc.CreationTimestamp.Time),
+	}
+
+	if pvc.Spec.StorageClassName != nil {
+		info.StorageClass = *pvc.Spec.StorageClassName
+	}
+	if pvc.Spec.VolumeMode != nil {
+		info.VolumeMode = string(*pvc.Spec.VolumeMode)
+	}
+	if requested, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		info.Requested = requested.String()
+	}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		info.Capacity = capacity.String()
+	}
+	if pvc.DeletionTimestamp != nil {
+		info.Status = "Terminating"
+	}
+
+	return info
+}
+
+func convertPersistentVolume(pv *corev1.PersistentVolume) PersistentVolumeInfo {
+	info := PersistentVolumeInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        pv.Name,
+			Kind:        "PersistentVolume",
+			APIVersion:  "v1",
+			Labels:      pv.Labels,
+			Annotations: pv.Annotations,
+			CreatedAt:   pv.CreationTimestamp.Time,
+			Status:      string(pv.Status.Phase),
+		},
+		Phase:         string(pv.Status.Phase),
+		AccessModes:   accessModes(pv.Spec.AccessModes),
+		ReclaimPolicy: string(pv.Spec.PersistentVolumeReclaimPolicy),
+		StorageClass:  pv.Spec.StorageClassName,
+		Reason:        pv.Status.Reason,
+		Age:           formatAge(pv.CreationTimestamp.Time),
+	}
+
+	if capacity, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+		info.Capacity = capacity.String()
+	}
+	if ref := pv.Spec.ClaimRef; ref != nil {
+		info.Claim = ref.Namespace + "/" + ref.Name
+	}
+
+	return info
+}
+
+func convertStorageClass(sc *storagev1.StorageClass) StorageClassInfo {
+	info := StorageClassInfo{
+		ResourceInfo: ResourceInfo{
+			Name:        sc.Name,
+			Kind:        "StorageClass",
+			APIVersion:  "storage.k8s.io/v1",
+			Labels:      sc.Labels,
+			Annotations: sc.Annotations,
+			CreatedAt:   sc.CreationTimestamp.Time,
+		},
+		Provisioner:       sc.Provisioner,
+		ReclaimPolicy:     string(corev1.PersistentVolumeReclaimDelete),
+		VolumeBindingMode: string(storagev1.VolumeBindingImmediate),
+		Age:               formatAge(sc.CreationTimestamp.Time),
+	}
+
+	if sc.ReclaimPolicy != nil {
+		info.ReclaimPolicy = string(*sc.ReclaimPolicy)
+	}
+	if sc.VolumeBindingMode != nil {
+		info.VolumeBindingMode = string(*sc.VolumeBindingMode)
+	}
+	if sc.AllowVolumeExpansion != nil {
+		info.AllowExpansion = *sc.AllowVolumeExpansion
+	}
+	for _, annotation := range defaultStorageClassAnnotations {
+		if sc.Annotations[annotation] == "true" {
+			info.Default = true
+		}
+	}
+
+	return info
+}
+
+// accessModes abbreviates access modes the way kubectl prints them
+func accessModes(modes []corev1.PersistentVolumeAccessMode) []string {
+	abbreviated := make([]string, 0, len(modes))
+	for _, mode := range modes {
+		switch mode {
+		case corev1.ReadWriteOnce:
+			abbreviated = append(abbreviated, "RWO")
+		case corev1.ReadOnlyMany:
+			abbreviated = append(abbreviated, "ROX")
+		case corev1.ReadWriteMany:
+			abbreviated = append(abbreviated, "RWX")
+		case corev1.ReadWriteOncePod:
+			abbreviated = append(abbreviated, "RWOP")
+		default:
+			abbreviated = append(abbreviated, string(mode))
+		}
+	}
+	return abbreviated
+}
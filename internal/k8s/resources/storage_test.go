@@ -0,0 +1,95 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvertPersistentVolumeClaim(t *testing.T) {
+	class := "fast"
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-db-0", Namespace: "demo"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadWriteOncePod},
+			StorageClassName: &class,
+			VolumeName:       "pvc-1234",
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase:    corev1.ClaimBound,
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("12Gi")},
+		},
+	}
+
+	info := convertPersistentVolumeClaim(pvc)
+	if info.Status != "Bound" || info.Volume != "pvc-1234" || info.StorageClass != "fast" {
+		t.Errorf("Unexpected claim %+v", info)
+	}
+	if info.Requested != "10Gi" || info.Capacity != "12Gi" {
+		t.Errorf("Expected 10Gi requested and 12Gi capacity, got %s and %s", info.Requested, info.Capacity)
+	}
+	if !reflect.DeepEqual(info.AccessModes, []string{"RWO", "RWOP"}) {
+		t.Errorf("Unexpected access modes %v", info.AccessModes)
+	}
+
+	pending := convertPersistentVolumeClaim(&corev1.PersistentVolumeClaim{Status: corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending}})
+	if pending.Status != "Pending" || pending.Volume != "" || pending.Capacity != "" {
+		t.Errorf("Unexpected pending claim %+v", pending)
+	}
+}
+
+func TestConvertPersistentVolume(t *testing.T) {
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc-1234"},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity:                      corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("12Gi")},
+			AccessModes:                   []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              "fast",
+			ClaimRef:                      &corev1.ObjectReference{Namespace: "demo", Name: "data-db-0"},
+		},
+		Status: corev1.PersistentVolumeStatus{Phase: corev1.VolumeReleased},
+	}
+
+	info := convertPersistentVolume(pv)
+	if info.Namespace != "" || info.Status != "Released" || info.Capacity != "12Gi" || info.ReclaimPolicy != "Retain" {
+		t.Errorf("Unexpected volume %+v", info)
+	}
+	if info.Claim != "demo/data-db-0" || !reflect.DeepEqual(info.AccessModes, []string{"RWX"}) {
+		t.Errorf("Unexpected claim %q or access modes %v", info.Claim, info.AccessModes)
+	}
+}
+
+func TestConvertStorageClass(t *testing.T) {
+	waitForConsumer := storagev1.VolumeBindingWaitForFirstConsumer
+	expand := true
+	sc := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fast",
+			Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+		},
+		Provisioner:          "ebs.csi.aws.com",
+		VolumeBindingMode:    &waitForConsumer,
+		AllowVolumeExpansion: &expand,
+	}
+
+	info := convertStorageClass(sc)
+	if !info.Default || !info.AllowExpansion || info.VolumeBindingMode != "WaitForFirstConsumer" {
+		t.Errorf("Unexpected storage class %+v", info)
+	}
+	// The API server defaults an unset reclaim policy to Delete
+	if info.ReclaimPolicy != "Delete" {
+		t.Errorf("Expected the Delete reclaim policy by default, got %q", info.ReclaimPolicy)
+	}
+
+	if plain := convertStorageClass(&storagev1.StorageClass{}); plain.Default || plain.VolumeBindingMode != "Immediate" {
+		t.Errorf("Unexpected defaults %+v", plain)
+	}
+}
@@ -0,0 +1,280 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// TabSummary is the number of objects of a resource type and how many of
+// them are failing, e.g. pods that crash or deployments short of replicas
+type TabSummary struct {
+	Resource string
+	Count    int
+	Failing  int
+}
+
+// TabSummaryWatcher watches the object counts the tab bar shows
+type TabSummaryWatcher interface {
+	// WatchTabSummaries watches the summarized resource types of a
+	// namespace and calls send with every summary when one changes, until
+	// ctx is done
+	WatchTabSummaries(ctx context.Context, namespace string, send func([]TabSummary)) error
+}
+
+// summaryResource is a resource type watched for the tab bar. Types without
+// a failing func are watched by metadata only, so e.g. secret data is never
+// held in memory.
+type summaryResource struct {
+	gvr           schema.GroupVersionResource
+	clusterScoped bool
+	failing       func(obj *unstructured.Unstructured) bool
+}
+
+// summaryResources are the resource types of the tabs
+var summaryResources = []summaryResource{
+	{gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, failing: podFailing},
+	{gvr: schema.GroupVersionResource{Version: "v1", Resource: "services"}},
+	{gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, failing: replicasFailing("availableReplicas")},
+	{gvr: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}},
+	{gvr: schema.GroupVersionResource{Version: "v1", Resource: "secrets"}},
+	{gvr: schema.GroupVersionResource{Group: "build.openshift.io", Version: "v1", Resource: "buildconfigs"}},
+	{gvr: schema.GroupVersionResource{Group: "image.openshift.io", Version: "v1", Resource: "imagestreams"}},
+	{gvr: routeResource},
+	{gvr: schema.GroupVersionResource{Group: "build.openshift.io", Version: "v1", Resource: "builds"}, failing: phaseFailing("Failed", "Error")},
+	{gvr: schema.GroupVersionResource{Version: "v1", Resource: "events"}, failing: eventWarning},
+	{gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}, failing: replicasFailing("readyReplicas")},
+	{gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}, failing: daemonSetFailing},
+	{gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}, failing: replicasFailing("readyReplicas")},
+	{gvr: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}, failing: jobFailing},
+	{gvr: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}},
+	{gvr: schema.GroupVersionResource{Version: "v1", Resource: "nodes"}, clusterScoped: true, failing: nodeFailing},
+	{gvr: schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}, failing: pvcFailing},
+	{gvr: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}},
+	{gvr: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}},
+	{gvr: schema.GroupVersionResource{Group: "apps.openshift.io", Version: "v1", Resource: "deploymentconfigs"}, failing: replicasFailing("availableReplicas")},
+}
+
+// podWaitingHealthy are the reasons a container waits while a pod starts
+var podWaitingHealthy = []string{"ContainerCreating", "PodInitializing"}
+
+// podFailing reports pods that failed, or whose containers wait for another
+// reason than starting, e.g. CrashLoopBackOff or ImagePullBackOff
+func podFailing(obj *unstructured.Unstructured) bool {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase == "Failed" || phase == "Unknown" {
+		return true
+	}
+	if phase == "Succeeded" {
+		return false
+	}
+	for _, field := range []string{"initContainerStatuses", "containerStatuses"} {
+		statuses, _, _ := unstructured.NestedSlice(obj.Object, "status", field)
+		for _, status := range statuses {
+			status, ok := status.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			reason, found, _ := unstructured.NestedString(status, "state", "waiting", "reason")
+			if found && !slices.Contains(podWaitingHealthy, reason) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// replicasFailing reports workloads with fewer replicas in the given status
+// field than they ask for
+func replicasFailing(field string) func(obj *unstructured.Unstructured) bool {
+	return func(obj *unstructured.Unstructured) bool {
+		desired, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		if !found {
+			desired = 1
+		}
+		current, _, _ := unstructured.NestedInt64(obj.Object, "status", field)
+		return current < desired
+	}
+}
+
+// daemonSetFailing reports DaemonSets with pods that are not ready
+func daemonSetFailing(obj *unstructured.Unstructured) bool {
+	desired, _, _ := unstructured.NestedInt64(obj.Object, "status", "desiredNumberScheduled")
+	ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "numberReady")
+	return ready < desired
+}
+
+// jobFailing reports Jobs that failed
+func jobFailing(obj *unstructured.Unstructured) bool {
+	return conditionStatus(obj, "Failed") == "True"
+}
+
+// nodeFailing reports nodes that are not ready
+func nodeFailing(obj *unstructured.Unstructured) bool {
+	return conditionStatus(obj, "Ready") != "True"
+}
+
+// pvcFailing reports claims that are not bound to a volume
+func pvcFailing(obj *unstructured.Unstructured) bool {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	return phase != "Bound"
+}
+
+// eventWarning reports warning events
+func eventWarning(obj *unstructured.Unstructured) bool {
+	eventType, _, _ := unstructured.NestedString(obj.Object, "type")
+	return eventType == "Warning"
+}
+
+// phaseFailing reports objects in one of the given status phases
+func phaseFailing(phases ...string) func(obj *unstructured.Unstructured) bool {
+	return func(obj *unstructured.Unstructured) bool {
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		return slices.Contains(phases, phase)
+	}
+}
+
+// conditionStatus returns the status of a status condition, empty when the
+// object has no such condition
+func conditionStatus(obj *unstructured.Unstructured, conditionType string) string {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, condition := range conditions {
+		condition, ok := condition.(map[string]interface{})
+		if ok && condition["type"] == conditionType {
+			status, _ := condition["status"].(string)
+			return status
+		}
+	}
+	return ""
+}
+
+// summarize counts the objects of an informer's store
+func (r summaryResource) summarize(store cache.Store) TabSummary {
+	summary := TabSummary{Resource: r.gvr.Resource}
+	for _, obj := range store.List() {
+		summary.Count++
+		if u, ok := obj.(*unstructured.Unstructured); ok && r.failing != nil && r.failing(u) {
+			summary.Failing++
+		}
+	}
+	return summary
+}
+
+// WatchTabSummaries watches the resource types of the tabs in a namespace
+// and calls send with every summary when one changes, until ctx is done.
+// Types the user may not list or the cluster does not serve are left out.
+func (c *K8sResourceClient) WatchTabSummaries(ctx context.Context, namespace string, send func([]TabSummary)) error {
+	if c.restConfig == nil {
+		return fmt.Errorf("REST config not available for watches")
+	}
+	metadataClient, err := c.metadataClient()
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := dynamic.NewForConfig(c.restConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	var mu sync.Mutex
+	stores := make([]cache.Store, len(summaryResources))
+	synced := make([]cache.InformerSynced, len(summaryResources))
+	dirty := false
+	markDirty := func() {
+		mu.Lock()
+		dirty = true
+		mu.Unlock()
+	}
+
+	for i, resource := range summaryResources {
+		scope := namespace
+		if resource.clusterScoped {
+			scope = ""
+		}
+		var informer cache.SharedIndexInformer
+		if resource.failing != nil {
+			informer = dynamicinformer.NewFilteredDynamicInformer(dynamicClient, resource.gvr, scope, 0, cache.Indexers{}, nil).Informer()
+		} else {
+			informer = metadatainformer.NewFilteredMetadataInformer(metadataClient, resource.gvr, scope, 0, cache.Indexers{}, nil).Informer()
+		}
+
+		informerCtx, stop := context.WithCancel(ctx)
+		// Stop watching types the user may not list or the cluster does not
+		// serve; other errors are retried by the informer
+		_ = informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+			if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
+				stop()
+			}
+		})
+		_, _ = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { markDirty() },
+			UpdateFunc: func(interface{}, interface{}) { markDirty() },
+			DeleteFunc: func(interface{}) { markDirty() },
+		})
+		go informer.Run(informerCtx.Done())
+
+		mu.Lock()
+		stores[i] = informer.GetStore()
+		mu.Unlock()
+		go func(i int) {
+			// Types that stopped are left out of the summaries
+			<-informerCtx.Done()
+			mu.Lock()
+			stores[i] = nil
+			dirty = true
+			mu.Unlock()
+		}(i)
+		go func(informer cache.SharedIndexInformer) {
+			// An empty store is only a count of zero once the list arrived
+			if cache.WaitForCacheSync(informerCtx.Done(), informer.HasSynced) {
+				markDirty()
+			}
+		}(informer)
+		synced[i] = informer.HasSynced
+	}
+
+	// Changes are sent at most once per interval, a rollout updates many
+	// objects at once
+	go func() {
+		ticker := time.NewTicker(constants.TabSummaryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			mu.Lock()
+			if !dirty {
+				mu.Unlock()
+				continue
+			}
+			dirty = false
+			var summaries []TabSummary
+			for i, store := range stores {
+				if store != nil && synced[i]() {
+					summaries = append(summaries, summaryResources[i].summarize(store))
+				}
+			}
+			mu.Unlock()
+			send(summaries)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestSummaryFailing(t *testing.T) {
+	object := func(fields map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: fields}
+	}
+	waiting := func(reason string) map[string]interface{} {
+		return map[string]interface{}{"status": map[string]interface{}{
+			"phase": "Running",
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"state": map[string]interface{}{"waiting": map[string]interface{}{"reason": reason}}},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name    string
+		failing func(*unstructured.Unstructured) bool
+		obj     *unstructured.Unstructured
+		want    bool
+	}{
+		{"crash looping pod", podFailing, object(waiting("CrashLoopBackOff")), true},
+		{"starting pod", podFailing, object(waiting("ContainerCreating")), false},
+		{"failed pod", podFailing, object(map[string]interface{}{"status": map[string]interface{}{"phase": "Failed"}}), true},
+		{"completed pod", podFailing, object(map[string]interface{}{"status": map[string]interface{}{"phase": "Succeeded"}}), false},
+		{"deployment short of replicas", replicasFailing("availableReplicas"), object(map[string]interface{}{
+			"spec":   map[string]interface{}{"replicas": int64(3)},
+			"status": map[string]interface{}{"availableReplicas": int64(2)},
+		}), true},
+		{"deployment defaulting to one replica", replicasFailing("availableReplicas"), object(map[string]interface{}{
+			"status": map[string]interface{}{"availableReplicas": int64(1)},
+		}), false},
+		{"scaled to zero", replicasFailing("readyReplicas"), object(map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": int64(0)},
+		}), false},
+		{"failed job", jobFailing, object(map[string]interface{}{"status": map[string]interface{}{
+			"conditions": []interface{}{map[string]interface{}{"type": "Failed", "status": "True"}},
+		}}), true},
+		{"node without ready condition", nodeFailing, object(map[string]interface{}{}), true},
+		{"pending claim", pvcFailing, object(map[string]interface{}{"status": map[string]interface{}{"phase": "Pending"}}), true},
+		{"warning event", eventWarning, object(map[string]interface{}{"type": "Warning"}), true},
+		{"errored build", phaseFailing("Failed", "Error"), object(map[string]interface{}{"status": map[string]interface{}{"phase": "Error"}}), true},
+	}
+	for _, tt := range tests {
+		if got := tt.failing(tt.obj); got != tt.want {
+			t.Errorf("%s: failing = %v, expected %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	for i, phase := range []string{"Running", "Failed", "Running"} {
+		pod := &unstructured.Unstructured{Object: map[string]interface{}{"status": map[string]interface{}{"phase": phase}}}
+		pod.SetNamespace("shop")
+		pod.SetName(fmt.Sprintf("web-%d", i))
+		if err := store.Add(pod); err != nil {
+			t.Fatalf("Add() returned error: %v", err)
+		}
+	}
+
+	got := summaryResources[0].summarize(store)
+	if got != (TabSummary{Resource: "pods", Count: 3, Failing: 1}) {
+		t.Errorf("summarize() = %+v, expected 3 pods with 1 failing", got)
+	}
+}
@@ -0,0 +1,365 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+)
+
+// failingContainerReasons are the waiting and terminated reasons of
+// containers that will not become ready without intervention
+var failingContainerReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+	"RunContainerError":          true,
+	"Error":                      true,
+	"OOMKilled":                  true,
+}
+
+// listAllIngresses lists every Ingress of a namespace, page by page
+func (c *K8sResourceClient) listAllIngresses(ctx context.Context, namespace string) ([]IngressInfo, error) {
+	var ingresses []IngressInfo
+	opts := ListOptions{Namespace: namespace}
+	for {
+		list, err := c.ListIngresses(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		ingresses = append(ingresses, list.Items...)
+		if list.Continue == "" {
+			return ingresses, nil
+		}
+		opts.Continue = list.Continue
+	}
+}
+
+// GetServiceTopology returns the path traffic takes through a service: the
+// ingresses routing to it, the pods it selects and the workloads owning those
+// pods. Routes are OpenShift resources and are added by RouteTopologyNodes.
+func (c *K8sResourceClient) GetServiceTopology(ctx context.Context, namespace, serviceName string) (*ServiceTopology, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	svc, err := c.clientset.CoreV1().Services(namespace).Get(ctx, serviceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s/%s: %w", namespace, serviceName, err)
+	}
+
+	topology := &ServiceTopology{}
+
+	// Ingresses are best effort, listing them may be forbidden
+	if ingresses, err := c.listAllIngresses(ctx, namespace); err == nil {
+		topology.Entries = IngressTopologyNodes(ingresses, svc.Name)
+	}
+
+	var pods []corev1.Pod
+	if len(svc.Spec.Selector) > 0 {
+		list, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods for service %s/%s: %w", namespace, serviceName, err)
+		}
+		pods = list.Items
+	}
+
+	owners := c.workloadOwners(ctx, namespace)
+	if controllers, err := c.clientset.CoreV1().ReplicationControllers(namespace).List(ctx, metav1.ListOptions{}); err == nil {
+		// DeploymentConfigs run their pods through ReplicationControllers
+		for i := range controllers.Items {
+			if ref := metav1.GetControllerOf(&controllers.Items[i]); ref != nil {
+				owners[workloadKey(namespace, "ReplicationController", controllers.Items[i].Name)] = ref.Kind + "/" + ref.Name
+			}
+		}
+	}
+
+	var workloads []string
+	seen := make(map[string]bool)
+	for i := range pods {
+		owner := strings.TrimPrefix(podWorkload(&pods[i], owners), namespace+"/")
+		if strings.HasPrefix(owner, "Pod/") {
+			owner = ""
+		}
+		topology.Pods = append(topology.Pods, podTopologyNode(c.convertPod(&pods[i]), owner))
+		if owner != "" && !seen[owner] {
+			seen[owner] = true
+			workloads = append(workloads, owner)
+		}
+	}
+	sort.Slice(topology.Pods, func(i, j int) bool { return topology.Pods[i].Name < topology.Pods[j].Name })
+	sort.Strings(workloads)
+
+	for _, workload := range workloads {
+		kind, name, _ := strings.Cut(workload, "/")
+		topology.Workloads = append(topology.Workloads, c.workloadTopologyNode(ctx, namespace, kind, name))
+	}
+
+	topology.Service = serviceTopologyNode(c.convertService(svc), topology.Pods)
+	return topology, nil
+}
+
+// workloadTopologyNode reports the readiness of a workload. Workloads that
+// cannot be read are listed with an unknown health.
+func (c *K8sResourceClient) workloadTopologyNode(ctx context.Context, namespace, kind, name string) TopologyNode {
+	node := TopologyNode{Kind: kind, Name: name}
+
+	var ready, desired int32
+	var err error
+	switch kind {
+	case "Deployment":
+		var deploy *appsv1.Deployment
+		if deploy, err = c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			ready, desired = deploy.Status.ReadyReplicas, replicasOrDefault(deploy.Spec.Replicas)
+		}
+	case "StatefulSet":
+		var sts *appsv1.StatefulSet
+		if sts, err = c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			ready, desired = sts.Status.ReadyReplicas, replicasOrDefault(sts.Spec.Replicas)
+		}
+	case "DaemonSet":
+		var ds *appsv1.DaemonSet
+		if ds, err = c.clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			ready, desired = ds.Status.NumberReady, ds.Status.DesiredNumberScheduled
+		}
+	case "ReplicaSet":
+		var rs *appsv1.ReplicaSet
+		if rs, err = c.clientset.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			ready, desired = rs.Status.ReadyReplicas, replicasOrDefault(rs.Spec.Replicas)
+		}
+	case "ReplicationController":
+		var rc *corev1.ReplicationController
+		if rc, err = c.clientset.CoreV1().ReplicationControllers(namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			ready, desired = rc.Status.ReadyReplicas, replicasOrDefault(rc.Spec.Replicas)
+		}
+	case "DeploymentConfig":
+		ready, desired, err = c.deploymentConfigReplicas(ctx, namespace, name)
+	case "Job":
+		job, err := c.clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			node.Detail = "unknown"
+			return node
+		}
+		switch {
+		case job.Status.Succeeded > 0:
+			node.Health, node.Detail = TopologyHealthy, "complete"
+		case job.Status.Failed > 0:
+			node.Health, node.Detail = TopologyFailed, fmt.Sprintf("%d failed", job.Status.Failed)
+		default:
+			node.Health, node.Detail = TopologyDegraded, fmt.Sprintf("%d active", job.Status.Active)
+		}
+		return node
+	default:
+		return node
+	}
+
+	if err != nil {
+		node.Detail = "unknown"
+		return node
+	}
+	node.Health, node.Detail = replicaHealth(ready, desired)
+	return node
+}
+
+// deploymentConfigReplicas reads the ready and desired replicas of a
+// DeploymentConfig without depending on the OpenShift client
+func (c *K8sResourceClient) deploymentConfigReplicas(ctx context.Context, namespace, name string) (int32, int32, error) {
+	if c.restConfig == nil {
+		return 0, 0, fmt.Errorf("REST config not available for dynamic operations")
+	}
+	dynamicClient, err := dynamic.NewForConfig(c.restConfig)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	dc, err := dynamicClient.Resource(cascadeResources["deploymentconfig"]).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, 0, err
+	}
+	ready, _, _ := unstructured.NestedInt64(dc.Object, "status", "readyReplicas")
+	desired, _, _ := unstructured.NestedInt64(dc.Object, "spec", "replicas")
+	return int32(ready), int32(desired), nil
+}
+
+// replicasOrDefault returns the desired replicas of a workload, which
+// default to one when unset
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// replicaHealth rates a workload by its ready replicas
+func replicaHealth(ready, desired int32) (string, string) {
+	detail := fmt.Sprintf("%d/%d ready", ready, desired)
+	switch {
+	case desired == 0:
+		return TopologyDegraded, "scaled to zero"
+	case ready >= desired:
+		return TopologyHealthy, detail
+	case ready > 0:
+		return TopologyDegraded, detail
+	default:
+		return TopologyFailed, detail
+	}
+}
+
+// podTopologyNode rates a pod selected by a service: failing containers make
+// it Failed, a pod that is not yet ready is Degraded
+func podTopologyNode(pod PodInfo, owner string) TopologyNode {
+	node := TopologyNode{Kind: "Pod", Name: pod.Name, Owner: owner, Health: TopologyHealthy}
+
+	state := pod.Phase
+	allReady := len(pod.ContainerInfo) > 0
+	for _, container := range pod.ContainerInfo {
+		if failingContainerReasons[container.Reason] {
+			state = container.Reason
+			node.Health = TopologyFailed
+		}
+		allReady = allReady && container.Ready
+	}
+
+	switch {
+	case pod.Phase == string(corev1.PodFailed):
+		node.Health = TopologyFailed
+	case node.Health != TopologyFailed && (pod.Phase != string(corev1.PodRunning) || !allReady):
+		node.Health = TopologyDegraded
+	}
+
+	node.Detail = state + " " + pod.Ready
+	if pod.Restarts > 0 {
+		node.Detail += fmt.Sprintf(" · %d restarts", pod.Restarts)
+	}
+	return node
+}
+
+// serviceTopologyNode rates a service by how many of its pods are ready to
+// serve. Services without a selector have endpoints managed outside of
+// Kubernetes and an unknown health.
+func serviceTopologyNode(svc ServiceInfo, pods []TopologyNode) TopologyNode {
+	node := TopologyNode{Kind: "Service", Name: svc.Name}
+
+	parts := []string{svc.Type}
+	if len(svc.Ports) > 0 {
+		parts = append(parts, strings.Join(svc.Ports, ","))
+	}
+
+	ready := 0
+	for _, pod := range pods {
+		if pod.Health == TopologyHealthy {
+			ready++
+		}
+	}
+
+	switch {
+	case svc.Type == string(corev1.ServiceTypeExternalName):
+		parts = append(parts, "external name")
+	case svc.Selector == "":
+		parts = append(parts, "no selector")
+	case len(pods) == 0:
+		node.Health = TopologyFailed
+		parts = append(parts, "no endpoints")
+	default:
+		node.Health, _ = replicaHealth(int32(ready), int32(len(pods)))
+		parts = append(parts, fmt.Sprintf("%d/%d pods ready", ready, len(pods)))
+	}
+
+	node.Detail = strings.Join(parts, " · ")
+	return node
+}
+
+// IngressTopologyNodes returns the ingresses with a rule or default backend
+// routing to a service. Ingresses without a load balancer address are
+// Degraded.
+func IngressTopologyNodes(ingresses []IngressInfo, service string) []TopologyNode {
+	var nodes []TopologyNode
+	for _, ing := range ingresses {
+		var hosts []string
+		targets := backendService(ing.DefaultBackend) == service
+		for _, rule := range ing.Rules {
+			if backendService(rule.Backend) != service {
+				continue
+			}
+			targets = true
+			host := rule.Host
+			if host == "" {
+				host = "*"
+			}
+			hosts = append(hosts, host+rule.Path)
+		}
+		if !targets {
+			continue
+		}
+
+		node := TopologyNode{Kind: "Ingress", Name: ing.Name, Detail: strings.Join(hosts, ", "), Health: TopologyHealthy}
+		if len(ing.Addresses) == 0 {
+			node.Health = TopologyDegraded
+			node.Detail = strings.TrimPrefix(node.Detail+" · no address", " · ")
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes
+}
+
+// backendService returns the service of an ingress backend formatted as
+// service:port, or "" for resource backends
+func backendService(backend string) string {
+	if strings.Contains(backend, "/") {
+		return ""
+	}
+	service, _, _ := strings.Cut(backend, ":")
+	return service
+}
+
+// RouteTopologyNodes returns the routes sending traffic to a service. A route
+// is Healthy when every router considering it admitted it, Failed when none
+// did and Degraded otherwise.
+func RouteTopologyNodes(routes []RouteInfo, service string) []TopologyNode {
+	var nodes []TopologyNode
+	for _, route := range routes {
+		if route.Service.Name != service || (route.Service.Kind != "" && route.Service.Kind != "Service") {
+			continue
+		}
+
+		admitted, rejected := 0, 0
+		for _, admission := range route.Admissions {
+			switch admission.Admitted {
+			case string(corev1.ConditionTrue):
+				admitted++
+			case string(corev1.ConditionFalse):
+				rejected++
+			}
+		}
+
+		node := TopologyNode{Kind: "Route", Name: route.Name, Detail: route.Host + route.Path}
+		switch {
+		case admitted > 0 && rejected == 0:
+			node.Health = TopologyHealthy
+		case admitted == 0 && rejected > 0:
+			node.Health = TopologyFailed
+			node.Detail += " · not admitted"
+		default:
+			node.Health = TopologyDegraded
+			if admitted == 0 {
+				node.Detail += " · pending admission"
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes
+}
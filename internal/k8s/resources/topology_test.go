@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"testing"
+)
+
+func TestPodTopologyNode(t *testing.T) {
+	tests := []struct {
+		name   string
+		pod    PodInfo
+		health string
+		detail string
+	}{
+		{
+			name:   "ready",
+			pod:    PodInfo{Phase: "Running", Ready: "1/1", ContainerInfo: []ContainerInfo{{Ready: true, State: "Running"}}},
+			health: TopologyHealthy,
+			detail: "Running 1/1",
+		},
+		{
+			name:   "not ready yet",
+			pod:    PodInfo{Phase: "Running", Ready: "0/1", ContainerInfo: []ContainerInfo{{State: "Running"}}},
+			health: TopologyDegraded,
+			detail: "Running 0/1",
+		},
+		{
+			name:   "crash looping",
+			pod:    PodInfo{Phase: "Running", Ready: "0/1", Restarts: 7, ContainerInfo: []ContainerInfo{{State: "Waiting", Reason: "CrashLoopBackOff"}}},
+			health: TopologyFailed,
+			detail: "CrashLoopBackOff 0/1 · 7 restarts",
+		},
+		{
+			name:   "pending",
+			pod:    PodInfo{Phase: "Pending", Ready: "0/1", ContainerInfo: []ContainerInfo{{State: "Waiting", Reason: "ContainerCreating"}}},
+			health: TopologyDegraded,
+			detail: "Pending 0/1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := podTopologyNode(tt.pod, "Deployment/web")
+			if node.Health != tt.health || node.Detail != tt.detail || node.Owner != "Deployment/web" {
+				t.Errorf("Expected %s %q, got %+v", tt.health, tt.detail, node)
+			}
+		})
+	}
+}
+
+func TestServiceTopologyNode(t *testing.T) {
+	svc := ServiceInfo{ResourceInfo: ResourceInfo{Name: "web"}, Type: "ClusterIP", Ports: []string{"http:8080"}, Selector: "app=web"}
+	pods := []TopologyNode{{Health: TopologyHealthy}, {Health: TopologyFailed}}
+
+	if node := serviceTopologyNode(svc, pods); node.Health != TopologyDegraded || node.Detail != "ClusterIP · http:8080 · 1/2 pods ready" {
+		t.Errorf("Expected one of two pods ready, got %+v", node)
+	}
+	if node := serviceTopologyNode(svc, nil); node.Health != TopologyFailed || node.Detail != "ClusterIP · http:8080 · no endpoints" {
+		t.Errorf("Expected a service without pods to fail, got %+v", node)
+	}
+
+	svc.Selector = ""
+	if node := serviceTopologyNode(svc, nil); node.Health != "" {
+		t.Errorf("Expected an unknown health without a selector, got %+v", node)
+	}
+}
+
+func TestEntryTopologyNodes(t *testing.T) {
+	ingresses := []IngressInfo{
+		{ResourceInfo: ResourceInfo{Name: "shop"}, Rules: []IngressPath{{Host: "shop.example.com", Path: "/api", Backend: "web:http"}, {Host: "shop.example.com", Backend: "static:80"}}, Addresses: []string{"10.0.0.1"}},
+		{ResourceInfo: ResourceInfo{Name: "fallback"}, DefaultBackend: "web:8080"},
+		{ResourceInfo: ResourceInfo{Name: "other"}, Rules: []IngressPath{{Host: "other.example.com", Backend: "api:80"}}},
+	}
+	nodes := IngressTopologyNodes(ingresses, "web")
+	if len(nodes) != 2 || nodes[0].Name != "fallback" || nodes[1].Name != "shop" {
+		t.Fatalf("Expected the two ingresses routing to web, got %+v", nodes)
+	}
+	if nodes[0].Health != TopologyDegraded || nodes[0].Detail != "no address" {
+		t.Errorf("Expected an ingress without address to be degraded, got %+v", nodes[0])
+	}
+	if nodes[1].Health != TopologyHealthy || nodes[1].Detail != "shop.example.com/api" {
+		t.Errorf("Expected only the rule routing to web, got %+v", nodes[1])
+	}
+
+	routes := []RouteInfo{
+		{ResourceInfo: ResourceInfo{Name: "web"}, Host: "web.apps.example.com", Service: RouteTargetRef{Kind: "Service", Name: "web"}, Admissions: []RouteAdmission{{Admitted: "True"}}},
+		{ResourceInfo: ResourceInfo{Name: "web-internal"}, Host: "web.internal.example.com", Service: RouteTargetRef{Kind: "Service", Name: "web"}, Admissions: []RouteAdmission{{Admitted: "False"}}},
+		{ResourceInfo: ResourceInfo{Name: "api"}, Host: "api.apps.example.com", Service: RouteTargetRef{Kind: "Service", Name: "api"}},
+	}
+	nodes = RouteTopologyNodes(routes, "web")
+	if len(nodes) != 2 || nodes[0].Health != TopologyHealthy || nodes[1].Health != TopologyFailed {
+		t.Errorf("Expected an admitted and a rejected route, got %+v", nodes)
+	}
+}
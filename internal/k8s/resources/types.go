@@ -19,25 +19,56 @@ type ResourceInfo struct {
 // PodInfo represents simplified Pod information
 type PodInfo struct {
 	ResourceInfo
-	Phase         string          `json:"phase"`
-	Ready         string          `json:"ready"` // "1/1", "0/1", etc.
-	Restarts      int32           `json:"restarts"`
-	Age           string          `json:"age"`
-	Node          string          `json:"node"`
-	IP            string          `json:"ip"`
-	ContainerInfo []ContainerInfo `json:"containers"`
+	Phase          string          `json:"phase"`
+	Ready          string          `json:"ready"` // "1/1", "0/1", etc.
+	Restarts       int32           `json:"restarts"`
+	Age            string          `json:"age"`
+	Node           string          `json:"node"`
+	IP             string          `json:"ip"`
+	ContainerInfo  []ContainerInfo `json:"containers"`
+	InitContainers []ContainerInfo `json:"initContainers,omitempty"`
 }
 
 // ContainerInfo represents container information within a pod
 type ContainerInfo struct {
-	Name         string          `json:"name"`
-	Image        string          `json:"image"`
-	Ready        bool            `json:"ready"`
-	State        string          `json:"state"` // Running, Waiting, Terminated
-	Reason       string          `json:"reason,omitempty"`
-	RestartCount int32           `json:"restartCount"`
-	Ports        []ContainerPort `json:"ports,omitempty"`
-	Env          []EnvVar        `json:"env,omitempty"`
+	Name            string             `json:"name"`
+	Image           string             `json:"image"`
+	Ready           bool               `json:"ready"`
+	State           string             `json:"state"` // Running, Waiting, Terminated
+	Reason          string             `json:"reason,omitempty"`
+	LastTermination string             `json:"lastTermination,omitempty"` // reason of the previous run, e.g. OOMKilled
+	RestartCount    int32              `json:"restartCount"`
+	Ports           []ContainerPort    `json:"ports,omitempty"`
+	Env             []EnvVar           `json:"env,omitempty"`
+	Resources       ContainerResources `json:"resources"`
+}
+
+// ContainerResources holds the requests and limits of a container. Zero means
+// not set.
+type ContainerResources struct {
+	CPURequest    int64 `json:"cpuRequest"`    // millicores
+	CPULimit      int64 `json:"cpuLimit"`      // millicores
+	MemoryRequest int64 `json:"memoryRequest"` // bytes
+	MemoryLimit   int64 `json:"memoryLimit"`   // bytes
+}
+
+// ContainerUsage is a CPU and memory sample of a running container from the
+// metrics API
+type ContainerUsage struct {
+	Namespace string    `json:"namespace"`
+	Pod       string    `json:"pod"`
+	Container string    `json:"container"`
+	CPU       int64     `json:"cpu"`    // millicores
+	Memory    int64     `json:"memory"` // bytes
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NodeUsage is a CPU and memory sample of a node from the metrics API
+type NodeUsage struct {
+	Name      string    `json:"name"`
+	CPU       int64     `json:"cpu"`    // millicores
+	Memory    int64     `json:"memory"` // bytes
+	Timestamp time.Time `json:"timestamp"`
 }
 
 // ContainerPort represents a port in a container
@@ -101,6 +132,274 @@ type DeploymentInfo struct {
 	Condition         string `json:"condition"`
 }
 
+// StatefulSetInfo represents simplified StatefulSet information
+type StatefulSetInfo struct {
+	ResourceInfo
+	Replicas        int32    `json:"replicas"`
+	ReadyReplicas   int32    `json:"readyReplicas"`
+	CurrentReplicas int32    `json:"currentReplicas"`
+	UpdatedReplicas int32    `json:"updatedReplicas"`
+	ServiceName     string   `json:"serviceName"`
+	UpdateStrategy  string   `json:"updateStrategy"`
+	Images          []string `json:"images"`
+	Age             string   `json:"age"`
+}
+
+// DaemonSetInfo represents simplified DaemonSet information
+type DaemonSetInfo struct {
+	ResourceInfo
+	Desired        int32             `json:"desired"`
+	Current        int32             `json:"current"`
+	Ready          int32             `json:"ready"`
+	UpToDate       int32             `json:"upToDate"`
+	Available      int32             `json:"available"`
+	NodeSelector   map[string]string `json:"nodeSelector,omitempty"`
+	UpdateStrategy string            `json:"updateStrategy"`
+	Images         []string          `json:"images"`
+	Age            string            `json:"age"`
+}
+
+// ReplicaSetInfo represents simplified ReplicaSet information
+type ReplicaSetInfo struct {
+	ResourceInfo
+	Replicas          int32    `json:"replicas"`
+	ReadyReplicas     int32    `json:"readyReplicas"`
+	AvailableReplicas int32    `json:"availableReplicas"`
+	Owner             string   `json:"owner,omitempty"` // e.g. Deployment/web
+	Images            []string `json:"images"`
+	Age               string   `json:"age"`
+}
+
+// JobInfo represents simplified Job information
+type JobInfo struct {
+	ResourceInfo
+	Completions int32    `json:"completions"`
+	Succeeded   int32    `json:"succeeded"`
+	Failed      int32    `json:"failed"`
+	Active      int32    `json:"active"`
+	Duration    string   `json:"duration,omitempty"`
+	CronJob     string   `json:"cronJob,omitempty"` // CronJob that created the Job
+	Images      []string `json:"images"`
+	Age         string   `json:"age"`
+}
+
+// CronJobInfo represents simplified CronJob information
+type CronJobInfo struct {
+	ResourceInfo
+	Schedule       string    `json:"schedule"`
+	TimeZone       string    `json:"timeZone,omitempty"`
+	Suspended      bool      `json:"suspended"`
+	ActiveJobs     int       `json:"activeJobs"`
+	LastSchedule   time.Time `json:"lastSchedule,omitempty"`
+	LastSuccessful time.Time `json:"lastSuccessful,omitempty"`
+	LastRun        string    `json:"lastRun,omitempty"` // age of the last schedule, e.g. 5m
+	Images         []string  `json:"images"`
+	Age            string    `json:"age"`
+}
+
+// NodeInfo represents simplified Node information. Nodes are cluster-scoped,
+// so Namespace is always empty.
+type NodeInfo struct {
+	ResourceInfo
+	Roles             []string `json:"roles"`
+	Unschedulable     bool     `json:"unschedulable"`
+	KubeletVersion    string   `json:"kubeletVersion"`
+	InternalIP        string   `json:"internalIP,omitempty"`
+	OSImage           string   `json:"osImage,omitempty"`
+	CPUAllocatable    string   `json:"cpuAllocatable"`
+	MemoryAllocatable string   `json:"memoryAllocatable"`
+	PodCapacity       int64    `json:"podCapacity"` // allocatable pods
+	PodCount          int      `json:"podCount"`    // non-terminated pods, -1 if they could not be listed
+	Taints            []string `json:"taints,omitempty"`
+	Age               string   `json:"age"`
+}
+
+// DrainPlan lists what draining a node would do with each of its pods
+type DrainPlan struct {
+	Node    string   `json:"node"`
+	Evict   []string `json:"evict"`   // namespace/name of pods that will be evicted
+	Skipped []string `json:"skipped"` // DaemonSet and mirror pods, which are left in place
+	Blocked []string `json:"blocked"` // pods without a controller, which would not be recreated
+	// LocalData lists pods with emptyDir volumes, whose data an eviction
+	// deletes; kubectl drain refuses them without --delete-emptydir-data
+	LocalData []string `json:"localData"`
+}
+
+// PersistentVolumeClaimInfo represents simplified PersistentVolumeClaim information
+type PersistentVolumeClaimInfo struct {
+	ResourceInfo
+	Phase        string   `json:"phase"`
+	Volume       string   `json:"volume,omitempty"`   // bound PersistentVolume
+	Capacity     string   `json:"capacity,omitempty"` // capacity of the bound volume
+	Requested    string   `json:"requested"`
+	AccessModes  []string `json:"accessModes"` // abbreviated, e.g. RWO
+	StorageClass string   `json:"storageClass,omitempty"`
+	VolumeMode   string   `json:"volumeMode,omitempty"`
+	Age          string   `json:"age"`
+}
+
+// PersistentVolumeInfo represents simplified PersistentVolume information.
+// PersistentVolumes are cluster-scoped, so Namespace is always empty.
+type PersistentVolumeInfo struct {
+	ResourceInfo
+	Phase         string   `json:"phase"`
+	Capacity      string   `json:"capacity"`
+	AccessModes   []string `json:"accessModes"`
+	ReclaimPolicy string   `json:"reclaimPolicy"`
+	StorageClass  string   `json:"storageClass,omitempty"`
+	Claim         string   `json:"claim,omitempty"` // namespace/name of the bound claim
+	Reason        string   `json:"reason,omitempty"`
+	Age           string   `json:"age"`
+}
+
+// StorageClassInfo represents simplified StorageClass information
+type StorageClassInfo struct {
+	ResourceInfo
+	Provisioner       string `json:"provisioner"`
+	ReclaimPolicy     string `json:"reclaimPolicy"`
+	VolumeBindingMode string `json:"volumeBindingMode"`
+	AllowExpansion    bool   `json:"allowExpansion"`
+	Default           bool   `json:"default"`
+	Age               string `json:"age"`
+}
+
+// IngressInfo represents simplified Ingress information
+type IngressInfo struct {
+	ResourceInfo
+	IngressClass   string        `json:"ingressClass,omitempty"`
+	Rules          []IngressPath `json:"rules"`
+	DefaultBackend string        `json:"defaultBackend,omitempty"`
+	TLS            []IngressTLS  `json:"tls,omitempty"`
+	Addresses      []string      `json:"addresses,omitempty"` // load balancer IPs or hostnames
+	Age            string        `json:"age"`
+}
+
+// IngressPath is one host and path of an Ingress and the backend serving it
+type IngressPath struct {
+	Host     string `json:"host,omitempty"` // empty matches every host
+	Path     string `json:"path,omitempty"`
+	PathType string `json:"pathType,omitempty"`
+	Backend  string `json:"backend"` // service:port or Kind/name
+}
+
+// IngressTLS lists hosts served with the certificate of a secret
+type IngressTLS struct {
+	Hosts      []string `json:"hosts"`
+	SecretName string   `json:"secretName,omitempty"`
+}
+
+// NetworkPolicyInfo represents simplified NetworkPolicy information
+type NetworkPolicyInfo struct {
+	ResourceInfo
+	PodSelector  string   `json:"podSelector"` // label selector of the pods the policy applies to
+	PolicyTypes  []string `json:"policyTypes"`
+	IngressRules []string `json:"ingressRules"` // one summary per rule, e.g. "from pods app=web; ports TCP/8080"
+	EgressRules  []string `json:"egressRules"`
+	Age          string   `json:"age"`
+}
+
+// ImageUsage is a container image running in the cluster and the workloads
+// using it
+type ImageUsage struct {
+	Image      string   `json:"image"` // reference as written in the pod spec
+	Repository string   `json:"repository"`
+	Tag        string   `json:"tag,omitempty"`
+	Digest     string   `json:"digest,omitempty"` // digest the containers actually run, when reported
+	Pods       int      `json:"pods"`
+	Workloads  []string `json:"workloads"` // namespace/Kind/name
+}
+
+// ObjectCount is the number of objects of one resource type in a namespace
+type ObjectCount struct {
+	Namespace string `json:"namespace"`
+	Resource  string `json:"resource"`
+	Count     int    `json:"count"`
+	Cleanup   int    `json:"cleanup"` // finished or unused objects that usually can be deleted
+}
+
+// NamespaceObjects totals the object counts of a namespace
+type NamespaceObjects struct {
+	Namespace string        `json:"namespace"`
+	Total     int           `json:"total"`
+	Cleanup   int           `json:"cleanup"`
+	Counts    []ObjectCount `json:"counts"` // largest first
+}
+
+// DependentTree is an object and its dependents, the objects naming it as
+// an owner that the garbage collector deletes along with it
+type DependentTree struct {
+	Kind     string          `json:"kind"`
+	Name     string          `json:"name"`
+	Children []DependentTree `json:"children,omitempty"`
+}
+
+// Count returns the number of dependents in the tree, not counting its root
+func (t DependentTree) Count() int {
+	count := len(t.Children)
+	for _, child := range t.Children {
+		count += child.Count()
+	}
+	return count
+}
+
+// Health of a hop in a service topology
+const (
+	TopologyHealthy  = "Healthy"
+	TopologyDegraded = "Degraded"
+	TopologyFailed   = "Failed"
+)
+
+// ServiceTopology is the path traffic takes through a service: the routes
+// and ingresses exposing it, the pods it selects and the workloads running
+// those pods
+type ServiceTopology struct {
+	Entries   []TopologyNode `json:"entries"` // routes and ingresses
+	Service   TopologyNode   `json:"service"`
+	Pods      []TopologyNode `json:"pods"`
+	Workloads []TopologyNode `json:"workloads"`
+}
+
+// TopologyNode is one hop of a service topology
+type TopologyNode struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"` // host, ports or readiness
+	Health string `json:"health"`           // Healthy, Degraded, Failed or empty when unknown
+	Owner  string `json:"owner,omitempty"`  // Kind/name of the workload running a pod
+}
+
+// FieldOwnership describes who last changed the fields of an object: the
+// managers recorded in its managed fields and, for objects created with
+// 'kubectl apply', where the live object drifted from the applied manifest
+type FieldOwnership struct {
+	Managers    []FieldManager `json:"managers"`
+	LastApplied bool           `json:"lastApplied"` // the last-applied-configuration annotation is set
+	Drift       []AppliedDrift `json:"drift,omitempty"`
+}
+
+// FieldManager is a manager of some fields of an object
+type FieldManager struct {
+	Manager     string   `json:"manager"`
+	Operation   string   `json:"operation"` // Apply or Update
+	Subresource string   `json:"subresource,omitempty"`
+	Age         string   `json:"age,omitempty"` // since the manager last changed its fields
+	Fields      []string `json:"fields"`
+}
+
+// AppliedDrift is a field whose live value differs from the last applied one
+type AppliedDrift struct {
+	Path    string `json:"path"`
+	Applied string `json:"applied"`
+	Live    string `json:"live"`
+}
+
+// StoredObjectCount is the number of objects of a resource the API server
+// keeps in etcd
+type StoredObjectCount struct {
+	Resource string `json:"resource"` // resource.group, or resource for the core group
+	Count    int64  `json:"count"`
+}
+
 // NamespaceInfo represents simplified Namespace information
 type NamespaceInfo struct {
 	ResourceInfo
@@ -140,6 +439,8 @@ type ListOptions struct {
 	Limit         int64  `json:"limit,omitempty"`
 	Continue      string `json:"continue,omitempty"`
 	Watch         bool   `json:"watch,omitempty"`
+	// AllNamespaces lists in every namespace, Namespace is ignored
+	AllNamespaces bool `json:"allNamespaces,omitempty"`
 }
 
 // NamespaceContext represents current namespace context
@@ -261,6 +562,7 @@ type BuildInfo struct {
 	BuildConfig    string     `json:"buildConfig"`           // Parent BuildConfig name
 	Strategy       string     `json:"strategy"`              // Build strategy used
 	OutputImage    string     `json:"outputImage,omitempty"` // Resulting image
+	Commit         string     `json:"commit,omitempty"`      // Source commit being built
 	Age            string     `json:"age"`
 }
 
@@ -308,6 +610,8 @@ type DeploymentConfigInfo struct {
 	Strategy          DeploymentStrategy    `json:"strategy"`
 	Triggers          []DeploymentTrigger   `json:"triggers"`
 	Conditions        []DeploymentCondition `json:"conditions"`
+	Images            []string              `json:"images"`
+	Paused            bool                  `json:"paused"`
 	Age               string                `json:"age"`
 }
 
@@ -343,6 +647,7 @@ type DeploymentTriggerImageChange struct {
 	From               *ImageStreamReference `json:"from,omitempty"`
 	LastTriggeredImage string                `json:"lastTriggeredImage,omitempty"`
 	ContainerNames     []string              `json:"containerNames,omitempty"`
+	Automatic          bool                  `json:"automatic"`
 }
 
 // ImageStreamReference represents a reference to an ImageStream
@@ -352,6 +657,23 @@ type ImageStreamReference struct {
 	Name      string `json:"name"`
 }
 
+// ImageStreamTagRef identifies a tag of an ImageStream
+type ImageStreamTagRef struct {
+	Namespace string `json:"namespace"`
+	Stream    string `json:"stream"`
+	Tag       string `json:"tag"`
+}
+
+// DanglingImageReference is a BuildConfig, Deployment or DeploymentConfig
+// referencing an ImageStreamTag that does not exist
+type DanglingImageReference struct {
+	Kind      string            `json:"kind"`
+	Namespace string            `json:"namespace"`
+	Name      string            `json:"name"`
+	Ref       ImageStreamTagRef `json:"ref"`
+	Reason    string            `json:"reason"` // e.g. "imagestream shop/app not found"
+}
+
 // DeploymentCondition represents a deployment condition
 type DeploymentCondition struct {
 	Type               string    `json:"type"`
@@ -372,9 +694,21 @@ type RouteInfo struct {
 	TLS                *TLSConfig       `json:"tls,omitempty"`
 	WildcardPolicy     string           `json:"wildcardPolicy,omitempty"`
 	AdmittedConditions []RouteCondition `json:"admittedConditions"`
+	Admissions         []RouteAdmission `json:"admissions"` // one per router shard that considered the route
 	Age                string           `json:"age"`
 }
 
+// RouteAdmission is the decision of one router (IngressController shard)
+// about a route
+type RouteAdmission struct {
+	RouterName        string `json:"routerName"`
+	CanonicalHostname string `json:"canonicalHostname,omitempty"`
+	Host              string `json:"host"`
+	Admitted          string `json:"admitted"` // True, False or Unknown
+	Reason            string `json:"reason,omitempty"`
+	Message           string `json:"message,omitempty"`
+}
+
 // RouteTargetRef represents a route target reference
 type RouteTargetRef struct {
 	Kind   string `json:"kind"`
@@ -501,3 +835,128 @@ type SubscriptionCondition struct {
 	Reason             string    `json:"reason,omitempty"`
 	Message            string    `json:"message,omitempty"`
 }
+
+// ComponentHealthInfo represents the health of a single control-plane component
+type ComponentHealthInfo struct {
+	Name    string `json:"name"`
+	Source  string `json:"source"` // readyz, componentstatus, clusteroperator
+	Healthy bool   `json:"healthy"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterOperatorInfo represents simplified OpenShift ClusterOperator information
+type ClusterOperatorInfo struct {
+	ResourceInfo
+	Version     string              `json:"version"`
+	Available   string              `json:"available"`
+	Progressing string              `json:"progressing"`
+	Degraded    string              `json:"degraded"`
+	Message     string              `json:"message,omitempty"`
+	Conditions  []OperatorCondition `json:"conditions"`
+	Age         string              `json:"age"`
+}
+
+// ClusterDetails describes the connected cluster for the cluster info view.
+// The OpenShift fields come from the cluster-scoped config.openshift.io resources.
+type ClusterDetails struct {
+	APIURL               string                 `json:"apiURL"`
+	OpenShift            bool                   `json:"openShift"`
+	KubernetesVersion    string                 `json:"kubernetesVersion,omitempty"`
+	OpenShiftVersion     string                 `json:"openShiftVersion,omitempty"`
+	VersionEstimated     bool                   `json:"versionEstimated,omitempty"` // Only the OpenShift minor version, derived from the Kubernetes version
+	Channel              string                 `json:"channel,omitempty"`
+	ConsoleURL           string                 `json:"consoleURL,omitempty"`
+	Platform             string                 `json:"platform,omitempty"`
+	InfrastructureName   string                 `json:"infrastructureName,omitempty"`
+	ControlPlaneTopology string                 `json:"controlPlaneTopology,omitempty"`
+	IdentityProviders    []IdentityProviderInfo `json:"identityProviders,omitempty"`
+	Unavailable          []string               `json:"unavailable,omitempty"` // What could not be read, usually for lack of permissions
+}
+
+// IdentityProviderInfo is an identity provider of the OpenShift OAuth server
+type IdentityProviderInfo struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	MappingMethod string `json:"mappingMethod,omitempty"`
+}
+
+// MachineSetInfo represents simplified OpenShift MachineSet information
+type MachineSetInfo struct {
+	ResourceInfo
+	Replicas          int32  `json:"replicas"`
+	CurrentReplicas   int32  `json:"currentReplicas"`
+	ReadyReplicas     int32  `json:"readyReplicas"`
+	AvailableReplicas int32  `json:"availableReplicas"`
+	Role              string `json:"role,omitempty"`
+	AutoscaleMin      string `json:"autoscaleMin,omitempty"` // Set when a MachineAutoscaler manages the set
+	AutoscaleMax      string `json:"autoscaleMax,omitempty"`
+	ErrorMessage      string `json:"errorMessage,omitempty"`
+	Age               string `json:"age"`
+}
+
+// MachineInfo represents simplified OpenShift Machine information
+type MachineInfo struct {
+	ResourceInfo
+	Phase        string `json:"phase"`
+	MachineSet   string `json:"machineSet,omitempty"`
+	NodeName     string `json:"nodeName,omitempty"`
+	InstanceType string `json:"instanceType,omitempty"`
+	Zone         string `json:"zone,omitempty"`
+	ProviderID   string `json:"providerID,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	Age          string `json:"age"`
+}
+
+// RolloutStatus represents the progress of a Deployment rollout
+type RolloutStatus struct {
+	Deployment    string `json:"deployment"`
+	Revision      string `json:"revision"`
+	Progressing   string `json:"progressing"`
+	Available     string `json:"available"`
+	Message       string `json:"message,omitempty"`
+	NewReplicaSet string `json:"newReplicaSet,omitempty"`
+	NewReplicas   int32  `json:"newReplicas"`
+	NewReady      int32  `json:"newReady"`
+	OldReplicas   int32  `json:"oldReplicas"`
+	Desired       int32  `json:"desired"`
+	Complete      bool   `json:"complete"`
+}
+
+// EventInfo represents simplified Event information
+type EventInfo struct {
+	ResourceInfo
+	Type         string    `json:"type"` // Normal or Warning
+	Reason       string    `json:"reason"`
+	Message      string    `json:"message"`
+	InvolvedKind string    `json:"involvedKind"`
+	InvolvedName string    `json:"involvedName"`
+	Source       string    `json:"source,omitempty"`
+	Count        int32     `json:"count"`
+	LastSeen     time.Time `json:"lastSeen"`
+	Age          string    `json:"age"`
+}
+
+// ScalingActivity summarizes the recent activity of the cluster autoscaler
+type ScalingActivity struct {
+	WaitingPods   []ScalingPod `json:"waitingPods"` // Pods that triggered a scale up
+	BlockedPods   []ScalingPod `json:"blockedPods"` // Pending pods no scale up can help
+	LastScaleUp   *EventInfo   `json:"lastScaleUp,omitempty"`
+	LastScaleDown *EventInfo   `json:"lastScaleDown,omitempty"`
+	Failures      []EventInfo  `json:"failures"`
+}
+
+// ScalingPod is a pending pod the cluster autoscaler reported on
+type ScalingPod struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	Message   string    `json:"message"`
+	LastSeen  time.Time `json:"lastSeen"`
+}
+
+// UserIdentity describes the identity that API requests are authenticated as
+type UserIdentity struct {
+	Username string   `json:"username"`
+	Groups   []string `json:"groups,omitempty"`
+	Source   string   `json:"source"` // How the identity was determined
+}
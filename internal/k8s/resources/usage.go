@@ -0,0 +1,137 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// podMetricsList is the subset of the metrics.k8s.io PodMetricsList used here
+type podMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+		Timestamp  time.Time `json:"timestamp"`
+		Containers []struct {
+			Name  string            `json:"name"`
+			Usage map[string]string `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// nodeMetricsList is the subset of the metrics.k8s.io NodeMetricsList used here
+type nodeMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Timestamp time.Time         `json:"timestamp"`
+		Usage     map[string]string `json:"usage"`
+	} `json:"items"`
+}
+
+// ListPodUsage returns the current CPU and memory usage of every container
+// in a namespace from the resource metrics API. It fails when metrics-server
+// is not installed.
+func (c *K8sResourceClient) ListPodUsage(ctx context.Context, namespace string) ([]ContainerUsage, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	body, err := c.clientset.Discovery().RESTClient().Get().
+		AbsPath(constants.MetricsAPIPath, "namespaces", namespace, "pods").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod metrics: %w", err)
+	}
+
+	return parsePodMetrics(body)
+}
+
+// parsePodMetrics converts a PodMetricsList response into usage samples
+func parsePodMetrics(body []byte) ([]ContainerUsage, error) {
+	var list podMetricsList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode pod metrics: %w", err)
+	}
+
+	var usage []ContainerUsage
+	for _, item := range list.Items {
+		for _, container := range item.Containers {
+			sample := ContainerUsage{
+				Namespace: item.Metadata.Namespace,
+				Pod:       item.Metadata.Name,
+				Container: container.Name,
+				Timestamp: item.Timestamp,
+			}
+			if cpu, err := resource.ParseQuantity(container.Usage["cpu"]); err == nil {
+				sample.CPU = cpu.MilliValue()
+			}
+			if memory, err := resource.ParseQuantity(container.Usage["memory"]); err == nil {
+				sample.Memory = memory.Value()
+			}
+			usage = append(usage, sample)
+		}
+	}
+	return usage, nil
+}
+
+// ListNodeUsage returns the current CPU and memory usage of every node from
+// the resource metrics API. It fails when metrics-server is not installed.
+func (c *K8sResourceClient) ListNodeUsage(ctx context.Context) ([]NodeUsage, error) {
+	body, err := c.clientset.Discovery().RESTClient().Get().
+		AbsPath(constants.MetricsAPIPath, "nodes").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node metrics: %w", err)
+	}
+
+	return parseNodeMetrics(body)
+}
+
+// parseNodeMetrics converts a NodeMetricsList response into usage samples
+func parseNodeMetrics(body []byte) ([]NodeUsage, error) {
+	var list nodeMetricsList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, fmt.Errorf("failed to decode node metrics: %w", err)
+	}
+
+	usage := make([]NodeUsage, 0, len(list.Items))
+	for _, item := range list.Items {
+		sample := NodeUsage{Name: item.Metadata.Name, Timestamp: item.Timestamp}
+		if cpu, err := resource.ParseQuantity(item.Usage["cpu"]); err == nil {
+			sample.CPU = cpu.MilliValue()
+		}
+		if memory, err := resource.ParseQuantity(item.Usage["memory"]); err == nil {
+			sample.Memory = memory.Value()
+		}
+		usage = append(usage, sample)
+	}
+	return usage, nil
+}
+
+// containerResources extracts the requests and limits of a container
+func containerResources(reqs corev1.ResourceRequirements) ContainerResources {
+	var res ContainerResources
+	if cpu, ok := reqs.Requests[corev1.ResourceCPU]; ok {
+		res.CPURequest = cpu.MilliValue()
+	}
+	if cpu, ok := reqs.Limits[corev1.ResourceCPU]; ok {
+		res.CPULimit = cpu.MilliValue()
+	}
+	if memory, ok := reqs.Requests[corev1.ResourceMemory]; ok {
+		res.MemoryRequest = memory.Value()
+	}
+	if memory, ok := reqs.Limits[corev1.ResourceMemory]; ok {
+		res.MemoryLimit = memory.Value()
+	}
+	return res
+}
@@ -0,0 +1,258 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// workloadListOptions resolves the namespace and list options shared by the
+// StatefulSet, DaemonSet and ReplicaSet listings
+func (c *K8sResourceClient) workloadListOptions(opts ListOptions) (string, metav1.ListOptions) {
+	namespace := c.listNamespace(opts)
+
+	listOpts := metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+		Limit:         opts.Limit,
+		Continue:      opts.Continue,
+	}
+	if listOpts.Limit == 0 {
+		listOpts.Limit = c.defaultLimit
+	}
+
+	return namespace, listOpts
+}
+
+// remainingItems returns the remaining item count of a paged list
+func remainingItems(count *int64) int64 {
+	if count != nil {
+		return *count
+	}
+	return 0
+}
+
+// ListStatefulSets lists statefulsets in the specified namespace
+func (c *K8sResourceClient) ListStatefulSets(ctx context.Context, opts ListOptions) (*ResourceList[StatefulSetInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.AppsV1().StatefulSets(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+
+	items := make([]StatefulSetInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertStatefulSet(&list.Items[i])
+	}
+
+	return &ResourceList[StatefulSetInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// GetStatefulSet gets a specific statefulset
+func (c *K8sResourceClient) GetStatefulSet(ctx context.Context, namespace, name string) (*StatefulSetInfo, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	sts, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statefulset %s/%s: %w", namespace, name, err)
+	}
+
+	info := convertStatefulSet(sts)
+	return &info, nil
+}
+
+// ListDaemonSets lists daemonsets in the specified namespace
+func (c *K8sResourceClient) ListDaemonSets(ctx context.Context, opts ListOptions) (*ResourceList[DaemonSetInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.AppsV1().DaemonSets(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets: %w", err)
+	}
+
+	items := make([]DaemonSetInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertDaemonSet(&list.Items[i])
+	}
+
+	return &ResourceList[DaemonSetInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// GetDaemonSet gets a specific daemonset
+func (c *K8sResourceClient) GetDaemonSet(ctx context.Context, namespace, name string) (*DaemonSetInfo, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	ds, err := c.clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daemonset %s/%s: %w", namespace, name, err)
+	}
+
+	info := convertDaemonSet(ds)
+	return &info, nil
+}
+
+// ListReplicaSets lists replicasets in the specified namespace
+func (c *K8sResourceClient) ListReplicaSets(ctx context.Context, opts ListOptions) (*ResourceList[ReplicaSetInfo], error) {
+	namespace, listOpts := c.workloadListOptions(opts)
+
+	list, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replicasets: %w", err)
+	}
+
+	items := make([]ReplicaSetInfo, len(list.Items))
+	for i := range list.Items {
+		items[i] = convertReplicaSet(&list.Items[i])
+	}
+
+	return &ResourceList[ReplicaSetInfo]{
+		Items:     items,
+		Total:     len(items),
+		Namespace: namespace,
+		Continue:  list.Continue,
+		Remaining: remainingItems(list.RemainingItemCount),
+	}, nil
+}
+
+// GetReplicaSet gets a specific replicaset
+func (c *K8sResourceClient) GetReplicaSet(ctx context.Context, namespace, name string) (*ReplicaSetInfo, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	rs, err := c.clientset.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replicaset %s/%s: %w", namespace, name, err)
+	}
+
+	info := convertReplicaSet(rs)
+	return &info, nil
+}
+
+// workloadResourceInfo builds the common metadata of a workload
+func workloadResourceInfo(meta metav1.ObjectMeta, kind, status string) ResourceInfo {
+	return ResourceInfo{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Kind:        kind,
+		APIVersion:  "apps/v1",
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+		CreatedAt:   meta.CreationTimestamp.Time,
+		Status:      status,
+	}
+}
+
+// templateImages returns the container images of a pod template
+func templateImages(template corev1.PodTemplateSpec) []string {
+	images := make([]string, 0, len(template.Spec.Containers))
+	for _, container := range template.Spec.Containers {
+		images = append(images, container.Image)
+	}
+	return images
+}
+
+// replicaStatus summarizes how many of the desired replicas are ready
+func replicaStatus(desired, ready int32) string {
+	switch {
+	case desired == 0:
+		return "Scaled Down"
+	case ready >= desired:
+		return "Ready"
+	default:
+		return "Progressing"
+	}
+}
+
+func convertStatefulSet(sts *appsv1.StatefulSet) StatefulSetInfo {
+	replicas := int32(0)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+
+	strategy := string(sts.Spec.UpdateStrategy.Type)
+	if strategy == "" {
+		strategy = string(appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+
+	return StatefulSetInfo{
+		ResourceInfo:    workloadResourceInfo(sts.ObjectMeta, "StatefulSet", replicaStatus(replicas, sts.Status.ReadyReplicas)),
+		Replicas:        replicas,
+		ReadyReplicas:   sts.Status.ReadyReplicas,
+		CurrentReplicas: sts.Status.CurrentReplicas,
+		UpdatedReplicas: sts.Status.UpdatedReplicas,
+		ServiceName:     sts.Spec.ServiceName,
+		UpdateStrategy:  strategy,
+		Images:          templateImages(sts.Spec.Template),
+		Age:             formatAge(sts.CreationTimestamp.Time),
+	}
+}
+
+func convertDaemonSet(ds *appsv1.DaemonSet) DaemonSetInfo {
+	strategy := string(ds.Spec.UpdateStrategy.Type)
+	if strategy == "" {
+		strategy = string(appsv1.RollingUpdateDaemonSetStrategyType)
+	}
+
+	desired := ds.Status.DesiredNumberScheduled
+	status := replicaStatus(desired, ds.Status.NumberReady)
+	if desired == 0 {
+		// A DaemonSet with no matching nodes is not scaled down
+		status = "No Nodes"
+	}
+
+	return DaemonSetInfo{
+		ResourceInfo:   workloadResourceInfo(ds.ObjectMeta, "DaemonSet", status),
+		Desired:        desired,
+		Current:        ds.Status.CurrentNumberScheduled,
+		Ready:          ds.Status.NumberReady,
+		UpToDate:       ds.Status.UpdatedNumberScheduled,
+		Available:      ds.Status.NumberAvailable,
+		NodeSelector:   ds.Spec.Template.Spec.NodeSelector,
+		UpdateStrategy: strategy,
+		Images:         templateImages(ds.Spec.Template),
+		Age:            formatAge(ds.CreationTimestamp.Time),
+	}
+}
+
+func convertReplicaSet(rs *appsv1.ReplicaSet) ReplicaSetInfo {
+	replicas := int32(0)
+	if rs.Spec.Replicas != nil {
+		replicas = *rs.Spec.Replicas
+	}
+
+	owner := ""
+	if ref := metav1.GetControllerOf(rs); ref != nil {
+		owner = ref.Kind + "/" + ref.Name
+	}
+
+	return ReplicaSetInfo{
+		ResourceInfo:      workloadResourceInfo(rs.ObjectMeta, "ReplicaSet", replicaStatus(replicas, rs.Status.ReadyReplicas)),
+		Replicas:          replicas,
+		ReadyReplicas:     rs.Status.ReadyReplicas,
+		AvailableReplicas: rs.Status.AvailableReplicas,
+		Owner:             owner,
+		Images:            templateImages(rs.Spec.Template),
+		Age:               formatAge(rs.CreationTimestamp.Time),
+	}
+}
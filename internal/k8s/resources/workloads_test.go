@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func podTemplate(images ...string) corev1.PodTemplateSpec {
+	var containers []corev1.Container
+	for _, image := range images {
+		containers = append(containers, corev1.Container{Name: "c", Image: image})
+	}
+	return corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: containers}}
+}
+
+func TestConvertStatefulSet(t *testing.T) {
+	replicas := int32(3)
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "demo"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: "db-headless",
+			Template:    podTemplate("postgres:16"),
+		},
+		Status: appsv1.StatefulSetStatus{ReadyReplicas: 2, CurrentReplicas: 3, UpdatedReplicas: 3},
+	}
+
+	info := convertStatefulSet(sts)
+	if info.Kind != "StatefulSet" || info.Replicas != 3 || info.ReadyReplicas != 2 {
+		t.Errorf("Unexpected statefulset info %+v", info)
+	}
+	if info.Status != "Progressing" {
+		t.Errorf("Expected status Progressing with 2/3 ready, got %s", info.Status)
+	}
+	if info.UpdateStrategy != "RollingUpdate" || info.ServiceName != "db-headless" {
+		t.Errorf("Expected default strategy and service name, got %s and %s", info.UpdateStrategy, info.ServiceName)
+	}
+	if len(info.Images) != 1 || info.Images[0] != "postgres:16" {
+		t.Errorf("Expected image postgres:16, got %v", info.Images)
+	}
+}
+
+func TestConvertDaemonSet(t *testing.T) {
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent"},
+		Spec: appsv1.DaemonSetSpec{
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType},
+			Template:       podTemplate("agent:1"),
+		},
+		Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, CurrentNumberScheduled: 2, NumberReady: 2},
+	}
+
+	info := convertDaemonSet(ds)
+	if info.Status != "Ready" || info.UpdateStrategy != "OnDelete" {
+		t.Errorf("Expected ready OnDelete daemonset, got %s %s", info.Status, info.UpdateStrategy)
+	}
+
+	ds.Status = appsv1.DaemonSetStatus{}
+	if info := convertDaemonSet(ds); info.Status != "No Nodes" {
+		t.Errorf("Expected status No Nodes without scheduled pods, got %s", info.Status)
+	}
+}
+
+func TestConvertReplicaSet(t *testing.T) {
+	replicas := int32(0)
+	controller := true
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "web-7d9f",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "Deployment", Name: "web", Controller: &controller},
+			},
+		},
+		Spec: appsv1.ReplicaSetSpec{Replicas: &replicas},
+	}
+
+	info := convertReplicaSet(rs)
+	if info.Owner != "Deployment/web" {
+		t.Errorf("Expected owner Deployment/web, got %q", info.Owner)
+	}
+	if info.Status != "Scaled Down" {
+		t.Errorf("Expected status Scaled Down, got %s", info.Status)
+	}
+}
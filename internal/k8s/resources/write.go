@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// decodeManifest decodes a YAML manifest into obj and verifies that it still
+// identifies the resource being edited
+func decodeManifest(manifest []byte, obj metav1.Object, kind, namespace, name string) error {
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(manifest, &typeMeta); err != nil {
+		return fmt.Errorf("invalid YAML: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(manifest, obj); err != nil {
+		return fmt.Errorf("invalid %s manifest: %w", kind, err)
+	}
+
+	if typeMeta.Kind != "" && !strings.EqualFold(typeMeta.Kind, kind) {
+		return fmt.Errorf("kind cannot be changed (expected %s, got %s)", kindName(kind), typeMeta.Kind)
+	}
+	if obj.GetName() != name {
+		return fmt.Errorf("name cannot be changed (expected %s, got %s)", name, obj.GetName())
+	}
+	if obj.GetNamespace() != "" && obj.GetNamespace() != namespace {
+		return fmt.Errorf("namespace cannot be changed (expected %s, got %s)", namespace, obj.GetNamespace())
+	}
+	obj.SetNamespace(namespace)
+
+	return nil
+}
+
+// UpdateFromYAML replaces a resource with an edited manifest
+func (c *K8sResourceClient) UpdateFromYAML(ctx context.Context, kind, namespace, name string, manifest []byte) error {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	var err error
+	switch strings.ToLower(kind) {
+	case "pod":
+		obj := &corev1.Pod{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.clientset.CoreV1().Pods(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	case "service":
+		obj := &corev1.Service{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.clientset.CoreV1().Services(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	case "deployment":
+		obj := &appsv1.Deployment{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.clientset.AppsV1().Deployments(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	case "configmap":
+		obj := &corev1.ConfigMap{}
+		if err = decodeManifest(manifest, obj, kind, namespace, name); err != nil {
+			return err
+		}
+		_, err = c.clientset.CoreV1().ConfigMaps(namespace).Update(ctx, obj, metav1.UpdateOptions{DryRun: dryRunOption(ctx)})
+	default:
+		return fmt.Errorf("editing is not supported for kind %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return nil
+}
+
+// Patch applies a patch to a resource
+func (c *K8sResourceClient) Patch(ctx context.Context, kind, namespace, name string, patchType types.PatchType, data []byte) error {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	var err error
+	switch strings.ToLower(kind) {
+	case "pod":
+		_, err = c.clientset.CoreV1().Pods(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "service":
+		_, err = c.clientset.CoreV1().Services(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "deployment":
+		_, err = c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "configmap":
+		_, err = c.clientset.CoreV1().ConfigMaps(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "secret":
+		_, err = c.clientset.CoreV1().Secrets(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	case "cronjob":
+		_, err = c.clientset.BatchV1().CronJobs(namespace).Patch(ctx, name, patchType, data, metav1.PatchOptions{DryRun: dryRunOption(ctx)})
+	default:
+		return fmt.Errorf("patching is not supported for kind %s", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to patch %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDecodeManifest(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    string
+		expectedErr string
+	}{
+		{
+			name:     "valid edit",
+			manifest: "# comment\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\ndata:\n  key: value\n",
+		},
+		{
+			name:        "renamed resource",
+			manifest:    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: other\n",
+			expectedErr: "name cannot be changed",
+		},
+		{
+			name:        "changed kind",
+			manifest:    "apiVersion: v1\nkind: Secret\nmetadata:\n  name: app\n",
+			expectedErr: "kind cannot be changed",
+		},
+		{
+			name:        "moved namespace",
+			manifest:    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\n  namespace: prod\n",
+			expectedErr: "namespace cannot be changed",
+		},
+		{
+			name:        "unknown field",
+			manifest:    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: app\ndatta:\n  key: value\n",
+			expectedErr: "invalid configmap manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &corev1.ConfigMap{}
+			err := decodeManifest([]byte(tt.manifest), obj, "configmap", "default", "app")
+
+			if tt.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("decodeManifest() returned error: %v", err)
+				}
+				if obj.Namespace != "default" || obj.Data["key"] != "value" {
+					t.Errorf("Unexpected decoded object: %+v", obj)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
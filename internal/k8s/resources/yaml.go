@@ -0,0 +1,167 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// redactedValue replaces secret values in YAML output
+const redactedValue = "<redacted>"
+
+// GetYAML returns the full YAML manifest of a resource. Managed fields are
+// omitted and Secret values are redacted.
+func (c *K8sResourceClient) GetYAML(ctx context.Context, kind, namespace, name string) (string, error) {
+	if namespace == "" {
+		namespace = c.currentNamespace
+	}
+
+	var obj runtime.Object
+	var apiVersion string
+	var err error
+
+	switch strings.ToLower(kind) {
+	case "pod":
+		obj, err = c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "v1"
+	case "service":
+		obj, err = c.clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "v1"
+	case "deployment":
+		obj, err = c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "apps/v1"
+	case "statefulset":
+		obj, err = c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "apps/v1"
+	case "daemonset":
+		obj, err = c.clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "apps/v1"
+	case "replicaset":
+		obj, err = c.clientset.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "apps/v1"
+	case "job":
+		obj, err = c.clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "batch/v1"
+	case "cronjob":
+		obj, err = c.clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "batch/v1"
+	case "configmap":
+		obj, err = c.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "v1"
+	case "secret":
+		secret, getErr := c.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if getErr == nil {
+			for key := range secret.Data {
+				secret.Data[key] = []byte(redactedValue)
+			}
+			for key := range secret.StringData {
+				secret.StringData[key] = redactedValue
+			}
+			delete(secret.Annotations, "kubectl.kubernetes.io/last-applied-configuration")
+		}
+		obj, err = secret, getErr
+		apiVersion = "v1"
+	case "event":
+		obj, err = c.clientset.CoreV1().Events(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "v1"
+	case "node":
+		obj, err = c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "v1"
+	case "persistentvolumeclaim":
+		obj, err = c.clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "v1"
+	case "ingress":
+		obj, err = c.clientset.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "networking.k8s.io/v1"
+	case "networkpolicy":
+		obj, err = c.clientset.NetworkingV1().NetworkPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+		apiVersion = "networking.k8s.io/v1"
+	default:
+		return "", fmt.Errorf("YAML view not supported for kind %s", kind)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s %s/%s: %w", kind, namespace, name, err)
+	}
+
+	return marshalObjectYAML(obj, apiVersion, kindName(kind))
+}
+
+// marshalObjectYAML renders an object as YAML, restoring the type metadata that
+// typed clients strip and dropping managed fields
+func marshalObjectYAML(obj runtime.Object, apiVersion, kind string) (string, error) {
+	obj = obj.DeepCopyObject()
+	obj.GetObjectKind().SetGroupVersionKind(schemaGVK(apiVersion, kind))
+
+	if accessor, ok := obj.(metav1.Object); ok {
+		accessor.SetManagedFields(nil)
+	}
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s as YAML: %w", kind, err)
+	}
+
+	return string(data), nil
+}
+
+// kindName normalizes a lower-case kind to its canonical form
+func kindName(kind string) string {
+	switch strings.ToLower(kind) {
+	case "pod":
+		return "Pod"
+	case "service":
+		return "Service"
+	case "deployment":
+		return "Deployment"
+	case "statefulset":
+		return "StatefulSet"
+	case "daemonset":
+		return "DaemonSet"
+	case "replicaset":
+		return "ReplicaSet"
+	case "job":
+		return "Job"
+	case "cronjob":
+		return "CronJob"
+	case "configmap":
+		return "ConfigMap"
+	case "secret":
+		return "Secret"
+	case "event":
+		return "Event"
+	case "node":
+		return "Node"
+	case "persistentvolumeclaim":
+		return "PersistentVolumeClaim"
+	case "ingress":
+		return "Ingress"
+	case "networkpolicy":
+		return "NetworkPolicy"
+	case "buildconfig":
+		return "BuildConfig"
+	case "imagestream":
+		return "ImageStream"
+	case "route":
+		return "Route"
+	case "build":
+		return "Build"
+	case "deploymentconfig":
+		return "DeploymentConfig"
+	default:
+		return kind
+	}
+}
+
+// schemaGVK builds a GroupVersionKind from an apiVersion string
+func schemaGVK(apiVersion, kind string) schema.GroupVersionKind {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionKind{Kind: kind}
+	}
+	return gv.WithKind(kind)
+}
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMarshalObjectYAML(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "web-1",
+			Namespace:     "default",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+	}
+
+	out, err := marshalObjectYAML(pod, "v1", "Pod")
+	if err != nil {
+		t.Fatalf("marshalObjectYAML() returned error: %v", err)
+	}
+
+	for _, expected := range []string{"apiVersion: v1", "kind: Pod", "name: web-1"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, out)
+		}
+	}
+	if strings.Contains(out, "managedFields") {
+		t.Errorf("Expected managedFields to be omitted, got:\n%s", out)
+	}
+	if len(pod.ManagedFields) != 1 {
+		t.Errorf("Expected source object to be left unmodified")
+	}
+}
@@ -0,0 +1,167 @@
+// Package testenv connects integration tests to a real API server, such as a
+// kind cluster or the control plane started by envtest, and gives each test a
+// namespace of its own that is deleted when the test ends.
+//
+// Integration tests are built with the "integration" tag and skip themselves
+// unless LAZYOC_TEST_KUBECONFIG points at the cluster's kubeconfig:
+//
+//	kind create cluster --name lazyoc-it
+//	kind get kubeconfig --name lazyoc-it > /tmp/lazyoc-it.kubeconfig
+//	LAZYOC_TEST_KUBECONFIG=/tmp/lazyoc-it.kubeconfig go test -tags integration ./...
+//
+// envtest has no kubelet, so pods are created but never run there; tests that
+// need running pods should use kind.
+package testenv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubeconfigEnv names the environment variable holding the test cluster's kubeconfig
+const KubeconfigEnv = "LAZYOC_TEST_KUBECONFIG"
+
+// DefaultTimeout bounds waits on the test cluster
+const DefaultTimeout = 2 * time.Minute
+
+// PauseImage is a minimal image for pods that only need to exist and run
+const PauseImage = "registry.k8s.io/pause:3.10"
+
+// createdByLabel marks namespaces created by the harness so leftovers of
+// aborted runs can be cleaned up with a label selector
+const createdByLabel = "app.kubernetes.io/created-by"
+
+// Env is a connection to the test cluster scoped to a fresh namespace
+type Env struct {
+	Config    *rest.Config
+	Clientset *kubernetes.Clientset
+	Namespace string
+
+	kubeconfigPath string
+}
+
+// Start connects to the cluster named by LAZYOC_TEST_KUBECONFIG and creates a
+// namespace for the test. The test is skipped when the variable is not set.
+func Start(t testing.TB) *Env {
+	t.Helper()
+
+	path := os.Getenv(KubeconfigEnv)
+	if path == "" {
+		t.Skipf("%s is not set; point it at a kind or envtest kubeconfig to run integration tests", KubeconfigEnv)
+	}
+
+	config, err := clientcmd.BuildConfigFromFlags("", path)
+	if err != nil {
+		t.Fatalf("Failed to load kubeconfig %s: %v", path, err)
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatalf("Failed to create clientset: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	namespace, err := clientset.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "lazyoc-it-",
+			Labels:       map[string]string{createdByLabel: "lazyoc-tests"},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create test namespace: %v", err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+		defer cancel()
+		if err := clientset.CoreV1().Namespaces().Delete(ctx, namespace.Name, metav1.DeleteOptions{}); err != nil {
+			t.Logf("Failed to delete test namespace %s: %v", namespace.Name, err)
+		}
+	})
+
+	return &Env{
+		Config:         config,
+		Clientset:      clientset,
+		Namespace:      namespace.Name,
+		kubeconfigPath: path,
+	}
+}
+
+// Kubeconfig writes a copy of the cluster's kubeconfig whose current context
+// uses the test namespace, for code that connects on its own such as the TUI
+func (e *Env) Kubeconfig(t testing.TB) string {
+	t.Helper()
+
+	raw, err := clientcmd.LoadFromFile(e.kubeconfigPath)
+	if err != nil {
+		t.Fatalf("Failed to load kubeconfig %s: %v", e.kubeconfigPath, err)
+	}
+	kubeContext, ok := raw.Contexts[raw.CurrentContext]
+	if !ok {
+		t.Fatalf("Kubeconfig %s has no current context", e.kubeconfigPath)
+	}
+	kubeContext.Namespace = e.Namespace
+
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := clientcmd.WriteToFile(*raw, path); err != nil {
+		t.Fatalf("Failed to write kubeconfig: %v", err)
+	}
+	return path
+}
+
+// CreatePod creates a single-container pod running PauseImage in the test namespace
+func (e *Env) CreatePod(t testing.TB, name string, labels map[string]string) *corev1.Pod {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	pod, err := e.Clientset.CoreV1().Pods(e.Namespace).Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main", Image: PauseImage}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create pod %s: %v", name, err)
+	}
+	return pod
+}
+
+// WaitFor polls condition until it reports true, failing the test on error
+// or after DefaultTimeout
+func (e *Env) WaitFor(t testing.TB, what string, condition func(ctx context.Context) (bool, error)) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	if err := wait.PollUntilContextCancel(ctx, time.Second, true, condition); err != nil {
+		t.Fatalf("Timed out waiting for %s: %v", what, err)
+	}
+}
+
+// WaitForPodRunning waits until the named pod is running. It needs a cluster
+// with nodes, such as kind.
+func (e *Env) WaitForPodRunning(t testing.TB, name string) {
+	t.Helper()
+
+	e.WaitFor(t, "pod "+name+" to run", func(ctx context.Context) (bool, error) {
+		pod, err := e.Clientset.CoreV1().Pods(e.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return pod.Status.Phase == corev1.PodRunning, nil
+	})
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// noAccessIcon marks the tabs of resources the user may not list
+const noAccessIcon = "🔒"
+
+// accessDenials remembers the resource types the user may not list in the
+// current scope, so their tabs are locked rather than asking the API again on
+// every refresh. Loaders record and look up denials concurrently.
+type accessDenials struct {
+	mu     sync.Mutex
+	denied map[string]error
+}
+
+// record remembers that listing resource was forbidden with err
+func (d *accessDenials) record(resource string, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.denied == nil {
+		d.denied = make(map[string]error)
+	}
+	d.denied[resource] = err
+}
+
+// lookup returns the error listing resource was forbidden with, nil when it
+// was not
+func (d *accessDenials) lookup(resource string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.denied[resource]
+}
+
+// forget lets the next load of resource ask the API again
+func (d *accessDenials) forget(resource string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.denied, resource)
+}
+
+// reset forgets every denial, e.g. after reconnecting or switching projects
+func (d *accessDenials) reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.denied = nil
+}
+
+// tabResource reports whether resource is listed by a tab, only those are
+// locked as other loads, e.g. of metrics, have their own fallbacks
+func tabResource(resource string) bool {
+	for _, name := range tabSummaryResources {
+		if name == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// recordAccessDenial remembers a forbidden list of a tab's resource
+func (t *TUI) recordAccessDenial(resource string, err error) bool {
+	if err == nil || !apierrors.IsForbidden(err) || !tabResource(resource) {
+		return false
+	}
+	t.accessDenials.record(resource, err)
+	return true
+}
+
+// tabNoAccess returns why the user may not list the resources of a tab, nil
+// when they may
+func (t *TUI) tabNoAccess(tab int) error {
+	if tab < 0 || tab >= len(tabSummaryResources) {
+		return nil
+	}
+	return t.accessDenials.lookup(tabSummaryResources[tab])
+}
+
+// renderNoAccess returns the content of a locked tab in place of its list
+func (t *TUI) renderNoAccess(tab int, err error) string {
+	scope := "in namespace " + t.namespace
+	switch {
+	case tab == int(models.TabNodes):
+		scope = "in this cluster"
+	case t.allNamespaces:
+		scope = "across all namespaces"
+	}
+	return fmt.Sprintf("%s %s\n\nNo access: your account may not list %s %s.\n\n%s\n\n"+
+		"The other tabs keep working. Press 'r' to check again once your permissions changed.",
+		noAccessIcon, constants.ResourceTabs[tab], tabSummaryResources[tab], scope,
+		t.statusStyle(statusFailed).Render(truncateString(err.Error(), 200)))
+}
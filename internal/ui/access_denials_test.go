@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestAccessDenials(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), namespace: "shop", width: 400}
+
+	calls := 0
+	forbidden := func(context.Context, resources.ListOptions) (*resources.ResourceList[resources.ServiceInfo], error) {
+		calls++
+		return nil, apierrors.NewForbidden(schema.GroupResource{Resource: "services"}, "", nil)
+	}
+	if _, err := loadAllPages(tui, "services", resources.ListOptions{}, forbidden); !apierrors.IsForbidden(err) {
+		t.Fatalf("Expected the forbidden error, got %v", err)
+	}
+	if _, err := loadAllPages(tui, "services", resources.ListOptions{}, forbidden); err == nil || calls != 1 {
+		t.Fatalf("Expected a denied resource not to be listed again, got %d calls", calls)
+	}
+
+	if label := tui.tabLabel(int(models.TabServices), false); label != noAccessIcon+" Services" {
+		t.Errorf("Expected the Services tab to be locked, got %q", label)
+	}
+	if label := tui.tabLabel(int(models.TabPods), false); strings.Contains(label, noAccessIcon) {
+		t.Errorf("Expected the Pods tab to stay open, got %q", label)
+	}
+	if cmd := tui.refreshTab(int(models.TabServices)); cmd != nil {
+		t.Error("Expected no refresh of a locked tab")
+	}
+	content := tui.renderNoAccess(int(models.TabServices), tui.tabNoAccess(int(models.TabServices)))
+	if !strings.Contains(content, "may not list services in namespace shop") {
+		t.Errorf("Expected the locked tab to explain the denial, got %q", content)
+	}
+
+	// Other failures and resources without a tab are asked for again
+	if tui.recordAccessDenial("pods", context.DeadlineExceeded) || tui.recordAccessDenial("metrics", apierrors.NewForbidden(schema.GroupResource{}, "", nil)) {
+		t.Error("Expected only forbidden lists of tab resources to be recorded")
+	}
+
+	// r on the locked tab asks again
+	tui.ActiveTab = models.TabServices
+	tui.refreshNow()
+	if tui.tabNoAccess(int(models.TabServices)) != nil {
+		t.Error("Expected r to unlock the tab until the next denial")
+	}
+
+	tui.recordAccessDenial("secrets", apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil))
+	tui.resetLoaderCircuits()
+	if tui.tabNoAccess(int(models.TabSecrets)) != nil {
+		t.Error("Expected reconnecting or switching projects to unlock every tab")
+	}
+}
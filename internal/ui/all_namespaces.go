@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// namespaceColumnWidth is the width of the NAMESPACE column shown in the
+// all namespaces view
+const namespaceColumnWidth = 20
+
+// listOptions returns the options for listing namespaced resources, either
+// in the current namespace or in every namespace
+func (t *TUI) listOptions() resources.ListOptions {
+	if t.allNamespaces {
+		return resources.ListOptions{AllNamespaces: true}
+	}
+	return resources.ListOptions{Namespace: t.namespace}
+}
+
+// toggleAllNamespaces switches the namespaced tabs between the current
+// namespace and every namespace, and reloads what they show
+func (t *TUI) toggleAllNamespaces() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+
+	t.allNamespaces = !t.allNamespaces
+	if t.allNamespaces {
+		t.logInfo(categoryProject, "Listing resources in all namespaces")
+	} else {
+		t.logInfo(categoryProject, "Listing resources in namespace %s", t.namespace)
+	}
+
+	// Listing across namespaces needs other permissions than one namespace
+	t.accessDenials.reset()
+	t.dropLoadedResources()
+	t.startTabSummaries()
+
+	cmds := []tea.Cmd{t.refreshTab(int(t.ActiveTab))}
+	if t.ActiveTab != models.TabPods {
+		cmds = append(cmds, t.loadPods())
+	}
+	return tea.Batch(cmds...)
+}
+
+// dropLoadedResources forgets the namespaced resources loaded for the
+// previous scope, so switching tabs reloads them
+func (t *TUI) dropLoadedResources() {
+	t.allServices, t.allDeployments, t.allConfigMaps, t.allSecrets = nil, nil, nil, nil
+	t.allBuildConfigs, t.allImageStreams, t.allRoutes, t.allBuilds, t.allEvents = nil, nil, nil, nil, nil
+	t.allStatefulSets, t.allDaemonSets, t.allReplicaSets, t.allJobs, t.allCronJobs = nil, nil, nil, nil, nil
+	t.allPVCs, t.allIngresses, t.allNetworkPolicies, t.allDeploymentConfigs = nil, nil, nil, nil
+}
+
+// namespaceHeader returns the NAMESPACE column title prepended to the
+// namespaced tables in the all namespaces view
+func (t *TUI) namespaceHeader() string {
+	if !t.allNamespaces {
+		return ""
+	}
+	return fmt.Sprintf("%-*s ", namespaceColumnWidth, "NAMESPACE")
+}
+
+// namespaceCell returns the NAMESPACE column of a table row
+func (t *TUI) namespaceCell(namespace string) string {
+	if !t.allNamespaces {
+		return ""
+	}
+	return fmt.Sprintf("%-*s ", namespaceColumnWidth, truncateString(namespace, namespaceColumnWidth))
+}
+
+// resourceNamespace returns the namespace actions on a listed resource run
+// in. In the all namespaces view it differs from the current namespace.
+func (t *TUI) resourceNamespace(namespace string) string {
+	if namespace == "" {
+		return t.namespace
+	}
+	return namespace
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestAllNamespacesToggle(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "demo"}
+	tui.ActiveTab = models.TabServices
+	tui.allServices = []resources.ServiceInfo{{ResourceInfo: resources.ResourceInfo{Name: "stale"}}}
+
+	if opts := tui.listOptions(); opts.Namespace != "demo" || opts.AllNamespaces {
+		t.Errorf("Expected listing in demo, got %+v", opts)
+	}
+
+	if cmd := tui.toggleAllNamespaces(); cmd == nil || !tui.allNamespaces {
+		t.Fatalf("Expected the toggle to enable all namespaces and reload")
+	}
+	if tui.allServices != nil {
+		t.Errorf("Expected services of the previous scope to be dropped")
+	}
+	if opts := tui.listOptions(); !opts.AllNamespaces {
+		t.Errorf("Expected listing in all namespaces, got %+v", opts)
+	}
+
+	tui.Update(messages.ServicesLoaded{Services: []resources.ServiceInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "demo"}, Type: "ClusterIP"},
+		{ResourceInfo: resources.ResourceInfo{Name: "api", Namespace: "payments"}, Type: "ClusterIP"},
+	}})
+	if !strings.Contains(tui.mainContent, "NAMESPACE") || !strings.Contains(tui.mainContent, "payments") {
+		t.Errorf("Expected a NAMESPACE column, got %q", tui.mainContent)
+	}
+
+	// Actions on a resource run in its own namespace
+	NewNavigator(tui).moveResourceSelection(1)
+	ref, ok := tui.selectedResource()
+	if !ok || ref.Namespace != "payments" || ref.Name != "api" {
+		t.Errorf("Expected payments/api as the YAML target, got %+v", ref)
+	}
+
+	tui.toggleAllNamespaces()
+	tui.Update(messages.ServicesLoaded{Services: []resources.ServiceInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "demo"}, Type: "ClusterIP"},
+	}})
+	if tui.allNamespaces || strings.Contains(tui.mainContent, "NAMESPACE") {
+		t.Errorf("Expected the NAMESPACE column to go away, got %q", tui.mainContent)
+	}
+}
@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// startAPILatencyTimer schedules the next API latency probe
+func (t *TUI) startAPILatencyTimer() tea.Cmd {
+	if t.safeMode {
+		return nil
+	}
+	return tea.Tick(constants.APILatencyProbeInterval, func(time.Time) tea.Msg {
+		return messages.APILatencyTick{}
+	})
+}
+
+// probeAPILatency measures the round-trip time of a lightweight API request
+func (t *TUI) probeAPILatency() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.APILatencyMeasured{Err: fmt.Errorf("not connected")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.ConnectionTestTimeout)
+		defer cancel()
+
+		latency, err := t.resourceClient.MeasureLatency(ctx)
+		return messages.APILatencyMeasured{Latency: latency, Err: err}
+	}
+}
+
+// handleAPILatencyMeasured records the latest probe result
+func (t *TUI) handleAPILatencyMeasured(msg messages.APILatencyMeasured) {
+	if msg.Err != nil {
+		t.apiLatency = 0
+		t.apiLatencyErr = true
+		return
+	}
+	t.apiLatency = msg.Latency
+	t.apiLatencyErr = false
+}
+
+// renderAPILatency returns the colored latency indicator for the status bar
+func (t *TUI) renderAPILatency() string {
+	if !t.connected {
+		return ""
+	}
+
+	if t.apiLatencyErr {
+		return t.statusStyle(statusFailed).Render(t.statusPrefix(statusFailed) + "api timeout")
+	}
+	if t.apiLatency == 0 {
+		return ""
+	}
+
+	level := apiLatencyLevel(t.apiLatency)
+	return t.statusStyle(level).Render(fmt.Sprintf("%sapi %dms", t.statusPrefix(level), t.apiLatency.Milliseconds()))
+}
+
+// apiLatencyLevel maps a latency to ok, warning or failed
+func apiLatencyLevel(latency time.Duration) statusLevel {
+	switch {
+	case latency >= constants.APILatencyCriticalThreshold:
+		return statusFailed
+	case latency >= constants.APILatencyWarnThreshold:
+		return statusWarn
+	default:
+		return statusOK
+	}
+}
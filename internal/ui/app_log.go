@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// appLogLevel is the severity of an app log entry
+type appLogLevel int
+
+const (
+	levelInfo appLogLevel = iota
+	levelSuccess
+	levelWarn
+	levelError
+)
+
+// String returns the fixed-width label shown in the app log
+func (l appLogLevel) String() string {
+	switch l {
+	case levelSuccess:
+		return "OK"
+	case levelWarn:
+		return "WARN"
+	case levelError:
+		return "ERROR"
+	default:
+		return "INFO"
+	}
+}
+
+// appLogCategory groups app log entries by the area of the application they come from
+type appLogCategory string
+
+const (
+	categoryConnection appLogCategory = "connection"
+	categoryProject    appLogCategory = "project"
+	categoryResource   appLogCategory = "resource"
+	categoryAction     appLogCategory = "action"
+)
+
+// appLogCategories is the category filter cycle order. The empty category shows all entries.
+var appLogCategories = []appLogCategory{"", categoryConnection, categoryProject, categoryResource, categoryAction}
+
+// appLogEntry is a single structured app log entry
+type appLogEntry struct {
+	Time     time.Time
+	Level    appLogLevel
+	Category appLogCategory
+	Message  string
+}
+
+// appendAppLog records an entry, keeping at most MaxAppLogEntries
+func (t *TUI) appendAppLog(level appLogLevel, category appLogCategory, message string) {
+	t.appLog = append(t.appLog, appLogEntry{
+		Time:     time.Now(),
+		Level:    level,
+		Category: category,
+		Message:  message,
+	})
+	if len(t.appLog) > constants.MaxAppLogEntries {
+		t.appLog = t.appLog[len(t.appLog)-constants.MaxAppLogEntries:]
+	}
+}
+
+// logInfo records an informational app log entry
+func (t *TUI) logInfo(category appLogCategory, format string, args ...interface{}) {
+	t.appendAppLog(levelInfo, category, fmt.Sprintf(format, args...))
+}
+
+// logSuccess records an app log entry for a completed operation
+func (t *TUI) logSuccess(category appLogCategory, format string, args ...interface{}) {
+	t.appendAppLog(levelSuccess, category, fmt.Sprintf(format, args...))
+}
+
+// logWarn records a warning app log entry
+func (t *TUI) logWarn(category appLogCategory, format string, args ...interface{}) {
+	t.appendAppLog(levelWarn, category, fmt.Sprintf(format, args...))
+}
+
+// logError records an error app log entry
+func (t *TUI) logError(category appLogCategory, format string, args ...interface{}) {
+	t.appendAppLog(levelError, category, fmt.Sprintf(format, args...))
+}
+
+// filteredAppLog returns the entries matching the active category filter
+func (t *TUI) filteredAppLog() []appLogEntry {
+	if t.appLogFilter == "" {
+		return t.appLog
+	}
+
+	var entries []appLogEntry
+	for _, entry := range t.appLog {
+		if entry.Category == t.appLogFilter {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// cycleAppLogFilter shows the app log and advances its category filter. After
+// the last category the log panel returns to the tab's regular log mode.
+func (t *TUI) cycleAppLogFilter() {
+	t.showLogs = true
+	if t.logViewMode != constants.AppLogViewMode {
+		t.logViewMode = constants.AppLogViewMode
+		t.appLogFilter = ""
+		return
+	}
+
+	for i, category := range appLogCategories {
+		if category == t.appLogFilter {
+			if i+1 < len(appLogCategories) {
+				t.appLogFilter = appLogCategories[i+1]
+				return
+			}
+			break
+		}
+	}
+
+	t.appLogFilter = ""
+	t.logViewMode = constants.PodLogViewMode
+	if t.ActiveTab == 1 {
+		t.logViewMode = constants.ServiceLogViewMode
+	}
+}
+
+// appLogHeader returns the log panel header for the app log
+func (t *TUI) appLogHeader() string {
+	if t.appLogFilter == "" {
+		return "📱 App Logs (all)"
+	}
+	return fmt.Sprintf("📱 App Logs (%s)", t.appLogFilter)
+}
+
+// renderAppLogEntry formats an entry as "time LEVEL category message", colored by level
+func renderAppLogEntry(entry appLogEntry) string {
+	var levelStyle lipgloss.Style
+	switch entry.Level {
+	case levelError:
+		levelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true) // Bright red + bold
+	case levelWarn:
+		levelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")) // Orange
+	case levelSuccess:
+		levelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("46")) // Bright green
+	default:
+		levelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("81")) // Bright blue
+	}
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("246"))
+
+	message := entry.Message
+	if entry.Level == levelError || entry.Level == levelWarn {
+		message = levelStyle.Render(message)
+	}
+
+	return fmt.Sprintf("%s %s %s %s",
+		dimStyle.Render(entry.Time.Format("15:04:05")),
+		levelStyle.Render(fmt.Sprintf("%-5s", entry.Level)),
+		dimStyle.Render(fmt.Sprintf("%-10s", entry.Category)),
+		strings.TrimSpace(message))
+}
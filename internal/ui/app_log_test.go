@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestAppLogFilterAndLimit(t *testing.T) {
+	tui := &TUI{}
+
+	tui.logSuccess(categoryConnection, "Connected to %s", "cluster")
+	tui.logError(categoryResource, "Failed to load pods: %v", "timeout")
+	tui.logInfo(categoryProject, "Switched to project '%s'", "dev")
+
+	if got := len(tui.filteredAppLog()); got != 3 {
+		t.Fatalf("Expected 3 unfiltered entries, got %d", got)
+	}
+
+	tui.appLogFilter = categoryResource
+	entries := tui.filteredAppLog()
+	if len(entries) != 1 || entries[0].Level != levelError || entries[0].Message != "Failed to load pods: timeout" {
+		t.Errorf("Unexpected filtered entries: %+v", entries)
+	}
+
+	if rendered := renderAppLogEntry(entries[0]); !strings.Contains(rendered, "ERROR") || !strings.Contains(rendered, "resource") {
+		t.Errorf("Expected level and category in rendered entry, got %q", rendered)
+	}
+
+	for i := 0; i < constants.MaxAppLogEntries+10; i++ {
+		tui.logInfo(categoryAction, "entry %d", i)
+	}
+	if len(tui.appLog) != constants.MaxAppLogEntries {
+		t.Errorf("Expected app log to be capped at %d, got %d", constants.MaxAppLogEntries, len(tui.appLog))
+	}
+}
+
+func TestCycleAppLogFilter(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), logViewMode: constants.PodLogViewMode}
+
+	tui.cycleAppLogFilter()
+	if tui.logViewMode != constants.AppLogViewMode || tui.appLogFilter != "" || !tui.showLogs {
+		t.Fatalf("Expected app log with no filter, got mode=%s filter=%q", tui.logViewMode, tui.appLogFilter)
+	}
+
+	for _, expected := range appLogCategories[1:] {
+		tui.cycleAppLogFilter()
+		if tui.appLogFilter != expected {
+			t.Errorf("Expected filter %q, got %q", expected, tui.appLogFilter)
+		}
+	}
+
+	tui.cycleAppLogFilter()
+	if tui.logViewMode != constants.PodLogViewMode || tui.appLogFilter != "" {
+		t.Errorf("Expected to return to pod logs, got mode=%s filter=%q", tui.logViewMode, tui.appLogFilter)
+	}
+}
@@ -0,0 +1,290 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// startApply opens $EDITOR on an empty manifest; the saved objects are
+// applied to the current namespace
+func (t *TUI) startApply() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	if _, ok := t.resourceClient.(resources.ResourceApplier); !ok {
+		t.logError(categoryAction, "Cannot apply manifests: resource client does not support apply")
+		return nil
+	}
+
+	file, err := os.CreateTemp("", constants.ApplyTempFilePattern)
+	if err != nil {
+		t.logError(categoryAction, "Failed to create temp file: %v", err)
+		return nil
+	}
+	path := file.Name()
+
+	namespace := t.namespace
+	original := fmt.Sprintf("# Write or paste manifests to apply to namespace %s, separated by ---.\n"+
+		"# Save and exit to apply; exit without changes to cancel.\n", namespace)
+	if _, err := file.WriteString(original); err != nil {
+		file.Close()
+		os.Remove(path)
+		t.logError(categoryAction, "Failed to write temp file: %v", err)
+		return nil
+	}
+	file.Close()
+
+	editor := editorCommand()
+	cmd := exec.Command(editor[0], append(editor[1:], path)...)
+	logging.Info(t.Logger, "Opening apply manifest in %s", editor[0])
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return messages.ApplyEditorFinished{
+			Namespace: namespace,
+			Path:      path,
+			Original:  original,
+			Err:       err,
+		}
+	})
+}
+
+// handleApplyEditorFinished applies the written manifests in the background
+func (t *TUI) handleApplyEditorFinished(msg messages.ApplyEditorFinished) tea.Cmd {
+	defer os.Remove(msg.Path)
+
+	if msg.Err != nil {
+		t.logError(categoryAction, "Editor failed: %v", msg.Err)
+		return nil
+	}
+
+	manifest, err := os.ReadFile(msg.Path)
+	if err != nil {
+		t.logError(categoryAction, "Failed to read manifest file: %v", err)
+		return nil
+	}
+	if string(manifest) == msg.Original || strings.TrimSpace(string(manifest)) == "" {
+		t.logInfo(categoryAction, "Apply cancelled, no manifests written")
+		return nil
+	}
+
+	return t.previewOrApplyManifests(msg.Namespace, manifest)
+}
+
+// previewOrApplyManifests applies manifests to a namespace, previewing them
+// in the diff tool first when one is configured
+func (t *TUI) previewOrApplyManifests(namespace string, manifest []byte) tea.Cmd {
+	if _, ok := t.resourceClient.(resources.ManifestPreviewer); ok && t.prefs.DiffTool != "" {
+		return t.previewManifests(namespace, manifest)
+	}
+	return t.applyManifests(namespace, manifest)
+}
+
+// applyManifests applies manifests to a namespace in the background
+func (t *TUI) applyManifests(namespace string, manifest []byte) tea.Cmd {
+	applier, ok := t.resourceClient.(resources.ResourceApplier)
+	if !ok {
+		t.logError(categoryAction, "Cannot apply manifests: resource client does not support apply")
+		return nil
+	}
+
+	var results []resources.ApplyResult
+	dryRun := t.dryRun
+	return t.runPreviewTask(fmt.Sprintf("Apply manifests to %s", namespace),
+		func(ctx context.Context, _ func(done, total int)) error {
+			var err error
+			results, err = applier.ApplyManifests(ctx, namespace, manifest)
+			return err
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to apply manifests: %v", err)
+				return nil
+			}
+			t.showApplyResultsFor(results, dryRun)
+			if dryRun {
+				return nil
+			}
+			return t.refreshTab(int(t.ActiveTab))
+		})
+}
+
+// showApplyResultsFor opens the results panel and records failed objects in
+// the error center so they can be inspected after the panel is closed.
+// Results of a dry run describe what would have happened.
+func (t *TUI) showApplyResultsFor(results []resources.ApplyResult, dryRun bool) {
+	t.applyResults = results
+	t.applyResultsDryRun = dryRun
+	t.applyResultsScroll = 0
+	t.showApplyResults = true
+
+	counts := make(map[string]int)
+	for _, result := range results {
+		counts[result.Action]++
+		if result.Err == nil {
+			t.logInfo(categoryAction, "%s %s", applyResultRef(result), result.Action)
+			continue
+		}
+
+		userError := errors.MapKubernetesError(result.Err)
+		userError.Title = fmt.Sprintf("Apply failed: %s", result.Ref())
+		t.errorDisplay.AddError(userError)
+		t.logError(categoryAction, "Failed to apply %s: %v", result.Ref(), result.Err)
+	}
+
+	verb := "Applied"
+	if dryRun {
+		verb = "Dry run applied"
+	}
+	summary := applySummary(counts)
+	if counts[resources.ApplyFailed] > 0 {
+		t.logWarn(categoryAction, "%s %d objects: %s", verb, len(results), summary)
+	} else {
+		t.logSuccess(categoryAction, "%s %d objects: %s", verb, len(results), summary)
+	}
+}
+
+// applyResultRef names an applied object with its namespace, e.g.
+// "deployment/web in shop"
+func applyResultRef(result resources.ApplyResult) string {
+	if result.Namespace == "" {
+		return result.Ref()
+	}
+	return result.Ref() + " in " + result.Namespace
+}
+
+// applySummary formats per-outcome counts, e.g. "2 created, 1 unchanged"
+func applySummary(counts map[string]int) string {
+	var parts []string
+	for _, action := range []string{resources.ApplyCreated, resources.ApplyConfigured, resources.ApplyUnchanged, resources.ApplyFailed} {
+		if counts[action] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[action], action))
+		}
+	}
+	if len(parts) == 0 {
+		return "nothing to apply"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// applyActionStyle colors an apply outcome
+func applyActionStyle(action string) lipgloss.Style {
+	switch action {
+	case resources.ApplyCreated:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
+	case resources.ApplyConfigured:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("3"))
+	case resources.ApplyFailed:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
+	default:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	}
+}
+
+// applyResultLines renders one line per object, plus the error message
+// below each failed object
+func (t *TUI) applyResultLines(width int) []string {
+	var lines []string
+	for _, result := range t.applyResults {
+		namespace := result.Namespace
+		if namespace == "" {
+			namespace = "-"
+		}
+		line := fmt.Sprintf("%-40s %-16s %s", truncateString(result.Ref(), 40), truncateString(namespace, 16),
+			applyActionStyle(result.Action).Render(result.Action))
+		lines = append(lines, line)
+
+		if result.Err != nil {
+			lines = append(lines, applyActionStyle(resources.ApplyFailed).Render("  "+truncateString(result.Err.Error(), max(width-2, 10))))
+		}
+	}
+	return lines
+}
+
+// handleApplyResultsKeys handles key input for the apply results panel
+func (t *TUI) handleApplyResultsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "enter":
+		t.showApplyResults = false
+
+	case "j", "down":
+		if t.applyResultsScroll < len(t.applyResultLines(t.width))-1 {
+			t.applyResultsScroll++
+		}
+
+	case "k", "up":
+		if t.applyResultsScroll > 0 {
+			t.applyResultsScroll--
+		}
+
+	case "e":
+		// Jump to the error center to inspect failed objects
+		t.showApplyResults = false
+		if t.errorDisplay.HasErrors() {
+			t.showErrorModal = true
+		}
+	}
+	return t, nil
+}
+
+// renderApplyResults renders the per-object apply results panel
+func (t *TUI) renderApplyResults() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(90, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	counts := make(map[string]int)
+	for _, result := range t.applyResults {
+		counts[result.Action]++
+	}
+
+	var content strings.Builder
+	title := "📥 Apply Results"
+	if t.applyResultsDryRun {
+		title += " (dry run, nothing was changed)"
+	}
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(title) + "\n")
+	content.WriteString(applySummary(counts) + "\n\n")
+
+	header := fmt.Sprintf("%-40s %-16s %s", "OBJECT", "NAMESPACE", "RESULT")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header) + "\n")
+
+	lines := t.applyResultLines(modalWidth - 8)
+	visible := max(t.height-14, 3)
+	start := min(t.applyResultsScroll, max(len(lines)-visible, 0))
+	end := min(start+visible, len(lines))
+	for _, line := range lines[start:end] {
+		content.WriteString(line + "\n")
+	}
+	if len(lines) > visible {
+		content.WriteString(fmt.Sprintf("[%d-%d of %d lines]\n", start+1, end, len(lines)))
+	}
+
+	content.WriteString("\n")
+	if counts[resources.ApplyFailed] > 0 {
+		content.WriteString("j/k: scroll • e: error center • esc: close")
+	} else {
+		content.WriteString("j/k: scroll • esc: close")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,240 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// applyPickerEntry is a directory or manifest file in the apply file picker
+type applyPickerEntry struct {
+	Name string
+	Dir  bool
+}
+
+// listApplyPickerDir returns the subdirectories and manifest files of a
+// directory, directories first, with ".." unless it is the root. Hidden
+// entries are left out.
+func listApplyPickerDir(dir string) ([]applyPickerEntry, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []applyPickerEntry
+	for _, entry := range dirEntries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		isDir := entry.IsDir()
+		if entry.Type()&os.ModeSymlink != 0 {
+			if info, err := os.Stat(filepath.Join(dir, entry.Name())); err == nil {
+				isDir = info.IsDir()
+			}
+		}
+		if isDir || resources.IsManifestFile(entry.Name()) {
+			entries = append(entries, applyPickerEntry{Name: entry.Name(), Dir: isDir})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Dir != entries[j].Dir {
+			return entries[i].Dir
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	if filepath.Dir(dir) != dir {
+		entries = append([]applyPickerEntry{{Name: "..", Dir: true}}, entries...)
+	}
+	return entries, nil
+}
+
+// openApplyPicker opens the manifest file picker in the directory it was
+// last in, or in the working directory
+func (t *TUI) openApplyPicker() {
+	if !t.connected {
+		return
+	}
+	if _, ok := t.resourceClient.(resources.ResourceApplier); !ok {
+		t.logError(categoryAction, "Cannot apply manifests: resource client does not support apply")
+		return
+	}
+
+	dir := t.applyPickerDir
+	if dir == "" {
+		var err error
+		if dir, err = os.Getwd(); err != nil {
+			dir = "."
+		}
+	}
+	t.showApplyPicker = true
+	t.changeApplyPickerDir(dir)
+}
+
+// changeApplyPickerDir lists another directory in the file picker
+func (t *TUI) changeApplyPickerDir(dir string) {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+	t.applyPickerIndex = 0
+	t.applyPickerScroll = 0
+	t.applyPickerDir = dir
+	t.applyPickerEntries, t.applyPickerError = nil, ""
+	entries, err := listApplyPickerDir(dir)
+	if err != nil {
+		t.applyPickerError = err.Error()
+		return
+	}
+	t.applyPickerEntries = entries
+}
+
+// applyManifestFiles applies the manifests of files and directories to the
+// current namespace
+func (t *TUI) applyManifestFiles(paths []string) tea.Cmd {
+	manifest, err := resources.ReadManifestFiles(paths, nil)
+	if err != nil {
+		t.logError(categoryAction, "Cannot read manifests: %v", err)
+		return nil
+	}
+	if strings.TrimSpace(string(manifest)) == "" {
+		t.logInfo(categoryAction, "Nothing to apply in %s", strings.Join(paths, ", "))
+		return nil
+	}
+
+	t.logInfo(categoryAction, "Applying %s to %s...", strings.Join(paths, ", "), t.namespace)
+	return t.previewOrApplyManifests(t.namespace, manifest)
+}
+
+// runApplyCommand runs :apply, applying a file or directory, or opening the
+// file picker without one
+func (t *TUI) runApplyCommand(path string) (tea.Cmd, error) {
+	if _, ok := t.resourceClient.(resources.ResourceApplier); !ok {
+		return nil, fmt.Errorf("resource client does not support apply")
+	}
+	if path == "" {
+		t.openApplyPicker()
+		return nil, nil
+	}
+
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, rest)
+		}
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	return t.applyManifestFiles([]string{path}), nil
+}
+
+// applyPickerHeight returns the number of entries visible at once
+func (t *TUI) applyPickerHeight() int {
+	return max(t.height-14, 3)
+}
+
+// renderApplyPicker renders the manifest file picker
+func (t *TUI) renderApplyPicker() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(90, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("📥 Apply Manifests to %s", t.namespace)) + "\n")
+	content.WriteString(dimStyle.Render(truncateString(t.applyPickerDir, modalWidth-8)) + "\n\n")
+
+	switch {
+	case t.applyPickerError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.applyPickerError) + "\n")
+	case len(t.applyPickerEntries) == 0:
+		content.WriteString(dimStyle.Render("No directories or .yaml, .yml and .json files here") + "\n")
+	default:
+		height := t.applyPickerHeight()
+		end := min(t.applyPickerScroll+height, len(t.applyPickerEntries))
+		for i := t.applyPickerScroll; i < end; i++ {
+			entry := t.applyPickerEntries[i]
+			name := "📄 " + entry.Name
+			if entry.Dir {
+				name = "📁 " + entry.Name + "/"
+			}
+			line := "  " + truncateString(name, modalWidth-10)
+			if i == t.applyPickerIndex {
+				line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(fmt.Sprintf("%-*s", modalWidth-8, line))
+			}
+			content.WriteString(line + "\n")
+		}
+		if len(t.applyPickerEntries) > height {
+			content.WriteString(dimStyle.Render(fmt.Sprintf("[%d-%d of %d]", t.applyPickerScroll+1, end, len(t.applyPickerEntries))) + "\n")
+		}
+	}
+
+	content.WriteString("\n")
+	if t.dryRun {
+		content.WriteString("Dry run is on, nothing is saved.\n\n")
+	}
+	content.WriteString("j/k: navigate • enter: open directory / apply file • backspace: up • a: apply all files here • esc: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleApplyPickerKeys handles key input for the manifest file picker
+func (t *TUI) handleApplyPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	count := len(t.applyPickerEntries)
+
+	switch msg.String() {
+	case "esc", "q":
+		t.showApplyPicker = false
+
+	case "j", "down":
+		if count > 0 {
+			t.applyPickerIndex = (t.applyPickerIndex + 1) % count
+		}
+
+	case "k", "up":
+		if count > 0 {
+			t.applyPickerIndex = (t.applyPickerIndex + count - 1) % count
+		}
+
+	case "backspace", "h", "left":
+		t.changeApplyPickerDir(filepath.Dir(t.applyPickerDir))
+
+	case "enter", "l", "right":
+		if t.applyPickerIndex >= count {
+			return t, nil
+		}
+		entry := t.applyPickerEntries[t.applyPickerIndex]
+		path := filepath.Join(t.applyPickerDir, entry.Name)
+		if entry.Dir {
+			t.changeApplyPickerDir(path)
+			return t, nil
+		}
+		t.showApplyPicker = false
+		return t, t.applyManifestFiles([]string{path})
+
+	case "a":
+		t.showApplyPicker = false
+		return t, t.applyManifestFiles([]string{t.applyPickerDir})
+	}
+
+	// Keep the selected entry visible
+	height := t.applyPickerHeight()
+	if t.applyPickerIndex < t.applyPickerScroll {
+		t.applyPickerScroll = t.applyPickerIndex
+	} else if t.applyPickerIndex >= t.applyPickerScroll+height {
+		t.applyPickerScroll = t.applyPickerIndex - height + 1
+	}
+	return t, nil
+}
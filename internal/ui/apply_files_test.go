@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// stubApplier is a resource client that supports apply
+type stubApplier struct {
+	resources.ResourceClient
+}
+
+func (stubApplier) ApplyManifests(ctx context.Context, namespace string, manifest []byte) ([]resources.ApplyResult, error) {
+	return nil, nil
+}
+
+func TestApplyPicker(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"deploy.yaml", "notes.txt", ".hidden.yaml", "overlays/prod/kustomization.yml"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("kind: ConfigMap\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := listApplyPickerDir(dir)
+	if err != nil {
+		t.Fatalf("listApplyPickerDir() returned error: %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	if got := strings.Join(names, ","); got != "..,overlays,deploy.yaml" {
+		t.Errorf("Expected .., overlays and deploy.yaml, got %s", got)
+	}
+
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", resourceClient: stubApplier{}, applyPickerDir: dir, width: 120, height: 40}
+	key := func(k string) {
+		keyMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			keyMsg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "backspace":
+			keyMsg = tea.KeyMsg{Type: tea.KeyBackspace}
+		}
+		tui.handleApplyPickerKeys(keyMsg)
+	}
+
+	tui.openApplyPicker()
+	if !tui.showApplyPicker || !strings.Contains(tui.renderApplyPicker(), "deploy.yaml") {
+		t.Fatalf("Expected the picker to list %s", dir)
+	}
+
+	// enter opens the selected directory, backspace goes back up
+	key("j")
+	key("enter")
+	if tui.applyPickerDir != filepath.Join(dir, "overlays") || len(tui.applyPickerEntries) != 2 {
+		t.Errorf("Expected overlays to open, got %s with %+v", tui.applyPickerDir, tui.applyPickerEntries)
+	}
+	key("backspace")
+	if tui.applyPickerDir != dir {
+		t.Errorf("Expected backspace to go back to %s, got %s", dir, tui.applyPickerDir)
+	}
+
+	key("esc")
+	if tui.showApplyPicker {
+		t.Error("Expected esc to close the picker")
+	}
+
+	// The picker opens again where it was left
+	tui.openApplyPicker()
+	if tui.applyPickerDir != dir {
+		t.Errorf("Expected the picker to reopen in %s, got %s", dir, tui.applyPickerDir)
+	}
+
+	if _, err := tui.runApplyCommand(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("Expected :apply of a missing file to fail")
+	}
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/components"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestShowApplyResults(t *testing.T) {
+	tui := &TUI{
+		App:          models.NewApp("test"),
+		errorDisplay: components.NewErrorDisplayComponent("dark"),
+		width:        120,
+		height:       40,
+	}
+
+	tui.showApplyResultsFor([]resources.ApplyResult{
+		{Kind: "ConfigMap", Namespace: "demo", Name: "settings", Action: resources.ApplyCreated},
+		{Kind: "Deployment", Namespace: "demo", Name: "web", Action: resources.ApplyConfigured},
+		{Kind: "Service", Namespace: "demo", Name: "web", Action: resources.ApplyUnchanged},
+		{Kind: "Widget", Namespace: "demo", Name: "x", Action: resources.ApplyFailed, Err: fmt.Errorf("unknown resource type Widget")},
+	}, false)
+
+	if !tui.showApplyResults {
+		t.Fatalf("Expected the results panel to open")
+	}
+	if !tui.errorDisplay.HasErrors() {
+		t.Errorf("Expected the failed object to be kept in the error center")
+	}
+
+	// Each applied object is logged
+	var logged []string
+	for _, entry := range tui.appLog {
+		logged = append(logged, entry.Message)
+	}
+	if !strings.Contains(strings.Join(logged, "\n"), "deployment/web in demo configured") {
+		t.Errorf("Expected the configured deployment in the app log, got %q", logged)
+	}
+
+	rendered := tui.renderApplyResults()
+	for _, want := range []string{"1 created, 1 configured, 1 unchanged, 1 error", "configmap/settings", "widget/x", "unknown resource type Widget"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected results panel to contain %q", want)
+		}
+	}
+}
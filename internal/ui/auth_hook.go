@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+)
+
+// authHookFromPreferences returns the hook command of the preferences, nil
+// when none is configured
+func authHookFromPreferences(command string) auth.ConfigHook {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil
+	}
+	return auth.NewExecHook(fields...)
+}
+
+// newAuthProvider creates the provider connecting to a kubeconfig context,
+// asking the auth hook for the connection when one is configured
+func newAuthProvider(kubeconfigPath, contextName string, hook auth.ConfigHook) auth.AuthProvider {
+	kubeconfig := auth.NewKubeconfigProviderWithContext(kubeconfigPath, contextName)
+	if hook == nil {
+		return kubeconfig
+	}
+	return auth.NewHookProvider(kubeconfig, hook)
+}
+
+// authTimeout returns how long connecting may take, longer with an auth
+// hook, which may wait for a login in the browser
+func authTimeout(hook auth.ConfigHook) time.Duration {
+	if hook == nil {
+		return constants.AuthenticationTimeout
+	}
+	return constants.AuthHookTimeout
+}
@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultAutoRefreshTabs returns the tabs that refresh automatically by default.
+// Slow-changing resources such as ConfigMaps and Secrets are off by default.
+func defaultAutoRefreshTabs() map[int]bool {
+	return map[int]bool{
+		0:  true, // Pods
+		1:  true, // Services
+		2:  true, // Deployments
+		5:  true, // BuildConfigs
+		8:  true, // Builds
+		9:  true, // Events
+		13: true, // Jobs
+	}
+}
+
+// autoRefreshInterval returns the refresh interval for a tab
+func (t *TUI) autoRefreshInterval(tab int) time.Duration {
+	if tab == 0 {
+		return t.prefs.PodRefreshInterval()
+	}
+	return t.prefs.ResourceRefreshInterval()
+}
+
+// autoRefreshActive reports whether the active tab is currently auto-refreshing
+func (t *TUI) autoRefreshActive() bool {
+	return t.connected && !t.autoRefreshPaused && t.autoRefreshTabs[int(t.ActiveTab)]
+}
+
+// resetAutoRefreshCountdown restarts the countdown for the active tab
+func (t *TUI) resetAutoRefreshCountdown() {
+	t.nextAutoRefresh = time.Now().Add(t.autoRefreshInterval(int(t.ActiveTab)))
+}
+
+// handleAutoRefreshTick refreshes the active tab once its countdown expires
+func (t *TUI) handleAutoRefreshTick() tea.Cmd {
+	if !t.autoRefreshActive() {
+		return nil
+	}
+	if t.nextAutoRefresh.IsZero() {
+		t.resetAutoRefreshCountdown()
+		return nil
+	}
+	if time.Now().Before(t.nextAutoRefresh) {
+		return nil
+	}
+
+	t.resetAutoRefreshCountdown()
+	return t.refreshTab(int(t.ActiveTab))
+}
+
+// refreshTab reloads the resources shown in a tab, unless the user may not
+// list them
+func (t *TUI) refreshTab(tab int) tea.Cmd {
+	if t.tabNoAccess(tab) != nil {
+		return nil
+	}
+	switch tab {
+	case 0:
+		return t.withUsageSample(t.loadPods())
+	case 1:
+		return t.loadServices()
+	case 2:
+		return t.withUsageSample(t.loadDeployments())
+	case 3:
+		return t.loadConfigMaps()
+	case 4:
+		return t.loadSecrets()
+	case 5:
+		return t.loadBuildConfigs()
+	case 6:
+		return t.loadImageStreams()
+	case 7:
+		return t.loadRoutes()
+	case 8:
+		return t.loadBuilds()
+	case 9:
+		return t.loadEvents()
+	case 10:
+		return t.withUsageSample(t.loadStatefulSets())
+	case 11:
+		return t.withUsageSample(t.loadDaemonSets())
+	case 12:
+		return t.loadReplicaSets()
+	case 13:
+		return t.loadJobs()
+	case 14:
+		return tea.Batch(t.loadCronJobs(), t.loadJobs())
+	case 15:
+		return t.withNodeUsage(t.withScalingEvents(t.loadNodes()))
+	case 16:
+		return tea.Batch(t.loadPersistentVolumeClaims(), t.loadStorageClasses())
+	case 17:
+		return t.loadIngresses()
+	case 18:
+		return t.loadNetworkPolicies()
+	case 19:
+		return t.loadDeploymentConfigs()
+	}
+	return nil
+}
+
+// toggleTabAutoRefresh enables or disables auto refresh for the active tab
+func (t *TUI) toggleTabAutoRefresh() {
+	tab := int(t.ActiveTab)
+	t.autoRefreshTabs[tab] = !t.autoRefreshTabs[tab]
+	t.resetAutoRefreshCountdown()
+
+	state := "disabled"
+	if t.autoRefreshTabs[tab] {
+		state = fmt.Sprintf("enabled (every %s)", t.autoRefreshInterval(tab))
+	}
+	t.logInfo(categoryAction, "Auto refresh %s for %s", state, t.GetTabName(t.ActiveTab))
+}
+
+// toggleAutoRefreshPause pauses or resumes auto refresh for all tabs
+func (t *TUI) toggleAutoRefreshPause() {
+	t.autoRefreshPaused = !t.autoRefreshPaused
+	if t.autoRefreshPaused {
+		t.logInfo(categoryAction, "Auto refresh paused")
+		return
+	}
+	t.resetAutoRefreshCountdown()
+	t.logInfo(categoryAction, "Auto refresh resumed")
+}
+
+// renderAutoRefreshStatus returns the countdown indicator for the status bar
+func (t *TUI) renderAutoRefreshStatus() string {
+	switch {
+	case t.safeMode:
+		return "⟳ manual (r)"
+	case t.autoRefreshPaused:
+		return "⏸ paused"
+	case !t.autoRefreshTabs[int(t.ActiveTab)]:
+		return "⟳ off"
+	case t.nextAutoRefresh.IsZero():
+		return ""
+	}
+
+	remaining := time.Until(t.nextAutoRefresh).Round(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return fmt.Sprintf("⟳ %ds", int(remaining.Seconds()))
+}
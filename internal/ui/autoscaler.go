@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadScalingEvents loads the events of the cluster autoscaler in all namespaces
+func (t *TUI) loadScalingEvents() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.ScalingEventsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		list, err := loadWithRetry(t, "scaling events", t.resourceClient.ListScalingEvents)
+		if err != nil {
+			return messages.ScalingEventsLoadError{Err: err}
+		}
+
+		return messages.ScalingEventsLoaded{Events: list.Items}
+	}
+}
+
+// withScalingEvents loads the autoscaler events along with the nodes
+func (t *TUI) withScalingEvents(cmd tea.Cmd) tea.Cmd {
+	return tea.Batch(cmd, t.loadScalingEvents())
+}
+
+// handleScalingEventsLoadError records a failed load. Listing events in all
+// namespaces needs cluster-wide access, the Nodes tab works without them.
+func (t *TUI) handleScalingEventsLoadError(msg messages.ScalingEventsLoadError) {
+	if !t.scalingEventsUnavailable {
+		t.logWarn(categoryResource, "Cluster autoscaler events unavailable: %v", msg.Err)
+	}
+	t.scalingEvents = nil
+	t.scalingEventsUnavailable = true
+}
+
+// renderScalingActivity summarizes the recent cluster autoscaler activity
+// below the node list: pods waiting for new nodes, pods no scale up can
+// help and the latest scale up and scale down
+func (t *TUI) renderScalingActivity() string {
+	if len(t.scalingEvents) == 0 {
+		return ""
+	}
+	activity := resources.SummarizeScaling(t.scalingEvents, constants.ScalingEventWindow, time.Now())
+
+	var section strings.Builder
+	section.WriteString("\n" + lipgloss.NewStyle().Bold(true).Render("Autoscaler Activity") + "\n")
+
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+
+	if len(activity.WaitingPods) > 0 {
+		section.WriteString(warnStyle.Render(fmt.Sprintf("  ⏳ %d pod(s) waiting for a scale up", len(activity.WaitingPods))) + "\n")
+		writeScalingPods(&section, activity.WaitingPods)
+	}
+	if len(activity.BlockedPods) > 0 {
+		section.WriteString(errorStyle.Render(fmt.Sprintf("  ✗ %d pending pod(s) no node group can fit", len(activity.BlockedPods))) + "\n")
+		writeScalingPods(&section, activity.BlockedPods)
+	}
+	if activity.LastScaleUp != nil {
+		section.WriteString(fmt.Sprintf("  ↑ Last scale up %s: %s\n", t.formatTimeAgo(activity.LastScaleUp.LastSeen, activity.LastScaleUp.Age), truncateString(activity.LastScaleUp.Message, 80)))
+	}
+	if activity.LastScaleDown != nil {
+		section.WriteString(fmt.Sprintf("  ↓ Last scale down %s: %s\n", t.formatTimeAgo(activity.LastScaleDown.LastSeen, activity.LastScaleDown.Age), activity.LastScaleDown.InvolvedName))
+	}
+	for _, failure := range activity.Failures {
+		section.WriteString(errorStyle.Render(fmt.Sprintf("  ⚠ %s on %s: %s", failure.Reason, failure.InvolvedName, truncateString(failure.Message, 70))) + "\n")
+	}
+	return section.String()
+}
+
+// writeScalingPods lists pods the autoscaler reported on
+func writeScalingPods(section *strings.Builder, pods []resources.ScalingPod) {
+	for i, pod := range pods {
+		if i == constants.MaxScalingPodsShown {
+			section.WriteString(fmt.Sprintf("      … and %d more\n", len(pods)-i))
+			break
+		}
+		section.WriteString(fmt.Sprintf("      %s/%s: %s\n", pod.Namespace, pod.Name, truncateString(pod.Message, 80)))
+	}
+}
+
+// renderNodeScalingEvents lists the cluster autoscaler events about a node,
+// such as it being marked for scale down
+func (t *TUI) renderNodeScalingEvents(name string) string {
+	events := resources.EventsFor(t.scalingEvents, "Node", name)
+	if len(events) == 0 {
+		return ""
+	}
+
+	var section strings.Builder
+	section.WriteString("\nAutoscaler:\n")
+	for i, event := range events {
+		if i == constants.MaxRelatedEvents {
+			section.WriteString(fmt.Sprintf("  ... %d more\n", len(events)-i))
+			break
+		}
+		line := fmt.Sprintf("  %s (%s)", event.Reason, t.formatTimeAgo(event.LastSeen, event.Age))
+		if event.Type == "Warning" {
+			line = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("  ⚠ " + strings.TrimSpace(line))
+		}
+		section.WriteString(line + "\n")
+		section.WriteString(fmt.Sprintf("    %s\n", truncateString(event.Message, 70)))
+	}
+	return section.String()
+}
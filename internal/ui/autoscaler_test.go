@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestScalingActivityOnNodesTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabNodes
+
+	tui.Update(messages.NodesLoaded{Nodes: []resources.NodeInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "node-1", Status: "Ready"}},
+	}})
+	if strings.Contains(tui.mainContent, "Autoscaler Activity") {
+		t.Errorf("Expected no autoscaler section without autoscaler events")
+	}
+
+	now := time.Now()
+	tui.Update(messages.ScalingEventsLoaded{Events: []resources.EventInfo{
+		{ResourceInfo: resources.ResourceInfo{Namespace: "shop"}, Reason: "TriggeredScaleUp", InvolvedKind: "Pod", InvolvedName: "web-1",
+			Message: "pod triggered scale-up: [{worker-a 2->3 (max: 6)}]", Source: "cluster-autoscaler", LastSeen: now},
+		{Reason: "ScaleDown", Type: "Normal", InvolvedKind: "Node", InvolvedName: "node-1",
+			Message: "marked the node as toBeDeleted/unschedulable", Source: "cluster-autoscaler", LastSeen: now.Add(-2 * time.Minute), Age: "2m"},
+	}})
+
+	for _, want := range []string{"1 pod(s) waiting for a scale up", "shop/web-1: pod triggered scale-up", "Last scale down 2m ago: node-1"} {
+		if !strings.Contains(tui.mainContent, want) {
+			t.Errorf("Expected the Nodes tab to contain %q, got %q", want, tui.mainContent)
+		}
+	}
+	if !strings.Contains(tui.detailContent, "marked the node as toBeDeleted") {
+		t.Errorf("Expected the node details to show its autoscaler events, got %q", tui.detailContent)
+	}
+}
@@ -0,0 +1,428 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// batchMark is drawn before the name of a marked item
+const batchMark = "✓"
+
+// batchActions are the actions that can be run on the items marked in a tab
+var batchActions = map[models.TabType][]string{
+	models.TabPods:        {"delete", "label"},
+	models.TabDeployments: {"delete", "restart", "label"},
+	models.TabJobs:        {"delete"},
+}
+
+// batchActionKeys are the keys choosing an action in the batch menu
+var batchActionKeys = map[string]string{"d": "delete", "r": "restart", "l": "label"}
+
+// batchKey identifies a marked item of a tab
+func batchKey(ref resourceRef) string {
+	return ref.Namespace + "/" + ref.Name
+}
+
+// batchItems returns the displayed items of a tab that can be marked, in
+// list order
+func (t *TUI) batchItems(tab models.TabType) []resourceRef {
+	var refs []resourceRef
+	switch tab {
+	case models.TabPods:
+		for _, pod := range t.pods {
+			refs = append(refs, resourceRef{Kind: "Pod", Namespace: t.resourceNamespace(pod.Namespace), Name: pod.Name})
+		}
+	case models.TabDeployments:
+		for _, deploy := range t.deployments {
+			refs = append(refs, resourceRef{Kind: "Deployment", Namespace: t.resourceNamespace(deploy.Namespace), Name: deploy.Name})
+		}
+	case models.TabJobs:
+		for _, job := range t.jobs {
+			refs = append(refs, resourceRef{Kind: "Job", Namespace: t.resourceNamespace(job.Namespace), Name: job.Name})
+		}
+	}
+	return refs
+}
+
+// markedRefs returns the marked items of a tab that are still displayed.
+// Items deleted or hidden by the quick filter since they were marked are left out.
+func (t *TUI) markedRefs(tab models.TabType) []resourceRef {
+	marks := t.batchMarks[tab]
+	if len(marks) == 0 {
+		return nil
+	}
+
+	var refs []resourceRef
+	for _, ref := range t.batchItems(tab) {
+		if marks[batchKey(ref)] {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// toggleBatchMark marks or unmarks the selected item. It returns false on
+// tabs without batch actions or when nothing is selected.
+func (t *TUI) toggleBatchMark() bool {
+	if _, ok := batchActions[t.ActiveTab]; !ok {
+		return false
+	}
+	ref, ok := t.selectedResource()
+	if !ok {
+		return false
+	}
+
+	if t.batchMarks == nil {
+		t.batchMarks = make(map[models.TabType]map[string]bool)
+	}
+	marks := t.batchMarks[t.ActiveTab]
+	if marks == nil {
+		marks = make(map[string]bool)
+		t.batchMarks[t.ActiveTab] = marks
+	}
+	if marks[batchKey(ref)] {
+		delete(marks, batchKey(ref))
+	} else {
+		marks[batchKey(ref)] = true
+	}
+	t.updateMainContent()
+	return true
+}
+
+// toggleBatchMarkAll marks every displayed item of the tab, or unmarks them
+// all when they are already marked. It returns false when nothing is marked
+// yet, so that the key keeps its meaning outside of a selection.
+func (t *TUI) toggleBatchMarkAll() bool {
+	tab := t.ActiveTab
+	if len(t.batchMarks[tab]) == 0 {
+		return false
+	}
+
+	items := t.batchItems(tab)
+	all := len(t.markedRefs(tab)) == len(items)
+	marks := make(map[string]bool, len(items))
+	if !all {
+		for _, ref := range items {
+			marks[batchKey(ref)] = true
+		}
+	}
+	t.batchMarks[tab] = marks
+	t.updateMainContent()
+	return true
+}
+
+// batchMarkCell returns the mark of an item followed by a space, or "" when
+// the item is not marked
+func (t *TUI) batchMarkCell(tab models.TabType, namespace, name string) string {
+	if !t.batchMarks[tab][t.resourceNamespace(namespace)+"/"+name] {
+		return ""
+	}
+	return batchMark + " "
+}
+
+// batchLegend returns the title suffix counting the marked items of a tab,
+// e.g. "  ✓ 20 marked"
+func (t *TUI) batchLegend(tab models.TabType) string {
+	refs := t.markedRefs(tab)
+	if len(refs) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("  %s %d marked", batchMark, len(refs))
+}
+
+// supportsBatchAction reports whether an action can be run on the items of a tab
+func supportsBatchAction(tab models.TabType, action string) bool {
+	for _, supported := range batchActions[tab] {
+		if supported == action {
+			return true
+		}
+	}
+	return false
+}
+
+// openBatch asks for confirmation before running an action on the marked
+// items of the active tab, or shows the batch menu when action is empty.
+// It returns false when nothing is marked or the action is not supported.
+func (t *TUI) openBatch(action string) bool {
+	refs := t.markedRefs(t.ActiveTab)
+	if len(refs) == 0 || !t.connected || (action != "" && !supportsBatchAction(t.ActiveTab, action)) {
+		return false
+	}
+
+	t.batchTab = t.ActiveTab
+	t.batchRefs = refs
+	t.batchError = ""
+	t.showBatch = true
+	t.selectBatchAction(action)
+	return true
+}
+
+// selectBatchAction moves the batch modal to the confirmation of an action
+func (t *TUI) selectBatchAction(action string) {
+	t.batchAction = action
+	if action != "label" {
+		return
+	}
+
+	input := textinput.New()
+	input.Placeholder = "key=value, key-"
+	input.CharLimit = 512
+	input.Width = 60
+	input.Focus()
+	t.batchLabelInput = input
+}
+
+// closeBatch dismisses the batch modal, the marks are kept
+func (t *TUI) closeBatch() {
+	t.showBatch = false
+	t.batchAction = ""
+	t.batchRefs = nil
+	t.batchError = ""
+}
+
+// batchLabelPatch parses label changes, "key=value" to set a label and
+// "key-" to remove it, separated by commas or spaces, into a merge patch
+func batchLabelPatch(input string) ([]byte, error) {
+	labels := make(map[string]interface{})
+	fields := strings.FieldsFunc(input, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+	for _, field := range fields {
+		if key, ok := strings.CutSuffix(field, "-"); ok && !strings.Contains(field, "=") {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid label key %q: %s", key, errs[0])
+			}
+			labels[key] = nil
+			continue
+		}
+
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is neither key=value nor key-", field)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label key %q: %s", key, errs[0])
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid value of label %s: %s", key, errs[0])
+		}
+		labels[key] = value
+	}
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("enter key=value to set a label or key- to remove it")
+	}
+
+	return json.Marshal(map[string]interface{}{"metadata": map[string]interface{}{"labels": labels}})
+}
+
+// batchOperation returns the request an action makes for each item
+func (t *TUI) batchOperation(action, kind string, patch []byte) (func(ctx context.Context, ref resourceRef) error, error) {
+	client := t.resourceClient
+	switch action {
+	case "delete":
+		return func(ctx context.Context, ref resourceRef) error {
+			if ref.Kind == "Pod" {
+				return client.DeletePod(ctx, ref.Namespace, ref.Name)
+			}
+			return client.DeleteResource(ctx, ref.Kind, ref.Namespace, ref.Name, metav1.DeletePropagationBackground)
+		}, nil
+	case "restart":
+		return func(ctx context.Context, ref resourceRef) error {
+			return client.RolloutRestart(ctx, ref.Namespace, ref.Name)
+		}, nil
+	case "label":
+		writer, err := t.resourceWriterFor(kind)
+		if err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, ref resourceRef) error {
+			return writer.Patch(ctx, ref.Kind, ref.Namespace, ref.Name, types.MergePatchType, patch)
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown batch action %s", action)
+}
+
+// batchTitle names a batch, e.g. "Delete 20 pods"
+func batchTitle(action string, refs []resourceRef) string {
+	noun := strings.ToLower(refs[0].Kind)
+	if len(refs) > 1 {
+		noun += "s"
+	}
+	return fmt.Sprintf("%s%s %d %s", strings.ToUpper(action[:1]), action[1:], len(refs), noun)
+}
+
+// runBatch runs an action on each item as one background task. Failed items
+// do not stop the batch, they are summarized in the task's error.
+func (t *TUI) runBatch(tab models.TabType, action string, refs []resourceRef, patch []byte) tea.Cmd {
+	if !t.connected || t.resourceClient == nil || len(refs) == 0 {
+		return nil
+	}
+	operation, err := t.batchOperation(action, refs[0].Kind, patch)
+	if err != nil {
+		t.logError(categoryAction, "Failed to %s: %v", strings.ToLower(batchTitle(action, refs)), err)
+		return nil
+	}
+
+	title := batchTitle(action, refs)
+	t.logInfo(categoryAction, "%s...", title)
+
+	return t.runTask(title,
+		func(ctx context.Context, progress func(done, total int)) error {
+			var failed []string
+			var firstErr error
+			for i, ref := range refs {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if err := operation(ctx, ref); err != nil {
+					failed = append(failed, ref.Name)
+					if firstErr == nil {
+						firstErr = err
+					}
+				}
+				progress(i+1, len(refs))
+			}
+			if len(failed) > 0 {
+				return fmt.Errorf("%d of %d failed (%s): %w", len(failed), len(refs), strings.Join(failed, ", "), firstErr)
+			}
+			return nil
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				// The marks are kept so that the failed items can be retried
+				t.errorDisplay.AddError(errors.MapKubernetesError(err))
+				t.logError(categoryAction, "%s: %v", title, err)
+				return t.refreshTab(int(tab))
+			}
+			delete(t.batchMarks, tab)
+			t.logSuccess(categoryAction, "%s done", title)
+			return t.refreshTab(int(tab))
+		})
+}
+
+// renderBatch renders the batch menu and confirmation
+func (t *TUI) renderBatch() string {
+	primaryColor, errorColor := t.getThemeColors()
+	borderColor := primaryColor
+	if t.batchAction == "delete" {
+		borderColor = lipgloss.Color("9")
+	}
+
+	modalWidth := min(80, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	switch t.batchAction {
+	case "":
+		content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s %d marked", batchMark, len(t.batchRefs))) + "\n\n")
+	case "delete":
+		content.WriteString(lipgloss.NewStyle().Bold(true).Foreground(borderColor).Render("🗑️ "+batchTitle(t.batchAction, t.batchRefs)) + "\n\n")
+	default:
+		content.WriteString(lipgloss.NewStyle().Bold(true).Render(batchTitle(t.batchAction, t.batchRefs)) + "\n\n")
+	}
+
+	for i, ref := range t.batchRefs {
+		if i == constants.MaxBatchItemsShown {
+			content.WriteString(fmt.Sprintf("  … and %d more\n", len(t.batchRefs)-i))
+			break
+		}
+		name := ref.Name
+		if t.allNamespaces {
+			name = ref.Namespace + "/" + ref.Name
+		}
+		content.WriteString("  " + truncateString(name, modalWidth-10) + "\n")
+	}
+	content.WriteString("\n")
+
+	switch t.batchAction {
+	case "":
+		var choices []string
+		for _, action := range batchActions[t.batchTab] {
+			choices = append(choices, fmt.Sprintf("%s: %s", action[:1], action))
+		}
+		content.WriteString(strings.Join(choices, " • ") + " • esc: cancel")
+	case "label":
+		content.WriteString("Labels to set (key=value) or remove (key-):\n")
+		content.WriteString(t.batchLabelInput.View() + "\n")
+		if t.batchError != "" {
+			content.WriteString("\n" + lipgloss.NewStyle().Foreground(errorColor).Render(t.batchError) + "\n")
+		}
+		content.WriteString("\nenter: apply • esc: cancel")
+	case "delete":
+		if t.batchTab == models.TabPods {
+			content.WriteString("Pods owned by a controller will be recreated.\n\n")
+		}
+		content.WriteString("y/enter: delete • n/esc: cancel")
+	default:
+		content.WriteString("y/enter: confirm • n/esc: cancel")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleBatchKeys handles key input for the batch menu and confirmation
+func (t *TUI) handleBatchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch t.batchAction {
+	case "":
+		switch msg.String() {
+		case "esc", "q", "n", "x":
+			t.closeBatch()
+		default:
+			if action, ok := batchActionKeys[msg.String()]; ok && supportsBatchAction(t.batchTab, action) {
+				t.selectBatchAction(action)
+			}
+		}
+		return t, nil
+
+	case "label":
+		switch msg.String() {
+		case "esc":
+			t.closeBatch()
+			return t, nil
+
+		case "enter":
+			patch, err := batchLabelPatch(t.batchLabelInput.Value())
+			if err != nil {
+				// Keep the prompt open so the labels can be corrected
+				t.batchError = err.Error()
+				return t, nil
+			}
+			tab, refs := t.batchTab, t.batchRefs
+			t.closeBatch()
+			return t, t.runBatch(tab, "label", refs, patch)
+		}
+
+		t.batchError = ""
+		var cmd tea.Cmd
+		t.batchLabelInput, cmd = t.batchLabelInput.Update(msg)
+		return t, cmd
+	}
+
+	switch msg.String() {
+	case "y", "Y", "enter":
+		tab, action, refs := t.batchTab, t.batchAction, t.batchRefs
+		t.closeBatch()
+		return t, t.runBatch(tab, action, refs, nil)
+
+	case "n", "N", "esc", "q":
+		t.closeBatch()
+	}
+	return t, nil
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestBatchMarks(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	for _, name := range []string{"job-1-abc", "job-2-def", "web-1"} {
+		tui.pods = append(tui.pods, resources.PodInfo{ResourceInfo: resources.ResourceInfo{Name: name, Namespace: "shop"}})
+	}
+
+	if tui.toggleBatchMarkAll() {
+		t.Errorf("Expected select all to wait for a first mark")
+	}
+	tui.toggleBatchMark()
+	tui.selectedPod = 1
+	tui.toggleBatchMark()
+	if refs := tui.markedRefs(models.TabPods); len(refs) != 2 || refs[1].Name != "job-2-def" || refs[1].Kind != "Pod" {
+		t.Fatalf("Expected the two first pods marked, got %v", refs)
+	}
+	if cell := tui.batchMarkCell(models.TabPods, "shop", "job-1-abc"); cell != "✓ " {
+		t.Errorf("Expected a mark before the marked pod, got %q", cell)
+	}
+	if legend := tui.batchLegend(models.TabPods); legend != "  ✓ 2 marked" {
+		t.Errorf("Expected the marked pods counted in the title, got %q", legend)
+	}
+
+	// Pods deleted since they were marked are left out
+	tui.pods = tui.pods[1:]
+	if refs := tui.markedRefs(models.TabPods); len(refs) != 1 {
+		t.Errorf("Expected the deleted pod left out, got %v", refs)
+	}
+
+	tui.toggleBatchMarkAll()
+	if refs := tui.markedRefs(models.TabPods); len(refs) != 2 {
+		t.Errorf("Expected every listed pod marked, got %v", refs)
+	}
+	tui.toggleBatchMarkAll()
+	if refs := tui.markedRefs(models.TabPods); len(refs) != 0 {
+		t.Errorf("Expected select all to unmark every pod once all are marked, got %v", refs)
+	}
+
+	// Tabs without batch actions keep space for the details
+	tui.ActiveTab = models.TabServices
+	tui.services = []resources.ServiceInfo{{ResourceInfo: resources.ResourceInfo{Name: "web"}}}
+	if tui.toggleBatchMark() {
+		t.Errorf("Expected no marks on the services tab")
+	}
+}
+
+func TestBatchConfirmation(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	tui.ActiveTab = models.TabJobs
+	for _, name := range []string{"backup-1", "backup-2"} {
+		tui.jobs = append(tui.jobs, resources.JobInfo{ResourceInfo: resources.ResourceInfo{Name: name, Namespace: "shop"}})
+	}
+
+	if tui.openBatch(""); tui.showBatch {
+		t.Fatalf("Expected no batch without marks")
+	}
+	tui.toggleBatchMark()
+	if tui.openBatch("restart") {
+		t.Errorf("Expected jobs not to be restarted")
+	}
+
+	tui.openBatch("")
+	tui.handleBatchKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if tui.batchAction != "" {
+		t.Errorf("Expected restart not to be offered for jobs")
+	}
+	tui.handleBatchKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	rendered := tui.renderBatch()
+	for _, want := range []string{"Delete 1 job", "backup-1", "y/enter: delete"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the confirmation to contain %q, got %q", want, rendered)
+		}
+	}
+
+	tui.handleBatchKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showBatch || len(tui.markedRefs(models.TabJobs)) != 1 {
+		t.Errorf("Expected esc to cancel the batch and keep the marks")
+	}
+}
+
+func TestBatchLabelPatch(t *testing.T) {
+	patch, err := batchLabelPatch("team=shop, tier=web stale-")
+	if err != nil {
+		t.Fatalf("Expected valid label changes, got %v", err)
+	}
+	if want := `{"metadata":{"labels":{"stale":null,"team":"shop","tier":"web"}}}`; string(patch) != want {
+		t.Errorf("Expected %s, got %s", want, patch)
+	}
+
+	for _, input := range []string{"", "team", "bad key=x", "team=no spaces!"} {
+		if _, err := batchLabelPatch(input); err == nil {
+			t.Errorf("Expected %q to be rejected", input)
+		}
+	}
+}
+
+func TestBatchTitle(t *testing.T) {
+	refs := []resourceRef{{Kind: "Pod", Name: "a"}, {Kind: "Pod", Name: "b"}}
+	if title := batchTitle("delete", refs); title != "Delete 2 pods" {
+		t.Errorf("Expected \"Delete 2 pods\", got %q", title)
+	}
+	if title := batchTitle("restart", refs[:1]); title != "Restart 1 pod" {
+		t.Errorf("Expected \"Restart 1 pod\", got %q", title)
+	}
+}
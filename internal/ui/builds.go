@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// startBuild instantiates a new Build from the selected BuildConfig
+func (t *TUI) startBuild() tea.Cmd {
+	if !t.connected || t.selectedBuildConfig < 0 || t.selectedBuildConfig >= len(t.buildConfigs) {
+		return nil
+	}
+
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	if !ok || !osClient.IsOpenShift() {
+		t.logWarn(categoryAction, "Builds can only be started on OpenShift clusters")
+		return nil
+	}
+
+	bc := t.buildConfigs[t.selectedBuildConfig]
+	namespace := t.resourceNamespace(bc.Namespace)
+	t.logInfo(categoryAction, "Starting build for BuildConfig %s...", bc.Name)
+
+	var build *resources.BuildInfo
+	return t.runTask(fmt.Sprintf("Start build %s", bc.Name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			var err error
+			build, err = resources.NewOpenShiftResourceClient(osClient).StartBuild(ctx, namespace, bc.Name)
+			return err
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to start build for %s: %v", bc.Name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Started build %s", build.Name)
+			return tea.Batch(t.loadBuildConfigs(), t.loadBuilds())
+		})
+}
+
+// loadBuilds loads OpenShift Builds from the current namespace
+func (t *TUI) loadBuilds() tea.Cmd {
+	return func() tea.Msg {
+		osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+		if !ok || !osClient.IsOpenShift() {
+			return messages.BuildsLoadError{Err: fmt.Errorf("not connected to an OpenShift cluster")}
+		}
+
+		resourceClient := resources.NewOpenShiftResourceClient(osClient)
+		listOpts := t.listOptions()
+
+		buildList, err := loadAllPages(t, "builds", listOpts, resourceClient.ListBuilds)
+		if err != nil {
+			return messages.BuildsLoadError{Err: err}
+		}
+
+		// Newest builds first
+		items := buildList.Items
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].CreatedAt.After(items[j].CreatedAt)
+		})
+
+		return messages.BuildsLoaded{Builds: items}
+	}
+}
+
+// buildPhaseStyle returns the row style for a build phase
+func buildPhaseStyle(phase string) lipgloss.Style {
+	switch phase {
+	case "Complete":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("46"))
+	case "Failed", "Error":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	case "Running", "Pending", "New":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	default:
+		return lipgloss.NewStyle()
+	}
+}
+
+// shortCommit abbreviates a git commit hash
+func shortCommit(commit string) string {
+	if len(commit) > constants.ShortCommitLength {
+		return commit[:constants.ShortCommitLength]
+	}
+	return commit
+}
+
+// updateBuildDisplay updates the main content with Build information
+func (t *TUI) updateBuildDisplay() {
+	if t.loadingBuilds {
+		t.mainContent = "🏗️ Builds\n\nLoading Builds..."
+		return
+	}
+
+	if len(t.builds) == 0 {
+		t.mainContent = "🏗️ Builds\n\nNo Builds found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🏗️ Builds\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-12s %-12s %-10s %s", "NAME", "PHASE", "DURATION", "COMMIT", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 80))
+	content.WriteString("\n")
+
+	// Build rows
+	start, end := t.listWindow(t.selectedBuild, len(t.builds))
+	for i := start; i < end; i++ {
+		build := t.builds[i]
+		style := buildPhaseStyle(build.Phase)
+		if i == t.selectedBuild {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		row := t.namespaceCell(build.Namespace) + fmt.Sprintf("%-30s %-12s %-12s %-10s %s",
+			truncateString(build.Name, 30),
+			build.Phase,
+			build.Duration,
+			shortCommit(build.Commit),
+			t.formatTime(build.CreatedAt, build.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.builds)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to stream build logs • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected Build info
+	if t.selectedBuild < len(t.builds) && t.selectedBuild >= 0 {
+		t.updateBuildDetails(t.builds[t.selectedBuild])
+	}
+}
+
+// updateBuildDetails updates the detail pane with Build information
+func (t *TUI) updateBuildDetails(build resources.BuildInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🏗️ Build Details: %s\n\n", build.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", build.Namespace))
+	details.WriteString(fmt.Sprintf("Phase:        %s\n", build.Phase))
+	details.WriteString(fmt.Sprintf("BuildConfig:  %s\n", build.BuildConfig))
+	details.WriteString(fmt.Sprintf("Strategy:     %s\n", build.Strategy))
+	details.WriteString(fmt.Sprintf("Duration:     %s\n", build.Duration))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(build.CreatedAt, build.Age)))
+	if build.Commit != "" {
+		details.WriteString(fmt.Sprintf("Commit:       %s\n", build.Commit))
+	}
+	if build.OutputImage != "" {
+		details.WriteString(fmt.Sprintf("\nOutput Image:\n  %s\n", build.OutputImage))
+	}
+	if build.Message != "" {
+		details.WriteString(fmt.Sprintf("\nMessage:      %s\n", build.Message))
+	}
+
+	t.detailContent = details.String()
+}
+
+// startBuildLogStream follows the selected build's logs in the log panel
+func (t *TUI) startBuildLogStream() tea.Cmd {
+	if t.selectedBuild < 0 || t.selectedBuild >= len(t.builds) {
+		return nil
+	}
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	if !ok || !osClient.IsOpenShift() {
+		return nil
+	}
+
+	t.stopBuildLogStream()
+
+	build := t.builds[t.selectedBuild]
+	ctx, cancel := context.WithCancel(context.Background())
+	t.buildLogCancel = cancel
+	t.buildLogName = build.Name
+	t.buildLogs = []string{}
+	t.buildLogStreaming = true
+	t.showLogs = true
+	t.logViewMode = constants.BuildLogViewMode
+	namespace := t.resourceNamespace(build.Namespace)
+
+	return func() tea.Msg {
+		logChan, err := resources.NewOpenShiftResourceClient(osClient).StreamBuildLogs(ctx, namespace, build.Name, !t.safeMode)
+		if err != nil {
+			return messages.BuildLogStreamEnded{Build: build.Name, Err: err}
+		}
+
+		for line := range logChan {
+			if t.program != nil {
+				t.program.Send(messages.BuildLogStreamUpdate{Build: build.Name, LogLine: line})
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+		return messages.BuildLogStreamEnded{Build: build.Name}
+	}
+}
+
+// stopBuildLogStream cancels the active build log stream
+func (t *TUI) stopBuildLogStream() {
+	if t.buildLogCancel != nil {
+		t.buildLogCancel()
+		t.buildLogCancel = nil
+	}
+	t.buildLogStreaming = false
+}
+
+// handleBuildLogLine appends a streamed build log line
+func (t *TUI) handleBuildLogLine(msg messages.BuildLogStreamUpdate) {
+	if msg.Build != t.buildLogName {
+		return
+	}
+	t.buildLogs = append(t.buildLogs, msg.LogLine)
+	if len(t.buildLogs) > constants.MaxLogLines {
+		t.buildLogs = t.buildLogs[len(t.buildLogs)-constants.MaxLogLines:]
+	}
+}
+
+// handleBuildLogEnded records the end of a build log stream and refreshes the build list
+func (t *TUI) handleBuildLogEnded(msg messages.BuildLogStreamEnded) tea.Cmd {
+	if msg.Build != t.buildLogName {
+		return nil
+	}
+	t.buildLogStreaming = false
+	t.buildLogCancel = nil
+
+	if msg.Err != nil {
+		t.buildLogs = append(t.buildLogs, fmt.Sprintf("Failed to stream build logs: %v", msg.Err))
+		t.logError(categoryResource, "Failed to stream logs for build %s: %v", msg.Build, msg.Err)
+		return nil
+	}
+
+	if t.safeMode {
+		t.buildLogs = append(t.buildLogs, fmt.Sprintf("--- safe mode: logs of build %s are not followed, press enter to read them again ---", msg.Build))
+		return nil
+	}
+	t.buildLogs = append(t.buildLogs, fmt.Sprintf("--- end of logs for build %s ---", msg.Build))
+	return t.loadBuilds()
+}
+
+// renderBuildLogs returns the log panel text and header for the streamed build logs
+func (t *TUI) renderBuildLogs(maxLines int) (string, string) {
+	if t.buildLogName == "" {
+		return "🏗️ Select a build and press enter to stream its logs", "🏗️ Build Logs"
+	}
+
+	header := fmt.Sprintf("🏗️ Build Logs: %s", t.buildLogName)
+	if t.buildLogStreaming {
+		header += " [FOLLOW]"
+	}
+	if len(t.buildLogs) == 0 {
+		if t.buildLogStreaming {
+			return "🔄 Waiting for build logs...", header
+		}
+		return fmt.Sprintf("🏗️ No logs available for build '%s'", t.buildLogName), header
+	}
+
+	start := max(0, len(t.buildLogs)-max(1, maxLines))
+	lines := make([]string, 0, len(t.buildLogs)-start)
+	for _, line := range t.buildLogs[start:] {
+		lines = append(lines, t.colorizePodLog(line))
+	}
+	return strings.Join(lines, "\n"), header
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestBuildLogStreamLines(t *testing.T) {
+	tui := &TUI{
+		App:          models.NewApp("test"),
+		buildLogName: "app-3",
+	}
+
+	tui.handleBuildLogLine(messages.BuildLogStreamUpdate{Build: "app-2", LogLine: "stale"})
+	if len(tui.buildLogs) != 0 {
+		t.Fatalf("Expected lines from other builds to be ignored, got %v", tui.buildLogs)
+	}
+
+	for i := 0; i < constants.MaxLogLines+5; i++ {
+		tui.handleBuildLogLine(messages.BuildLogStreamUpdate{Build: "app-3", LogLine: "step"})
+	}
+	if len(tui.buildLogs) != constants.MaxLogLines {
+		t.Errorf("Expected build logs to be capped at %d lines, got %d", constants.MaxLogLines, len(tui.buildLogs))
+	}
+
+	tui.buildLogStreaming = true
+	if cmd := tui.handleBuildLogEnded(messages.BuildLogStreamEnded{Build: "app-3"}); cmd == nil {
+		t.Errorf("Expected the build list to be refreshed when the stream ends")
+	}
+	if tui.buildLogStreaming {
+		t.Errorf("Expected streaming to stop when the stream ends")
+	}
+	if last := tui.buildLogs[len(tui.buildLogs)-1]; !strings.Contains(last, "end of logs") {
+		t.Errorf("Expected an end-of-logs marker, got %q", last)
+	}
+}
+
+func TestShortCommit(t *testing.T) {
+	if got := shortCommit("0123456789abcdef"); got != "0123456" {
+		t.Errorf("Expected abbreviated commit, got %q", got)
+	}
+	if got := shortCommit("abc"); got != "abc" {
+		t.Errorf("Expected short commit unchanged, got %q", got)
+	}
+}
@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// cascadeKinds are the kinds deleted with a preview of their dependents
+var cascadeKinds = map[string]bool{
+	"Deployment": true, "StatefulSet": true, "DaemonSet": true, "ReplicaSet": true,
+	"Job": true, "CronJob": true, "DeploymentConfig": true,
+}
+
+// cascadePropagations are the deletion modes cycled in the confirmation
+var cascadePropagations = []metav1.DeletionPropagation{
+	metav1.DeletePropagationBackground,
+	metav1.DeletePropagationForeground,
+	metav1.DeletePropagationOrphan,
+}
+
+// openCascadeDelete asks for confirmation before deleting the selected
+// workload and loads the dependents the garbage collector deletes with it
+func (t *TUI) openCascadeDelete() tea.Cmd {
+	ref, ok := t.selectedResource()
+	if !ok || !cascadeKinds[ref.Kind] || !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	t.cascadeKind, t.cascadeNamespace, t.cascadeName = ref.Kind, ref.Namespace, ref.Name
+	t.cascadePlan = nil
+	t.cascadePlanErr = nil
+	t.cascadePropagation = metav1.DeletePropagationBackground
+	t.loadingCascadePlan = true
+	t.showCascadeDelete = true
+
+	client := t.resourceClient
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		plan, err := client.PlanCascade(ctx, ref.Kind, ref.Namespace, ref.Name)
+		return messages.CascadePlanLoaded{Kind: ref.Kind, Name: ref.Name, Plan: plan, Err: err}
+	}
+}
+
+// handleCascadePlanLoaded shows the dependents in the confirmation
+func (t *TUI) handleCascadePlanLoaded(msg messages.CascadePlanLoaded) {
+	if !t.showCascadeDelete || t.cascadeKind != msg.Kind || t.cascadeName != msg.Name {
+		return
+	}
+	t.loadingCascadePlan = false
+	t.cascadePlan = msg.Plan
+	t.cascadePlanErr = msg.Err
+}
+
+// closeCascadeDelete dismisses the confirmation
+func (t *TUI) closeCascadeDelete() {
+	t.showCascadeDelete = false
+	t.cascadeKind, t.cascadeNamespace, t.cascadeName = "", "", ""
+	t.cascadePlan = nil
+	t.cascadePlanErr = nil
+	t.loadingCascadePlan = false
+}
+
+// cycleCascadePropagation switches to the next deletion mode
+func (t *TUI) cycleCascadePropagation() {
+	for i, propagation := range cascadePropagations {
+		if propagation == t.cascadePropagation {
+			t.cascadePropagation = cascadePropagations[(i+1)%len(cascadePropagations)]
+			return
+		}
+	}
+	t.cascadePropagation = metav1.DeletePropagationBackground
+}
+
+// cascadeDescription explains what a deletion mode does with the dependents
+func cascadeDescription(propagation metav1.DeletionPropagation) string {
+	switch propagation {
+	case metav1.DeletePropagationForeground:
+		return "Dependents are deleted first, the object stays until they are gone"
+	case metav1.DeletePropagationOrphan:
+		return "Dependents keep running without an owner"
+	default:
+		return "The object is deleted now, its dependents right after"
+	}
+}
+
+// deleteWithCascade deletes a workload as a background task
+func (t *TUI) deleteWithCascade(kind, namespace, name string, propagation metav1.DeletionPropagation) tea.Cmd {
+	if !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	client := t.resourceClient
+	t.logInfo(categoryAction, "Deleting %s %s (%s)...", kind, name, strings.ToLower(string(propagation)))
+
+	return t.runTask(fmt.Sprintf("Delete %s %s", strings.ToLower(kind), name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return client.DeleteResource(ctx, kind, namespace, name, propagation)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to delete %s %s: %v", kind, name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Deleted %s %s", kind, name)
+			return t.refreshTab(int(t.ActiveTab))
+		})
+}
+
+// cascadeTreeLines renders the dependents of an object as an indented tree
+func cascadeTreeLines(tree resources.DependentTree, indent string, lines []string) []string {
+	for i, child := range tree.Children {
+		branch, next := "├─ ", "│  "
+		if i == len(tree.Children)-1 {
+			branch, next = "└─ ", "   "
+		}
+		lines = append(lines, fmt.Sprintf("%s%s%s/%s", indent, branch, child.Kind, child.Name))
+		lines = cascadeTreeLines(child, indent+next, lines)
+	}
+	return lines
+}
+
+// renderCascadeDelete renders the workload delete confirmation
+func (t *TUI) renderCascadeDelete() string {
+	modalWidth := min(80, t.width-4)
+	red := lipgloss.Color("9")
+
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(red).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Foreground(red).Render("🗑️ Delete "+t.cascadeKind) + "\n\n")
+	content.WriteString(fmt.Sprintf("%s: %s\n", t.cascadeKind, t.cascadeName))
+	content.WriteString(fmt.Sprintf("Namespace: %s\n\n", t.cascadeNamespace))
+
+	switch {
+	case t.loadingCascadePlan:
+		content.WriteString(fmt.Sprintf("%s Finding dependents...\n\n", t.getLoadingSpinner()))
+		content.WriteString("esc: cancel")
+	case t.cascadePlanErr != nil:
+		content.WriteString(lipgloss.NewStyle().Foreground(red).
+			Render(truncateString(t.cascadePlanErr.Error(), modalWidth-8)) + "\n\n")
+		content.WriteString("esc: close")
+	default:
+		content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("Mode: %s", t.cascadePropagation)) + "\n")
+		content.WriteString(cascadeDescription(t.cascadePropagation) + "\n\n")
+
+		lines := cascadeTreeLines(*t.cascadePlan, "  ", nil)
+		if len(lines) == 0 {
+			content.WriteString("No dependents\n")
+		} else {
+			verb := "Deleted along with it"
+			if t.cascadePropagation == metav1.DeletePropagationOrphan {
+				verb = "Left running"
+			}
+			content.WriteString(fmt.Sprintf("%s: %d object(s)\n", verb, t.cascadePlan.Count()))
+			for i, line := range lines {
+				if i == constants.MaxCascadeLinesShown {
+					content.WriteString(fmt.Sprintf("  … and %d more\n", len(lines)-i))
+					break
+				}
+				content.WriteString(truncateString(line, modalWidth-8) + "\n")
+			}
+		}
+		content.WriteString("\ny/enter: delete • tab: change mode • n/esc: cancel")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleCascadeDeleteKeys handles key input for the workload delete confirmation
+func (t *TUI) handleCascadeDeleteKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y", "enter":
+		if t.cascadePlan == nil {
+			return t, nil
+		}
+		kind, namespace, name, propagation := t.cascadeKind, t.cascadeNamespace, t.cascadeName, t.cascadePropagation
+		t.closeCascadeDelete()
+		return t, t.deleteWithCascade(kind, namespace, name, propagation)
+
+	case "tab":
+		t.cycleCascadePropagation()
+
+	case "n", "N", "esc", "q":
+		t.closeCascadeDelete()
+	}
+
+	return t, nil
+}
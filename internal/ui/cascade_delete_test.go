@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestCascadeDelete(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 120, height: 40}
+	tui.showCascadeDelete = true
+	tui.loadingCascadePlan = true
+	tui.cascadeKind, tui.cascadeNamespace, tui.cascadeName = "Deployment", "demo", "web"
+	tui.cascadePropagation = metav1.DeletePropagationBackground
+
+	// Plans for another object are dropped
+	tui.Update(messages.CascadePlanLoaded{Kind: "Deployment", Name: "api", Plan: &resources.DependentTree{}})
+	if !tui.loadingCascadePlan {
+		t.Fatalf("Expected the plan of another deployment to be ignored")
+	}
+
+	tui.Update(messages.CascadePlanLoaded{Kind: "Deployment", Name: "web", Plan: &resources.DependentTree{
+		Kind: "Deployment", Name: "web",
+		Children: []resources.DependentTree{
+			{Kind: "ReplicaSet", Name: "web-5c9", Children: []resources.DependentTree{{Kind: "Pod", Name: "web-5c9-a"}}},
+			{Kind: "ReplicaSet", Name: "web-7d4"},
+		},
+	}})
+
+	rendered := tui.renderCascadeDelete()
+	for _, want := range []string{"Mode: Background", "Deleted along with it: 3 object(s)", "├─ ReplicaSet/web-5c9", "│  └─ Pod/web-5c9-a", "└─ ReplicaSet/web-7d4"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the preview to contain %q, got %q", want, rendered)
+		}
+	}
+
+	tui.handleCascadeDeleteKeys(tea.KeyMsg{Type: tea.KeyTab})
+	tui.handleCascadeDeleteKeys(tea.KeyMsg{Type: tea.KeyTab})
+	if rendered := tui.renderCascadeDelete(); !strings.Contains(rendered, "Mode: Orphan") || !strings.Contains(rendered, "Left running: 3 object(s)") {
+		t.Errorf("Expected orphan mode after two tabs, got %q", rendered)
+	}
+
+	tui.handleCascadeDeleteKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showCascadeDelete || tui.cascadePlan != nil {
+		t.Errorf("Expected esc to cancel the delete")
+	}
+
+	// Kinds without dependents are not offered
+	tui.ActiveTab = models.TabServices
+	tui.services = []resources.ServiceInfo{{ResourceInfo: resources.ResourceInfo{Name: "web"}}}
+	if tui.openCascadeDelete(); tui.showCascadeDelete {
+		t.Errorf("Expected no cascade delete for services")
+	}
+}
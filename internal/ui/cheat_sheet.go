@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// CheatSheetFormat is a document format of the keybinding cheat sheet
+type CheatSheetFormat string
+
+const (
+	// CheatSheetMarkdown is a Markdown document with a table per section,
+	// for wikis that render GitHub flavored Markdown
+	CheatSheetMarkdown CheatSheetFormat = "md"
+
+	// CheatSheetPrintable is a standalone HTML page laid out for A4 paper,
+	// printed to PDF by a browser or a tool such as wkhtmltopdf
+	CheatSheetPrintable CheatSheetFormat = "pdf-ready"
+)
+
+// ParseCheatSheetFormat parses the --format flag of lazyoc keys
+func ParseCheatSheetFormat(value string) (CheatSheetFormat, error) {
+	switch format := CheatSheetFormat(strings.ToLower(value)); format {
+	case CheatSheetMarkdown, CheatSheetPrintable:
+		return format, nil
+	}
+	return "", fmt.Errorf("unknown cheat sheet format %q, use %s or %s", value, CheatSheetMarkdown, CheatSheetPrintable)
+}
+
+// CheatSheet renders the keymap the help shows as a document for team
+// wikis. The recorded macros, keyed by register, follow as the keys the
+// user added.
+func CheatSheet(format CheatSheetFormat, macros map[string][]string) string {
+	sections := keymap
+	if len(macros) > 0 {
+		sections = append(sections[:len(sections):len(sections)], macroSection(macros))
+	}
+	if format == CheatSheetPrintable {
+		return printableCheatSheet(sections)
+	}
+	return markdownCheatSheet(sections)
+}
+
+// macroSection lists the recorded macros by register
+func macroSection(macros map[string][]string) keySection {
+	registers := make([]string, 0, len(macros))
+	for register := range macros {
+		registers = append(registers, register)
+	}
+	sort.Strings(registers)
+
+	section := keySection{Title: "Macros"}
+	for _, register := range registers {
+		section.Bindings = append(section.Bindings, keyBinding{
+			Key:         "@" + register,
+			Description: "Replay " + strings.Join(macros[register], " "),
+		})
+	}
+	return section
+}
+
+// markdownCheatSheet renders the sections as Markdown tables
+func markdownCheatSheet(sections []keySection) string {
+	// escape keeps a | of a key or description from splitting a table cell
+	escape := func(s string) string {
+		return strings.ReplaceAll(s, "|", `\|`)
+	}
+
+	var b strings.Builder
+	b.WriteString("# LazyOC Keybindings\n")
+	for _, section := range sections {
+		b.WriteString("\n## " + section.Title + "\n\n")
+		b.WriteString("| Key | Action |\n| --- | --- |\n")
+		for _, binding := range section.Bindings {
+			description := strings.ReplaceAll(escape(binding.Description), "\n", "<br>")
+			b.WriteString(fmt.Sprintf("| `%s` | %s |\n", escape(binding.Key), description))
+		}
+	}
+	return b.String()
+}
+
+// printableCheatSheet renders the sections as an HTML page in two columns,
+// keeping each section on one page
+func printableCheatSheet(sections []keySection) string {
+	var b strings.Builder
+	b.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>LazyOC Keybindings</title>
+<style>
+@page { size: A4; margin: 12mm; }
+body { font: 9pt sans-serif; column-count: 2; column-gap: 8mm; }
+h1 { column-span: all; font-size: 16pt; }
+section { break-inside: avoid; margin-bottom: 4mm; }
+h2 { font-size: 11pt; margin: 0 0 1mm; }
+table { border-collapse: collapse; width: 100%; }
+td { border-top: 1px solid #ccc; padding: 1mm; vertical-align: top; }
+td:first-child { white-space: nowrap; }
+kbd { font: 8.5pt monospace; background: #eee; border-radius: 2px; padding: 0 1mm; }
+</style>
+</head>
+<body>
+<h1>LazyOC Keybindings</h1>
+`)
+	for _, section := range sections {
+		b.WriteString("<section>\n<h2>" + html.EscapeString(section.Title) + "</h2>\n<table>\n")
+		for _, binding := range section.Bindings {
+			description := strings.ReplaceAll(html.EscapeString(binding.Description), "\n", "<br>")
+			b.WriteString(fmt.Sprintf("<tr><td><kbd>%s</kbd></td><td>%s</td></tr>\n", html.EscapeString(binding.Key), description))
+		}
+		b.WriteString("</table>\n</section>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheatSheetMarkdown(t *testing.T) {
+	sheet := CheatSheet(CheatSheetMarkdown, map[string][]string{"w": {"/", "w", "e", "b", "enter"}, "a": {"0"}})
+
+	for _, expected := range []string{
+		"## Navigation\n\n| Key | Action |\n| --- | --- |\n| `tab` | Next panel |",
+		"| `\\|` | Compare the current tab across both clusters side by side |",
+		"(pods tab)<br>/ drain selected node",
+		"## Macros\n\n| Key | Action |\n| --- | --- |\n| `@a` | Replay 0 |\n| `@w` | Replay / w e b enter |",
+	} {
+		if !strings.Contains(sheet, expected) {
+			t.Errorf("Expected the cheat sheet to contain %q", expected)
+		}
+	}
+	if strings.Contains(CheatSheet(CheatSheetMarkdown, nil), "## Macros") {
+		t.Error("Expected no macro section without macros")
+	}
+}
+
+func TestCheatSheetPrintable(t *testing.T) {
+	sheet := CheatSheet(CheatSheetPrintable, map[string][]string{"x": {"<", "enter"}})
+
+	if !strings.HasPrefix(sheet, "<!DOCTYPE html>") || !strings.Contains(sheet, "@page { size: A4;") {
+		t.Errorf("Expected a printable HTML page, got %q", sheet[:min(len(sheet), 80)])
+	}
+	if !strings.Contains(sheet, "<tr><td><kbd>@x</kbd></td><td>Replay &lt; enter</td></tr>") {
+		t.Error("Expected the macro keys to be escaped")
+	}
+	if strings.Count(sheet, "<section>") != len(keymap)+1 {
+		t.Errorf("Expected a section per keymap section and the macros, got %d", strings.Count(sheet, "<section>"))
+	}
+}
+
+func TestHelpTextFromKeymap(t *testing.T) {
+	help := helpText()
+	for _, section := range keymap {
+		for _, binding := range section.Bindings {
+			if !strings.Contains(help, "  "+binding.Key) {
+				t.Errorf("Expected the help to show %q", binding.Key)
+			}
+		}
+	}
+	if !strings.Contains(help, "  ctrl+d     Delete, evict or force delete the selected pod with a grace period (pods tab)\n             / drain selected node (nodes tab)\n") {
+		t.Error("Expected the further lines of a key to be indented under its description")
+	}
+}
+
+func TestParseCheatSheetFormat(t *testing.T) {
+	if format, err := ParseCheatSheetFormat("PDF-READY"); err != nil || format != CheatSheetPrintable {
+		t.Errorf("Expected pdf-ready, got %q, %v", format, err)
+	}
+	if _, err := ParseCheatSheetFormat("pdf"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,193 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadClusterInfo fetches the API URL and versions of the cluster and, on
+// OpenShift, its console URL, platform and identity providers
+func (t *TUI) loadClusterInfo() tea.Cmd {
+	k8sClient, resourceClient := t.k8sClient, t.resourceClient
+	return func() tea.Msg {
+		if k8sClient == nil || resourceClient == nil {
+			return messages.ClusterInfoError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		details := &resources.ClusterDetails{}
+		if config := k8sClient.GetConfig(); config != nil {
+			details.APIURL = config.Host
+		}
+
+		info, err := resourceClient.GetServerInfo(ctx)
+		if err != nil {
+			details.Unavailable = append(details.Unavailable, fmt.Sprintf("kubernetes version: %v", err))
+		} else {
+			details.KubernetesVersion, _ = info["version"].(string)
+		}
+
+		// The Kubernetes version is still shown when the OpenShift config is
+		// not readable
+		if osClient, ok := k8sClient.(k8s.OpenShiftClient); ok && osClient.IsOpenShift() {
+			if err := resources.NewOpenShiftResourceClient(osClient).AddClusterDetails(ctx, details); err != nil {
+				details.OpenShift = true
+				details.Unavailable = append(details.Unavailable, fmt.Sprintf("openshift config: %v", err))
+			}
+		}
+
+		return messages.ClusterInfoLoaded{Version: clusterVersionLabel(details), Details: details}
+	}
+}
+
+// clusterVersionLabel returns the version shown in the status bar, e.g.
+// "OpenShift 4.16.3" or "OpenShift ~4.16" when estimated, or "" when no
+// version could be read
+func clusterVersionLabel(details *resources.ClusterDetails) string {
+	switch {
+	case details.VersionEstimated:
+		return "OpenShift ~" + details.OpenShiftVersion
+	case details.OpenShiftVersion != "":
+		return "OpenShift " + strings.Fields(details.OpenShiftVersion)[0]
+	case details.KubernetesVersion != "":
+		return "Kubernetes " + details.KubernetesVersion
+	}
+	return ""
+}
+
+// handleClusterInfoLoaded stores the cluster info
+func (t *TUI) handleClusterInfoLoaded(msg messages.ClusterInfoLoaded) {
+	if msg.Version != "" && msg.Version != t.clusterVersion {
+		t.logInfo(categoryConnection, "Cluster version: %s", msg.Version)
+	}
+	t.clusterVersion = msg.Version
+	t.clusterDetails = msg.Details
+	t.loadingClusterInfo = false
+	t.clusterInfoError = ""
+}
+
+// handleClusterInfoError records a failed load of the cluster info
+func (t *TUI) handleClusterInfoError(msg messages.ClusterInfoError) {
+	t.logError(categoryConnection, "Failed to load cluster info: %v", msg.Err)
+	t.loadingClusterInfo = false
+	t.clusterInfoError = msg.Err.Error()
+}
+
+// openClusterInfo shows the cluster info view and reloads it
+func (t *TUI) openClusterInfo() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showClusterInfo = true
+	t.loadingClusterInfo = true
+	t.clusterInfoError = ""
+	return t.loadClusterInfo()
+}
+
+// clusterInfoLines renders the cluster details as label and value lines
+func clusterInfoLines(details *resources.ClusterDetails) []string {
+	orNone := func(value string) string {
+		if value == "" {
+			return "-"
+		}
+		return value
+	}
+
+	lines := []string{
+		fmt.Sprintf("API URL:        %s", orNone(details.APIURL)),
+		fmt.Sprintf("Kubernetes:     %s", orNone(details.KubernetesVersion)),
+	}
+	if !details.OpenShift {
+		return append(lines, "", "Not an OpenShift cluster, the console, platform and identity providers are OpenShift only")
+	}
+
+	version := orNone(details.OpenShiftVersion)
+	if details.VersionEstimated {
+		version += ".x (estimated from the Kubernetes version, the ClusterVersion is not readable)"
+	}
+	if details.Channel != "" {
+		version += fmt.Sprintf(" (channel %s)", details.Channel)
+	}
+	platform := orNone(details.Platform)
+	if details.ControlPlaneTopology != "" {
+		platform += fmt.Sprintf(" (%s control plane)", details.ControlPlaneTopology)
+	}
+	lines = append(lines,
+		fmt.Sprintf("OpenShift:      %s", version),
+		fmt.Sprintf("Console:        %s", orNone(details.ConsoleURL)),
+		fmt.Sprintf("Platform:       %s", platform),
+		fmt.Sprintf("Infrastructure: %s", orNone(details.InfrastructureName)),
+		"",
+		"Identity providers:",
+	)
+
+	if len(details.IdentityProviders) == 0 {
+		lines = append(lines, "  none configured")
+	}
+	for _, idp := range details.IdentityProviders {
+		lines = append(lines, strings.TrimRight(fmt.Sprintf("  %-25s %-15s %s", truncateString(idp.Name, 25), idp.Type, idp.MappingMethod), " "))
+	}
+	return lines
+}
+
+// renderClusterInfoView renders the cluster info view
+func (t *TUI) renderClusterInfoView() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(100, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("ℹ️ Cluster Info: "+t.obfuscateClusterContext(t.context)) + "\n\n")
+
+	switch {
+	case t.loadingClusterInfo && t.clusterDetails == nil:
+		content.WriteString(fmt.Sprintf("%s Loading cluster info...\n", t.getLoadingSpinner()))
+	case t.clusterInfoError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.clusterInfoError, modalWidth-10)) + "\n")
+	case t.clusterDetails != nil:
+		for _, line := range clusterInfoLines(t.clusterDetails) {
+			content.WriteString(line + "\n")
+		}
+		if len(t.clusterDetails.Unavailable) > 0 {
+			content.WriteString("\nNot readable with your permissions or on this cluster:\n")
+			for _, reason := range t.clusterDetails.Unavailable {
+				content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("  "+truncateString(reason, modalWidth-10)) + "\n")
+			}
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("r: reload • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleClusterInfoKeys handles key input for the cluster info view
+func (t *TUI) handleClusterInfoKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "w":
+		t.showClusterInfo = false
+
+	case "r":
+		return t, t.openClusterInfo()
+	}
+
+	return t, nil
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestClusterInfoView(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 120, height: 40, showClusterInfo: true, loadingClusterInfo: true}
+	if rendered := tui.renderClusterInfoView(); !strings.Contains(rendered, "Loading cluster info") {
+		t.Errorf("Expected a loading state, got %q", rendered)
+	}
+
+	details := &resources.ClusterDetails{
+		APIURL:            "https://api.shop.example.com:6443",
+		KubernetesVersion: "v1.29.6+aba1e8d",
+		OpenShift:         true,
+		OpenShiftVersion:  "4.16.3",
+		Channel:           "stable-4.16",
+		ConsoleURL:        "https://console-openshift-console.apps.shop.example.com",
+		Platform:          "AWS",
+		IdentityProviders: []resources.IdentityProviderInfo{{Name: "corp-sso", Type: "OpenID", MappingMethod: "claim"}},
+		Unavailable:       []string{"infrastructure: forbidden"},
+	}
+	tui.handleClusterInfoLoaded(messages.ClusterInfoLoaded{Version: clusterVersionLabel(details), Details: details})
+	if tui.clusterVersion != "OpenShift 4.16.3" {
+		t.Errorf("Expected the OpenShift version in the status bar, got %q", tui.clusterVersion)
+	}
+
+	rendered := tui.renderClusterInfoView()
+	for _, want := range []string{"https://api.shop.example.com:6443", "4.16.3 (channel stable-4.16)", "console-openshift-console", "corp-sso", "OpenID", "infrastructure: forbidden"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the cluster info to contain %q, got %q", want, rendered)
+		}
+	}
+
+	tui.handleClusterInfoError(messages.ClusterInfoError{Err: errors.New("connection refused")})
+	if rendered := tui.renderClusterInfoView(); !strings.Contains(rendered, "connection refused") {
+		t.Errorf("Expected the load error, got %q", rendered)
+	}
+}
+
+func TestClusterInfoLines(t *testing.T) {
+	details := &resources.ClusterDetails{APIURL: "https://127.0.0.1:6443", KubernetesVersion: "v1.30.2"}
+	lines := strings.Join(clusterInfoLines(details), "\n")
+	if !strings.Contains(lines, "v1.30.2") || !strings.Contains(lines, "Not an OpenShift cluster") {
+		t.Errorf("Expected the Kubernetes version and an OpenShift note, got:\n%s", lines)
+	}
+	if label := clusterVersionLabel(details); label != "Kubernetes v1.30.2" {
+		t.Errorf("Expected the Kubernetes version label, got %q", label)
+	}
+
+	details = &resources.ClusterDetails{OpenShift: true, OpenShiftVersion: "4.16.3 (updating to 4.16.5)"}
+	if label := clusterVersionLabel(details); label != "OpenShift 4.16.3" {
+		t.Errorf("Expected the running version only, got %q", label)
+	}
+	if lines := strings.Join(clusterInfoLines(details), "\n"); !strings.Contains(lines, "none configured") {
+		t.Errorf("Expected no identity providers noted, got:\n%s", lines)
+	}
+
+	details = &resources.ClusterDetails{OpenShift: true, OpenShiftVersion: "4.16", VersionEstimated: true}
+	if label := clusterVersionLabel(details); label != "OpenShift ~4.16" {
+		t.Errorf("Expected the estimated minor version, got %q", label)
+	}
+	if lines := strings.Join(clusterInfoLines(details), "\n"); !strings.Contains(lines, "4.16.x (estimated") {
+		t.Errorf("Expected the estimate noted, got:\n%s", lines)
+	}
+}
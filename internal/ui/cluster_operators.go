@@ -0,0 +1,247 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadClusterOperators lists the ClusterOperators of the cluster
+func (t *TUI) loadClusterOperators() tea.Cmd {
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	return func() tea.Msg {
+		if !ok || !osClient.IsOpenShift() {
+			return messages.ClusterOperatorsLoaded{Err: fmt.Errorf("ClusterOperators exist on OpenShift 4 clusters only")}
+		}
+
+		list, err := loadWithRetry(t, "clusteroperators", func(ctx context.Context) (*resources.ResourceList[resources.ClusterOperatorInfo], error) {
+			return resources.NewOpenShiftResourceClient(osClient).ListClusterOperators(ctx, resources.ListOptions{})
+		})
+		if err != nil {
+			if apierrors.IsForbidden(err) {
+				err = fmt.Errorf("%w (reading ClusterOperators needs the cluster-reader role)", err)
+			}
+			return messages.ClusterOperatorsLoaded{Err: err}
+		}
+		return messages.ClusterOperatorsLoaded{Operators: list.Items}
+	}
+}
+
+// openClusterOperators shows the ClusterOperators and loads them
+func (t *TUI) openClusterOperators() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showClusterOperators = true
+	t.loadingClusterOperators = true
+	t.clusterOperatorsError = ""
+	return t.loadClusterOperators()
+}
+
+// handleClusterOperatorsLoaded shows the listed ClusterOperators, keeping the
+// selected one selected
+func (t *TUI) handleClusterOperatorsLoaded(msg messages.ClusterOperatorsLoaded) {
+	t.loadingClusterOperators = false
+	if msg.Err != nil {
+		t.clusterOperatorsError = msg.Err.Error()
+		return
+	}
+
+	selected := ""
+	if operators := t.visibleClusterOperators(); t.selectedClusterOperator < len(operators) {
+		selected = operators[t.selectedClusterOperator].Name
+	}
+	t.clusterOperators = msg.Operators
+	t.clusterOperatorsError = ""
+	t.selectedClusterOperator = indexByName(t.visibleClusterOperators(), selected, func(co resources.ClusterOperatorInfo) string { return co.Name })
+}
+
+// clusterOperatorLevel returns the health of a ClusterOperator: failed when
+// degraded or unavailable, warn while progressing
+func clusterOperatorLevel(co resources.ClusterOperatorInfo) statusLevel {
+	switch {
+	case co.Degraded == "True" || co.Available != "True":
+		return statusFailed
+	case co.Progressing == "True":
+		return statusWarn
+	}
+	return statusOK
+}
+
+// clusterOperatorMessage returns the message explaining the state of a
+// ClusterOperator, like the MESSAGE column of oc get clusteroperators
+func clusterOperatorMessage(co resources.ClusterOperatorInfo) string {
+	messages := make(map[string]string, len(co.Conditions))
+	for _, condition := range co.Conditions {
+		messages[condition.Type+"="+condition.Status] = condition.Message
+	}
+	for _, key := range []string{"Degraded=True", "Available=False", "Progressing=True", "Available=True"} {
+		if message := messages[key]; message != "" {
+			return strings.Join(strings.Fields(message), " ")
+		}
+	}
+	return ""
+}
+
+// clusterOperatorSince returns when the Available condition of a
+// ClusterOperator last changed
+func clusterOperatorSince(co resources.ClusterOperatorInfo) time.Time {
+	for _, condition := range co.Conditions {
+		if condition.Type == "Available" {
+			return condition.LastTransitionTime
+		}
+	}
+	return time.Time{}
+}
+
+// visibleClusterOperators returns the ClusterOperators listed, only the
+// unhealthy ones when filtered
+func (t *TUI) visibleClusterOperators() []resources.ClusterOperatorInfo {
+	if !t.clusterOperatorsUnhealthy {
+		return t.clusterOperators
+	}
+	var unhealthy []resources.ClusterOperatorInfo
+	for _, co := range t.clusterOperators {
+		if clusterOperatorLevel(co) != statusOK {
+			unhealthy = append(unhealthy, co)
+		}
+	}
+	return unhealthy
+}
+
+// clusterOperatorSummary counts the ClusterOperators by condition, e.g. "34
+// operators: 33 available, 1 progressing, 1 degraded"
+func clusterOperatorSummary(operators []resources.ClusterOperatorInfo) string {
+	available, progressing, degraded := 0, 0, 0
+	for _, co := range operators {
+		if co.Available == "True" {
+			available++
+		}
+		if co.Progressing == "True" {
+			progressing++
+		}
+		if co.Degraded == "True" {
+			degraded++
+		}
+	}
+	return fmt.Sprintf("%d operators: %d available, %d progressing, %d degraded", len(operators), available, progressing, degraded)
+}
+
+// clusterOperatorVisibleRows returns the number of operators the view has
+// room for above the conditions of the selected one
+func (t *TUI) clusterOperatorVisibleRows() int {
+	return max(t.height-22, 3)
+}
+
+// renderClusterOperators renders the ClusterOperator view
+func (t *TUI) renderClusterOperators() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(140, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🧩 Cluster Operators") + "\n\n")
+
+	operators := t.visibleClusterOperators()
+	switch {
+	case t.clusterOperatorsError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.clusterOperatorsError, modalWidth-10)) + "\n")
+	case t.loadingClusterOperators && t.clusterOperators == nil:
+		content.WriteString(fmt.Sprintf("%s Loading cluster operators...\n", t.getLoadingSpinner()))
+	default:
+		summary := clusterOperatorSummary(t.clusterOperators)
+		if t.loadingClusterOperators {
+			summary = t.getLoadingSpinner() + " " + summary
+		}
+		if t.clusterOperatorsUnhealthy {
+			summary += " (showing the unhealthy ones)"
+		}
+		content.WriteString(summary + "\n\n")
+
+		timeWidth := t.timeWidth()
+		messageWidth := max(modalWidth-(2+40+16+10+12+9+timeWidth+1)-8, 10)
+		content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("  %-40s %-16s %-10s %-12s %-9s %-*s %s",
+			"NAME", "VERSION", "AVAILABLE", "PROGRESSING", "DEGRADED", timeWidth, "SINCE", "MESSAGE")) + "\n")
+		if len(operators) == 0 {
+			content.WriteString("  none\n")
+		}
+
+		visible := t.clusterOperatorVisibleRows()
+		start := max(min(t.selectedClusterOperator-visible/2, len(operators)-visible), 0)
+		end := min(start+visible, len(operators))
+		for i := start; i < end; i++ {
+			co := operators[i]
+			row := fmt.Sprintf("%-40s %-16s %-10s %-12s %-9s %-*s %s", truncateString(co.Name, 40), truncateString(co.Version, 16),
+				co.Available, co.Progressing, co.Degraded, timeWidth, t.formatTime(clusterOperatorSince(co), ""),
+				truncateString(clusterOperatorMessage(co), messageWidth))
+			prefix, style := "  ", t.statusStyle(clusterOperatorLevel(co))
+			if i == t.selectedClusterOperator {
+				prefix, style = "▶ ", style.Bold(true)
+			}
+			content.WriteString(prefix + style.Render(row) + "\n")
+		}
+		if len(operators) > visible {
+			content.WriteString(fmt.Sprintf("[%d-%d of %d]\n", start+1, end, len(operators)))
+		}
+
+		if t.selectedClusterOperator < len(operators) {
+			co := operators[t.selectedClusterOperator]
+			content.WriteString("\n" + lipgloss.NewStyle().Bold(true).Render("Conditions of "+co.Name) + "\n")
+			for _, condition := range co.Conditions {
+				content.WriteString(fmt.Sprintf("  %-16s %-7s %-*s %-28s %s\n", condition.Type, condition.Status,
+					timeWidth, t.formatTime(condition.LastTransitionTime, ""), truncateString(condition.Reason, 28),
+					truncateString(strings.Join(strings.Fields(condition.Message), " "), max(modalWidth-timeWidth-70, 10))))
+			}
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: select • f: unhealthy only • r: reload • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleClusterOperatorsKeys handles key input for the ClusterOperator view
+func (t *TUI) handleClusterOperatorsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		t.showClusterOperators = false
+
+	case "r":
+		if t.loadingClusterOperators {
+			return t, nil
+		}
+		return t, t.openClusterOperators()
+
+	case "f":
+		t.clusterOperatorsUnhealthy = !t.clusterOperatorsUnhealthy
+		t.selectedClusterOperator = 0
+
+	case "j", "down":
+		if t.selectedClusterOperator < len(t.visibleClusterOperators())-1 {
+			t.selectedClusterOperator++
+		}
+
+	case "k", "up":
+		if t.selectedClusterOperator > 0 {
+			t.selectedClusterOperator--
+		}
+	}
+
+	return t, nil
+}
@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// testClusterOperator returns a ClusterOperator with the given conditions
+func testClusterOperator(name, available, progressing, degraded, message string) resources.ClusterOperatorInfo {
+	changed := time.Now().Add(-2 * time.Hour)
+	return resources.ClusterOperatorInfo{
+		ResourceInfo: resources.ResourceInfo{Name: name},
+		Version:      "4.16.3",
+		Available:    available,
+		Progressing:  progressing,
+		Degraded:     degraded,
+		Conditions: []resources.OperatorCondition{
+			{Type: "Available", Status: available, LastTransitionTime: changed},
+			{Type: "Progressing", Status: progressing, LastTransitionTime: changed},
+			{Type: "Degraded", Status: degraded, LastTransitionTime: changed, Message: message},
+		},
+	}
+}
+
+func TestClusterOperators(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 160, height: 40}
+
+	if msg := tui.openClusterOperators()(); msg.(messages.ClusterOperatorsLoaded).Err == nil {
+		t.Fatal("Expected ClusterOperators to need an OpenShift cluster")
+	}
+
+	operators := []resources.ClusterOperatorInfo{
+		testClusterOperator("authentication", "True", "False", "False", ""),
+		testClusterOperator("etcd", "True", "False", "True", "EtcdMembersDegraded: 2 of 3 members are available"),
+		testClusterOperator("ingress", "True", "True", "False", ""),
+	}
+	if level := clusterOperatorLevel(operators[1]); level != statusFailed {
+		t.Errorf("Expected a degraded operator to fail, got %v", level)
+	}
+	if level := clusterOperatorLevel(operators[2]); level != statusWarn {
+		t.Errorf("Expected a progressing operator to warn, got %v", level)
+	}
+	if message := clusterOperatorMessage(operators[1]); message != "EtcdMembersDegraded: 2 of 3 members are available" {
+		t.Errorf("Expected the Degraded message, got %q", message)
+	}
+
+	tui.selectedClusterOperator = 0
+	tui.handleClusterOperatorsLoaded(messages.ClusterOperatorsLoaded{Operators: operators})
+	rendered := tui.renderClusterOperators()
+	for _, want := range []string{"3 operators: 3 available, 1 progressing, 1 degraded", "authentication", "EtcdMembersDegraded", "Conditions of authentication"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected %q in the view, got:\n%s", want, rendered)
+		}
+	}
+
+	// f lists the unhealthy operators only
+	tui.handleClusterOperatorsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	tui.handleClusterOperatorsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if visible := tui.visibleClusterOperators(); len(visible) != 2 || visible[tui.selectedClusterOperator].Name != "ingress" {
+		t.Fatalf("Expected etcd and ingress with ingress selected, got %+v", visible)
+	}
+
+	// A reload keeps the selected operator
+	tui.handleClusterOperatorsLoaded(messages.ClusterOperatorsLoaded{Operators: append([]resources.ClusterOperatorInfo{
+		testClusterOperator("dns", "False", "True", "False", ""),
+	}, operators...)})
+	if name := tui.visibleClusterOperators()[tui.selectedClusterOperator].Name; name != "ingress" {
+		t.Errorf("Expected ingress to stay selected, got %q", name)
+	}
+
+	tui.handleClusterOperatorsKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showClusterOperators {
+		t.Error("Expected esc to close the view")
+	}
+}
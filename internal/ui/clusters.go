@@ -0,0 +1,688 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// clusterSession holds the clients and connection state of a connected
+// cluster, so a second cluster can be kept connected next to the active one
+type clusterSession struct {
+	k8sClient      k8s.Client
+	resourceClient resources.ResourceClient
+	authProvider   auth.AuthProvider
+	projectManager projects.ProjectManager
+	projectFactory *projects.DefaultProjectManagerFactory
+	currentProject *projects.ProjectInfo
+	context        string
+	namespace      string
+	clusterVersion string
+	clusterDetails *resources.ClusterDetails
+	identity       *resources.UserIdentity
+	tokenExpiresAt time.Time
+}
+
+// clusterCompareFields are the fields compared across clusters for each tab
+var clusterCompareFields = map[int][]string{
+	0:  {"status", "ready", "restarts"},
+	1:  {"type", "ports"},
+	2:  {"ready", "replicas", "status"},
+	3:  {"data"},
+	4:  {"type", "data"},
+	5:  {"strategy", "builds"},
+	6:  {"tags"},
+	7:  {"host", "service"},
+	8:  {"status", "commit"},
+	9:  {"type", "reason", "count"},
+	10: {"ready", "replicas", "image"},
+	11: {"ready", "desired", "image"},
+	12: {"ready", "replicas", "image"},
+	13: {"status", "completions"},
+	14: {"schedule", "suspended", "image"},
+	15: {"status", "version"},
+	16: {"status", "capacity", "storageclass"},
+	17: {"host", "backend"},
+	18: {"pods", "types"},
+	19: {"ready", "version", "image"},
+}
+
+// connectCluster connects to a kubeconfig context without touching the
+// active connection
+func connectCluster(kubeconfigPath, contextName string, hook auth.ConfigHook) (*clusterSession, error) {
+	authProvider := newAuthProvider(kubeconfigPath, contextName, hook)
+
+	ctx, cancel := context.WithTimeout(context.Background(), authTimeout(hook))
+	defer cancel()
+
+	config, err := authProvider.Authenticate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("clientset creation failed: %w", err)
+	}
+
+	k8sClient := k8s.NewClientFactory()
+	k8sClient.SetClientset(clientset)
+	k8sClient.SetConfig(config)
+	// Not an OpenShift cluster when this fails, the Kubernetes tabs still work
+	_ = k8sClient.InitializeOpenShiftAfterSetup()
+
+	namespace := authProvider.GetNamespace()
+	resourceClient := resources.NewK8sResourceClientWithConfig(clientset, config, namespace)
+
+	testCtx, testCancel := context.WithTimeout(context.Background(), constants.ConnectionTestTimeout)
+	defer testCancel()
+
+	if err := resourceClient.TestConnection(testCtx); err != nil {
+		return nil, fmt.Errorf("connection test failed: %w", err)
+	}
+
+	return &clusterSession{
+		k8sClient:      k8sClient,
+		resourceClient: resourceClient,
+		authProvider:   authProvider,
+		context:        authProvider.GetContext(),
+		namespace:      namespace,
+	}, nil
+}
+
+// openClusterPicker lists the kubeconfig contexts that can be connected as
+// the second cluster
+func (t *TUI) openClusterPicker() {
+	if !t.connected {
+		return
+	}
+
+	t.clusterContexts = nil
+	t.clusterPickerIndex = 0
+	t.clusterPickerError = ""
+	t.showClusterPicker = true
+
+	contexts, err := auth.NewKubeconfigProvider(t.KubeconfigPath).GetAvailableContexts()
+	if err != nil {
+		t.clusterPickerError = err.Error()
+		return
+	}
+	sort.Strings(contexts)
+	for _, name := range contexts {
+		if name != t.context {
+			t.clusterContexts = append(t.clusterContexts, name)
+		}
+	}
+	if len(t.clusterContexts) == 0 {
+		t.clusterPickerError = "No other contexts in the kubeconfig"
+	}
+}
+
+// connectPeerCluster connects a context as the second cluster in the background
+func (t *TUI) connectPeerCluster(contextName string) tea.Cmd {
+	if t.connectingPeer != "" {
+		return nil
+	}
+
+	t.connectingPeer = contextName
+	t.logInfo(categoryConnection, "Connecting to %s as the second cluster...", t.obfuscateClusterContext(contextName))
+
+	kubeconfigPath, hook := t.KubeconfigPath, t.authHook
+	return func() tea.Msg {
+		session, err := connectCluster(kubeconfigPath, contextName, hook)
+		if err != nil {
+			return messages.ClusterConnected{Context: contextName, Err: err}
+		}
+		return messages.ClusterConnected{
+			Context:        session.context,
+			Namespace:      session.namespace,
+			K8sClient:      session.k8sClient,
+			ResourceClient: session.resourceClient,
+			AuthProvider:   session.authProvider,
+		}
+	}
+}
+
+// handleClusterConnected keeps a connected second cluster next to the active
+// one, or switches to it when it was connected to be made active
+func (t *TUI) handleClusterConnected(msg messages.ClusterConnected) tea.Cmd {
+	t.connectingPeer = ""
+	switchTo := t.switchOnConnect
+	t.switchOnConnect = false
+	if msg.Err != nil {
+		t.pendingProfile = ""
+		t.logError(categoryConnection, "Failed to connect to %s: %v", t.obfuscateClusterContext(msg.Context), msg.Err)
+		return nil
+	}
+
+	t.peerCluster = &clusterSession{
+		k8sClient:      msg.K8sClient,
+		resourceClient: msg.ResourceClient,
+		authProvider:   msg.AuthProvider,
+		context:        msg.Context,
+		namespace:      msg.Namespace,
+	}
+	if switchTo {
+		return t.switchCluster()
+	}
+	t.logSuccess(categoryConnection, "Connected to %s as the second cluster, press X to switch to it", t.obfuscateClusterContext(msg.Context))
+	return nil
+}
+
+// disconnectPeerCluster drops the second cluster
+func (t *TUI) disconnectPeerCluster() {
+	if t.peerCluster == nil {
+		return
+	}
+	t.logInfo(categoryConnection, "Disconnected from %s", t.obfuscateClusterContext(t.peerCluster.context))
+	t.peerCluster = nil
+	t.showClusterCompare = false
+}
+
+// activeClusterSession captures the clients and connection state of the
+// active cluster
+func (t *TUI) activeClusterSession() *clusterSession {
+	return &clusterSession{
+		k8sClient:      t.k8sClient,
+		resourceClient: t.resourceClient,
+		authProvider:   t.authProvider,
+		projectManager: t.projectManager,
+		projectFactory: t.projectFactory,
+		currentProject: t.currentProject,
+		context:        t.context,
+		namespace:      t.namespace,
+		clusterVersion: t.clusterVersion,
+		clusterDetails: t.clusterDetails,
+		identity:       t.identity,
+		tokenExpiresAt: t.tokenExpiresAt,
+	}
+}
+
+// switchCluster swaps the active cluster with the second one and reloads
+// what the tabs show
+func (t *TUI) switchCluster() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	if t.peerCluster == nil {
+		t.logInfo(categoryConnection, "No second cluster connected, press K to connect one")
+		return nil
+	}
+
+	previous := t.activeClusterSession()
+	session := t.peerCluster
+	t.peerCluster = previous
+
+	t.k8sClient = session.k8sClient
+	t.resourceClient = session.resourceClient
+	t.authProvider = session.authProvider
+	t.projectManager = session.projectManager
+	t.projectFactory = session.projectFactory
+	t.currentProject = session.currentProject
+	t.context = session.context
+	t.namespace = session.namespace
+	t.clusterVersion = session.clusterVersion
+	t.clusterDetails = session.clusterDetails
+	t.identity = session.identity
+	t.tokenExpiresAt = session.tokenExpiresAt
+	t.tokenExpiryPrompted = false
+	if t.projectManager == nil {
+		t.initializeProjectManager()
+	}
+
+	t.logSuccess(categoryConnection, "Switched to %s", t.obfuscateClusterContext(t.context))
+
+	// Nothing loaded from the previous cluster applies to this one
+	t.clearPodLogs()
+	t.dropLoadedResources()
+	t.allPods, t.pods = nil, nil
+	t.allNodes, t.nodes = nil, nil
+	t.resetLoaderCircuits()
+	t.startTabSummaries()
+
+	cmds := []tea.Cmd{t.loadIdentity(), t.loadTokenExpiry(), t.probeAPILatency(), t.refreshTab(int(t.ActiveTab))}
+	if t.ActiveTab != models.TabPods {
+		cmds = append(cmds, t.loadPods())
+	}
+	if t.clusterDetails == nil {
+		cmds = append(cmds, t.loadClusterInfo())
+	}
+	if t.showClusterCompare {
+		cmds = append(cmds, t.loadClusterCompare())
+	}
+	cmds = append(cmds, t.resumePendingProfile())
+	return tea.Batch(cmds...)
+}
+
+// peerClusterSuffix returns the second cluster shown after the active one in the header
+func (t *TUI) peerClusterSuffix() string {
+	switch {
+	case t.connectingPeer != "":
+		return " ⇄ " + t.obfuscateClusterContext(t.connectingPeer) + "…"
+	case t.peerCluster != nil:
+		return " ⇄ " + t.obfuscateClusterContext(t.peerCluster.context)
+	}
+	return ""
+}
+
+// listTabRows lists the resources of a tab in a cluster
+func (s *clusterSession) listTabRows(ctx context.Context, tab int, allNamespaces bool) ([]viewRow, error) {
+	opts := resources.ListOptions{Namespace: s.namespace, AllNamespaces: allNamespaces}
+	client := s.resourceClient
+
+	var osClient *resources.OpenShiftResourceClient
+	switch tab {
+	case 5, 6, 7, 8, 19:
+		openshift, ok := s.k8sClient.(k8s.OpenShiftClient)
+		if !ok || !openshift.IsOpenShift() {
+			return nil, fmt.Errorf("%s is not an OpenShift cluster", s.context)
+		}
+		osClient = resources.NewOpenShiftResourceClient(openshift)
+	}
+
+	switch tab {
+	case 0:
+		list, err := client.ListPods(ctx, opts)
+		return listRows(list, err, podViewRow)
+	case 1:
+		list, err := client.ListServices(ctx, opts)
+		return listRows(list, err, serviceViewRow)
+	case 2:
+		list, err := client.ListDeployments(ctx, opts)
+		return listRows(list, err, deploymentViewRow)
+	case 3:
+		list, err := client.ListConfigMaps(ctx, opts)
+		return listRows(list, err, configMapViewRow)
+	case 4:
+		list, err := client.ListSecrets(ctx, opts)
+		return listRows(list, err, secretViewRow)
+	case 5:
+		list, err := osClient.ListBuildConfigs(ctx, opts)
+		return listRows(list, err, buildConfigViewRow)
+	case 6:
+		list, err := osClient.ListImageStreams(ctx, opts)
+		return listRows(list, err, imageStreamViewRow)
+	case 7:
+		list, err := osClient.ListRoutes(ctx, opts)
+		return listRows(list, err, routeViewRow)
+	case 8:
+		list, err := osClient.ListBuilds(ctx, opts)
+		return listRows(list, err, buildViewRow)
+	case 9:
+		list, err := client.ListEvents(ctx, opts)
+		return listRows(list, err, eventViewRow)
+	case 10:
+		list, err := client.ListStatefulSets(ctx, opts)
+		return listRows(list, err, statefulSetViewRow)
+	case 11:
+		list, err := client.ListDaemonSets(ctx, opts)
+		return listRows(list, err, daemonSetViewRow)
+	case 12:
+		list, err := client.ListReplicaSets(ctx, opts)
+		return listRows(list, err, replicaSetViewRow)
+	case 13:
+		list, err := client.ListJobs(ctx, opts)
+		return listRows(list, err, jobViewRow)
+	case 14:
+		list, err := client.ListCronJobs(ctx, opts)
+		return listRows(list, err, cronJobViewRow)
+	case 15:
+		list, err := client.ListNodes(ctx, resources.ListOptions{})
+		return listRows(list, err, nodeViewRow)
+	case 16:
+		list, err := client.ListPersistentVolumeClaims(ctx, opts)
+		return listRows(list, err, pvcViewRow)
+	case 17:
+		list, err := client.ListIngresses(ctx, opts)
+		return listRows(list, err, ingressViewRow)
+	case 18:
+		list, err := client.ListNetworkPolicies(ctx, opts)
+		return listRows(list, err, networkPolicyViewRow)
+	case 19:
+		list, err := osClient.ListDeploymentConfigs(ctx, opts)
+		return listRows(list, err, deploymentConfigViewRow)
+	}
+	return nil, fmt.Errorf("tab %d cannot be compared", tab)
+}
+
+// listRows converts a listed page of resources to view rows
+func listRows[T any](list *resources.ResourceList[T], err error, toRow func(T) viewRow) ([]viewRow, error) {
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]viewRow, 0, len(list.Items))
+	for _, item := range list.Items {
+		rows = append(rows, toRow(item))
+	}
+	return rows, nil
+}
+
+// compareClusterRows pairs the resources of both clusters by namespace and
+// name and summarizes the compared fields of each side
+func compareClusterRows(tab int, active, peer []viewRow) []messages.ClusterCompareRow {
+	fields := clusterCompareFields[tab]
+	summary := func(row viewRow) string {
+		values := make([]string, 0, len(fields))
+		for _, field := range fields {
+			values = append(values, row.fields[field])
+		}
+		return strings.Join(values, " · ")
+	}
+	key := func(row viewRow) string {
+		if namespace := row.fields["namespace"]; namespace != "" {
+			return namespace + "/" + row.fields["name"]
+		}
+		return row.fields["name"]
+	}
+
+	byName := make(map[string]*messages.ClusterCompareRow)
+	for _, row := range active {
+		byName[key(row)] = &messages.ClusterCompareRow{Name: key(row), Active: summary(row), InActive: true}
+	}
+	for _, row := range peer {
+		compared, ok := byName[key(row)]
+		if !ok {
+			compared = &messages.ClusterCompareRow{Name: key(row)}
+			byName[key(row)] = compared
+		}
+		compared.Peer = summary(row)
+		compared.InPeer = true
+	}
+
+	rows := make([]messages.ClusterCompareRow, 0, len(byName))
+	for _, row := range byName {
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Name < rows[j].Name })
+	return rows
+}
+
+// openClusterCompare shows the active tab of both clusters side by side
+func (t *TUI) openClusterCompare() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	if t.peerCluster == nil {
+		t.logInfo(categoryConnection, "No second cluster connected, press K to connect one")
+		return nil
+	}
+
+	t.showClusterCompare = true
+	t.clusterCompareScroll = 0
+	t.clusterCompareDiffOnly = false
+	return t.loadClusterCompare()
+}
+
+// loadClusterCompare lists the active tab in both clusters
+func (t *TUI) loadClusterCompare() tea.Cmd {
+	tab := int(t.ActiveTab)
+	active, peer := t.activeClusterSession(), t.peerCluster
+	allNamespaces := t.allNamespaces
+
+	t.clusterCompareTab = tab
+	t.clusterCompareRows = nil
+	t.clusterCompareError = ""
+	t.loadingClusterCompare = true
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		activeRows, err := active.listTabRows(ctx, tab, allNamespaces)
+		if err != nil {
+			return messages.ClusterCompareLoaded{Tab: tab, Err: fmt.Errorf("%s: %w", active.context, err)}
+		}
+		peerRows, err := peer.listTabRows(ctx, tab, allNamespaces)
+		if err != nil {
+			return messages.ClusterCompareLoaded{Tab: tab, Err: fmt.Errorf("%s: %w", peer.context, err)}
+		}
+		return messages.ClusterCompareLoaded{Tab: tab, Rows: compareClusterRows(tab, activeRows, peerRows)}
+	}
+}
+
+// handleClusterCompareLoaded shows the listed resources of both clusters
+func (t *TUI) handleClusterCompareLoaded(msg messages.ClusterCompareLoaded) {
+	if !t.showClusterCompare || msg.Tab != t.clusterCompareTab {
+		return
+	}
+
+	t.loadingClusterCompare = false
+	if msg.Err != nil {
+		t.clusterCompareError = msg.Err.Error()
+		t.logError(categoryResource, "Failed to compare %s across clusters: %v", t.GetTabName(models.TabType(msg.Tab)), msg.Err)
+		return
+	}
+	t.clusterCompareRows = msg.Rows
+	t.clusterCompareScroll = 0
+}
+
+// visibleClusterCompareRows returns the compared resources, or only those
+// that differ between the clusters
+func (t *TUI) visibleClusterCompareRows() []messages.ClusterCompareRow {
+	if !t.clusterCompareDiffOnly {
+		return t.clusterCompareRows
+	}
+	var rows []messages.ClusterCompareRow
+	for _, row := range t.clusterCompareRows {
+		if row.Differs() {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// clusterCompareHeight returns the number of compared resources visible at once
+func (t *TUI) clusterCompareHeight() int {
+	return max(t.height-6, 1) // title, separator, column titles, fields, footer separator, footer
+}
+
+// renderClusterCompare renders the active tab of both clusters side by side
+func (t *TUI) renderClusterCompare() string {
+	primaryColor, errorColor := t.getThemeColors()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(primaryColor)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("242"))
+	diffStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("11"))
+	missingStyle := lipgloss.NewStyle().Foreground(errorColor)
+
+	nameWidth := max(t.width/3, 20)
+	sideWidth := max((t.width-nameWidth-6)/2, 10)
+	cell := func(value string, width int) string {
+		return fmt.Sprintf("%-*s", width, truncateString(value, width))
+	}
+
+	var content strings.Builder
+	tabName := t.GetTabName(models.TabType(t.clusterCompareTab))
+	content.WriteString(titleStyle.Render(fmt.Sprintf("⇄ %s across clusters", tabName)) + "\n")
+	content.WriteString(dimStyle.Render(strings.Repeat("─", max(t.width, 1))) + "\n")
+
+	peerContext := ""
+	if t.peerCluster != nil {
+		peerContext = t.peerCluster.context
+	}
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("  %s │ %s │ %s",
+		cell("NAME", nameWidth),
+		cell(t.obfuscateClusterContext(t.context), sideWidth),
+		cell(t.obfuscateClusterContext(peerContext), sideWidth))) + "\n")
+	fields := strings.ToUpper(strings.Join(clusterCompareFields[t.clusterCompareTab], " · "))
+	content.WriteString(dimStyle.Render(fmt.Sprintf("  %s │ %s │ %s", cell("", nameWidth), cell(fields, sideWidth), cell(fields, sideWidth))) + "\n")
+
+	height := t.clusterCompareHeight()
+	rows := t.visibleClusterCompareRows()
+	lines := make([]string, 0, height)
+	switch {
+	case t.loadingClusterCompare:
+		lines = append(lines, fmt.Sprintf("%s Listing %s in both clusters...", t.getLoadingSpinner(), strings.ToLower(tabName)))
+	case t.clusterCompareError != "":
+		lines = append(lines, missingStyle.Render("❌ "+t.clusterCompareError))
+	case len(rows) == 0 && t.clusterCompareDiffOnly:
+		lines = append(lines, "✅ No differences")
+	case len(rows) == 0:
+		lines = append(lines, "No resources in either cluster")
+	default:
+		end := min(t.clusterCompareScroll+height, len(rows))
+		for _, row := range rows[t.clusterCompareScroll:end] {
+			active, peer := cell(row.Active, sideWidth), cell(row.Peer, sideWidth)
+			marker := "  "
+			switch {
+			case !row.InActive:
+				marker, active = missingStyle.Render("+ "), missingStyle.Render(cell("missing", sideWidth))
+			case !row.InPeer:
+				marker, peer = missingStyle.Render("- "), missingStyle.Render(cell("missing", sideWidth))
+			case row.Differs():
+				marker, active, peer = diffStyle.Render("≠ "), diffStyle.Render(active), diffStyle.Render(peer)
+			}
+			lines = append(lines, fmt.Sprintf("%s%s │ %s │ %s", marker, cell(row.Name, nameWidth), active, peer))
+		}
+	}
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	content.WriteString(strings.Join(lines, "\n") + "\n")
+
+	differing := 0
+	for _, row := range t.clusterCompareRows {
+		if row.Differs() {
+			differing++
+		}
+	}
+	filter := "d: differences only"
+	if t.clusterCompareDiffOnly {
+		filter = "d: show all"
+	}
+	content.WriteString(dimStyle.Render(strings.Repeat("─", max(t.width, 1))) + "\n")
+	content.WriteString(dimStyle.Render(fmt.Sprintf("%d resources, %d differ • j/k: scroll • %s • X: switch cluster • r: reload • esc/q: close",
+		len(t.clusterCompareRows), differing, filter)))
+
+	return content.String()
+}
+
+// handleClusterCompareKeys handles key input for the side by side view
+func (t *TUI) handleClusterCompareKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	maxScroll := max(len(t.visibleClusterCompareRows())-t.clusterCompareHeight(), 0)
+
+	switch msg.String() {
+	case "esc", "q", "|":
+		t.showClusterCompare = false
+		t.clusterCompareRows = nil
+
+	case "j", "down":
+		t.clusterCompareScroll = min(t.clusterCompareScroll+1, maxScroll)
+
+	case "k", "up":
+		t.clusterCompareScroll = max(t.clusterCompareScroll-1, 0)
+
+	case "pgdown", "ctrl+f", " ":
+		t.clusterCompareScroll = min(t.clusterCompareScroll+t.clusterCompareHeight(), maxScroll)
+
+	case "pgup", "ctrl+b":
+		t.clusterCompareScroll = max(t.clusterCompareScroll-t.clusterCompareHeight(), 0)
+
+	case "g", "home":
+		t.clusterCompareScroll = 0
+
+	case "G", "end":
+		t.clusterCompareScroll = maxScroll
+
+	case "d":
+		t.clusterCompareDiffOnly = !t.clusterCompareDiffOnly
+		t.clusterCompareScroll = 0
+
+	case "r":
+		return t, t.loadClusterCompare()
+
+	case "X":
+		return t, t.switchCluster()
+	}
+
+	return t, nil
+}
+
+// renderClusterPicker renders the second cluster picker modal
+func (t *TUI) renderClusterPicker() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(70, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("⇄ Connect a second cluster") + "\n\n")
+	content.WriteString(fmt.Sprintf("Active: %s\n\n", t.obfuscateClusterContext(t.context)))
+
+	if t.clusterPickerError != "" {
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render(truncateString(t.clusterPickerError, modalWidth-8)) + "\n\n")
+		content.WriteString("esc: close")
+	} else {
+		for i, name := range t.clusterContexts {
+			marker := "  "
+			if t.peerCluster != nil && t.peerCluster.context == name {
+				marker = "● "
+			}
+			line := marker + truncateString(t.obfuscateClusterContext(name), modalWidth-10)
+			if i == t.clusterPickerIndex {
+				line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(line)
+			}
+			content.WriteString(line + "\n")
+		}
+
+		content.WriteString("\nj/k: navigate • enter: connect")
+		if t.peerCluster != nil {
+			content.WriteString(" • x: disconnect")
+		}
+		content.WriteString(" • esc: close")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleClusterPickerKeys handles key input for the second cluster picker
+func (t *TUI) handleClusterPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "K":
+		t.showClusterPicker = false
+
+	case "j", "down":
+		if len(t.clusterContexts) > 0 {
+			t.clusterPickerIndex = (t.clusterPickerIndex + 1) % len(t.clusterContexts)
+		}
+
+	case "k", "up":
+		if len(t.clusterContexts) > 0 {
+			t.clusterPickerIndex = (t.clusterPickerIndex - 1 + len(t.clusterContexts)) % len(t.clusterContexts)
+		}
+
+	case "x":
+		t.disconnectPeerCluster()
+
+	case "enter":
+		if t.clusterPickerIndex >= len(t.clusterContexts) {
+			return t, nil
+		}
+		t.showClusterPicker = false
+		name := t.clusterContexts[t.clusterPickerIndex]
+		if t.peerCluster != nil && t.peerCluster.context == name {
+			return t, nil
+		}
+		return t, t.connectPeerCluster(name)
+	}
+
+	return t, nil
+}
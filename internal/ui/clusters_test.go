@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestCompareClusterRows(t *testing.T) {
+	staging := []viewRow{
+		deploymentViewRow(resources.DeploymentInfo{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "shop"}, Replicas: 2, ReadyReplicas: 2}),
+		deploymentViewRow(resources.DeploymentInfo{ResourceInfo: resources.ResourceInfo{Name: "api", Namespace: "shop"}, Replicas: 1, ReadyReplicas: 1}),
+		deploymentViewRow(resources.DeploymentInfo{ResourceInfo: resources.ResourceInfo{Name: "canary", Namespace: "shop"}, Replicas: 1}),
+	}
+	prod := []viewRow{
+		deploymentViewRow(resources.DeploymentInfo{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "shop"}, Replicas: 5, ReadyReplicas: 3}),
+		deploymentViewRow(resources.DeploymentInfo{ResourceInfo: resources.ResourceInfo{Name: "api", Namespace: "shop"}, Replicas: 1, ReadyReplicas: 1}),
+		deploymentViewRow(resources.DeploymentInfo{ResourceInfo: resources.ResourceInfo{Name: "worker", Namespace: "shop"}, Replicas: 1, ReadyReplicas: 1}),
+	}
+
+	rows := compareClusterRows(2, staging, prod)
+	if len(rows) != 4 {
+		t.Fatalf("Expected 4 deployments across both clusters, got %+v", rows)
+	}
+
+	want := map[string]bool{"shop/api": false, "shop/canary": true, "shop/web": true, "shop/worker": true}
+	for _, row := range rows {
+		if differs, ok := want[row.Name]; !ok || row.Differs() != differs {
+			t.Errorf("Unexpected comparison of %s: %+v", row.Name, row)
+		}
+	}
+	if rows[0].Name != "shop/api" || rows[0].Active != "1 · 1 · " {
+		t.Errorf("Expected rows sorted by name with ready, replicas and status, got %+v", rows[0])
+	}
+	if rows[1].InPeer || !rows[3].InPeer || rows[3].InActive {
+		t.Errorf("Expected canary only in the active cluster and worker only in the second, got %+v", rows)
+	}
+}
+
+func TestSwitchCluster(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", context: "staging", width: 120, height: 40}
+	tui.allDeployments = []resources.DeploymentInfo{{ResourceInfo: resources.ResourceInfo{Name: "web"}}}
+
+	if cmd := tui.switchCluster(); cmd != nil {
+		t.Fatalf("Expected no switch without a second cluster")
+	}
+
+	tui.handleClusterConnected(messages.ClusterConnected{Context: "prod", Namespace: "shop-prod"})
+	if !strings.Contains(tui.peerClusterSuffix(), "prod") {
+		t.Errorf("Expected the second cluster in the header, got %q", tui.peerClusterSuffix())
+	}
+
+	if cmd := tui.switchCluster(); cmd == nil {
+		t.Fatalf("Expected the switch to reload the tabs")
+	}
+	if tui.context != "prod" || tui.namespace != "shop-prod" || tui.peerCluster.context != "staging" || tui.peerCluster.namespace != "shop" {
+		t.Errorf("Expected prod to be active and staging kept as the second cluster, got %s/%s and %+v", tui.context, tui.namespace, tui.peerCluster)
+	}
+	if tui.allDeployments != nil {
+		t.Errorf("Expected resources of the previous cluster to be dropped")
+	}
+
+	tui.switchCluster()
+	if tui.context != "staging" || tui.peerCluster.context != "prod" {
+		t.Errorf("Expected switching again to return to staging, got %s", tui.context)
+	}
+}
+
+func TestClusterCompareView(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, context: "staging", width: 120, height: 20}
+	tui.peerCluster = &clusterSession{context: "prod"}
+	tui.showClusterCompare = true
+	tui.clusterCompareTab = 2
+	tui.clusterCompareRows = []messages.ClusterCompareRow{
+		{Name: "shop/api", Active: "1 · 1", Peer: "1 · 1", InActive: true, InPeer: true},
+		{Name: "shop/web", Active: "2 · 2", Peer: "3 · 5", InActive: true, InPeer: true},
+		{Name: "shop/worker", Peer: "1 · 1", InPeer: true},
+	}
+
+	tui.Update(messages.ClusterCompareLoaded{Tab: 3})
+	if len(tui.clusterCompareRows) != 3 {
+		t.Fatalf("Expected results for another tab to be ignored")
+	}
+
+	rendered := tui.renderClusterCompare()
+	for _, want := range []string{"staging", "prod", "shop/web", "missing", "3 resources, 2 differ"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the side by side view to contain %q", want)
+		}
+	}
+
+	tui.handleClusterCompareKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if rows := tui.visibleClusterCompareRows(); len(rows) != 2 || rows[0].Name != "shop/web" {
+		t.Errorf("Expected only the differing resources, got %+v", rows)
+	}
+
+	tui.handleClusterCompareKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showClusterCompare {
+		t.Errorf("Expected esc to close the side by side view")
+	}
+}
@@ -0,0 +1,459 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// commandTabs maps the ':' commands that open a tab to the tab, with the
+// singular and kubectl short names as aliases
+var commandTabs = map[string]models.TabType{
+	"pods": models.TabPods, "pod": models.TabPods, "po": models.TabPods,
+	"services": models.TabServices, "service": models.TabServices, "svc": models.TabServices,
+	"deployments": models.TabDeployments, "deployment": models.TabDeployments, "deploy": models.TabDeployments,
+	"configmaps": models.TabConfigMaps, "configmap": models.TabConfigMaps, "cm": models.TabConfigMaps,
+	"secrets": models.TabSecrets, "secret": models.TabSecrets,
+	"buildconfigs": models.TabBuildConfigs, "buildconfig": models.TabBuildConfigs, "bc": models.TabBuildConfigs,
+	"imagestreams": models.TabImageStreams, "imagestream": models.TabImageStreams, "is": models.TabImageStreams,
+	"routes": models.TabRoutes, "route": models.TabRoutes,
+	"builds": models.TabBuilds, "build": models.TabBuilds,
+	"events": models.TabEvents, "event": models.TabEvents, "ev": models.TabEvents,
+	"statefulsets": models.TabStatefulSets, "statefulset": models.TabStatefulSets, "sts": models.TabStatefulSets,
+	"daemonsets": models.TabDaemonSets, "daemonset": models.TabDaemonSets, "ds": models.TabDaemonSets,
+	"replicasets": models.TabReplicaSets, "replicaset": models.TabReplicaSets, "rs": models.TabReplicaSets,
+	"jobs": models.TabJobs, "job": models.TabJobs,
+	"cronjobs": models.TabCronJobs, "cronjob": models.TabCronJobs, "cj": models.TabCronJobs,
+	"nodes": models.TabNodes, "node": models.TabNodes, "no": models.TabNodes,
+	"storage": models.TabStorage, "pvc": models.TabStorage,
+	"ingresses": models.TabIngresses, "ingress": models.TabIngresses, "ing": models.TabIngresses,
+	"networkpolicies": models.TabNetworkPolicies, "networkpolicy": models.TabNetworkPolicies, "netpol": models.TabNetworkPolicies,
+	"deploymentconfigs": models.TabDeploymentConfigs, "deploymentconfig": models.TabDeploymentConfigs, "dc": models.TabDeploymentConfigs,
+}
+
+// Commands taking an argument, with their aliases
+var (
+	namespaceCommands = []string{"ns", "namespace", "project"}
+	contextCommands   = []string{"ctx", "context"}
+	logsCommands      = []string{"logs"}
+	topCommands       = []string{"top"}
+	infoCommands      = []string{"info"}
+	quotaCommands     = []string{"quota", "quotas"}
+	dashboardCommands = []string{"overview", "dash", "dashboard"}
+	operatorCommands  = []string{"co", "clusteroperators", "operators"}
+	helmCommands      = []string{"helm", "releases"}
+	diffCommands      = []string{"diff", "compare"}
+	applyCommands     = []string{"apply"}
+	filesCommands     = []string{"files", "cp"}
+	debugCommands     = []string{"debug"}
+	checksCommands    = []string{"checks"}
+	profileCommands   = []string{"profile"}
+	quitCommands      = []string{"q", "quit"}
+)
+
+// commandNames returns every command name in sorted order
+func commandNames() []string {
+	names := make([]string, 0, len(commandTabs)+8)
+	for name := range commandTabs {
+		names = append(names, name)
+	}
+	for _, group := range [][]string{namespaceCommands, contextCommands, logsCommands, topCommands, infoCommands, quotaCommands, dashboardCommands, operatorCommands, helmCommands, diffCommands, applyCommands, filesCommands, debugCommands, checksCommands, profileCommands, quitCommands} {
+		names = append(names, group...)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isCommand reports whether name is one of the given command names
+func isCommand(name string, names []string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// openCommandPrompt opens the ':' command prompt
+func (t *TUI) openCommandPrompt() tea.Cmd {
+	t.commandInput = textinput.New()
+	t.commandInput.Prompt = ":"
+	t.commandInput.Placeholder = "pods, deploy, ns <namespace>, ctx <context>, logs <pod>"
+	t.commandInput.CharLimit = 256
+	t.commandInput.Width = max(t.width/2, 20)
+	t.commandInput.Focus()
+	t.commandError = ""
+	t.commandHistoryIndex = len(t.commandHistory)
+	t.showCommandPrompt = true
+
+	// Contexts are completed from the kubeconfig
+	t.commandContexts = nil
+	if contexts, err := auth.NewKubeconfigProvider(t.KubeconfigPath).GetAvailableContexts(); err == nil {
+		sort.Strings(contexts)
+		t.commandContexts = contexts
+	}
+	return textinput.Blink
+}
+
+// closeCommandPrompt closes the ':' command prompt
+func (t *TUI) closeCommandPrompt() {
+	t.showCommandPrompt = false
+	t.commandError = ""
+	t.commandInput.Blur()
+}
+
+// commandCompletions returns the start of line kept when completing and the
+// candidates for the word being typed: a command name, or the namespace,
+// context, pod or profile argument of a command
+func (t *TUI) commandCompletions(line string) (string, []string) {
+	space := strings.LastIndex(line, " ")
+	prefix, word := line[:space+1], strings.ToLower(line[space+1:])
+
+	var options []string
+	if space < 0 {
+		options = commandNames()
+	} else {
+		fields := strings.Fields(prefix)
+		if len(fields) != 1 {
+			return prefix, nil // Commands take a single argument
+		}
+		command := strings.ToLower(fields[0])
+		switch {
+		case isCommand(command, namespaceCommands):
+			for _, project := range t.projectList {
+				options = append(options, project.Name)
+			}
+		case isCommand(command, contextCommands):
+			options = t.commandContexts
+		case isCommand(command, profileCommands):
+			options = t.profileNames()
+		case isCommand(command, logsCommands):
+			for _, pod := range t.allPods {
+				options = append(options, pod.Name)
+			}
+		}
+	}
+
+	var candidates []string
+	for _, option := range options {
+		if strings.HasPrefix(strings.ToLower(option), word) {
+			candidates = append(candidates, option)
+		}
+	}
+	return prefix, candidates
+}
+
+// completeCommand completes the word being typed, fully when one candidate
+// is left or otherwise to the prefix all candidates share
+func (t *TUI) completeCommand() {
+	prefix, candidates := t.commandCompletions(t.commandInput.Value())
+	if len(candidates) == 0 {
+		return
+	}
+
+	completion := candidates[0]
+	for _, candidate := range candidates[1:] {
+		for !strings.HasPrefix(candidate, completion) {
+			completion = completion[:len(completion)-1]
+		}
+	}
+
+	line := prefix + completion
+	if len(candidates) == 1 && prefix == "" {
+		name := candidates[0]
+		if isCommand(name, namespaceCommands) || isCommand(name, contextCommands) || isCommand(name, logsCommands) {
+			line += " "
+		}
+	}
+	if len(line) >= len(t.commandInput.Value()) {
+		t.commandInput.SetValue(line)
+		t.commandInput.CursorEnd()
+	}
+}
+
+// recallCommand moves through the command history, delta -1 for older
+func (t *TUI) recallCommand(delta int) {
+	index := t.commandHistoryIndex + delta
+	if index < 0 || index > len(t.commandHistory) {
+		return
+	}
+
+	t.commandHistoryIndex = index
+	if index == len(t.commandHistory) {
+		t.commandInput.SetValue("")
+	} else {
+		t.commandInput.SetValue(t.commandHistory[index])
+	}
+	t.commandInput.CursorEnd()
+}
+
+// rememberCommand adds a command to the history, skipping repeats
+func (t *TUI) rememberCommand(line string) {
+	if n := len(t.commandHistory); n > 0 && t.commandHistory[n-1] == line {
+		return
+	}
+	t.commandHistory = append(t.commandHistory, line)
+	if len(t.commandHistory) > constants.MaxCommandHistory {
+		t.commandHistory = t.commandHistory[len(t.commandHistory)-constants.MaxCommandHistory:]
+	}
+}
+
+// runCommand runs a ':' command line
+func (t *TUI) runCommand(line string) (tea.Cmd, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	name := strings.ToLower(fields[0])
+	arg := ""
+	if len(fields) > 1 {
+		arg = fields[1]
+	}
+
+	if tab, ok := commandTabs[name]; ok {
+		t.ActiveTab = tab
+		return t.handleTabSwitch(), nil
+	}
+
+	switch {
+	case isCommand(name, quitCommands):
+		return t.quit(), nil
+
+	case isCommand(name, topCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.openTop(), nil
+
+	case isCommand(name, infoCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.openClusterInfo(), nil
+
+	case isCommand(name, dashboardCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.openDashboard(), nil
+
+	case isCommand(name, operatorCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.openClusterOperators(), nil
+
+	case isCommand(name, helmCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.openHelm(), nil
+
+	case isCommand(name, diffCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.runDiffCommand(arg)
+
+	case isCommand(name, applyCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.runApplyCommand(arg)
+
+	case isCommand(name, filesCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		if _, ok := t.selectedLogPod(); t.ActiveTab != 0 || !ok {
+			return nil, fmt.Errorf("select a pod in the pods tab")
+		}
+		return t.openPodFiles(), nil
+
+	case isCommand(name, debugCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.runDebugCommand(arg)
+
+	case isCommand(name, quotaCommands):
+		if !t.connected || t.projectManager == nil {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		if arg == "" {
+			arg = t.namespace
+		}
+		return t.openProjectQuotas(arg), nil
+
+	case isCommand(name, checksCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		return t.openStartupChecks(), nil
+
+	case isCommand(name, profileCommands):
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+		if arg == "" {
+			return nil, t.openProfilePicker()
+		}
+		return t.openProfile(arg)
+
+	case isCommand(name, namespaceCommands), isCommand(name, contextCommands), isCommand(name, logsCommands):
+		if arg == "" {
+			return nil, fmt.Errorf("%s needs an argument", name)
+		}
+		if !t.connected {
+			return nil, fmt.Errorf("not connected to a cluster")
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown command %q", name)
+	}
+
+	switch {
+	case isCommand(name, namespaceCommands):
+		t.switchingProject = true
+		t.logInfo(categoryProject, "Switching to %s...", arg)
+		return t.switchToProject(projects.ProjectInfo{Name: arg}), nil
+
+	case isCommand(name, contextCommands):
+		return t.switchContext(arg)
+	}
+	return t.showPodLogs(arg)
+}
+
+// switchContext makes a kubeconfig context the active cluster. The previous
+// cluster stays connected as the second cluster.
+func (t *TUI) switchContext(name string) (tea.Cmd, error) {
+	if name == t.context {
+		return nil, nil
+	}
+	if t.peerCluster != nil && t.peerCluster.context == name {
+		return t.switchCluster(), nil
+	}
+	if !isCommand(name, t.commandContexts) {
+		return nil, fmt.Errorf("no context %q in the kubeconfig", name)
+	}
+	if t.connectingPeer != "" {
+		return nil, fmt.Errorf("already connecting to %s", t.obfuscateClusterContext(t.connectingPeer))
+	}
+
+	t.switchOnConnect = true
+	return t.connectPeerCluster(name), nil
+}
+
+// showPodLogs selects a pod on the pods tab and follows its logs. The name
+// may be a unique prefix of a pod name.
+func (t *TUI) showPodLogs(name string) (tea.Cmd, error) {
+	index := -1
+	for i, pod := range t.pods {
+		if pod.Name == name {
+			index = i
+			break
+		}
+		if strings.HasPrefix(pod.Name, name) {
+			if index >= 0 {
+				return nil, fmt.Errorf("%q matches more than one pod", name)
+			}
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("no pod %q in %s", name, t.namespace)
+	}
+
+	t.ActiveTab = models.TabPods
+	tabCmd := t.handleTabSwitch()
+	t.selectedPod = index
+	t.showLogs = true
+	if t.focusManager != nil {
+		t.focusManager.FocusPanel(2)
+	}
+	t.updateMainContent()
+	t.clearPodLogs()
+	return tea.Batch(tabCmd, t.startPodLogStream()), nil
+}
+
+// handleCommandPromptKeys handles typing in the ':' command prompt
+func (t *TUI) handleCommandPromptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		t.closeCommandPrompt()
+		return t, nil
+
+	case tea.KeyEnter:
+		line := strings.TrimSpace(t.commandInput.Value())
+		if line == "" {
+			t.closeCommandPrompt()
+			return t, nil
+		}
+		t.rememberCommand(line)
+		cmd, err := t.runCommand(line)
+		if err != nil {
+			// Keep the prompt open to correct the command
+			t.commandError = err.Error()
+			t.commandHistoryIndex = len(t.commandHistory)
+			return t, nil
+		}
+		t.closeCommandPrompt()
+		return t, cmd
+
+	case tea.KeyTab:
+		t.completeCommand()
+		return t, nil
+
+	case tea.KeyUp:
+		t.recallCommand(-1)
+		return t, nil
+
+	case tea.KeyDown:
+		t.recallCommand(1)
+		return t, nil
+
+	case tea.KeyBackspace:
+		if t.commandInput.Value() == "" {
+			t.closeCommandPrompt()
+			return t, nil
+		}
+	}
+
+	t.commandError = ""
+	var cmd tea.Cmd
+	t.commandInput, cmd = t.commandInput.Update(msg)
+	return t, cmd
+}
+
+// renderCommandPrompt renders the ':' command prompt in place of the status
+// bar, followed by the completions of the word being typed or an error
+func (t *TUI) renderCommandPrompt() string {
+	_, errorColor := t.getThemeColors()
+	barStyle := lipgloss.NewStyle().
+		Width(t.width).
+		MaxHeight(1).
+		Background(lipgloss.Color("236")).
+		Foreground(lipgloss.Color("15"))
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("242"))
+
+	line := t.commandInput.View()
+	switch {
+	case t.commandError != "":
+		line += "  " + lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.commandError)
+	case t.commandInput.Value() != "":
+		if _, candidates := t.commandCompletions(t.commandInput.Value()); len(candidates) > 1 {
+			line += "  " + hintStyle.Render(truncateString(strings.Join(candidates, " "), max(t.width/2, 10)))
+		}
+	}
+
+	return barStyle.Render(line)
+}
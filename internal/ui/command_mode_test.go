@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func typeCommand(tui *TUI, text string) {
+	for _, r := range text {
+		tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestCommandModeSwitchesTabs(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), width: 120, height: 40}
+	tui.openCommandPrompt()
+	typeCommand(tui, "deploy")
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if tui.ActiveTab != models.TabDeployments || tui.showCommandPrompt {
+		t.Fatalf("Expected :deploy to open the deployments tab, got tab %d", tui.ActiveTab)
+	}
+
+	tui.openCommandPrompt()
+	typeCommand(tui, "bogus")
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.showCommandPrompt || !strings.Contains(tui.commandError, "unknown command") {
+		t.Errorf("Expected an unknown command to keep the prompt open with an error")
+	}
+	if !strings.Contains(tui.renderCommandPrompt(), "unknown command") {
+		t.Errorf("Expected the error in the prompt")
+	}
+
+	// History recalls the newest command first
+	tui.commandInput.SetValue("")
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyUp})
+	if tui.commandInput.Value() != "bogus" {
+		t.Errorf("Expected the last command, got %q", tui.commandInput.Value())
+	}
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyUp})
+	if tui.commandInput.Value() != "deploy" {
+		t.Errorf("Expected the command before it, got %q", tui.commandInput.Value())
+	}
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyDown})
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyDown})
+	if tui.commandInput.Value() != "" {
+		t.Errorf("Expected to return to an empty line, got %q", tui.commandInput.Value())
+	}
+}
+
+func TestCommandModeCompletion(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	tui.allPods = []resources.PodInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web-5c9-a"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "web-5c9-b"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "worker-1"}},
+	}
+	tui.pods = tui.allPods
+
+	tui.openCommandPrompt()
+	typeCommand(tui, "lo")
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyTab})
+	if tui.commandInput.Value() != "logs " {
+		t.Fatalf("Expected the command to be completed, got %q", tui.commandInput.Value())
+	}
+
+	typeCommand(tui, "we")
+	tui.handleCommandPromptKeys(tea.KeyMsg{Type: tea.KeyTab})
+	if tui.commandInput.Value() != "logs web-5c9-" {
+		t.Errorf("Expected the shared prefix of the pods, got %q", tui.commandInput.Value())
+	}
+	if _, candidates := tui.commandCompletions(tui.commandInput.Value()); len(candidates) != 2 {
+		t.Errorf("Expected two candidates, got %v", candidates)
+	}
+
+	if _, err := tui.runCommand("logs web-5c9"); err == nil || !strings.Contains(err.Error(), "more than one") {
+		t.Errorf("Expected an ambiguous pod to be rejected, got %v", err)
+	}
+	tui.ActiveTab = models.TabServices
+	if _, err := tui.runCommand("logs worker"); err != nil {
+		t.Fatalf("Expected a unique prefix to select the pod, got %v", err)
+	}
+	if tui.ActiveTab != models.TabPods || tui.selectedPod != 2 || !tui.showLogs {
+		t.Errorf("Expected the pod's logs on the pods tab, got tab %d and pod %d", tui.ActiveTab, tui.selectedPod)
+	}
+
+	if _, err := tui.runCommand("ctx missing"); err == nil {
+		t.Errorf("Expected an unknown context to be rejected")
+	}
+	if _, err := tui.runCommand("ns"); err == nil {
+		t.Errorf("Expected a namespace to be required")
+	}
+}
@@ -0,0 +1,401 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// Formats of configmap values that are highlighted
+const (
+	valueFormatYAML       = "yaml"
+	valueFormatJSON       = "json"
+	valueFormatProperties = "properties"
+)
+
+var (
+	// propertiesLinePattern matches a key=value or key: value line of a properties file
+	propertiesLinePattern = regexp.MustCompile(`^[\w.\-]+\s*[=:]`)
+	// yamlLinePattern matches a key: value line of a YAML document
+	yamlLinePattern = regexp.MustCompile(`^(- )?[\w.\-"']+:(\s|$)`)
+)
+
+// loadConfigMapData loads the data of a configmap for the data modal
+func (t *TUI) loadConfigMapData(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.ConfigMapDataLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		data, err := t.resourceClient.GetConfigMapData(ctx, namespace, name)
+		if err != nil {
+			return messages.ConfigMapDataLoadError{Err: err}
+		}
+
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		return messages.ConfigMapDataLoaded{Namespace: namespace, ConfigMapName: name, Data: data, Keys: keys}
+	}
+}
+
+// openConfigMapModal loads the selected configmap into the data modal
+func (t *TUI) openConfigMapModal() tea.Cmd {
+	if t.selectedConfigMap >= len(t.configMaps) {
+		return nil
+	}
+	cm := t.configMaps[t.selectedConfigMap]
+	return t.loadConfigMapData(t.resourceNamespace(cm.Namespace), cm.Name)
+}
+
+// handleConfigMapDataLoaded shows the data modal, keeping the selected key
+// when the same configmap is reloaded after an edit
+func (t *TUI) handleConfigMapDataLoaded(msg messages.ConfigMapDataLoaded) {
+	selected := ""
+	if t.showConfigMapModal && t.configMapModalName == msg.ConfigMapName && t.selectedConfigMapKey < len(t.configMapModalKeys) {
+		selected = t.configMapModalKeys[t.selectedConfigMapKey]
+	}
+
+	t.configMapModalNamespace = msg.Namespace
+	t.configMapModalName = msg.ConfigMapName
+	t.configMapModalData = msg.Data
+	t.configMapModalKeys = msg.Keys
+	t.selectedConfigMapKey = 0
+	for i, key := range msg.Keys {
+		if key == selected {
+			t.selectedConfigMapKey = i
+		}
+	}
+	if selected == "" {
+		t.configMapValueScroll = 0
+	}
+	t.showConfigMapModal = true
+}
+
+// closeConfigMapModal dismisses the data modal
+func (t *TUI) closeConfigMapModal() {
+	t.showConfigMapModal = false
+	t.configMapModalData = nil
+	t.configMapModalKeys = nil
+	t.selectedConfigMapKey = 0
+	t.configMapValueScroll = 0
+}
+
+// configMapValueFormat guesses the format of a value from the extension of
+// its key, then from its content
+func configMapValueFormat(key, value string) string {
+	switch strings.ToLower(filepath.Ext(key)) {
+	case ".yaml", ".yml":
+		return valueFormatYAML
+	case ".json":
+		return valueFormatJSON
+	case ".properties", ".conf", ".cfg", ".ini", ".env":
+		return valueFormatProperties
+	}
+
+	trimmed := strings.TrimSpace(value)
+	if (strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")) && json.Valid([]byte(trimmed)) {
+		return valueFormatJSON
+	}
+	if !strings.Contains(trimmed, "\n") {
+		return ""
+	}
+	for _, line := range strings.Split(trimmed, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || line == "---" {
+			continue
+		}
+		switch {
+		case yamlLinePattern.MatchString(line):
+			return valueFormatYAML
+		case propertiesLinePattern.MatchString(line):
+			return valueFormatProperties
+		}
+		return ""
+	}
+	return ""
+}
+
+// colorizeJSONLine highlights the key of a JSON line and its string values
+func colorizeJSONLine(line string) string {
+	trimmed := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(trimmed)]
+	if !strings.HasPrefix(trimmed, `"`) {
+		return line
+	}
+
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("14"))
+	end := strings.Index(trimmed[1:], `"`)
+	if end < 0 {
+		return line
+	}
+	key, rest := trimmed[:end+2], trimmed[end+2:]
+	if !strings.HasPrefix(strings.TrimLeft(rest, " "), ":") {
+		// A string in an array
+		return indent + lipgloss.NewStyle().Foreground(lipgloss.Color("10")).Render(key) + rest
+	}
+	return indent + keyStyle.Render(key) + rest
+}
+
+// colorizePropertiesLine highlights the key of a properties line and dims comments
+func colorizePropertiesLine(line string) string {
+	trimmed := strings.TrimLeft(line, " \t")
+	if strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "!") || strings.HasPrefix(trimmed, ";") {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("242")).Render(line)
+	}
+
+	separator := strings.IndexAny(trimmed, "=:")
+	if separator <= 0 {
+		return line
+	}
+	indent := line[:len(line)-len(trimmed)]
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("14"))
+	return indent + keyStyle.Render(trimmed[:separator]) + trimmed[separator:]
+}
+
+// highlightConfigMapValue splits a value into lines highlighted for its format
+func highlightConfigMapValue(format, value string) []string {
+	lines := strings.Split(strings.TrimRight(value, "\n"), "\n")
+	colorize := func(line string) string { return line }
+	switch format {
+	case valueFormatYAML:
+		colorize = colorizeYAMLLine
+	case valueFormatJSON:
+		colorize = colorizeJSONLine
+	case valueFormatProperties:
+		colorize = colorizePropertiesLine
+	}
+
+	highlighted := make([]string, len(lines))
+	for i, line := range lines {
+		highlighted[i] = colorize(line)
+	}
+	return highlighted
+}
+
+// configMapValueHeight returns the number of value lines visible at once
+func (t *TUI) configMapValueHeight() int {
+	return max(t.height-constants.MaxConfigMapKeysShown-16, 3)
+}
+
+// selectedConfigMapValue returns the selected key of the data modal and its value
+func (t *TUI) selectedConfigMapValue() (string, string, bool) {
+	if t.selectedConfigMapKey >= len(t.configMapModalKeys) {
+		return "", "", false
+	}
+	key := t.configMapModalKeys[t.selectedConfigMapKey]
+	return key, t.configMapModalData[key], true
+}
+
+// renderConfigMapModal renders the configmap data modal
+func (t *TUI) renderConfigMapModal() string {
+	primaryColor, _ := t.getThemeColors()
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("242"))
+
+	modalWidth := min(120, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("📋 ConfigMap: %s (%d keys)", t.configMapModalName, len(t.configMapModalKeys))) + "\n\n")
+
+	if len(t.configMapModalKeys) == 0 {
+		content.WriteString("No data\n\n")
+		content.WriteString("esc/q: close")
+		return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modalStyle.Render(content.String()))
+	}
+
+	// Keys, scrolled to keep the selected one visible
+	start := max(t.selectedConfigMapKey-constants.MaxConfigMapKeysShown+1, 0)
+	end := min(start+constants.MaxConfigMapKeysShown, len(t.configMapModalKeys))
+	for i := start; i < end; i++ {
+		key := t.configMapModalKeys[i]
+		line := fmt.Sprintf("  %s (%d bytes)", key, len(t.configMapModalData[key]))
+		if i == t.selectedConfigMapKey {
+			line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render("► " + line[2:])
+		}
+		content.WriteString(line + "\n")
+	}
+	if len(t.configMapModalKeys) > end-start {
+		content.WriteString(dimStyle.Render(fmt.Sprintf("[%d-%d of %d keys]", start+1, end, len(t.configMapModalKeys))) + "\n")
+	}
+
+	// Value of the selected key
+	key, value, _ := t.selectedConfigMapValue()
+	format := configMapValueFormat(key, value)
+	title := "Value"
+	if format != "" {
+		title += " (" + format + ")"
+	}
+	content.WriteString("\n" + dimStyle.Render(fmt.Sprintf("── %s %s", title, strings.Repeat("─", max(modalWidth-len(title)-12, 0)))) + "\n")
+
+	lines := highlightConfigMapValue(format, value)
+	height := t.configMapValueHeight()
+	scroll := min(t.configMapValueScroll, max(len(lines)-height, 0))
+	for _, line := range lines[scroll:min(scroll+height, len(lines))] {
+		content.WriteString(lipgloss.NewStyle().MaxWidth(modalWidth-8).Render(line) + "\n")
+	}
+	if len(lines) > height {
+		content.WriteString(dimStyle.Render(fmt.Sprintf("[lines %d-%d of %d]", scroll+1, min(scroll+height, len(lines)), len(lines))) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: select key • pgup/pgdn: scroll value • e: edit in $EDITOR • c: copy value • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleConfigMapModalKeys handles key input for the configmap data modal
+func (t *TUI) handleConfigMapModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		t.closeConfigMapModal()
+
+	case "j", "down":
+		if t.selectedConfigMapKey < len(t.configMapModalKeys)-1 {
+			t.selectedConfigMapKey++
+			t.configMapValueScroll = 0
+		}
+
+	case "k", "up":
+		if t.selectedConfigMapKey > 0 {
+			t.selectedConfigMapKey--
+			t.configMapValueScroll = 0
+		}
+
+	case "pgdown", "ctrl+f", " ":
+		_, value, _ := t.selectedConfigMapValue()
+		maxScroll := max(strings.Count(strings.TrimRight(value, "\n"), "\n")+1-t.configMapValueHeight(), 0)
+		t.configMapValueScroll = min(t.configMapValueScroll+t.configMapValueHeight(), maxScroll)
+
+	case "pgup", "ctrl+b":
+		t.configMapValueScroll = max(t.configMapValueScroll-t.configMapValueHeight(), 0)
+
+	case "c":
+		if _, value, ok := t.selectedConfigMapValue(); ok {
+			return t, t.copyToClipboard(value)
+		}
+
+	case "e":
+		return t, t.editConfigMapValue()
+	}
+
+	return t, nil
+}
+
+// editConfigMapValue writes the selected value to a temporary file and
+// suspends the TUI while $EDITOR runs
+func (t *TUI) editConfigMapValue() tea.Cmd {
+	key, value, ok := t.selectedConfigMapValue()
+	if !ok {
+		return nil
+	}
+
+	file, err := os.CreateTemp("", constants.ConfigMapValueTempFilePattern+key)
+	if err != nil {
+		t.logError(categoryAction, "Failed to create temp file: %v", err)
+		return nil
+	}
+	path := file.Name()
+
+	if _, err := file.WriteString(value); err != nil {
+		file.Close()
+		os.Remove(path)
+		t.logError(categoryAction, "Failed to write temp file: %v", err)
+		return nil
+	}
+	file.Close()
+
+	namespace, name := t.configMapModalNamespace, t.configMapModalName
+	editor := editorCommand()
+	cmd := exec.Command(editor[0], append(editor[1:], path)...)
+	logging.Info(t.Logger, "Opening key %s of configmap %s in %s", key, name, editor[0])
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return messages.ConfigMapEditorFinished{
+			Namespace: namespace,
+			Name:      name,
+			Key:       key,
+			Path:      path,
+			Original:  value,
+			Err:       err,
+		}
+	})
+}
+
+// configMapValuePatch returns the merge patch setting one key of a configmap
+func configMapValuePatch(key, value string) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"data": map[string]string{key: value}})
+}
+
+// handleConfigMapEditorFinished patches the configmap with the edited value
+// if it changed, then reloads the data modal
+func (t *TUI) handleConfigMapEditorFinished(msg messages.ConfigMapEditorFinished) tea.Cmd {
+	defer os.Remove(msg.Path)
+
+	if msg.Err != nil {
+		t.logError(categoryAction, "Editor failed: %v", msg.Err)
+		return nil
+	}
+
+	edited, err := os.ReadFile(msg.Path)
+	if err != nil {
+		t.logError(categoryAction, "Failed to read edited file: %v", err)
+		return nil
+	}
+	if string(edited) == msg.Original {
+		t.logInfo(categoryAction, "Edit cancelled, no changes made to key %s of configmap %s", msg.Key, msg.Name)
+		return nil
+	}
+
+	patch, err := configMapValuePatch(msg.Key, string(edited))
+	if err != nil {
+		t.logError(categoryAction, "Failed to build the patch of configmap %s: %v", msg.Name, err)
+		return nil
+	}
+	writer, err := t.resourceWriterFor("ConfigMap")
+	if err != nil {
+		t.logError(categoryAction, "Cannot edit configmap %s: %v", msg.Name, err)
+		return nil
+	}
+
+	return t.runTask(fmt.Sprintf("Update key %s of configmap %s", msg.Key, msg.Name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return writer.Patch(ctx, "ConfigMap", msg.Namespace, msg.Name, types.MergePatchType, patch)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to update configmap %s: %v", msg.Name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Updated key %s of configmap %s", msg.Key, msg.Name)
+			return tea.Batch(t.loadConfigMapData(msg.Namespace, msg.Name), t.loadConfigMaps())
+		})
+}
@@ -0,0 +1,106 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestConfigMapValueFormat(t *testing.T) {
+	tests := []struct {
+		key, value, want string
+	}{
+		{"application.yaml", "", valueFormatYAML},
+		{"settings.json", "", valueFormatJSON},
+		{"app.properties", "", valueFormatProperties},
+		{"config", `{"debug": true}`, valueFormatJSON},
+		{"config", "# server\nserver:\n  port: 8080\n", valueFormatYAML},
+		{"config", "# server\nserver.port=8080\nserver.host=0.0.0.0\n", valueFormatProperties},
+		{"LOG_LEVEL", "debug", ""},
+		{"motd", "Welcome to the shop\nBe nice\n", ""},
+	}
+	for _, tt := range tests {
+		if got := configMapValueFormat(tt.key, tt.value); got != tt.want {
+			t.Errorf("configMapValueFormat(%q, %q) = %q, want %q", tt.key, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestColorizeLines(t *testing.T) {
+	if got := colorizePropertiesLine("# comment"); !strings.Contains(got, "# comment") {
+		t.Errorf("Expected the comment kept, got %q", got)
+	}
+	if got := colorizePropertiesLine("no separator"); got != "no separator" {
+		t.Errorf("Expected a line without key left as is, got %q", got)
+	}
+	if got := colorizeJSONLine(`  "port": 8080,`); !strings.HasPrefix(got, "  ") || !strings.HasSuffix(got, `: 8080,`) {
+		t.Errorf("Expected the indent and value kept, got %q", got)
+	}
+	if got := colorizeJSONLine("  }"); got != "  }" {
+		t.Errorf("Expected a closing brace left as is, got %q", got)
+	}
+}
+
+func TestConfigMapModal(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 120, height: 40}
+	tui.handleConfigMapDataLoaded(messages.ConfigMapDataLoaded{
+		Namespace:     "shop",
+		ConfigMapName: "web-config",
+		Data:          map[string]string{"LOG_LEVEL": "debug", "application.yaml": "server:\n  port: 8080\n"},
+		Keys:          []string{"LOG_LEVEL", "application.yaml"},
+	})
+	if !tui.showConfigMapModal {
+		t.Fatalf("Expected the data modal to open")
+	}
+
+	tui.handleConfigMapModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	rendered := tui.renderConfigMapModal()
+	for _, want := range []string{"web-config (2 keys)", "► application.yaml", "Value (yaml)", "8080"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the modal to contain %q, got %q", want, rendered)
+		}
+	}
+
+	// A reload after an edit keeps the selected key
+	tui.handleConfigMapDataLoaded(messages.ConfigMapDataLoaded{
+		Namespace:     "shop",
+		ConfigMapName: "web-config",
+		Data:          map[string]string{"LOG_LEVEL": "debug", "application.yaml": "server:\n  port: 9090\n", "a.txt": ""},
+		Keys:          []string{"LOG_LEVEL", "a.txt", "application.yaml"},
+	})
+	if key, _, _ := tui.selectedConfigMapValue(); key != "application.yaml" {
+		t.Errorf("Expected application.yaml still selected, got %q", key)
+	}
+
+	tui.handleConfigMapModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showConfigMapModal || tui.configMapModalData != nil {
+		t.Errorf("Expected esc to close the modal")
+	}
+}
+
+func TestConfigMapEditorFinishedUnchanged(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	file, err := os.CreateTemp(t.TempDir(), "value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString("debug")
+	file.Close()
+
+	if cmd := tui.handleConfigMapEditorFinished(messages.ConfigMapEditorFinished{Name: "web-config", Key: "LOG_LEVEL", Path: file.Name(), Original: "debug"}); cmd != nil {
+		t.Errorf("Expected no patch for an unchanged value")
+	}
+	if _, err := os.Stat(file.Name()); !os.IsNotExist(err) {
+		t.Errorf("Expected the temp file removed")
+	}
+
+	patch, err := configMapValuePatch("LOG_LEVEL", "info")
+	if err != nil || string(patch) != `{"data":{"LOG_LEVEL":"info"}}` {
+		t.Errorf("Expected a merge patch of the key, got %s (%v)", patch, err)
+	}
+}
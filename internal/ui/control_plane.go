@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadControlPlaneHealth probes control-plane health. On OpenShift the
+// operator conditions for the control-plane components are included as well.
+func (t *TUI) loadControlPlaneHealth() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.ControlPlaneHealthLoadError{Err: fmt.Errorf("not connected")}
+		}
+
+		var components []resources.ComponentHealthInfo
+		var errs []string
+
+		if osClient, ok := t.k8sClient.(k8s.OpenShiftClient); ok && osClient.IsOpenShift() {
+			osResourceClient := resources.NewOpenShiftResourceClient(osClient)
+			operatorHealth, err := loadWithRetry(t, "clusteroperators", osResourceClient.GetControlPlaneOperatorHealth)
+			if err != nil {
+				errs = append(errs, err.Error())
+			} else {
+				components = append(components, operatorHealth...)
+			}
+		}
+
+		probeHealth, err := loadWithRetry(t, "controlplane", t.resourceClient.GetControlPlaneHealth)
+		if err != nil {
+			errs = append(errs, err.Error())
+		} else {
+			components = append(components, probeHealth...)
+		}
+
+		if len(components) == 0 && len(errs) > 0 {
+			return messages.ControlPlaneHealthLoadError{Err: fmt.Errorf("%s", strings.Join(errs, "; "))}
+		}
+
+		return messages.ControlPlaneHealthLoaded{Components: components}
+	}
+}
+
+// openControlPlaneModal shows the control-plane modal and starts loading health data
+func (t *TUI) openControlPlaneModal() tea.Cmd {
+	t.showControlPlaneModal = true
+	t.loadingControlPlane = true
+	t.controlPlaneError = ""
+	return t.loadControlPlaneHealth()
+}
+
+// handleControlPlaneHealthLoaded stores the probe results
+func (t *TUI) handleControlPlaneHealthLoaded(msg messages.ControlPlaneHealthLoaded) {
+	t.controlPlaneHealth = msg.Components
+	t.loadingControlPlane = false
+	t.controlPlaneError = ""
+
+	unhealthy := 0
+	for _, c := range msg.Components {
+		if !c.Healthy {
+			unhealthy++
+		}
+	}
+	logging.Info(t.Logger, "Control plane health: %d components, %d unhealthy", len(msg.Components), unhealthy)
+	if unhealthy > 0 {
+		t.logWarn(categoryConnection, "Control plane: %d of %d checks unhealthy", unhealthy, len(msg.Components))
+	}
+}
+
+// handleControlPlaneHealthLoadError records a failed probe
+func (t *TUI) handleControlPlaneHealthLoadError(msg messages.ControlPlaneHealthLoadError) {
+	t.controlPlaneHealth = nil
+	t.loadingControlPlane = false
+	t.controlPlaneError = msg.Err.Error()
+	t.logError(categoryConnection, "Failed to load control plane health: %v", msg.Err)
+}
+
+// renderControlPlaneModal renders the control-plane health modal
+func (t *TUI) renderControlPlaneModal() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(90, t.width-4)
+	modalHeight := min(30, t.height-4)
+
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4).
+		Height(modalHeight - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🩺 Control Plane Health") + "\n\n")
+
+	switch {
+	case t.loadingControlPlane:
+		content.WriteString("⏳ Probing control plane...\n")
+	case t.controlPlaneError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("❌ "+t.controlPlaneError) + "\n\n")
+		content.WriteString("Control-plane endpoints may be restricted by RBAC.\n")
+	case len(t.controlPlaneHealth) == 0:
+		content.WriteString("No control-plane health information available\n")
+	default:
+		sectionStyle := lipgloss.NewStyle().Bold(true)
+
+		maxLines := modalHeight - 10
+		lines := 0
+		lastSource := ""
+		for _, c := range t.controlPlaneHealth {
+			if lines >= maxLines {
+				content.WriteString(fmt.Sprintf("... and more (%d checks total)\n", len(t.controlPlaneHealth)))
+				break
+			}
+			if c.Source != lastSource {
+				if lastSource != "" {
+					content.WriteString("\n")
+				}
+				content.WriteString(sectionStyle.Render(controlPlaneSourceTitle(c.Source)) + "\n")
+				lastSource = c.Source
+				lines += 2
+			}
+
+			indicator := t.statusIndicator(statusOK, "✓")
+			if !c.Healthy {
+				indicator = t.statusIndicator(statusFailed, "✗")
+			}
+			line := fmt.Sprintf(" %s %-40s %s", indicator, truncateString(c.Name, 40), c.Status)
+			if c.Message != "" {
+				line += " - " + truncateString(c.Message, max(modalWidth-60, 10))
+			}
+			content.WriteString(line + "\n")
+			lines++
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("r: refresh • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// controlPlaneSourceTitle returns the section title for a health source
+func controlPlaneSourceTitle(source string) string {
+	switch source {
+	case resources.HealthSourceClusterOperator:
+		return "Cluster Operators"
+	case resources.HealthSourceReadyz:
+		return "API Server Readiness (/readyz)"
+	case resources.HealthSourceComponentStatus:
+		return "Component Statuses"
+	default:
+		return source
+	}
+}
+
+// handleControlPlaneModalKeys handles key input for the control-plane modal
+func (t *TUI) handleControlPlaneModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "H":
+		t.showControlPlaneModal = false
+		return t, nil
+
+	case "r":
+		if t.loadingControlPlane {
+			return t, nil
+		}
+		return t, t.openControlPlaneModal()
+	}
+
+	return t, nil
+}
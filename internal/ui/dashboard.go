@@ -0,0 +1,356 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// dashboardKey opens the overview of the current namespace
+const dashboardKey = "B"
+
+// dashboardListed is how many failing objects and warnings each section of
+// the dashboard lists
+const dashboardListed = 5
+
+// dashboardPhases are the pod phases in the order the dashboard shows them
+var dashboardPhases = []string{"Running", "Pending", "Succeeded", "Failed", "Unknown"}
+
+// dashboard is the health summary of a namespace and its cluster
+type dashboard struct {
+	Namespace string
+	Version   string
+	OpenShift bool
+
+	Pods      int
+	PodPhases map[string]int
+
+	Deployments            int
+	UnavailableDeployments []resources.DeploymentInfo
+
+	Builds       int
+	FailedBuilds []resources.BuildInfo
+
+	Warnings       int
+	RecentWarnings []resources.EventInfo
+
+	Operators          int
+	UnhealthyOperators []resources.ClusterOperatorInfo
+
+	// Errors are the sections that could not be loaded, e.g. for lack of
+	// permissions
+	Errors []string
+}
+
+// loadDashboard lists the pods, deployments, builds, events and cluster
+// operators the dashboard summarizes, all at once
+func (t *TUI) loadDashboard() tea.Cmd {
+	namespace, version := t.namespace, t.clusterVersion
+	k8sClient, resourceClient := t.k8sClient, t.resourceClient
+	return func() tea.Msg {
+		opts := resources.ListOptions{Namespace: namespace}
+		osClient, isOpenShift := k8sClient.(k8s.OpenShiftClient)
+		isOpenShift = isOpenShift && osClient.IsOpenShift()
+
+		var (
+			wg          sync.WaitGroup
+			mu          sync.Mutex
+			errs        []string
+			pods        []resources.PodInfo
+			deployments []resources.DeploymentInfo
+			builds      []resources.BuildInfo
+			events      []resources.EventInfo
+			operators   []resources.ClusterOperatorInfo
+		)
+		section := func(name string, load func() error) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := load(); err != nil {
+					mu.Lock()
+					errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+					mu.Unlock()
+				}
+			}()
+		}
+
+		section("pods", func() error {
+			list, err := loadAllPages(t, "pods", opts, resourceClient.ListPods)
+			if err == nil {
+				pods = list.Items
+			}
+			return err
+		})
+		section("deployments", func() error {
+			list, err := loadAllPages(t, "deployments", opts, resourceClient.ListDeployments)
+			if err == nil {
+				deployments = list.Items
+			}
+			return err
+		})
+		section("events", func() error {
+			list, err := loadAllPages(t, "events", opts, resourceClient.ListEvents)
+			if err == nil {
+				events = list.Items
+			}
+			return err
+		})
+		if version == "" {
+			section("version", func() error {
+				info, err := loadWithRetry(t, "version", resourceClient.GetServerInfo)
+				if err == nil {
+					if gitVersion, _ := info["version"].(string); gitVersion != "" {
+						version = "Kubernetes " + gitVersion
+					}
+				}
+				return err
+			})
+		}
+		if isOpenShift {
+			osResourceClient := resources.NewOpenShiftResourceClient(osClient)
+			section("builds", func() error {
+				list, err := loadAllPages(t, "builds", opts, osResourceClient.ListBuilds)
+				if err == nil {
+					builds = list.Items
+				}
+				return err
+			})
+			section("cluster operators", func() error {
+				list, err := loadWithRetry(t, "clusteroperators", func(ctx context.Context) (*resources.ResourceList[resources.ClusterOperatorInfo], error) {
+					return osResourceClient.ListClusterOperators(ctx, resources.ListOptions{})
+				})
+				if err == nil {
+					operators = list.Items
+				}
+				return err
+			})
+		}
+		wg.Wait()
+
+		sort.Strings(errs)
+		return messages.DashboardLoaded{
+			Namespace:   namespace,
+			Version:     version,
+			OpenShift:   isOpenShift,
+			Pods:        pods,
+			Deployments: deployments,
+			Builds:      builds,
+			Events:      events,
+			Operators:   operators,
+			Errors:      errs,
+		}
+	}
+}
+
+// summarizeDashboard counts the objects of a namespace and picks the ones
+// that need attention, most recent first
+func summarizeDashboard(namespace string, pods []resources.PodInfo, deployments []resources.DeploymentInfo, builds []resources.BuildInfo, events []resources.EventInfo, operators []resources.ClusterOperatorInfo) *dashboard {
+	d := &dashboard{
+		Namespace:   namespace,
+		Pods:        len(pods),
+		PodPhases:   make(map[string]int),
+		Deployments: len(deployments),
+		Builds:      len(builds),
+		Operators:   len(operators),
+	}
+
+	for _, pod := range pods {
+		d.PodPhases[pod.Phase]++
+	}
+	for _, deployment := range deployments {
+		if deployment.AvailableReplicas < deployment.Replicas {
+			d.UnavailableDeployments = append(d.UnavailableDeployments, deployment)
+		}
+	}
+
+	for _, build := range builds {
+		if build.Phase == "Failed" || build.Phase == "Error" {
+			d.FailedBuilds = append(d.FailedBuilds, build)
+		}
+	}
+	sort.SliceStable(d.FailedBuilds, func(i, j int) bool {
+		return d.FailedBuilds[i].CreatedAt.After(d.FailedBuilds[j].CreatedAt)
+	})
+
+	warnings := resources.WarningEvents(events)
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return warnings[i].LastSeen.After(warnings[j].LastSeen)
+	})
+	d.Warnings = len(warnings)
+	d.RecentWarnings = warnings[:min(len(warnings), dashboardListed)]
+
+	for _, operator := range operators {
+		if operator.Available != "True" || operator.Degraded == "True" {
+			d.UnhealthyOperators = append(d.UnhealthyOperators, operator)
+		}
+	}
+	return d
+}
+
+// openDashboard shows the dashboard of the current namespace and loads it
+func (t *TUI) openDashboard() tea.Cmd {
+	if !t.connected || t.resourceClient == nil {
+		return nil
+	}
+	t.showDashboard = true
+	t.loadingDashboard = true
+	t.dashboardScroll = 0
+	return t.loadDashboard()
+}
+
+// handleDashboardLoaded summarizes the listed objects into the dashboard,
+// unless the project was switched while they loaded
+func (t *TUI) handleDashboardLoaded(msg messages.DashboardLoaded) {
+	if msg.Namespace != t.namespace {
+		return
+	}
+	d := summarizeDashboard(msg.Namespace, msg.Pods, msg.Deployments, msg.Builds, msg.Events, msg.Operators)
+	d.Version = msg.Version
+	d.OpenShift = msg.OpenShift
+	d.Errors = msg.Errors
+	t.dashboard = d
+	t.loadingDashboard = false
+}
+
+// dashboardLines renders the sections of the dashboard
+func (t *TUI) dashboardLines(d *dashboard) []string {
+	heading := lipgloss.NewStyle().Bold(true)
+	health := func(failing int) string {
+		if failing > 0 {
+			return t.statusIndicator(statusFailed, "●")
+		}
+		return t.statusIndicator(statusOK, "●")
+	}
+
+	version := d.Version
+	if version == "" {
+		version = "version unknown"
+	}
+	lines := []string{heading.Render("Cluster") + "  " + version}
+	if d.OpenShift {
+		lines = append(lines, fmt.Sprintf("  %s Cluster operators: %d of %d healthy",
+			health(len(d.UnhealthyOperators)), d.Operators-len(d.UnhealthyOperators), d.Operators))
+		for _, operator := range d.UnhealthyOperators {
+			lines = append(lines, fmt.Sprintf("    %-32s available %s, degraded %s", truncateString(operator.Name, 32), operator.Available, operator.Degraded))
+		}
+	}
+
+	var phases []string
+	for _, phase := range dashboardPhases {
+		if count := d.PodPhases[phase]; count > 0 {
+			phases = append(phases, fmt.Sprintf("%d %s", count, strings.ToLower(phase)))
+		}
+	}
+	if len(phases) == 0 {
+		phases = append(phases, "none")
+	}
+	lines = append(lines, "", heading.Render("Workloads"),
+		fmt.Sprintf("  %s Pods: %d (%s)", health(d.PodPhases["Failed"]+d.PodPhases["Unknown"]), d.Pods, strings.Join(phases, ", ")),
+		fmt.Sprintf("  %s Deployments: %d of %d fully available",
+			health(len(d.UnavailableDeployments)), d.Deployments-len(d.UnavailableDeployments), d.Deployments))
+	for _, deployment := range d.UnavailableDeployments[:min(len(d.UnavailableDeployments), dashboardListed)] {
+		lines = append(lines, fmt.Sprintf("    %-32s %d/%d available", truncateString(deployment.Name, 32), deployment.AvailableReplicas, deployment.Replicas))
+	}
+	if d.OpenShift {
+		lines = append(lines, fmt.Sprintf("  %s Builds: %d, %d failed", health(len(d.FailedBuilds)), d.Builds, len(d.FailedBuilds)))
+		for _, build := range d.FailedBuilds[:min(len(d.FailedBuilds), dashboardListed)] {
+			lines = append(lines, fmt.Sprintf("    %-32s %s", truncateString(build.Name, 32), truncateString(build.Message, 60)))
+		}
+	}
+
+	lines = append(lines, "", heading.Render(fmt.Sprintf("Warning events (%d)", d.Warnings)))
+	if d.Warnings == 0 {
+		lines = append(lines, "  none")
+	}
+	for _, event := range d.RecentWarnings {
+		lines = append(lines, eventTypeStyle(event.Type).Render(fmt.Sprintf("  %-10s %-20s %s",
+			t.formatTimeAgo(event.LastSeen, event.Age), truncateString(event.Reason, 20), eventObject(event)))+
+			"  "+truncateString(event.Message, 50))
+	}
+
+	if len(d.Errors) > 0 {
+		lines = append(lines, "", "Not readable with your permissions or on this cluster:")
+		for _, err := range d.Errors {
+			lines = append(lines, t.statusStyle(statusFailed).Render("  "+truncateString(err, 90)))
+		}
+	}
+	return lines
+}
+
+// dashboardVisibleRows returns the number of lines the dashboard has room for
+func (t *TUI) dashboardVisibleRows() int {
+	return max(t.height-12, 3)
+}
+
+// renderDashboard renders the dashboard
+func (t *TUI) renderDashboard() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(110, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("📋 Overview: "+t.namespace) + "\n\n")
+
+	switch {
+	case t.dashboard == nil || t.dashboard.Namespace != t.namespace:
+		content.WriteString(fmt.Sprintf("%s Loading overview...\n", t.getLoadingSpinner()))
+	default:
+		if t.loadingDashboard {
+			content.WriteString(fmt.Sprintf("%s Reloading...\n\n", t.getLoadingSpinner()))
+		}
+		lines := t.dashboardLines(t.dashboard)
+		visible := t.dashboardVisibleRows()
+		start := min(t.dashboardScroll, max(len(lines)-visible, 0))
+		end := min(start+visible, len(lines))
+		for _, line := range lines[start:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d lines]\n", start+1, end, len(lines)))
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: scroll • r: reload • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleDashboardKeys handles key input for the dashboard
+func (t *TUI) handleDashboardKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", dashboardKey:
+		t.showDashboard = false
+
+	case "r":
+		t.loadingDashboard = true
+		return t, t.loadDashboard()
+
+	case "j", "down":
+		if t.dashboard != nil && t.dashboardScroll < len(t.dashboardLines(t.dashboard))-t.dashboardVisibleRows() {
+			t.dashboardScroll++
+		}
+
+	case "k", "up":
+		if t.dashboardScroll > 0 {
+			t.dashboardScroll--
+		}
+	}
+
+	return t, nil
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestDashboard(t *testing.T) {
+	now := time.Now()
+	pods := []resources.PodInfo{
+		testPod("api-1", "Running", "node-a", 0, time.Hour),
+		testPod("api-2", "Running", "node-a", 0, time.Hour),
+		testPod("job-1", "Failed", "node-b", 0, time.Hour),
+	}
+	deployments := []resources.DeploymentInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "api"}, Replicas: 2, AvailableReplicas: 2},
+		{ResourceInfo: resources.ResourceInfo{Name: "worker"}, Replicas: 3, AvailableReplicas: 1},
+	}
+	builds := []resources.BuildInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "api-1", CreatedAt: now.Add(-time.Hour)}, Phase: "Failed", Message: "Dockerfile not found"},
+		{ResourceInfo: resources.ResourceInfo{Name: "api-2", CreatedAt: now}, Phase: "Complete"},
+	}
+	events := []resources.EventInfo{
+		{Type: "Warning", Reason: "BackOff", InvolvedKind: "Pod", InvolvedName: "api-1", LastSeen: now.Add(-2 * time.Minute)},
+		{Type: "Warning", Reason: "FailedMount", InvolvedKind: "Pod", InvolvedName: "api-2", LastSeen: now.Add(-time.Minute)},
+		{Type: "Normal", Reason: "Pulled", InvolvedKind: "Pod", InvolvedName: "api-1", LastSeen: now},
+	}
+	operators := []resources.ClusterOperatorInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "ingress"}, Available: "True", Degraded: "False"},
+		{ResourceInfo: resources.ResourceInfo{Name: "dns"}, Available: "True", Degraded: "True"},
+	}
+
+	d := summarizeDashboard("shop", pods, deployments, builds, events, operators)
+	if d.PodPhases["Running"] != 2 || d.PodPhases["Failed"] != 1 {
+		t.Errorf("Expected the pods counted by phase, got %v", d.PodPhases)
+	}
+	if len(d.UnavailableDeployments) != 1 || len(d.FailedBuilds) != 1 || len(d.UnhealthyOperators) != 1 {
+		t.Errorf("Expected worker, api-1 and dns to need attention, got %+v", d)
+	}
+	if d.Warnings != 2 || d.RecentWarnings[0].Reason != "FailedMount" {
+		t.Errorf("Expected the warnings most recent first, got %+v", d.RecentWarnings)
+	}
+
+	d.OpenShift, d.Version = true, "OpenShift 4.16.3"
+	tui := &TUI{App: models.NewApp("test"), namespace: "shop"}
+	lines := strings.Join(tui.dashboardLines(d), "\n")
+	for _, want := range []string{
+		"OpenShift 4.16.3",
+		"Cluster operators: 1 of 2 healthy",
+		"Pods: 3 (2 running, 1 failed)",
+		"Deployments: 1 of 2 fully available",
+		"worker                           1/3 available",
+		"Builds: 2, 1 failed",
+		"Dockerfile not found",
+		"Warning events (2)",
+	} {
+		if !strings.Contains(lines, want) {
+			t.Errorf("Expected %q in the overview, got\n%s", want, lines)
+		}
+	}
+
+	// An overview of the previous project is not shown
+	tui.showDashboard, tui.loadingDashboard = true, true
+	tui.handleDashboardLoaded(messages.DashboardLoaded{Namespace: "other"})
+	if tui.dashboard != nil || !tui.loadingDashboard {
+		t.Errorf("Expected the overview of another namespace to be ignored")
+	}
+
+	tui.handleDashboardLoaded(messages.DashboardLoaded{Namespace: "shop", Version: "OpenShift 4.16.3", Pods: pods, Errors: []string{"events: forbidden"}})
+	if tui.dashboard == nil || tui.dashboard.Pods != 3 || tui.dashboard.Version != "OpenShift 4.16.3" || len(tui.dashboard.Errors) != 1 {
+		t.Errorf("Expected the listed objects to be summarized, got %+v", tui.dashboard)
+	}
+}
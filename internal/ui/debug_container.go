@@ -0,0 +1,243 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// debugShell is the shell started in debug containers, which busybox and
+// the usual debug images have
+const debugShell = "sh"
+
+// podDebugger returns the client attaching ephemeral debug containers
+func (t *TUI) podDebugger() (resources.PodDebugger, bool) {
+	debugger, ok := t.resourceClient.(resources.PodDebugger)
+	return debugger, ok && t.connected
+}
+
+// openDebugContainer asks to attach a debug container running image to the
+// selected pod, targeting the container whose logs are shown. An empty
+// image uses the configured one.
+func (t *TUI) openDebugContainer(image string) {
+	pod, ok := t.selectedLogPod()
+	if t.ActiveTab != 0 || !ok {
+		return
+	}
+	if _, ok := t.podDebugger(); !ok {
+		t.logError(categoryAction, "Cannot debug pods: resource client does not support ephemeral containers")
+		return
+	}
+	if pod.Phase != "Running" {
+		t.logWarn(categoryAction, "Pod %s is %s, debug containers need a running pod", pod.Name, pod.Phase)
+		return
+	}
+
+	var targets []string
+	for _, container := range pod.ContainerInfo {
+		targets = append(targets, container.Name)
+	}
+	if len(targets) == 0 {
+		return
+	}
+	target := targets[0]
+	if logTarget := t.currentLogTarget(pod); slices.Contains(targets, logTarget.Container) {
+		target = logTarget.Container
+	}
+	if image == "" {
+		image = t.prefs.DebugImageName()
+	}
+
+	t.showDebugContainer = true
+	t.debugNamespace = t.resourceNamespace(pod.Namespace)
+	t.debugPod = pod.Name
+	t.debugTargets = targets
+	t.debugTarget = target
+	t.debugImage = image
+	t.debugContainer = ""
+}
+
+// startDebugContainer attaches the debug container in the background and
+// offers a shell in it once it runs
+func (t *TUI) startDebugContainer() tea.Cmd {
+	debugger, ok := t.podDebugger()
+	if !ok {
+		return nil
+	}
+
+	t.showDebugContainer = false
+	namespace, pod, target, image := t.debugNamespace, t.debugPod, t.debugTarget, t.debugImage
+	var name string
+	return t.runTask(fmt.Sprintf("Debug %s with %s", pod, image),
+		func(ctx context.Context, _ func(done, total int)) error {
+			var err error
+			name, err = debugger.AddDebugContainer(ctx, namespace, pod, target, image)
+			return err
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				t.logError(categoryAction, "Failed to debug %s: %v", pod, err)
+				return nil
+			}
+			t.debugContainerStarted(namespace, pod, name)
+			return nil
+		})
+}
+
+// debugContainerStarted offers a shell in a debug container that runs
+func (t *TUI) debugContainerStarted(namespace, pod, name string) {
+	t.logSuccess(categoryAction, "Debug container %s is running in %s", name, pod)
+	t.showDebugContainer = true
+	t.debugNamespace = namespace
+	t.debugPod = pod
+	t.debugContainer = name
+}
+
+// debugShellArgs returns the arguments of the oc or kubectl command opening
+// a shell in the debug container
+func (t *TUI) debugShellArgs() []string {
+	args := []string{"exec", "-it", "-n", t.debugNamespace, t.debugPod, "-c", t.debugContainer}
+	if t.authProvider != nil && t.authProvider.GetContext() != "" {
+		args = append(args, "--context", t.authProvider.GetContext())
+	}
+	return append(args, "--", debugShell)
+}
+
+// debugShellCommand returns the command opening a shell in the debug
+// container, or nil when neither oc nor kubectl is installed
+func (t *TUI) debugShellCommand() *exec.Cmd {
+	for _, cli := range []string{t.cliName(""), "oc", "kubectl"} {
+		cliPath, err := exec.LookPath(cli)
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(cliPath, t.debugShellArgs()...)
+		if t.KubeconfigPath != "" {
+			cmd.Env = append(os.Environ(), "KUBECONFIG="+t.KubeconfigPath)
+		}
+		return cmd
+	}
+	return nil
+}
+
+// debugShellCommandLine returns the command opening a shell in the debug
+// container as it is typed
+func (t *TUI) debugShellCommandLine() string {
+	return t.cliName("") + " " + strings.Join(t.debugShellArgs(), " ")
+}
+
+// execDebugContainer suspends the TUI while a shell runs in the debug
+// container
+func (t *TUI) execDebugContainer() tea.Cmd {
+	cmd := t.debugShellCommand()
+	if cmd == nil {
+		t.logWarn(categoryAction, "oc and kubectl not found in PATH: run '%s' in another terminal", t.debugShellCommandLine())
+		return nil
+	}
+
+	t.showDebugContainer = false
+	pod, container := t.debugPod, t.debugContainer
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return messages.DebugShellFinished{Pod: pod, Container: container, Err: err}
+	})
+}
+
+// handleDebugShellFinished reports how the shell in a debug container ended
+func (t *TUI) handleDebugShellFinished(msg messages.DebugShellFinished) {
+	if msg.Err != nil {
+		t.logError(categoryAction, "Shell in %s/%s failed: %v", msg.Pod, msg.Container, msg.Err)
+		return
+	}
+	t.logInfo(categoryAction, "Left %s/%s, the debug container stays until the pod is deleted", msg.Pod, msg.Container)
+}
+
+// runDebugCommand runs :debug, attaching a debug container running image,
+// or the configured one without it, to the selected pod
+func (t *TUI) runDebugCommand(image string) (tea.Cmd, error) {
+	if _, ok := t.podDebugger(); !ok {
+		return nil, fmt.Errorf("resource client does not support ephemeral containers")
+	}
+	if _, ok := t.selectedLogPod(); t.ActiveTab != 0 || !ok {
+		return nil, fmt.Errorf("select a pod in the pods tab")
+	}
+	t.openDebugContainer(image)
+	return nil, nil
+}
+
+// renderDebugContainer renders the debug container confirmation, or the
+// shell prompt once the container runs
+func (t *TUI) renderDebugContainer() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(80, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("🐞 Debug %s", t.debugPod)) + "\n\n")
+
+	if t.debugContainer != "" {
+		content.WriteString(fmt.Sprintf("Debug container %s is running.\n\n", t.debugContainer))
+		content.WriteString(dimStyle.Render(truncateString("$ "+t.debugShellCommandLine(), modalWidth-8)) + "\n\n")
+		content.WriteString("enter: open a shell • c: copy the command • esc: close")
+		return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modalStyle.Render(content.String()))
+	}
+
+	content.WriteString(fmt.Sprintf("Image:     %s\n", t.debugImage))
+	content.WriteString(fmt.Sprintf("Target:    %s", t.debugTarget))
+	if len(t.debugTargets) > 1 {
+		content.WriteString(dimStyle.Render(fmt.Sprintf("  (%d containers, tab to switch)", len(t.debugTargets))))
+	}
+	content.WriteString("\n\n")
+	content.WriteString(dimStyle.Render("An ephemeral container sharing the target's processes is added to the pod. It cannot be removed and stays until the pod is deleted.") + "\n\n")
+	if t.dryRun {
+		content.WriteString("Dry run is on, the container is validated but not added.\n\n")
+	}
+	content.WriteString("enter: attach • tab: target container • esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleDebugContainerKeys handles key input for the debug container
+// confirmation and shell prompt
+func (t *TUI) handleDebugContainerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.debugContainer != "" {
+		switch msg.String() {
+		case "enter", "e":
+			return t, t.execDebugContainer()
+		case "c":
+			command := t.debugShellCommandLine()
+			t.logInfo(categoryAction, "$ %s", command)
+			return t, t.copyToClipboard(command)
+		case "esc", "q":
+			t.showDebugContainer = false
+		}
+		return t, nil
+	}
+
+	switch msg.String() {
+	case "enter", "y":
+		return t, t.startDebugContainer()
+	case "tab":
+		if len(t.debugTargets) > 0 {
+			t.debugTarget = t.debugTargets[(slices.Index(t.debugTargets, t.debugTarget)+1)%len(t.debugTargets)]
+		}
+	case "esc", "q", "n":
+		t.showDebugContainer = false
+	}
+	return t, nil
+}
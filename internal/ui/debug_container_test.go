@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// stubDebugger is a resource client attaching debug containers
+type stubDebugger struct {
+	resources.ResourceClient
+}
+
+func (stubDebugger) AddDebugContainer(ctx context.Context, namespace, pod, target, image string) (string, error) {
+	return "debugger-abcde", nil
+}
+
+func TestDebugContainer(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", resourceClient: stubDebugger{}, width: 120, height: 40}
+	tui.prefs = config.Preferences{DebugImage: "nicolaka/netshoot"}
+	tui.pods = []resources.PodInfo{{
+		ResourceInfo:  resources.ResourceInfo{Name: "web-1", Namespace: "shop"},
+		Phase:         "Pending",
+		ContainerInfo: []resources.ContainerInfo{{Name: "app"}, {Name: "sidecar"}},
+	}}
+	key := func(k string) tea.Cmd {
+		keyMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			keyMsg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "tab":
+			keyMsg = tea.KeyMsg{Type: tea.KeyTab}
+		case "esc":
+			keyMsg = tea.KeyMsg{Type: tea.KeyEsc}
+		}
+		_, cmd := tui.handleDebugContainerKeys(keyMsg)
+		return cmd
+	}
+
+	if tui.openDebugContainer(""); tui.showDebugContainer {
+		t.Fatal("Expected a pending pod not to be debugged")
+	}
+
+	tui.pods[0].Phase = "Running"
+	tui.openDebugContainer("")
+	if !tui.showDebugContainer || tui.debugTarget != "app" || tui.debugImage != "nicolaka/netshoot" || tui.debugNamespace != "shop" {
+		t.Fatalf("Expected the netshoot image targeting app, got %s targeting %s", tui.debugImage, tui.debugTarget)
+	}
+	if !strings.Contains(tui.renderDebugContainer(), "nicolaka/netshoot") {
+		t.Error("Expected the confirmation to show the image")
+	}
+	key("tab")
+	if tui.debugTarget != "sidecar" {
+		t.Errorf("Expected tab to target the sidecar, got %s", tui.debugTarget)
+	}
+	key("esc")
+	if tui.showDebugContainer {
+		t.Error("Expected esc to cancel")
+	}
+
+	// :debug picks the image for this pod only
+	if _, err := tui.runCommand("debug busybox:1.36"); err != nil || tui.debugImage != "busybox:1.36" {
+		t.Fatalf("Expected :debug to use busybox:1.36, got %s, %v", tui.debugImage, err)
+	}
+	if cmd := key("enter"); cmd == nil || tui.showDebugContainer || len(tui.tasks) != 1 {
+		t.Fatal("Expected enter to attach the container in the background")
+	}
+
+	tui.debugContainerStarted("shop", "web-1", "debugger-abcde")
+	if !tui.showDebugContainer || !strings.Contains(tui.renderDebugContainer(), "debugger-abcde is running") {
+		t.Fatal("Expected the shell prompt once the container runs")
+	}
+	if got := strings.Join(tui.debugShellArgs(), " "); got != "exec -it -n shop web-1 -c debugger-abcde -- sh" {
+		t.Errorf("Unexpected exec arguments %q", got)
+	}
+
+	tui.handleDebugShellFinished(messages.DebugShellFinished{Pod: "web-1", Container: "debugger-abcde"})
+	if last := tui.appLog[len(tui.appLog)-1].Message; !strings.Contains(last, "stays until the pod is deleted") {
+		t.Errorf("Expected a note that the container stays, got %q", last)
+	}
+
+	tui.ActiveTab = models.TabDeployments
+	if _, err := tui.runCommand("debug"); err == nil {
+		t.Error("Expected :debug to need the pods tab")
+	}
+}
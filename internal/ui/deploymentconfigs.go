@@ -0,0 +1,311 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// loadDeploymentConfigs loads the DeploymentConfigs of the current namespace
+func (t *TUI) loadDeploymentConfigs() tea.Cmd {
+	return func() tea.Msg {
+		osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+		if !ok || !osClient.IsOpenShift() {
+			return messages.DeploymentConfigsLoadError{Err: fmt.Errorf("not connected to an OpenShift cluster")}
+		}
+
+		resourceClient := resources.NewOpenShiftResourceClient(osClient)
+		list, err := loadAllPages(t, "deploymentconfigs", t.listOptions(), resourceClient.ListDeploymentConfigs)
+		if err != nil {
+			return messages.DeploymentConfigsLoadError{Err: err}
+		}
+
+		return messages.DeploymentConfigsLoaded{DeploymentConfigs: list.Items}
+	}
+}
+
+// currentDeploymentConfig returns the selected DeploymentConfig, if any
+func (t *TUI) currentDeploymentConfig() (resources.DeploymentConfigInfo, bool) {
+	if t.selectedDeploymentConfig < 0 || t.selectedDeploymentConfig >= len(t.deploymentConfigs) {
+		return resources.DeploymentConfigInfo{}, false
+	}
+	return t.deploymentConfigs[t.selectedDeploymentConfig], true
+}
+
+// deploymentConfigTriggers summarizes the triggers of a DeploymentConfig,
+// e.g. "config,image(shop:latest)". Manual image triggers are marked.
+func deploymentConfigTriggers(dc resources.DeploymentConfigInfo) string {
+	if len(dc.Triggers) == 0 {
+		return "manual"
+	}
+
+	triggers := make([]string, 0, len(dc.Triggers))
+	for _, trigger := range dc.Triggers {
+		switch {
+		case trigger.Type == "ConfigChange":
+			triggers = append(triggers, "config")
+		case trigger.ImageChange != nil && trigger.ImageChange.From != nil:
+			image := fmt.Sprintf("image(%s)", trigger.ImageChange.From.Name)
+			if !trigger.ImageChange.Automatic {
+				image += "[off]"
+			}
+			triggers = append(triggers, image)
+		default:
+			triggers = append(triggers, strings.ToLower(trigger.Type))
+		}
+	}
+	return strings.Join(triggers, ",")
+}
+
+// updateDeploymentConfigDisplay updates the main content with DeploymentConfig information
+func (t *TUI) updateDeploymentConfigDisplay() {
+	if t.loadingDeploymentConfigs {
+		t.mainContent = "🔁 DeploymentConfigs\n\nLoading DeploymentConfigs..."
+		return
+	}
+
+	if len(t.deploymentConfigs) == 0 {
+		t.mainContent = "🔁 DeploymentConfigs\n\nNo DeploymentConfigs found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🔁 DeploymentConfigs\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-12s %-8s %-8s %-35s %s", "NAME", "STATUS", "READY", "LATEST", "TRIGGERS", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 105))
+	content.WriteString("\n")
+
+	// DeploymentConfig rows
+	start, end := t.listWindow(t.selectedDeploymentConfig, len(t.deploymentConfigs))
+	for i := start; i < end; i++ {
+		dc := t.deploymentConfigs[i]
+		style := workloadRowStyle(i == t.selectedDeploymentConfig, dc.Replicas, dc.ReadyReplicas)
+		if dc.Status == "Failed" && i != t.selectedDeploymentConfig {
+			style = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+		}
+
+		row := t.namespaceCell(dc.Namespace) + fmt.Sprintf("%-30s %-12s %-8s %-8d %-35s %s",
+			truncateString(t.danglingImageCell("DeploymentConfig", dc.Namespace, dc.Name)+dc.Name, 30),
+			dc.Status,
+			fmt.Sprintf("%d/%d", dc.ReadyReplicas, dc.Replicas),
+			dc.LatestVersion,
+			truncateString(deploymentConfigTriggers(dc), 35),
+			t.formatTime(dc.CreatedAt, dc.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.deploymentConfigs)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'R' to rollout latest • Press 'U' to roll back")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected DeploymentConfig info
+	if dc, ok := t.currentDeploymentConfig(); ok {
+		t.updateDeploymentConfigDetails(dc)
+	}
+}
+
+// updateDeploymentConfigDetails updates the detail pane with DeploymentConfig information
+func (t *TUI) updateDeploymentConfigDetails(dc resources.DeploymentConfigInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🔁 DeploymentConfig Details: %s\n\n", dc.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", dc.Namespace))
+	details.WriteString(fmt.Sprintf("Status:       %s\n", dc.Status))
+	details.WriteString(fmt.Sprintf("Version:      %d\n", dc.LatestVersion))
+	details.WriteString(fmt.Sprintf("Strategy:     %s\n", dc.Strategy.Type))
+	if dc.Paused {
+		details.WriteString("Paused:       rollouts are paused\n")
+	}
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(dc.CreatedAt, dc.Age)))
+
+	details.WriteString("\nReplicas:\n")
+	details.WriteString(fmt.Sprintf("  Desired:    %d\n", dc.Replicas))
+	details.WriteString(fmt.Sprintf("  Ready:      %d\n", dc.ReadyReplicas))
+	details.WriteString(fmt.Sprintf("  Updated:    %d\n", dc.UpdatedReplicas))
+	details.WriteString(fmt.Sprintf("  Available:  %d\n", dc.AvailableReplicas))
+
+	// Triggers
+	details.WriteString("\nTriggers:\n")
+	if len(dc.Triggers) == 0 {
+		details.WriteString("  None, rollouts only start with 'R'\n")
+	}
+	for _, trigger := range dc.Triggers {
+		change := trigger.ImageChange
+		if change == nil || change.From == nil {
+			details.WriteString(fmt.Sprintf("  • %s\n", trigger.Type))
+			continue
+		}
+		automatic := "automatic"
+		if !change.Automatic {
+			automatic = "disabled"
+		}
+		details.WriteString(fmt.Sprintf("  • ImageChange %s %s (%s)\n", change.From.Kind, change.From.Name, automatic))
+		if len(change.ContainerNames) > 0 {
+			details.WriteString(fmt.Sprintf("    Containers: %s\n", strings.Join(change.ContainerNames, ", ")))
+		}
+		if change.LastTriggeredImage != "" {
+			details.WriteString(fmt.Sprintf("    Last image: %s\n", change.LastTriggeredImage))
+		}
+	}
+	details.WriteString(t.renderDanglingImageRefs("DeploymentConfig", dc.Namespace, dc.Name))
+
+	// Conditions
+	if len(dc.Conditions) > 0 {
+		details.WriteString("\nConditions:\n")
+		for _, cond := range dc.Conditions {
+			line := fmt.Sprintf("  %s: %s", cond.Type, cond.Status)
+			if cond.Reason != "" {
+				line += fmt.Sprintf(" (%s)", cond.Reason)
+			}
+			details.WriteString(line + "\n")
+			if cond.Status != "True" && cond.Message != "" {
+				details.WriteString(fmt.Sprintf("    %s\n", truncateString(cond.Message, 70)))
+			}
+		}
+	}
+
+	writeWorkloadMetadata(&details, dc.Images, dc.Labels)
+	details.WriteString(t.renderRelatedEvents("DeploymentConfig", dc.Name))
+
+	t.detailContent = details.String()
+}
+
+// rolloutLatest starts a new rollout of the selected DeploymentConfig
+func (t *TUI) rolloutLatest() tea.Cmd {
+	dc, ok := t.currentDeploymentConfig()
+	if t.ActiveTab != models.TabDeploymentConfigs || !ok || !t.connected {
+		return nil
+	}
+
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	if !ok || !osClient.IsOpenShift() {
+		t.logWarn(categoryAction, "DeploymentConfigs can only be rolled out on OpenShift clusters")
+		return nil
+	}
+
+	namespace := t.resourceNamespace(dc.Namespace)
+	t.logInfo(categoryAction, "Rolling out latest version of DeploymentConfig %s...", dc.Name)
+
+	var updated *resources.DeploymentConfigInfo
+	return t.runTask(fmt.Sprintf("Rollout latest deploymentconfig %s", dc.Name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			var err error
+			updated, err = resources.NewOpenShiftResourceClient(osClient).RolloutLatestDeploymentConfig(ctx, namespace, dc.Name)
+			return err
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to roll out DeploymentConfig %s: %v", dc.Name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Started rollout #%d of DeploymentConfig %s", updated.LatestVersion, dc.Name)
+			return t.loadDeploymentConfigs()
+		})
+}
+
+// openRollbackModal asks for confirmation before rolling the selected
+// DeploymentConfig back to its previous version
+func (t *TUI) openRollbackModal() {
+	dc, ok := t.currentDeploymentConfig()
+	if t.ActiveTab != models.TabDeploymentConfigs || !ok || !t.connected {
+		return
+	}
+	if dc.LatestVersion < 2 {
+		t.logWarn(categoryAction, "DeploymentConfig %s has no earlier version to roll back to", dc.Name)
+		return
+	}
+
+	t.rollbackNamespace = t.resourceNamespace(dc.Namespace)
+	t.rollbackName = dc.Name
+	t.rollbackVersion = dc.LatestVersion - 1
+	t.showRollbackModal = true
+}
+
+// closeRollbackModal dismisses the rollback confirmation
+func (t *TUI) closeRollbackModal() {
+	t.showRollbackModal = false
+	t.rollbackNamespace = ""
+	t.rollbackName = ""
+	t.rollbackVersion = 0
+}
+
+// rollbackDeploymentConfig rolls a DeploymentConfig back as a background task
+func (t *TUI) rollbackDeploymentConfig(namespace, name string, version int64) tea.Cmd {
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	if !t.connected || !ok || !osClient.IsOpenShift() {
+		return nil
+	}
+
+	t.logInfo(categoryAction, "Rolling back DeploymentConfig %s to version %d...", name, version)
+
+	return t.runTask(fmt.Sprintf("Roll back deploymentconfig %s to #%d", name, version),
+		func(ctx context.Context, _ func(done, total int)) error {
+			_, err := resources.NewOpenShiftResourceClient(osClient).RollbackDeploymentConfig(ctx, namespace, name, version)
+			return err
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to roll back DeploymentConfig %s: %v", name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Rolled back DeploymentConfig %s to version %d", name, version)
+			return t.loadDeploymentConfigs()
+		})
+}
+
+// handleRollbackModalKeys handles key input for the rollback confirmation
+func (t *TUI) handleRollbackModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y", "enter":
+		namespace, name, version := t.rollbackNamespace, t.rollbackName, t.rollbackVersion
+		t.closeRollbackModal()
+		return t, t.rollbackDeploymentConfig(namespace, name, version)
+
+	case "n", "N", "esc", "q":
+		t.closeRollbackModal()
+	}
+	return t, nil
+}
+
+// renderRollbackModal renders the DeploymentConfig rollback confirmation
+func (t *TUI) renderRollbackModal() string {
+	modalWidth := min(70, t.width-4)
+
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("214")).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("214")).Render("⏪ Roll Back DeploymentConfig") + "\n\n")
+	content.WriteString(fmt.Sprintf("DeploymentConfig: %s\n", t.rollbackName))
+	content.WriteString(fmt.Sprintf("Roll back to:     version %d\n\n", t.rollbackVersion))
+	content.WriteString("The pod template of that version is restored and a new\nrollout starts. Automatic image change triggers are\ndisabled so a new image does not undo the rollback.\n\n")
+	content.WriteString("y/enter: roll back • n/esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
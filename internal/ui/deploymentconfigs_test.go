@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestDeploymentConfigsTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabDeploymentConfigs
+
+	tui.Update(messages.DeploymentConfigsLoaded{DeploymentConfigs: []resources.DeploymentConfigInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "shop", Namespace: "demo", Status: "Ready"}, Replicas: 2, ReadyReplicas: 2, LatestVersion: 3,
+			Strategy: resources.DeploymentStrategy{Type: "Rolling"},
+			Triggers: []resources.DeploymentTrigger{
+				{Type: "ConfigChange"},
+				{Type: "ImageChange", ImageChange: &resources.DeploymentTriggerImageChange{
+					From: &resources.ImageStreamReference{Kind: "ImageStreamTag", Name: "shop:latest"}, Automatic: true, ContainerNames: []string{"web"}}},
+			}},
+		{ResourceInfo: resources.ResourceInfo{Name: "batch", Namespace: "demo", Status: "Progressing"}, Replicas: 1, LatestVersion: 1},
+	}})
+
+	if !strings.Contains(tui.mainContent, "config,image(shop:latest)") || !strings.Contains(tui.mainContent, "manual") {
+		t.Errorf("Expected trigger summaries in the table, got %q", tui.mainContent)
+	}
+	for _, want := range []string{"DeploymentConfig Details: shop", "Version:      3", "ImageChange ImageStreamTag shop:latest (automatic)"} {
+		if !strings.Contains(tui.detailContent, want) {
+			t.Errorf("Expected details to contain %q, got %q", want, tui.detailContent)
+		}
+	}
+
+	// Rolling back asks for confirmation of the previous version
+	tui.openRollbackModal()
+	if !tui.showRollbackModal || tui.rollbackName != "shop" || tui.rollbackVersion != 2 {
+		t.Fatalf("Expected a rollback of shop to version 2, got %s/%d", tui.rollbackName, tui.rollbackVersion)
+	}
+	tui.handleRollbackModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showRollbackModal {
+		t.Errorf("Expected esc to cancel the rollback")
+	}
+
+	// The first version has nothing to roll back to
+	NewNavigator(tui).moveResourceSelection(1)
+	tui.openRollbackModal()
+	if tui.showRollbackModal {
+		t.Errorf("Expected no rollback for a DeploymentConfig at version 1")
+	}
+
+	ref, ok := tui.selectedResource()
+	if !ok || ref.Kind != "DeploymentConfig" || ref.Name != "batch" {
+		t.Errorf("Expected DeploymentConfig/batch as the YAML target, got %+v", ref)
+	}
+}
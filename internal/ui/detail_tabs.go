@@ -0,0 +1,276 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// Detail pane tabs, switched with [ and ]
+const (
+	detailTabSummary = iota
+	detailTabYAML
+	detailTabEvents
+	detailTabMetrics
+	detailTabRelated
+	detailTabCount
+)
+
+// detailTabNames are the labels of the detail pane tabs
+var detailTabNames = [detailTabCount]string{"Summary", "YAML", "Events", "Metrics", "Related"}
+
+// detailTabBarLines are the lines above the body of the detail pane: the
+// tab bar and a blank line
+const detailTabBarLines = 2
+
+// cycleDetailTab switches the detail pane to the previous or next tab
+func (t *TUI) cycleDetailTab(delta int) tea.Cmd {
+	if !t.showDetails {
+		return nil
+	}
+	return t.selectDetailTab((t.detailTab + delta + detailTabCount) % detailTabCount)
+}
+
+// selectDetailTab switches the detail pane to a tab and loads what it shows
+// of the selected object
+func (t *TUI) selectDetailTab(tab int) tea.Cmd {
+	if tab == t.detailTab {
+		return nil
+	}
+	// Tabs load afresh when they are opened again
+	switch t.detailTab {
+	case detailTabYAML:
+		t.detailYAMLRef = resourceRef{}
+	case detailTabRelated:
+		t.ownerTreeRef = resourceRef{}
+	}
+	t.detailTab = tab
+	t.detailScroll = 0
+	return t.followDetailTab()
+}
+
+// followDetailTab loads what the open detail tab shows once the selection
+// moved to another object, and scrolls the pane back to the top
+func (t *TUI) followDetailTab() tea.Cmd {
+	if !t.showDetails {
+		return nil
+	}
+	if ref, ok := t.selectedResource(); ok && ref != t.detailRef {
+		t.detailRef = ref
+		t.detailScroll = 0
+	}
+
+	switch t.detailTab {
+	case detailTabYAML:
+		return t.followDetailYAML()
+	case detailTabRelated:
+		return t.followOwnerTree()
+	}
+	return nil
+}
+
+// followDetailYAML fetches the manifest of the selected object for the
+// YAML tab, unless it is already shown
+func (t *TUI) followDetailYAML() tea.Cmd {
+	ref, ok := t.selectedResource()
+	if !t.connected || !ok || ref == t.detailYAMLRef {
+		return nil
+	}
+
+	t.detailYAMLRef = ref
+	t.detailYAMLLines = nil
+	t.detailYAMLErr = ""
+	t.loadingDetailYAML = true
+	load := t.loadResourceYAML(ref)
+	return func() tea.Msg {
+		switch msg := load().(type) {
+		case messages.ResourceYAMLLoaded:
+			return messages.DetailYAMLLoaded{Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name, Content: msg.Content}
+		case messages.ResourceYAMLLoadError:
+			return messages.DetailYAMLLoaded{Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name, Err: msg.Err}
+		}
+		return nil
+	}
+}
+
+// handleDetailYAMLLoaded shows a fetched manifest in the YAML tab, unless
+// the selection moved on while it loaded
+func (t *TUI) handleDetailYAMLLoaded(msg messages.DetailYAMLLoaded) {
+	if (resourceRef{Kind: msg.Kind, Namespace: msg.Namespace, Name: msg.Name}) != t.detailYAMLRef {
+		return
+	}
+	t.loadingDetailYAML = false
+	if msg.Err != nil {
+		t.detailYAMLErr = msg.Err.Error()
+		return
+	}
+	t.detailYAMLLines = strings.Split(strings.TrimRight(msg.Content, "\n"), "\n")
+}
+
+// detailBodyHeight returns the number of body lines the detail pane shows
+// at once
+func (t *TUI) detailBodyHeight() int {
+	availableHeight := t.height - t.headerHeight() - 2 // tabs + status bar
+	mainHeight := availableHeight - t.logPanelHeight(availableHeight)
+	return max(mainHeight-borderOverhead-paddingOverhead-detailTabBarLines, 1)
+}
+
+// detailBodyLines returns the lines of the open detail tab wrapped to the
+// width of the pane
+func (t *TUI) detailBodyLines(width int) []string {
+	body := strings.TrimRight(t.renderDetailBody(width), "\n")
+	if width > 0 {
+		body = lipgloss.NewStyle().Width(width).Render(body)
+	}
+	return strings.Split(body, "\n")
+}
+
+// scrollDetails scrolls the detail pane by delta lines and reports whether
+// it moved
+func (t *TUI) scrollDetails(delta int) bool {
+	total := len(t.detailBodyLines(t.detailPaneWidth()))
+	maxScroll := max(total-detailRows(total, t.detailBodyHeight()), 0)
+	scroll := max(min(t.detailScroll+delta, maxScroll), 0)
+	if scroll == t.detailScroll {
+		return false
+	}
+	t.detailScroll = scroll
+	return true
+}
+
+// detailRows returns how many of total body lines fit a pane of height
+// lines, one fewer when the position line is needed
+func detailRows(total, height int) int {
+	if total > height {
+		return max(height-1, 1)
+	}
+	return height
+}
+
+// detailPaneWidth returns the width of the text in the detail pane
+func (t *TUI) detailPaneWidth() int {
+	mainWidth := int(float64(t.width) * constants.MainPanelWidthRatio)
+	return max(t.width-mainWidth-borderOverhead-paddingOverhead, 1)
+}
+
+// renderDetailPane renders the tab bar of the detail pane and the visible
+// lines of the open tab
+func (t *TUI) renderDetailPane(width, height int) string {
+	lines := t.detailBodyLines(width)
+	rows := detailRows(len(lines), max(height-detailTabBarLines, 1))
+	scroll := min(t.detailScroll, max(len(lines)-rows, 0))
+	end := min(scroll+rows, len(lines))
+	visible := lines[scroll:end:end]
+	if end-scroll < len(lines) {
+		visible = append(visible, lipgloss.NewStyle().Foreground(lipgloss.Color("242")).
+			Render(fmt.Sprintf("↕ %d-%d of %d", scroll+1, end, len(lines))))
+	}
+	return t.renderDetailTabBar() + "\n\n" + strings.Join(visible, "\n")
+}
+
+// renderDetailTabBar renders the labels of the detail pane tabs, the open
+// one highlighted
+func (t *TUI) renderDetailTabBar() string {
+	primaryColor, _ := t.getThemeColors()
+	activeStyle := lipgloss.NewStyle().Bold(true).Underline(true).Foreground(primaryColor)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	labels := make([]string, detailTabCount)
+	for tab, name := range detailTabNames {
+		if tab == t.detailTab {
+			labels[tab] = activeStyle.Render(name)
+		} else {
+			labels[tab] = dimStyle.Render(name)
+		}
+	}
+	return strings.Join(labels, "  ")
+}
+
+// renderDetailBody renders the open detail tab of the selected object
+func (t *TUI) renderDetailBody(width int) string {
+	switch t.detailTab {
+	case detailTabYAML:
+		return t.renderDetailYAML()
+	case detailTabEvents:
+		return t.renderDetailEvents(width)
+	case detailTabMetrics:
+		return t.renderDetailMetrics()
+	case detailTabRelated:
+		return t.renderOwnerTree()
+	}
+	return t.detailContent
+}
+
+// renderDetailYAML renders the manifest of the selected object
+func (t *TUI) renderDetailYAML() string {
+	switch {
+	case !t.connected:
+		return "Not connected"
+	case t.loadingDetailYAML:
+		return fmt.Sprintf("%s Loading YAML...", t.getLoadingSpinner())
+	case t.detailYAMLErr != "":
+		return t.statusIndicator(statusFailed, "❌") + " " + t.detailYAMLErr
+	case t.detailYAMLRef == resourceRef{}:
+		return "No resource selected"
+	}
+
+	var b strings.Builder
+	for _, line := range t.detailYAMLLines {
+		b.WriteString(colorizeYAMLLine(line) + "\n")
+	}
+	b.WriteString("\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render("y: full screen"))
+	return b.String()
+}
+
+// renderDetailEvents renders every event of the selected object, warnings
+// and normal ones, most recent first
+func (t *TUI) renderDetailEvents(width int) string {
+	ref, ok := t.selectedResource()
+	if !ok {
+		return "No resource selected"
+	}
+	events := resources.EventsFor(t.allEvents, ref.Kind, ref.Name)
+	if len(events) == 0 {
+		return fmt.Sprintf("No events for %s %s", ref.Kind, ref.Name)
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("🔔 Events of %s %s (%d)\n\n", ref.Kind, ref.Name, len(events)))
+	for _, event := range events {
+		line := fmt.Sprintf("%s (x%d, %s)", event.Reason, event.Count, t.formatTimeAgo(event.LastSeen, event.Age))
+		if !strings.EqualFold(event.InvolvedKind, ref.Kind) {
+			line += fmt.Sprintf(" on %s", eventObject(event))
+		}
+		b.WriteString(eventTypeStyle(event.Type).Render(line) + "\n")
+		b.WriteString(fmt.Sprintf("  %s\n", truncateString(event.Message, max(width-2, 10))))
+	}
+	return b.String()
+}
+
+// renderDetailMetrics renders the usage of the selected pod or node
+func (t *TUI) renderDetailMetrics() string {
+	ref, ok := t.selectedResource()
+	if !ok {
+		return "No resource selected"
+	}
+
+	var usage string
+	switch ref.Kind {
+	case "Pod":
+		usage = t.renderPodUsage(t.pods[t.selectedPod])
+	case "Node":
+		usage = t.renderNodeUsage(t.nodes[t.selectedNode])
+	default:
+		return fmt.Sprintf("No metrics for %s %s, usage is measured for pods and nodes", ref.Kind, ref.Name)
+	}
+	if usage == "" {
+		return fmt.Sprintf("📈 Metrics of %s %s\n\nNo usage measured yet", ref.Kind, ref.Name)
+	}
+	return fmt.Sprintf("📈 Metrics of %s %s\n%s", ref.Kind, ref.Name, usage)
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestDetailTabs(t *testing.T) {
+	pods := []resources.PodInfo{testPod("api-1", "Running", "node-a", 0, time.Hour)}
+	pods[0].Namespace = "shop"
+	tui := &TUI{App: models.NewApp("test"), namespace: "shop", allPods: pods, pods: pods,
+		activeViews: make(map[int]config.SavedView), width: 120, height: 40}
+
+	if cmd := tui.cycleDetailTab(1); cmd != nil || tui.detailTab != detailTabSummary {
+		t.Fatal("Expected the tabs to stay put while the details are hidden")
+	}
+
+	tui.showDetails = true
+	tui.allEvents = []resources.EventInfo{
+		{Type: "Normal", Reason: "Pulled", Count: 1, InvolvedKind: "Pod", InvolvedName: "api-1", Message: "Image pulled"},
+		{Type: "Warning", Reason: "BackOff", Count: 4, InvolvedKind: "Pod", InvolvedName: "api-1", Message: "Back-off restarting"},
+		{Type: "Normal", Reason: "Scheduled", Count: 1, InvolvedKind: "Pod", InvolvedName: "other"},
+	}
+	tui.cycleDetailTab(1)
+	tui.cycleDetailTab(1)
+	if tui.detailTab != detailTabEvents {
+		t.Fatalf("Expected ] twice to open the Events tab, got %d", tui.detailTab)
+	}
+	body := tui.renderDetailBody(80)
+	if !strings.Contains(body, "Pulled") || !strings.Contains(body, "BackOff") || strings.Contains(body, "Scheduled") {
+		t.Errorf("Expected every event of the pod and no other, got %q", body)
+	}
+
+	tui.cycleDetailTab(1)
+	if body := tui.renderDetailBody(80); !strings.Contains(body, "No usage measured yet") {
+		t.Errorf("Expected the Metrics tab without samples, got %q", body)
+	}
+
+	// [ from the first tab wraps around to the last
+	tui.selectDetailTab(detailTabSummary)
+	tui.cycleDetailTab(-1)
+	if tui.detailTab != detailTabRelated {
+		t.Errorf("Expected [ to wrap around to the Related tab, got %d", tui.detailTab)
+	}
+
+	if pane := tui.renderDetailPane(50, 20); !strings.HasPrefix(pane, "Summary  YAML  Events  Metrics  Related") {
+		t.Errorf("Expected the tab bar on top of the pane, got %q", pane)
+	}
+}
+
+func TestDetailYAMLLoaded(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, detailTab: detailTabYAML,
+		detailYAMLRef: resourceRef{Kind: "Pod", Namespace: "shop", Name: "api-1"}, loadingDetailYAML: true}
+
+	// A manifest of an object no longer selected is ignored
+	tui.handleDetailYAMLLoaded(messages.DetailYAMLLoaded{Kind: "Pod", Namespace: "shop", Name: "api-2", Content: "kind: Pod\n"})
+	if !tui.loadingDetailYAML {
+		t.Fatal("Expected a manifest of another pod to be ignored")
+	}
+
+	tui.handleDetailYAMLLoaded(messages.DetailYAMLLoaded{Kind: "Pod", Namespace: "shop", Name: "api-1", Content: "kind: Pod\nmetadata:\n  name: api-1\n"})
+	if body := tui.renderDetailBody(80); !strings.Contains(body, "name: api-1") {
+		t.Errorf("Expected the manifest in the YAML tab, got %q", body)
+	}
+
+	tui.handleDetailYAMLLoaded(messages.DetailYAMLLoaded{Kind: "Pod", Namespace: "shop", Name: "api-1", Err: errors.New("forbidden")})
+	if body := tui.renderDetailBody(80); !strings.Contains(body, "forbidden") {
+		t.Errorf("Expected the error in the YAML tab, got %q", body)
+	}
+}
+
+func TestScrollDetails(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), showDetails: true, width: 120, height: 30}
+	var content strings.Builder
+	for i := 0; i < 100; i++ {
+		content.WriteString(fmt.Sprintf("line %d\n", i))
+	}
+	tui.detailContent = content.String()
+
+	if !tui.scrollDetails(5) || tui.detailScroll != 5 {
+		t.Fatalf("Expected the details to scroll, got %d", tui.detailScroll)
+	}
+	if tui.scrollDetails(1000); tui.detailScroll != 100-(tui.detailBodyHeight()-1) {
+		t.Errorf("Expected the scroll to stop at the last line, got %d", tui.detailScroll)
+	}
+	if tui.scrollDetails(1) {
+		t.Errorf("Expected no scrolling past the end")
+	}
+	if pane := tui.renderDetailPane(tui.detailPaneWidth(), tui.detailBodyHeight()+detailTabBarLines); !strings.Contains(pane, "line 99") {
+		t.Errorf("Expected the last line at the end of the pane, got %q", pane)
+	}
+}
@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// pendingDiff is a change previewed in the diff tool, saved once confirmed
+type pendingDiff struct {
+	title   string
+	save    func() tea.Cmd
+	toolErr error // The diff tool failed, the change was not previewed
+}
+
+// diffToolCommand returns the diff tool command line comparing two files
+func diffToolCommand(tool, oldPath, newPath string) []string {
+	return append(strings.Fields(tool), oldPath, newPath)
+}
+
+// writeDiffSide writes one side of a diff to a temporary file
+func writeDiffSide(side, content string) (string, error) {
+	file, err := os.CreateTemp("", constants.DiffTempFilePattern+side+".yaml")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(content); err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// reviewDiff suspends the TUI while the diff tool shows a change, then asks
+// to confirm it. save is run once the change is confirmed.
+func (t *TUI) reviewDiff(title, oldContent, newContent string, save func() tea.Cmd) tea.Cmd {
+	oldPath, err := writeDiffSide("live", oldContent)
+	if err != nil {
+		t.logError(categoryAction, "Cannot preview %s: %v", title, err)
+		return nil
+	}
+	newPath, err := writeDiffSide("new", newContent)
+	if err != nil {
+		os.Remove(oldPath)
+		t.logError(categoryAction, "Cannot preview %s: %v", title, err)
+		return nil
+	}
+
+	t.diffReview = pendingDiff{title: title, save: save}
+	command := diffToolCommand(t.prefs.DiffTool, oldPath, newPath)
+	cmd := exec.Command(command[0], command[1:]...)
+	logging.Info(t.Logger, "Previewing %s in %s", title, command[0])
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return messages.DiffToolFinished{OldPath: oldPath, NewPath: newPath, Err: err}
+	})
+}
+
+// handleDiffToolFinished asks to confirm the previewed change
+func (t *TUI) handleDiffToolFinished(msg messages.DiffToolFinished) {
+	os.Remove(msg.OldPath)
+	os.Remove(msg.NewPath)
+
+	// Diff tools exit with 1 when the files differ
+	if exitErr, ok := msg.Err.(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+		t.diffReview.toolErr = msg.Err
+	}
+	if t.diffReview.toolErr != nil {
+		t.logWarn(categoryAction, "Diff tool %s failed: %v", t.prefs.DiffTool, t.diffReview.toolErr)
+	}
+	t.showDiffReview = true
+}
+
+// previewManifests dry-runs manifests to show what applying them changes
+// in the diff tool
+func (t *TUI) previewManifests(namespace string, manifest []byte) tea.Cmd {
+	previewer, ok := t.resourceClient.(resources.ManifestPreviewer)
+	if !ok {
+		return t.applyManifests(namespace, manifest)
+	}
+
+	t.logInfo(categoryAction, "Previewing manifests for %s...", namespace)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+		previews, err := previewer.PreviewManifests(ctx, namespace, manifest)
+		return messages.ManifestPreviewLoaded{Namespace: namespace, Manifest: manifest, Previews: previews, Err: err}
+	}
+}
+
+// handleManifestPreviewLoaded opens the diff tool on the previewed manifests
+func (t *TUI) handleManifestPreviewLoaded(msg messages.ManifestPreviewLoaded) tea.Cmd {
+	if msg.Err != nil {
+		t.logError(categoryAction, "Failed to preview manifests: %v", msg.Err)
+		return nil
+	}
+
+	live, applied := manifestPreviewSides(msg.Previews)
+	return t.reviewDiff(fmt.Sprintf("Apply manifests to %s", msg.Namespace), live, applied, func() tea.Cmd {
+		return t.applyManifests(msg.Namespace, msg.Manifest)
+	})
+}
+
+// manifestPreviewSides renders the live and applied objects of a preview as
+// two multi-document manifests with the objects in the same order
+func manifestPreviewSides(previews []resources.ManifestPreview) (string, string) {
+	var live, applied strings.Builder
+	for i, preview := range previews {
+		if i > 0 {
+			live.WriteString("---\n")
+			applied.WriteString("---\n")
+		}
+		header := fmt.Sprintf("# %s\n", preview.Ref())
+		live.WriteString(header)
+		applied.WriteString(header)
+
+		switch {
+		case preview.Err != nil:
+			applied.WriteString(fmt.Sprintf("# error: %v\n", preview.Err))
+		case preview.Live == "":
+			live.WriteString("# does not exist, it is created\n")
+		}
+		live.WriteString(preview.Live)
+		applied.WriteString(preview.Applied)
+	}
+	return live.String(), applied.String()
+}
+
+// closeDiffReview drops the previewed change
+func (t *TUI) closeDiffReview() {
+	t.showDiffReview = false
+	t.diffReview = pendingDiff{}
+}
+
+// handleDiffReviewKeys handles key input for the confirmation of a previewed change
+func (t *TUI) handleDiffReviewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y", "enter":
+		save := t.diffReview.save
+		t.closeDiffReview()
+		return t, save()
+
+	case "n", "N", "esc", "q":
+		t.logInfo(categoryAction, "%s cancelled", t.diffReview.title)
+		t.closeDiffReview()
+	}
+	return t, nil
+}
+
+// renderDiffReview renders the confirmation of a previewed change
+func (t *TUI) renderDiffReview() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(70, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🔍 Save Changes?") + "\n\n")
+	content.WriteString(t.diffReview.title + "\n\n")
+	if t.diffReview.toolErr != nil {
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render(
+			fmt.Sprintf("❌ %s failed, the changes were not previewed: %v", t.prefs.DiffTool, t.diffReview.toolErr)) + "\n\n")
+	}
+	if t.dryRun {
+		content.WriteString("Dry run is on, nothing is saved.\n\n")
+	}
+	content.WriteString("y/enter: save • n/esc: discard")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,61 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestManifestPreviewSides(t *testing.T) {
+	live, applied := manifestPreviewSides([]resources.ManifestPreview{
+		{Kind: "ConfigMap", Name: "settings", Live: "data:\n  mode: slow\n", Applied: "data:\n  mode: fast\n"},
+		{Kind: "Service", Name: "shop", Applied: "spec: {}\n"},
+		{Kind: "Widget", Name: "unknown", Err: errors.New("unknown resource type Widget")},
+	})
+
+	if strings.Count(live, "---\n") != 2 || strings.Count(applied, "---\n") != 2 {
+		t.Errorf("Expected both sides to keep a document per object")
+	}
+	if !strings.Contains(live, "# service/shop\n# does not exist, it is created\n") {
+		t.Errorf("Expected the created object to be marked on the live side, got:\n%s", live)
+	}
+	if !strings.Contains(applied, "# widget/unknown\n# error: unknown resource type Widget") {
+		t.Errorf("Expected the failure on the applied side, got:\n%s", applied)
+	}
+}
+
+func TestDiffReview(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), width: 120, height: 40}
+	tui.prefs.DiffTool = "delta --side-by-side"
+
+	if got := diffToolCommand(tui.prefs.DiffTool, "a.yaml", "b.yaml"); strings.Join(got, " ") != "delta --side-by-side a.yaml b.yaml" {
+		t.Errorf("Expected the files after the tool's arguments, got %v", got)
+	}
+
+	saved := false
+	if cmd := tui.reviewDiff("Update configmap settings", "mode: slow\n", "mode: fast\n", func() tea.Cmd { saved = true; return nil }); cmd == nil {
+		t.Fatalf("Expected the diff tool to run")
+	}
+	tui.handleDiffToolFinished(messages.DiffToolFinished{Err: errors.New("exec: \"delta\": executable file not found in $PATH")})
+	if !tui.showDiffReview || !strings.Contains(tui.renderDiffReview(), "were not previewed") {
+		t.Fatalf("Expected the confirmation to warn the change was not previewed")
+	}
+
+	tui.handleDiffReviewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if saved || tui.showDiffReview {
+		t.Fatalf("Expected n to discard the change")
+	}
+
+	tui.reviewDiff("Update configmap settings", "mode: slow\n", "mode: fast\n", func() tea.Cmd { saved = true; return nil })
+	tui.handleDiffToolFinished(messages.DiffToolFinished{})
+	tui.handleDiffReviewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if !saved || tui.showDiffReview {
+		t.Errorf("Expected y to save the change")
+	}
+}
@@ -0,0 +1,79 @@
+//go:build integration
+
+package ui
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/katyella/lazyoc/internal/testenv"
+	"github.com/katyella/lazyoc/internal/ui/uitest"
+)
+
+// startE2E runs the full program against the test cluster's namespace
+func startE2E(t *testing.T, env *testenv.Env) *uitest.Driver {
+	// Keep the user's saved views and macros out of the test
+	t.Setenv("HOME", t.TempDir())
+
+	opts := DefaultProgramOptions()
+	opts.AltScreen = false
+	opts.MouseSupport = false
+	opts.KubeConfig = env.Kubeconfig(t)
+
+	return uitest.Start(t, func(teaOpts ...tea.ProgramOption) *tea.Program {
+		opts.TeaOptions = teaOpts
+		return NewProgram(opts)
+	}, 160, 50)
+}
+
+func TestE2EBrowsePodsAndServices(t *testing.T) {
+	env := testenv.Start(t)
+	env.CreatePod(t, "web-1", map[string]string{"app": "web"})
+	_, err := env.Clientset.CoreV1().Services(env.Namespace).Create(context.Background(), &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "web"},
+			Ports:    []corev1.ServicePort{{Port: 80}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create service: %v", err)
+	}
+
+	d := startE2E(t, env)
+	d.WaitForText(env.Namespace)
+	d.WaitForText("web-1")
+
+	// The Services tab lists the service
+	d.Press(tea.KeyRight)
+	d.WaitForText("frontend")
+
+	final, ok := d.Quit().(*TUI)
+	if !ok {
+		t.Fatalf("Expected the final model to be the TUI")
+	}
+	if !final.connected || final.namespace != env.Namespace {
+		t.Errorf("Expected to be connected to %s, got connected=%v namespace=%s", env.Namespace, final.connected, final.namespace)
+	}
+}
+
+func TestE2EListFilter(t *testing.T) {
+	env := testenv.Start(t)
+	env.CreatePod(t, "api-1", nil)
+	env.CreatePod(t, "worker-1", nil)
+
+	d := startE2E(t, env)
+	d.WaitForText("worker-1")
+
+	d.Type("/wrk")
+	d.WaitForText("(1/2)")
+
+	final := d.Quit().(*TUI)
+	if len(final.pods) != 1 || final.pods[0].Name != "worker-1" {
+		t.Errorf("Expected the filter to keep only worker-1, got %d pods", len(final.pods))
+	}
+}
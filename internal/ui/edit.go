@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// editorCommand returns the user's editor command, honoring $KUBE_EDITOR, $EDITOR and $VISUAL
+func editorCommand() []string {
+	for _, env := range []string{"KUBE_EDITOR", "EDITOR", "VISUAL"} {
+		if fields := strings.Fields(os.Getenv(env)); len(fields) > 0 {
+			return fields
+		}
+	}
+	return []string{constants.DefaultEditor}
+}
+
+// resourceWriterFor returns the write-capable client for a kind
+func (t *TUI) resourceWriterFor(kind string) (resources.ResourceWriter, error) {
+	if isOpenShiftKind(kind) {
+		osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+		if !ok || !osClient.IsOpenShift() {
+			return nil, fmt.Errorf("not connected to an OpenShift cluster")
+		}
+		return resources.NewOpenShiftResourceClient(osClient), nil
+	}
+
+	writer, ok := t.resourceClient.(resources.ResourceWriter)
+	if !ok {
+		return nil, fmt.Errorf("resource client does not support writes")
+	}
+	return writer, nil
+}
+
+// startEdit fetches the selected resource's manifest for editing
+func (t *TUI) startEdit() tea.Cmd {
+	ref, ok := t.selectedResource()
+	if !t.connected || !ok {
+		return nil
+	}
+	if ref.Kind == "Secret" {
+		t.logWarn(categoryAction, "Secrets cannot be edited as YAML (values are redacted)")
+		return nil
+	}
+
+	yamlCmd := t.loadResourceYAML(ref)
+	return func() tea.Msg {
+		switch msg := yamlCmd().(type) {
+		case messages.ResourceYAMLLoaded:
+			return messages.EditManifestReady{Kind: msg.Kind, Namespace: msg.Namespace, Name: msg.Name, Content: msg.Content}
+		default:
+			return msg
+		}
+	}
+}
+
+// openEditor writes the manifest to a temporary file and suspends the TUI while $EDITOR runs
+func (t *TUI) openEditor(msg messages.EditManifestReady) tea.Cmd {
+	file, err := os.CreateTemp("", constants.EditTempFilePattern)
+	if err != nil {
+		t.logError(categoryAction, "Failed to create temp file: %v", err)
+		return nil
+	}
+	path := file.Name()
+
+	header := fmt.Sprintf("# Editing %s %s/%s. Save and exit to apply; exit without changes to cancel.\n", msg.Kind, msg.Namespace, msg.Name)
+	original := header + msg.Content
+	if _, err := file.WriteString(original); err != nil {
+		file.Close()
+		os.Remove(path)
+		t.logError(categoryAction, "Failed to write temp file: %v", err)
+		return nil
+	}
+	file.Close()
+
+	editor := editorCommand()
+	cmd := exec.Command(editor[0], append(editor[1:], path)...)
+	logging.Info(t.Logger, "Opening %s %s in %s", msg.Kind, msg.Name, editor[0])
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return messages.EditorFinished{
+			Kind:      msg.Kind,
+			Namespace: msg.Namespace,
+			Name:      msg.Name,
+			Path:      path,
+			Original:  original,
+			Err:       err,
+		}
+	})
+}
+
+// handleEditorFinished applies the edited manifest if it changed
+func (t *TUI) handleEditorFinished(msg messages.EditorFinished) tea.Cmd {
+	defer os.Remove(msg.Path)
+
+	if msg.Err != nil {
+		t.logError(categoryAction, "Editor failed: %v", msg.Err)
+		return nil
+	}
+
+	edited, err := os.ReadFile(msg.Path)
+	if err != nil {
+		t.logError(categoryAction, "Failed to read edited file: %v", err)
+		return nil
+	}
+	if string(edited) == msg.Original || strings.TrimSpace(string(edited)) == "" {
+		t.logInfo(categoryAction, "Edit cancelled, no changes made to %s %s", msg.Kind, msg.Name)
+		return nil
+	}
+
+	writer, err := t.resourceWriterFor(msg.Kind)
+	if err != nil {
+		t.logError(categoryAction, "Cannot edit %s: %v", msg.Kind, err)
+		return nil
+	}
+
+	title := fmt.Sprintf("Update %s %s", strings.ToLower(msg.Kind), msg.Name)
+	save := func() tea.Cmd {
+		return t.runTask(title,
+			func(ctx context.Context, _ func(done, total int)) error {
+				return writer.UpdateFromYAML(ctx, msg.Kind, msg.Namespace, msg.Name, edited)
+			},
+			func(err error) tea.Cmd {
+				if err != nil {
+					userError := errors.MapKubernetesError(err)
+					t.errorDisplay.AddError(userError)
+					t.logError(categoryAction, "Failed to update %s %s: %v", msg.Kind, msg.Name, err)
+					return nil
+				}
+				t.logSuccess(categoryAction, "Updated %s %s", msg.Kind, msg.Name)
+				return t.refreshTab(int(t.ActiveTab))
+			})
+	}
+
+	if t.prefs.DiffTool != "" {
+		return t.reviewDiff(title, msg.Original, string(edited), save)
+	}
+	return save()
+}
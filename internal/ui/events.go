@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadEvents loads Events from the current namespace
+func (t *TUI) loadEvents() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.EventsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		// Events are reloaded along with pods and deployments, a denial is
+		// shown by the locked tab rather than logged every time
+		if t.accessDenials.lookup("events") != nil {
+			return nil
+		}
+
+		opts := t.listOptions()
+
+		eventList, err := loadAllPages(t, "events", opts, t.resourceClient.ListEvents)
+		if err != nil {
+			return messages.EventsLoadError{Err: err}
+		}
+
+		return messages.EventsLoaded{Events: eventList.Items}
+	}
+}
+
+// eventTypeStyle returns the row style for an event type
+func eventTypeStyle(eventType string) lipgloss.Style {
+	if eventType == "Warning" {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	}
+	return lipgloss.NewStyle()
+}
+
+// eventObject formats the object an event is about
+func eventObject(event resources.EventInfo) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(event.InvolvedKind), event.InvolvedName)
+}
+
+// updateEventDisplay updates the main content with Event information
+func (t *TUI) updateEventDisplay() {
+	if t.loadingEvents {
+		t.mainContent = "🔔 Events\n\nLoading Events..."
+		return
+	}
+
+	if len(t.events) == 0 {
+		t.mainContent = "🔔 Events\n\nNo Events found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🔔 Events\n\n")
+
+	// Header
+	seenWidth := max(8, t.timeWidth())
+	header := t.namespaceHeader() + fmt.Sprintf("%-8s %-20s %-35s %-6s %-*s %s", "TYPE", "REASON", "OBJECT", "COUNT", seenWidth, "SEEN", "MESSAGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 120))
+	content.WriteString("\n")
+
+	// Event rows
+	start, end := t.listWindow(t.selectedEvent, len(t.events))
+	for i := start; i < end; i++ {
+		event := t.events[i]
+		style := eventTypeStyle(event.Type)
+		if i == t.selectedEvent {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		row := t.namespaceCell(event.Namespace) + fmt.Sprintf("%-8s %-20s %s %-6d %-*s %s",
+			event.Type,
+			truncateString(event.Reason, 20),
+			t.highlightListFilter(fmt.Sprintf("%-35s", truncateString(eventObject(event), 35))),
+			event.Count,
+			seenWidth, t.formatTime(event.LastSeen, event.Age),
+			truncateString(event.Message, 60),
+		)
+
+		content.WriteString(style.Render(row))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.events)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to toggle details • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected Event info
+	if t.selectedEvent < len(t.events) && t.selectedEvent >= 0 {
+		t.updateEventDetails(t.events[t.selectedEvent])
+	}
+}
+
+// updateEventDetails updates the detail pane with Event information
+func (t *TUI) updateEventDetails(event resources.EventInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🔔 Event Details: %s\n\n", event.Reason))
+
+	details.WriteString(fmt.Sprintf("Namespace:  %s\n", event.Namespace))
+	details.WriteString(fmt.Sprintf("Type:       %s\n", event.Type))
+	details.WriteString(fmt.Sprintf("Object:     %s\n", eventObject(event)))
+	details.WriteString(fmt.Sprintf("Count:      %d\n", event.Count))
+	details.WriteString(fmt.Sprintf("Last Seen:  %s\n", t.formatTimeAgo(event.LastSeen, event.Age)))
+	if event.Source != "" {
+		details.WriteString(fmt.Sprintf("Source:     %s\n", event.Source))
+	}
+
+	details.WriteString("\nMessage:\n")
+	details.WriteString(fmt.Sprintf("  %s\n", event.Message))
+
+	t.detailContent = details.String()
+}
+
+// renderRelatedEvents renders the recent warnings for a resource in the detail pane
+func (t *TUI) renderRelatedEvents(kind, name string) string {
+	warnings := resources.WarningEvents(resources.EventsFor(t.allEvents, kind, name))
+	if len(warnings) == 0 {
+		return ""
+	}
+
+	var section strings.Builder
+	section.WriteString(fmt.Sprintf("\nWarnings (%d):\n", len(warnings)))
+
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	for i, event := range warnings {
+		if i == constants.MaxRelatedEvents {
+			section.WriteString(fmt.Sprintf("  ... %d more on the Events tab\n", len(warnings)-i))
+			break
+		}
+
+		line := fmt.Sprintf("  ⚠ %s (x%d, %s)", event.Reason, event.Count, t.formatTimeAgo(event.LastSeen, event.Age))
+		if !strings.EqualFold(event.InvolvedKind, kind) {
+			line += fmt.Sprintf(" on %s", eventObject(event))
+		}
+		section.WriteString(warnStyle.Render(line) + "\n")
+		section.WriteString(fmt.Sprintf("    %s\n", truncateString(event.Message, 70)))
+	}
+
+	return section.String()
+}
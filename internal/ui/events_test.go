@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+func TestRenderRelatedEvents(t *testing.T) {
+	tui := &TUI{
+		allEvents: []resources.EventInfo{
+			{Type: "Warning", Reason: "FailedScheduling", InvolvedKind: "Pod", InvolvedName: "web-5c7d-abcde", Message: "0/3 nodes are available", Count: 2},
+			{Type: "Normal", Reason: "Pulled", InvolvedKind: "Pod", InvolvedName: "web-5c7d-abcde"},
+			{Type: "Warning", Reason: "BackOff", InvolvedKind: "Pod", InvolvedName: "api-1"},
+		},
+	}
+
+	section := tui.renderRelatedEvents("Deployment", "web")
+	if !strings.Contains(section, "FailedScheduling") || !strings.Contains(section, "on pod/web-5c7d-abcde") {
+		t.Errorf("Expected the pod warning to be attributed to the deployment, got %q", section)
+	}
+	if strings.Contains(section, "Pulled") || strings.Contains(section, "BackOff") {
+		t.Errorf("Expected only related warnings, got %q", section)
+	}
+
+	if section := tui.renderRelatedEvents("Pod", "quiet"); section != "" {
+		t.Errorf("Expected no section without warnings, got %q", section)
+	}
+
+	for i := 0; i < constants.MaxRelatedEvents+2; i++ {
+		tui.allEvents = append(tui.allEvents, resources.EventInfo{Type: "Warning", Reason: "Unhealthy", InvolvedKind: "Pod", InvolvedName: "api-1"})
+	}
+	if section := tui.renderRelatedEvents("Pod", "api-1"); !strings.Contains(section, "3 more on the Events tab") {
+		t.Errorf("Expected the warning list to be capped, got %q", section)
+	}
+}
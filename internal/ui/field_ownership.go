@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadFieldOwnership fetches the field managers of a resource
+func (t *TUI) loadFieldOwnership(ref resourceRef) tea.Cmd {
+	client := t.resourceClient
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		ownership, err := client.GetFieldOwnership(ctx, ref.Kind, ref.Namespace, ref.Name)
+		return messages.FieldOwnershipLoaded{Kind: ref.Kind, Name: ref.Name, Ownership: ownership, Err: err}
+	}
+}
+
+// toggleFieldOwners switches the YAML view between the manifest and the
+// field managers of the resource
+func (t *TUI) toggleFieldOwners() tea.Cmd {
+	if !t.connected || t.resourceClient == nil || t.yamlRef.Name == "" {
+		return nil
+	}
+
+	t.yamlFieldOwners = !t.yamlFieldOwners
+	t.loadingYAML = true
+	t.yamlLines = nil
+	t.yamlScroll = 0
+	t.yamlError = ""
+
+	if t.yamlFieldOwners {
+		t.yamlTitle = fmt.Sprintf("%s/%s field managers", t.yamlRef.Kind, t.yamlRef.Name)
+		return t.loadFieldOwnership(t.yamlRef)
+	}
+	t.yamlTitle = fmt.Sprintf("%s/%s", t.yamlRef.Kind, t.yamlRef.Name)
+	return t.loadResourceYAML(t.yamlRef)
+}
+
+// handleFieldOwnershipLoaded shows the field managers in the YAML view
+func (t *TUI) handleFieldOwnershipLoaded(msg messages.FieldOwnershipLoaded) {
+	if !t.showYAMLView || !t.yamlFieldOwners || t.yamlRef.Kind != msg.Kind || t.yamlRef.Name != msg.Name {
+		return
+	}
+
+	t.loadingYAML = false
+	if msg.Err != nil {
+		t.yamlError = msg.Err.Error()
+		t.logError(categoryResource, "Failed to load field managers for %s %s: %v", msg.Kind, msg.Name, msg.Err)
+		return
+	}
+	t.yamlLines = fieldOwnershipLines(msg.Ownership)
+	t.yamlScroll = 0
+}
+
+// fieldOwnershipLines renders the field managers and the drift from the
+// last applied configuration as YAML-like lines
+func fieldOwnershipLines(ownership *resources.FieldOwnership) []string {
+	lines := []string{"# Field managers"}
+	if len(ownership.Managers) == 0 {
+		lines = append(lines, "# No managed fields recorded")
+	}
+	for _, manager := range ownership.Managers {
+		lines = append(lines, "- manager: "+manager.Manager, "  operation: "+manager.Operation)
+		if manager.Subresource != "" {
+			lines = append(lines, "  subresource: "+manager.Subresource)
+		}
+		if manager.Age != "" {
+			lines = append(lines, "  age: "+manager.Age)
+		}
+		lines = append(lines, "  fields:")
+		for i, field := range manager.Fields {
+			if i == constants.MaxManagedFieldsShown {
+				lines = append(lines, fmt.Sprintf("  # … and %d more", len(manager.Fields)-i))
+				break
+			}
+			lines = append(lines, "  - "+field)
+		}
+	}
+
+	lines = append(lines, "", "# Drift from last-applied-configuration")
+	switch {
+	case !ownership.LastApplied:
+		lines = append(lines, "# Not managed by kubectl apply")
+	case len(ownership.Drift) == 0:
+		lines = append(lines, "# Live object matches the last applied configuration")
+	}
+	for _, drift := range ownership.Drift {
+		lines = append(lines, "- path: "+drift.Path, "  applied: "+drift.Applied, "  live: "+drift.Live)
+	}
+
+	return lines
+}
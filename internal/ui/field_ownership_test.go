@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestFieldOwnershipView(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 120, height: 40}
+	tui.showYAMLView = true
+	tui.yamlFieldOwners = true
+	tui.loadingYAML = true
+	tui.yamlRef = resourceRef{Kind: "Deployment", Namespace: "demo", Name: "web"}
+
+	// A manifest arriving after the toggle does not replace the managers
+	tui.Update(messages.ResourceYAMLLoaded{Kind: "Deployment", Name: "web", Content: "kind: Deployment"})
+	if !tui.loadingYAML {
+		t.Fatalf("Expected the late manifest to be ignored")
+	}
+
+	tui.Update(messages.FieldOwnershipLoaded{Kind: "Deployment", Name: "web", Ownership: &resources.FieldOwnership{
+		Managers: []resources.FieldManager{
+			{Manager: "kubectl-client-side-apply", Operation: "Update", Age: "3d", Fields: []string{"spec.replicas"}},
+			{Manager: "kube-controller-manager", Operation: "Update", Subresource: "status", Fields: []string{"status.replicas"}},
+		},
+		LastApplied: true,
+		Drift:       []resources.AppliedDrift{{Path: "spec.replicas", Applied: "2", Live: "5"}},
+	}})
+
+	content := strings.Join(tui.yamlLines, "\n")
+	for _, want := range []string{"- manager: kubectl-client-side-apply", "  age: 3d", "  - spec.replicas", "  subresource: status", "- path: spec.replicas", "  live: 5"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected the field managers to contain %q, got %q", want, content)
+		}
+	}
+
+	lines := fieldOwnershipLines(&resources.FieldOwnership{})
+	if content := strings.Join(lines, "\n"); !strings.Contains(content, "No managed fields") || !strings.Contains(content, "Not managed by kubectl apply") {
+		t.Errorf("Expected placeholders for an object without managers, got %q", content)
+	}
+}
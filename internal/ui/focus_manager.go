@@ -41,6 +41,10 @@ func (f *FocusManager) FocusPanel(panel int) bool {
 	oldPanel := f.tui.focusedPanel
 	f.tui.focusedPanel = panel
 	logging.Debug(f.tui.Logger, "FocusManager: switched focus from panel %d to panel %d", oldPanel, panel)
+	if oldPanel != panel {
+		// The selection marker and the label chips follow the focus
+		f.tui.updateMainContent()
+	}
 	return true
 }
 
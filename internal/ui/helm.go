@@ -0,0 +1,349 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/katyella/lazyoc/internal/k8s/helm"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// helmClient returns a client reading Helm releases, nil when not connected
+func (t *TUI) helmClient() *helm.Client {
+	if t.k8sClient == nil {
+		return nil
+	}
+	clientset := t.k8sClient.GetClientset()
+	if clientset == nil {
+		return nil
+	}
+	return helm.NewClient(clientset)
+}
+
+// helmLoadError explains a failed read of the release secrets
+func helmLoadError(err error) error {
+	if apierrors.IsForbidden(err) {
+		return fmt.Errorf("%w (Helm stores releases in secrets, reading them needs access to secrets)", err)
+	}
+	return err
+}
+
+// loadHelmReleases reads the latest revision of the Helm releases in the
+// current namespace
+func (t *TUI) loadHelmReleases() tea.Cmd {
+	client, namespace := t.helmClient(), t.namespace
+	return func() tea.Msg {
+		if client == nil {
+			return messages.HelmReleasesLoaded{Namespace: namespace, Err: fmt.Errorf("not connected to cluster")}
+		}
+		releases, err := loadWithRetry(t, "helm releases", func(ctx context.Context) ([]helm.Release, error) {
+			return client.ListReleases(ctx, namespace)
+		})
+		return messages.HelmReleasesLoaded{Namespace: namespace, Releases: releases, Err: helmLoadError(err)}
+	}
+}
+
+// loadHelmHistory reads every revision of a Helm release
+func (t *TUI) loadHelmHistory(name string) tea.Cmd {
+	client, namespace := t.helmClient(), t.namespace
+	return func() tea.Msg {
+		if client == nil {
+			return messages.HelmHistoryLoaded{Name: name, Err: fmt.Errorf("not connected to cluster")}
+		}
+		revisions, err := loadWithRetry(t, "helm history", func(ctx context.Context) ([]helm.Release, error) {
+			return client.History(ctx, namespace, name)
+		})
+		return messages.HelmHistoryLoaded{Name: name, Revisions: revisions, Err: helmLoadError(err)}
+	}
+}
+
+// openHelm shows the Helm releases of the current namespace and loads them
+func (t *TUI) openHelm() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showHelm = true
+	t.loadingHelm = true
+	t.helmError = ""
+	t.helmHistoryName = ""
+	t.helmHistory = nil
+	t.helmText = nil
+	return t.loadHelmReleases()
+}
+
+// handleHelmReleasesLoaded shows the read releases, unless the project was
+// switched meanwhile
+func (t *TUI) handleHelmReleasesLoaded(msg messages.HelmReleasesLoaded) {
+	if msg.Namespace != t.namespace {
+		return
+	}
+	t.loadingHelm = false
+	if msg.Err != nil {
+		t.helmError = msg.Err.Error()
+		return
+	}
+	t.helmError = ""
+	t.helmReleases = msg.Releases
+	t.selectedHelmRelease = min(t.selectedHelmRelease, max(len(msg.Releases)-1, 0))
+}
+
+// handleHelmHistoryLoaded shows the revisions of the release they were read
+// for
+func (t *TUI) handleHelmHistoryLoaded(msg messages.HelmHistoryLoaded) {
+	if msg.Name != t.helmHistoryName {
+		return
+	}
+	t.loadingHelm = false
+	if msg.Err != nil {
+		t.helmError = msg.Err.Error()
+		return
+	}
+	t.helmHistory = msg.Revisions
+	t.selectedHelmRevision = 0
+}
+
+// helmSelection returns the release selected in the list or the revision
+// selected in the history
+func (t *TUI) helmSelection() (*helm.Release, bool) {
+	if t.helmHistoryName != "" {
+		if t.selectedHelmRevision < len(t.helmHistory) {
+			return &t.helmHistory[t.selectedHelmRevision], true
+		}
+		return nil, false
+	}
+	if t.selectedHelmRelease < len(t.helmReleases) {
+		return &t.helmReleases[t.selectedHelmRelease], true
+	}
+	return nil, false
+}
+
+// showHelmText shows the manifest or values of the selected revision
+func (t *TUI) showHelmText(what string) {
+	release, ok := t.helmSelection()
+	if !ok {
+		return
+	}
+
+	var text string
+	var err error
+	switch what {
+	case "manifest":
+		text = release.Manifest
+	case "values":
+		text, err = release.ValuesYAML(false)
+		if err == nil && text == "" {
+			text = "# No values supplied, the chart defaults apply (V: all values)"
+		}
+	case "all values":
+		text, err = release.ValuesYAML(true)
+	}
+	if err != nil {
+		t.helmError = err.Error()
+		return
+	}
+
+	t.helmTextTitle = fmt.Sprintf("%s of %s, revision %d", what, release.Name, release.Revision)
+	t.helmText = strings.Split(strings.TrimRight(text, "\n"), "\n")
+	t.helmScroll = 0
+}
+
+// helmVisibleRows returns the number of rows the Helm browser has room for
+func (t *TUI) helmVisibleRows() int {
+	return max(t.height-12, 3)
+}
+
+// helmReleaseLines renders the releases of the namespace like helm list
+func (t *TUI) helmReleaseLines() []string {
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("  %-30s %-8s %-15s %-32s %-14s %s",
+		"NAME", "REVISION", "STATUS", "CHART", "APP VERSION", "UPDATED"))}
+	if len(t.helmReleases) == 0 {
+		lines = append(lines, "  no Helm releases in this namespace")
+	}
+	for i, release := range t.helmReleases {
+		row := fmt.Sprintf("%-30s %-8d %-15s %-32s %-14s %s", truncateString(release.Name, 30), release.Revision,
+			release.Status, truncateString(release.ChartLabel(), 32), truncateString(release.AppVersion, 14),
+			t.formatTimeAgo(release.LastDeployed, ""))
+		lines = append(lines, t.helmRow(row, release.Status, i == t.selectedHelmRelease))
+	}
+	return lines
+}
+
+// helmHistoryLines renders the revisions of a release like helm history
+func (t *TUI) helmHistoryLines() []string {
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("  %-8s %-15s %-32s %-14s %-12s %s",
+		"REVISION", "STATUS", "CHART", "APP VERSION", "UPDATED", "DESCRIPTION"))}
+	for i, revision := range t.helmHistory {
+		row := fmt.Sprintf("%-8d %-15s %-32s %-14s %-12s %s", revision.Revision, revision.Status,
+			truncateString(revision.ChartLabel(), 32), truncateString(revision.AppVersion, 14),
+			t.formatTime(revision.LastDeployed, ""), truncateString(revision.Description, 50))
+		lines = append(lines, t.helmRow(row, revision.Status, i == t.selectedHelmRevision))
+	}
+	return lines
+}
+
+// helmRow colors a release row by its status and marks the selected one
+func (t *TUI) helmRow(row, status string, selected bool) string {
+	level := statusUnknown
+	switch status {
+	case "deployed":
+		level = statusOK
+	case "failed":
+		level = statusFailed
+	case "pending-install", "pending-upgrade", "pending-rollback", "uninstalling":
+		level = statusWarn
+	}
+	prefix, style := "  ", t.statusStyle(level)
+	if selected {
+		prefix, style = "▶ ", style.Bold(true)
+	}
+	return prefix + style.Render(row)
+}
+
+// renderHelm renders the Helm browser: the releases, the history of one or
+// the manifest or values of a revision
+func (t *TUI) renderHelm() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(140, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	title := "⎈ Helm releases: " + t.namespace
+	footer := "j/k: select • enter: history • m: manifest • v/V: values/all values • r: reload • esc/q: close"
+	var lines []string
+	selected := 0
+	switch {
+	case t.helmText != nil:
+		title = "⎈ Helm " + t.helmTextTitle
+		footer = "j/k: scroll • g/G: top/bottom • c: copy • esc: back"
+		for _, line := range t.helmText {
+			lines = append(lines, colorizeYAMLLine(truncateString(line, modalWidth-8)))
+		}
+	case t.helmHistoryName != "":
+		title = "⎈ Helm history: " + t.helmHistoryName
+		footer = "j/k: select • m: manifest • v/V: values/all values • esc: back"
+		lines, selected = t.helmHistoryLines(), t.selectedHelmRevision+1
+	default:
+		lines, selected = t.helmReleaseLines(), t.selectedHelmRelease+1
+	}
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(title) + "\n\n")
+
+	switch {
+	case t.helmError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.helmError, modalWidth-10)) + "\n")
+	case t.loadingHelm:
+		content.WriteString(fmt.Sprintf("%s Reading Helm releases...\n", t.getLoadingSpinner()))
+	default:
+		visible := t.helmVisibleRows()
+		start := t.helmScroll
+		if t.helmText == nil {
+			// Lists keep the selected row in view below their header
+			start = max(min(selected-visible/2, len(lines)-visible), 0)
+		}
+		start = min(start, max(len(lines)-visible, 0))
+		end := min(start+visible, len(lines))
+		for _, line := range lines[start:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d lines]\n", start+1, end, len(lines)))
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString(footer)
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleHelmKeys handles key input for the Helm browser
+func (t *TUI) handleHelmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	// The manifest or values of a revision
+	if t.helmText != nil {
+		maxScroll := max(len(t.helmText)-t.helmVisibleRows(), 0)
+		switch key {
+		case "esc", "q":
+			t.helmText = nil
+		case "j", "down":
+			t.helmScroll = min(t.helmScroll+1, maxScroll)
+		case "k", "up":
+			t.helmScroll = max(t.helmScroll-1, 0)
+		case "pgdown", "ctrl+f", " ":
+			t.helmScroll = min(t.helmScroll+t.helmVisibleRows(), maxScroll)
+		case "pgup", "ctrl+b":
+			t.helmScroll = max(t.helmScroll-t.helmVisibleRows(), 0)
+		case "g", "home":
+			t.helmScroll = 0
+		case "G", "end":
+			t.helmScroll = maxScroll
+		case "c":
+			return t, t.copyToClipboard(strings.Join(t.helmText, "\n"))
+		}
+		return t, nil
+	}
+
+	switch key {
+	case "esc", "q":
+		if t.helmHistoryName != "" {
+			t.helmHistoryName = ""
+			t.helmHistory = nil
+			t.helmError = ""
+			return t, nil
+		}
+		t.showHelm = false
+
+	case "r":
+		if t.helmHistoryName == "" && !t.loadingHelm {
+			t.loadingHelm = true
+			t.helmError = ""
+			return t, t.loadHelmReleases()
+		}
+
+	case "enter":
+		if t.helmHistoryName == "" && t.selectedHelmRelease < len(t.helmReleases) {
+			t.helmHistoryName = t.helmReleases[t.selectedHelmRelease].Name
+			t.helmHistory = nil
+			t.helmError = ""
+			t.loadingHelm = true
+			return t, t.loadHelmHistory(t.helmHistoryName)
+		}
+
+	case "m":
+		t.showHelmText("manifest")
+
+	case "v":
+		t.showHelmText("values")
+
+	case "V":
+		t.showHelmText("all values")
+
+	case "j", "down":
+		if t.helmHistoryName != "" {
+			t.selectedHelmRevision = min(t.selectedHelmRevision+1, max(len(t.helmHistory)-1, 0))
+		} else {
+			t.selectedHelmRelease = min(t.selectedHelmRelease+1, max(len(t.helmReleases)-1, 0))
+		}
+
+	case "k", "up":
+		if t.helmHistoryName != "" {
+			t.selectedHelmRevision = max(t.selectedHelmRevision-1, 0)
+		} else {
+			t.selectedHelmRelease = max(t.selectedHelmRelease-1, 0)
+		}
+	}
+
+	return t, nil
+}
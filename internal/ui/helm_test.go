@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/helm"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestHelmBrowser(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", showHelm: true, loadingHelm: true, width: 160, height: 40}
+	key := func(k string) {
+		if k == "enter" || k == "esc" {
+			tui.handleHelmKeys(tea.KeyMsg{Type: map[string]tea.KeyType{"enter": tea.KeyEnter, "esc": tea.KeyEsc}[k]})
+			return
+		}
+		tui.handleHelmKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+	}
+
+	// Releases of another namespace are ignored
+	tui.handleHelmReleasesLoaded(messages.HelmReleasesLoaded{Namespace: "other", Releases: []helm.Release{{Name: "stray"}}})
+	if !tui.loadingHelm {
+		t.Fatal("Expected releases of another namespace to be ignored")
+	}
+
+	deployed := time.Now().Add(-3 * time.Hour)
+	tui.handleHelmReleasesLoaded(messages.HelmReleasesLoaded{Namespace: "shop", Releases: []helm.Release{
+		{Name: "cache", Revision: 1, Status: "failed", Chart: "redis", ChartVersion: "18.1.0", LastDeployed: deployed},
+		{Name: "db", Revision: 3, Status: "deployed", Chart: "postgresql", ChartVersion: "12.5.6", AppVersion: "15.4.0",
+			LastDeployed: deployed, Manifest: "kind: StatefulSet\nmetadata:\n  name: db\n", Values: map[string]interface{}{"replicas": 2}},
+	}})
+	rendered := tui.renderHelm()
+	for _, want := range []string{"cache", "redis-18.1.0", "postgresql-12.5.6", "15.4.0", "3h ago"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected %q in the release list, got:\n%s", want, rendered)
+		}
+	}
+
+	key("j")
+	key("m")
+	if rendered := tui.renderHelm(); !strings.Contains(rendered, "manifest of db, revision 3") || !strings.Contains(rendered, "StatefulSet") {
+		t.Errorf("Expected the manifest of db, got:\n%s", rendered)
+	}
+	key("esc")
+	key("v")
+	if len(tui.helmText) != 1 || tui.helmText[0] != "replicas: 2" {
+		t.Errorf("Expected the supplied values of db, got %q", tui.helmText)
+	}
+	key("esc")
+
+	// enter opens the history of the selected release
+	key("enter")
+	if tui.helmHistoryName != "db" || !tui.loadingHelm {
+		t.Fatalf("Expected the history of db to load, got %q", tui.helmHistoryName)
+	}
+	tui.handleHelmHistoryLoaded(messages.HelmHistoryLoaded{Name: "db", Revisions: []helm.Release{
+		{Name: "db", Revision: 3, Status: "deployed", Description: "Upgrade complete"},
+		{Name: "db", Revision: 2, Status: "superseded", Description: "Upgrade complete"},
+	}})
+	if rendered := tui.renderHelm(); !strings.Contains(rendered, "Helm history: db") || !strings.Contains(rendered, "superseded") {
+		t.Errorf("Expected the revisions of db, got:\n%s", rendered)
+	}
+
+	key("esc")
+	if tui.helmHistoryName != "" || !tui.showHelm {
+		t.Error("Expected esc to go back from the history to the releases")
+	}
+	key("q")
+	if tui.showHelm {
+		t.Error("Expected q to close the Helm browser")
+	}
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// recordInput notes that the user is at the terminal
+func (t *TUI) recordInput() {
+	t.lastInputAt = time.Now()
+}
+
+// checkHibernation hibernates once the terminal has gone without input for
+// the configured period. It reports whether the session is hibernated.
+func (t *TUI) checkHibernation(now time.Time) bool {
+	if t.hibernated {
+		return true
+	}
+	after := t.prefs.HibernateAfter()
+	if after == 0 || !t.connected {
+		return false
+	}
+	if t.lastInputAt.IsZero() {
+		t.lastInputAt = now
+		return false
+	}
+	if now.Sub(t.lastInputAt) < after {
+		return false
+	}
+
+	t.hibernate(after)
+	return true
+}
+
+// hibernate stops the log streams and the refreshes until a key is pressed.
+// The timers keep ticking without calling the API.
+func (t *TUI) hibernate(after time.Duration) {
+	t.hibernated = true
+	t.hibernatedBuildLogs = t.buildLogStreaming
+	t.stopPodLogStream()
+	t.stopBuildLogStream()
+	t.stopTabSummaries()
+	t.logInfo(categoryConnection, "Hibernated after %s without input, press any key to resume", after)
+}
+
+// resumeFromHibernation restarts the log streams and reloads what is shown,
+// as it may have changed while hibernated
+func (t *TUI) resumeFromHibernation() tea.Cmd {
+	idle := time.Since(t.lastInputAt).Round(time.Second)
+	t.hibernated = false
+	t.recordInput()
+	t.logInfo(categoryConnection, "Resumed after %s without input", idle)
+	if !t.connected {
+		return nil
+	}
+
+	t.resetAutoRefreshCountdown()
+	t.startTabSummaries()
+	cmds := []tea.Cmd{t.refreshTab(int(t.ActiveTab))}
+	if t.ActiveTab != models.TabPods {
+		cmds = append(cmds, t.loadPods())
+	}
+	if t.logViewMode == constants.PodLogViewMode {
+		cmds = append(cmds, t.startPodLogStream())
+	}
+	if t.hibernatedBuildLogs {
+		t.hibernatedBuildLogs = false
+		cmds = append(cmds, t.startBuildLogStream())
+	}
+	if t.showTop {
+		cmds = append(cmds, t.loadPodUsage())
+	}
+	cmds = append(cmds, t.startRolloutStatusPoll())
+	return tea.Batch(cmds...)
+}
+
+// renderHibernationBanner renders the banner replacing the status bar while hibernated
+func (t *TUI) renderHibernationBanner() string {
+	primaryColor, _ := t.getThemeColors()
+	return lipgloss.NewStyle().
+		Width(t.width).
+		Background(primaryColor).
+		Foreground(lipgloss.Color("0")).
+		Bold(true).
+		Render(truncateString("💤 Hibernated, refreshes and log streams are stopped. Press any key to resume", t.width))
+}
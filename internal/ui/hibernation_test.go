@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestHibernation(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 120, height: 40}
+	tui.prefs = config.Preferences{HibernateMinutes: 10}
+	now := time.Now()
+
+	if tui.checkHibernation(now) || tui.lastInputAt.IsZero() {
+		t.Fatal("Expected the idle period to start on the first check")
+	}
+	if tui.checkHibernation(now.Add(9 * time.Minute)) {
+		t.Error("Expected no hibernation before the period")
+	}
+	if !tui.checkHibernation(now.Add(10*time.Minute)) || !tui.hibernated {
+		t.Fatal("Expected hibernation after the period without input")
+	}
+	if view := tui.renderStatusBar(); !strings.Contains(view, "Hibernated") {
+		t.Errorf("Expected the hibernation banner, got %q", view)
+	}
+
+	tui.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if tui.hibernated || time.Since(tui.lastInputAt) > time.Minute {
+		t.Error("Expected a key to resume and count as input")
+	}
+
+	// A click resumes as well, and still acts
+	clicked := NewTUI("test", false, false)
+	clicked.width, clicked.height = 120, 40
+	clicked.hibernated = true
+	clicked.Update(tea.MouseMsg{X: 1, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	if clicked.hibernated || time.Since(clicked.lastInputAt) > time.Minute {
+		t.Error("Expected a click to resume and count as input")
+	}
+
+	// The rollout status poll stops while hibernated and restarts on resume
+	polled := &TUI{App: models.NewApp("test"), connected: true, hibernated: true, rolloutPollActive: true}
+	polled.ActiveTab = models.TabDeployments
+	polled.deployments = []resources.DeploymentInfo{{ResourceInfo: resources.ResourceInfo{Name: "web"}}}
+	if cmd := polled.handleRolloutStatusTick(); cmd != nil || polled.rolloutPollActive {
+		t.Error("Expected the rollout status poll to stop while hibernated")
+	}
+	polled.hibernated = false
+	if cmd := polled.startRolloutStatusPoll(); cmd == nil || !polled.rolloutPollActive {
+		t.Error("Expected the rollout status poll to restart once resumed")
+	}
+
+	tui.prefs.HibernateMinutes = -1
+	tui.lastInputAt = now.Add(-time.Hour)
+	if tui.checkHibernation(now) {
+		t.Error("Expected no hibernation when it is off")
+	}
+}
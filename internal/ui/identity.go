@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadIdentity determines which user API requests are authenticated as. It
+// asks OpenShift's user API first, then a SelfSubjectReview, and finally
+// falls back to the kubeconfig user entry of the current context.
+func (t *TUI) loadIdentity() tea.Cmd {
+	k8sClient := t.k8sClient
+	resourceClient := t.resourceClient
+	kubeconfigPath := t.KubeconfigPath
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		var lookupErr error
+		if osClient, ok := k8sClient.(k8s.OpenShiftClient); ok && osClient.IsOpenShift() {
+			identity, err := resources.NewOpenShiftResourceClient(osClient).WhoAmI(ctx)
+			if err == nil {
+				return messages.IdentityLoaded{Identity: identity}
+			}
+			lookupErr = err
+		}
+
+		if resourceClient != nil {
+			identity, err := resourceClient.WhoAmI(ctx)
+			if err == nil {
+				return messages.IdentityLoaded{Identity: identity}
+			}
+			lookupErr = err
+		}
+
+		authInfo, err := auth.CurrentAuthInfo(kubeconfigPath)
+		if err != nil {
+			if lookupErr == nil {
+				lookupErr = err
+			}
+			return messages.IdentityLoadError{Err: lookupErr}
+		}
+
+		return messages.IdentityLoaded{Identity: &resources.UserIdentity{
+			Username: authInfo,
+			Source:   resources.IdentitySourceKubeconfig,
+		}}
+	}
+}
+
+// handleIdentityLoaded records the authenticated user and logs it
+func (t *TUI) handleIdentityLoaded(msg messages.IdentityLoaded) {
+	t.identity = msg.Identity
+	if t.identity == nil || t.identity.Username == "" {
+		return
+	}
+
+	if len(t.identity.Groups) > 0 {
+		t.logInfo(categoryConnection, "Authenticated as %s (groups: %s)", t.identity.Username, strings.Join(t.identity.Groups, ", "))
+	} else {
+		t.logInfo(categoryConnection, "Authenticated as %s (via %s)", t.identity.Username, t.identity.Source)
+	}
+}
+
+// identitySuffix returns the " as <user>" suffix for the header
+func (t *TUI) identitySuffix() string {
+	if t.identity == nil || t.identity.Username == "" {
+		return ""
+	}
+	return " as " + t.identity.Username
+}
+
+// renderIdentity returns the status bar part for the authenticated user.
+// Groups are summarised as a count; the full list is in the app log.
+func (t *TUI) renderIdentity() string {
+	if t.identity == nil || t.identity.Username == "" {
+		return ""
+	}
+
+	user := fmt.Sprintf("👤 %s", t.identity.Username)
+	if t.identity.Source == resources.IdentitySourceKubeconfig {
+		// Only the kubeconfig entry name is known, not the cluster-side user
+		user += " (kubeconfig)"
+	}
+
+	switch n := len(t.identity.Groups); {
+	case n == 1:
+		user += " [1 group]"
+	case n > 1:
+		user += fmt.Sprintf(" [%d groups]", n)
+	}
+	return user
+}
@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestIdentityDisplay(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+	if tui.identitySuffix() != "" || tui.renderIdentity() != "" {
+		t.Fatalf("Expected no identity before it is loaded")
+	}
+
+	tui.handleIdentityLoaded(messages.IdentityLoaded{Identity: &resources.UserIdentity{
+		Username: "alice",
+		Groups:   []string{"developers", "system:authenticated"},
+		Source:   resources.IdentitySourceOpenShiftUser,
+	}})
+
+	if got := tui.identitySuffix(); got != " as alice" {
+		t.Errorf("Expected header suffix %q, got %q", " as alice", got)
+	}
+	if got := tui.renderIdentity(); got != "👤 alice [2 groups]" {
+		t.Errorf("Unexpected status bar identity %q", got)
+	}
+
+	tui.identity = &resources.UserIdentity{Username: "admin/api-cluster:6443", Source: resources.IdentitySourceKubeconfig}
+	if got := tui.renderIdentity(); !strings.HasSuffix(got, "(kubeconfig)") {
+		t.Errorf("Expected kubeconfig fallback to be marked, got %q", got)
+	}
+}
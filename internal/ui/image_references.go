@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// danglingImageMark is drawn before the name of an object referencing an
+// ImageStreamTag that does not exist
+const danglingImageMark = "⛔"
+
+// checkImageReferences checks the ImageStreamTags referenced by the loaded
+// BuildConfigs, Deployments and DeploymentConfigs. It returns nil outside of
+// OpenShift, where there are no ImageStreams.
+func (t *TUI) checkImageReferences() tea.Cmd {
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	if !ok || !osClient.IsOpenShift() {
+		return nil
+	}
+
+	buildConfigs, deployments, deploymentConfigs := t.allBuildConfigs, t.allDeployments, t.allDeploymentConfigs
+	scope := t.alertScope()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		dangling, err := resources.NewOpenShiftResourceClient(osClient).FindDanglingImageReferences(ctx, buildConfigs, deployments, deploymentConfigs)
+		if err != nil {
+			return messages.ImageReferencesCheckError{Err: err}
+		}
+		return messages.ImageReferencesChecked{Scope: scope, Dangling: dangling}
+	}
+}
+
+// danglingImageKey identifies an object with dangling references
+func (t *TUI) danglingImageKey(kind, namespace, name string) string {
+	return kind + "/" + t.resourceNamespace(namespace) + "/" + name
+}
+
+// handleImageReferencesChecked stores the dangling references, unless the
+// namespace was switched during the check. Newly found ones are logged.
+func (t *TUI) handleImageReferencesChecked(msg messages.ImageReferencesChecked) {
+	if msg.Scope != t.alertScope() {
+		return
+	}
+
+	found := make(map[string][]resources.DanglingImageReference)
+	for _, ref := range msg.Dangling {
+		key := t.danglingImageKey(ref.Kind, ref.Namespace, ref.Name)
+		if _, known := t.danglingImageRefs[key]; !known {
+			t.logWarn(categoryResource, "Must fix: %s %s references %s, %s", ref.Kind, ref.Name, ref.Ref, ref.Reason)
+		}
+		found[key] = append(found[key], ref)
+	}
+	t.danglingImageRefs = found
+	t.updateMainContent()
+}
+
+// handleImageReferencesCheckError records a failed check. It runs after each
+// load, so it is only written to the log file.
+func (t *TUI) handleImageReferencesCheckError(msg messages.ImageReferencesCheckError) {
+	logging.Warn(t.Logger, "Failed to check image references: %v", msg.Err)
+}
+
+// danglingImageCell returns the mark of an object with dangling references
+// followed by a space, or ""
+func (t *TUI) danglingImageCell(kind, namespace, name string) string {
+	if len(t.danglingImageRefs[t.danglingImageKey(kind, namespace, name)]) == 0 {
+		return ""
+	}
+	return danglingImageMark + " "
+}
+
+// renderDanglingImageRefs renders the must-fix section of the detail pane of
+// an object referencing ImageStreamTags that do not exist
+func (t *TUI) renderDanglingImageRefs(kind, namespace, name string) string {
+	refs := t.danglingImageRefs[t.danglingImageKey(kind, namespace, name)]
+	if len(refs) == 0 {
+		return ""
+	}
+
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	var section strings.Builder
+	section.WriteString("\n" + style.Bold(true).Render(danglingImageMark+" Must fix:") + "\n")
+	for _, ref := range refs {
+		section.WriteString(style.Render(fmt.Sprintf("  • %s: %s", ref.Ref, ref.Reason)) + "\n")
+	}
+	return section.String()
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestDanglingImageReferences(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	dangling := []resources.DanglingImageReference{{
+		Kind:      "BuildConfig",
+		Namespace: "shop",
+		Name:      "api",
+		Ref:       resources.ImageStreamTagRef{Namespace: "shop", Stream: "api", Tag: "latest"},
+		Reason:    "imagestream shop/api not found",
+	}}
+
+	// A check started before the namespace was switched is dropped
+	tui.handleImageReferencesChecked(messages.ImageReferencesChecked{Scope: "/dev", Dangling: dangling})
+	if len(tui.danglingImageRefs) != 0 {
+		t.Fatalf("Expected the check of another namespace to be dropped")
+	}
+
+	tui.handleImageReferencesChecked(messages.ImageReferencesChecked{Scope: tui.alertScope(), Dangling: dangling})
+	if cell := tui.danglingImageCell("BuildConfig", "shop", "api"); cell != "⛔ " {
+		t.Errorf("Expected a must-fix mark before the BuildConfig, got %q", cell)
+	}
+	if cell := tui.danglingImageCell("Deployment", "shop", "api"); cell != "" {
+		t.Errorf("Expected no mark on a Deployment of the same name, got %q", cell)
+	}
+	section := tui.renderDanglingImageRefs("BuildConfig", "shop", "api")
+	for _, want := range []string{"Must fix", "shop/api:latest", "imagestream shop/api not found"} {
+		if !strings.Contains(section, want) {
+			t.Errorf("Expected the details to contain %q, got %q", want, section)
+		}
+	}
+
+	logged := len(tui.appLog)
+	if logged == 0 {
+		t.Errorf("Expected the dangling reference to be logged")
+	}
+	tui.handleImageReferencesChecked(messages.ImageReferencesChecked{Scope: tui.alertScope(), Dangling: dangling})
+	if len(tui.appLog) != logged {
+		t.Errorf("Expected a known dangling reference not to be logged again")
+	}
+
+	tui.handleImageReferencesChecked(messages.ImageReferencesChecked{Scope: tui.alertScope()})
+	if section := tui.renderDanglingImageRefs("BuildConfig", "shop", "api"); section != "" {
+		t.Errorf("Expected the fixed reference to be cleared, got %q", section)
+	}
+}
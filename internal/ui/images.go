@@ -0,0 +1,240 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadImageInventory lists the images running in the current namespace, or
+// in all namespaces
+func (t *TUI) loadImageInventory(allNamespaces bool) tea.Cmd {
+	namespace := t.namespace
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.ImageInventoryLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		inventory, err := loadWithRetry(t, "images", func(ctx context.Context) ([]resources.ImageUsage, error) {
+			return t.resourceClient.ListImageInventory(ctx, namespace, allNamespaces)
+		})
+		if err != nil {
+			return messages.ImageInventoryLoadError{Err: err}
+		}
+
+		return messages.ImageInventoryLoaded{Images: inventory, AllNamespaces: allNamespaces}
+	}
+}
+
+// openImageReport shows the image inventory of the current namespace, or of
+// all namespaces in the all namespaces view
+func (t *TUI) openImageReport() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showImageReport = true
+	t.imageReportAll = t.allNamespaces
+	return t.reloadImageReport()
+}
+
+// reloadImageReport reloads the inventory for the selected scope
+func (t *TUI) reloadImageReport() tea.Cmd {
+	t.loadingImageReport = true
+	t.imageReportError = ""
+	t.imageReportStatus = ""
+	t.imageReportScroll = 0
+	return t.loadImageInventory(t.imageReportAll)
+}
+
+// handleImageInventoryLoaded stores the inventory, unless the scope was
+// switched while it loaded
+func (t *TUI) handleImageInventoryLoaded(msg messages.ImageInventoryLoaded) {
+	if msg.AllNamespaces != t.imageReportAll {
+		return
+	}
+	t.imageReport = msg.Images
+	t.loadingImageReport = false
+}
+
+// handleImageInventoryLoadError records a failed inventory load
+func (t *TUI) handleImageInventoryLoadError(msg messages.ImageInventoryLoadError) {
+	t.imageReport = nil
+	t.loadingImageReport = false
+	t.imageReportError = msg.Err.Error()
+	t.logError(categoryResource, "Failed to load image inventory: %v", msg.Err)
+}
+
+// imageReportScope names the namespace or cluster the report covers
+func (t *TUI) imageReportScope() string {
+	if t.imageReportAll {
+		return "all namespaces"
+	}
+	return t.namespace
+}
+
+// defaultImageReportName returns a timestamped CSV file name such as
+// images-demo-20250102-150405.csv
+func defaultImageReportName(scope string, now time.Time) string {
+	scope = strings.NewReplacer("/", "_", string(os.PathSeparator), "_", " ", "-").Replace(scope)
+	return fmt.Sprintf("images-%s-%s.csv", scope, now.Format("20060102-150405"))
+}
+
+// exportImageReport writes the report to a new CSV file in the current directory
+func (t *TUI) exportImageReport() {
+	if len(t.imageReport) == 0 {
+		t.imageReportStatus = "Nothing to export"
+		return
+	}
+
+	path, err := filepath.Abs(defaultImageReportName(t.imageReportScope(), time.Now()))
+	if err == nil {
+		err = writeImageReport(path, t.imageReport)
+	}
+	if err != nil {
+		t.imageReportStatus = fmt.Sprintf("Export failed: %v", err)
+		t.logError(categoryAction, "Failed to export image inventory: %v", err)
+		return
+	}
+
+	t.imageReportStatus = "Saved to " + path
+	t.logSuccess(categoryAction, "Saved %d images to %s", len(t.imageReport), path)
+}
+
+// writeImageReport writes an inventory to a new file; existing files are not overwritten
+func writeImageReport(path string, inventory []resources.ImageUsage) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, constants.LogExportFilePermissions)
+	if err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("%s already exists", path)
+		}
+		return err
+	}
+
+	if err := resources.WriteImageInventoryCSV(file, inventory); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// imageReportLines renders one line per image followed by its workloads
+func (t *TUI) imageReportLines(width int) []string {
+	workloadStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	var lines []string
+	for _, usage := range t.imageReport {
+		version := usage.Tag
+		if usage.Digest != "" {
+			digest := usage.Digest
+			if len(digest) > 19 {
+				digest = digest[:19] // sha256: plus 12 hex characters
+			}
+			version = strings.TrimPrefix(version+" "+digest, " ")
+		}
+		lines = append(lines, fmt.Sprintf("%-50s %-30s %d pod(s)",
+			truncateString(usage.Repository, 50), truncateString(version, 30), usage.Pods))
+
+		for _, workload := range usage.Workloads {
+			if !t.imageReportAll {
+				// Every workload is in the current namespace
+				_, workload, _ = strings.Cut(workload, "/")
+			}
+			lines = append(lines, workloadStyle.Render("  "+truncateString(workload, max(width-2, 10))))
+		}
+	}
+	return lines
+}
+
+// renderImageReport renders the image inventory report
+func (t *TUI) renderImageReport() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(110, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("🏷️ Image Inventory (%s)", t.imageReportScope())) + "\n\n")
+
+	switch {
+	case t.loadingImageReport:
+		content.WriteString(fmt.Sprintf("%s Loading images...\n", t.getLoadingSpinner()))
+	case t.imageReportError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.imageReportError) + "\n")
+	case len(t.imageReport) == 0:
+		content.WriteString("No running containers found\n")
+	default:
+		content.WriteString(fmt.Sprintf("%d unique images\n\n", len(t.imageReport)))
+		header := fmt.Sprintf("%-50s %-30s %s", "IMAGE", "TAG / DIGEST", "PODS")
+		content.WriteString(lipgloss.NewStyle().Bold(true).Render(header) + "\n")
+
+		lines := t.imageReportLines(modalWidth - 8)
+		visible := max(t.height-16, 3)
+		start := min(t.imageReportScroll, max(len(lines)-visible, 0))
+		end := min(start+visible, len(lines))
+		for _, line := range lines[start:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d lines]\n", start+1, end, len(lines)))
+		}
+	}
+
+	if t.imageReportStatus != "" {
+		content.WriteString("\n" + t.imageReportStatus + "\n")
+	}
+
+	scope := "a: all namespaces"
+	if t.imageReportAll {
+		scope = "a: current namespace"
+	}
+	content.WriteString("\n")
+	content.WriteString(fmt.Sprintf("j/k: scroll • %s • x: export CSV • r: refresh • esc/q: close", scope))
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleImageReportKeys handles key input for the image inventory report
+func (t *TUI) handleImageReportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		t.showImageReport = false
+		t.imageReport = nil
+
+	case "j", "down":
+		if t.imageReportScroll < len(t.imageReportLines(t.width))-1 {
+			t.imageReportScroll++
+		}
+
+	case "k", "up":
+		if t.imageReportScroll > 0 {
+			t.imageReportScroll--
+		}
+
+	case "a":
+		t.imageReportAll = !t.imageReportAll
+		return t, t.reloadImageReport()
+
+	case "r":
+		return t, t.reloadImageReport()
+
+	case "x":
+		t.exportImageReport()
+	}
+
+	return t, nil
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestDefaultImageReportName(t *testing.T) {
+	now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	if got := defaultImageReportName("all namespaces", now); got != "images-all-namespaces-20250102-150405.csv" {
+		t.Errorf("Unexpected file name %q", got)
+	}
+}
+
+func TestImageReport(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "demo", width: 140, height: 40}
+	tui.showImageReport = true
+	tui.loadingImageReport = true
+
+	images := []resources.ImageUsage{
+		{Image: "nginx:1.27", Repository: "nginx", Tag: "1.27", Digest: "sha256:0123456789abcdef", Pods: 2, Workloads: []string{"demo/Deployment/web"}},
+	}
+
+	// Results for a scope that is no longer selected are dropped
+	tui.Update(messages.ImageInventoryLoaded{Images: images, AllNamespaces: true})
+	if !tui.loadingImageReport {
+		t.Fatalf("Expected cluster-wide results to be ignored for the namespace report")
+	}
+
+	tui.Update(messages.ImageInventoryLoaded{Images: images})
+	rendered := tui.renderImageReport()
+	for _, want := range []string{"Image Inventory (demo)", "sha256:0123456789ab", "2 pod(s)", "Deployment/web"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected report to contain %q", want)
+		}
+	}
+
+	tui.handleImageReportKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	files, _ := filepath.Glob("images-demo-*.csv")
+	if len(files) != 1 {
+		t.Fatalf("Expected one exported CSV file, got %v (%s)", files, tui.imageReportStatus)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil || !strings.Contains(string(data), "nginx:1.27,nginx,1.27,sha256:0123456789abcdef,2,demo/Deployment/web") {
+		t.Errorf("Unexpected CSV contents %q (%v)", data, err)
+	}
+}
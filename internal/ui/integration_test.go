@@ -11,6 +11,8 @@ func TestTUIStartsAndStops(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
 	}
+	// Debug mode writes lazyoc.log to the working directory
+	t.Chdir(t.TempDir())
 
 	opts := ProgramOptions{
 		Version:      "0.1.0-test",
@@ -50,6 +52,9 @@ func TestTUIStartsAndStops(t *testing.T) {
 
 // TestProgramCreationWithDifferentOptions tests various program configurations
 func TestProgramCreationWithDifferentOptions(t *testing.T) {
+	// Debug mode writes lazyoc.log to the working directory
+	t.Chdir(t.TempDir())
+
 	testCases := []struct {
 		name string
 		opts ProgramOptions
@@ -0,0 +1,318 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// loadJobs loads Jobs from the current namespace
+func (t *TUI) loadJobs() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.JobsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		opts := t.listOptions()
+
+		list, err := loadAllPages(t, "jobs", opts, t.resourceClient.ListJobs)
+		if err != nil {
+			return messages.JobsLoadError{Err: err}
+		}
+
+		return messages.JobsLoaded{Jobs: list.Items}
+	}
+}
+
+// loadCronJobs loads CronJobs from the current namespace
+func (t *TUI) loadCronJobs() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.CronJobsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		opts := t.listOptions()
+
+		list, err := loadAllPages(t, "cronjobs", opts, t.resourceClient.ListCronJobs)
+		if err != nil {
+			return messages.CronJobsLoadError{Err: err}
+		}
+
+		return messages.CronJobsLoaded{CronJobs: list.Items}
+	}
+}
+
+// currentCronJob returns the selected CronJob, if any
+func (t *TUI) currentCronJob() (resources.CronJobInfo, bool) {
+	if t.selectedCronJob < 0 || t.selectedCronJob >= len(t.cronJobs) {
+		return resources.CronJobInfo{}, false
+	}
+	return t.cronJobs[t.selectedCronJob], true
+}
+
+// triggerCronJob runs the selected CronJob now by creating a Job from it
+func (t *TUI) triggerCronJob() tea.Cmd {
+	cronJob, ok := t.currentCronJob()
+	if t.ActiveTab != models.TabCronJobs || !ok || !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	namespace := t.resourceNamespace(cronJob.Namespace)
+	t.logInfo(categoryAction, "Triggering cronjob %s...", cronJob.Name)
+
+	var job *resources.JobInfo
+	return t.runTask(fmt.Sprintf("Trigger cronjob %s", cronJob.Name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			var err error
+			job, err = t.resourceClient.TriggerCronJob(ctx, namespace, cronJob.Name)
+			return err
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to trigger cronjob %s: %v", cronJob.Name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Created job %s from cronjob %s", job.Name, cronJob.Name)
+			return tea.Batch(t.loadCronJobs(), t.loadJobs())
+		})
+}
+
+// toggleCronJobSuspend suspends the selected CronJob's schedule, or resumes it
+// when it is already suspended
+func (t *TUI) toggleCronJobSuspend() tea.Cmd {
+	cronJob, ok := t.currentCronJob()
+	if t.ActiveTab != models.TabCronJobs || !ok || !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	namespace := t.resourceNamespace(cronJob.Namespace)
+	suspend := !cronJob.Suspended
+	action, done := "Suspend", "Suspended"
+	if !suspend {
+		action, done = "Resume", "Resumed"
+	}
+	t.logInfo(categoryAction, "%s cronjob %s...", action, cronJob.Name)
+
+	return t.runTask(fmt.Sprintf("%s cronjob %s", action, cronJob.Name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return t.resourceClient.SetCronJobSuspended(ctx, namespace, cronJob.Name, suspend)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to %s cronjob %s: %v", strings.ToLower(action), cronJob.Name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "%s cronjob %s", done, cronJob.Name)
+			return t.loadCronJobs()
+		})
+}
+
+// jobStatusStyle returns the row style for a Job status
+func jobStatusStyle(status string) lipgloss.Style {
+	switch status {
+	case "Failed":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	case "Running", "Pending":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	}
+	return lipgloss.NewStyle()
+}
+
+// updateJobDisplay updates the main content with Job information
+func (t *TUI) updateJobDisplay() {
+	if t.loadingJobs {
+		t.mainContent = "⚡ Jobs\n\nLoading Jobs..."
+		return
+	}
+
+	if len(t.jobs) == 0 {
+		t.mainContent = "⚡ Jobs\n\nNo Jobs found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("⚡ Jobs" + t.batchLegend(models.TabJobs) + "\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-35s %-10s %-12s %-10s %-20s %s", "NAME", "STATUS", "COMPLETIONS", "DURATION", "CRONJOB", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 100))
+	content.WriteString("\n")
+
+	// Job rows
+	start, end := t.listWindow(t.selectedJob, len(t.jobs))
+	for i := start; i < end; i++ {
+		job := t.jobs[i]
+		style := jobStatusStyle(job.Status)
+		if i == t.selectedJob {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		row := t.namespaceCell(job.Namespace) + fmt.Sprintf("%-35s %-10s %-12s %-10s %-20s %s",
+			truncateString(t.batchMarkCell(models.TabJobs, job.Namespace, job.Name)+job.Name, 35),
+			job.Status,
+			fmt.Sprintf("%d/%d", job.Succeeded, job.Completions),
+			job.Duration,
+			truncateString(job.CronJob, 20),
+			t.formatTime(job.CreatedAt, job.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.jobs)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to toggle details • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected Job info
+	if t.selectedJob < len(t.jobs) && t.selectedJob >= 0 {
+		t.updateJobDetails(t.jobs[t.selectedJob])
+	}
+}
+
+// updateJobDetails updates the detail pane with Job information
+func (t *TUI) updateJobDetails(job resources.JobInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("⚡ Job Details: %s\n\n", job.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", job.Namespace))
+	details.WriteString(fmt.Sprintf("Status:       %s\n", job.Status))
+	if job.CronJob != "" {
+		details.WriteString(fmt.Sprintf("CronJob:      %s\n", job.CronJob))
+	}
+	if job.Duration != "" {
+		details.WriteString(fmt.Sprintf("Duration:     %s\n", job.Duration))
+	}
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(job.CreatedAt, job.Age)))
+
+	details.WriteString("\nPods:\n")
+	details.WriteString(fmt.Sprintf("  Completions: %d\n", job.Completions))
+	details.WriteString(fmt.Sprintf("  Active:      %d\n", job.Active))
+	details.WriteString(fmt.Sprintf("  Succeeded:   %d\n", job.Succeeded))
+	details.WriteString(fmt.Sprintf("  Failed:      %d\n", job.Failed))
+
+	writeWorkloadMetadata(&details, job.Images, job.Labels)
+	details.WriteString(t.renderRelatedEvents("Job", job.Name))
+
+	t.detailContent = details.String()
+}
+
+// updateCronJobDisplay updates the main content with CronJob information
+func (t *TUI) updateCronJobDisplay() {
+	if t.loadingCronJobs {
+		t.mainContent = "⏰ CronJobs\n\nLoading CronJobs..."
+		return
+	}
+
+	if len(t.cronJobs) == 0 {
+		t.mainContent = "⏰ CronJobs\n\nNo CronJobs found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("⏰ CronJobs\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-20s %-8s %-7s %-14s %s", "NAME", "SCHEDULE", "SUSPEND", "ACTIVE", "LAST SCHEDULE", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 90))
+	content.WriteString("\n")
+
+	// CronJob rows
+	start, end := t.listWindow(t.selectedCronJob, len(t.cronJobs))
+	for i := start; i < end; i++ {
+		cronJob := t.cronJobs[i]
+		style := lipgloss.NewStyle()
+		if cronJob.Suspended {
+			style = style.Foreground(lipgloss.Color("244"))
+		}
+		if i == t.selectedCronJob {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		lastRun := "<none>"
+		if cronJob.LastRun != "" {
+			lastRun = cronJob.LastRun + " ago"
+		}
+
+		row := t.namespaceCell(cronJob.Namespace) + fmt.Sprintf("%-30s %-20s %-8t %-7d %-14s %s",
+			truncateString(cronJob.Name, 30),
+			truncateString(cronJob.Schedule, 20),
+			cronJob.Suspended,
+			cronJob.ActiveJobs,
+			lastRun,
+			t.formatTime(cronJob.CreatedAt, cronJob.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.cronJobs)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to run now • Press 's' to suspend/resume")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected CronJob info
+	if cronJob, ok := t.currentCronJob(); ok {
+		t.updateCronJobDetails(cronJob)
+	}
+}
+
+// updateCronJobDetails updates the detail pane with CronJob information
+func (t *TUI) updateCronJobDetails(cronJob resources.CronJobInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("⏰ CronJob Details: %s\n\n", cronJob.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", cronJob.Namespace))
+	details.WriteString(fmt.Sprintf("Status:       %s\n", cronJob.Status))
+	details.WriteString(fmt.Sprintf("Schedule:     %s\n", cronJob.Schedule))
+	if cronJob.TimeZone != "" {
+		details.WriteString(fmt.Sprintf("Time Zone:    %s\n", cronJob.TimeZone))
+	}
+	details.WriteString(fmt.Sprintf("Active Jobs:  %d\n", cronJob.ActiveJobs))
+	if !cronJob.LastSchedule.IsZero() {
+		details.WriteString(fmt.Sprintf("Last Run:     %s (%s ago)\n", cronJob.LastSchedule.Format("2006-01-02 15:04:05"), cronJob.LastRun))
+	}
+	if !cronJob.LastSuccessful.IsZero() {
+		details.WriteString(fmt.Sprintf("Last Success: %s\n", cronJob.LastSuccessful.Format("2006-01-02 15:04:05")))
+	}
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(cronJob.CreatedAt, cronJob.Age)))
+
+	// Jobs created by this CronJob that are still loaded on the Jobs tab
+	var recent []resources.JobInfo
+	for _, job := range t.allJobs {
+		if job.CronJob == cronJob.Name {
+			recent = append(recent, job)
+		}
+	}
+	if len(recent) > 0 {
+		details.WriteString(fmt.Sprintf("\nJobs (%d):\n", len(recent)))
+		for _, job := range recent {
+			details.WriteString(fmt.Sprintf("  %s  %s  %s\n", job.Name, job.Status, t.formatTimeAgo(job.CreatedAt, job.Age)))
+		}
+	}
+
+	writeWorkloadMetadata(&details, cronJob.Images, cronJob.Labels)
+	details.WriteString(t.renderRelatedEvents("CronJob", cronJob.Name))
+
+	t.detailContent = details.String()
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestCronJobsTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabCronJobs
+
+	tui.Update(messages.JobsLoaded{Jobs: []resources.JobInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "report-manual-1", Status: "Complete"}, CronJob: "report", Completions: 1, Succeeded: 1},
+		{ResourceInfo: resources.ResourceInfo{Name: "other-1", Status: "Failed"}},
+	}})
+	tui.Update(messages.CronJobsLoaded{CronJobs: []resources.CronJobInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "report", Status: "Suspended"}, Schedule: "0 2 * * *", Suspended: true, LastRun: "5h"},
+	}})
+
+	if !strings.Contains(tui.mainContent, "0 2 * * *") || !strings.Contains(tui.mainContent, "5h ago") {
+		t.Errorf("Expected schedule and last run in the table, got %q", tui.mainContent)
+	}
+	if !strings.Contains(tui.detailContent, "Jobs (1):") || !strings.Contains(tui.detailContent, "report-manual-1") {
+		t.Errorf("Expected the cronjob's jobs in its details, got %q", tui.detailContent)
+	}
+	if strings.Contains(tui.detailContent, "other-1") {
+		t.Errorf("Expected unrelated jobs to be left out, got %q", tui.detailContent)
+	}
+
+	ref, ok := tui.selectedResource()
+	if !ok || ref.Kind != "CronJob" || ref.Name != "report" {
+		t.Errorf("Expected CronJob/report as the YAML target, got %+v", ref)
+	}
+
+	// Actions only apply on the CronJobs tab
+	tui.ActiveTab = models.TabJobs
+	if tui.triggerCronJob() != nil || tui.toggleCronJobSuspend() != nil {
+		t.Errorf("Expected no cronjob actions outside the CronJobs tab")
+	}
+}
+
+func TestJobsTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabJobs
+
+	tui.Update(messages.JobsLoaded{Jobs: []resources.JobInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "migrate", Status: "Running"}, Completions: 3, Succeeded: 1, Active: 1, Duration: "2m0s"},
+	}})
+
+	if !strings.Contains(tui.mainContent, "1/3") || !strings.Contains(tui.mainContent, "2m0s") {
+		t.Errorf("Expected completions and duration in the table, got %q", tui.mainContent)
+	}
+	if !strings.Contains(tui.detailContent, "Job Details: migrate") {
+		t.Errorf("Expected job details, got %q", tui.detailContent)
+	}
+}
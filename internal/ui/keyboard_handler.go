@@ -23,6 +23,18 @@ func NewKeyboardHandler(tui *TUI, navigator *Navigator, focusManager *FocusManag
 
 // Handle processes keyboard events
 func (k *KeyboardHandler) Handle(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// ctrl+z suspends to the shell from any screen, fg resumes
+	if msg.String() == "ctrl+z" {
+		return k.tui, tea.Suspend
+	}
+
+	// Complete a macro Q/@ prefix with its register, and record every
+	// other key while a macro is being recorded
+	if k.tui.macroPendingKey != "" {
+		return k.tui, k.tui.handleMacroRegisterKey(msg)
+	}
+	k.tui.recordMacroKey(msg)
+
 	// Special handling for help mode
 	if k.tui.showHelp {
 		if msg.String() == "?" || msg.String() == "esc" {
@@ -37,38 +49,454 @@ func (k *KeyboardHandler) Handle(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return k.tui.handleErrorModalKeys(msg)
 	}
 
+	// Special handling for the quota view, also opened over the project modal
+	if k.tui.showProjectQuotas {
+		return k.tui.handleProjectQuotasKeys(msg)
+	}
+
 	// Special handling for project modal
 	if k.tui.showProjectModal {
 		return k.tui.handleProjectModalKeys(msg)
 	}
 
+	// Special handling for create secret form
+	if k.tui.showSecretForm {
+		return k.tui.handleSecretFormKeys(msg)
+	}
+
 	// Special handling for secret modal
 	if k.tui.showSecretModal {
 		return k.tui.handleSecretModalKeys(msg)
 	}
 
+	// Special handling for configmap data modal
+	if k.tui.showConfigMapModal {
+		return k.tui.handleConfigMapModalKeys(msg)
+	}
+
+	// Special handling for full-screen YAML view
+	if k.tui.showYAMLView {
+		return k.tui.handleYAMLViewKeys(msg)
+	}
+
+	// Special handling for the full-screen diff of two resources
+	if k.tui.showResourceDiff {
+		return k.tui.handleResourceDiffKeys(msg)
+	}
+
+	// Special handling for the side by side cluster view
+	if k.tui.showClusterCompare {
+		return k.tui.handleClusterCompareKeys(msg)
+	}
+
+	// Special handling for the second cluster picker
+	if k.tui.showClusterPicker {
+		return k.tui.handleClusterPickerKeys(msg)
+	}
+
+	// Special handling for pod delete confirmation
+	if k.tui.showDeletePodModal {
+		return k.tui.handleDeletePodModalKeys(msg)
+	}
+
+	// Special handling for workload delete confirmation
+	if k.tui.showCascadeDelete {
+		return k.tui.handleCascadeDeleteKeys(msg)
+	}
+
+	// Special handling for the service topology
+	if k.tui.showTopology {
+		return k.tui.handleServiceTopologyKeys(msg)
+	}
+
+	// Special handling for node cordon and drain confirmation
+	if k.tui.showNodeActionModal {
+		return k.tui.handleNodeActionModalKeys(msg)
+	}
+
+	// Special handling for the confirmation of a change previewed in the diff tool
+	if k.tui.showDiffReview {
+		return k.tui.handleDiffReviewKeys(msg)
+	}
+
+	// Special handling for DeploymentConfig rollback confirmation
+	if k.tui.showRollbackModal {
+		return k.tui.handleRollbackModalKeys(msg)
+	}
+
+	// Special handling for background task panel
+	if k.tui.showTaskPanel {
+		return k.tui.handleTaskPanelKeys(msg)
+	}
+
+	// Special handling for the login form
+	if k.tui.showLoginForm {
+		return k.tui.handleLoginFormKeys(msg)
+	}
+
+	// Special handling for the settings screen
+	if k.tui.showSettings {
+		return k.tui.handleSettingsKeys(msg)
+	}
+
+	// Special handling for saved view editor and picker
+	if k.tui.showViewForm {
+		return k.tui.handleViewFormKeys(msg)
+	}
+	if k.tui.showViewPicker {
+		return k.tui.handleViewPickerKeys(msg)
+	}
+
+	// Special handling for the file browser of a pod
+	if k.tui.showPodFiles {
+		return k.tui.handlePodFilesKeys(msg)
+	}
+
+	// Special handling for the debug container launcher
+	if k.tui.showDebugContainer {
+		return k.tui.handleDebugContainerKeys(msg)
+	}
+
+	// Special handling for the manifest file picker
+	if k.tui.showApplyPicker {
+		return k.tui.handleApplyPickerKeys(msg)
+	}
+
+	// Special handling for the apply results panel
+	if k.tui.showApplyResults {
+		return k.tui.handleApplyResultsKeys(msg)
+	}
+
+	// Special handling for the re-login prompt
+	if k.tui.showReloginPrompt {
+		return k.tui.handleReloginPromptKeys(msg)
+	}
+
+	// Special handling for the container log picker
+	if k.tui.showContainerPicker {
+		return k.tui.handleContainerPickerKeys(msg)
+	}
+
+	// Special handling for the save logs prompt
+	if k.tui.showLogExport {
+		return k.tui.handleLogExportKeys(msg)
+	}
+
+	// Special handling for control plane modal
+	if k.tui.showControlPlaneModal {
+		return k.tui.handleControlPlaneModalKeys(msg)
+	}
+
+	// Special handling for the cluster storage view
+	if k.tui.showClusterStorage {
+		return k.tui.handleClusterStorageKeys(msg)
+	}
+
+	// Special handling for the machines view
+	if k.tui.showMachines {
+		return k.tui.handleMachinesKeys(msg)
+	}
+
+	// Special handling for the certificate error screen
+	if k.tui.showTLSError {
+		return k.tui.handleTLSErrorKeys(msg)
+	}
+
+	// Special handling for the profile picker
+	if k.tui.showProfilePicker {
+		return k.tui.handleProfilePickerKeys(msg)
+	}
+
+	// Special handling for the related resource picker
+	if k.tui.showRelatedPicker {
+		return k.tui.handleRelatedPickerKeys(msg)
+	}
+
+	// Special handling for the startup check warnings
+	if k.tui.showStartupChecks {
+		return k.tui.handleStartupChecksKeys(msg)
+	}
+
+	// Special handling for the cluster info view
+	if k.tui.showClusterInfo {
+		return k.tui.handleClusterInfoKeys(msg)
+	}
+
+	// Special handling for the overview
+	if k.tui.showDashboard {
+		return k.tui.handleDashboardKeys(msg)
+	}
+
+	// Special handling for the cluster operators
+	if k.tui.showClusterOperators {
+		return k.tui.handleClusterOperatorsKeys(msg)
+	}
+
+	// Special handling for the Helm browser
+	if k.tui.showHelm {
+		return k.tui.handleHelmKeys(msg)
+	}
+
+	// Special handling for the batch menu and confirmation
+	if k.tui.showBatch {
+		return k.tui.handleBatchKeys(msg)
+	}
+
+	// Special handling for the notification history
+	if k.tui.showNotifications {
+		return k.tui.handleNotificationsKeys(msg)
+	}
+
+	// Special handling for the Top view
+	if k.tui.showTop {
+		return k.tui.handleTopKeys(msg)
+	}
+
+	// Special handling for the image inventory report
+	if k.tui.showImageReport {
+		return k.tui.handleImageReportKeys(msg)
+	}
+
+	// Special handling for the object count report
+	if k.tui.showObjectReport {
+		return k.tui.handleObjectReportKeys(msg)
+	}
+
+	// Special handling for typing a pod log search
+	if k.tui.editingLogSearch {
+		return k.tui.handleLogSearchKeys(msg)
+	}
+
+	// Special handling for typing in the quick filter bar
+	if k.tui.editingListFilter {
+		return k.tui.handleListFilterKeys(msg)
+	}
+
+	// Special handling for typing a ':' command
+	if k.tui.showCommandPrompt {
+		return k.tui.handleCommandPromptKeys(msg)
+	}
+
 	// Normal key handling
 	switch msg.String() {
 	case "ctrl+c", "q":
-		// Stop log streaming before quitting
-		k.tui.stopPodLogStream()
-		return k.tui, tea.Quit
+		return k.tui, k.tui.quit()
 		
 	case "ctrl+p":
 		return k.handleProjectSwitchKey()
 
+	case "ctrl+l":
+		return k.tui, k.tui.openLoginForm()
+
+	case ",":
+		k.tui.openSettings()
+		return k.tui, nil
+
+	case ":":
+		return k.tui, k.tui.openCommandPrompt()
+
+	case "o":
+		if k.tui.ActiveTab == 7 || k.tui.ActiveTab == 17 { // Routes, Ingresses tabs
+			return k.tui, k.tui.openSelectedURL()
+		}
+		k.tui.cycleListSort()
+		return k.tui, nil
+
+	case "i":
+		k.tui.reverseListSort()
+		return k.tui, nil
+
+	case "H":
+		return k.handleControlPlaneKey()
+
+	case "I":
+		return k.tui, k.tui.openImageReport()
+
+	case "O":
+		return k.tui, k.tui.openObjectReport()
+
+	case "v":
+		if k.tui.ActiveTab == 16 { // Storage tab
+			return k.tui, k.tui.openClusterStorage()
+		}
+		return k.tui, nil
+
+	case "u":
+		return k.tui, k.tui.openTop()
+
+	case "W":
+		k.tui.openNotifications()
+		return k.tui, nil
+
+	case "M":
+		if k.tui.ActiveTab == 15 { // Nodes tab
+			return k.tui, k.tui.openMachines()
+		}
+		return k.tui, nil
+
+	case "ctrl+d":
+		if k.tui.openBatch("delete") {
+			return k.tui, nil
+		}
+		if k.tui.ActiveTab == 15 { // Nodes tab
+			return k.tui, k.tui.openNodeDrainModal()
+		}
+		if k.tui.ActiveTab == 0 { // Pods tab
+			return k.tui, k.tui.openDeletePodModal()
+		}
+		return k.tui, k.tui.openCascadeDelete()
+
+	case "m":
+		return k.tui, k.tui.openServiceTopology()
+
+	case "V":
+		k.tui.openViewPicker()
+		return k.tui, nil
+
+	case "y":
+		return k.tui, k.tui.openYAMLView()
+
+	case "Y":
+		return k.tui, k.tui.copyEquivalentCommand()
+
+	case "=":
+		return k.tui, k.tui.markForDiff()
+
+	case "E":
+		return k.tui, k.tui.startEdit()
+
+	case "a":
+		if k.tui.toggleBatchMarkAll() {
+			return k.tui, nil
+		}
+		return k.tui, k.tui.startApply()
+
+	case "F":
+		k.tui.openApplyPicker()
+		return k.tui, nil
+
+	case "J":
+		return k.tui, k.tui.openPodFiles()
+
+	case "Z":
+		k.tui.openDebugContainer("")
+		return k.tui, nil
+
+	case "w":
+		return k.tui, k.tui.openClusterInfo()
+
+	case projectQuotasKey:
+		return k.tui, k.tui.openProjectQuotas(k.tui.namespace)
+
+	case dashboardKey:
+		return k.tui, k.tui.openDashboard()
+
+	case "x":
+		if !k.tui.openBatch("") {
+			k.tui.logWarn(categoryAction, "Mark pods, deployments or jobs with space first")
+		}
+		return k.tui, nil
+
+	case "R":
+		if k.tui.openBatch("restart") {
+			return k.tui, nil
+		}
+		if k.tui.ActiveTab == 19 { // DeploymentConfigs tab
+			return k.tui, k.tui.rolloutLatest()
+		}
+		return k.tui, k.tui.rolloutRestart()
+
+	case "U":
+		k.tui.openRollbackModal()
+		return k.tui, nil
+
+	case "+":
+		if k.tui.ActiveTab == 4 { // Secrets tab
+			return k.tui, k.tui.openSecretForm()
+		}
+		return k.tui, nil
+
+	case "s":
+		if k.tui.ActiveTab == 15 { // Nodes tab
+			k.tui.openNodeCordonModal()
+			return k.tui, nil
+		}
+		return k.tui, k.tui.toggleCronJobSuspend()
+
+	case "D":
+		k.tui.toggleDryRun()
+		return k.tui, nil
+
+	case "f":
+		k.tui.cycleAppLogFilter()
+		return k.tui, nil
+
+	case "A":
+		k.tui.toggleTabAutoRefresh()
+		return k.tui, nil
+
+	case "P":
+		k.tui.toggleAutoRefreshPause()
+		return k.tui, nil
+
+	case "b":
+		k.tui.showTaskPanel = true
+		return k.tui, nil
+
+	case macroRecordKey:
+		return k.tui, k.tui.toggleMacroRecording()
+
+	case macroReplayKey:
+		k.tui.macroPendingKey = macroReplayKey
+		return k.tui, nil
+
 	case "esc":
 		// Close error modal if open
 		if k.tui.showErrorModal {
 			k.tui.showErrorModal = false
 			return k.tui, nil
 		}
+		// Clear the pod log search when the log panel is focused
+		if k.tui.focusedPanel == 2 && k.tui.logSearchQuery != "" {
+			k.tui.clearLogSearch()
+			return k.tui, nil
+		}
+		// Clear the quick filter of the current list
+		if k.tui.listFilters[int(k.tui.ActiveTab)] != "" {
+			return k.tui, k.tui.setListFilter("")
+		}
+		return k.tui, nil
+
+	case "/":
+		// Search the logs when the log panel is focused, otherwise filter the list
+		if k.tui.focusedPanel == 2 && k.tui.logViewMode == constants.PodLogViewMode {
+			k.tui.openLogSearch()
+		} else if k.tui.connected {
+			k.tui.openListFilter()
+		}
+		return k.tui, nil
+
+	case "n", "N":
+		if k.tui.logSearchActive() {
+			if msg.String() == "n" {
+				k.tui.nextLogMatch(1)
+			} else {
+				k.tui.nextLogMatch(-1)
+			}
+		}
 		return k.tui, nil
 
 	case "r":
-		// Manual retry/reconnect
+		// Manual retry/reconnect, or refresh
 		if !k.tui.connected && !k.tui.connecting {
-			return k.tui, k.tui.InitializeK8sClient(k.tui.KubeconfigPath)
+			return k.tui, k.tui.reconnect()
+		}
+		if k.tui.logStreamBudgetExhausted() {
+			k.tui.resetLogStreamBudget()
+			return k.tui, k.tui.startPodLogStream()
+		}
+		if k.tui.connected {
+			return k.tui, k.tui.refreshNow()
 		}
 		return k.tui, nil
 
@@ -94,7 +522,18 @@ func (k *KeyboardHandler) Handle(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return k.handleSpaceKey()
 
 	case "c":
-		return k.handleCopyKey()
+		return k.tui, k.tui.cycleLogContainer()
+
+	case "C":
+		if k.tui.ActiveTab == 7 || k.tui.ActiveTab == 17 { // Routes, Ingresses tabs
+			return k.tui, k.tui.checkSelectedURL()
+		}
+		k.tui.openContainerPicker()
+		return k.tui, nil
+
+	case "S":
+		k.tui.openLogExport()
+		return k.tui, nil
 
 	case "d":
 		return k.handleDetailsToggleKey()
@@ -109,10 +548,17 @@ func (k *KeyboardHandler) Handle(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return k.handleTailToggleKey()
 
 	case "l":
+		if k.tui.labelChipsActive() {
+			k.tui.moveLabelChip(1)
+			return k.tui, nil
+		}
 		return k.handleLogToggleKey()
 		
 	case "L":
-		return k.handleLogPanelToggleKey()
+		model, cmd := k.handleLogPanelToggleKey()
+		// The log panel takes rows from the list window
+		k.tui.updateMainContent()
+		return model, cmd
 
 	case "j", "down":
 		return k.handleDownKey()
@@ -121,6 +567,10 @@ func (k *KeyboardHandler) Handle(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return k.handleUpKey()
 
 	case "h":
+		if k.tui.labelChipsActive() {
+			k.tui.moveLabelChip(-1)
+			return k.tui, nil
+		}
 		return k.handleLeftTabKey()
 		
 	case "left":
@@ -131,6 +581,34 @@ func (k *KeyboardHandler) Handle(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		k.tui.NextTab()
 		return k.tui, k.tui.handleTabSwitch()
 
+	case "0":
+		return k.tui, k.tui.toggleAllNamespaces()
+
+	case "g":
+		if k.focusManager.IsMainPanelFocused() {
+			return k.tui, k.tui.openRelated()
+		}
+		return k.tui, nil
+
+	case "G":
+		return k.tui, k.tui.toggleOwnerTree()
+
+	case "[":
+		return k.tui, k.tui.cycleDetailTab(-1)
+
+	case "]":
+		return k.tui, k.tui.cycleDetailTab(1)
+
+	case "K":
+		k.tui.openClusterPicker()
+		return k.tui, nil
+
+	case "X":
+		return k.tui, k.tui.switchCluster()
+
+	case "|":
+		return k.tui, k.tui.openClusterCompare()
+
 	case "1":
 		k.focusManager.FocusPanel(0) // Focus main panel
 		return k.tui, nil
@@ -160,7 +638,10 @@ func (k *KeyboardHandler) handleDownKey() (tea.Model, tea.Cmd) {
 			k.tui.clearPodLogs()
 			return k.tui, k.tui.startPodLogStream()
 		}
-		return k.tui, k.tui.loadPodLogs()
+		return k.tui, nil
+	} else if k.focusManager.IsDetailsPanelFocused() && k.tui.scrollDetails(1) {
+		// Scroll the detail pane, and move on to the logs at its end
+		return k.tui, nil
 	} else if k.focusManager.IsMainPanelFocused() && k.tui.showLogs {
 		// Move focus down to logs panel
 		k.focusManager.FocusPanel(2)
@@ -198,7 +679,7 @@ func (k *KeyboardHandler) handleUpKey() (tea.Model, tea.Cmd) {
 			k.tui.clearPodLogs()
 			return k.tui, k.tui.startPodLogStream()
 		}
-		return k.tui, k.tui.loadPodLogs()
+		return k.tui, nil
 	} else if k.focusManager.IsLogsPanelFocused() && len(k.tui.podLogs) > 0 {
 		// Scroll up in pod logs
 		if k.tui.logScrollOffset > 0 {
@@ -215,6 +696,9 @@ func (k *KeyboardHandler) handleUpKey() (tea.Model, tea.Cmd) {
 		} else {
 			k.focusManager.FocusPanel(0) // Focus main panel
 		}
+	} else if k.focusManager.IsDetailsPanelFocused() && k.tui.scrollDetails(-1) {
+		// Scroll the detail pane, and move up to main at its top
+		return k.tui, nil
 	} else if k.focusManager.IsDetailsPanelFocused() {
 		// Move focus up from details to main
 		k.focusManager.FocusPanel(0)
@@ -224,6 +708,10 @@ func (k *KeyboardHandler) handleUpKey() (tea.Model, tea.Cmd) {
 
 // Additional handler methods for other keys
 func (k *KeyboardHandler) handleEnterKey() (tea.Model, tea.Cmd) {
+	// Filter the pods by the label chip selected in the pod details
+	if k.tui.labelChipsActive() {
+		return k.tui, k.tui.filterByLabelChip()
+	}
 	if k.focusManager.IsMainPanelFocused() {
 		switch k.tui.ActiveTab {
 		case 0: // Pods tab
@@ -246,9 +734,8 @@ func (k *KeyboardHandler) handleEnterKey() (tea.Model, tea.Cmd) {
 			}
 		case 3: // ConfigMaps tab
 			if len(k.tui.configMaps) > 0 {
-				// Toggle details panel for the selected configmap
-				k.tui.showDetails = !k.tui.showDetails
-				return k.tui, nil
+				// Load and show configmap data in modal
+				return k.tui, k.tui.openConfigMapModal()
 			}
 		case 4: // Secrets tab
 			if len(k.tui.secrets) > 0 {
@@ -257,7 +744,28 @@ func (k *KeyboardHandler) handleEnterKey() (tea.Model, tea.Cmd) {
 			}
 		case 5: // BuildConfigs tab
 			if len(k.tui.buildConfigs) > 0 {
-				// Toggle details panel for the selected buildconfig
+				// Start a new build from the selected buildconfig
+				return k.tui, k.tui.startBuild()
+			}
+		case 8: // Builds tab
+			if len(k.tui.builds) > 0 {
+				// Stream the selected build's logs into the log panel
+				return k.tui, k.tui.startBuildLogStream()
+			}
+		case 9: // Events tab
+			if len(k.tui.events) > 0 {
+				// Toggle details panel for the selected event
+				k.tui.showDetails = !k.tui.showDetails
+				return k.tui, nil
+			}
+		case 14: // CronJobs tab
+			if len(k.tui.cronJobs) > 0 {
+				// Run the selected cronjob now
+				return k.tui, k.tui.triggerCronJob()
+			}
+		case 10, 11, 12, 13, 15, 16, 17, 18, 19: // Workload, Nodes, Storage and networking tabs
+			if _, shown := k.tui.listLength(int(k.tui.ActiveTab)); shown > 0 {
+				// Toggle details panel for the selected workload
 				k.tui.showDetails = !k.tui.showDetails
 				return k.tui, nil
 			}
@@ -295,16 +803,16 @@ func (k *KeyboardHandler) handleProjectSwitchKey() (tea.Model, tea.Cmd) {
 }
 
 func (k *KeyboardHandler) handleSpaceKey() (tea.Model, tea.Cmd) {
+	// Mark the selected item for a batch action and move on to the next one
+	if k.focusManager.IsMainPanelFocused() && k.tui.toggleBatchMark() {
+		return k.handleDownKey()
+	}
+
 	// Toggle details panel
 	k.tui.showDetails = !k.tui.showDetails
 	return k.tui, nil
 }
 
-func (k *KeyboardHandler) handleCopyKey() (tea.Model, tea.Cmd) {
-	// Copy selected resource info (placeholder - not implemented in original)
-	return k.tui, nil
-}
-
 func (k *KeyboardHandler) handleDetailsToggleKey() (tea.Model, tea.Cmd) {
 	// Toggle details panel
 	k.tui.showDetails = !k.tui.showDetails
@@ -322,9 +830,9 @@ func (k *KeyboardHandler) handleErrorKey() (tea.Model, tea.Cmd) {
 func (k *KeyboardHandler) handleThemeToggleKey() (tea.Model, tea.Cmd) {
 	// Toggle theme
 	if k.tui.theme == "dark" {
-		k.tui.theme = "light"
+		k.tui.setTheme("light")
 	} else {
-		k.tui.theme = "dark"
+		k.tui.setTheme("dark")
 	}
 	return k.tui, nil
 }
@@ -397,4 +905,11 @@ func (k *KeyboardHandler) handleLeftTabKey() (tea.Model, tea.Cmd) {
 		return k.tui, k.tui.handleTabSwitch()
 	}
 	return k.tui, nil
-}
\ No newline at end of file
+}
+// handleControlPlaneKey handles 'H' key for the control-plane health view
+func (k *KeyboardHandler) handleControlPlaneKey() (tea.Model, tea.Cmd) {
+	if !k.tui.connected {
+		return k.tui, nil
+	}
+	return k.tui, k.tui.openControlPlaneModal()
+}
@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keyBinding is a key of the keymap and what it does. Further lines of the
+// description, one per tab or panel the key does something else in, are
+// separated by newlines.
+type keyBinding struct {
+	Key         string
+	Description string
+}
+
+// keySection is a group of the keymap
+type keySection struct {
+	Title    string
+	Bindings []keyBinding
+}
+
+// keymap is the registry of the keys the help and the cheat sheet show.
+// Add a key here when the keyboard handler learns one.
+var keymap = []keySection{
+	{"Navigation", []keyBinding{
+		{"tab", "Next panel"},
+		{"shift+tab", "Previous panel"},
+		{"j/k", "Move down/up in pod list OR scroll details or logs"},
+		{"h/l", "Previous/Next tab (in main panel)"},
+		{"arrow keys", "Navigate tabs/list"},
+		{"1/2/3", "Jump to main/detail/log panel"},
+		{"h/l enter", "Select a label chip of the pod details, list the pods with that label"},
+	}},
+	{"Log Scrolling (when in log panel)", []keyBinding{
+		{"j/k", "Scroll up/down line by line"},
+		{"PgUp/PgDn", "Scroll up/down page by page"},
+		{"Home/End", "Jump to top/bottom of logs"},
+		{"T", "Toggle tail mode (auto-scroll to new logs)"},
+		{"/", "Search pod logs (smart case), enter keeps it, esc clears"},
+		{"n/N", "Next/previous search match"},
+	}},
+	{"Commands", []keyBinding{
+		{"?", "Toggle help"},
+		{"enter", "Show details, view or edit configmap or secret data, start a build, run a cronjob or stream build logs"},
+		{"+", "Create an Opaque, docker-registry or tls secret (Secrets tab)"},
+		{"o", "Open the URL in the browser (Routes and Ingresses tabs)"},
+		{"C", "HTTP health check of the URL (Routes and Ingresses tabs)"},
+		{"l", "Toggle app/pod logs (when in log panel) OR navigate tabs"},
+		{"ctrl+p", "Switch project/namespace, favorites and recent ones first; in it * stars, + creates ctrl+d deletes and % shows the quotas of the selected one"},
+		{"ctrl+l", "Log in to a cluster with a token or username and password (saved to kubeconfig)"},
+		{"0", "Toggle listing resources in all namespaces"},
+		{"[ / ]", "Previous/next tab of the details: summary, YAML, events, metrics and related"},
+		{"G", "Toggle the Related tab of the details: the owner tree of controllers that created the selected object"},
+		{"g", "Jump to related resources: a service's pods, a deployment's ReplicaSets or pods, a route's services"},
+		{"K", "Connect a second cluster from the kubeconfig contexts"},
+		{"X", "Switch between the two connected clusters"},
+		{"|", "Compare the current tab across both clusters side by side"},
+		{"/", "Fuzzy filter the current list (esc clears)"},
+		{"o / i", "Sort by the next column / reverse the sort (pods, services and deployments tabs)"},
+		{"H", "Control plane health"},
+		{"I", "Image inventory report (all namespaces, CSV export)"},
+		{"O", "Object counts per resource type and namespace"},
+		{"v", "PersistentVolumes and StorageClasses (storage tab)"},
+		{"M", "MachineSets and Machines, scale with +/- (nodes tab, OpenShift)"},
+		{"W", "Notification history: CrashLoopBackOff, OOMKilled and unavailable deployments"},
+		{"u", "Top: pods ranked by CPU or memory usage with sparkline history (also :top)"},
+		{"w", "Cluster info: API and console URLs, platform, versions and identity providers (also :info)"},
+		{"B", "Overview of the namespace: pods by phase, unavailable deployments, failed builds, recent warnings and cluster operators (also :overview)"},
+		{"%", "Quotas of the current project with usage bars, and the defaults of its limit ranges (also :quota [namespace])"},
+		{":co", "Cluster operators with their Available, Progressing and Degraded conditions, like oc get co (OpenShift)"},
+		{":helm", "Helm releases of the namespace with chart, status and revision history, and their manifests and values"},
+		{":checks", "Rerun the cluster checks: metrics-server, default storage class, image pull secrets"},
+		{":profile", "Open a configured profile: context, namespace, tab and filter (no name: pick one)"},
+		{"ctrl+d", "Delete, evict or force delete the selected pod with a grace period (pods tab)\n/ drain selected node (nodes tab)\n/ delete selected workload, previewing its dependents (tab: orphan or foreground)"},
+		{"space", "Mark the selected pod, deployment or job for a batch action"},
+		{"a", "Mark all listed items once one is marked (again: unmark all)"},
+		{"x", "Run delete, restart or label on the marked items (ctrl+d and R also act on them)"},
+		{"m", "Topology mini-map of the selected service: routes, ingresses, pods and workloads (services tab)"},
+		{"V", "Saved views for current tab"},
+		{"y", "View full YAML of selected resource (m: field managers and last-applied drift)"},
+		{"=", "Mark the selected resource to compare, then = on another one diffs their manifests (also :diff <namespace|revision>)"},
+		{"Y", "Copy the equivalent oc/kubectl command: logs, describe, start-build, get -o yaml"},
+		{"E", "Edit selected resource in $EDITOR"},
+		{"a", "Apply manifests written in $EDITOR (multi-document)"},
+		{"J", "Browse the files of the selected pod's container, download them to the current directory or upload local ones (pods tab, also :files)"},
+		{"Z", "Attach an ephemeral debug container (busybox or the debug image setting) to the selected pod and open a shell in it (pods tab, also :debug [image])"},
+		{"F", "Apply manifest files or directories picked from disk (also :apply [path])"},
+		{"R", "Rollout restart selected deployment / rollout latest (deploymentconfigs tab)"},
+		{"U", "Roll back selected deploymentconfig to its previous version"},
+		{"s", "Suspend/resume selected cronjob / cordon or uncordon selected node"},
+		{"D", "Toggle server-side dry run for changes (nothing is saved)"},
+		{"f", "App log: cycle category filter (all/connection/project/resource/action)"},
+		{"A", "Toggle auto refresh for current tab"},
+		{"P", "Pause/resume all auto refresh"},
+		{"b", "Background task panel"},
+		{"d", "Toggle details panel"},
+		{"L", "Toggle log panel (shift+l)"},
+		{"c", "Cycle log container, incl. init and previous (pods tab)"},
+		{"C", "Pick log container (pods tab)"},
+		{"S", "Save loaded pod, service or build logs to a file"},
+		{"r", "Refresh the current tab / Retry connection / Restart a stopped log stream"},
+		{"Q<a-z>", "Record a keyboard macro into a register (Q again stops)"},
+		{"@<a-z>", "Replay a macro (@@ replays the last one, any key cancels)"},
+		{"e", "Show error details (when errors exist)"},
+		{"t", "Toggle theme"},
+		{":", "Command mode: :pods, :deploy, :ns <namespace>, :ctx <context>, :logs <pod> (tab completes, ↑/↓ history)"},
+		{",", "Settings: theme, status palette, default namespace, refresh intervals, mouse, log tail, time format (saved)"},
+		{"ctrl+z", "Suspend to the shell (fg resumes)"},
+		{"q", "Quit"},
+	}},
+}
+
+// helpText renders the keymap as the text of the help overlay
+func helpText() string {
+	var b strings.Builder
+	b.WriteString("📖 LazyOC Help\n")
+	for _, section := range keymap {
+		b.WriteString("\n" + section.Title + ":\n")
+		for _, binding := range section.Bindings {
+			lines := strings.Split(binding.Description, "\n")
+			b.WriteString(fmt.Sprintf("  %-10s %s\n", binding.Key, lines[0]))
+			for _, line := range lines[1:] {
+				b.WriteString(fmt.Sprintf("  %-10s %s\n", "", line))
+			}
+		}
+	}
+	b.WriteString("\nPress ? or ESC to close")
+	return b.String()
+}
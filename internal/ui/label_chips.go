@@ -0,0 +1,96 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// podLabelChips returns the labels of a pod as sorted "key=value" chips
+func podLabelChips(pod resources.PodInfo) []string {
+	chips := make([]string, 0, len(pod.Labels))
+	for key, value := range pod.Labels {
+		chips = append(chips, key+"="+value)
+	}
+	sort.Strings(chips)
+	return chips
+}
+
+// selectedLabelChip returns the index of the chip selected on a pod, the
+// first one after the selection moved to another pod
+func (t *TUI) selectedLabelChip(pod resources.PodInfo, chips []string) int {
+	if t.labelChipPod != pod.Namespace+"/"+pod.Name || t.labelChip >= len(chips) {
+		return 0
+	}
+	return t.labelChip
+}
+
+// labelChipsActive reports whether the chips of the selected pod take the
+// keys, i.e. the pod details are focused
+func (t *TUI) labelChipsActive() bool {
+	return t.ActiveTab == 0 && t.focusedPanel == 1 && t.showDetails &&
+		t.selectedPod >= 0 && t.selectedPod < len(t.pods) && len(t.pods[t.selectedPod].Labels) > 0
+}
+
+// moveLabelChip selects the previous or next chip of the selected pod
+func (t *TUI) moveLabelChip(delta int) {
+	if !t.labelChipsActive() {
+		return
+	}
+	pod := t.pods[t.selectedPod]
+	chips := podLabelChips(pod)
+	t.labelChip = (t.selectedLabelChip(pod, chips) + delta + len(chips)) % len(chips)
+	t.labelChipPod = pod.Namespace + "/" + pod.Name
+	t.updatePodDetails(pod)
+}
+
+// filterByLabelChip filters the pod list by the selected chip's label and
+// returns focus to the list, where the pod's siblings are now listed
+func (t *TUI) filterByLabelChip() tea.Cmd {
+	if !t.labelChipsActive() {
+		return nil
+	}
+	pod := t.pods[t.selectedPod]
+	chips := podLabelChips(pod)
+	chip := chips[t.selectedLabelChip(pod, chips)]
+
+	t.focusedPanel = 0
+	return t.setActiveView(&config.SavedView{Name: chip, Filter: "label:" + chip})
+}
+
+// renderLabelChips renders the labels of a pod as chips, highlighting the
+// selected one while the details are focused
+func (t *TUI) renderLabelChips(pod resources.PodInfo) string {
+	chips := podLabelChips(pod)
+	if len(chips) == 0 {
+		return ""
+	}
+
+	chipStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("12"))
+	selectedStyle := chipStyle.Bold(true).Reverse(true)
+	selected := -1
+	if t.labelChipsActive() {
+		selected = t.selectedLabelChip(pod, chips)
+	}
+
+	rendered := make([]string, len(chips))
+	for i, chip := range chips {
+		if i == selected {
+			rendered[i] = selectedStyle.Render("[" + chip + "]")
+		} else {
+			rendered[i] = chipStyle.Render("[" + chip + "]")
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("\nLabels:\n  " + strings.Join(rendered, " ") + "\n")
+	if selected >= 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render("  h/l: select label • enter: list pods with it") + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestHasLabel(t *testing.T) {
+	tests := []struct {
+		want     string
+		expected bool
+	}{
+		{"app=web", true},
+		{"app=we", false},
+		{"app", true},
+		{"ap", false},
+		{"tier=front", true},
+		{"tier=back", false},
+	}
+	for _, test := range tests {
+		if got := hasLabel("app=web,tier=front", test.want); got != test.expected {
+			t.Errorf("hasLabel(%q) = %v, expected %v", test.want, got, test.expected)
+		}
+	}
+}
+
+func TestFilterByLabelChip(t *testing.T) {
+	labelled := func(name string, labels map[string]string) resources.PodInfo {
+		pod := testPod(name, "Running", "node-a", 0, time.Hour)
+		pod.Labels = labels
+		return pod
+	}
+	pods := []resources.PodInfo{
+		labelled("web-1", map[string]string{"app": "web", "tier": "front"}),
+		labelled("web-2", map[string]string{"app": "web", "tier": "front"}),
+		labelled("webhook-1", map[string]string{"app": "webhook", "tier": "front"}),
+		labelled("db-1", map[string]string{"app": "db"}),
+	}
+	tui := &TUI{App: models.NewApp("test"), allPods: pods, pods: pods, activeViews: make(map[int]config.SavedView),
+		showDetails: true, focusedPanel: 1, width: 120, height: 40}
+
+	if details := tui.renderLabelChips(pods[0]); !strings.Contains(details, "[app=web]") || !strings.Contains(details, "[tier=front]") {
+		t.Errorf("Expected the labels as chips, got %q", details)
+	}
+
+	// Chips are sorted, l moves from app=web to tier=front and wraps around
+	tui.moveLabelChip(1)
+	tui.moveLabelChip(1)
+	tui.moveLabelChip(1)
+	if tui.selectedLabelChip(pods[0], podLabelChips(pods[0])) != 1 {
+		t.Fatalf("Expected the tier chip to be selected, got %d", tui.labelChip)
+	}
+	if tui.selectedLabelChip(pods[3], podLabelChips(pods[3])) != 0 {
+		t.Error("Expected another pod to start at its first chip")
+	}
+
+	tui.moveLabelChip(-1)
+	tui.filterByLabelChip()
+	if tui.focusedPanel != 0 {
+		t.Error("Expected the focus to return to the pod list")
+	}
+	view := tui.activeView(0)
+	if view == nil || view.Filter != "label:app=web" {
+		t.Fatalf("Expected a label view, got %+v", view)
+	}
+	if len(tui.pods) != 2 || tui.pods[0].Name != "web-1" || tui.pods[1].Name != "web-2" {
+		t.Errorf("Expected only the pods labelled app=web, got %+v", tui.pods)
+	}
+}
@@ -0,0 +1,231 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// Fuzzy match highlighting toggles bold and underline only, so it can be nested
+// inside a row style without resetting the row's colors
+const (
+	fuzzyHighlightOn  = "\x1b[1;4m"
+	fuzzyHighlightOff = "\x1b[22;24m"
+)
+
+// fuzzyMatch reports whether all runes of query appear in target in order,
+// ignoring case, and returns the rune positions of the leftmost match
+func fuzzyMatch(target, query string) ([]int, bool) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, true
+	}
+
+	want := []rune(strings.ToLower(query))
+	positions := make([]int, 0, len(want))
+	qi := 0
+	for i, r := range []rune(target) {
+		if qi == len(want) {
+			break
+		}
+		if unicode.ToLower(r) == want[qi] {
+			positions = append(positions, i)
+			qi++
+		}
+	}
+	return positions, qi == len(want)
+}
+
+// fuzzyTarget returns the text of a row the quick filter matches against.
+// Events are matched on the object they concern rather than their generated name.
+func fuzzyTarget(row viewRow) string {
+	if object, ok := row.fields["object"]; ok {
+		return object
+	}
+	return row.fields["name"]
+}
+
+// fuzzyFilterItems keeps the items whose row matches the fuzzy query, preserving order
+func fuzzyFilterItems[T any](items []T, query string, toRow func(T) viewRow) []T {
+	if strings.TrimSpace(query) == "" {
+		return items
+	}
+
+	matches := make([]T, 0, len(items))
+	for _, item := range items {
+		if _, ok := fuzzyMatch(fuzzyTarget(toRow(item)), query); ok {
+			matches = append(matches, item)
+		}
+	}
+	return matches
+}
+
+// viewItems derives the displayed list of a tab: the active saved view and
+// column sort are applied first, then the tab's quick filter narrows the result
+func viewItems[T any](t *TUI, tab int, items []T, toRow func(T) viewRow) []T {
+	return fuzzyFilterItems(applyView(items, t.sortedView(tab), toRow), t.listFilters[tab], toRow)
+}
+
+// highlightFuzzy marks the runes of s matched by the fuzzy query
+func highlightFuzzy(s, query string) string {
+	positions, ok := fuzzyMatch(s, query)
+	if !ok || len(positions) == 0 {
+		return s
+	}
+
+	var b strings.Builder
+	next := 0
+	for i, r := range []rune(s) {
+		if next < len(positions) && positions[next] == i {
+			b.WriteString(fuzzyHighlightOn)
+			b.WriteRune(r)
+			b.WriteString(fuzzyHighlightOff)
+			next++
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// highlightListFilter highlights the active tab's quick filter matches in s
+func (t *TUI) highlightListFilter(s string) string {
+	return highlightFuzzy(s, t.listFilters[int(t.ActiveTab)])
+}
+
+// listLength returns the number of loaded and displayed items of a tab
+func (t *TUI) listLength(tab int) (total, shown int) {
+	switch tab {
+	case 0:
+		return len(t.allPods), len(t.pods)
+	case 1:
+		return len(t.allServices), len(t.services)
+	case 2:
+		return len(t.allDeployments), len(t.deployments)
+	case 3:
+		return len(t.allConfigMaps), len(t.configMaps)
+	case 4:
+		return len(t.allSecrets), len(t.secrets)
+	case 5:
+		return len(t.allBuildConfigs), len(t.buildConfigs)
+	case 6:
+		return len(t.allImageStreams), len(t.imageStreams)
+	case 7:
+		return len(t.allRoutes), len(t.routes)
+	case 8:
+		return len(t.allBuilds), len(t.builds)
+	case 9:
+		return len(t.allEvents), len(t.events)
+	case 10:
+		return len(t.allStatefulSets), len(t.statefulSets)
+	case 11:
+		return len(t.allDaemonSets), len(t.daemonSets)
+	case 12:
+		return len(t.allReplicaSets), len(t.replicaSets)
+	case 13:
+		return len(t.allJobs), len(t.jobs)
+	case 14:
+		return len(t.allCronJobs), len(t.cronJobs)
+	case 15:
+		return len(t.allNodes), len(t.nodes)
+	case 16:
+		return len(t.allPVCs), len(t.pvcs)
+	case 17:
+		return len(t.allIngresses), len(t.ingresses)
+	case 18:
+		return len(t.allNetworkPolicies), len(t.networkPolicies)
+	case 19:
+		return len(t.allDeploymentConfigs), len(t.deploymentConfigs)
+	}
+	return 0, 0
+}
+
+// openListFilter starts editing the quick filter of the active tab
+func (t *TUI) openListFilter() {
+	if t.listFilters == nil {
+		t.listFilters = make(map[int]string)
+	}
+	t.editingListFilter = true
+}
+
+// setListFilter updates the active tab's quick filter and re-derives its list.
+// The selection is kept on the same item when it still matches.
+func (t *TUI) setListFilter(query string) tea.Cmd {
+	tab := int(t.ActiveTab)
+	previousPod := selectedName(t.pods, t.selectedPod, func(p resources.PodInfo) string { return p.Name })
+
+	if query == "" {
+		delete(t.listFilters, tab)
+	} else {
+		t.listFilters[tab] = query
+	}
+	t.reapplyView(tab)
+	t.updateMainContent()
+
+	if tab == 0 && len(t.pods) > 0 && selectedName(t.pods, t.selectedPod, func(p resources.PodInfo) string { return p.Name }) != previousPod {
+		t.clearPodLogs()
+		return t.startPodLogStream()
+	}
+	return nil
+}
+
+// handleListFilterKeys handles typing in the quick filter bar
+func (t *TUI) handleListFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	query := t.listFilters[int(t.ActiveTab)]
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		// Discard the filter
+		t.editingListFilter = false
+		return t, t.setListFilter("")
+	case tea.KeyEnter:
+		// Keep the filter and return to navigating the narrowed list
+		t.editingListFilter = false
+		return t, nil
+	case tea.KeyBackspace:
+		if query == "" {
+			t.editingListFilter = false
+			return t, nil
+		}
+		runes := []rune(query)
+		return t, t.setListFilter(string(runes[:len(runes)-1]))
+	case tea.KeyUp, tea.KeyDown:
+		// Allow moving through the matches while typing
+		if msg.Type == tea.KeyUp {
+			t.navigator.moveResourceSelection(-1)
+		} else {
+			t.navigator.moveResourceSelection(1)
+		}
+		return t, nil
+	case tea.KeyRunes, tea.KeySpace:
+		return t, t.setListFilter(query + string(msg.Runes))
+	}
+
+	return t, nil
+}
+
+// renderListFilterBar renders the quick filter of the active tab above the list
+func (t *TUI) renderListFilterBar() string {
+	tab := int(t.ActiveTab)
+	query := t.listFilters[tab]
+	if query == "" && !t.editingListFilter {
+		return ""
+	}
+
+	cursor := ""
+	if t.editingListFilter {
+		cursor = "▏"
+	}
+	total, shown := t.listLength(tab)
+	bar := fmt.Sprintf("/%s%s  (%d/%d)", query, cursor, shown, total)
+	if !t.editingListFilter {
+		bar += "  • / to edit, esc to clear"
+	}
+
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("14")).Render(bar) + "\n"
+}
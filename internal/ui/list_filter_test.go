@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		target, query string
+		want          bool
+	}{
+		{"payments-api-7d9f", "pay", true},
+		{"payments-api-7d9f", "pai", true},
+		{"payments-api-7d9f", "PAPI", true},
+		{"payments-api-7d9f", "ipa", false},
+		{"frontend", "", true},
+	}
+	for _, tt := range tests {
+		if _, got := fuzzyMatch(tt.target, tt.query); got != tt.want {
+			t.Errorf("fuzzyMatch(%q, %q) = %v, want %v", tt.target, tt.query, got, tt.want)
+		}
+	}
+
+	positions, _ := fuzzyMatch("api-gateway", "agw")
+	if len(positions) != 3 || positions[0] != 0 || positions[1] != 4 || positions[2] != 8 {
+		t.Errorf("Expected leftmost match positions [0 4 8], got %v", positions)
+	}
+}
+
+func TestHighlightFuzzy(t *testing.T) {
+	got := highlightFuzzy("web", "wb")
+	want := fuzzyHighlightOn + "w" + fuzzyHighlightOff + "e" + fuzzyHighlightOn + "b" + fuzzyHighlightOff
+	if got != want {
+		t.Errorf("highlightFuzzy() = %q, want %q", got, want)
+	}
+	if got := highlightFuzzy("web", "x"); got != "web" {
+		t.Errorf("Expected unmatched text unchanged, got %q", got)
+	}
+}
+
+func TestListFilterNarrowsSelection(t *testing.T) {
+	tui := &TUI{
+		App:       models.NewApp("test"),
+		connected: true,
+		allServices: []resources.ServiceInfo{
+			{ResourceInfo: resources.ResourceInfo{Name: "api"}},
+			{ResourceInfo: resources.ResourceInfo{Name: "frontend"}},
+			{ResourceInfo: resources.ResourceInfo{Name: "payments-api"}},
+		},
+	}
+	tui.navigator = NewNavigator(tui)
+	tui.ActiveTab = models.TabServices
+	tui.reapplyView(1)
+	tui.selectedService = 2
+
+	tui.openListFilter()
+	for _, r := range "pa" {
+		tui.handleListFilterKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if len(tui.services) != 1 || tui.services[0].Name != "payments-api" {
+		t.Fatalf("Expected only payments-api to match, got %v", tui.services)
+	}
+	if tui.selectedService != 0 {
+		t.Errorf("Expected the selection to follow payments-api, got %d", tui.selectedService)
+	}
+	if bar := tui.renderListFilterBar(); !strings.Contains(bar, "(1/3)") {
+		t.Errorf("Expected match count in filter bar, got %q", bar)
+	}
+
+	tui.handleListFilterKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if tui.editingListFilter || tui.listFilters[1] != "pa" {
+		t.Errorf("Expected enter to keep the filter and stop editing")
+	}
+
+	tui.openListFilter()
+	tui.handleListFilterKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if len(tui.services) != 3 || tui.listFilters[1] != "" {
+		t.Errorf("Expected esc to clear the filter, got %d services", len(tui.services))
+	}
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"context"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+)
+
+// loadAllPages loads a resource list page by page, following the continue
+// token of each page, so that namespaces with more resources than one
+// request returns are listed in full. Each page is retried on its own. The
+// list stops at constants.MaxListItems, keeping the continue token of the
+// last page loaded.
+func loadAllPages[T any](t *TUI, resource string, opts resources.ListOptions, list func(context.Context, resources.ListOptions) (*resources.ResourceList[T], error)) (*resources.ResourceList[T], error) {
+	opts.Limit = constants.ListPageSize
+	opts.Continue = ""
+
+	var all *resources.ResourceList[T]
+	for {
+		page, err := loadWithRetry(t, resource, func(ctx context.Context) (*resources.ResourceList[T], error) {
+			return list(ctx, opts)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if all == nil {
+			all = page
+		} else {
+			all.Items = append(all.Items, page.Items...)
+			all.Continue = page.Continue
+			all.Remaining = page.Remaining
+		}
+		if page.Continue == "" || len(all.Items) >= constants.MaxListItems {
+			break
+		}
+		opts.Continue = page.Continue
+	}
+
+	all.Total = len(all.Items)
+	if all.Continue != "" {
+		logging.Warn(t.Logger, "Loaded the first %d %s, about %d more were not loaded", all.Total, resource, all.Remaining)
+	}
+	return all, nil
+}
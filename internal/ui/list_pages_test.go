@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// pagedPods serves total pods in pages of the requested size
+func pagedPods(total int, requests *[]resources.ListOptions) func(context.Context, resources.ListOptions) (*resources.ResourceList[resources.PodInfo], error) {
+	return func(_ context.Context, opts resources.ListOptions) (*resources.ResourceList[resources.PodInfo], error) {
+		*requests = append(*requests, opts)
+		start := 0
+		if opts.Continue != "" {
+			fmt.Sscanf(opts.Continue, "%d", &start)
+		}
+		end := min(start+int(opts.Limit), total)
+
+		list := &resources.ResourceList[resources.PodInfo]{Remaining: int64(total - end)}
+		for i := start; i < end; i++ {
+			list.Items = append(list.Items, resources.PodInfo{ResourceInfo: resources.ResourceInfo{Name: fmt.Sprintf("pod-%d", i)}})
+		}
+		if end < total {
+			list.Continue = fmt.Sprint(end)
+		}
+		return list, nil
+	}
+}
+
+func TestLoadAllPages(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+
+	var requests []resources.ListOptions
+	total := constants.ListPageSize*2 + 7
+	list, err := loadAllPages(tui, "pods", resources.ListOptions{Namespace: "shop"}, pagedPods(total, &requests))
+	if err != nil {
+		t.Fatalf("loadAllPages() returned error: %v", err)
+	}
+	if len(requests) != 3 || list.Total != total || list.Items[total-1].Name != fmt.Sprintf("pod-%d", total-1) {
+		t.Fatalf("Expected %d pods in 3 pages, got %d in %d", total, list.Total, len(requests))
+	}
+	if requests[1].Continue == "" || requests[1].Namespace != "shop" || list.Continue != "" {
+		t.Errorf("Expected the continue token of each page to be followed, got %+v", requests)
+	}
+
+	requests = nil
+	list, _ = loadAllPages(tui, "pods", resources.ListOptions{}, pagedPods(constants.MaxListItems*2, &requests))
+	if list.Total != constants.MaxListItems || list.Continue == "" {
+		t.Errorf("Expected the list to stop at %d pods, got %d", constants.MaxListItems, list.Total)
+	}
+
+	failing := func(context.Context, resources.ListOptions) (*resources.ResourceList[resources.PodInfo], error) {
+		return nil, errors.New("forbidden")
+	}
+	if _, err := loadAllPages(tui, "pods", resources.ListOptions{}, failing); err == nil {
+		t.Errorf("Expected a failed page to fail the load")
+	}
+}
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"slices"
+
+	"github.com/katyella/lazyoc/internal/config"
+)
+
+// listSort is the column a tab's list is sorted by from its table header
+type listSort struct {
+	field string
+	desc  bool
+}
+
+// sortColumns lists the columns o cycles through on each sortable tab
+var sortColumns = map[int][]string{
+	0: {"name", "age", "restarts", "status", "ready"},
+	1: {"name", "age", "type"},
+	2: {"name", "age", "ready", "replicas", "status"},
+}
+
+// sortDescending are the columns that sort largest first when selected, so
+// that the most restarted pods come first
+var sortDescending = map[string]bool{"restarts": true}
+
+// sortedView returns the active view of a tab with the column sort applied,
+// or nil when neither is set. The column sort replaces the view's sort but
+// keeps its filter and grouping.
+func (t *TUI) sortedView(tab int) *config.SavedView {
+	view := t.activeView(tab)
+	order, ok := t.listSorts[tab]
+	if !ok {
+		return view
+	}
+
+	if view == nil {
+		view = &config.SavedView{}
+	}
+	view.SortBy = order.field
+	view.SortDesc = order.desc
+	return view
+}
+
+// cycleListSort sorts the active tab by its next column, clearing the sort
+// after the last one
+func (t *TUI) cycleListSort() {
+	tab := int(t.ActiveTab)
+	columns, ok := sortColumns[tab]
+	if !ok {
+		t.logInfo(categoryAction, "Sorting by column is available on the Pods, Services and Deployments tabs")
+		return
+	}
+	if t.listSorts == nil {
+		t.listSorts = make(map[int]listSort)
+	}
+
+	next := 0
+	if current, ok := t.listSorts[tab]; ok {
+		next = slices.Index(columns, current.field) + 1
+	}
+	if next == len(columns) {
+		delete(t.listSorts, tab)
+		t.logInfo(categoryAction, "Cleared the sort on %s", t.GetTabName(t.ActiveTab))
+	} else {
+		field := columns[next]
+		t.listSorts[tab] = listSort{field: field, desc: sortDescending[field]}
+		t.logSortChange()
+	}
+	t.reapplyView(tab)
+	t.updateMainContent()
+}
+
+// reverseListSort flips the direction of the active tab's column sort
+func (t *TUI) reverseListSort() {
+	tab := int(t.ActiveTab)
+	order, ok := t.listSorts[tab]
+	if !ok {
+		return
+	}
+
+	order.desc = !order.desc
+	t.listSorts[tab] = order
+	t.logSortChange()
+	t.reapplyView(tab)
+	t.updateMainContent()
+}
+
+// logSortChange logs the active tab's column sort
+func (t *TUI) logSortChange() {
+	order := t.listSorts[int(t.ActiveTab)]
+	direction := "ascending"
+	if order.desc {
+		direction = "descending"
+	}
+	t.logInfo(categoryAction, "Sorted %s by %s, %s", t.GetTabName(t.ActiveTab), order.field, direction)
+}
+
+// sortTitle returns a column title marked with the sort direction when the
+// tab's list is sorted by field
+func (t *TUI) sortTitle(tab int, field, title string) string {
+	view := t.sortedView(tab)
+	if view == nil || view.SortBy != field {
+		return title
+	}
+	if view.SortDesc {
+		return title + " ▼"
+	}
+	return title + " ▲"
+}
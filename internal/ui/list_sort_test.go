@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestColumnSort(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "demo", width: 160, activeViews: make(map[int]config.SavedView)}
+	tui.allPods = []resources.PodInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web"}, Phase: "Running", Restarts: 0},
+		{ResourceInfo: resources.ResourceInfo{Name: "api"}, Phase: "Running", Restarts: 42},
+		{ResourceInfo: resources.ResourceInfo{Name: "db"}, Phase: "Running", Restarts: 3},
+	}
+	tui.reapplyView(0)
+	tui.selectedPod = 1 // api
+
+	names := func() string {
+		var names []string
+		for _, pod := range tui.pods {
+			names = append(names, pod.Name)
+		}
+		return strings.Join(names, ",")
+	}
+
+	tui.cycleListSort() // name
+	if names() != "api,db,web" || !strings.Contains(tui.mainContent, "NAME ▲") {
+		t.Errorf("Expected pods sorted by name, got %s", names())
+	}
+
+	tui.cycleListSort() // age
+	tui.cycleListSort() // restarts
+	if names() != "api,db,web" || !strings.Contains(tui.mainContent, "RESTARTS ▼") {
+		t.Errorf("Expected the most restarted pods first, got %s", names())
+	}
+	if tui.pods[tui.selectedPod].Name != "api" {
+		t.Errorf("Expected the selection to stay on the same pod")
+	}
+
+	tui.reverseListSort()
+	if names() != "web,db,api" || !strings.Contains(tui.mainContent, "RESTARTS ▲") {
+		t.Errorf("Expected the least restarted pods first, got %s", names())
+	}
+
+	// A saved view keeps its filter while the column sort replaces its sort
+	tui.activeViews[0] = config.SavedView{Name: "restarting", Filter: "restarts>0", SortBy: "name"}
+	tui.reapplyView(0)
+	if names() != "db,api" {
+		t.Errorf("Expected the view's filter with the column sort, got %s", names())
+	}
+
+	tui.cycleListSort() // status
+	tui.cycleListSort() // ready
+	tui.cycleListSort() // cleared
+	if _, ok := tui.listSorts[0]; ok {
+		t.Errorf("Expected the sort to be cleared after the last column")
+	}
+}
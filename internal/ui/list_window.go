@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// listChromeLines are the lines of a list around its rows: the title and a
+// blank line, the column header and its separator, and the instructions
+// after a blank line
+const listChromeLines = 6
+
+// listRowCapacity returns how many rows of the active list fit in the main
+// panel, or 0 when the terminal size is not known yet
+func (t *TUI) listRowCapacity() int {
+	if t.height <= 0 {
+		return 0
+	}
+
+	availableHeight := t.height - t.headerHeight() - 2 // tabs + status bar
+	mainHeight := availableHeight - t.logPanelHeight(availableHeight)
+	banner := t.renderViewBanner() + t.renderListFilterBar()
+	capacity := mainHeight - borderOverhead - paddingOverhead - strings.Count(banner, "\n") - listChromeLines
+	return max(capacity, 1)
+}
+
+// listWindow returns the rows [start, end) of a list of total rows to
+// render, the window of the main panel kept centered on the selected row.
+// Only these rows are built, so rendering a list of thousands of resources
+// costs the same as rendering a screenful.
+func (t *TUI) listWindow(selected, total int) (int, int) {
+	capacity := t.listRowCapacity()
+	if capacity == 0 || total <= capacity {
+		return 0, total
+	}
+
+	capacity = max(capacity-1, 1) // the position line
+	start := max(0, min(selected-capacity/2, total-capacity))
+	return start, start + capacity
+}
+
+// listWindowFooter returns the position line shown under a windowed list,
+// or "" when every row is rendered
+func listWindowFooter(start, end, total int) string {
+	if start == 0 && end == total {
+		return ""
+	}
+	return fmt.Sprintf("  ↕ %d-%d of %d\n", start+1, end, total)
+}
+
+// clipLines keeps the first n lines of s
+func clipLines(s string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	index := 0
+	for i := 0; i < n; i++ {
+		next := strings.IndexByte(s[index:], '\n')
+		if next < 0 {
+			return s
+		}
+		index += next + 1
+	}
+	return s[:index-1]
+}
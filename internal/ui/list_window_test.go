@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/components"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestListWindowRendersVisibleRowsOnly(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, ready: true, namespace: "demo", width: 120, height: 40, errorDisplay: components.NewErrorDisplayComponent("dark")}
+	for i := 0; i < 5000; i++ {
+		tui.pods = append(tui.pods, resources.PodInfo{ResourceInfo: resources.ResourceInfo{Name: fmt.Sprintf("pod-%04d", i)}, Phase: "Running", Ready: "1/1"})
+	}
+	tui.selectedPod = 2500
+
+	tui.updatePodDisplay()
+	if lines := strings.Count(tui.mainContent, "\n"); lines > tui.height {
+		t.Fatalf("Expected only a screenful of rows, got %d lines", lines)
+	}
+	if !strings.Contains(tui.mainContent, "▶ pod-2500") || strings.Contains(tui.mainContent, "pod-0000") {
+		t.Errorf("Expected the window around the selected pod")
+	}
+	if !strings.Contains(tui.mainContent, "of 5000") {
+		t.Errorf("Expected the position in the list")
+	}
+
+	if lines := strings.Count(tui.View(), "\n") + 1; lines > tui.height {
+		t.Errorf("Expected the view to fit the terminal, got %d lines", lines)
+	}
+}
+
+func TestListWindow(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), width: 120}
+	if start, end := tui.listWindow(3, 100); start != 0 || end != 100 {
+		t.Errorf("Expected every row before the terminal size is known, got %d-%d", start, end)
+	}
+
+	tui.height = 40
+	capacity := tui.listRowCapacity()
+	if start, end := tui.listWindow(0, capacity); start != 0 || end != capacity {
+		t.Errorf("Expected a list that fits to be rendered whole, got %d-%d", start, end)
+	}
+	if start, end := tui.listWindow(99, 100); end != 100 || end-start != capacity-1 {
+		t.Errorf("Expected the window to end at the last row, got %d-%d", start, end)
+	}
+	if footer := listWindowFooter(10, 40, 100); footer != "  ↕ 11-40 of 100\n" {
+		t.Errorf("Unexpected footer %q", footer)
+	}
+
+	if clipped := clipLines("a\nb\nc", 2); clipped != "a\nb" {
+		t.Errorf("Expected two lines, got %q", clipped)
+	}
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"context"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+)
+
+// initLoaderRetry configures loader backoff and circuit breaking from the user configuration
+func (t *TUI) initLoaderRetry() {
+	t.retryPolicy = resources.DefaultBackoffPolicy()
+	threshold := constants.CircuitBreakerThreshold
+	if t.config != nil {
+		t.retryPolicy.MaxAttempts = t.config.RetryAttempts()
+		threshold = t.config.CircuitBreakerThreshold()
+	}
+	t.loadBreaker = resources.NewCircuitBreaker(threshold, constants.CircuitBreakerCooldown)
+}
+
+// loadWithRetry runs a loader API call with jittered exponential backoff. Each
+// attempt gets its own timeout, and the resource's circuit is opened once the
+// API fails consistently so refresh ticks stop hammering it. Resources the
+// user may not list are not asked for again until their denial is reset.
+func loadWithRetry[T any](t *TUI, resource string, load func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	if err := t.accessDenials.lookup(resource); err != nil {
+		return zero, err
+	}
+	if t.loadBreaker != nil {
+		if err := t.loadBreaker.Allow(resource); err != nil {
+			return zero, err
+		}
+	}
+
+	result, err := resources.RetryWithBackoff(context.Background(), t.retryPolicy, func(ctx context.Context) (T, error) {
+		attemptCtx, cancel := context.WithTimeout(ctx, constants.DefaultOperationTimeout)
+		defer cancel()
+		return load(attemptCtx)
+	})
+
+	if t.recordAccessDenial(resource, err) {
+		logging.Warn(t.Logger, "No access to %s, not loading them again until refreshed with r: %v", resource, err)
+	}
+	if t.loadBreaker != nil {
+		t.loadBreaker.Record(resource, err)
+		if err != nil && t.loadBreaker.IsOpen(resource) {
+			logging.Warn(t.Logger, "Pausing %s loads after repeated failures: %v", resource, err)
+		}
+	}
+
+	return result, err
+}
+
+// resetLoaderCircuits closes all loader circuits and forgets the denied
+// resources, e.g. after reconnecting or switching projects
+func (t *TUI) resetLoaderCircuits() {
+	t.accessDenials.reset()
+	if t.loadBreaker != nil {
+		t.loadBreaker.Reset()
+	}
+}
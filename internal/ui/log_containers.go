@@ -0,0 +1,282 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// logTarget is a container whose logs can be streamed for a pod. Previous
+// targets read the logs of the container's last terminated instance.
+type logTarget struct {
+	Container string
+	Init      bool
+	Previous  bool
+	Finished  bool // The container has terminated, so its stream will end
+}
+
+// label returns a short description of the target for headers and pickers
+func (lt logTarget) label() string {
+	label := lt.Container
+	if lt.Init {
+		label += " (init)"
+	}
+	if lt.Previous {
+		label += " (previous)"
+	}
+	return label
+}
+
+// podLogTargets lists the log targets of a pod: regular containers first,
+// then init containers, then the previous instance of every container that
+// has restarted
+func podLogTargets(pod resources.PodInfo) []logTarget {
+	var targets, previous []logTarget
+
+	add := func(c resources.ContainerInfo, init bool) {
+		targets = append(targets, logTarget{
+			Container: c.Name,
+			Init:      init,
+			Finished:  c.State == "Terminated",
+		})
+		if c.RestartCount > 0 {
+			previous = append(previous, logTarget{
+				Container: c.Name,
+				Init:      init,
+				Previous:  true,
+				Finished:  true,
+			})
+		}
+	}
+
+	for _, c := range pod.ContainerInfo {
+		add(c, false)
+	}
+	for _, c := range pod.InitContainers {
+		add(c, true)
+	}
+	return append(targets, previous...)
+}
+
+// currentLogTarget returns the log target chosen for pod, falling back to the
+// pod's first container when nothing was chosen or the choice no longer exists
+func (t *TUI) currentLogTarget(pod resources.PodInfo) logTarget {
+	targets := podLogTargets(pod)
+	if len(targets) == 0 {
+		return logTarget{}
+	}
+
+	if t.logTargetPod == pod.Name {
+		for _, target := range targets {
+			// Return the fresh target so Finished reflects the latest pod status
+			if target.Container == t.logTarget.Container && target.Previous == t.logTarget.Previous {
+				return target
+			}
+		}
+	}
+	return targets[0]
+}
+
+// selectedLogPod returns the pod whose logs are shown, if any
+func (t *TUI) selectedLogPod() (resources.PodInfo, bool) {
+	if len(t.pods) == 0 || t.selectedPod >= len(t.pods) {
+		return resources.PodInfo{}, false
+	}
+	return t.pods[t.selectedPod], true
+}
+
+// setLogTarget switches the streamed logs of the selected pod to target
+func (t *TUI) setLogTarget(target logTarget) tea.Cmd {
+	pod, ok := t.selectedLogPod()
+	if !ok {
+		return nil
+	}
+
+	t.logTargetPod = pod.Name
+	t.logTarget = target
+	t.logInfo(categoryResource, "Streaming logs of %s/%s", pod.Name, target.label())
+
+	t.logViewMode = constants.PodLogViewMode
+	t.clearPodLogs()
+	return t.startPodLogStream()
+}
+
+// cycleLogContainer switches to the next log target of the selected pod
+func (t *TUI) cycleLogContainer() tea.Cmd {
+	pod, ok := t.selectedLogPod()
+	if !ok || t.ActiveTab != models.TabPods {
+		return nil
+	}
+
+	targets := podLogTargets(pod)
+	if len(targets) < 2 {
+		return nil
+	}
+
+	current := t.currentLogTarget(pod)
+	next := 0
+	for i, target := range targets {
+		if target.Container == current.Container && target.Previous == current.Previous {
+			next = (i + 1) % len(targets)
+			break
+		}
+	}
+	return t.setLogTarget(targets[next])
+}
+
+// openContainerPicker shows the log target picker for the selected pod
+func (t *TUI) openContainerPicker() {
+	pod, ok := t.selectedLogPod()
+	if !ok || t.ActiveTab != models.TabPods || len(podLogTargets(pod)) == 0 {
+		return
+	}
+
+	current := t.currentLogTarget(pod)
+	t.containerPickerIndex = 0
+	for i, target := range podLogTargets(pod) {
+		if target.Container == current.Container && target.Previous == current.Previous {
+			t.containerPickerIndex = i
+			break
+		}
+	}
+	t.showContainerPicker = true
+}
+
+// handleContainerPickerKeys handles key input for the log target picker
+func (t *TUI) handleContainerPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	pod, ok := t.selectedLogPod()
+	targets := podLogTargets(pod)
+	if !ok || len(targets) == 0 {
+		t.showContainerPicker = false
+		return t, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q", "C":
+		t.showContainerPicker = false
+
+	case "j", "down":
+		t.containerPickerIndex = (t.containerPickerIndex + 1) % len(targets)
+
+	case "k", "up":
+		t.containerPickerIndex--
+		if t.containerPickerIndex < 0 {
+			t.containerPickerIndex = len(targets) - 1
+		}
+
+	case "enter":
+		t.showContainerPicker = false
+		if t.containerPickerIndex < len(targets) {
+			return t, t.setLogTarget(targets[t.containerPickerIndex])
+		}
+	}
+
+	return t, nil
+}
+
+// renderContainerPicker renders the log target picker modal
+func (t *TUI) renderContainerPicker() string {
+	primaryColor, _ := t.getThemeColors()
+	pod, _ := t.selectedLogPod()
+	current := t.currentLogTarget(pod)
+
+	modalWidth := min(70, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("📦 Containers: %s", pod.Name)) + "\n\n")
+
+	containers := make(map[string]resources.ContainerInfo)
+	for _, c := range pod.ContainerInfo {
+		containers[c.Name] = c
+	}
+	for _, c := range pod.InitContainers {
+		containers[c.Name] = c
+	}
+
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	for i, target := range podLogTargets(pod) {
+		marker := "  "
+		if target.Container == current.Container && target.Previous == current.Previous {
+			marker = "● "
+		}
+
+		line := fmt.Sprintf("%s%-34s", marker, truncateString(target.label(), 34))
+		if i == t.containerPickerIndex {
+			line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(line)
+		}
+
+		c := containers[target.Container]
+		status := c.State
+		if c.Reason != "" {
+			status += " (" + c.Reason + ")"
+		}
+		if target.Previous {
+			status = fmt.Sprintf("%d restarts", c.RestartCount)
+		}
+		content.WriteString(line + " " + dimStyle.Render(status) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: navigate • enter: stream logs • esc: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// logTargetSuffix returns the container part of the pod log header, shown
+// only when the pod has more than one log target
+func (t *TUI) logTargetSuffix(pod resources.PodInfo) string {
+	if len(podLogTargets(pod)) < 2 {
+		return ""
+	}
+	return " [" + t.currentLogTarget(pod).label() + "]"
+}
+
+// handleFinishedLogStream reports the end of a stream for a terminated
+// container instead of reconnecting to it. It returns false when the stream
+// was expected to keep following.
+func (t *TUI) handleFinishedLogStream(podName, container string, err error) bool {
+	if !errors.Is(err, errLogStreamEnded) {
+		return false
+	}
+
+	pod, ok := t.selectedLogPod()
+	if !ok || pod.Name != podName {
+		return false
+	}
+
+	// Logs read once in safe mode end right away
+	if t.safeMode {
+		t.loadingLogs = false
+		t.setLogStreamStatus(fmt.Sprintf("--- safe mode: logs of %s are not followed, press r to read new lines ---", podName))
+		return true
+	}
+
+	target := t.currentLogTarget(pod)
+	if !target.Finished || target.Container != container {
+		return false
+	}
+
+	t.loadingLogs = false
+	t.setLogStreamStatus(fmt.Sprintf("--- end of logs for %s/%s ---", podName, target.label()))
+	return true
+}
+
+// restartSuffix returns a ", N restarts" note for containers that restarted
+func restartSuffix(c resources.ContainerInfo) string {
+	if c.RestartCount == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", %d restarts", c.RestartCount)
+}
@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func multiContainerPod() resources.PodInfo {
+	return resources.PodInfo{
+		ResourceInfo: resources.ResourceInfo{Name: "web-1"},
+		ContainerInfo: []resources.ContainerInfo{
+			{Name: "app", State: "Running", RestartCount: 2},
+			{Name: "proxy", State: "Running"},
+		},
+		InitContainers: []resources.ContainerInfo{
+			{Name: "migrate", State: "Terminated", Reason: "Completed"},
+		},
+	}
+}
+
+func TestPodLogTargets(t *testing.T) {
+	var labels []string
+	for _, target := range podLogTargets(multiContainerPod()) {
+		labels = append(labels, target.label())
+	}
+
+	want := "app,proxy,migrate (init),app (previous)"
+	if got := strings.Join(labels, ","); got != want {
+		t.Errorf("Expected targets %q, got %q", want, got)
+	}
+}
+
+func TestCycleLogContainer(t *testing.T) {
+	tui := &TUI{
+		App:       models.NewApp("test"),
+		pods:      []resources.PodInfo{multiContainerPod()},
+		connected: true,
+	}
+
+	if got := tui.currentLogTarget(tui.pods[0]); got.Container != "app" || got.Previous {
+		t.Fatalf("Expected the first container by default, got %+v", got)
+	}
+
+	for _, want := range []string{"proxy", "migrate (init)", "app (previous)", "app"} {
+		if cmd := tui.cycleLogContainer(); cmd == nil {
+			t.Fatalf("Expected cycling to restart the log stream")
+		}
+		if got := tui.currentLogTarget(tui.pods[0]).label(); got != want {
+			t.Errorf("Expected %q after cycling, got %q", want, got)
+		}
+	}
+
+	// The choice only applies to the pod it was made for
+	other := resources.PodInfo{
+		ResourceInfo:  resources.ResourceInfo{Name: "web-2"},
+		ContainerInfo: []resources.ContainerInfo{{Name: "app"}, {Name: "proxy"}},
+	}
+	tui.logTarget = logTarget{Container: "proxy"}
+	if got := tui.currentLogTarget(other); got.Container != "app" {
+		t.Errorf("Expected another pod to default to its first container, got %q", got.Container)
+	}
+}
+
+func TestHandleFinishedLogStream(t *testing.T) {
+	tui := &TUI{
+		App:  models.NewApp("test"),
+		pods: []resources.PodInfo{multiContainerPod()},
+	}
+
+	if tui.handleFinishedLogStream("web-1", "app", errLogStreamEnded) {
+		t.Errorf("Expected a running container's stream to reconnect")
+	}
+
+	tui.logTargetPod = "web-1"
+	tui.logTarget = logTarget{Container: "app", Previous: true}
+	if !tui.handleFinishedLogStream("web-1", "app", errLogStreamEnded) {
+		t.Fatalf("Expected the previous container's stream to end without reconnecting")
+	}
+	if len(tui.podLogs) != 1 || !strings.Contains(tui.podLogs[0], "end of logs for web-1/app (previous)") {
+		t.Errorf("Expected an end marker, got %v", tui.podLogs)
+	}
+}
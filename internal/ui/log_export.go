@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// logExportSource returns the loaded lines of the log panel and a name for
+// the file they are saved to. App logs are not exported.
+func (t *TUI) logExportSource() ([]string, string, bool) {
+	switch t.logViewMode {
+	case constants.PodLogViewMode:
+		pod, ok := t.selectedLogPod()
+		if !ok || len(t.podLogs) == 0 {
+			return nil, "", false
+		}
+		name := pod.Name
+		if target := t.currentLogTarget(pod); target.Container != "" {
+			name += "-" + target.Container
+			if target.Previous {
+				name += "-previous"
+			}
+		}
+
+		// Start with the lines spilled to disk, and leave out the stream
+		// status line, it is not part of the pod's output
+		lines := t.spilledPodLogs()
+		for _, line := range t.podLogs {
+			if t.logStreamStatus == "" || line != t.logStreamStatus {
+				lines = append(lines, line)
+			}
+		}
+		return lines, name, true
+
+	case constants.ServiceLogViewMode:
+		if len(t.serviceLogs) == 0 || t.selectedService >= len(t.services) {
+			return nil, "", false
+		}
+		return t.serviceLogs, "svc-" + t.services[t.selectedService].Name, true
+
+	case constants.BuildLogViewMode:
+		if len(t.buildLogs) == 0 || t.buildLogName == "" {
+			return nil, "", false
+		}
+		return t.buildLogs, "build-" + t.buildLogName, true
+	}
+	return nil, "", false
+}
+
+// defaultLogExportName returns a timestamped file name such as
+// web-1-app-20250102-150405.log
+func defaultLogExportName(name string, now time.Time) string {
+	name = strings.NewReplacer("/", "_", string(os.PathSeparator), "_", " ", "_").Replace(name)
+	return fmt.Sprintf("%s-%s.log", name, now.Format("20060102-150405"))
+}
+
+// openLogExport snapshots the log panel and asks where to save it
+func (t *TUI) openLogExport() {
+	lines, name, ok := t.logExportSource()
+	if !ok {
+		t.logWarn(categoryAction, "No pod, service or build logs loaded to save")
+		return
+	}
+
+	input := textinput.New()
+	input.Placeholder = "file name"
+	input.CharLimit = 512
+	input.Width = 60
+	input.SetValue(defaultLogExportName(name, time.Now()))
+	input.Focus()
+
+	t.logExportInput = input
+	t.logExportLines = append([]string(nil), lines...)
+	t.logExportError = ""
+	t.showLogExport = true
+}
+
+// expandLogExportPath resolves ~ and makes the path absolute
+func expandLogExportPath(path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", fmt.Errorf("file name is required")
+	}
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to determine home directory: %w", err)
+		}
+		path = filepath.Join(home, rest)
+	}
+	return filepath.Abs(path)
+}
+
+// writeLogExport writes lines to a new file; existing files are not overwritten
+func writeLogExport(path string, lines []string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, constants.LogExportFilePermissions)
+	if err != nil {
+		if os.IsExist(err) {
+			return fmt.Errorf("%s already exists", path)
+		}
+		return err
+	}
+
+	if _, err := file.WriteString(strings.Join(lines, "\n") + "\n"); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// handleLogExportKeys handles key input for the save logs prompt
+func (t *TUI) handleLogExportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		t.showLogExport = false
+		t.logExportLines = nil
+		return t, nil
+
+	case "enter":
+		path, err := expandLogExportPath(t.logExportInput.Value())
+		if err == nil {
+			err = writeLogExport(path, t.logExportLines)
+		}
+		if err != nil {
+			// Keep the prompt open so another name can be chosen
+			t.logExportError = err.Error()
+			return t, nil
+		}
+
+		t.logSuccess(categoryAction, "Saved %d log lines to %s", len(t.logExportLines), path)
+		t.showLogExport = false
+		t.logExportLines = nil
+		return t, nil
+	}
+
+	t.logExportError = ""
+	var cmd tea.Cmd
+	t.logExportInput, cmd = t.logExportInput.Update(msg)
+	return t, cmd
+}
+
+// renderLogExport renders the save logs prompt
+func (t *TUI) renderLogExport() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(80, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("💾 Save Logs") + "\n\n")
+	content.WriteString(fmt.Sprintf("Save %d loaded lines to:\n", len(t.logExportLines)))
+	content.WriteString(t.logExportInput.View() + "\n")
+
+	if t.logExportError != "" {
+		content.WriteString("\n" + lipgloss.NewStyle().Foreground(errorColor).Render(t.logExportError) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("Relative paths are saved in the current directory\n")
+	content.WriteString("enter: save • esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestDefaultLogExportName(t *testing.T) {
+	now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	if got := defaultLogExportName("web-1/app", now); got != "web-1_app-20250102-150405.log" {
+		t.Errorf("Unexpected file name %q", got)
+	}
+}
+
+func TestSavePodLogs(t *testing.T) {
+	tui := &TUI{
+		App:         models.NewApp("test"),
+		logViewMode: constants.PodLogViewMode,
+		pods:        []resources.PodInfo{multiContainerPod()},
+		podLogs:     []string{"starting", "ready"},
+	}
+	tui.setLogStreamStatus("⚠️ Log stream for web-1 interrupted")
+
+	tui.openLogExport()
+	if !tui.showLogExport {
+		t.Fatalf("Expected the save prompt to open")
+	}
+
+	path := filepath.Join(t.TempDir(), "web.log")
+	tui.logExportInput.SetValue(path)
+	tui.handleLogExportKeys(tea.KeyMsg{Type: tea.KeyEnter})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected the logs to be saved: %v", err)
+	}
+	if string(data) != "starting\nready\n" {
+		t.Errorf("Expected only pod output to be saved, got %q", data)
+	}
+	if tui.showLogExport {
+		t.Errorf("Expected the prompt to close after saving")
+	}
+
+	// Existing files are not overwritten
+	tui.openLogExport()
+	tui.logExportInput.SetValue(path)
+	tui.handleLogExportKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.showLogExport || tui.logExportError == "" {
+		t.Errorf("Expected an error for an existing file")
+	}
+}
+
+func TestOpenLogExportWithoutLogs(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), logViewMode: constants.DefaultLogViewMode}
+	tui.openLogExport()
+	if tui.showLogExport {
+		t.Errorf("Expected app logs not to be exported")
+	}
+}
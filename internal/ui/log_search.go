@@ -0,0 +1,281 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// Log search highlighting uses reverse video, which can be nested inside the
+// line's level color without resetting it
+const (
+	logSearchHighlightOn  = "\x1b[7m"
+	logSearchHighlightOff = "\x1b[27m"
+)
+
+// logSearchContextLines is how many lines are kept above a match jumped to
+const logSearchContextLines = 2
+
+// logSearchFold prepares text for matching. The search is smart-case: it
+// ignores case unless the query contains an upper-case letter.
+func logSearchFold(query string) func(rune) rune {
+	for _, r := range query {
+		if unicode.IsUpper(r) {
+			return func(r rune) rune { return r }
+		}
+	}
+	return unicode.ToLower
+}
+
+// logSearchPositions returns the rune offsets of non-overlapping matches of
+// query in text
+func logSearchPositions(text []rune, query string) []int {
+	fold := logSearchFold(query)
+	want := []rune(query)
+	if len(want) == 0 {
+		return nil
+	}
+
+	var positions []int
+	for i := 0; i+len(want) <= len(text); {
+		matched := true
+		for j, r := range want {
+			if fold(text[i+j]) != fold(r) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			positions = append(positions, i)
+			i += len(want)
+			continue
+		}
+		i++
+	}
+	return positions
+}
+
+// logLineMatches reports whether a log line contains query
+func logLineMatches(line, query string) bool {
+	return len(logSearchPositions([]rune(line), query)) > 0
+}
+
+// highlightLogSearch marks matches of query in an already colored line.
+// Escape sequences are skipped while matching so that the level colors added
+// by colorizePodLog are kept intact.
+func highlightLogSearch(colored, query string) string {
+	if query == "" {
+		return colored
+	}
+
+	// Collect the visible runes and where each starts in the colored string
+	runes := []rune(colored)
+	var visible []rune
+	var index []int
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == '\x1b' && i+1 < len(runes) && runes[i+1] == '[' {
+			for i+1 < len(runes) && !unicode.IsLetter(runes[i+1]) {
+				i++
+			}
+			i++ // final letter of the sequence
+			continue
+		}
+		visible = append(visible, runes[i])
+		index = append(index, i)
+	}
+
+	positions := logSearchPositions(visible, query)
+	if len(positions) == 0 {
+		return colored
+	}
+
+	width := len([]rune(query))
+	starts := make(map[int]bool, len(positions))
+	ends := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		starts[index[p]] = true
+		ends[index[p+width-1]] = true
+	}
+
+	var b strings.Builder
+	for i, r := range runes {
+		if starts[i] {
+			b.WriteString(logSearchHighlightOn)
+		}
+		b.WriteRune(r)
+		if ends[i] {
+			b.WriteString(logSearchHighlightOff)
+		}
+	}
+	return b.String()
+}
+
+// openLogSearch starts typing a search in the pod log panel
+func (t *TUI) openLogSearch() {
+	t.editingLogSearch = true
+}
+
+// setLogSearch updates the log search query and jumps to the first match at
+// or below the top of the view
+func (t *TUI) setLogSearch(query string) {
+	t.logSearchQuery = query
+	t.logSearchCurrent = -1
+	t.refreshLogSearch(0)
+	t.countSpilledLogMatches()
+
+	for i, line := range t.logSearchMatches {
+		if line >= t.logScrollOffset {
+			t.logSearchCurrent = i
+			break
+		}
+	}
+	if t.logSearchCurrent < 0 && len(t.logSearchMatches) > 0 {
+		t.logSearchCurrent = 0
+	}
+	t.scrollToLogMatch()
+}
+
+// clearLogSearch removes the log search and its highlighting
+func (t *TUI) clearLogSearch() {
+	t.editingLogSearch = false
+	t.logSearchQuery = ""
+	t.logSearchMatches = nil
+	t.logSearchCurrent = -1
+	t.logSpillMatches = 0
+}
+
+// refreshLogSearch recomputes the matching lines after the log buffer
+// changed. trimmed is the number of lines dropped from the start of the
+// buffer, so the current match stays on the same line.
+func (t *TUI) refreshLogSearch(trimmed int) {
+	if t.logSearchQuery == "" {
+		t.logSearchMatches = nil
+		t.logSearchCurrent = -1
+		return
+	}
+
+	currentLine := -1
+	if t.logSearchCurrent >= 0 && t.logSearchCurrent < len(t.logSearchMatches) {
+		currentLine = max(t.logSearchMatches[t.logSearchCurrent]-trimmed, 0)
+	}
+
+	t.logSearchMatches = t.logSearchMatches[:0]
+	for i, line := range t.podLogs {
+		if logLineMatches(line, t.logSearchQuery) {
+			t.logSearchMatches = append(t.logSearchMatches, i)
+		}
+	}
+
+	t.logSearchCurrent = -1
+	if currentLine < 0 {
+		return
+	}
+	for i, line := range t.logSearchMatches {
+		if line >= currentLine {
+			t.logSearchCurrent = i
+			return
+		}
+	}
+	if len(t.logSearchMatches) > 0 {
+		t.logSearchCurrent = len(t.logSearchMatches) - 1
+	}
+}
+
+// nextLogMatch moves to the next (or previous) match, wrapping around
+func (t *TUI) nextLogMatch(step int) {
+	if len(t.logSearchMatches) == 0 {
+		return
+	}
+	n := len(t.logSearchMatches)
+	t.logSearchCurrent = ((t.logSearchCurrent+step)%n + n) % n
+	t.scrollToLogMatch()
+}
+
+// scrollToLogMatch scrolls the current match into view and leaves tail mode
+// so that new lines do not move it away
+func (t *TUI) scrollToLogMatch() {
+	if t.logSearchCurrent < 0 || t.logSearchCurrent >= len(t.logSearchMatches) {
+		return
+	}
+
+	line := t.logSearchMatches[t.logSearchCurrent]
+	t.tailMode = false
+	t.userScrolled = true
+	t.logScrollOffset = min(max(line-logSearchContextLines, 0), t.getMaxLogScrollOffset())
+	t.updateScrollAnchor()
+}
+
+// currentLogMatchLine returns the buffer index of the current match, or -1
+func (t *TUI) currentLogMatchLine() int {
+	if t.logSearchCurrent < 0 || t.logSearchCurrent >= len(t.logSearchMatches) {
+		return -1
+	}
+	return t.logSearchMatches[t.logSearchCurrent]
+}
+
+// logSearchActive reports whether n/N navigate pod log matches
+func (t *TUI) logSearchActive() bool {
+	return t.logSearchQuery != "" && t.logViewMode == constants.PodLogViewMode
+}
+
+// handleLogSearchKeys handles typing in the log search bar
+func (t *TUI) handleLogSearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		t.clearLogSearch()
+	case tea.KeyEnter:
+		// Keep the search and navigate matches with n/N
+		t.editingLogSearch = false
+	case tea.KeyBackspace:
+		if t.logSearchQuery == "" {
+			t.editingLogSearch = false
+			return t, nil
+		}
+		runes := []rune(t.logSearchQuery)
+		t.setLogSearch(string(runes[:len(runes)-1]))
+	case tea.KeyRunes, tea.KeySpace:
+		t.setLogSearch(t.logSearchQuery + string(msg.Runes))
+	}
+	return t, nil
+}
+
+// renderPodLogLine colors a pod log line and highlights search matches. The
+// current match is marked in the gutter.
+func (t *TUI) renderPodLogLine(line string, index int) string {
+	colored := t.colorizePodLog(line)
+	if t.logSearchQuery == "" {
+		return colored
+	}
+
+	colored = highlightLogSearch(colored, t.logSearchQuery)
+	if index == t.currentLogMatchLine() {
+		colored = lipgloss.NewStyle().Foreground(lipgloss.Color("11")).Bold(true).Render("▶ ") + colored
+	}
+	return colored
+}
+
+// logSearchHeader returns the search part of the pod log header, e.g.
+// " [/timeout 3/17]"
+func (t *TUI) logSearchHeader() string {
+	if t.logSearchQuery == "" && !t.editingLogSearch {
+		return ""
+	}
+
+	cursor := ""
+	if t.editingLogSearch {
+		cursor = "▏"
+	}
+	switch {
+	case t.logSearchQuery == "":
+		return fmt.Sprintf(" [/%s]", cursor)
+	case len(t.logSearchMatches) == 0:
+		return fmt.Sprintf(" [/%s%s no matches]", t.logSearchQuery, cursor)
+	default:
+		return fmt.Sprintf(" [/%s%s %d/%d]", t.logSearchQuery, cursor, t.logSearchCurrent+1, len(t.logSearchMatches))
+	}
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestHighlightLogSearch(t *testing.T) {
+	colored := "\x1b[31mERROR: timeout talking to db\x1b[0m"
+	want := "\x1b[31mERROR: " + logSearchHighlightOn + "timeout" + logSearchHighlightOff + " talking to db\x1b[0m"
+	if got := highlightLogSearch(colored, "timeout"); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	// Lower-case queries ignore case, queries with upper case do not
+	if !logLineMatches("Connection TIMEOUT", "timeout") {
+		t.Errorf("Expected a lower-case query to ignore case")
+	}
+	if logLineMatches("connection timeout", "Timeout") {
+		t.Errorf("Expected an upper-case query to match case")
+	}
+
+	// The escape sequence itself is never matched
+	if got := highlightLogSearch("\x1b[31mred\x1b[0m", "31m"); got != "\x1b[31mred\x1b[0m" {
+		t.Errorf("Expected escape sequences to be skipped, got %q", got)
+	}
+}
+
+func TestLogSearchNavigation(t *testing.T) {
+	tui := &TUI{
+		App:         models.NewApp("test"),
+		logViewMode: constants.PodLogViewMode,
+		tailMode:    true,
+	}
+	for i := 0; i < 30; i++ {
+		if i%10 == 5 {
+			tui.podLogs = append(tui.podLogs, fmt.Sprintf("line %d: request failed", i))
+		} else {
+			tui.podLogs = append(tui.podLogs, fmt.Sprintf("line %d: ok", i))
+		}
+	}
+
+	tui.openLogSearch()
+	for _, r := range "failed" {
+		tui.handleLogSearchKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	tui.handleLogSearchKeys(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if tui.editingLogSearch || len(tui.logSearchMatches) != 3 {
+		t.Fatalf("Expected 3 matches after confirming the search, got %v", tui.logSearchMatches)
+	}
+	if tui.currentLogMatchLine() != 5 || tui.tailMode {
+		t.Errorf("Expected to jump to line 5 and leave tail mode, got line %d", tui.currentLogMatchLine())
+	}
+	if got := tui.logSearchHeader(); got != " [/failed 1/3]" {
+		t.Errorf("Unexpected header %q", got)
+	}
+
+	tui.nextLogMatch(-1)
+	if tui.currentLogMatchLine() != 25 {
+		t.Errorf("Expected N to wrap to the last match, got line %d", tui.currentLogMatchLine())
+	}
+
+	// Lines trimmed from the buffer keep the current match on the same line
+	tui.podLogs = tui.podLogs[10:]
+	tui.refreshLogSearch(10)
+	if len(tui.logSearchMatches) != 2 || tui.currentLogMatchLine() != 15 {
+		t.Errorf("Expected the current match to follow the trimmed buffer, got line %d of %v",
+			tui.currentLogMatchLine(), tui.logSearchMatches)
+	}
+
+	tui.handleLogSearchKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.logSearchActive() || tui.logSearchHeader() != "" {
+		t.Errorf("Expected esc to clear the search")
+	}
+}
@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// logSpill is a temporary file holding the pod log lines trimmed from the
+// log buffer, oldest first, so that long sessions keep their history
+type logSpill struct {
+	file  *os.File
+	lines int
+}
+
+// newLogSpill creates an empty spill file
+func newLogSpill() (*logSpill, error) {
+	file, err := os.CreateTemp("", constants.LogSpillFilePattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log spill file: %w", err)
+	}
+	return &logSpill{file: file}, nil
+}
+
+// append writes lines to the end of the spill file
+func (s *logSpill) append(lines []string) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	if _, err := s.file.WriteString(strings.Join(lines, "\n") + "\n"); err != nil {
+		return err
+	}
+	s.lines += len(lines)
+	return nil
+}
+
+// scan calls fn with every spilled line, oldest first
+func (s *logSpill) scan(fn func(string)) error {
+	scanner := bufio.NewScanner(io.NewSectionReader(s.file, 0, 1<<62))
+	scanner.Buffer(make([]byte, 64*1024), 16<<20)
+	for scanner.Scan() {
+		fn(scanner.Text())
+	}
+	return scanner.Err()
+}
+
+// readAll returns every spilled line, oldest first
+func (s *logSpill) readAll() ([]string, error) {
+	lines := make([]string, 0, s.lines)
+	err := s.scan(func(line string) { lines = append(lines, line) })
+	return lines, err
+}
+
+// remove closes and deletes the spill file
+func (s *logSpill) remove() {
+	s.file.Close()
+	os.Remove(s.file.Name())
+}
+
+// trimPodLogs drops the oldest lines beyond the line count and memory caps of
+// the pod log buffer, spilling them to disk when enabled. It returns the
+// number of lines dropped from the buffer.
+func (t *TUI) trimPodLogs() int {
+	size := 0
+	for _, line := range t.podLogs {
+		size += len(line)
+	}
+
+	trimmed := max(len(t.podLogs)-constants.MaxLogLines, 0)
+	for _, line := range t.podLogs[:trimmed] {
+		size -= len(line)
+	}
+	// The newest line is kept even when it alone exceeds the cap
+	limit := t.prefs.LogBufferBytes()
+	for size > limit && trimmed < len(t.podLogs)-1 {
+		size -= len(t.podLogs[trimmed])
+		trimmed++
+	}
+	if trimmed == 0 {
+		return 0
+	}
+
+	t.spillPodLogs(t.podLogs[:trimmed])
+	t.podLogs = t.podLogs[trimmed:]
+	return trimmed
+}
+
+// spillPodLogs keeps lines trimmed from the pod log buffer in the spill file,
+// or counts them as dropped when spilling is off or fails
+func (t *TUI) spillPodLogs(lines []string) {
+	// Leave out the stream status line, it is not part of the pod's output
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if t.logStreamStatus == "" || line != t.logStreamStatus {
+			kept = append(kept, line)
+		}
+	}
+
+	if !t.prefs.LogSpillEnabled() {
+		t.podLogsDropped += len(kept)
+		return
+	}
+
+	if t.logSpill == nil {
+		spill, err := newLogSpill()
+		if err != nil {
+			t.logWarn(categoryResource, "Older pod log lines are dropped: %v", err)
+			t.prefs.LogSpill = nil
+			t.podLogsDropped += len(kept)
+			return
+		}
+		t.logSpill = spill
+	}
+
+	if err := t.logSpill.append(kept); err != nil {
+		t.logWarn(categoryResource, "Older pod log lines are dropped, writing %s failed: %v", t.logSpill.file.Name(), err)
+		t.prefs.LogSpill = nil
+		t.podLogsDropped += len(kept)
+		return
+	}
+
+	if t.logSearchQuery != "" {
+		for _, line := range kept {
+			if logLineMatches(line, t.logSearchQuery) {
+				t.logSpillMatches++
+			}
+		}
+	}
+}
+
+// countSpilledLogMatches counts the search matches among the spilled lines
+func (t *TUI) countSpilledLogMatches() {
+	t.logSpillMatches = 0
+	if t.logSpill == nil || t.logSearchQuery == "" {
+		return
+	}
+
+	query := t.logSearchQuery
+	if err := t.logSpill.scan(func(line string) {
+		if logLineMatches(line, query) {
+			t.logSpillMatches++
+		}
+	}); err != nil {
+		t.logWarn(categoryResource, "Failed to search spilled pod logs: %v", err)
+	}
+}
+
+// spilledPodLogs returns the spilled lines to save along with the buffer
+func (t *TUI) spilledPodLogs() []string {
+	if t.logSpill == nil {
+		return nil
+	}
+	lines, err := t.logSpill.readAll()
+	if err != nil {
+		t.logWarn(categoryAction, "Failed to read spilled pod logs, saving only the loaded lines: %v", err)
+		return nil
+	}
+	return lines
+}
+
+// closeLogSpill deletes the spill file and forgets the trimmed lines
+func (t *TUI) closeLogSpill() {
+	if t.logSpill != nil {
+		t.logSpill.remove()
+		t.logSpill = nil
+	}
+	t.logSpillMatches = 0
+	t.podLogsDropped = 0
+}
+
+// logHistoryHeader returns the trimmed history part of the pod log header,
+// e.g. " [+1200 earlier lines on disk, 3 matches]"
+func (t *TUI) logHistoryHeader() string {
+	switch {
+	case t.logSpill != nil && t.logSpill.lines > 0:
+		if t.logSearchQuery != "" {
+			return fmt.Sprintf(" [+%d earlier lines on disk, %d matches]", t.logSpill.lines, t.logSpillMatches)
+		}
+		return fmt.Sprintf(" [+%d earlier lines on disk]", t.logSpill.lines)
+	case t.podLogsDropped > 0:
+		return fmt.Sprintf(" [%d earlier lines dropped]", t.podLogsDropped)
+	}
+	return ""
+}
@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestPodLogsMemoryCap(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), prefs: config.Preferences{LogBufferMB: 1}}
+
+	line := strings.Repeat("x", 4096)
+	for i := 0; i < 300; i++ {
+		tui.podLogs = append(tui.podLogs, line)
+	}
+	trimmed := tui.trimPodLogs()
+
+	if len(tui.podLogs) != 256 || trimmed != 44 {
+		t.Errorf("Expected the buffer to be capped at 1 MiB, kept %d lines and trimmed %d", len(tui.podLogs), trimmed)
+	}
+	if tui.podLogsDropped != 44 || tui.logHistoryHeader() != " [44 earlier lines dropped]" {
+		t.Errorf("Expected the dropped lines to be reported, got %q", tui.logHistoryHeader())
+	}
+}
+
+func TestPodLogsSpillToDisk(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	spill := true
+	tui := &TUI{App: models.NewApp("test"), prefs: config.Preferences{LogSpill: &spill}, logViewMode: constants.PodLogViewMode}
+	tui.logSearchQuery = "error"
+
+	for i := 0; i < constants.MaxLogLines+20; i++ {
+		level := "info"
+		if i%10 == 0 {
+			level = "error"
+		}
+		tui.podLogs = append(tui.podLogs, fmt.Sprintf("%s line %d", level, i))
+	}
+	tui.trimPodLogs()
+
+	if tui.logSpill == nil || tui.logSpill.lines != 20 || tui.podLogsDropped != 0 {
+		t.Fatalf("Expected the oldest lines to be spilled to disk")
+	}
+	if tui.logSpillMatches != 2 {
+		t.Errorf("Expected 2 matches among the spilled lines, got %d", tui.logSpillMatches)
+	}
+	tui.logSpillMatches = 0
+	tui.countSpilledLogMatches()
+	if tui.logSpillMatches != 2 {
+		t.Errorf("Expected a new search to count 2 spilled matches, got %d", tui.logSpillMatches)
+	}
+	if header := tui.logHistoryHeader(); header != " [+20 earlier lines on disk, 2 matches]" {
+		t.Errorf("Unexpected header %q", header)
+	}
+
+	spilled := tui.spilledPodLogs()
+	if len(spilled) != 20 || spilled[0] != "error line 0" || spilled[19] != "info line 19" {
+		t.Errorf("Expected the spilled lines oldest first, got %d lines", len(spilled))
+	}
+
+	path := tui.logSpill.file.Name()
+	tui.closeLogSpill()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the spill file to be removed")
+	}
+}
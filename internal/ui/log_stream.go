@@ -0,0 +1,203 @@
+package ui
+
+import (
+	"context"
+	"math"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// startPodLogStream follows the logs of the selected pod's chosen container,
+// or reads them once in safe mode.
+// Any previous stream is cancelled first, and lines it still has in flight
+// are dropped because they carry an older stream ID. When the same
+// container's stream is reconnected, it resumes from the last received line
+// instead of replaying the tail.
+func (t *TUI) startPodLogStream() tea.Cmd {
+	t.stopPodLogStream()
+
+	pod, ok := t.selectedLogPod()
+	if !t.connected || !ok {
+		return nil
+	}
+	target := t.currentLogTarget(pod)
+
+	opts := resources.LogOptions{
+		Follow:   !t.safeMode,
+		Previous: target.Previous,
+	}
+	if t.resumableLogStream(pod.Name, target.Container) {
+		since := int64(math.Ceil(time.Since(t.logStreamLastLineAt).Seconds())) + 1
+		opts.SinceSeconds = &since
+		t.logResumeOverlap = recentLines(t.podLogs, constants.LogResumeOverlapLines)
+	} else {
+		tail := int64(t.prefs.LogTail())
+		opts.TailLines = &tail
+		t.logResumeOverlap = nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.logStreamID++
+	t.logStreamCtx, t.logStreamCancel = ctx, cancel
+	t.currentPodName = pod.Name
+	t.logStreamContainer = target.Container
+	t.logStreamStartedAt = time.Now()
+
+	stream := t.logStreamID
+	client := t.resourceClient
+	program := t.program
+	return func() tea.Msg {
+		if client == nil {
+			return nil
+		}
+		logChan, err := client.StreamPodLogs(ctx, pod.Namespace, pod.Name, target.Container, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return messages.PodLogStreamError{
+				PodName:   pod.Name,
+				Container: target.Container,
+				Stream:    stream,
+				Err:       err,
+			}
+		}
+
+		go pumpPodLogs(ctx, program, logChan, stream, pod.Name, target.Container)
+		return nil
+	}
+}
+
+// stopPodLogStream cancels the current log stream, if any
+func (t *TUI) stopPodLogStream() {
+	if t.logStreamCancel != nil {
+		t.logStreamCancel()
+		t.logStreamCancel = nil
+		t.logStreamCtx = nil
+	}
+	t.currentPodName = ""
+	// Invalidate lines still in flight from the cancelled stream
+	t.logStreamID++
+}
+
+// resumableLogStream reports whether a new stream for the container can
+// continue where the previous one left off. clearPodLogs resets the state
+// whenever the pod or container changes.
+func (t *TUI) resumableLogStream(podName, container string) bool {
+	return !t.logStreamLastLineAt.IsZero() && len(t.podLogs) > 0 &&
+		t.logStreamResumePod == podName && t.logStreamContainer == container
+}
+
+// pumpPodLogs forwards lines from a log stream to the UI. Lines that are
+// already buffered are delivered together so that bursts cause a single
+// re-render instead of one per line. Without a program, as in a TUI built
+// outside NewProgram, the lines are drained and dropped.
+func pumpPodLogs(ctx context.Context, program *tea.Program, logChan <-chan string, stream int, podName, container string) {
+	for {
+		var line string
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return
+		case line, ok = <-logChan:
+		}
+
+		if !ok {
+			// Channel closed: the container exited or the connection dropped.
+			// Report it unless the stream was stopped on purpose.
+			if ctx.Err() == nil && program != nil {
+				program.Send(messages.PodLogStreamError{
+					PodName:   podName,
+					Container: container,
+					Stream:    stream,
+					Err:       errLogStreamEnded,
+				})
+			}
+			return
+		}
+
+		batch := []string{line}
+	drain:
+		for len(batch) < constants.LogStreamBatchSize {
+			select {
+			case line, ok = <-logChan:
+				if !ok {
+					// Deliver what we have; the next receive reports the close
+					break drain
+				}
+				batch = append(batch, line)
+			default:
+				break drain
+			}
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		if program == nil {
+			continue
+		}
+		program.Send(PodLogsRefreshed{
+			Logs:      batch,
+			PodName:   podName,
+			Container: container,
+			Stream:    stream,
+		})
+	}
+}
+
+// handlePodLogsRefreshed appends streamed lines to the log panel
+func (t *TUI) handlePodLogsRefreshed(msg PodLogsRefreshed) {
+	if msg.Stream != t.logStreamID {
+		return
+	}
+
+	lines := make([]string, 0, len(msg.Logs))
+	for _, line := range msg.Logs {
+		if line == "" {
+			continue
+		}
+		// After a reconnect, skip lines we already have from the previous stream
+		if t.logResumeOverlap != nil {
+			if t.logResumeOverlap[line] {
+				continue
+			}
+			t.logResumeOverlap = nil
+		}
+		lines = append(lines, line)
+	}
+
+	t.loadingLogs = false
+	t.logStreamLastLineAt = time.Now()
+	t.logStreamResumePod = msg.PodName
+	if len(lines) == 0 {
+		return
+	}
+
+	t.podLogs = append(t.podLogs, lines...)
+	t.refreshLogSearch(t.trimPodLogs())
+
+	// Handle scroll behavior based on mode
+	if t.tailMode {
+		// In tail mode, always stay at the bottom
+		t.logScrollOffset = t.getMaxLogScrollOffset()
+		t.userScrolled = false
+	} else if t.userScrolled && t.anchorLogLine != "" {
+		// Keep the anchored line in view while the user reads older logs
+		t.adjustScrollForAnchor()
+	}
+}
+
+// recentLines returns the last n lines as a set
+func recentLines(lines []string, n int) map[string]bool {
+	recent := make(map[string]bool, n)
+	for _, line := range lines[max(len(lines)-n, 0):] {
+		recent[line] = true
+	}
+	return recent
+}
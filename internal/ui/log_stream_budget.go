@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// errLogStreamEnded reports a log stream that closed while it was still wanted
+var errLogStreamEnded = errors.New("log stream ended")
+
+// logStreamBackoff is the reconnect policy for failing pod log streams
+var logStreamBackoff = resources.BackoffPolicy{
+	BaseDelay: constants.LogStreamRetryBaseDelay,
+	MaxDelay:  constants.LogStreamRetryMaxDelay,
+}
+
+// handleLogStreamError records a log stream failure against the error budget.
+// Reconnects back off exponentially and stop once the budget is spent; the
+// log panel shows a single status line that is updated in place.
+func (t *TUI) handleLogStreamError(podName string, err error) tea.Cmd {
+	t.loadingLogs = false
+
+	// A stream that stayed up for a while counts as healthy again
+	if podName != t.logStreamFailPod || time.Since(t.logStreamStartedAt) >= constants.LogStreamHealthyAfter {
+		t.logStreamFailPod = podName
+		t.logStreamFailures = 0
+	}
+	t.logStreamFailures++
+
+	if t.logStreamFailures >= constants.LogStreamErrorBudget {
+		t.setLogStreamStatus(fmt.Sprintf("⏸ Log stream for %s stopped after %d consecutive failures: %v (press r to retry)",
+			podName, t.logStreamFailures, err))
+		logging.Warn(t.Logger, "Stopped log stream for %s after %d failures: %v", podName, t.logStreamFailures, err)
+		return nil
+	}
+
+	delay := logStreamBackoff.Delay(t.logStreamFailures)
+	t.setLogStreamStatus(fmt.Sprintf("⚠️ Log stream for %s interrupted (%d/%d): %v, reconnecting in %s",
+		podName, t.logStreamFailures, constants.LogStreamErrorBudget, err, delay.Round(time.Second)))
+
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return messages.RefreshPodLogs{PodName: podName}
+	})
+}
+
+// setLogStreamStatus shows line as the log stream status, replacing the
+// previous status line if it is still the last line in the log panel
+func (t *TUI) setLogStreamStatus(line string) {
+	if n := len(t.podLogs); n > 0 && t.logStreamStatus != "" && t.podLogs[n-1] == t.logStreamStatus {
+		t.podLogs[n-1] = line
+	} else {
+		t.podLogs = append(t.podLogs, line)
+	}
+	t.logStreamStatus = line
+
+	if t.tailMode {
+		t.logScrollOffset = t.getMaxLogScrollOffset()
+	}
+}
+
+// logStreamBudgetExhausted reports whether reconnects for the selected pod were stopped
+func (t *TUI) logStreamBudgetExhausted() bool {
+	return t.logStreamFailures >= constants.LogStreamErrorBudget &&
+		len(t.pods) > 0 && t.selectedPod < len(t.pods) &&
+		t.pods[t.selectedPod].Name == t.logStreamFailPod
+}
+
+// resetLogStreamBudget clears the failure count and status line tracking
+func (t *TUI) resetLogStreamBudget() {
+	t.logStreamFailures = 0
+	t.logStreamFailPod = ""
+	t.logStreamStatus = ""
+}
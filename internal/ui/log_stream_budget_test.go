@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestLogStreamErrorBudget(t *testing.T) {
+	tui := &TUI{
+		App:                models.NewApp("test"),
+		pods:               []resources.PodInfo{{ResourceInfo: resources.ResourceInfo{Name: "web-1"}}},
+		podLogs:            []string{"line 1"},
+		logStreamStartedAt: time.Now(),
+	}
+	streamErr := errors.New("container is restarting")
+
+	for i := 1; i < constants.LogStreamErrorBudget; i++ {
+		if cmd := tui.handleLogStreamError("web-1", streamErr); cmd == nil {
+			t.Fatalf("Expected a reconnect to be scheduled after failure %d", i)
+		}
+	}
+
+	if len(tui.podLogs) != 2 {
+		t.Fatalf("Expected a single consolidated status line, got %v", tui.podLogs)
+	}
+	if !strings.Contains(tui.podLogs[1], "reconnecting") {
+		t.Errorf("Expected reconnect status line, got %q", tui.podLogs[1])
+	}
+
+	if cmd := tui.handleLogStreamError("web-1", streamErr); cmd != nil {
+		t.Errorf("Expected reconnects to stop once the error budget is spent")
+	}
+	if len(tui.podLogs) != 2 || !strings.Contains(tui.podLogs[1], "stopped") {
+		t.Errorf("Expected status line to report the stopped stream, got %v", tui.podLogs)
+	}
+	if !tui.logStreamBudgetExhausted() {
+		t.Errorf("Expected budget to be exhausted for the selected pod")
+	}
+
+	// A failure for another pod starts a fresh budget
+	if cmd := tui.handleLogStreamError("web-2", streamErr); cmd == nil || tui.logStreamFailures != 1 {
+		t.Errorf("Expected a fresh budget for a different pod, failures=%d", tui.logStreamFailures)
+	}
+}
@@ -0,0 +1,84 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestHandlePodLogsRefreshed(t *testing.T) {
+	tui := &TUI{
+		App:      models.NewApp("test"),
+		tailMode: true,
+	}
+	tui.logStreamID = 2
+
+	// Lines from a cancelled stream are dropped
+	tui.handlePodLogsRefreshed(PodLogsRefreshed{Logs: []string{"stale"}, PodName: "web-1", Stream: 1})
+	if len(tui.podLogs) != 0 {
+		t.Fatalf("Expected lines from an old stream to be dropped, got %v", tui.podLogs)
+	}
+
+	// Repeated lines are real output and are kept
+	tui.handlePodLogsRefreshed(PodLogsRefreshed{Logs: []string{"tick", "tick", ""}, PodName: "web-1", Stream: 2})
+	if got := strings.Join(tui.podLogs, ","); got != "tick,tick" {
+		t.Errorf("Expected repeated lines to be kept, got %q", got)
+	}
+	if tui.logStreamResumePod != "web-1" || tui.logStreamLastLineAt.IsZero() {
+		t.Errorf("Expected the stream position to be recorded for resuming")
+	}
+}
+
+func TestHandlePodLogsRefreshedSkipsResumeOverlap(t *testing.T) {
+	tui := &TUI{
+		App:      models.NewApp("test"),
+		podLogs:  []string{"one", "two", "three"},
+		tailMode: true,
+	}
+	tui.logResumeOverlap = recentLines(tui.podLogs, constants.LogResumeOverlapLines)
+
+	tui.handlePodLogsRefreshed(PodLogsRefreshed{Logs: []string{"two", "three", "four", "two"}, PodName: "web-1"})
+
+	if got := strings.Join(tui.podLogs, ","); got != "one,two,three,four,two" {
+		t.Errorf("Expected only the replayed lines to be skipped, got %q", got)
+	}
+	if tui.logResumeOverlap != nil {
+		t.Errorf("Expected the overlap to be cleared after the first new line")
+	}
+}
+
+func TestHandlePodLogsRefreshedTrimsBuffer(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+
+	lines := make([]string, constants.MaxLogLines+10)
+	for i := range lines {
+		lines[i] = "line"
+	}
+	tui.handlePodLogsRefreshed(PodLogsRefreshed{Logs: lines, PodName: "web-1"})
+
+	if len(tui.podLogs) != constants.MaxLogLines {
+		t.Errorf("Expected the buffer to be capped at %d lines, got %d", constants.MaxLogLines, len(tui.podLogs))
+	}
+}
+
+func TestPumpPodLogsWithoutProgram(t *testing.T) {
+	logChan := make(chan string, 2)
+	logChan <- "line 1"
+	logChan <- "line 2"
+	close(logChan)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pumpPodLogs(context.Background(), nil, logChan, 1, "web-1", "app")
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected the stream to be drained without a program")
+	}
+}
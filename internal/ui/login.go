@@ -0,0 +1,217 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// Login form fields
+const (
+	loginFormServer = iota
+	loginFormToken
+	loginFormUsername
+	loginFormPassword
+	loginFormProject
+	loginFormFieldCount
+)
+
+// openLoginForm opens the login form, the in-TUI equivalent of 'oc login'
+func (t *TUI) openLoginForm() tea.Cmd {
+	placeholders := [loginFormFieldCount]string{
+		"API server (e.g. https://api.example.com:6443)",
+		"token (e.g. sha256~..., leave empty to use a password)",
+		"username",
+		"password",
+		"project (default: default)",
+	}
+
+	t.loginInputs = make([]textinput.Model, loginFormFieldCount)
+	for i := range t.loginInputs {
+		input := textinput.New()
+		input.Placeholder = placeholders[i]
+		input.CharLimit = 2048
+		input.Width = 60
+		t.loginInputs[i] = input
+	}
+	t.loginInputs[loginFormToken].EchoMode = textinput.EchoPassword
+	t.loginInputs[loginFormPassword].EchoMode = textinput.EchoPassword
+
+	t.loginFocus = loginFormServer
+	t.loginInputs[loginFormServer].Focus()
+	t.loginError = ""
+	t.loggingIn = false
+	t.loginInsecure = false
+	t.showLoginForm = true
+	return textinput.Blink
+}
+
+// loginOptionsFromForm builds the login credentials from the form inputs
+func (t *TUI) loginOptionsFromForm() (auth.LoginOptions, error) {
+	value := func(field int) string { return strings.TrimSpace(t.loginInputs[field].Value()) }
+	options := auth.LoginOptions{
+		Server:                value(loginFormServer),
+		Token:                 value(loginFormToken),
+		Username:              value(loginFormUsername),
+		Password:              t.loginInputs[loginFormPassword].Value(),
+		Namespace:             value(loginFormProject),
+		InsecureSkipTLSVerify: t.loginInsecure,
+	}
+
+	switch {
+	case options.Server == "":
+		return options, fmt.Errorf("the API server is required")
+	case options.Token == "" && options.Username == "":
+		return options, fmt.Errorf("enter a token, or a username and password")
+	case options.Token == "" && options.Password == "":
+		return options, fmt.Errorf("the password is required")
+	}
+	return options, nil
+}
+
+// submitLogin logs in with the form credentials, saves the login to the
+// kubeconfig and connects with it
+func (t *TUI) submitLogin() tea.Cmd {
+	options, err := t.loginOptionsFromForm()
+	if err != nil {
+		t.loginError = err.Error()
+		return nil
+	}
+
+	t.loginError = ""
+	t.loggingIn = true
+	t.logInfo(categoryConnection, "Logging in to %s...", t.obfuscateClusterContext(options.Server))
+
+	kubeconfigPath := t.KubeconfigPath
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.ValidationTimeout)
+		defer cancel()
+
+		provider := auth.NewLoginProvider(options)
+		if _, err := provider.Authenticate(ctx); err != nil {
+			return messages.LoginFailed{Err: err}
+		}
+		path, err := provider.Save(kubeconfigPath)
+		if err != nil {
+			return messages.LoginFailed{Err: err}
+		}
+		return messages.LoginSucceeded{KubeconfigPath: path, Context: provider.GetContext(), Username: provider.GetUsername()}
+	}
+}
+
+// handleLoginSucceeded closes the form and connects with the saved login
+func (t *TUI) handleLoginSucceeded(msg messages.LoginSucceeded) tea.Cmd {
+	t.showLoginForm = false
+	t.loggingIn = false
+	t.loginInputs = nil
+	t.logSuccess(categoryConnection, "Logged in as %s, saved context %s to %s", msg.Username, t.obfuscateClusterContext(msg.Context), msg.KubeconfigPath)
+
+	// Nothing loaded from a previous connection applies to the new one
+	if t.connected {
+		t.clearPodLogs()
+		t.dropLoadedResources()
+	}
+
+	// The login's project replaces the default namespace
+	t.startNamespace = ""
+	t.KubeconfigPath = msg.KubeconfigPath
+	return t.SetKubeconfig(msg.KubeconfigPath)
+}
+
+// handleLoginFailed shows why a login failed in the form
+func (t *TUI) handleLoginFailed(msg messages.LoginFailed) {
+	t.loggingIn = false
+	t.loginError = msg.Err.Error()
+	t.logError(categoryConnection, "Login failed: %v", msg.Err)
+}
+
+// renderLoginForm renders the login form
+func (t *TUI) renderLoginForm() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(90, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	labels := [loginFormFieldCount]string{"Server", "Token", "Username", "Password", "Project"}
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🔑 Log in to a cluster") + "\n\n")
+	for i, input := range t.loginInputs {
+		label := fmt.Sprintf("%-9s", labels[i])
+		if i == t.loginFocus {
+			label = lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Render(label)
+		}
+		content.WriteString(label + " " + input.View() + "\n")
+	}
+
+	insecure := "[ ]"
+	if t.loginInsecure {
+		insecure = "[x]"
+	}
+	content.WriteString(fmt.Sprintf("\n%s Skip TLS verification (insecure)\n", insecure))
+
+	switch {
+	case t.loggingIn:
+		content.WriteString(fmt.Sprintf("\n%s Logging in...\n", t.getLoadingSpinner()))
+	case t.loginError != "":
+		content.WriteString("\n" + lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.loginError, modalWidth-10)) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("tab/↑↓: next field • ctrl+t: toggle TLS verification • enter: log in • esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleLoginFormKeys handles key input for the login form
+func (t *TUI) handleLoginFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.loggingIn {
+		return t, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		t.showLoginForm = false
+		t.loginInputs = nil
+		return t, nil
+
+	case "tab", "down":
+		t.focusLoginField((t.loginFocus + 1) % loginFormFieldCount)
+		return t, nil
+
+	case "shift+tab", "up":
+		t.focusLoginField((t.loginFocus + loginFormFieldCount - 1) % loginFormFieldCount)
+		return t, nil
+
+	case "ctrl+t":
+		t.loginInsecure = !t.loginInsecure
+		return t, nil
+
+	case "enter":
+		return t, t.submitLogin()
+	}
+
+	var cmd tea.Cmd
+	t.loginInputs[t.loginFocus], cmd = t.loginInputs[t.loginFocus].Update(msg)
+	return t, cmd
+}
+
+// focusLoginField moves focus to the given login form field
+func (t *TUI) focusLoginField(index int) {
+	t.loginInputs[t.loginFocus].Blur()
+	t.loginFocus = index
+	t.loginInputs[t.loginFocus].Focus()
+}
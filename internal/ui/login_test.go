@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestLoginForm(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), width: 120, height: 40}
+
+	tui.Update(messages.NoKubeconfigMsg{Message: "No kubeconfig found at ~/.kube/config"})
+	if !tui.showLoginForm {
+		t.Fatalf("Expected the login form to open without a kubeconfig")
+	}
+
+	tui.handleLoginFormKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if tui.loginError == "" || tui.loggingIn {
+		t.Errorf("Expected a missing server to be reported, got %q", tui.loginError)
+	}
+
+	tui.handleLoginFormKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("api.example.com:6443")})
+	tui.handleLoginFormKeys(tea.KeyMsg{Type: tea.KeyTab})
+	tui.handleLoginFormKeys(tea.KeyMsg{Type: tea.KeyTab})
+	tui.handleLoginFormKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("developer")})
+	if _, err := tui.loginOptionsFromForm(); err == nil || !strings.Contains(err.Error(), "password") {
+		t.Errorf("Expected the password to be required, got %v", err)
+	}
+
+	tui.loginInputs[loginFormPassword].SetValue("secret")
+	tui.handleLoginFormKeys(tea.KeyMsg{Type: tea.KeyCtrlT})
+	options, err := tui.loginOptionsFromForm()
+	if err != nil || options.Server != "api.example.com:6443" || options.Username != "developer" || !options.InsecureSkipTLSVerify {
+		t.Fatalf("Unexpected login options %+v, %v", options, err)
+	}
+	if rendered := tui.renderLoginForm(); strings.Contains(rendered, "secret") || !strings.Contains(rendered, "[x] Skip TLS verification") {
+		t.Errorf("Expected the password to be hidden and TLS verification skipped, got %q", rendered)
+	}
+
+	tui.Update(messages.LoginFailed{Err: errors.New("login failed: invalid username or password")})
+	if !tui.showLoginForm || !strings.Contains(tui.loginError, "invalid username") {
+		t.Errorf("Expected the failure in the form, got %q", tui.loginError)
+	}
+
+	_, cmd := tui.Update(messages.LoginSucceeded{KubeconfigPath: "/tmp/kubeconfig", Context: "default/api-example-com:6443/developer", Username: "developer"})
+	if cmd == nil || tui.showLoginForm || tui.KubeconfigPath != "/tmp/kubeconfig" {
+		t.Errorf("Expected the form to close and connect with the saved kubeconfig")
+	}
+}
@@ -0,0 +1,322 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadMachines loads the MachineSets and Machines of the machine API namespace
+func (t *TUI) loadMachines() tea.Cmd {
+	return func() tea.Msg {
+		osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+		if !ok || !osClient.IsOpenShift() {
+			return messages.MachinesLoadError{Err: fmt.Errorf("not connected to an OpenShift cluster")}
+		}
+
+		resourceClient := resources.NewOpenShiftResourceClient(osClient)
+		listOpts := resources.ListOptions{Namespace: constants.MachineAPINamespace}
+
+		machineSets, err := loadAllPages(t, "machinesets", listOpts, resourceClient.ListMachineSets)
+		if err != nil {
+			return messages.MachinesLoadError{Err: err}
+		}
+
+		machines, err := loadAllPages(t, "machines", listOpts, resourceClient.ListMachines)
+		if err != nil {
+			return messages.MachinesLoadError{Err: err}
+		}
+
+		return messages.MachinesLoaded{MachineSets: machineSets.Items, Machines: machines.Items}
+	}
+}
+
+// openMachines shows the MachineSets and Machines of the cluster
+func (t *TUI) openMachines() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showMachines = true
+	t.selectedMachineSet = 0
+	return t.reloadMachines()
+}
+
+// reloadMachines reloads the machines view, dropping a pending scale
+func (t *TUI) reloadMachines() tea.Cmd {
+	t.loadingMachines = true
+	t.machinesError = ""
+	t.scalingMachineSet = false
+	return t.loadMachines()
+}
+
+// closeMachines dismisses the machines view
+func (t *TUI) closeMachines() {
+	t.showMachines = false
+	t.machineSets = nil
+	t.machines = nil
+	t.scalingMachineSet = false
+}
+
+// handleMachinesLoaded stores the machine API state, keeping the selected MachineSet
+func (t *TUI) handleMachinesLoaded(msg messages.MachinesLoaded) {
+	selected := selectedName(t.machineSets, t.selectedMachineSet, func(ms resources.MachineSetInfo) string { return ms.Name })
+	t.machineSets = msg.MachineSets
+	t.machines = msg.Machines
+	t.selectedMachineSet = indexByName(t.machineSets, selected, func(ms resources.MachineSetInfo) string { return ms.Name })
+	t.loadingMachines = false
+}
+
+// handleMachinesLoadError records a failed load. Clusters without the machine
+// API, such as hosted control planes, fail here as well.
+func (t *TUI) handleMachinesLoadError(msg messages.MachinesLoadError) {
+	t.machineSets = nil
+	t.machines = nil
+	t.loadingMachines = false
+	t.machinesError = msg.Err.Error()
+	t.logError(categoryResource, "Failed to load MachineSets: %v", msg.Err)
+}
+
+// currentMachineSet returns the selected MachineSet, if any
+func (t *TUI) currentMachineSet() (resources.MachineSetInfo, bool) {
+	if t.selectedMachineSet < 0 || t.selectedMachineSet >= len(t.machineSets) {
+		return resources.MachineSetInfo{}, false
+	}
+	return t.machineSets[t.selectedMachineSet], true
+}
+
+// machinesOf returns the Machines owned by a MachineSet
+func machinesOf(machines []resources.MachineInfo, machineSet string) []resources.MachineInfo {
+	var owned []resources.MachineInfo
+	for _, machine := range machines {
+		if machine.MachineSet == machineSet {
+			owned = append(owned, machine)
+		}
+	}
+	return owned
+}
+
+// machineSetAutoscale formats the MachineAutoscaler bounds of a MachineSet
+func machineSetAutoscale(ms resources.MachineSetInfo) string {
+	if ms.AutoscaleMin == "" && ms.AutoscaleMax == "" {
+		return "-"
+	}
+	return fmt.Sprintf("%s-%s", ms.AutoscaleMin, ms.AutoscaleMax)
+}
+
+// adjustMachineSetTarget changes the pending replica count of the selected MachineSet
+func (t *TUI) adjustMachineSetTarget(delta int32) {
+	ms, ok := t.currentMachineSet()
+	if !ok {
+		return
+	}
+	if !t.scalingMachineSet {
+		t.machineSetTarget = ms.Replicas
+		t.scalingMachineSet = true
+	}
+	if t.machineSetTarget+delta >= 0 {
+		t.machineSetTarget += delta
+	}
+}
+
+// scaleMachineSet scales a MachineSet as a background task
+func (t *TUI) scaleMachineSet(name string, replicas int32) tea.Cmd {
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	if !t.connected || !ok || !osClient.IsOpenShift() {
+		return nil
+	}
+
+	t.logInfo(categoryAction, "Scaling MachineSet %s to %d...", name, replicas)
+
+	return t.runTask(fmt.Sprintf("Scale machineset %s to %d", name, replicas),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return resources.NewOpenShiftResourceClient(osClient).ScaleMachineSet(ctx, constants.MachineAPINamespace, name, replicas)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to scale MachineSet %s: %v", name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Scaled MachineSet %s to %d", name, replicas)
+			if t.showMachines {
+				return t.loadMachines()
+			}
+			return nil
+		})
+}
+
+// machinesLines renders the MachineSet table and the Machines of the selected set
+func (t *TUI) machinesLines() []string {
+	bold := lipgloss.NewStyle().Bold(true)
+	warn := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	failed := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	selected := lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+
+	lines := []string{bold.Render(fmt.Sprintf("MachineSets (%d)", len(t.machineSets)))}
+	lines = append(lines, bold.Render(fmt.Sprintf("%-45s %-8s %-9s %-8s %-6s %-10s %s", "NAME", "ROLE", "DESIRED", "CURRENT", "READY", "AVAILABLE", "AUTOSCALE")))
+	for i, ms := range t.machineSets {
+		desired := fmt.Sprintf("%d", ms.Replicas)
+		if i == t.selectedMachineSet && t.scalingMachineSet && t.machineSetTarget != ms.Replicas {
+			desired = fmt.Sprintf("%d → %d", ms.Replicas, t.machineSetTarget)
+		}
+		line := fmt.Sprintf("%-45s %-8s %-9s %-8d %-6d %-10d %s",
+			truncateString(ms.Name, 45),
+			ms.Role,
+			desired,
+			ms.CurrentReplicas,
+			ms.ReadyReplicas,
+			ms.AvailableReplicas,
+			machineSetAutoscale(ms),
+		)
+		switch {
+		case i == t.selectedMachineSet:
+			line = selected.Render(line)
+		case ms.Status == "Failed":
+			line = failed.Render(line)
+		case ms.ReadyReplicas < ms.Replicas:
+			line = warn.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	ms, ok := t.currentMachineSet()
+	if !ok {
+		return lines
+	}
+	if ms.ErrorMessage != "" {
+		lines = append(lines, "", failed.Render("Error: "+ms.ErrorMessage))
+	}
+	if ms.AutoscaleMin != "" || ms.AutoscaleMax != "" {
+		lines = append(lines, "", warn.Render("A MachineAutoscaler manages this MachineSet and may override a manual scale"))
+	}
+
+	owned := machinesOf(t.machines, ms.Name)
+	lines = append(lines, "", bold.Render(fmt.Sprintf("Machines of %s (%d)", ms.Name, len(owned))))
+	lines = append(lines, bold.Render(fmt.Sprintf("%-45s %-13s %-14s %-12s %-35s %s", "NAME", "PHASE", "TYPE", "ZONE", "NODE", "AGE")))
+	for _, machine := range owned {
+		node := machine.NodeName
+		if node == "" {
+			node = "<none>"
+		}
+		line := fmt.Sprintf("%-45s %-13s %-14s %-12s %-35s %s",
+			truncateString(machine.Name, 45),
+			machine.Phase,
+			truncateString(machine.InstanceType, 14),
+			truncateString(machine.Zone, 12),
+			truncateString(node, 35),
+			t.formatTime(machine.CreatedAt, machine.Age),
+		)
+		switch {
+		case machine.Phase == "Failed":
+			line = failed.Render(line)
+		case machine.Phase != "Running":
+			line = warn.Render(line)
+		}
+		lines = append(lines, line)
+		if machine.ErrorMessage != "" {
+			lines = append(lines, failed.Render("  "+truncateString(machine.ErrorMessage, 110)))
+		}
+	}
+
+	if others := len(machinesOf(t.machines, "")); others > 0 {
+		lines = append(lines, "", fmt.Sprintf("%d machine(s) outside any MachineSet, such as control plane machines", others))
+	}
+	return lines
+}
+
+// renderMachines renders the machines view
+func (t *TUI) renderMachines() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(140, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🏭 MachineSets and Machines") + "\n\n")
+
+	switch {
+	case t.loadingMachines:
+		content.WriteString(fmt.Sprintf("%s Loading MachineSets and Machines...\n", t.getLoadingSpinner()))
+	case t.machinesError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.machinesError) + "\n")
+		content.WriteString(fmt.Sprintf("Listing machines needs read access to %s and a cluster running the machine API\n", constants.MachineAPINamespace))
+	case len(t.machineSets) == 0:
+		content.WriteString("No MachineSets found, the cluster nodes are not managed by the machine API\n")
+	default:
+		lines := t.machinesLines()
+		visible := max(t.height-14, 3)
+		end := min(visible, len(lines))
+		for _, line := range lines[:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d of %d lines]\n", end, len(lines)))
+		}
+	}
+
+	content.WriteString("\n")
+	if t.scalingMachineSet {
+		content.WriteString("+/-: adjust • enter: scale • esc: cancel")
+	} else {
+		content.WriteString("j/k: select • +/-: scale • r: refresh • esc/q: close")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleMachinesKeys handles key input for the machines view. Scaling is
+// staged with +/- and only applied on enter.
+func (t *TUI) handleMachinesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		if t.scalingMachineSet {
+			t.scalingMachineSet = false
+			return t, nil
+		}
+		t.closeMachines()
+
+	case "j", "down":
+		if !t.scalingMachineSet && t.selectedMachineSet < len(t.machineSets)-1 {
+			t.selectedMachineSet++
+		}
+
+	case "k", "up":
+		if !t.scalingMachineSet && t.selectedMachineSet > 0 {
+			t.selectedMachineSet--
+		}
+
+	case "+", "=":
+		t.adjustMachineSetTarget(1)
+
+	case "-":
+		t.adjustMachineSetTarget(-1)
+
+	case "enter":
+		ms, ok := t.currentMachineSet()
+		scaling := t.scalingMachineSet
+		t.scalingMachineSet = false
+		if ok && scaling && t.machineSetTarget != ms.Replicas {
+			return t, t.scaleMachineSet(ms.Name, t.machineSetTarget)
+		}
+
+	case "r":
+		return t, t.reloadMachines()
+	}
+
+	return t, nil
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestMachinesView(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabNodes
+	tui.showMachines = true
+
+	tui.Update(messages.MachinesLoaded{
+		MachineSets: []resources.MachineSetInfo{
+			{ResourceInfo: resources.ResourceInfo{Name: "worker-a"}, Role: "worker", Replicas: 2, CurrentReplicas: 2, ReadyReplicas: 2},
+			{ResourceInfo: resources.ResourceInfo{Name: "infra-b"}, Role: "infra", Replicas: 1, AutoscaleMin: "1", AutoscaleMax: "3"},
+		},
+		Machines: []resources.MachineInfo{
+			{ResourceInfo: resources.ResourceInfo{Name: "worker-a-1"}, Phase: "Running", MachineSet: "worker-a", NodeName: "node-1"},
+			{ResourceInfo: resources.ResourceInfo{Name: "worker-a-2"}, Phase: "Failed", MachineSet: "worker-a", ErrorMessage: "InsufficientInstanceCapacity"},
+			{ResourceInfo: resources.ResourceInfo{Name: "infra-b-1"}, Phase: "Provisioning", MachineSet: "infra-b"},
+			{ResourceInfo: resources.ResourceInfo{Name: "master-0"}, Phase: "Running", NodeName: "master-0"},
+		},
+	})
+
+	content := strings.Join(tui.machinesLines(), "\n")
+	for _, want := range []string{"Machines of worker-a (2)", "worker-a-2", "InsufficientInstanceCapacity", "1 machine(s) outside any MachineSet"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Expected the machines view to contain %q, got %q", want, content)
+		}
+	}
+	if strings.Contains(content, "infra-b-1") {
+		t.Errorf("Expected only the machines of the selected MachineSet")
+	}
+
+	// Scaling is staged and can be cancelled
+	tui.handleMachinesKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	tui.handleMachinesKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	if !tui.scalingMachineSet || tui.machineSetTarget != 4 {
+		t.Fatalf("Expected a staged scale to 4, got %d", tui.machineSetTarget)
+	}
+	if !strings.Contains(strings.Join(tui.machinesLines(), "\n"), "2 → 4") {
+		t.Errorf("Expected the staged replica count in the table")
+	}
+	tui.handleMachinesKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.scalingMachineSet || !tui.showMachines {
+		t.Errorf("Expected esc to cancel the scale and keep the view open")
+	}
+
+	// Scaling below zero is not possible
+	tui.handleMachinesKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	tui.handleMachinesKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	tui.handleMachinesKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	if tui.machineSetTarget != 0 {
+		t.Errorf("Expected the target to stop at 0, got %d", tui.machineSetTarget)
+	}
+	if !strings.Contains(strings.Join(tui.machinesLines(), "\n"), "MachineAutoscaler manages this MachineSet") {
+		t.Errorf("Expected a warning for an autoscaled MachineSet")
+	}
+}
@@ -0,0 +1,311 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// Macro keys: Q<register> starts recording and Q stops it, @<register>
+// replays a macro and @@ replays the last one
+const (
+	macroRecordKey = "Q"
+	macroReplayKey = "@"
+)
+
+// macroKeyTypes maps key names such as "enter" or "ctrl+p" back to key types
+var macroKeyTypes = func() map[string]tea.KeyType {
+	types := make(map[string]tea.KeyType)
+	for kt := tea.KeyType(-128); kt < 128; kt++ {
+		if kt == tea.KeyRunes {
+			continue
+		}
+		if name := (tea.Key{Type: kt}).String(); name != "" {
+			if _, exists := types[name]; !exists {
+				types[name] = kt
+			}
+		}
+	}
+	return types
+}()
+
+// parseMacroKey turns a recorded key name back into a key message
+func parseMacroKey(name string) (tea.KeyMsg, bool) {
+	if name == "" {
+		return tea.KeyMsg{}, false
+	}
+
+	alt := false
+	if rest, ok := strings.CutPrefix(name, "alt+"); ok && rest != "" {
+		alt, name = true, rest
+	}
+
+	if kt, ok := macroKeyTypes[name]; ok {
+		return tea.KeyMsg{Type: kt, Alt: alt}, true
+	}
+	if runes := []rune(name); len(runes) == 1 {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: runes, Alt: alt}, true
+	}
+	return tea.KeyMsg{}, false
+}
+
+// isMacroRegister reports whether key names a macro register (a-z)
+func isMacroRegister(key string) bool {
+	return len(key) == 1 && key[0] >= 'a' && key[0] <= 'z'
+}
+
+// recordMacroKey adds a key pressed by the user to the macro being recorded
+func (t *TUI) recordMacroKey(msg tea.KeyMsg) {
+	if t.macroRecording == "" || t.macroReplaying || msg.Paste {
+		return
+	}
+
+	key := msg.String()
+	if key == "" {
+		return
+	}
+	if len(t.macroKeys) >= constants.MaxMacroKeys {
+		t.logWarn(categoryAction, "Macro @%s reached %d keys, recording stopped", t.macroRecording, constants.MaxMacroKeys)
+		t.stopMacroRecording()
+		return
+	}
+	t.macroKeys = append(t.macroKeys, key)
+}
+
+// toggleMacroRecording stops the current recording, or waits for the
+// register to record into
+func (t *TUI) toggleMacroRecording() tea.Cmd {
+	if t.macroReplaying {
+		return nil
+	}
+	if t.macroRecording != "" {
+		// Drop the Q that stopped the recording
+		if n := len(t.macroKeys); n > 0 && t.macroKeys[n-1] == macroRecordKey {
+			t.macroKeys = t.macroKeys[:n-1]
+		}
+		t.stopMacroRecording()
+		return nil
+	}
+	t.macroPendingKey = macroRecordKey
+	return nil
+}
+
+// stopMacroRecording saves the recorded keys to the macro's register
+func (t *TUI) stopMacroRecording() {
+	register, keys := t.macroRecording, t.macroKeys
+	t.macroRecording = ""
+	t.macroKeys = nil
+
+	if t.config == nil {
+		t.logError(categoryAction, "Cannot save macro @%s: no configuration available", register)
+		return
+	}
+	t.config.SetMacro(register, keys)
+	if len(keys) == 0 {
+		t.logInfo(categoryAction, "Macro @%s cleared", register)
+	} else {
+		t.logSuccess(categoryAction, "Recorded macro @%s (%d keys): %s", register, len(keys), strings.Join(keys, " "))
+	}
+	if err := t.saveUserConfig(); err != nil {
+		t.logError(categoryAction, "Failed to save macro @%s: %v", register, err)
+	}
+}
+
+// handleMacroRegisterKey completes a Q or @ prefix with the register key
+func (t *TUI) handleMacroRegisterKey(msg tea.KeyMsg) tea.Cmd {
+	prefix := t.macroPendingKey
+	t.macroPendingKey = ""
+	key := msg.String()
+
+	if key == "esc" {
+		return nil
+	}
+
+	switch prefix {
+	case macroRecordKey:
+		if !isMacroRegister(key) {
+			t.logWarn(categoryAction, "Macro registers are a-z, got %q", key)
+			return nil
+		}
+		t.macroRecording = key
+		t.macroKeys = nil
+		t.logInfo(categoryAction, "Recording macro @%s, press Q to stop", key)
+
+	case macroReplayKey:
+		if t.macroReplaying {
+			// Replaying a macro from a macro could recurse forever
+			t.logWarn(categoryAction, "Skipping @%s: macros cannot replay other macros", key)
+			return nil
+		}
+		t.recordMacroKey(msg)
+		if key == macroReplayKey {
+			key = t.lastMacro
+		}
+		if !isMacroRegister(key) {
+			t.logWarn(categoryAction, "No macro to replay")
+			return nil
+		}
+		return t.replayMacro(key)
+	}
+	return nil
+}
+
+// replayMacro replays the keys recorded in register, one step at a time
+func (t *TUI) replayMacro(register string) tea.Cmd {
+	if register == t.macroRecording {
+		t.logWarn(categoryAction, "Cannot replay macro @%s while recording it", register)
+		return nil
+	}
+
+	var keys []string
+	if t.config != nil {
+		keys, _ = t.config.Macro(register)
+	}
+	if len(keys) == 0 {
+		t.logWarn(categoryAction, "Macro @%s is empty, record it with Q%s", register, register)
+		return nil
+	}
+
+	t.lastMacro = register
+	t.macroReplaying = true
+	t.macroReplayKeys = keys
+	t.macroReplayIndex = 0
+	t.macroReplayRun++
+	t.macroWaitingSince = time.Time{}
+	t.logInfo(categoryAction, "Replaying macro @%s (%d keys)", register, len(keys))
+	return t.scheduleMacroStep()
+}
+
+// scheduleMacroStep schedules the next key of the running replay
+func (t *TUI) scheduleMacroStep() tea.Cmd {
+	run := t.macroReplayRun
+	return tea.Tick(constants.MacroStepInterval, func(time.Time) tea.Msg {
+		return messages.MacroStep{Run: run}
+	})
+}
+
+// handleMacroStep replays the next key once the UI has finished loading
+// what the previous key requested, so that e.g. a project switch completes
+// before the pod list is navigated
+func (t *TUI) handleMacroStep(msg messages.MacroStep) tea.Cmd {
+	if !t.macroReplaying || msg.Run != t.macroReplayRun {
+		return nil
+	}
+
+	if t.macroBusy() {
+		if t.macroWaitingSince.IsZero() {
+			t.macroWaitingSince = time.Now()
+		} else if time.Since(t.macroWaitingSince) > constants.MacroStepTimeout {
+			t.logError(categoryAction, "Macro @%s aborted at key %d: still loading after %s",
+				t.lastMacro, t.macroReplayIndex+1, constants.MacroStepTimeout)
+			t.finishMacroReplay()
+			return nil
+		}
+		return t.scheduleMacroStep()
+	}
+	t.macroWaitingSince = time.Time{}
+
+	if t.macroReplayIndex >= len(t.macroReplayKeys) {
+		t.logSuccess(categoryAction, "Macro @%s finished", t.lastMacro)
+		t.finishMacroReplay()
+		return nil
+	}
+
+	name := t.macroReplayKeys[t.macroReplayIndex]
+	t.macroReplayIndex++
+	key, ok := parseMacroKey(name)
+	if !ok {
+		t.logError(categoryAction, "Macro @%s aborted: unknown key %q", t.lastMacro, name)
+		t.finishMacroReplay()
+		return nil
+	}
+
+	_, cmd := t.keyboardHandler.Handle(key)
+	return tea.Batch(cmd, t.scheduleMacroStep())
+}
+
+// cancelMacroReplay stops a running replay; pending steps are ignored
+func (t *TUI) cancelMacroReplay() {
+	t.logWarn(categoryAction, "Macro @%s cancelled at key %d of %d", t.lastMacro, t.macroReplayIndex, len(t.macroReplayKeys))
+	t.finishMacroReplay()
+}
+
+// finishMacroReplay ends the running replay
+func (t *TUI) finishMacroReplay() {
+	t.macroReplaying = false
+	t.macroReplayKeys = nil
+	t.macroReplayIndex = 0
+	t.macroReplayRun++
+}
+
+// macroBusy reports whether a replay should wait before sending the next key
+func (t *TUI) macroBusy() bool {
+	if t.connecting || t.loadingProjects || t.switchingProject || t.creatingProject || t.loadingYAML {
+		return true
+	}
+
+	switch t.ActiveTab {
+	case models.TabPods:
+		return t.loadingPods
+	case models.TabServices:
+		return t.loadingServices
+	case models.TabDeployments:
+		return t.loadingDeployments
+	case models.TabConfigMaps:
+		return t.loadingConfigMaps
+	case models.TabSecrets:
+		return t.loadingSecrets
+	case models.TabBuildConfigs:
+		return t.loadingBuildConfigs
+	case models.TabImageStreams:
+		return t.loadingImageStreams
+	case models.TabRoutes:
+		return t.loadingRoutes
+	case models.TabBuilds:
+		return t.loadingBuilds
+	case models.TabEvents:
+		return t.loadingEvents
+	case models.TabStatefulSets:
+		return t.loadingStatefulSets
+	case models.TabDaemonSets:
+		return t.loadingDaemonSets
+	case models.TabReplicaSets:
+		return t.loadingReplicaSets
+	case models.TabJobs:
+		return t.loadingJobs
+	case models.TabCronJobs:
+		return t.loadingCronJobs
+	case models.TabNodes:
+		return t.loadingNodes
+	case models.TabStorage:
+		return t.loadingPVCs
+	case models.TabIngresses:
+		return t.loadingIngresses
+	case models.TabNetworkPolicies:
+		return t.loadingNetworkPolicies
+	case models.TabDeploymentConfigs:
+		return t.loadingDeploymentConfigs
+	}
+	return false
+}
+
+// renderMacroStatus returns the status bar indicator for macro recording or replay
+func (t *TUI) renderMacroStatus() string {
+	switch {
+	case t.macroRecording != "":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Render("● REC @" + t.macroRecording)
+	case t.macroReplaying:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("3")).
+			Render(fmt.Sprintf("▶ @%s %d/%d", t.lastMacro, t.macroReplayIndex, len(t.macroReplayKeys)))
+	case t.macroPendingKey != "":
+		return t.macroPendingKey + "…"
+	}
+	return ""
+}
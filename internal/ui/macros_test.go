@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestParseMacroKey(t *testing.T) {
+	for _, name := range []string{"j", "/", "enter", "esc", "ctrl+p", "shift+tab", " ", "alt+x", "@"} {
+		key, ok := parseMacroKey(name)
+		if !ok {
+			t.Errorf("Expected %q to parse", name)
+			continue
+		}
+		if got := key.String(); got != name {
+			t.Errorf("Expected %q to round-trip, got %q", name, got)
+		}
+	}
+
+	if _, ok := parseMacroKey("not-a-key"); ok {
+		t.Errorf("Expected unknown key names to be rejected")
+	}
+}
+
+func newMacroTestTUI(t *testing.T) *TUI {
+	tui := &TUI{
+		App:        models.NewApp("test"),
+		config:     &config.Config{},
+		configPath: filepath.Join(t.TempDir(), "config.json"),
+	}
+	tui.keyboardHandler = NewKeyboardHandler(tui, nil, nil)
+	return tui
+}
+
+func runeKey(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestRecordAndReplayMacro(t *testing.T) {
+	tui := newMacroTestTUI(t)
+
+	// Qa ? esc Q records "?" and "esc" into register a
+	for _, key := range []tea.KeyMsg{runeKey("Q"), runeKey("a"), runeKey("?"), {Type: tea.KeyEsc}, runeKey("Q")} {
+		tui.keyboardHandler.Handle(key)
+	}
+	keys, ok := tui.config.Macro("a")
+	if !ok || len(keys) != 2 || keys[0] != "?" || keys[1] != "esc" {
+		t.Fatalf("Expected macro a to hold [? esc], got %v", keys)
+	}
+	if tui.macroRecording != "" {
+		t.Errorf("Expected recording to stop")
+	}
+
+	saved, err := config.Load(tui.configPath)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if _, ok := saved.Macro("a"); !ok {
+		t.Errorf("Expected the macro to be saved to the config file")
+	}
+
+	// Replay only the first key, then check it took effect
+	tui.config.SetMacro("b", []string{"?"})
+	tui.keyboardHandler.Handle(runeKey("@"))
+	tui.keyboardHandler.Handle(runeKey("b"))
+	if !tui.macroReplaying {
+		t.Fatalf("Expected @b to start a replay")
+	}
+	tui.handleMacroStep(messages.MacroStep{Run: tui.macroReplayRun})
+	if !tui.showHelp {
+		t.Errorf("Expected the replayed key to open the help")
+	}
+	tui.handleMacroStep(messages.MacroStep{Run: tui.macroReplayRun})
+	if tui.macroReplaying {
+		t.Errorf("Expected the replay to finish after its last key")
+	}
+}
+
+func TestMacroReplayWaitsAndCancels(t *testing.T) {
+	tui := newMacroTestTUI(t)
+	tui.config.SetMacro("a", []string{"?"})
+
+	tui.replayMacro("a")
+	run := tui.macroReplayRun
+
+	// Keys are held back while the pod list loads
+	tui.loadingPods = true
+	tui.handleMacroStep(messages.MacroStep{Run: run})
+	if tui.showHelp || tui.macroReplayIndex != 0 {
+		t.Errorf("Expected the replay to wait while loading")
+	}
+
+	// A key typed by the user cancels the replay and stale steps are ignored
+	tui.loadingPods = false
+	tui.Update(runeKey("j"))
+	if tui.macroReplaying {
+		t.Fatalf("Expected a key press to cancel the replay")
+	}
+	tui.handleMacroStep(messages.MacroStep{Run: run})
+	if tui.showHelp {
+		t.Errorf("Expected steps of a cancelled replay to be ignored")
+	}
+}
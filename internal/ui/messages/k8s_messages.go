@@ -3,7 +3,15 @@
 // and application state changes using the Bubble Tea architecture.
 package messages
 
-import "github.com/katyella/lazyoc/internal/k8s/resources"
+import (
+	"time"
+
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/helm"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
 
 // ConnectionError is sent when K8s connection fails
 type ConnectionError struct {
@@ -26,23 +34,20 @@ type PodsLoaded struct {
 	Pods []resources.PodInfo
 }
 
-// RefreshPods is sent to trigger pod list refresh
-type RefreshPods struct{}
-
-// RefreshPodLogs is sent to trigger pod logs refresh
-type RefreshPodLogs struct{}
+// AutoRefreshTick drives the per-tab auto-refresh countdown
+type AutoRefreshTick struct{}
 
-// PodLogStreamUpdate is sent when new log lines are received in real-time
-type PodLogStreamUpdate struct{
-	PodName   string
-	Container string
-	LogLine   string
+// RefreshPodLogs is sent to trigger pod logs refresh. A non-empty PodName
+// restricts the refresh to that pod still being selected.
+type RefreshPodLogs struct {
+	PodName string
 }
 
 // PodLogStreamError is sent when log streaming encounters an error
-type PodLogStreamError struct{
+type PodLogStreamError struct {
 	PodName   string
 	Container string
+	Stream    int // ID of the stream that failed
 	Err       error
 }
 
@@ -63,8 +68,8 @@ type ConnectingMsg struct {
 
 // ClusterInfoLoaded is sent when cluster information is successfully loaded
 type ClusterInfoLoaded struct {
-	Version    string
-	ServerInfo map[string]interface{}
+	Version string // Short label of the status bar, e.g. "OpenShift 4.16.3"
+	Details *resources.ClusterDetails
 }
 
 // ClusterInfoError is sent when cluster information loading fails
@@ -72,6 +77,27 @@ type ClusterInfoError struct {
 	Err error
 }
 
+// IdentityLoaded is sent when the authenticated user has been determined
+type IdentityLoaded struct {
+	Identity *resources.UserIdentity
+}
+
+// IdentityLoadError is sent when the authenticated user cannot be determined
+type IdentityLoadError struct {
+	Err error
+}
+
+// TokenExpiryLoaded is sent when the bearer token's expiry has been determined
+type TokenExpiryLoaded struct {
+	ExpiresAt time.Time
+	Source    string // "JWT" or "OAuth access token"
+}
+
+// ReloginFinished is sent when the external login command exits
+type ReloginFinished struct {
+	Err error
+}
+
 // Kubernetes resource messages
 
 // ServicesLoaded is sent when Services are successfully loaded
@@ -126,11 +152,25 @@ type ServiceLogsLoadError struct {
 	Err error
 }
 
+// ConfigMapDataLoaded is sent when configmap data is successfully loaded
+type ConfigMapDataLoaded struct {
+	Namespace     string
+	ConfigMapName string
+	Data          map[string]string
+	Keys          []string // Sorted
+}
+
+// ConfigMapDataLoadError is sent when configmap data loading fails
+type ConfigMapDataLoadError struct {
+	Err error
+}
+
 // SecretDataLoaded is sent when secret data is successfully loaded
 type SecretDataLoaded struct {
+	Namespace  string
 	SecretName string
 	Data       map[string]string
-	Keys       []string
+	Keys       []string // Sorted
 }
 
 // SecretDataLoadError is sent when secret data loading fails
@@ -138,6 +178,16 @@ type SecretDataLoadError struct {
 	Err error
 }
 
+// SecretEditorFinished is sent when the editor opened on a secret value exits
+type SecretEditorFinished struct {
+	Namespace string
+	Name      string
+	Key       string
+	Path      string // Temporary file holding the decoded value
+	Original  string
+	Err       error
+}
+
 // OpenShift-specific messages
 
 // BuildConfigsLoaded is sent when BuildConfigs are successfully loaded
@@ -169,3 +219,581 @@ type RoutesLoaded struct {
 type RoutesLoadError struct {
 	Err error
 }
+
+// Control plane messages
+
+// ControlPlaneHealthLoaded is sent when control-plane health probes complete
+type ControlPlaneHealthLoaded struct {
+	Components []resources.ComponentHealthInfo
+}
+
+// ControlPlaneHealthLoadError is sent when control-plane health cannot be determined
+type ControlPlaneHealthLoadError struct {
+	Err error
+}
+
+// APILatencyTick triggers the next API server latency probe
+type APILatencyTick struct{}
+
+// APILatencyMeasured is sent when an API server latency probe completes
+type APILatencyMeasured struct {
+	Latency time.Duration
+	Err     error
+}
+
+// ResourceYAMLLoaded is sent when a resource manifest has been fetched
+type ResourceYAMLLoaded struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Content   string
+}
+
+// ResourceYAMLLoadError is sent when fetching a resource manifest fails
+type ResourceYAMLLoadError struct {
+	Kind string
+	Name string
+	Err  error
+}
+
+// DetailYAMLLoaded is sent when the manifest of the YAML detail tab was
+// fetched
+type DetailYAMLLoaded struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Content   string
+	Err       error
+}
+
+// Background task messages
+
+// TaskProgress is sent when a background task reports progress
+type TaskProgress struct {
+	ID    int
+	Done  int
+	Total int
+}
+
+// TaskFinished is sent when a background task completes, fails or is cancelled
+type TaskFinished struct {
+	ID  int
+	Err error
+}
+
+// EditManifestReady is sent when a resource manifest has been fetched for editing
+type EditManifestReady struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Content   string
+}
+
+// EditorFinished is sent when the external editor exits
+type EditorFinished struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Path      string
+	Original  string
+	Err       error
+}
+
+// ConfigMapEditorFinished is sent when the external editor used to edit a
+// configmap value exits
+type ConfigMapEditorFinished struct {
+	Namespace string
+	Name      string
+	Key       string
+	Path      string
+	Original  string
+	Err       error
+}
+
+// ApplyEditorFinished is sent when the external editor used to write manifests to apply exits
+type ApplyEditorFinished struct {
+	Namespace string
+	Path      string
+	Original  string
+	Err       error
+}
+
+// ManifestPreviewLoaded is sent when the dry run previewing what applying
+// manifests would change is done
+type ManifestPreviewLoaded struct {
+	Namespace string
+	Manifest  []byte
+	Previews  []resources.ManifestPreview
+	Err       error
+}
+
+// DiffToolFinished is sent when the external diff tool previewing a change exits
+type DiffToolFinished struct {
+	OldPath string
+	NewPath string
+	Err     error
+}
+
+// RolloutStatusLoaded is sent when a Deployment's rollout status has been fetched
+type RolloutStatusLoaded struct {
+	Status *resources.RolloutStatus
+}
+
+// RolloutStatusLoadError is sent when a Deployment's rollout status cannot be fetched
+type RolloutStatusLoadError struct {
+	Name string
+	Err  error
+}
+
+// RolloutStatusTick triggers the next rollout status refresh
+type RolloutStatusTick struct{}
+
+// BuildsLoaded is sent when OpenShift Builds are successfully loaded
+type BuildsLoaded struct {
+	Builds []resources.BuildInfo
+}
+
+// BuildsLoadError is sent when loading OpenShift Builds fails
+type BuildsLoadError struct {
+	Err error
+}
+
+// BuildLogStreamUpdate is sent for each line streamed from a build's logs
+type BuildLogStreamUpdate struct {
+	Build   string
+	LogLine string
+}
+
+// BuildLogStreamEnded is sent when a build log stream finishes or fails
+type BuildLogStreamEnded struct {
+	Build string
+	Err   error
+}
+
+// EventsLoaded is sent when namespace Events are successfully loaded
+type EventsLoaded struct {
+	Events []resources.EventInfo
+}
+
+// EventsLoadError is sent when loading Events fails
+type EventsLoadError struct {
+	Err error
+}
+
+// StatefulSetsLoaded is sent when StatefulSets are successfully loaded
+type StatefulSetsLoaded struct {
+	StatefulSets []resources.StatefulSetInfo
+}
+
+// StatefulSetsLoadError is sent when loading StatefulSets fails
+type StatefulSetsLoadError struct {
+	Err error
+}
+
+// DaemonSetsLoaded is sent when DaemonSets are successfully loaded
+type DaemonSetsLoaded struct {
+	DaemonSets []resources.DaemonSetInfo
+}
+
+// DaemonSetsLoadError is sent when loading DaemonSets fails
+type DaemonSetsLoadError struct {
+	Err error
+}
+
+// ReplicaSetsLoaded is sent when ReplicaSets are successfully loaded
+type ReplicaSetsLoaded struct {
+	ReplicaSets []resources.ReplicaSetInfo
+}
+
+// ReplicaSetsLoadError is sent when loading ReplicaSets fails
+type ReplicaSetsLoadError struct {
+	Err error
+}
+
+// JobsLoaded is sent when Jobs are successfully loaded
+type JobsLoaded struct {
+	Jobs []resources.JobInfo
+}
+
+// JobsLoadError is sent when loading Jobs fails
+type JobsLoadError struct {
+	Err error
+}
+
+// CronJobsLoaded is sent when CronJobs are successfully loaded
+type CronJobsLoaded struct {
+	CronJobs []resources.CronJobInfo
+}
+
+// CronJobsLoadError is sent when loading CronJobs fails
+type CronJobsLoadError struct {
+	Err error
+}
+
+// NodesLoaded is sent when Nodes are successfully loaded
+type NodesLoaded struct {
+	Nodes []resources.NodeInfo
+}
+
+// NodesLoadError is sent when loading Nodes fails
+type NodesLoadError struct {
+	Err error
+}
+
+// NodeDrainPlanLoaded is sent when the pods a drain would evict are known
+type NodeDrainPlanLoaded struct {
+	Node string
+	Plan *resources.DrainPlan
+	Err  error
+}
+
+// PodDisruptionBudgetsLoaded is sent when the disruption budgets covering a
+// pod about to be deleted are known
+type PodDisruptionBudgetsLoaded struct {
+	Namespace string
+	Pod       string
+	Budgets   []resources.DisruptionBudget
+	Err       error
+}
+
+// RelatedResourcesLoaded is sent when the resources related to a service,
+// deployment or route are known
+type RelatedResourcesLoaded struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Relations []resources.Relation
+	Err       error
+}
+
+// OwnerChainLoaded is sent when the ownership chain of the object shown in
+// the owner tree is known
+type OwnerChainLoaded struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Chain     []resources.OwnerLink
+	Err       error
+}
+
+// CascadePlanLoaded is sent when the dependents a delete would remove are known
+type CascadePlanLoaded struct {
+	Kind string
+	Name string
+	Plan *resources.DependentTree
+	Err  error
+}
+
+// FieldOwnershipLoaded is sent when the field managers of a resource are known
+type FieldOwnershipLoaded struct {
+	Kind      string
+	Name      string
+	Ownership *resources.FieldOwnership
+	Err       error
+}
+
+// ServiceTopologyLoaded is sent when the topology of a service is known
+type ServiceTopologyLoaded struct {
+	Namespace string
+	Name      string
+	Topology  *resources.ServiceTopology
+	Err       error
+}
+
+// PersistentVolumeClaimsLoaded is sent when PersistentVolumeClaims are successfully loaded
+type PersistentVolumeClaimsLoaded struct {
+	Claims []resources.PersistentVolumeClaimInfo
+}
+
+// PersistentVolumeClaimsLoadError is sent when loading PersistentVolumeClaims fails
+type PersistentVolumeClaimsLoadError struct {
+	Err error
+}
+
+// PersistentVolumesLoaded is sent when PersistentVolumes are successfully loaded
+type PersistentVolumesLoaded struct {
+	Volumes []resources.PersistentVolumeInfo
+}
+
+// PersistentVolumesLoadError is sent when loading PersistentVolumes fails
+type PersistentVolumesLoadError struct {
+	Err error
+}
+
+// ScalingEventsLoaded is sent when the cluster autoscaler events are loaded
+type ScalingEventsLoaded struct {
+	Events []resources.EventInfo
+}
+
+// ScalingEventsLoadError is sent when loading the cluster autoscaler events fails
+type ScalingEventsLoadError struct {
+	Err error
+}
+
+// MachinesLoaded is sent when the MachineSets and Machines of the cluster are loaded
+type MachinesLoaded struct {
+	MachineSets []resources.MachineSetInfo
+	Machines    []resources.MachineInfo
+}
+
+// MachinesLoadError is sent when loading MachineSets or Machines fails
+type MachinesLoadError struct {
+	Err error
+}
+
+// StorageClassesLoaded is sent when StorageClasses are successfully loaded
+type StorageClassesLoaded struct {
+	StorageClasses []resources.StorageClassInfo
+}
+
+// StorageClassesLoadError is sent when loading StorageClasses fails
+type StorageClassesLoadError struct {
+	Err error
+}
+
+// IngressesLoaded is sent when Ingresses are successfully loaded
+type IngressesLoaded struct {
+	Ingresses []resources.IngressInfo
+}
+
+// IngressesLoadError is sent when loading Ingresses fails
+type IngressesLoadError struct {
+	Err error
+}
+
+// NetworkPoliciesLoaded is sent when NetworkPolicies are successfully loaded
+type NetworkPoliciesLoaded struct {
+	NetworkPolicies []resources.NetworkPolicyInfo
+}
+
+// NetworkPoliciesLoadError is sent when loading NetworkPolicies fails
+type NetworkPoliciesLoadError struct {
+	Err error
+}
+
+// DeploymentConfigsLoaded is sent when DeploymentConfigs are successfully loaded
+type DeploymentConfigsLoaded struct {
+	DeploymentConfigs []resources.DeploymentConfigInfo
+}
+
+// DeploymentConfigsLoadError is sent when loading DeploymentConfigs fails
+type DeploymentConfigsLoadError struct {
+	Err error
+}
+
+// ImageReferencesChecked is sent when the ImageStreamTags referenced by the
+// loaded BuildConfigs, Deployments and DeploymentConfigs were checked
+type ImageReferencesChecked struct {
+	Scope    string // Cluster and namespace the lists were loaded from
+	Dangling []resources.DanglingImageReference
+}
+
+// ImageReferencesCheckError is sent when the ImageStreams could not be listed
+type ImageReferencesCheckError struct {
+	Err error
+}
+
+// RouteHostsChecked is sent when the hosts of the loaded Routes were compared
+// with the Routes of every accessible namespace
+type RouteHostsChecked struct {
+	Scope     string                           // Cluster and namespace the Routes were loaded from
+	Conflicts map[string][]resources.RouteInfo // By namespace/name, see resources.RouteHostConflicts
+}
+
+// RouteHostsCheckError is sent when no Routes could be listed for the check
+type RouteHostsCheckError struct {
+	Err error
+}
+
+// StartupChecksCompleted is sent when the startup checks of a cluster ran
+type StartupChecksCompleted struct {
+	Context string // Context the checks ran against
+	Results []resources.StartupCheckResult
+	Manual  bool // Run on request rather than on connect
+}
+
+// URLHealthChecked is sent when the health check of a route or ingress URL
+// got a response or failed
+type URLHealthChecked struct {
+	Key        string // Kind/namespace/name of the route or ingress
+	URL        string
+	Status     string // e.g. "200 OK"
+	StatusCode int
+	Latency    time.Duration
+	Untrusted  bool // The response was only received without verifying the certificate
+	Err        error
+}
+
+// ImageInventoryLoaded is sent when the image inventory report is loaded
+type ImageInventoryLoaded struct {
+	Images        []resources.ImageUsage
+	AllNamespaces bool
+}
+
+// ImageInventoryLoadError is sent when loading the image inventory fails
+type ImageInventoryLoadError struct {
+	Err error
+}
+
+// ObjectCountsLoaded is sent when the object count report is loaded
+type ObjectCountsLoaded struct {
+	Counts        []resources.ObjectCount
+	Stored        []resources.StoredObjectCount // etcd object counts, when readable
+	AllNamespaces bool
+}
+
+// ObjectCountsLoadError is sent when loading the object count report fails
+type ObjectCountsLoadError struct {
+	Err error
+}
+
+// PodUsageLoaded is sent with a sample of container usage from the metrics API
+type PodUsageLoaded struct {
+	Namespace string
+	Usage     []resources.ContainerUsage
+}
+
+// PodUsageLoadError is sent when container usage cannot be sampled
+type PodUsageLoadError struct {
+	Err error
+}
+
+// PostmortemSaved is sent when the evidence of a failing pod has been saved
+type PostmortemSaved struct {
+	Pod    string
+	Reason string
+	Path   string
+	Err    error
+}
+
+// TopRefreshTick triggers the next usage sample of the Top view
+type TopRefreshTick struct{}
+
+// NodeUsageLoaded is sent with a sample of node usage from the metrics API
+type NodeUsageLoaded struct {
+	Usage []resources.NodeUsage
+}
+
+// NodeUsageLoadError is sent when node usage cannot be sampled
+type NodeUsageLoadError struct {
+	Err error
+}
+
+// TabSummariesUpdated is sent when the watched object counts of the tab
+// labels change
+type TabSummariesUpdated struct {
+	Namespace string
+	Summaries []resources.TabSummary
+}
+
+// ClusterOperatorsLoaded is sent when the ClusterOperators were listed
+type ClusterOperatorsLoaded struct {
+	Operators []resources.ClusterOperatorInfo
+	Err       error
+}
+
+// HelmReleasesLoaded is sent when the Helm releases of a namespace were
+// read from their secrets
+type HelmReleasesLoaded struct {
+	Namespace string
+	Releases  []helm.Release
+	Err       error
+}
+
+// HelmHistoryLoaded is sent when the revisions of a Helm release were read
+type HelmHistoryLoaded struct {
+	Name      string
+	Revisions []helm.Release
+	Err       error
+}
+
+// ResourceDiffLoaded is sent when the diff of two resources or revisions was computed
+type ResourceDiffLoaded struct {
+	Title string
+	Diff  string
+	Err   error
+}
+
+// PodFilesLoaded is sent when a directory of a container was listed
+type PodFilesLoaded struct {
+	Pod       string
+	Container string
+	Dir       string
+	Entries   []resources.PodFileEntry
+	Err       error
+}
+
+// DebugShellFinished is sent when the shell in a debug container exits
+type DebugShellFinished struct {
+	Pod       string
+	Container string
+	Err       error
+}
+
+// ProjectQuotasLoaded is sent when the quotas and limit ranges of a
+// project were fetched
+type ProjectQuotasLoaded struct {
+	Name    string
+	Project *projects.ProjectInfo
+	Err     error
+}
+
+// DashboardLoaded is sent when the objects the dashboard of a namespace
+// summarizes were listed
+type DashboardLoaded struct {
+	Namespace   string
+	Version     string
+	OpenShift   bool
+	Pods        []resources.PodInfo
+	Deployments []resources.DeploymentInfo
+	Builds      []resources.BuildInfo
+	Events      []resources.EventInfo
+	Operators   []resources.ClusterOperatorInfo
+
+	// Errors are the sections that could not be listed, e.g. for lack of
+	// permissions
+	Errors []string
+}
+
+// ClusterConnected is sent when the second cluster has been connected
+type ClusterConnected struct {
+	Context        string
+	Namespace      string
+	K8sClient      k8s.Client
+	ResourceClient resources.ResourceClient
+	AuthProvider   auth.AuthProvider
+	Err            error
+}
+
+// ClusterCompareLoaded is sent when a tab has been listed in both clusters
+type ClusterCompareLoaded struct {
+	Tab  int
+	Rows []ClusterCompareRow
+	Err  error
+}
+
+// ClusterCompareRow is a resource as listed in the active and the second cluster
+type ClusterCompareRow struct {
+	Name     string
+	Active   string
+	Peer     string
+	InActive bool
+	InPeer   bool
+}
+
+// Differs reports whether the resource is missing or different in one cluster
+func (r ClusterCompareRow) Differs() bool {
+	return r.InActive != r.InPeer || r.Active != r.Peer
+}
+
+// LoginSucceeded is sent when a login from the form has been saved to the kubeconfig
+type LoginSucceeded struct {
+	KubeconfigPath string
+	Context        string
+	Username       string
+}
+
+// LoginFailed is sent when a login from the form fails
+type LoginFailed struct {
+	Err error
+}
@@ -78,3 +78,8 @@ type LoadPodLogsMsg struct {
 	PodName   string
 	Namespace string
 }
+
+// MacroStep replays the next key of a keyboard macro
+type MacroStep struct {
+	Run int // Replay the step belongs to; steps of a cancelled replay are ignored
+}
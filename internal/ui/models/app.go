@@ -46,6 +46,18 @@ const (
 	TabBuildConfigs
 	TabImageStreams
 	TabRoutes
+	TabBuilds
+	TabEvents
+	TabStatefulSets
+	TabDaemonSets
+	TabReplicaSets
+	TabJobs
+	TabCronJobs
+	TabNodes
+	TabStorage
+	TabIngresses
+	TabNetworkPolicies
+	TabDeploymentConfigs
 )
 
 // App represents the main application model
@@ -166,7 +178,9 @@ func (a *App) NextTab() {
 	// Get all available tabs in order (matching constants.ResourceTabs)
 	tabs := []TabType{
 		TabPods, TabServices, TabDeployments, TabConfigMaps, TabSecrets,
-		TabBuildConfigs, TabImageStreams, TabRoutes,
+		TabBuildConfigs, TabImageStreams, TabRoutes, TabBuilds, TabEvents,
+		TabStatefulSets, TabDaemonSets, TabReplicaSets, TabJobs, TabCronJobs,
+		TabNodes, TabStorage, TabIngresses, TabNetworkPolicies, TabDeploymentConfigs,
 	}
 
 	// Find current tab index and move to next
@@ -185,7 +199,9 @@ func (a *App) PrevTab() {
 	// Get all available tabs in order (matching constants.ResourceTabs)
 	tabs := []TabType{
 		TabPods, TabServices, TabDeployments, TabConfigMaps, TabSecrets,
-		TabBuildConfigs, TabImageStreams, TabRoutes,
+		TabBuildConfigs, TabImageStreams, TabRoutes, TabBuilds, TabEvents,
+		TabStatefulSets, TabDaemonSets, TabReplicaSets, TabJobs, TabCronJobs,
+		TabNodes, TabStorage, TabIngresses, TabNetworkPolicies, TabDeploymentConfigs,
 	}
 
 	// Find current tab index and move to previous
@@ -219,6 +235,30 @@ func (a *App) GetTabName(tab TabType) string {
 		return "ImageStreams"
 	case TabRoutes:
 		return "Routes"
+	case TabBuilds:
+		return "Builds"
+	case TabEvents:
+		return "Events"
+	case TabStatefulSets:
+		return "StatefulSets"
+	case TabDaemonSets:
+		return "DaemonSets"
+	case TabReplicaSets:
+		return "ReplicaSets"
+	case TabJobs:
+		return "Jobs"
+	case TabCronJobs:
+		return "CronJobs"
+	case TabNodes:
+		return "Nodes"
+	case TabStorage:
+		return "Storage"
+	case TabIngresses:
+		return "Ingresses"
+	case TabNetworkPolicies:
+		return "NetworkPolicies"
+	case TabDeploymentConfigs:
+		return "DeploymentConfigs"
 	default:
 		return "Unknown"
 	}
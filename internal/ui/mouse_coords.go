@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"github.com/charmbracelet/lipgloss"
+
 	"github.com/katyella/lazyoc/internal/constants"
 	"github.com/katyella/lazyoc/internal/logging"
 )
@@ -78,15 +80,13 @@ func (m *MouseCoordinator) GetClickTarget(x, y int) ClickTarget {
 
 // calculateTabIndex determines which tab was clicked based on x coordinate
 func (m *MouseCoordinator) calculateTabIndex(x int) int {
-	tabs := constants.ResourceTabs
-
 	// Calculate actual tab positions accounting for padding and centering
 	var tabWidths []int
 	totalTabsWidth := 0
 
-	for _, tab := range tabs {
-		// Each tab has padding of 1 on each side, so width = len(name) + 2
-		tabWidth := len(tab) + 2
+	for _, tab := range m.tui.tabLabels() {
+		// Each tab has padding of 1 on each side, so width = label width + 2
+		tabWidth := lipgloss.Width(tab) + 2
 		tabWidths = append(tabWidths, tabWidth)
 		totalTabsWidth += tabWidth
 	}
@@ -191,6 +191,30 @@ func (m *MouseCoordinator) isValidResourceClick(resourceIndex int) bool {
 		return resourceIndex >= 0 && resourceIndex < len(m.tui.imageStreams)
 	case 7: // Routes
 		return resourceIndex >= 0 && resourceIndex < len(m.tui.routes)
+	case 8: // Builds
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.builds)
+	case 9: // Events
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.events)
+	case 10: // StatefulSets
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.statefulSets)
+	case 11: // DaemonSets
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.daemonSets)
+	case 12: // ReplicaSets
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.replicaSets)
+	case 13: // Jobs
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.jobs)
+	case 14: // CronJobs
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.cronJobs)
+	case 15: // Nodes
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.nodes)
+	case 16: // Storage
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.pvcs)
+	case 17: // Ingresses
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.ingresses)
+	case 18: // NetworkPolicies
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.networkPolicies)
+	case 19: // DeploymentConfigs
+		return resourceIndex >= 0 && resourceIndex < len(m.tui.deploymentConfigs)
 	default:
 		return false
 	}
@@ -28,7 +28,7 @@ func NewMouseHandler(tui *TUI, navigator *Navigator, focusManager *FocusManager,
 // Handle processes mouse events
 func (m *MouseHandler) Handle(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	// Ignore mouse events in modal states
-	if m.tui.showHelp || m.tui.showErrorModal || m.tui.showProjectModal || m.tui.showSecretModal {
+	if m.tui.showHelp || m.tui.showErrorModal || m.tui.showProjectModal || m.tui.showProjectQuotas || m.tui.showDashboard || m.tui.showClusterOperators || m.tui.showHelm || m.tui.showSecretModal || m.tui.showSecretForm || m.tui.showConfigMapModal || m.tui.showControlPlaneModal || m.tui.showImageReport || m.tui.showObjectReport || m.tui.showClusterStorage || m.tui.showMachines || m.tui.showTop || m.tui.showNotifications || m.tui.showBatch || m.tui.showClusterInfo || m.tui.showStartupChecks || m.tui.showProfilePicker || m.tui.showRelatedPicker || m.tui.showTLSError || m.tui.showDeletePodModal || m.tui.showCascadeDelete || m.tui.showTopology || m.tui.showNodeActionModal || m.tui.showRollbackModal || m.tui.showDiffReview || m.tui.showViewPicker || m.tui.showContainerPicker || m.tui.showLogExport || m.tui.showReloginPrompt || m.tui.showPodFiles || m.tui.showDebugContainer || m.tui.showApplyPicker || m.tui.showApplyResults || m.tui.showViewForm || m.tui.showLoginForm || m.tui.showSettings || m.tui.showYAMLView || m.tui.showResourceDiff || m.tui.showClusterPicker || m.tui.showClusterCompare || m.tui.showTaskPanel {
 		return m.tui, nil
 	}
 
@@ -134,7 +134,7 @@ func (m *MouseHandler) handleResourceClick(resourceIndex int) (tea.Model, tea.Cm
 		return m.tui, m.tui.startPodLogStream()
 	}
 	
-	return m.tui, m.tui.loadPodLogs()
+	return m.tui, nil
 }
 
 // handlePanelClick processes clicks that change panel focus
@@ -163,8 +163,7 @@ func (m *MouseHandler) handleMouseWheel(direction int, x, y int) (tea.Model, tea
 	
 	case 1: // Details panel - scroll details content
 		if m.tui.showDetails {
-			logging.Debug(m.tui.Logger, "MouseHandler: wheel in details panel (not implemented)")
-			// Details scrolling can be implemented later
+			m.tui.scrollDetails(direction)
 		}
 	
 	case 2: // Logs panel - scroll logs
@@ -190,7 +189,7 @@ func (m *MouseHandler) handleResourceListScroll(direction int) (tea.Model, tea.C
 		return m.tui, m.tui.startPodLogStream()
 	}
 	
-	return m.tui, m.tui.loadPodLogs()
+	return m.tui, nil
 }
 
 // handleLogScroll scrolls through log content
@@ -249,6 +248,30 @@ func (m *MouseHandler) getCurrentSelectedIndex() int {
 		return m.tui.selectedImageStream
 	case 7: // Routes
 		return m.tui.selectedRoute
+	case 8: // Builds
+		return m.tui.selectedBuild
+	case 9: // Events
+		return m.tui.selectedEvent
+	case 10: // StatefulSets
+		return m.tui.selectedStatefulSet
+	case 11: // DaemonSets
+		return m.tui.selectedDaemonSet
+	case 12: // ReplicaSets
+		return m.tui.selectedReplicaSet
+	case 13: // Jobs
+		return m.tui.selectedJob
+	case 14: // CronJobs
+		return m.tui.selectedCronJob
+	case 15: // Nodes
+		return m.tui.selectedNode
+	case 16: // Storage
+		return m.tui.selectedPVC
+	case 17: // Ingresses
+		return m.tui.selectedIngress
+	case 18: // NetworkPolicies
+		return m.tui.selectedNetworkPolicy
+	case 19: // DeploymentConfigs
+		return m.tui.selectedDeploymentConfig
 	default:
 		return 0
 	}
@@ -79,6 +79,78 @@ func (n *Navigator) SelectResource(index int) {
 			n.tui.updateRouteDisplay()
 			logging.Debug(n.tui.Logger, "Selected route %d", index)
 		}
+	case models.TabBuilds:
+		if index >= 0 && index < len(n.tui.builds) {
+			n.tui.selectedBuild = index
+			n.tui.updateBuildDisplay()
+			logging.Debug(n.tui.Logger, "Selected build %d", index)
+		}
+	case models.TabEvents:
+		if index >= 0 && index < len(n.tui.events) {
+			n.tui.selectedEvent = index
+			n.tui.updateEventDisplay()
+			logging.Debug(n.tui.Logger, "Selected event %d", index)
+		}
+	case models.TabStatefulSets:
+		if index >= 0 && index < len(n.tui.statefulSets) {
+			n.tui.selectedStatefulSet = index
+			n.tui.updateStatefulSetDisplay()
+			logging.Debug(n.tui.Logger, "Selected statefulset %d", index)
+		}
+	case models.TabDaemonSets:
+		if index >= 0 && index < len(n.tui.daemonSets) {
+			n.tui.selectedDaemonSet = index
+			n.tui.updateDaemonSetDisplay()
+			logging.Debug(n.tui.Logger, "Selected daemonset %d", index)
+		}
+	case models.TabReplicaSets:
+		if index >= 0 && index < len(n.tui.replicaSets) {
+			n.tui.selectedReplicaSet = index
+			n.tui.updateReplicaSetDisplay()
+			logging.Debug(n.tui.Logger, "Selected replicaset %d", index)
+		}
+	case models.TabJobs:
+		if index >= 0 && index < len(n.tui.jobs) {
+			n.tui.selectedJob = index
+			n.tui.updateJobDisplay()
+			logging.Debug(n.tui.Logger, "Selected job %d", index)
+		}
+	case models.TabCronJobs:
+		if index >= 0 && index < len(n.tui.cronJobs) {
+			n.tui.selectedCronJob = index
+			n.tui.updateCronJobDisplay()
+			logging.Debug(n.tui.Logger, "Selected cronjob %d", index)
+		}
+	case models.TabNodes:
+		if index >= 0 && index < len(n.tui.nodes) {
+			n.tui.selectedNode = index
+			n.tui.updateNodeDisplay()
+			logging.Debug(n.tui.Logger, "Selected node %d", index)
+		}
+	case models.TabStorage:
+		if index >= 0 && index < len(n.tui.pvcs) {
+			n.tui.selectedPVC = index
+			n.tui.updatePVCDisplay()
+			logging.Debug(n.tui.Logger, "Selected persistentvolumeclaim %d", index)
+		}
+	case models.TabIngresses:
+		if index >= 0 && index < len(n.tui.ingresses) {
+			n.tui.selectedIngress = index
+			n.tui.updateIngressDisplay()
+			logging.Debug(n.tui.Logger, "Selected ingress %d", index)
+		}
+	case models.TabNetworkPolicies:
+		if index >= 0 && index < len(n.tui.networkPolicies) {
+			n.tui.selectedNetworkPolicy = index
+			n.tui.updateNetworkPolicyDisplay()
+			logging.Debug(n.tui.Logger, "Selected networkpolicy %d", index)
+		}
+	case models.TabDeploymentConfigs:
+		if index >= 0 && index < len(n.tui.deploymentConfigs) {
+			n.tui.selectedDeploymentConfig = index
+			n.tui.updateDeploymentConfigDisplay()
+			logging.Debug(n.tui.Logger, "Selected deploymentconfig %d", index)
+		}
 	}
 }
 
@@ -101,6 +173,30 @@ func (n *Navigator) moveResourceSelection(delta int) {
 		n.moveImageStreamSelection(delta)
 	case models.TabRoutes:
 		n.moveRouteSelection(delta)
+	case models.TabBuilds:
+		n.moveBuildSelection(delta)
+	case models.TabEvents:
+		n.moveEventSelection(delta)
+	case models.TabStatefulSets:
+		n.moveStatefulSetSelection(delta)
+	case models.TabDaemonSets:
+		n.moveDaemonSetSelection(delta)
+	case models.TabReplicaSets:
+		n.moveReplicaSetSelection(delta)
+	case models.TabJobs:
+		n.moveJobSelection(delta)
+	case models.TabCronJobs:
+		n.moveCronJobSelection(delta)
+	case models.TabNodes:
+		n.moveNodeSelection(delta)
+	case models.TabStorage:
+		n.movePVCSelection(delta)
+	case models.TabIngresses:
+		n.moveIngressSelection(delta)
+	case models.TabNetworkPolicies:
+		n.moveNetworkPolicySelection(delta)
+	case models.TabDeploymentConfigs:
+		n.moveDeploymentConfigSelection(delta)
 	}
 }
 
@@ -249,4 +345,219 @@ func (n *Navigator) moveRouteSelection(delta int) {
 		}
 	}
 	n.tui.updateRouteDisplay()
-}
\ No newline at end of file
+}
+func (n *Navigator) moveBuildSelection(delta int) {
+	if len(n.tui.builds) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedBuild + delta
+	if delta > 0 {
+		n.tui.selectedBuild = (newIndex) % len(n.tui.builds)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedBuild = len(n.tui.builds) - 1
+		} else {
+			n.tui.selectedBuild = newIndex
+		}
+	}
+	n.tui.updateBuildDisplay()
+}
+
+func (n *Navigator) moveEventSelection(delta int) {
+	if len(n.tui.events) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedEvent + delta
+	if delta > 0 {
+		n.tui.selectedEvent = (newIndex) % len(n.tui.events)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedEvent = len(n.tui.events) - 1
+		} else {
+			n.tui.selectedEvent = newIndex
+		}
+	}
+	n.tui.updateEventDisplay()
+}
+
+func (n *Navigator) moveStatefulSetSelection(delta int) {
+	if len(n.tui.statefulSets) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedStatefulSet + delta
+	if delta > 0 {
+		n.tui.selectedStatefulSet = (newIndex) % len(n.tui.statefulSets)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedStatefulSet = len(n.tui.statefulSets) - 1
+		} else {
+			n.tui.selectedStatefulSet = newIndex
+		}
+	}
+	n.tui.updateStatefulSetDisplay()
+}
+
+func (n *Navigator) moveDaemonSetSelection(delta int) {
+	if len(n.tui.daemonSets) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedDaemonSet + delta
+	if delta > 0 {
+		n.tui.selectedDaemonSet = (newIndex) % len(n.tui.daemonSets)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedDaemonSet = len(n.tui.daemonSets) - 1
+		} else {
+			n.tui.selectedDaemonSet = newIndex
+		}
+	}
+	n.tui.updateDaemonSetDisplay()
+}
+
+func (n *Navigator) moveReplicaSetSelection(delta int) {
+	if len(n.tui.replicaSets) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedReplicaSet + delta
+	if delta > 0 {
+		n.tui.selectedReplicaSet = (newIndex) % len(n.tui.replicaSets)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedReplicaSet = len(n.tui.replicaSets) - 1
+		} else {
+			n.tui.selectedReplicaSet = newIndex
+		}
+	}
+	n.tui.updateReplicaSetDisplay()
+}
+
+func (n *Navigator) moveJobSelection(delta int) {
+	if len(n.tui.jobs) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedJob + delta
+	if delta > 0 {
+		n.tui.selectedJob = (newIndex) % len(n.tui.jobs)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedJob = len(n.tui.jobs) - 1
+		} else {
+			n.tui.selectedJob = newIndex
+		}
+	}
+	n.tui.updateJobDisplay()
+}
+
+func (n *Navigator) moveCronJobSelection(delta int) {
+	if len(n.tui.cronJobs) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedCronJob + delta
+	if delta > 0 {
+		n.tui.selectedCronJob = (newIndex) % len(n.tui.cronJobs)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedCronJob = len(n.tui.cronJobs) - 1
+		} else {
+			n.tui.selectedCronJob = newIndex
+		}
+	}
+	n.tui.updateCronJobDisplay()
+}
+
+func (n *Navigator) moveNodeSelection(delta int) {
+	if len(n.tui.nodes) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedNode + delta
+	if delta > 0 {
+		n.tui.selectedNode = (newIndex) % len(n.tui.nodes)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedNode = len(n.tui.nodes) - 1
+		} else {
+			n.tui.selectedNode = newIndex
+		}
+	}
+	n.tui.updateNodeDisplay()
+}
+
+func (n *Navigator) movePVCSelection(delta int) {
+	if len(n.tui.pvcs) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedPVC + delta
+	if delta > 0 {
+		n.tui.selectedPVC = (newIndex) % len(n.tui.pvcs)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedPVC = len(n.tui.pvcs) - 1
+		} else {
+			n.tui.selectedPVC = newIndex
+		}
+	}
+	n.tui.updatePVCDisplay()
+}
+
+func (n *Navigator) moveIngressSelection(delta int) {
+	if len(n.tui.ingresses) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedIngress + delta
+	if delta > 0 {
+		n.tui.selectedIngress = (newIndex) % len(n.tui.ingresses)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedIngress = len(n.tui.ingresses) - 1
+		} else {
+			n.tui.selectedIngress = newIndex
+		}
+	}
+	n.tui.updateIngressDisplay()
+}
+
+func (n *Navigator) moveNetworkPolicySelection(delta int) {
+	if len(n.tui.networkPolicies) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedNetworkPolicy + delta
+	if delta > 0 {
+		n.tui.selectedNetworkPolicy = (newIndex) % len(n.tui.networkPolicies)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedNetworkPolicy = len(n.tui.networkPolicies) - 1
+		} else {
+			n.tui.selectedNetworkPolicy = newIndex
+		}
+	}
+	n.tui.updateNetworkPolicyDisplay()
+}
+
+func (n *Navigator) moveDeploymentConfigSelection(delta int) {
+	if len(n.tui.deploymentConfigs) == 0 {
+		return
+	}
+
+	newIndex := n.tui.selectedDeploymentConfig + delta
+	if delta > 0 {
+		n.tui.selectedDeploymentConfig = (newIndex) % len(n.tui.deploymentConfigs)
+	} else {
+		if newIndex < 0 {
+			n.tui.selectedDeploymentConfig = len(n.tui.deploymentConfigs) - 1
+		} else {
+			n.tui.selectedDeploymentConfig = newIndex
+		}
+	}
+	n.tui.updateDeploymentConfigDisplay()
+}
@@ -0,0 +1,307 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadIngresses loads the ingresses of the current namespace
+func (t *TUI) loadIngresses() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.IngressesLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		list, err := loadAllPages(t, "ingresses", t.listOptions(), t.resourceClient.ListIngresses)
+		if err != nil {
+			return messages.IngressesLoadError{Err: err}
+		}
+
+		return messages.IngressesLoaded{Ingresses: list.Items}
+	}
+}
+
+// loadNetworkPolicies loads the networkpolicies of the current namespace
+func (t *TUI) loadNetworkPolicies() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.NetworkPoliciesLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		list, err := loadAllPages(t, "networkpolicies", t.listOptions(), t.resourceClient.ListNetworkPolicies)
+		if err != nil {
+			return messages.NetworkPoliciesLoadError{Err: err}
+		}
+
+		return messages.NetworkPoliciesLoaded{NetworkPolicies: list.Items}
+	}
+}
+
+// ingressHost formats a rule host, an empty host matches every host
+func ingressHost(host string) string {
+	if host == "" {
+		return "*"
+	}
+	return host
+}
+
+// ingressSummary returns the host, path and backend of the first rule of an
+// ingress, noting how many more rules it has
+func ingressSummary(ing resources.IngressInfo) (host, path, backend string) {
+	if len(ing.Rules) == 0 {
+		return "*", "", ing.DefaultBackend
+	}
+
+	rule := ing.Rules[0]
+	host = ingressHost(rule.Host)
+	if len(ing.Rules) > 1 {
+		host = fmt.Sprintf("%s (+%d)", host, len(ing.Rules)-1)
+	}
+	return host, rule.Path, rule.Backend
+}
+
+// ingressTLS reports whether a host is served over TLS
+func ingressTLS(ing resources.IngressInfo, host string) bool {
+	for _, tls := range ing.TLS {
+		if slices.Contains(tls.Hosts, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateIngressDisplay updates the main content with ingress information
+func (t *TUI) updateIngressDisplay() {
+	if t.loadingIngresses {
+		t.mainContent = "🌐 Ingresses\n\nLoading Ingresses..."
+		return
+	}
+
+	if len(t.ingresses) == 0 {
+		t.mainContent = "🌐 Ingresses\n\nNo Ingresses found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🌐 Ingresses\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-25s %-35s %-15s %-25s %-5s %s", "NAME", "HOST", "PATH", "BACKEND", "TLS", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 115))
+	content.WriteString("\n")
+
+	// Ingress rows
+	start, end := t.listWindow(t.selectedIngress, len(t.ingresses))
+	for i := start; i < end; i++ {
+		ing := t.ingresses[i]
+		style := lipgloss.NewStyle()
+		if ing.Status == "Pending" {
+			style = style.Foreground(lipgloss.Color("214"))
+		}
+		if i == t.selectedIngress {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		tlsStatus := "None"
+		if len(ing.TLS) > 0 {
+			tlsStatus = "🔒"
+		}
+
+		host, path, backend := ingressSummary(ing)
+		row := t.namespaceCell(ing.Namespace) + fmt.Sprintf("%-25s %-35s %-15s %-25s %-5s %s",
+			truncateString(ing.Name, 25),
+			truncateString(host, 35),
+			truncateString(path, 15),
+			truncateString(backend, 25),
+			tlsStatus,
+			t.formatTime(ing.CreatedAt, ing.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.ingresses)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected Ingress info
+	if t.selectedIngress < len(t.ingresses) && t.selectedIngress >= 0 {
+		t.updateIngressDetails(t.ingresses[t.selectedIngress])
+	}
+}
+
+// updateIngressDetails updates the detail pane with Ingress information
+func (t *TUI) updateIngressDetails(ing resources.IngressInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🌐 Ingress Details: %s\n\n", ing.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", ing.Namespace))
+	details.WriteString(fmt.Sprintf("Status:       %s\n", ing.Status))
+	if ing.IngressClass != "" {
+		details.WriteString(fmt.Sprintf("Class:        %s\n", ing.IngressClass))
+	}
+	if len(ing.Addresses) > 0 {
+		details.WriteString(fmt.Sprintf("Address:      %s\n", strings.Join(ing.Addresses, ", ")))
+	} else {
+		details.WriteString("Address:      none yet, no ingress controller has admitted it\n")
+	}
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(ing.CreatedAt, ing.Age)))
+
+	// Rules
+	if len(ing.Rules) > 0 {
+		details.WriteString("\nRules:\n")
+		for _, rule := range ing.Rules {
+			scheme := "http"
+			if ingressTLS(ing, rule.Host) {
+				scheme = "https"
+			}
+			path := rule.Path
+			if path == "" {
+				path = "/"
+			}
+			details.WriteString(fmt.Sprintf("  %s://%s%s → %s\n", scheme, ingressHost(rule.Host), path, rule.Backend))
+			if rule.PathType != "" {
+				details.WriteString(fmt.Sprintf("    Path type: %s\n", rule.PathType))
+			}
+		}
+	}
+
+	if ing.DefaultBackend != "" {
+		details.WriteString(fmt.Sprintf("\nDefault Backend: %s\n", ing.DefaultBackend))
+	}
+
+	// TLS information
+	if len(ing.TLS) > 0 {
+		details.WriteString("\nTLS:\n")
+		for _, tls := range ing.TLS {
+			secret := tls.SecretName
+			if secret == "" {
+				secret = "controller default certificate"
+			}
+			details.WriteString(fmt.Sprintf("  %s (%s)\n", strings.Join(tls.Hosts, ", "), secret))
+		}
+	} else {
+		details.WriteString("\nTLS:          None\n")
+	}
+	details.WriteString(t.renderURLHealthCheck(urlCheckKey("Ingress", ing.Namespace, ing.Name)))
+
+	writeWorkloadMetadata(&details, nil, ing.Labels)
+	details.WriteString(t.renderRelatedEvents("Ingress", ing.Name))
+
+	t.detailContent = details.String()
+}
+
+// networkPolicyRuleCount summarizes the rules of one direction for the list:
+// a direction the policy covers without rules denies all traffic
+func networkPolicyRuleCount(np resources.NetworkPolicyInfo, policyType string, rules []string) string {
+	switch {
+	case !slices.Contains(np.PolicyTypes, policyType):
+		return "-"
+	case len(rules) == 0:
+		return "deny all"
+	}
+	return fmt.Sprintf("%d rule(s)", len(rules))
+}
+
+// updateNetworkPolicyDisplay updates the main content with networkpolicy information
+func (t *TUI) updateNetworkPolicyDisplay() {
+	if t.loadingNetworkPolicies {
+		t.mainContent = "🛡️ NetworkPolicies\n\nLoading NetworkPolicies..."
+		return
+	}
+
+	if len(t.networkPolicies) == 0 {
+		t.mainContent = "🛡️ NetworkPolicies\n\nNo NetworkPolicies found in current namespace, all pod traffic is allowed.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🛡️ NetworkPolicies\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-30s %-15s %-12s %-12s %s", "NAME", "POD SELECTOR", "TYPES", "INGRESS", "EGRESS", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 115))
+	content.WriteString("\n")
+
+	// Policy rows
+	start, end := t.listWindow(t.selectedNetworkPolicy, len(t.networkPolicies))
+	for i := start; i < end; i++ {
+		np := t.networkPolicies[i]
+		style := lipgloss.NewStyle()
+		if i == t.selectedNetworkPolicy {
+			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		row := t.namespaceCell(np.Namespace) + fmt.Sprintf("%-30s %-30s %-15s %-12s %-12s %s",
+			truncateString(np.Name, 30),
+			truncateString(np.PodSelector, 30),
+			strings.Join(np.PolicyTypes, ","),
+			networkPolicyRuleCount(np, "Ingress", np.IngressRules),
+			networkPolicyRuleCount(np, "Egress", np.EgressRules),
+			t.formatTime(np.CreatedAt, np.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.networkPolicies)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected NetworkPolicy info
+	if t.selectedNetworkPolicy < len(t.networkPolicies) && t.selectedNetworkPolicy >= 0 {
+		t.updateNetworkPolicyDetails(t.networkPolicies[t.selectedNetworkPolicy])
+	}
+}
+
+// updateNetworkPolicyDetails updates the detail pane with NetworkPolicy information
+func (t *TUI) updateNetworkPolicyDetails(np resources.NetworkPolicyInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🛡️ NetworkPolicy Details: %s\n\n", np.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", np.Namespace))
+	details.WriteString(fmt.Sprintf("Pods:         %s\n", np.PodSelector))
+	details.WriteString(fmt.Sprintf("Types:        %s\n", strings.Join(np.PolicyTypes, ", ")))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(np.CreatedAt, np.Age)))
+
+	for _, direction := range []struct {
+		policyType string
+		rules      []string
+	}{
+		{"Ingress", np.IngressRules},
+		{"Egress", np.EgressRules},
+	} {
+		if !slices.Contains(np.PolicyTypes, direction.policyType) {
+			continue
+		}
+		details.WriteString(fmt.Sprintf("\n%s:\n", direction.policyType))
+		if len(direction.rules) == 0 {
+			details.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("  All traffic denied") + "\n")
+			continue
+		}
+		for _, rule := range direction.rules {
+			details.WriteString(fmt.Sprintf("  • %s\n", rule))
+		}
+	}
+
+	writeWorkloadMetadata(&details, nil, np.Labels)
+
+	t.detailContent = details.String()
+}
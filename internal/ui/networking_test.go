@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestIngressesTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabIngresses
+
+	tui.Update(messages.IngressesLoaded{Ingresses: []resources.IngressInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "shop", Namespace: "demo", Status: "Active"}, IngressClass: "nginx",
+			Rules: []resources.IngressPath{
+				{Host: "shop.example.com", Path: "/", Backend: "web:8080"},
+				{Host: "api.example.com", Path: "/v1", Backend: "api:http"},
+			},
+			TLS:       []resources.IngressTLS{{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"}},
+			Addresses: []string{"203.0.113.10"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "internal", Namespace: "demo", Status: "Pending"}, DefaultBackend: "admin:80"},
+	}})
+
+	if !strings.Contains(tui.mainContent, "shop.example.com (+1)") || !strings.Contains(tui.mainContent, "web:8080") {
+		t.Errorf("Expected host, path and backend columns, got %q", tui.mainContent)
+	}
+	for _, want := range []string{"Ingress Details: shop", "https://shop.example.com/ → web:8080", "http://api.example.com/v1 → api:http", "shop-tls"} {
+		if !strings.Contains(tui.detailContent, want) {
+			t.Errorf("Expected ingress details to contain %q, got %q", want, tui.detailContent)
+		}
+	}
+
+	NewNavigator(tui).moveResourceSelection(1)
+	if !strings.Contains(tui.detailContent, "no ingress controller has admitted it") || !strings.Contains(tui.detailContent, "Default Backend: admin:80") {
+		t.Errorf("Expected the pending ingress details, got %q", tui.detailContent)
+	}
+
+	ref, ok := tui.selectedResource()
+	if !ok || ref.Kind != "Ingress" || ref.Name != "internal" {
+		t.Errorf("Expected Ingress/internal as the YAML target, got %+v", ref)
+	}
+}
+
+func TestNetworkPoliciesTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabNetworkPolicies
+
+	tui.Update(messages.NetworkPoliciesLoaded{NetworkPolicies: []resources.NetworkPolicyInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "default-deny", Namespace: "demo"}, PodSelector: "(all)", PolicyTypes: []string{"Ingress"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "allow-web", Namespace: "demo"}, PodSelector: "app=api", PolicyTypes: []string{"Ingress"},
+			IngressRules: []string{"from pods app=web; ports TCP/8080"}},
+	}})
+
+	if !strings.Contains(tui.mainContent, "deny all") || !strings.Contains(tui.mainContent, "1 rule(s)") {
+		t.Errorf("Expected rule summaries in the table, got %q", tui.mainContent)
+	}
+	if !strings.Contains(tui.detailContent, "All traffic denied") || strings.Contains(tui.detailContent, "Egress:") {
+		t.Errorf("Expected the deny-all ingress details, got %q", tui.detailContent)
+	}
+
+	NewNavigator(tui).moveResourceSelection(1)
+	if !strings.Contains(tui.detailContent, "from pods app=web; ports TCP/8080") {
+		t.Errorf("Expected the rules of allow-web, got %q", tui.detailContent)
+	}
+}
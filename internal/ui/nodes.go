@@ -0,0 +1,417 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// Node actions confirmed in the node action modal
+const (
+	nodeActionCordon   = "cordon"
+	nodeActionUncordon = "uncordon"
+	nodeActionDrain    = "drain"
+)
+
+// loadNodes loads the nodes of the cluster
+func (t *TUI) loadNodes() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.NodesLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		list, err := loadAllPages(t, "nodes", resources.ListOptions{}, t.resourceClient.ListNodes)
+		if err != nil {
+			return messages.NodesLoadError{Err: err}
+		}
+
+		return messages.NodesLoaded{Nodes: list.Items}
+	}
+}
+
+// currentNode returns the selected node, if any
+func (t *TUI) currentNode() (resources.NodeInfo, bool) {
+	if t.selectedNode < 0 || t.selectedNode >= len(t.nodes) {
+		return resources.NodeInfo{}, false
+	}
+	return t.nodes[t.selectedNode], true
+}
+
+// openNodeCordonModal asks for confirmation before cordoning the selected
+// node, or uncordoning it when it is already unschedulable
+func (t *TUI) openNodeCordonModal() {
+	node, ok := t.currentNode()
+	if t.ActiveTab != models.TabNodes || !ok || !t.connected {
+		return
+	}
+
+	t.nodeAction = nodeActionCordon
+	if node.Unschedulable {
+		t.nodeAction = nodeActionUncordon
+	}
+	t.nodeActionName = node.Name
+	t.showNodeActionModal = true
+}
+
+// openNodeDrainModal asks for confirmation before draining the selected node
+// and loads the pods the drain would evict
+func (t *TUI) openNodeDrainModal() tea.Cmd {
+	node, ok := t.currentNode()
+	if t.ActiveTab != models.TabNodes || !ok || !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	t.nodeAction = nodeActionDrain
+	t.nodeActionName = node.Name
+	t.nodeDrainPlan = nil
+	t.nodeDrainPlanErr = nil
+	t.nodeConfirmInput = ""
+	t.loadingDrainPlan = true
+	t.showNodeActionModal = true
+
+	client := t.resourceClient
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		plan, err := client.PlanNodeDrain(ctx, node.Name)
+		return messages.NodeDrainPlanLoaded{Node: node.Name, Plan: plan, Err: err}
+	}
+}
+
+// handleNodeDrainPlanLoaded shows the drain plan in the confirmation modal
+func (t *TUI) handleNodeDrainPlanLoaded(msg messages.NodeDrainPlanLoaded) {
+	if !t.showNodeActionModal || t.nodeActionName != msg.Node {
+		return
+	}
+	t.loadingDrainPlan = false
+	t.nodeDrainPlan = msg.Plan
+	t.nodeDrainPlanErr = msg.Err
+}
+
+// closeNodeActionModal dismisses the node action modal
+func (t *TUI) closeNodeActionModal() {
+	t.showNodeActionModal = false
+	t.nodeAction = ""
+	t.nodeActionName = ""
+	t.nodeDrainPlan = nil
+	t.nodeDrainPlanErr = nil
+	t.loadingDrainPlan = false
+	t.nodeConfirmInput = ""
+}
+
+// canConfirmDrain reports whether the drain may start: the plan is loaded,
+// no pod blocks it and the node name was typed
+func (t *TUI) canConfirmDrain() bool {
+	return t.nodeDrainPlan != nil && len(t.nodeDrainPlan.Blocked) == 0 && len(t.nodeDrainPlan.LocalData) == 0 && t.nodeConfirmInput == t.nodeActionName
+}
+
+// setNodeUnschedulable cordons or uncordons a node as a background task
+func (t *TUI) setNodeUnschedulable(name string, unschedulable bool) tea.Cmd {
+	if !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	action, done := "Cordon", "Cordoned"
+	if !unschedulable {
+		action, done = "Uncordon", "Uncordoned"
+	}
+
+	client := t.resourceClient
+	return t.runTask(fmt.Sprintf("%s node %s", action, name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return client.SetNodeUnschedulable(ctx, name, unschedulable)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				t.handleNodeActionError(strings.ToLower(action), name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "%s node %s", done, name)
+			return t.loadNodes()
+		})
+}
+
+// drainNode cordons a node and evicts its pods as a background task
+func (t *TUI) drainNode(name string) tea.Cmd {
+	if !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	client := t.resourceClient
+	t.logInfo(categoryAction, "Draining node %s...", name)
+	return t.runTask(fmt.Sprintf("Drain node %s", name),
+		func(ctx context.Context, progress func(done, total int)) error {
+			return client.DrainNode(ctx, name, progress)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				t.handleNodeActionError("drain", name, err)
+				return t.loadNodes()
+			}
+			t.logSuccess(categoryAction, "Drained node %s", name)
+			return t.loadNodes()
+		})
+}
+
+// handleNodeActionError reports a failed node action
+func (t *TUI) handleNodeActionError(action, name string, err error) {
+	userError := errors.MapKubernetesError(err)
+	t.errorDisplay.AddError(userError)
+	t.logError(categoryAction, "Failed to %s node %s: %v", action, name, err)
+}
+
+// handleNodeActionModalKeys handles key input for the node action modal.
+// Cordon and uncordon are confirmed with y; a drain needs the node name typed.
+func (t *TUI) handleNodeActionModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.nodeAction != nodeActionDrain {
+		switch msg.String() {
+		case "y", "Y", "enter":
+			name, unschedulable := t.nodeActionName, t.nodeAction == nodeActionCordon
+			t.closeNodeActionModal()
+			return t, t.setNodeUnschedulable(name, unschedulable)
+
+		case "n", "N", "esc", "q":
+			t.closeNodeActionModal()
+		}
+		return t, nil
+	}
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		t.closeNodeActionModal()
+
+	case tea.KeyEnter:
+		if t.canConfirmDrain() {
+			name := t.nodeActionName
+			t.closeNodeActionModal()
+			return t, t.drainNode(name)
+		}
+
+	case tea.KeyBackspace:
+		if len(t.nodeConfirmInput) > 0 {
+			t.nodeConfirmInput = t.nodeConfirmInput[:len(t.nodeConfirmInput)-1]
+		}
+
+	case tea.KeyRunes:
+		t.nodeConfirmInput += string(msg.Runes)
+	}
+	return t, nil
+}
+
+// renderNodeActionModal renders the cordon, uncordon or drain confirmation
+func (t *TUI) renderNodeActionModal() string {
+	modalWidth := min(80, t.width-4)
+	color := lipgloss.Color("214")
+	if t.nodeAction == nodeActionDrain {
+		color = lipgloss.Color("9")
+	}
+
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	title := fmt.Sprintf("🖥️ %s Node", strings.ToUpper(t.nodeAction[:1])+t.nodeAction[1:])
+	content.WriteString(lipgloss.NewStyle().Bold(true).Foreground(color).Render(title) + "\n\n")
+	content.WriteString(fmt.Sprintf("Node: %s\n\n", t.nodeActionName))
+
+	switch t.nodeAction {
+	case nodeActionCordon:
+		content.WriteString("New pods will not be scheduled on this node.\nRunning pods are left in place.\n\n")
+		content.WriteString("y/enter: cordon • n/esc: cancel")
+	case nodeActionUncordon:
+		content.WriteString("The node will accept new pods again.\n\n")
+		content.WriteString("y/enter: uncordon • n/esc: cancel")
+	default:
+		content.WriteString(t.renderDrainPlan(modalWidth - 8))
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// renderDrainPlan describes what the drain will do and how to confirm it
+func (t *TUI) renderDrainPlan(width int) string {
+	var content strings.Builder
+	switch {
+	case t.loadingDrainPlan:
+		content.WriteString(fmt.Sprintf("%s Checking pods on the node...\n\n", t.getLoadingSpinner()))
+		content.WriteString("esc: cancel")
+		return content.String()
+	case t.nodeDrainPlanErr != nil:
+		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("9")).
+			Render(truncateString(t.nodeDrainPlanErr.Error(), width)) + "\n\n")
+		content.WriteString("esc: close")
+		return content.String()
+	}
+
+	plan := t.nodeDrainPlan
+	content.WriteString("The node is cordoned, then its pods are evicted.\nPodDisruptionBudgets are honored.\n\n")
+	content.WriteString(fmt.Sprintf("Evict: %d pod(s)   Skip: %d DaemonSet/static pod(s)\n", len(plan.Evict), len(plan.Skipped)))
+	for i, ref := range plan.Evict {
+		if i == constants.MaxDrainPodsShown {
+			content.WriteString(fmt.Sprintf("  … and %d more\n", len(plan.Evict)-i))
+			break
+		}
+		content.WriteString("  " + truncateString(ref, width-2) + "\n")
+	}
+
+	if len(plan.Blocked) > 0 || len(plan.LocalData) > 0 {
+		blocked := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+		if len(plan.Blocked) > 0 {
+			content.WriteString("\n" + blocked.Render(fmt.Sprintf("Blocked: %d pod(s) have no controller and would be lost:", len(plan.Blocked))) + "\n")
+			for _, ref := range plan.Blocked {
+				content.WriteString(blocked.Render("  "+truncateString(ref, width-2)) + "\n")
+			}
+		}
+		if len(plan.LocalData) > 0 {
+			content.WriteString("\n" + blocked.Render(fmt.Sprintf("Blocked: %d pod(s) have emptyDir volumes whose data would be lost:", len(plan.LocalData))) + "\n")
+			for _, ref := range plan.LocalData {
+				content.WriteString(blocked.Render("  "+truncateString(ref, width-2)) + "\n")
+			}
+		}
+		content.WriteString("\nDelete or move these pods first.\n\nesc: close")
+		return content.String()
+	}
+
+	content.WriteString(fmt.Sprintf("\nType the node name to confirm: %s█\n\n", t.nodeConfirmInput))
+	content.WriteString("enter: drain • esc: cancel")
+	return content.String()
+}
+
+// nodeRowStyle returns the row style for a node status
+func nodeRowStyle(node resources.NodeInfo) lipgloss.Style {
+	switch {
+	case !strings.HasPrefix(node.Status, "Ready"):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	case node.Unschedulable:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	}
+	return lipgloss.NewStyle()
+}
+
+// nodePods formats running pods against pod capacity, e.g. "12/250"
+func nodePods(node resources.NodeInfo) string {
+	count := "?"
+	if node.PodCount >= 0 {
+		count = fmt.Sprintf("%d", node.PodCount)
+	}
+	return fmt.Sprintf("%s/%d", count, node.PodCapacity)
+}
+
+// nodeRoles formats the roles of a node for display
+func nodeRoles(node resources.NodeInfo) string {
+	if len(node.Roles) == 0 {
+		return "<none>"
+	}
+	return strings.Join(node.Roles, ",")
+}
+
+// updateNodeDisplay updates the main content with node information
+func (t *TUI) updateNodeDisplay() {
+	if t.loadingNodes {
+		t.mainContent = "🖥️ Nodes\n\nLoading Nodes..."
+		return
+	}
+
+	if len(t.nodes) == 0 {
+		t.mainContent = "🖥️ Nodes\n\nNo Nodes found. Listing nodes needs cluster-wide read access.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🖥️ Nodes\n\n")
+
+	// Header
+	header := fmt.Sprintf("%-30s %-26s %-16s %-12s %-6s %-8s %-5s %-5s %-9s %s", "NAME", "STATUS", "ROLES", "VERSION", "CPU", "MEMORY", "CPU%", "MEM%", "PODS", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 132))
+	content.WriteString("\n")
+
+	// Node rows
+	start, end := t.listWindow(t.selectedNode, len(t.nodes))
+	for i := start; i < end; i++ {
+		node := t.nodes[i]
+		style := nodeRowStyle(node)
+		if i == t.selectedNode {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		cpuUsage, memoryUsage := t.nodeUsageCells(node)
+		row := fmt.Sprintf("%-30s %-26s %-16s %-12s %-6s %-8s %-5s %-5s %-9s %s",
+			truncateString(node.Name, 30),
+			node.Status,
+			truncateString(nodeRoles(node), 16),
+			truncateString(node.KubeletVersion, 12),
+			node.CPUAllocatable,
+			node.MemoryAllocatable,
+			cpuUsage,
+			memoryUsage,
+			nodePods(node),
+			t.formatTime(node.CreatedAt, node.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.nodes)))
+
+	content.WriteString(t.renderScalingActivity())
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 's' to cordon/uncordon • Press 'ctrl+d' to drain")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected node info
+	if node, ok := t.currentNode(); ok {
+		t.updateNodeDetails(node)
+	}
+}
+
+// updateNodeDetails updates the detail pane with node information
+func (t *TUI) updateNodeDetails(node resources.NodeInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🖥️ Node Details: %s\n\n", node.Name))
+
+	details.WriteString(fmt.Sprintf("Status:       %s\n", node.Status))
+	details.WriteString(fmt.Sprintf("Roles:        %s\n", nodeRoles(node)))
+	if node.InternalIP != "" {
+		details.WriteString(fmt.Sprintf("Internal IP:  %s\n", node.InternalIP))
+	}
+	details.WriteString(fmt.Sprintf("Kubelet:      %s\n", node.KubeletVersion))
+	if node.OSImage != "" {
+		details.WriteString(fmt.Sprintf("OS Image:     %s\n", node.OSImage))
+	}
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(node.CreatedAt, node.Age)))
+
+	details.WriteString("\nAllocatable:\n")
+	details.WriteString(fmt.Sprintf("  CPU:    %s\n", node.CPUAllocatable))
+	details.WriteString(fmt.Sprintf("  Memory: %s\n", node.MemoryAllocatable))
+	details.WriteString(fmt.Sprintf("  Pods:   %s\n", nodePods(node)))
+
+	if len(node.Taints) > 0 {
+		details.WriteString("\nTaints:\n")
+		for _, taint := range node.Taints {
+			details.WriteString(fmt.Sprintf("  %s\n", taint))
+		}
+	}
+
+	details.WriteString(t.renderNodeScalingEvents(node.Name))
+	writeWorkloadMetadata(&details, nil, node.Labels)
+
+	t.detailContent = details.String()
+}
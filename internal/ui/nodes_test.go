@@ -0,0 +1,85 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestNodesTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabNodes
+
+	tui.Update(messages.NodesLoaded{Nodes: []resources.NodeInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "worker-1", Status: "Ready,SchedulingDisabled"}, Roles: []string{"worker"},
+			Unschedulable: true, KubeletVersion: "v1.30.4", CPUAllocatable: "4", MemoryAllocatable: "15.5Gi", PodCapacity: 250, PodCount: 12},
+		{ResourceInfo: resources.ResourceInfo{Name: "worker-2", Status: "NotReady"}, PodCapacity: 250, PodCount: -1},
+	}})
+
+	if !strings.Contains(tui.mainContent, "12/250") || !strings.Contains(tui.mainContent, "v1.30.4") {
+		t.Errorf("Expected pod counts and kubelet version in the table, got %q", tui.mainContent)
+	}
+	if !strings.Contains(tui.detailContent, "Node Details: worker-1") || !strings.Contains(tui.detailContent, "15.5Gi") {
+		t.Errorf("Expected node details, got %q", tui.detailContent)
+	}
+
+	ref, ok := tui.selectedResource()
+	if !ok || ref.Kind != "Node" || ref.Name != "worker-1" || ref.Namespace != "" {
+		t.Errorf("Expected cluster-scoped Node/worker-1 as the YAML target, got %+v", ref)
+	}
+
+	// A cordoned node is offered an uncordon
+	tui.openNodeCordonModal()
+	if !tui.showNodeActionModal || tui.nodeAction != nodeActionUncordon {
+		t.Errorf("Expected an uncordon confirmation, got %q", tui.nodeAction)
+	}
+	tui.handleNodeActionModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showNodeActionModal {
+		t.Errorf("Expected esc to close the modal")
+	}
+}
+
+func TestNodeDrainConfirmation(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabNodes
+	tui.showNodeActionModal = true
+	tui.nodeAction = nodeActionDrain
+	tui.nodeActionName = "worker-1"
+	tui.loadingDrainPlan = true
+
+	tui.handleNodeDrainPlanLoaded(messages.NodeDrainPlanLoaded{Node: "worker-1", Plan: &resources.DrainPlan{
+		Node:    "worker-1",
+		Evict:   []string{"demo/web-1"},
+		Blocked: []string{"demo/debug"},
+	}})
+	if tui.loadingDrainPlan || !strings.Contains(tui.renderDrainPlan(80), "demo/debug") {
+		t.Errorf("Expected the blocking pod to be listed")
+	}
+
+	for _, r := range "worker-1" {
+		tui.handleNodeActionModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if tui.canConfirmDrain() {
+		t.Errorf("Expected a blocked drain to be refused")
+	}
+
+	tui.nodeDrainPlan.Blocked = nil
+	tui.nodeDrainPlan.LocalData = []string{"demo/cache-1"}
+	if tui.canConfirmDrain() || !strings.Contains(tui.renderDrainPlan(80), "emptyDir") {
+		t.Errorf("Expected a pod with emptyDir data to block the drain")
+	}
+
+	tui.nodeDrainPlan.LocalData = nil
+	if !tui.canConfirmDrain() {
+		t.Errorf("Expected the typed node name to confirm the drain")
+	}
+	tui.handleNodeActionModalKeys(tea.KeyMsg{Type: tea.KeyBackspace})
+	if tui.canConfirmDrain() {
+		t.Errorf("Expected a partial node name not to confirm the drain")
+	}
+}
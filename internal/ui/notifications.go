@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// notification is a pod or deployment status change worth knowing about
+type notification struct {
+	at    time.Time
+	level statusLevel
+	text  string
+}
+
+// statusAlert is a condition found in a loaded list. It is notified when its
+// key was not in the previous load.
+type statusAlert struct {
+	key  string
+	text string
+	// owner is the object a sticky alert is remembered for until it is
+	// deleted, so that a pod alternating between CrashLoopBackOff and
+	// Running is notified once
+	owner string
+}
+
+// toastExpiredMsg hides the status bar toast it was scheduled for
+type toastExpiredMsg struct {
+	seq int
+}
+
+// alertSnapshot holds the alert keys of the last loaded list, compared with
+// the next load to find status changes
+type alertSnapshot struct {
+	scope string            // cluster and namespace the snapshot was taken in
+	keys  map[string]string // alert key to the owner of sticky alerts
+}
+
+// newAlerts stores the alerts of a new load and returns those that were not
+// in the previous one. Sticky alerts of the objects still loaded are kept.
+// Nothing is returned for the first load of a scope.
+func (s *alertSnapshot) newAlerts(scope string, alerts []statusAlert, loaded map[string]bool) []statusAlert {
+	previous := s.keys
+	baseline := s.scope != scope || previous == nil
+
+	s.scope = scope
+	s.keys = make(map[string]string, len(alerts))
+	if !baseline {
+		for key, owner := range previous {
+			if owner != "" && loaded[owner] {
+				s.keys[key] = owner
+			}
+		}
+	}
+
+	var fresh []statusAlert
+	for _, alert := range alerts {
+		if _, seen := previous[alert.key]; !baseline && !seen {
+			fresh = append(fresh, alert)
+		}
+		s.keys[alert.key] = alert.owner
+	}
+	return fresh
+}
+
+// alertScope identifies the cluster and namespace lists are loaded from, so
+// that switching either does not report the new list as changes
+func (t *TUI) alertScope() string {
+	if t.allNamespaces {
+		return t.context + "/*"
+	}
+	return t.context + "/" + t.namespace
+}
+
+// podStatusAlerts returns the pods in CrashLoopBackOff and the containers
+// that were OOMKilled
+func podStatusAlerts(pods []resources.PodInfo) []statusAlert {
+	var alerts []statusAlert
+	for _, pod := range pods {
+		for _, c := range pod.ContainerInfo {
+			prefix := podKey(pod) + "/" + c.Name
+			if c.Reason == "CrashLoopBackOff" {
+				alerts = append(alerts, statusAlert{
+					key:   prefix + "/crashloop",
+					text:  fmt.Sprintf("Pod %s is in CrashLoopBackOff (%s)", pod.Name, c.Name),
+					owner: podKey(pod),
+				})
+			}
+			if c.Reason == "OOMKilled" || c.LastTermination == "OOMKilled" {
+				// A killed container counts the restart once it runs again,
+				// so both states of the same kill share a key
+				restarts := c.RestartCount
+				if c.Reason == "OOMKilled" {
+					restarts++
+				}
+				alerts = append(alerts, statusAlert{
+					key:  fmt.Sprintf("%s/oom/%d", prefix, restarts),
+					text: fmt.Sprintf("Container %s of pod %s was OOMKilled", c.Name, pod.Name),
+				})
+			}
+		}
+	}
+	return alerts
+}
+
+// podKey identifies a pod across namespaces
+func podKey(pod resources.PodInfo) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+// loadedPods returns the keys of the loaded pods
+func loadedPods(pods []resources.PodInfo) map[string]bool {
+	loaded := make(map[string]bool, len(pods))
+	for _, pod := range pods {
+		loaded[podKey(pod)] = true
+	}
+	return loaded
+}
+
+// deploymentStatusAlerts returns the deployments with no available replica
+// while some are wanted
+func deploymentStatusAlerts(deployments []resources.DeploymentInfo) []statusAlert {
+	var alerts []statusAlert
+	for _, d := range deployments {
+		if d.Replicas == 0 || d.AvailableReplicas > 0 {
+			continue
+		}
+		alerts = append(alerts, statusAlert{
+			key:  d.Namespace + "/" + d.Name + "/unavailable",
+			text: fmt.Sprintf("Deployment %s is unavailable (0/%d replicas)", d.Name, d.Replicas),
+		})
+	}
+	return alerts
+}
+
+// notifyPodChanges notifies pods that entered CrashLoopBackOff or were
+// OOMKilled since the pods were last loaded
+func (t *TUI) notifyPodChanges(pods []resources.PodInfo) tea.Cmd {
+	return t.notify(t.podAlerts.newAlerts(t.alertScope(), podStatusAlerts(pods), loadedPods(pods)))
+}
+
+// notifyDeploymentChanges notifies deployments that became unavailable since
+// the deployments were last loaded
+func (t *TUI) notifyDeploymentChanges(deployments []resources.DeploymentInfo) tea.Cmd {
+	return t.notify(t.deploymentAlerts.newAlerts(t.alertScope(), deploymentStatusAlerts(deployments), nil))
+}
+
+// notify adds alerts to the notification history and shows the last one as
+// a toast in the status bar
+func (t *TUI) notify(alerts []statusAlert) tea.Cmd {
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	for _, alert := range alerts {
+		t.notifications = append(t.notifications, notification{at: time.Now(), level: statusFailed, text: alert.text})
+		t.logWarn(categoryResource, "%s", alert.text)
+	}
+	if len(t.notifications) > constants.MaxNotifications {
+		t.notifications = t.notifications[len(t.notifications)-constants.MaxNotifications:]
+	}
+
+	toast := t.notifications[len(t.notifications)-1]
+	if len(alerts) > 1 {
+		toast.text += fmt.Sprintf(" (+%d more)", len(alerts)-1)
+	}
+	t.toast = &toast
+	t.toastSeq++
+	seq := t.toastSeq
+	return tea.Tick(constants.NotificationToastDuration, func(time.Time) tea.Msg {
+		return toastExpiredMsg{seq: seq}
+	})
+}
+
+// expireToast hides the toast unless a newer one replaced it
+func (t *TUI) expireToast(msg toastExpiredMsg) {
+	if msg.seq == t.toastSeq {
+		t.toast = nil
+	}
+}
+
+// renderToast renders the toast shown in place of the status bar hints
+func (t *TUI) renderToast() string {
+	return t.statusStyle(t.toast.level).Bold(true).Render(t.statusMark(t.toast.level, "🔔") + " " + t.toast.text)
+}
+
+// openNotifications shows the notification history
+func (t *TUI) openNotifications() {
+	t.showNotifications = true
+	t.notificationScroll = 0
+	t.toast = nil
+}
+
+// notificationLines renders the notification history, newest first
+func (t *TUI) notificationLines() []string {
+	lines := make([]string, 0, len(t.notifications))
+	for i := len(t.notifications) - 1; i >= 0; i-- {
+		n := t.notifications[i]
+		lines = append(lines, fmt.Sprintf("%s  %s %s", n.at.Format("15:04:05"), t.statusIndicator(n.level, "●"), n.text))
+	}
+	return lines
+}
+
+// renderNotifications renders the notification history
+func (t *TUI) renderNotifications() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(110, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("🔔 Notifications (%d)", len(t.notifications))) + "\n\n")
+
+	lines := t.notificationLines()
+	if len(lines) == 0 {
+		content.WriteString("No notifications yet. Pods entering CrashLoopBackOff or OOMKilled and\n")
+		content.WriteString("deployments becoming unavailable are notified when their tab refreshes.\n")
+	} else {
+		visible := max(t.height-12, 3)
+		start := min(t.notificationScroll, max(len(lines)-visible, 0))
+		end := min(start+visible, len(lines))
+		for _, line := range lines[start:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d]\n", start+1, end, len(lines)))
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: scroll • c: clear • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleNotificationsKeys handles key input for the notification history
+func (t *TUI) handleNotificationsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "W":
+		t.showNotifications = false
+
+	case "j", "down":
+		if t.notificationScroll < len(t.notifications)-1 {
+			t.notificationScroll++
+		}
+
+	case "k", "up":
+		if t.notificationScroll > 0 {
+			t.notificationScroll--
+		}
+
+	case "c":
+		t.notifications = nil
+		t.notificationScroll = 0
+	}
+
+	return t, nil
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/components"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestPodNotifications(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 160, height: 40, errorDisplay: components.NewErrorDisplayComponent("dark")}
+	pod := func(reason, last string, restarts int32) resources.PodInfo {
+		return resources.PodInfo{
+			ResourceInfo:  resources.ResourceInfo{Name: "web-1", Namespace: "shop"},
+			ContainerInfo: []resources.ContainerInfo{{Name: "app", Reason: reason, LastTermination: last, RestartCount: restarts}},
+		}
+	}
+
+	// The first load is the baseline, even with failing pods
+	if cmd := tui.notifyPodChanges([]resources.PodInfo{pod("OOMKilled", "Error", 4)}); cmd != nil || len(tui.notifications) != 0 {
+		t.Fatalf("Expected no notification for the first load")
+	}
+	tui.notifyPodChanges([]resources.PodInfo{pod("", "Error", 5)})
+
+	if cmd := tui.notifyPodChanges([]resources.PodInfo{pod("OOMKilled", "Error", 5)}); cmd == nil {
+		t.Fatalf("Expected an OOMKilled container to be notified")
+	}
+	if tui.toast == nil || !strings.Contains(tui.renderStatusBar(), "Container app of pod web-1 was OOMKilled") {
+		t.Errorf("Expected the notification in the status bar, got %q", tui.renderStatusBar())
+	}
+
+	// The same kill seen again once the container restarted is not notified twice
+	tui.notifyPodChanges([]resources.PodInfo{pod("", "OOMKilled", 6)})
+	if len(tui.notifications) != 1 {
+		t.Errorf("Expected one notification per kill, got %d", len(tui.notifications))
+	}
+
+	// A crash loop is notified once, though the pod runs between back-offs
+	tui.notifyPodChanges([]resources.PodInfo{pod("CrashLoopBackOff", "OOMKilled", 6)})
+	tui.notifyPodChanges([]resources.PodInfo{pod("", "OOMKilled", 6)})
+	tui.notifyPodChanges([]resources.PodInfo{pod("CrashLoopBackOff", "OOMKilled", 6)})
+	if len(tui.notifications) != 2 || !strings.Contains(tui.notifications[1].text, "CrashLoopBackOff") {
+		t.Errorf("Expected the crash loop to be notified once, got %+v", tui.notifications)
+	}
+
+	// Switching namespaces takes a new baseline
+	tui.namespace = "other"
+	tui.notifyPodChanges([]resources.PodInfo{pod("CrashLoopBackOff", "", 1)})
+	if len(tui.notifications) != 2 {
+		t.Errorf("Expected no notification after switching namespaces")
+	}
+
+	// Toasts expire unless a newer one replaced them
+	tui.expireToast(toastExpiredMsg{seq: tui.toastSeq - 1})
+	if tui.toast == nil {
+		t.Errorf("Expected an older toast timer not to hide the latest toast")
+	}
+	tui.expireToast(toastExpiredMsg{seq: tui.toastSeq})
+	if tui.toast != nil {
+		t.Errorf("Expected the toast to expire")
+	}
+}
+
+func TestDeploymentNotifications(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	deploy := func(available int32) []resources.DeploymentInfo {
+		return []resources.DeploymentInfo{{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "shop"}, Replicas: 3, AvailableReplicas: available}}
+	}
+
+	tui.notifyDeploymentChanges(deploy(3))
+	tui.notifyDeploymentChanges(deploy(0))
+	tui.notifyDeploymentChanges(deploy(0))
+	if len(tui.notifications) != 1 || !strings.Contains(tui.notifications[0].text, "Deployment web is unavailable (0/3 replicas)") {
+		t.Fatalf("Expected one notification when web became unavailable, got %+v", tui.notifications)
+	}
+
+	tui.openNotifications()
+	if !strings.Contains(tui.renderNotifications(), "Deployment web is unavailable") {
+		t.Errorf("Expected the notification in the history")
+	}
+	tui.handleNotificationsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	tui.handleNotificationsKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if len(tui.notifications) != 0 || tui.showNotifications {
+		t.Errorf("Expected c to clear the history and esc to close it")
+	}
+}
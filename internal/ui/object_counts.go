@@ -0,0 +1,228 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadObjectCounts counts the objects in the current namespace, or in all
+// namespaces along with the etcd object counts of the API server
+func (t *TUI) loadObjectCounts(allNamespaces bool) tea.Cmd {
+	namespace := t.namespace
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.ObjectCountsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		counts, err := loadWithRetry(t, "object counts", func(ctx context.Context) ([]resources.ObjectCount, error) {
+			return t.resourceClient.CountObjects(ctx, namespace, allNamespaces)
+		})
+		if err != nil {
+			return messages.ObjectCountsLoadError{Err: err}
+		}
+
+		// The API server metrics need cluster-wide access, the report works without them
+		var stored []resources.StoredObjectCount
+		if allNamespaces {
+			ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+			defer cancel()
+			stored, _ = t.resourceClient.ListStoredObjectCounts(ctx)
+		}
+
+		return messages.ObjectCountsLoaded{Counts: counts, Stored: stored, AllNamespaces: allNamespaces}
+	}
+}
+
+// openObjectReport shows the object counts of the current namespace, or of
+// all namespaces in the all namespaces view
+func (t *TUI) openObjectReport() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showObjectReport = true
+	t.objectReportAll = t.allNamespaces
+	return t.reloadObjectReport()
+}
+
+// reloadObjectReport reloads the counts for the selected scope
+func (t *TUI) reloadObjectReport() tea.Cmd {
+	t.loadingObjectReport = true
+	t.objectReportError = ""
+	t.objectReportScroll = 0
+	return t.loadObjectCounts(t.objectReportAll)
+}
+
+// closeObjectReport dismisses the object count report
+func (t *TUI) closeObjectReport() {
+	t.showObjectReport = false
+	t.objectCounts = nil
+	t.storedObjects = nil
+}
+
+// handleObjectCountsLoaded stores the counts, unless the scope was switched
+// while they loaded
+func (t *TUI) handleObjectCountsLoaded(msg messages.ObjectCountsLoaded) {
+	if msg.AllNamespaces != t.objectReportAll {
+		return
+	}
+	t.objectCounts = msg.Counts
+	t.storedObjects = msg.Stored
+	t.loadingObjectReport = false
+}
+
+// handleObjectCountsLoadError records a failed count
+func (t *TUI) handleObjectCountsLoadError(msg messages.ObjectCountsLoadError) {
+	t.objectCounts = nil
+	t.storedObjects = nil
+	t.loadingObjectReport = false
+	t.objectReportError = msg.Err.Error()
+	t.logError(categoryResource, "Failed to count objects: %v", msg.Err)
+}
+
+// objectReportScope names the namespace or cluster the report covers
+func (t *TUI) objectReportScope() string {
+	if t.objectReportAll {
+		return "all namespaces"
+	}
+	return t.namespace
+}
+
+// cleanupCell renders a cleanup count, highlighted when there is something
+// to clean up
+func cleanupCell(count int) string {
+	cell := fmt.Sprintf("%-8d", count)
+	if count == 0 {
+		return cell
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render(cell)
+}
+
+// objectReportLines renders one line per resource type for a namespace, or
+// one line per namespace with its largest resource types for all namespaces
+func (t *TUI) objectReportLines() []string {
+	bold := lipgloss.NewStyle().Bold(true)
+	summaries := resources.SummarizeObjectCounts(t.objectCounts)
+
+	var lines []string
+	if !t.objectReportAll {
+		total, cleanup := 0, 0
+		for _, summary := range summaries {
+			total += summary.Total
+			cleanup += summary.Cleanup
+		}
+		lines = append(lines, fmt.Sprintf("%d objects, %d cleanup candidates", total, cleanup), "")
+		lines = append(lines, bold.Render(fmt.Sprintf("%-30s %-10s %s", "RESOURCE", "COUNT", "CLEANUP")))
+		for _, summary := range summaries {
+			for _, count := range summary.Counts {
+				lines = append(lines, fmt.Sprintf("%-30s %-10d %s", count.Resource, count.Count, cleanupCell(count.Cleanup)))
+			}
+		}
+	} else {
+		lines = append(lines, fmt.Sprintf("%d namespaces", len(summaries)), "")
+		lines = append(lines, bold.Render(fmt.Sprintf("%-35s %-10s %-8s %s", "NAMESPACE", "OBJECTS", "CLEANUP", "LARGEST")))
+		for _, summary := range summaries {
+			var largest []string
+			for i, count := range summary.Counts {
+				if i == constants.MaxLargestResourcesShown {
+					break
+				}
+				largest = append(largest, fmt.Sprintf("%s %d", count.Resource, count.Count))
+			}
+			lines = append(lines, fmt.Sprintf("%-35s %-10d %s %s",
+				truncateString(summary.Namespace, 35), summary.Total, cleanupCell(summary.Cleanup), strings.Join(largest, ", ")))
+		}
+	}
+
+	if len(t.storedObjects) > 0 {
+		lines = append(lines, "", bold.Render("Stored in etcd (whole cluster)"))
+		for i, stored := range t.storedObjects {
+			if i == constants.MaxStoredObjectsShown {
+				lines = append(lines, fmt.Sprintf("  … and %d more resources", len(t.storedObjects)-i))
+				break
+			}
+			lines = append(lines, fmt.Sprintf("  %-45s %d", truncateString(stored.Resource, 45), stored.Count))
+		}
+	}
+	return lines
+}
+
+// renderObjectReport renders the object count report
+func (t *TUI) renderObjectReport() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(100, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("📊 Object Counts (%s)", t.objectReportScope())) + "\n\n")
+
+	switch {
+	case t.loadingObjectReport:
+		content.WriteString(fmt.Sprintf("%s Counting objects...\n", t.getLoadingSpinner()))
+	case t.objectReportError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.objectReportError) + "\n")
+	case len(t.objectCounts) == 0:
+		content.WriteString("No objects found\n")
+	default:
+		lines := t.objectReportLines()
+		visible := max(t.height-14, 3)
+		start := min(t.objectReportScroll, max(len(lines)-visible, 0))
+		end := min(start+visible, len(lines))
+		for _, line := range lines[start:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d lines]\n", start+1, end, len(lines)))
+		}
+		content.WriteString("\nCleanup: finished pods, completed jobs, replicasets scaled to zero, service account token secrets\n")
+	}
+
+	scope := "a: all namespaces"
+	if t.objectReportAll {
+		scope = "a: current namespace"
+	}
+	content.WriteString("\n")
+	content.WriteString(fmt.Sprintf("j/k: scroll • %s • r: refresh • esc/q: close", scope))
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleObjectReportKeys handles key input for the object count report
+func (t *TUI) handleObjectReportKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		t.closeObjectReport()
+
+	case "j", "down":
+		if t.objectReportScroll < len(t.objectReportLines())-1 {
+			t.objectReportScroll++
+		}
+
+	case "k", "up":
+		if t.objectReportScroll > 0 {
+			t.objectReportScroll--
+		}
+
+	case "a":
+		t.objectReportAll = !t.objectReportAll
+		return t, t.reloadObjectReport()
+
+	case "r":
+		return t, t.reloadObjectReport()
+	}
+
+	return t, nil
+}
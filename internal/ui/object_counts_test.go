@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestObjectReport(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "demo", width: 140, height: 40}
+	tui.showObjectReport = true
+	tui.loadingObjectReport = true
+
+	counts := []resources.ObjectCount{
+		{Namespace: "demo", Resource: "jobs", Count: 250, Cleanup: 248},
+		{Namespace: "demo", Resource: "secrets", Count: 12},
+	}
+
+	// Results for a scope that is no longer selected are dropped
+	tui.Update(messages.ObjectCountsLoaded{Counts: counts, AllNamespaces: true})
+	if !tui.loadingObjectReport {
+		t.Fatalf("Expected cluster-wide results to be ignored for the namespace report")
+	}
+
+	tui.Update(messages.ObjectCountsLoaded{Counts: counts})
+	rendered := tui.renderObjectReport()
+	for _, want := range []string{"Object Counts (demo)", "262 objects, 248 cleanup candidates", "jobs", "248"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected report to contain %q", want)
+		}
+	}
+
+	// All namespaces lists one line per namespace and the etcd counts
+	tui.handleObjectReportKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	tui.Update(messages.ObjectCountsLoaded{
+		Counts:        append(counts, resources.ObjectCount{Namespace: "ci", Resource: "pods", Count: 3}),
+		Stored:        []resources.StoredObjectCount{{Resource: "secrets", Count: 4000}},
+		AllNamespaces: true,
+	})
+	rendered = tui.renderObjectReport()
+	for _, want := range []string{"Object Counts (all namespaces)", "2 namespaces", "jobs 250, secrets 12", "Stored in etcd", "4000"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected report to contain %q", want)
+		}
+	}
+
+	tui.handleObjectReportKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showObjectReport || tui.objectCounts != nil {
+		t.Errorf("Expected esc to close the report")
+	}
+}
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// toggleOwnerTree switches the detail pane between the summary of the
+// selected object and the Related tab with its owner tree
+func (t *TUI) toggleOwnerTree() tea.Cmd {
+	if t.showDetails && t.detailTab == detailTabRelated {
+		return t.selectDetailTab(detailTabSummary)
+	}
+	t.showDetails = true
+	return t.selectDetailTab(detailTabRelated)
+}
+
+// followOwnerTree loads the ownership chain of the selected object once the
+// selection moved to another object while the Related tab is open
+func (t *TUI) followOwnerTree() tea.Cmd {
+	if t.detailTab != detailTabRelated || !t.connected {
+		return nil
+	}
+	ref, ok := t.selectedResource()
+	if !ok || ref == t.ownerTreeRef {
+		return nil
+	}
+
+	t.ownerTreeRef = ref
+	t.ownerChain = nil
+	t.ownerChainErr = nil
+	resolver, ok := t.resourceClient.(resources.OwnerResolver)
+	if !ok {
+		t.ownerChainErr = fmt.Errorf("owner references are not supported by the resource client")
+		t.updateMainContent()
+		return nil
+	}
+
+	t.loadingOwnerChain = true
+	t.updateMainContent()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+		chain, err := resolver.OwnerChain(ctx, ref.Kind, ref.Namespace, ref.Name)
+		return messages.OwnerChainLoaded{Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name, Chain: chain, Err: err}
+	}
+}
+
+// handleOwnerChainLoaded shows a loaded ownership chain, unless the
+// selection moved on while it loaded
+func (t *TUI) handleOwnerChainLoaded(msg messages.OwnerChainLoaded) {
+	if (resourceRef{Kind: msg.Kind, Namespace: msg.Namespace, Name: msg.Name}) != t.ownerTreeRef {
+		return
+	}
+	t.loadingOwnerChain = false
+	t.ownerChain = msg.Chain
+	t.ownerChainErr = msg.Err
+	t.updateMainContent()
+}
+
+// renderOwnerTree renders the ownership chain of the selected object, top
+// controller first
+func (t *TUI) renderOwnerTree() string {
+	ref := t.ownerTreeRef
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("🌳 Owner Tree: %s %s\n\n", ref.Kind, ref.Name))
+
+	switch {
+	case t.loadingOwnerChain:
+		b.WriteString(fmt.Sprintf("%s Following owner references...\n", t.getLoadingSpinner()))
+	case t.ownerChainErr != nil:
+		b.WriteString(t.statusIndicator(statusFailed, "❌") + fmt.Sprintf(" %v\n", t.ownerChainErr))
+	case len(t.ownerChain) == 1:
+		b.WriteString(fmt.Sprintf("%s %s\n\n", ref.Kind, ref.Name))
+		b.WriteString(dimStyle.Render("No owner references: created directly, e.g. by oc apply, not by a controller.") + "\n")
+	}
+
+	if t.loadingOwnerChain || t.ownerChainErr != nil || len(t.ownerChain) < 2 {
+		b.WriteString("\n" + dimStyle.Render("G: back to the summary"))
+		return b.String()
+	}
+
+	for i, link := range t.ownerChain {
+		line := link.Kind + " " + link.Name
+		if i > 0 {
+			line = strings.Repeat("   ", i-1) + "└─ " + line
+		}
+		switch {
+		case link.Missing:
+			line += " " + t.statusStyle(statusFailed).Render(t.statusPrefix(statusFailed)+"(deleted, left to the garbage collector)")
+		case i == len(t.ownerChain)-1:
+			line = lipgloss.NewStyle().Bold(true).Render(line) + dimStyle.Render("  ◀ selected")
+		}
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("\n" + dimStyle.Render("G: back to the summary"))
+	return b.String()
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestOwnerTree(t *testing.T) {
+	pods := []resources.PodInfo{testPod("web-7d9f-x2", "Running", "node-a", 0, time.Hour)}
+	pods[0].Namespace = "shop"
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", allPods: pods, pods: pods,
+		activeViews: make(map[int]config.SavedView), width: 120, height: 40}
+
+	// Without a resolver the tree explains why it is empty
+	if cmd := tui.toggleOwnerTree(); cmd != nil || tui.detailTab != detailTabRelated || !tui.showDetails {
+		t.Fatal("Expected the owner tree to be shown without loading")
+	}
+	if body := tui.renderDetailBody(80); !strings.Contains(body, "not supported") {
+		t.Errorf("Expected an unsupported note, got %q", body)
+	}
+
+	// A chain loaded for another object is ignored
+	chain := []resources.OwnerLink{{Kind: "Deployment", Name: "web"}, {Kind: "ReplicaSet", Name: "web-7d9f"}, {Kind: "Pod", Name: "web-7d9f-x2"}}
+	tui.handleOwnerChainLoaded(messages.OwnerChainLoaded{Kind: "Pod", Namespace: "shop", Name: "other", Chain: chain})
+	if tui.ownerChain != nil {
+		t.Fatal("Expected a chain of another pod to be ignored")
+	}
+
+	tui.handleOwnerChainLoaded(messages.OwnerChainLoaded{Kind: "Pod", Namespace: "shop", Name: "web-7d9f-x2", Chain: chain})
+	body := tui.renderDetailBody(80)
+	for _, line := range []string{"🌳 Owner Tree: Pod web-7d9f-x2", "Deployment web\n", "└─ ReplicaSet web-7d9f", "   └─ Pod web-7d9f-x2"} {
+		if !strings.Contains(body, line) {
+			t.Errorf("Expected the tree to contain %q, got %q", line, body)
+		}
+	}
+
+	// Back to the pod details
+	tui.toggleOwnerTree()
+	if body := tui.renderDetailBody(80); tui.detailTab != detailTabSummary || !strings.Contains(body, "Pod Details") {
+		t.Errorf("Expected the pod details again, got %q", body)
+	}
+}
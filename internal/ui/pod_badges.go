@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// podBadgeMark marks the pods behind the service or route selected last
+const podBadgeMark = "◆"
+
+// podBadgeSource is the service or route whose backend pods are badged on
+// the Pods tab
+type podBadgeSource struct {
+	source    string // e.g. "route/shop"
+	namespace string
+	service   string
+}
+
+// badgeServicePods badges the pods selected by a service
+func (t *TUI) badgeServicePods(svc resources.ServiceInfo) {
+	t.podBadge = &podBadgeSource{source: "service/" + svc.Name, namespace: svc.Namespace, service: svc.Name}
+}
+
+// badgeRoutePods badges the pods behind the service a route sends traffic to
+func (t *TUI) badgeRoutePods(route resources.RouteInfo) {
+	if route.Service.Kind != "" && route.Service.Kind != "Service" {
+		t.podBadge = nil
+		return
+	}
+	t.podBadge = &podBadgeSource{source: "route/" + route.Name, namespace: route.Namespace, service: route.Service.Name}
+}
+
+// podBadgeSelector returns the selector of the pods to badge, or nil when
+// no service or route was selected, or its service is not loaded or selects
+// no pods
+func (t *TUI) podBadgeSelector() labels.Selector {
+	if t.podBadge == nil {
+		return nil
+	}
+
+	for _, svc := range t.allServices {
+		if svc.Name != t.podBadge.service || svc.Namespace != t.podBadge.namespace {
+			continue
+		}
+		if svc.Selector == "" {
+			return nil
+		}
+		selector, err := labels.Parse(svc.Selector)
+		if err != nil {
+			return nil
+		}
+		return selector
+	}
+	return nil
+}
+
+// podBadgeCell returns the badge of a pod followed by a space, or "" when the
+// pod is not behind the service or route selected last
+func (t *TUI) podBadgeCell(selector labels.Selector, pod resources.PodInfo) string {
+	if selector == nil || pod.Namespace != t.podBadge.namespace || !selector.Matches(labels.Set(pod.Labels)) {
+		return ""
+	}
+	return podBadgeMark + " "
+}
+
+// podBadgeLegend returns the title suffix naming the badged pods' service
+// or route, e.g. "  ◆ behind route/shop"
+func (t *TUI) podBadgeLegend(selector labels.Selector) string {
+	if selector == nil {
+		return ""
+	}
+	return fmt.Sprintf("  %s behind %s", podBadgeMark, t.podBadge.source)
+}
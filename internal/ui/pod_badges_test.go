@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestPodBadges(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	tui.allServices = []resources.ServiceInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "shop"}, Selector: "app=web"},
+		{ResourceInfo: resources.ResourceInfo{Name: "external", Namespace: "shop"}},
+	}
+	tui.services = tui.allServices
+	tui.pods = []resources.PodInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web-1", Namespace: "shop", Labels: map[string]string{"app": "web"}}},
+		{ResourceInfo: resources.ResourceInfo{Name: "db-1", Namespace: "shop", Labels: map[string]string{"app": "db"}}},
+	}
+
+	tui.updatePodDisplay()
+	if strings.Contains(tui.mainContent, podBadgeMark) {
+		t.Fatalf("Expected no badges before a service or route is selected")
+	}
+
+	// Selecting the service on its tab badges its pods on the next visit
+	tui.updateServiceDisplay()
+	tui.updatePodDisplay()
+	if !strings.Contains(tui.mainContent, "◆ behind service/web") || !strings.Contains(tui.mainContent, "◆ web-1") {
+		t.Errorf("Expected web-1 to be badged, got:\n%s", tui.mainContent)
+	}
+	if strings.Contains(tui.mainContent, "◆ db-1") {
+		t.Errorf("Expected db-1 not to be badged")
+	}
+
+	tui.badgeRoutePods(resources.RouteInfo{ResourceInfo: resources.ResourceInfo{Name: "shop", Namespace: "shop"}, Service: resources.RouteTargetRef{Kind: "Service", Name: "web"}})
+	tui.updatePodDisplay()
+	if !strings.Contains(tui.mainContent, "◆ behind route/shop") || !strings.Contains(tui.mainContent, "◆ web-1") {
+		t.Errorf("Expected the route's backend pods to be badged")
+	}
+
+	// A service without a selector badges nothing
+	tui.selectedService = 1
+	tui.updateServiceDisplay()
+	tui.updatePodDisplay()
+	if strings.Contains(tui.mainContent, podBadgeMark) {
+		t.Errorf("Expected no badges for a service without a selector")
+	}
+}
@@ -0,0 +1,291 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// podDeleteModes are the ways the delete dialog removes a pod, in the order
+// m cycles through them
+var podDeleteModes = []resources.PodDeleteMode{resources.PodDelete, resources.PodEvict, resources.PodForceDelete}
+
+// openDeletePodModal opens the delete dialog of the selected pod and loads
+// the disruption budgets covering it
+func (t *TUI) openDeletePodModal() tea.Cmd {
+	if !t.connected || t.ActiveTab != 0 || len(t.pods) == 0 || t.selectedPod >= len(t.pods) {
+		return nil
+	}
+	t.deletePodName = t.pods[t.selectedPod].Name
+	t.deletePodNamespace = t.pods[t.selectedPod].Namespace
+	t.deletePodMode = resources.PodDelete
+	t.deletePodGrace = nil
+	t.editingDeletePodGrace = false
+	t.deletePodGraceError = ""
+	t.deletePodBudgets = nil
+	t.deletePodBudgetsErr = nil
+	t.showDeletePodModal = true
+
+	deleter, ok := t.resourceClient.(resources.PodDeleter)
+	if !ok {
+		return nil
+	}
+	t.loadingPodBudgets = true
+	namespace, name := t.resourceNamespace(t.deletePodNamespace), t.deletePodName
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+		budgets, err := deleter.PodDisruptionBudgets(ctx, namespace, name)
+		return messages.PodDisruptionBudgetsLoaded{Namespace: namespace, Pod: name, Budgets: budgets, Err: err}
+	}
+}
+
+// handlePodDisruptionBudgetsLoaded shows the budgets in the delete dialog
+func (t *TUI) handlePodDisruptionBudgetsLoaded(msg messages.PodDisruptionBudgetsLoaded) {
+	if !t.showDeletePodModal || msg.Pod != t.deletePodName {
+		return
+	}
+	t.loadingPodBudgets = false
+	t.deletePodBudgets = msg.Budgets
+	t.deletePodBudgetsErr = msg.Err
+}
+
+// closeDeletePodModal dismisses the delete dialog
+func (t *TUI) closeDeletePodModal() {
+	t.showDeletePodModal = false
+	t.editingDeletePodGrace = false
+	t.deletePodName = ""
+	t.deletePodNamespace = ""
+}
+
+// deletePodOptions returns the options chosen in the delete dialog
+func (t *TUI) deletePodOptions() resources.PodDeleteOptions {
+	return resources.PodDeleteOptions{Mode: t.deletePodMode, GracePeriodSeconds: t.deletePodGrace}
+}
+
+// deletePod deletes the named pod as a background task
+func (t *TUI) deletePod(namespace, name string, opts resources.PodDeleteOptions) tea.Cmd {
+	if !t.connected || t.resourceClient == nil {
+		return nil
+	}
+
+	client := t.resourceClient
+	deleter, ok := client.(resources.PodDeleter)
+	if !ok && opts != (resources.PodDeleteOptions{}) {
+		t.logError(categoryAction, "Cannot %s pod %s: not supported by the resource client", opts.Mode, name)
+		return nil
+	}
+
+	action := strings.ToUpper(opts.Mode.String()[:1]) + opts.Mode.String()[1:]
+	return t.runTask(fmt.Sprintf("%s pod %s", action, name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			if deleter != nil {
+				return deleter.DeletePodWithOptions(ctx, namespace, name, opts)
+			}
+			return client.DeletePod(ctx, namespace, name)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				t.handlePodDeleteError(name, err)
+				return nil
+			}
+			logging.Info(t.Logger, "Pod %s/%s: %s done", namespace, name, opts.Mode)
+			if opts.Mode == resources.PodEvict {
+				t.logSuccess(categoryAction, "Evicted pod %s", name)
+			} else {
+				t.logSuccess(categoryAction, "Deleted pod %s", name)
+			}
+			return t.loadPods()
+		})
+}
+
+// handlePodDeleteError reports a failed deletion
+func (t *TUI) handlePodDeleteError(name string, err error) {
+	logging.Error(t.Logger, "Failed to delete pod %s: %v", name, err)
+	userError := errors.MapKubernetesError(err)
+	t.errorDisplay.AddError(userError)
+	t.logError(categoryAction, "Failed to delete pod %s: %s", name, userError.GetDisplayMessage())
+}
+
+// cycleDeletePodMode switches the delete dialog to the next mode
+func (t *TUI) cycleDeletePodMode() {
+	for i, mode := range podDeleteModes {
+		if mode == t.deletePodMode {
+			t.deletePodMode = podDeleteModes[(i+1)%len(podDeleteModes)]
+			return
+		}
+	}
+	t.deletePodMode = resources.PodDelete
+}
+
+// editDeletePodGrace starts editing the grace period of the delete dialog
+func (t *TUI) editDeletePodGrace() tea.Cmd {
+	t.deletePodGraceInput = textinput.New()
+	t.deletePodGraceInput.Placeholder = "seconds, empty for the pod's own"
+	t.deletePodGraceInput.CharLimit = 6
+	t.deletePodGraceInput.Width = 34
+	if t.deletePodGrace != nil {
+		t.deletePodGraceInput.SetValue(strconv.FormatInt(*t.deletePodGrace, 10))
+	}
+	t.deletePodGraceInput.Focus()
+	t.deletePodGraceError = ""
+	t.editingDeletePodGrace = true
+	return textinput.Blink
+}
+
+// parseGracePeriod parses a grace period in seconds, nil when empty
+func parseGracePeriod(value string) (*int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return nil, fmt.Errorf("enter a number of seconds, 0 or more")
+	}
+	return &seconds, nil
+}
+
+// gracePeriodLabel describes the grace period the delete dialog uses
+func (t *TUI) gracePeriodLabel() string {
+	switch {
+	case t.deletePodMode == resources.PodForceDelete:
+		return "0s, the pod is not waited for"
+	case t.deletePodGrace == nil:
+		return "the pod's terminationGracePeriodSeconds"
+	}
+	return fmt.Sprintf("%ds", *t.deletePodGrace)
+}
+
+// deletePodModeHint explains what the chosen mode does
+func deletePodModeHint(mode resources.PodDeleteMode) string {
+	switch mode {
+	case resources.PodEvict:
+		return "Evicts through the eviction API, refused while a disruption budget allows no more disruptions."
+	case resources.PodForceDelete:
+		return "Removes the pod from the API at once, without waiting for the kubelet to stop its containers. Disruption budgets are not checked and the containers may still run on a node that is unreachable."
+	}
+	return "Deletes the pod after its grace period. Disruption budgets are not checked."
+}
+
+// renderDeletePodModal renders the pod delete dialog
+func (t *TUI) renderDeletePodModal() string {
+	modalWidth := min(76, t.width-4)
+
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("9")).
+		Padding(1).
+		Width(modalWidth - 4)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	selectedStyle := lipgloss.NewStyle().Bold(true).Reverse(true)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("9")).Render("🗑️ Delete Pod") + "\n\n")
+	content.WriteString(fmt.Sprintf("Pod:          %s\n", t.deletePodName))
+	content.WriteString(fmt.Sprintf("Namespace:    %s\n\n", t.deletePodNamespace))
+
+	var modes []string
+	for _, mode := range podDeleteModes {
+		label := " " + mode.String() + " "
+		if mode == t.deletePodMode {
+			label = selectedStyle.Render(label)
+		}
+		modes = append(modes, label)
+	}
+	content.WriteString("Mode:         " + strings.Join(modes, " ") + "\n")
+	if t.editingDeletePodGrace {
+		content.WriteString("Grace period: " + t.deletePodGraceInput.View() + "\n")
+	} else {
+		content.WriteString("Grace period: " + t.gracePeriodLabel() + "\n")
+	}
+	if t.deletePodGraceError != "" {
+		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("❌ "+t.deletePodGraceError) + "\n")
+	}
+	content.WriteString("\n" + dimStyle.Render(deletePodModeHint(t.deletePodMode)) + "\n\n")
+
+	content.WriteString("Disruption budgets:\n")
+	switch {
+	case t.loadingPodBudgets:
+		content.WriteString(fmt.Sprintf("  %s Loading...\n", t.getLoadingSpinner()))
+	case t.deletePodBudgetsErr != nil:
+		content.WriteString(fmt.Sprintf("  Unknown: %v\n", t.deletePodBudgetsErr))
+	case len(t.deletePodBudgets) == 0:
+		content.WriteString("  none cover this pod\n")
+	}
+	for _, budget := range t.deletePodBudgets {
+		level := statusOK
+		if budget.DisruptionsAllowed == 0 {
+			level = statusWarn
+		}
+		content.WriteString(fmt.Sprintf("  %s %s: %d disruption(s) allowed, %d/%d healthy\n",
+			t.statusIndicator(level, "●"), budget.Name, budget.DisruptionsAllowed, budget.CurrentHealthy, budget.DesiredHealthy))
+	}
+
+	content.WriteString("\nPods owned by a controller will be recreated.\n\n")
+	if t.editingDeletePodGrace {
+		content.WriteString("enter: set grace period • esc: cancel")
+	} else {
+		content.WriteString("m: mode • g: grace period • y/enter: run • n/esc: cancel")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleDeletePodModalKeys handles key input for the pod delete dialog
+func (t *TUI) handleDeletePodModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.editingDeletePodGrace {
+		switch msg.String() {
+		case "esc":
+			t.editingDeletePodGrace = false
+			t.deletePodGraceError = ""
+			return t, nil
+		case "enter":
+			grace, err := parseGracePeriod(t.deletePodGraceInput.Value())
+			if err != nil {
+				t.deletePodGraceError = err.Error()
+				return t, nil
+			}
+			t.deletePodGrace = grace
+			t.deletePodGraceError = ""
+			t.editingDeletePodGrace = false
+			return t, nil
+		}
+
+		var cmd tea.Cmd
+		t.deletePodGraceInput, cmd = t.deletePodGraceInput.Update(msg)
+		return t, cmd
+	}
+
+	switch msg.String() {
+	case "y", "Y", "enter":
+		namespace, name, opts := t.deletePodNamespace, t.deletePodName, t.deletePodOptions()
+		t.closeDeletePodModal()
+		return t, t.deletePod(namespace, name, opts)
+
+	case "m", "tab":
+		t.cycleDeletePodMode()
+
+	case "g":
+		if t.deletePodMode != resources.PodForceDelete {
+			return t, t.editDeletePodGrace()
+		}
+
+	case "n", "N", "esc", "q":
+		t.closeDeletePodModal()
+	}
+
+	return t, nil
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestParseGracePeriod(t *testing.T) {
+	if grace, err := parseGracePeriod(" "); err != nil || grace != nil {
+		t.Errorf("Expected an empty grace period to keep the pod's, got %v and %v", grace, err)
+	}
+	if grace, err := parseGracePeriod("15"); err != nil || grace == nil || *grace != 15 {
+		t.Errorf("Expected 15 seconds, got %v and %v", grace, err)
+	}
+	for _, value := range []string{"-1", "soon"} {
+		if _, err := parseGracePeriod(value); err == nil {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestDeletePodModal(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 120, height: 50}
+	tui.pods = []resources.PodInfo{{ResourceInfo: resources.ResourceInfo{Name: "web-1", Namespace: "shop"}}}
+
+	tui.openDeletePodModal()
+	if !tui.showDeletePodModal || tui.deletePodMode != resources.PodDelete || tui.deletePodGrace != nil {
+		t.Fatal("Expected the dialog to open on a plain delete with the pod's grace period")
+	}
+
+	tui.handlePodDisruptionBudgetsLoaded(messages.PodDisruptionBudgetsLoaded{Pod: "web-1", Budgets: []resources.DisruptionBudget{
+		{Name: "web", DisruptionsAllowed: 0, CurrentHealthy: 2, DesiredHealthy: 2},
+	}})
+	tui.handleDeletePodModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	if tui.deletePodMode != resources.PodEvict {
+		t.Errorf("Expected m to switch to eviction, got %s", tui.deletePodMode)
+	}
+	if view := tui.renderDeletePodModal(); !strings.Contains(view, "web: 0 disruption(s) allowed, 2/2 healthy") {
+		t.Errorf("Expected the budget to be listed, got %q", view)
+	}
+
+	tui.handleDeletePodModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	tui.deletePodGraceInput.SetValue("x")
+	tui.handleDeletePodModalKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.editingDeletePodGrace || tui.deletePodGraceError == "" {
+		t.Fatal("Expected an invalid grace period to be rejected")
+	}
+	tui.deletePodGraceInput.SetValue("10")
+	tui.handleDeletePodModalKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if opts := tui.deletePodOptions(); opts.Mode != resources.PodEvict || opts.GracePeriodSeconds == nil || *opts.GracePeriodSeconds != 10 {
+		t.Errorf("Expected an eviction with a 10s grace period, got %+v", opts)
+	}
+
+	tui.handleDeletePodModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	tui.handleDeletePodModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if tui.deletePodMode != resources.PodForceDelete || tui.editingDeletePodGrace {
+		t.Error("Expected force delete without a grace period to edit")
+	}
+
+	tui.handleDeletePodModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showDeletePodModal {
+		t.Error("Expected esc to close the dialog")
+	}
+}
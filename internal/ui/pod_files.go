@@ -0,0 +1,354 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// podFileBrowser returns the client listing and copying container files
+func (t *TUI) podFileBrowser() (resources.PodFileBrowser, bool) {
+	browser, ok := t.resourceClient.(resources.PodFileBrowser)
+	return browser, ok && t.connected
+}
+
+// openPodFiles opens the file browser on the selected pod, in the container
+// whose logs are shown
+func (t *TUI) openPodFiles() tea.Cmd {
+	pod, ok := t.selectedLogPod()
+	if t.ActiveTab != 0 || !ok {
+		return nil
+	}
+	if _, ok := t.podFileBrowser(); !ok {
+		t.logError(categoryAction, "Cannot browse files: resource client does not support exec")
+		return nil
+	}
+
+	var containers []string
+	for _, container := range pod.ContainerInfo {
+		containers = append(containers, container.Name)
+	}
+	if len(containers) == 0 {
+		return nil
+	}
+	container := containers[0]
+	if target := t.currentLogTarget(pod); slices.Contains(containers, target.Container) {
+		container = target.Container
+	}
+
+	t.showPodFiles = true
+	t.podFilesNamespace = t.resourceNamespace(pod.Namespace)
+	t.podFilesPod = pod.Name
+	t.podFilesContainers = containers
+	t.podFilesContainer = container
+	t.podFilesUploading = false
+	return t.loadPodFiles("/")
+}
+
+// loadPodFiles lists a directory of the browsed container
+func (t *TUI) loadPodFiles(dir string) tea.Cmd {
+	browser, ok := t.podFileBrowser()
+	if !ok {
+		return nil
+	}
+
+	t.podFilesDir = dir
+	t.podFilesEntries = nil
+	t.podFilesIndex = 0
+	t.podFilesScroll = 0
+	t.podFilesError = ""
+	t.loadingPodFiles = true
+
+	namespace, pod, container := t.podFilesNamespace, t.podFilesPod, t.podFilesContainer
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+		entries, err := browser.ListPodFiles(ctx, namespace, pod, container, dir)
+		return messages.PodFilesLoaded{Pod: pod, Container: container, Dir: dir, Entries: entries, Err: err}
+	}
+}
+
+// handlePodFilesLoaded shows the listed directory
+func (t *TUI) handlePodFilesLoaded(msg messages.PodFilesLoaded) {
+	if !t.showPodFiles || msg.Pod != t.podFilesPod || msg.Container != t.podFilesContainer || msg.Dir != t.podFilesDir {
+		return
+	}
+
+	t.loadingPodFiles = false
+	if msg.Err != nil {
+		t.podFilesError = msg.Err.Error()
+		t.logError(categoryResource, "Failed to list %s in %s/%s: %v", msg.Dir, msg.Pod, msg.Container, msg.Err)
+		return
+	}
+	t.podFilesEntries = msg.Entries
+}
+
+// selectedPodFile returns the selected entry and its path in the container
+func (t *TUI) selectedPodFile() (resources.PodFileEntry, string, bool) {
+	if t.podFilesIndex >= len(t.podFilesEntries) {
+		return resources.PodFileEntry{}, "", false
+	}
+	entry := t.podFilesEntries[t.podFilesIndex]
+	return entry, path.Join(t.podFilesDir, entry.Name), true
+}
+
+// downloadPodFile copies the selected file or directory into the working
+// directory in the background
+func (t *TUI) downloadPodFile() tea.Cmd {
+	browser, ok := t.podFileBrowser()
+	_, remotePath, selected := t.selectedPodFile()
+	if !ok || !selected {
+		return nil
+	}
+	localDir, err := os.Getwd()
+	if err != nil {
+		t.logError(categoryAction, "Cannot download %s: %v", remotePath, err)
+		return nil
+	}
+
+	namespace, pod, container := t.podFilesNamespace, t.podFilesPod, t.podFilesContainer
+	var localPath string
+	return t.runPreviewTask(fmt.Sprintf("Download %s from %s", remotePath, pod),
+		func(ctx context.Context, _ func(done, total int)) error {
+			var err error
+			localPath, err = browser.DownloadPodFile(ctx, namespace, pod, container, remotePath, localDir)
+			return err
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				t.logError(categoryAction, "Failed to download %s from %s: %v", remotePath, pod, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Downloaded %s from %s to %s", remotePath, pod, localPath)
+			return nil
+		})
+}
+
+// openPodFileUpload asks for the local file or directory to upload into the
+// browsed directory
+func (t *TUI) openPodFileUpload() {
+	if t.dryRun {
+		t.logWarn(categoryAction, "Dry run is on, files are not uploaded")
+		return
+	}
+
+	input := textinput.New()
+	input.Placeholder = "local file or directory"
+	input.CharLimit = 512
+	input.Width = 60
+	input.Focus()
+	t.podFilesUploadInput = input
+	t.podFilesUploadError = ""
+	t.podFilesUploading = true
+}
+
+// uploadPodFile copies a local file or directory into the browsed directory
+// in the background, listing the directory again once it is copied
+func (t *TUI) uploadPodFile(localPath string) (tea.Cmd, error) {
+	browser, ok := t.podFileBrowser()
+	if !ok {
+		return nil, fmt.Errorf("not connected")
+	}
+	localPath, err := expandLogExportPath(localPath)
+	if err == nil {
+		_, err = os.Stat(localPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, pod, container, dir := t.podFilesNamespace, t.podFilesPod, t.podFilesContainer, t.podFilesDir
+	return t.runTask(fmt.Sprintf("Upload %s to %s:%s", path.Base(localPath), pod, dir),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return browser.UploadPodFile(ctx, namespace, pod, container, localPath, dir)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				t.logError(categoryAction, "Failed to upload %s to %s: %v", localPath, pod, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Uploaded %s to %s:%s", localPath, pod, dir)
+			if t.showPodFiles && t.podFilesPod == pod && t.podFilesContainer == container && t.podFilesDir == dir {
+				return t.loadPodFiles(dir)
+			}
+			return nil
+		}), nil
+}
+
+// formatFileSize renders a file size, e.g. 512B or 3.2Gi
+func formatFileSize(size int64) string {
+	if size < 1<<10 {
+		return fmt.Sprintf("%dB", size)
+	}
+	return formatUsageBytes(size)
+}
+
+// podFilesHeight returns the number of entries visible at once
+func (t *TUI) podFilesHeight() int {
+	return max(t.height-16, 3)
+}
+
+// renderPodFiles renders the file browser of a container
+func (t *TUI) renderPodFiles() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(100, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("📂 Files of %s", t.podFilesPod)) + "\n")
+	content.WriteString(dimStyle.Render(truncateString(fmt.Sprintf("%s:%s", t.podFilesContainer, t.podFilesDir), modalWidth-8)) + "\n\n")
+
+	switch {
+	case t.loadingPodFiles:
+		content.WriteString(t.getLoadingSpinner() + " Listing...\n")
+	case t.podFilesError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.podFilesError) + "\n")
+		content.WriteString(dimStyle.Render("Listing and copying files need ls and tar in the container") + "\n")
+	case len(t.podFilesEntries) == 0:
+		content.WriteString(dimStyle.Render("Empty directory") + "\n")
+	default:
+		nameWidth := max(modalWidth-30, 10)
+		height := t.podFilesHeight()
+		end := min(t.podFilesScroll+height, len(t.podFilesEntries))
+		for i := t.podFilesScroll; i < end; i++ {
+			entry := t.podFilesEntries[i]
+			name, size := entry.Name, formatFileSize(entry.Size)
+			switch {
+			case entry.IsDir():
+				name, size = name+"/", "-"
+			case entry.IsLink():
+				name += " -> " + entry.Link
+			}
+			line := fmt.Sprintf("  %-10s %8s  %s", truncateString(entry.Mode, 10), size, truncateString(name, nameWidth))
+			if i == t.podFilesIndex {
+				line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(fmt.Sprintf("%-*s", modalWidth-8, line))
+			}
+			content.WriteString(line + "\n")
+		}
+		if len(t.podFilesEntries) > height {
+			content.WriteString(dimStyle.Render(fmt.Sprintf("[%d-%d of %d]", t.podFilesScroll+1, end, len(t.podFilesEntries))) + "\n")
+		}
+	}
+
+	content.WriteString("\n")
+	if t.podFilesUploading {
+		content.WriteString(fmt.Sprintf("Upload into %s:\n", t.podFilesDir))
+		content.WriteString(t.podFilesUploadInput.View() + "\n")
+		if t.podFilesUploadError != "" {
+			content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render(t.podFilesUploadError) + "\n")
+		}
+		content.WriteString("enter: upload • esc: cancel")
+	} else {
+		help := "j/k: navigate • enter: open • backspace: up • d: download to the current directory • u: upload • r: refresh"
+		if len(t.podFilesContainers) > 1 {
+			help += " • c: container"
+		}
+		content.WriteString(help + " • esc: close")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handlePodFilesKeys handles key input for the file browser
+func (t *TUI) handlePodFilesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.podFilesUploading {
+		return t.handlePodFileUploadKeys(msg)
+	}
+	count := len(t.podFilesEntries)
+
+	switch msg.String() {
+	case "esc", "q":
+		t.showPodFiles = false
+		t.podFilesEntries = nil
+		return t, nil
+
+	case "j", "down":
+		if count > 0 {
+			t.podFilesIndex = (t.podFilesIndex + 1) % count
+		}
+
+	case "k", "up":
+		if count > 0 {
+			t.podFilesIndex = (t.podFilesIndex + count - 1) % count
+		}
+
+	case "enter", "l", "right":
+		// Links are opened as directories, listing a file link fails
+		entry, remotePath, ok := t.selectedPodFile()
+		if ok && (entry.IsDir() || entry.IsLink()) {
+			return t, t.loadPodFiles(remotePath)
+		}
+
+	case "backspace", "h", "left":
+		if t.podFilesDir != "/" {
+			return t, t.loadPodFiles(path.Dir(t.podFilesDir))
+		}
+
+	case "d":
+		return t, t.downloadPodFile()
+
+	case "u":
+		t.openPodFileUpload()
+
+	case "c":
+		if len(t.podFilesContainers) > 1 {
+			next := (slices.Index(t.podFilesContainers, t.podFilesContainer) + 1) % len(t.podFilesContainers)
+			t.podFilesContainer = t.podFilesContainers[next]
+			return t, t.loadPodFiles(t.podFilesDir)
+		}
+
+	case "r":
+		return t, t.loadPodFiles(t.podFilesDir)
+	}
+
+	// Keep the selected entry visible
+	height := t.podFilesHeight()
+	if t.podFilesIndex < t.podFilesScroll {
+		t.podFilesScroll = t.podFilesIndex
+	} else if t.podFilesIndex >= t.podFilesScroll+height {
+		t.podFilesScroll = t.podFilesIndex - height + 1
+	}
+	return t, nil
+}
+
+// handlePodFileUploadKeys handles key input for the upload prompt
+func (t *TUI) handlePodFileUploadKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		t.podFilesUploading = false
+		return t, nil
+
+	case "enter":
+		cmd, err := t.uploadPodFile(t.podFilesUploadInput.Value())
+		if err != nil {
+			// Keep the prompt open so another path can be given
+			t.podFilesUploadError = err.Error()
+			return t, nil
+		}
+		t.podFilesUploading = false
+		return t, cmd
+	}
+
+	t.podFilesUploadError = ""
+	var cmd tea.Cmd
+	t.podFilesUploadInput, cmd = t.podFilesUploadInput.Update(msg)
+	return t, cmd
+}
@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// stubFileBrowser is a resource client that browses container files
+type stubFileBrowser struct {
+	resources.ResourceClient
+}
+
+func (stubFileBrowser) ListPodFiles(ctx context.Context, namespace, pod, container, dir string) ([]resources.PodFileEntry, error) {
+	return nil, nil
+}
+
+func (stubFileBrowser) DownloadPodFile(ctx context.Context, namespace, pod, container, remotePath, localDir string) (string, error) {
+	return "", nil
+}
+
+func (stubFileBrowser) UploadPodFile(ctx context.Context, namespace, pod, container, localPath, remoteDir string) error {
+	return nil
+}
+
+func TestPodFiles(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", resourceClient: stubFileBrowser{}, width: 120, height: 40}
+	tui.pods = []resources.PodInfo{{
+		ResourceInfo:  resources.ResourceInfo{Name: "web-1", Namespace: "shop"},
+		ContainerInfo: []resources.ContainerInfo{{Name: "app"}, {Name: "sidecar"}},
+	}}
+	key := func(k string) {
+		keyMsg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			keyMsg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "backspace":
+			keyMsg = tea.KeyMsg{Type: tea.KeyBackspace}
+		case "esc":
+			keyMsg = tea.KeyMsg{Type: tea.KeyEsc}
+		}
+		tui.handlePodFilesKeys(keyMsg)
+	}
+
+	if cmd := tui.openPodFiles(); cmd == nil || !tui.showPodFiles || tui.podFilesContainer != "app" || tui.podFilesDir != "/" {
+		t.Fatalf("Expected / of the app container to load, got %s:%s", tui.podFilesContainer, tui.podFilesDir)
+	}
+
+	// Listings of another directory are ignored
+	tui.handlePodFilesLoaded(messages.PodFilesLoaded{Pod: "web-1", Container: "app", Dir: "/tmp"})
+	if !tui.loadingPodFiles {
+		t.Fatal("Expected the listing of another directory to be ignored")
+	}
+	tui.handlePodFilesLoaded(messages.PodFilesLoaded{Pod: "web-1", Container: "app", Dir: "/", Entries: []resources.PodFileEntry{
+		{Name: "tmp", Mode: "drwxrwxrwt"},
+		{Name: "heap.hprof", Mode: "-rw-r--r--", Size: 3 << 30},
+	}})
+	if rendered := tui.renderPodFiles(); !strings.Contains(rendered, "tmp/") || !strings.Contains(rendered, "3.0Gi") {
+		t.Errorf("Expected the directory and the file size, got:\n%s", rendered)
+	}
+
+	// enter opens a directory, not a file
+	key("j")
+	key("enter")
+	if tui.podFilesDir != "/" {
+		t.Errorf("Expected enter on a file to stay in /, got %s", tui.podFilesDir)
+	}
+	key("k")
+	key("enter")
+	if tui.podFilesDir != "/tmp" || !tui.loadingPodFiles {
+		t.Errorf("Expected /tmp to load, got %s", tui.podFilesDir)
+	}
+	key("backspace")
+	if tui.podFilesDir != "/" {
+		t.Errorf("Expected backspace to go up to /, got %s", tui.podFilesDir)
+	}
+
+	key("c")
+	if tui.podFilesContainer != "sidecar" {
+		t.Errorf("Expected c to switch to the sidecar, got %s", tui.podFilesContainer)
+	}
+
+	// A missing local file keeps the upload prompt open
+	key("u")
+	tui.podFilesUploadInput.SetValue("/does/not/exist")
+	key("enter")
+	if !tui.podFilesUploading || tui.podFilesUploadError == "" {
+		t.Error("Expected the upload of a missing file to fail in the prompt")
+	}
+	key("esc")
+	key("esc")
+	if tui.showPodFiles {
+		t.Error("Expected esc to close the upload prompt, then the browser")
+	}
+
+	tui.dryRun = true
+	tui.openPodFileUpload()
+	if tui.podFilesUploading {
+		t.Error("Expected uploads to be refused in dry run")
+	}
+}
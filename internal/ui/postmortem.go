@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// postmortemFile is a file of a saved postmortem
+type postmortemFile struct {
+	name    string
+	content string
+}
+
+// postmortemTriggers returns the pods that failed or have a container in
+// CrashLoopBackOff. Triggers are sticky, a pod is saved once while it exists.
+func postmortemTriggers(pods []resources.PodInfo) []statusAlert {
+	var triggers []statusAlert
+	for _, pod := range pods {
+		reason := ""
+		if pod.Phase == "Failed" {
+			reason = "Failed"
+		}
+		for _, c := range pod.ContainerInfo {
+			if c.Reason == "CrashLoopBackOff" {
+				reason = "CrashLoopBackOff"
+			}
+		}
+		if reason != "" {
+			triggers = append(triggers, statusAlert{key: podKey(pod) + "/" + reason, text: reason, owner: podKey(pod)})
+		}
+	}
+	return triggers
+}
+
+// savePostmortems saves the evidence of pods that failed or started crash
+// looping since the pods were last loaded, when a postmortem directory is set
+func (t *TUI) savePostmortems(pods []resources.PodInfo) tea.Cmd {
+	fresh := t.postmortemPods.newAlerts(t.alertScope(), postmortemTriggers(pods), loadedPods(pods))
+	if len(fresh) == 0 || t.prefs.PostmortemDir == "" {
+		return nil
+	}
+
+	byKey := make(map[string]resources.PodInfo, len(pods))
+	for _, pod := range pods {
+		byKey[podKey(pod)] = pod
+	}
+	var cmds []tea.Cmd
+	for _, trigger := range fresh {
+		cmds = append(cmds, t.savePostmortem(byKey[trigger.owner], trigger.text))
+	}
+	return tea.Batch(cmds...)
+}
+
+// postmortemPath returns the directory a postmortem of a pod is saved to,
+// e.g. <dir>/shop_web-1_20250102-150405
+func postmortemPath(dir string, pod resources.PodInfo, now time.Time) string {
+	return filepath.Join(dir, fmt.Sprintf("%s_%s_%s", pod.Namespace, pod.Name, now.Format("20060102-150405")))
+}
+
+// savePostmortem fetches the YAML, events and last logs of a pod and saves
+// them. What cannot be fetched is noted in its file, the rest is still saved.
+func (t *TUI) savePostmortem(pod resources.PodInfo, reason string) tea.Cmd {
+	client := t.resourceClient
+	dir := t.prefs.PostmortemDir
+	return func() tea.Msg {
+		root, err := expandLogExportPath(dir)
+		if err != nil || client == nil {
+			if err == nil {
+				err = fmt.Errorf("not connected to cluster")
+			}
+			return messages.PostmortemSaved{Pod: pod.Name, Reason: reason, Err: err}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		files := []postmortemFile{{name: "pod.yaml", content: postmortemYAML(ctx, client, pod)}}
+		files = append(files, postmortemFile{name: "events.txt", content: postmortemEvents(ctx, client, pod)})
+		files = append(files, postmortemLogs(ctx, client, pod)...)
+
+		path := postmortemPath(root, pod, time.Now())
+		if err := writePostmortem(path, files); err != nil {
+			return messages.PostmortemSaved{Pod: pod.Name, Reason: reason, Err: err}
+		}
+		return messages.PostmortemSaved{Pod: pod.Name, Reason: reason, Path: path}
+	}
+}
+
+// postmortemYAML returns the YAML of a pod, or why it could not be fetched
+func postmortemYAML(ctx context.Context, client resources.ResourceClient, pod resources.PodInfo) string {
+	content, err := client.GetYAML(ctx, "Pod", pod.Namespace, pod.Name)
+	if err != nil {
+		return fmt.Sprintf("# failed to get the pod: %v\n", err)
+	}
+	return content
+}
+
+// postmortemEvents returns the events of a pod, one per line
+func postmortemEvents(ctx context.Context, client resources.ResourceClient, pod resources.PodInfo) string {
+	events, err := client.GetEventsFor(ctx, pod.Namespace, "Pod", pod.Name)
+	if err != nil {
+		return fmt.Sprintf("failed to list events: %v\n", err)
+	}
+	if len(events) == 0 {
+		return "no events\n"
+	}
+
+	var b strings.Builder
+	for _, event := range events {
+		b.WriteString(fmt.Sprintf("%s  %-7s %-20s x%-3d %s\n",
+			event.LastSeen.Format(time.RFC3339), event.Type, event.Reason, event.Count, event.Message))
+	}
+	return b.String()
+}
+
+// postmortemLogs returns the last log lines of each container, and of its
+// previous run when it restarted
+func postmortemLogs(ctx context.Context, client resources.ResourceClient, pod resources.PodInfo) []postmortemFile {
+	tail := int64(constants.PostmortemLogLines)
+	fetch := func(container string, previous bool) string {
+		logs, err := client.GetPodLogs(ctx, pod.Namespace, pod.Name, container, resources.LogOptions{TailLines: &tail, Previous: previous, Timestamps: true})
+		if err != nil {
+			return fmt.Sprintf("failed to get logs: %v\n", err)
+		}
+		return logs
+	}
+
+	var files []postmortemFile
+	for _, c := range append(append([]resources.ContainerInfo(nil), pod.InitContainers...), pod.ContainerInfo...) {
+		files = append(files, postmortemFile{name: c.Name + ".log", content: fetch(c.Name, false)})
+		if c.RestartCount > 0 {
+			files = append(files, postmortemFile{name: c.Name + ".previous.log", content: fetch(c.Name, true)})
+		}
+	}
+	return files
+}
+
+// writePostmortem writes the files of a postmortem to a new directory
+func writePostmortem(path string, files []postmortemFile) error {
+	if err := os.MkdirAll(path, constants.ConfigDirPermissions); err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(path, file.name), []byte(file.content), constants.LogExportFilePermissions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handlePostmortemSaved reports a saved postmortem in the app log
+func (t *TUI) handlePostmortemSaved(msg messages.PostmortemSaved) {
+	if msg.Err != nil {
+		t.logError(categoryAction, "Failed to save the postmortem of pod %s (%s): %v", msg.Pod, msg.Reason, msg.Err)
+		return
+	}
+	t.logSuccess(categoryAction, "Saved the postmortem of pod %s (%s) to %s", msg.Pod, msg.Reason, msg.Path)
+}
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestPostmortemTriggers(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop"}
+	pod := func(phase, reason string) resources.PodInfo {
+		return resources.PodInfo{
+			ResourceInfo:  resources.ResourceInfo{Name: "web-1", Namespace: "shop"},
+			Phase:         phase,
+			ContainerInfo: []resources.ContainerInfo{{Name: "app", Reason: reason}},
+		}
+	}
+
+	// Nothing is saved without a postmortem directory
+	tui.savePostmortems([]resources.PodInfo{pod("Running", "")})
+	if cmd := tui.savePostmortems([]resources.PodInfo{pod("Running", "CrashLoopBackOff")}); cmd != nil {
+		t.Fatalf("Expected no postmortem without a directory")
+	}
+
+	// A crash loop seen before the directory was set is not saved later
+	tui.prefs = config.Preferences{PostmortemDir: t.TempDir()}
+	if cmd := tui.savePostmortems([]resources.PodInfo{pod("Running", "CrashLoopBackOff")}); cmd != nil {
+		t.Errorf("Expected the crash loop not to be saved again")
+	}
+	if cmd := tui.savePostmortems([]resources.PodInfo{pod("Failed", "")}); cmd == nil {
+		t.Errorf("Expected a failed pod to be saved")
+	}
+
+	if triggers := postmortemTriggers([]resources.PodInfo{pod("Succeeded", "Completed")}); len(triggers) != 0 {
+		t.Errorf("Expected no trigger for a completed pod, got %+v", triggers)
+	}
+}
+
+func TestWritePostmortem(t *testing.T) {
+	pod := resources.PodInfo{ResourceInfo: resources.ResourceInfo{Name: "web-1", Namespace: "shop"}}
+	path := postmortemPath(t.TempDir(), pod, time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC))
+	if filepath.Base(path) != "shop_web-1_20250102-150405" {
+		t.Errorf("Unexpected postmortem directory %s", path)
+	}
+
+	files := []postmortemFile{{name: "pod.yaml", content: "kind: Pod\n"}, {name: "app.previous.log", content: "panic: boom\n"}}
+	if err := writePostmortem(path, files); err != nil {
+		t.Fatalf("Failed to write the postmortem: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(path, "app.previous.log"))
+	if err != nil || string(data) != "panic: boom\n" {
+		t.Errorf("Expected the previous logs to be saved, got %q (%v)", data, err)
+	}
+}
+
+func TestPostmortemDirSetting(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+	tui.settingsIndex = settingPostmortemDir
+	if tui.settingValue(settingPostmortemDir) != "off" {
+		t.Errorf("Expected postmortems to be off by default")
+	}
+
+	tui.editSetting()
+	tui.settingsInput.SetValue("~/postmortems")
+	tui.submitSetting()
+	if tui.prefs.PostmortemDir != "~/postmortems" || tui.settingsEditing {
+		t.Errorf("Expected the postmortem directory to be saved, got %q", tui.prefs.PostmortemDir)
+	}
+}
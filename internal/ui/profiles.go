@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+)
+
+// profileNames returns the names of the configured profiles
+func (t *TUI) profileNames() []string {
+	if t.config == nil {
+		return nil
+	}
+	return t.config.ProfileNames()
+}
+
+// lookupProfile returns the named profile from the configuration
+func (t *TUI) lookupProfile(name string) (config.Profile, error) {
+	if t.config != nil {
+		if profile, ok := t.config.Profile(name); ok {
+			return profile, nil
+		}
+	}
+	return config.Profile{}, fmt.Errorf("no profile %q in the configuration", name)
+}
+
+// startProfile opens the first connection on a profile's context and
+// namespace, with its tab and filter
+func (t *TUI) startProfile(name string) error {
+	profile, err := t.lookupProfile(name)
+	if err != nil {
+		return err
+	}
+
+	t.startContext = profile.Context
+	if profile.Namespace != "" {
+		t.startNamespace = profile.Namespace
+	}
+	t.applyProfileView(name, profile)
+	return nil
+}
+
+// applyProfileView opens a profile's tab and applies its filter to it as a
+// view named after the profile
+func (t *TUI) applyProfileView(name string, profile config.Profile) {
+	if tab, ok := t.tabByName(profile.Tab); ok {
+		t.ActiveTab = tab
+	}
+	if profile.Filter != "" {
+		t.activeViews[int(t.ActiveTab)] = config.SavedView{Name: name, Filter: profile.Filter}
+		t.reapplyView(int(t.ActiveTab))
+	}
+}
+
+// openProfile switches to a profile's context, namespace, tab and filter.
+// The rest of the profile is applied once a switched context is connected.
+func (t *TUI) openProfile(name string) (tea.Cmd, error) {
+	profile, err := t.lookupProfile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.Context != "" && profile.Context != t.context {
+		t.pendingProfile = name
+		cmd, err := t.switchContext(profile.Context)
+		if err != nil {
+			t.pendingProfile = ""
+		}
+		return cmd, err
+	}
+
+	t.logInfo(categoryAction, "Opening profile %s", name)
+	t.applyProfileView(name, profile)
+	cmds := []tea.Cmd{t.handleTabSwitch()}
+	if profile.Namespace != "" && profile.Namespace != t.namespace {
+		t.switchingProject = true
+		t.logInfo(categoryProject, "Switching to %s...", profile.Namespace)
+		cmds = append(cmds, t.switchToProject(projects.ProjectInfo{Name: profile.Namespace}))
+	}
+	return tea.Batch(cmds...), nil
+}
+
+// resumePendingProfile applies the rest of a profile once the cluster of
+// its context is active
+func (t *TUI) resumePendingProfile() tea.Cmd {
+	name := t.pendingProfile
+	t.pendingProfile = ""
+	if name == "" {
+		return nil
+	}
+
+	cmd, err := t.openProfile(name)
+	if err != nil {
+		t.logError(categoryAction, "Cannot open profile %s: %v", name, err)
+	}
+	return cmd
+}
+
+// openProfilePicker opens the profile picker
+func (t *TUI) openProfilePicker() error {
+	if len(t.profileNames()) == 0 {
+		return fmt.Errorf("no profiles in the configuration")
+	}
+	t.showProfilePicker = true
+	t.profilePickerIndex = 0
+	return nil
+}
+
+// profileSummary returns a short description of a profile's working set
+func profileSummary(profile config.Profile) string {
+	var parts []string
+	if profile.Context != "" {
+		parts = append(parts, "context: "+profile.Context)
+	}
+	if profile.Namespace != "" {
+		parts = append(parts, "namespace: "+profile.Namespace)
+	}
+	if profile.Tab != "" {
+		parts = append(parts, "tab: "+profile.Tab)
+	}
+	if profile.Filter != "" {
+		parts = append(parts, "filter: "+profile.Filter)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// renderProfilePicker renders the profile picker modal
+func (t *TUI) renderProfilePicker() string {
+	primaryColor, _ := t.getThemeColors()
+	names := t.profileNames()
+
+	modalWidth := min(100, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🧭 Profiles") + "\n\n")
+
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	for i, name := range names {
+		profile, _ := t.config.Profile(name)
+		line := fmt.Sprintf("  %-24s", truncateString(name, 24))
+		if i == t.profilePickerIndex {
+			line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(line)
+		}
+		line += " " + dimStyle.Render(truncateString(t.obfuscateProfileSummary(profile), max(modalWidth-34, 10)))
+		content.WriteString(line + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: navigate • enter: open • esc: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// obfuscateProfileSummary describes a profile with its context obfuscated
+// like the context in the header
+func (t *TUI) obfuscateProfileSummary(profile config.Profile) string {
+	if profile.Context != "" {
+		profile.Context = t.obfuscateClusterContext(profile.Context)
+	}
+	return profileSummary(profile)
+}
+
+// handleProfilePickerKeys handles key input for the profile picker
+func (t *TUI) handleProfilePickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	names := t.profileNames()
+	if len(names) == 0 {
+		t.showProfilePicker = false
+		return t, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		t.showProfilePicker = false
+
+	case "j", "down":
+		t.profilePickerIndex = (t.profilePickerIndex + 1) % len(names)
+
+	case "k", "up":
+		t.profilePickerIndex = (t.profilePickerIndex + len(names) - 1) % len(names)
+
+	case "enter":
+		t.showProfilePicker = false
+		cmd, err := t.openProfile(names[min(t.profilePickerIndex, len(names)-1)])
+		if err != nil {
+			t.logError(categoryAction, "Cannot open profile: %v", err)
+		}
+		return t, cmd
+	}
+
+	return t, nil
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func profileTestConfig() *config.Config {
+	return &config.Config{Profiles: map[string]config.Profile{
+		"payments-prod": {Context: "prod", Namespace: "payments", Tab: "Deployments", Filter: "labels:app=payments"},
+		"shop":          {Namespace: "shop", Tab: "Routes"},
+	}}
+}
+
+func TestStartProfile(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), config: profileTestConfig(), activeViews: make(map[int]config.SavedView)}
+
+	if err := tui.startProfile("missing"); err == nil {
+		t.Fatal("Expected an error for a missing profile")
+	}
+	if err := tui.startProfile("payments-prod"); err != nil {
+		t.Fatalf("startProfile failed: %v", err)
+	}
+	if tui.startContext != "prod" || tui.startNamespace != "payments" || tui.ActiveTab != models.TabDeployments {
+		t.Errorf("Expected the profile's context, namespace and tab, got %s, %s and %d", tui.startContext, tui.startNamespace, tui.ActiveTab)
+	}
+	view := tui.activeView(int(models.TabDeployments))
+	if view == nil || view.Name != "payments-prod" || view.Filter != "labels:app=payments" {
+		t.Errorf("Expected the profile's filter as the active view, got %+v", view)
+	}
+}
+
+func TestOpenProfile(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, context: "dev", namespace: "dev", config: profileTestConfig(), activeViews: make(map[int]config.SavedView), width: 120, height: 40}
+
+	if _, err := tui.openProfile("payments-prod"); err == nil || tui.pendingProfile != "" {
+		t.Errorf("Expected an unknown context to fail without a pending profile, got %v and %q", err, tui.pendingProfile)
+	}
+
+	if err := tui.openProfilePicker(); err != nil || !tui.showProfilePicker {
+		t.Fatalf("Expected the picker to open, got %v", err)
+	}
+	if view := tui.renderProfilePicker(); !strings.Contains(view, "payments-prod") || !strings.Contains(view, "namespace: shop") {
+		t.Errorf("Expected the picker to list the profiles, got %q", view)
+	}
+
+	tui.handleProfilePickerKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	_, cmd := tui.handleProfilePickerKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if tui.showProfilePicker || cmd == nil {
+		t.Fatal("Expected enter to close the picker and open the profile")
+	}
+	if tui.ActiveTab != models.TabRoutes || !tui.switchingProject {
+		t.Errorf("Expected the routes tab and a switch to the shop namespace, got tab %d", tui.ActiveTab)
+	}
+
+	tui.config = &config.Config{}
+	if err := tui.openProfilePicker(); err == nil {
+		t.Error("Expected no picker without profiles")
+	}
+}
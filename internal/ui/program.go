@@ -2,6 +2,9 @@ package ui
 
 import (
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
 	"github.com/katyella/lazyoc/internal/logging"
 )
 
@@ -13,6 +16,29 @@ type ProgramOptions struct {
 	MouseSupport        bool
 	KubeConfig          string
 	ShowFullClusterInfo bool
+	DryRun              bool // Start with server-side dry run enabled
+	MaxFPS              int  // Maximum redraws per second, constants.MaxRenderFPS when 0
+	SafeMode            bool // No background refreshes, probes or followed logs, for fragile API servers
+
+	// Preferences override the saved preferences for this session, e.g.
+	// from command line flags. Unset fields keep the saved values.
+	Preferences config.Preferences
+
+	// TLS overrides the verification of the API server's certificate for
+	// this session, e.g. from --certificate-authority
+	TLS auth.TLSOverrides
+
+	// AuthHook supplies the connection in place of the kubeconfig's
+	// credentials, e.g. from a company SSO flow. Nil runs the auth hook
+	// command of the preferences, if any.
+	AuthHook auth.ConfigHook
+
+	// Profile is the name of the configured profile to open, "" for none
+	Profile string
+
+	// TeaOptions are passed to the Bubble Tea program after the options
+	// above, e.g. to replace the terminal in end-to-end tests
+	TeaOptions []tea.ProgramOption
 }
 
 // DefaultProgramOptions returns sensible defaults for the TUI program
@@ -23,6 +49,7 @@ func DefaultProgramOptions() ProgramOptions {
 		AltScreen:           true,  // Use alternate screen buffer
 		MouseSupport:        true,  // Enable mouse support for scrolling
 		ShowFullClusterInfo: false, // Obfuscate cluster info by default for security
+		MaxFPS:              constants.MaxRenderFPS,
 	}
 }
 
@@ -35,15 +62,37 @@ func NewProgram(opts ProgramOptions) *tea.Program {
 	if opts.KubeConfig != "" {
 		tui.KubeconfigPath = opts.KubeConfig
 	}
+	tui.dryRun = opts.DryRun
+	tui.safeMode = opts.SafeMode
+	tui.tlsOverrides = opts.TLS
+	tui.overridePreferences(opts.Preferences)
+	tui.authHook = opts.AuthHook
+	if tui.authHook == nil {
+		tui.authHook = authHookFromPreferences(tui.prefs.AuthHook)
+	}
+	if opts.Profile != "" {
+		if err := tui.startProfile(opts.Profile); err != nil {
+			logging.Warn(tui.Logger, "Cannot open profile: %v", err)
+		}
+	}
+
+	// Cap redraws, coalescing changes between frames
+	maxFPS := opts.MaxFPS
+	if maxFPS <= 0 {
+		maxFPS = constants.MaxRenderFPS
+	}
+	tui.setMaxFPS(maxFPS)
 
 	// Configure program options
-	var programOpts []tea.ProgramOption
+	programOpts := []tea.ProgramOption{tea.WithFPS(maxFPS)}
 
 	if opts.AltScreen {
 		programOpts = append(programOpts, tea.WithAltScreen())
 	}
 
-	if opts.MouseSupport {
+	// Mouse support can be turned off by the option or the preferences
+	tui.mouseEnabled = opts.MouseSupport && tui.mouseEnabled
+	if tui.mouseEnabled {
 		// Use all motion for better trackpad support
 		programOpts = append(programOpts, tea.WithMouseAllMotion())
 	}
@@ -51,8 +100,10 @@ func NewProgram(opts ProgramOptions) *tea.Program {
 	// Add input handling (using default stdin, no need to specify nil)
 	// programOpts = append(programOpts, tea.WithInput(nil)) // Use stdin
 
-	logging.Info(tui.Logger, "Creating Bubble Tea program with options: AltScreen=%v, Mouse=%v",
-		opts.AltScreen, opts.MouseSupport)
+	programOpts = append(programOpts, opts.TeaOptions...)
+
+	logging.Info(tui.Logger, "Creating Bubble Tea program with options: AltScreen=%v, Mouse=%v, MaxFPS=%d",
+		opts.AltScreen, tui.mouseEnabled, maxFPS)
 
 	// Create the program
 	program := tea.NewProgram(tui, programOpts...)
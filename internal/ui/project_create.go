@@ -0,0 +1,241 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+)
+
+// ProjectCreateFailedMsg is sent when a project request is refused or fails
+type ProjectCreateFailedMsg struct {
+	Name string
+	Err  error
+}
+
+// ProjectCreateDryRunMsg is sent when the server accepted a dry-run project request
+type ProjectCreateDryRunMsg struct {
+	Name string
+}
+
+// canCreateProject reports whether enter in the project modal should request a
+// new project named after the search, which only happens when nothing matches
+func (t *TUI) canCreateProject() bool {
+	name := strings.TrimSpace(t.projectFilter)
+	return name != "" && len(t.visibleProjects()) == 0 && !t.loadingMoreProjects &&
+		len(validation.IsDNS1123Label(name)) == 0
+}
+
+// projectFormLabels are the fields of the new project form. OpenShift
+// projects also take a display name and a description.
+func (t *TUI) projectFormLabels() []string {
+	if t.projectManager != nil && t.projectManager.GetProjectType() == projects.ProjectTypeOpenShiftProject {
+		return []string{"Name", "Display name", "Description"}
+	}
+	return []string{"Name"}
+}
+
+// openProjectForm opens the new project form of the project modal, starting
+// from the search
+func (t *TUI) openProjectForm() tea.Cmd {
+	labels := t.projectFormLabels()
+	t.projectFormInputs = make([]textinput.Model, len(labels))
+	for i := range t.projectFormInputs {
+		input := textinput.New()
+		input.Width = 50
+		switch i {
+		case 0:
+			input.Placeholder = "name (e.g. team-x)"
+			input.CharLimit = validation.DNS1123LabelMaxLength
+			input.SetValue(strings.TrimSpace(t.projectFilter))
+		case 1:
+			input.Placeholder = "optional, e.g. Team X"
+			input.CharLimit = 253
+		default:
+			input.Placeholder = "optional"
+			input.CharLimit = 1024
+		}
+		t.projectFormInputs[i] = input
+	}
+	t.projectFormFocus = 0
+	t.projectFormInputs[0].Focus()
+	t.projectFormError = ""
+	t.showProjectForm = true
+	return textinput.Blink
+}
+
+// closeProjectForm dismisses the new project form
+func (t *TUI) closeProjectForm() {
+	t.showProjectForm = false
+	t.projectFormInputs = nil
+	t.projectFormError = ""
+}
+
+// submitProjectForm requests the project described by the form. The form
+// stays open with the error when the name is invalid.
+func (t *TUI) submitProjectForm() tea.Cmd {
+	values := make([]string, 3)
+	for i, input := range t.projectFormInputs {
+		values[i] = strings.TrimSpace(input.Value())
+	}
+	name := values[0]
+	if name == "" {
+		t.projectFormError = "A name is required"
+		return nil
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		t.projectFormError = fmt.Sprintf("Invalid name: %s", errs[0])
+		return nil
+	}
+
+	t.closeProjectForm()
+	return t.createProject(name, projects.CreateOptions{DisplayName: values[1], Description: values[2]})
+}
+
+// handleProjectFormKeys handles key input for the new project form
+func (t *TUI) handleProjectFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	count := len(t.projectFormInputs)
+	switch msg.String() {
+	case "esc":
+		t.closeProjectForm()
+		return t, nil
+
+	case "tab", "down":
+		t.focusProjectFormField((t.projectFormFocus + 1) % count)
+		return t, nil
+
+	case "shift+tab", "up":
+		t.focusProjectFormField((t.projectFormFocus + count - 1) % count)
+		return t, nil
+
+	case "enter":
+		return t, t.submitProjectForm()
+	}
+
+	t.projectFormError = ""
+	var cmd tea.Cmd
+	t.projectFormInputs[t.projectFormFocus], cmd = t.projectFormInputs[t.projectFormFocus].Update(msg)
+	return t, cmd
+}
+
+// focusProjectFormField moves focus to the given new project form field
+func (t *TUI) focusProjectFormField(index int) {
+	t.projectFormInputs[t.projectFormFocus].Blur()
+	t.projectFormFocus = index
+	t.projectFormInputs[t.projectFormFocus].Focus()
+}
+
+// renderProjectForm renders the new project form
+func (t *TUI) renderProjectForm() string {
+	primaryColor, errorColor := t.getThemeColors()
+	modalWidth := min(t.width-constants.ProjectModalMinWidth, constants.ProjectModalMaxWidth)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth)
+
+	kind := "namespace"
+	if len(t.projectFormInputs) > 1 {
+		kind = "project"
+	}
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("➕ New "+kind) + "\n\n")
+	for i, input := range t.projectFormInputs {
+		label := fmt.Sprintf("%-12s", t.projectFormLabels()[i])
+		if i == t.projectFormFocus {
+			label = lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Render(label)
+		}
+		content.WriteString(label + " " + input.View() + "\n")
+	}
+
+	if t.projectFormError != "" {
+		content.WriteString("\n" + lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.projectFormError, modalWidth-10)) + "\n")
+	}
+	if t.dryRun {
+		content.WriteString("\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render("Dry run: the request is only validated") + "\n")
+	}
+
+	content.WriteString("\n")
+	if len(t.projectFormInputs) > 1 {
+		content.WriteString("tab/↑↓: next field • ")
+	}
+	content.WriteString("enter: create and switch • esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// createProject requests a new project/namespace and switches to it. In
+// dry-run mode the request is only validated and nothing is switched.
+func (t *TUI) createProject(name string, opts projects.CreateOptions) tea.Cmd {
+	t.creatingProject = true
+	t.creatingProjectName = name
+	t.projectError = ""
+	t.projectErrorDetail = nil
+	opts.DryRun = t.dryRun
+
+	return func() tea.Msg {
+		if t.projectManager == nil {
+			return ProjectErrorMsg{Error: "Project manager not initialized"}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.ClusterDetectionTimeout)
+		defer cancel()
+
+		project, err := t.projectManager.Create(ctx, name, opts)
+		if err != nil {
+			return ProjectCreateFailedMsg{Name: name, Err: err}
+		}
+		if opts.DryRun {
+			return ProjectCreateDryRunMsg{Name: project.Name}
+		}
+
+		result, err := t.projectManager.SwitchTo(ctx, project.Name)
+		if err != nil {
+			return ProjectErrorMsg{Error: fmt.Sprintf("Created '%s' but failed to switch to it: %v", project.Name, err)}
+		}
+		if !result.Success {
+			return ProjectErrorMsg{Error: result.Message}
+		}
+		if result.ProjectInfo != nil {
+			return ProjectSwitchedMsg{Project: *result.ProjectInfo}
+		}
+		return ProjectSwitchedMsg{Project: *project}
+	}
+}
+
+// handleProjectCreateDryRun reports a project request the server would accept
+func (t *TUI) handleProjectCreateDryRun(msg ProjectCreateDryRunMsg) {
+	t.creatingProject = false
+	t.showProjectModal = false
+	t.logSuccess(categoryProject, "Dry run: project '%s' would be created; nothing was changed", msg.Name)
+}
+
+// handleProjectCreateFailed shows why a project request failed. Self-provisioning
+// denials list the required role and who to contact instead of a generic error.
+func (t *TUI) handleProjectCreateFailed(msg ProjectCreateFailedMsg) {
+	t.creatingProject = false
+	t.projectErrorTitle = "Create Failed"
+
+	var denied *projects.ProjectRequestDeniedError
+	if errors.As(msg.Err, &denied) {
+		t.projectError = fmt.Sprintf("Not allowed to create project '%s'", msg.Name)
+		t.projectErrorDetail = denied.Details()
+		t.logError(categoryProject, "Project request for '%s' denied: %s", msg.Name, strings.Join(denied.Details(), " "))
+		return
+	}
+
+	t.projectError = fmt.Sprintf("Failed to create project '%s': %v", msg.Name, msg.Err)
+	t.projectErrorDetail = nil
+	t.logError(categoryProject, "%s", t.projectError)
+}
@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestCanCreateProject(t *testing.T) {
+	tui := &TUI{
+		App:         models.NewApp("test"),
+		projectList: []projects.ProjectInfo{{Name: "frontend"}},
+	}
+
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{"", false},
+		{"front", false},     // matches an existing project
+		{"team-x", true},     // valid, unmatched name
+		{"Team_X", false},    // not a valid project name
+		{"  team-y  ", true}, // surrounding spaces are ignored
+	}
+	for _, tt := range tests {
+		tui.projectFilter = tt.filter
+		if got := tui.canCreateProject(); got != tt.want {
+			t.Errorf("canCreateProject() with filter %q = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestHandleProjectCreateFailed(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), creatingProject: true}
+
+	denied := &projects.ProjectRequestDeniedError{
+		Project:      "team-x",
+		User:         "alice",
+		RequiredRole: projects.SelfProvisionerRole,
+		Contact:      "platform@example.com",
+	}
+	tui.handleProjectCreateFailed(ProjectCreateFailedMsg{Name: "team-x", Err: fmt.Errorf("request failed: %w", denied)})
+
+	if tui.creatingProject {
+		t.Errorf("Expected creating state to be cleared")
+	}
+	if tui.projectErrorTitle != "Create Failed" {
+		t.Errorf("Expected create failure title, got %q", tui.projectErrorTitle)
+	}
+	detail := strings.Join(tui.projectErrorDetail, "\n")
+	if !strings.Contains(detail, projects.SelfProvisionerRole) || !strings.Contains(detail, "platform@example.com") {
+		t.Errorf("Expected role and contact in the failure detail, got %q", detail)
+	}
+}
+
+func TestProjectFormSubmit(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), showProjectModal: true, projectFilter: "team-x"}
+	tui.openProjectForm()
+
+	if len(tui.projectFormInputs) != 1 {
+		t.Fatalf("Expected only a name without an OpenShift project manager, got %d fields", len(tui.projectFormInputs))
+	}
+	if got := tui.projectFormInputs[0].Value(); got != "team-x" {
+		t.Errorf("Expected the form to start from the search, got %q", got)
+	}
+
+	tui.projectFormInputs[0].SetValue("Team_X")
+	if cmd := tui.submitProjectForm(); cmd != nil || !tui.showProjectForm || tui.projectFormError == "" {
+		t.Errorf("Expected an invalid name to keep the form open with an error")
+	}
+
+	tui.projectFormInputs[0].SetValue("team-y")
+	if cmd := tui.submitProjectForm(); cmd == nil {
+		t.Fatalf("Expected a valid name to request the project")
+	}
+	if tui.showProjectForm || !tui.creatingProject || tui.creatingProjectName != "team-y" {
+		t.Errorf("Expected the form to close while team-y is requested")
+	}
+}
@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+)
+
+// ProjectDeletedMsg is sent when a project deletion was accepted
+type ProjectDeletedMsg struct {
+	Name   string
+	DryRun bool
+}
+
+// ProjectDeleteFailedMsg is sent when a project deletion fails
+type ProjectDeleteFailedMsg struct {
+	Name string
+	Err  error
+}
+
+// openProjectDelete asks for the project name to be typed before deleting it
+func (t *TUI) openProjectDelete(name string) {
+	t.deleteProjectName = name
+	t.deleteProjectInput = ""
+}
+
+// closeProjectDelete dismisses the delete confirmation
+func (t *TUI) closeProjectDelete() {
+	t.deleteProjectName = ""
+	t.deleteProjectInput = ""
+}
+
+// canConfirmProjectDelete reports whether the project name was typed
+func (t *TUI) canConfirmProjectDelete() bool {
+	return t.deleteProjectName != "" && t.deleteProjectInput == t.deleteProjectName
+}
+
+// deleteProject deletes a project/namespace with everything in it. In
+// dry-run mode the deletion is only validated.
+func (t *TUI) deleteProject(name string) tea.Cmd {
+	t.deletingProject = true
+	t.projectError = ""
+	t.projectErrorDetail = nil
+	dryRun := t.dryRun
+
+	return func() tea.Msg {
+		if t.projectManager == nil {
+			return ProjectErrorMsg{Error: "Project manager not initialized"}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		if err := t.projectManager.Delete(ctx, name, projects.DeleteOptions{DryRun: dryRun}); err != nil {
+			return ProjectDeleteFailedMsg{Name: name, Err: err}
+		}
+		return ProjectDeletedMsg{Name: name, DryRun: dryRun}
+	}
+}
+
+// handleProjectDeleted reports the deletion and reloads the project list
+func (t *TUI) handleProjectDeleted(msg ProjectDeletedMsg) tea.Cmd {
+	t.deletingProject = false
+	t.closeProjectDelete()
+	if msg.DryRun {
+		t.logSuccess(categoryProject, "Dry run: project '%s' would be deleted; nothing was changed", msg.Name)
+		return nil
+	}
+
+	// The project terminates in the background while its resources are removed
+	t.logSuccess(categoryProject, "Deleted project '%s'", msg.Name)
+	if t.config != nil && t.config.ForgetProject(msg.Name) {
+		if err := t.saveUserConfig(); err != nil {
+			t.logWarn(categoryProject, "Failed to save favorite projects: %v", err)
+		}
+	}
+	if t.currentProject != nil && t.currentProject.Name == msg.Name {
+		t.logWarn(categoryProject, "'%s' was the current project, switch to another one", msg.Name)
+	}
+	t.loadingProjects = true
+	t.loadingMoreProjects = false
+	t.projectContinue = ""
+	return t.loadProjectList()
+}
+
+// handleProjectDeleteFailed shows why a project deletion failed
+func (t *TUI) handleProjectDeleteFailed(msg ProjectDeleteFailedMsg) {
+	t.deletingProject = false
+	t.closeProjectDelete()
+	t.projectErrorTitle = "Delete Failed"
+	t.projectError = fmt.Sprintf("Failed to delete project '%s': %v", msg.Name, msg.Err)
+	t.projectErrorDetail = nil
+	t.logError(categoryProject, "%s", t.projectError)
+}
+
+// handleProjectDeleteKeys handles key input for the delete confirmation,
+// which needs the project name typed
+func (t *TUI) handleProjectDeleteKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		t.closeProjectDelete()
+
+	case tea.KeyEnter:
+		if t.canConfirmProjectDelete() {
+			return t, t.deleteProject(t.deleteProjectName)
+		}
+
+	case tea.KeyBackspace:
+		if len(t.deleteProjectInput) > 0 {
+			t.deleteProjectInput = t.deleteProjectInput[:len(t.deleteProjectInput)-1]
+		}
+
+	case tea.KeyRunes:
+		t.deleteProjectInput += string(msg.Runes)
+	}
+	return t, nil
+}
+
+// renderProjectDelete renders the project delete confirmation
+func (t *TUI) renderProjectDelete() string {
+	color := lipgloss.Color("9")
+	modalWidth := min(t.width-constants.ProjectModalMinWidth, constants.ProjectModalMaxWidth)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color).
+		Padding(1).
+		Width(modalWidth)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Foreground(color).Render("🗑 Delete Project") + "\n\n")
+	content.WriteString(fmt.Sprintf("Project: %s\n\n", t.deleteProjectName))
+
+	if t.deletingProject {
+		content.WriteString("Deleting project...\n\nesc: close")
+		return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modalStyle.Render(content.String()))
+	}
+
+	content.WriteString("Every resource in the project is deleted with it.\nThis cannot be undone.\n")
+	if t.currentProject != nil && t.currentProject.Name == t.deleteProjectName {
+		content.WriteString(lipgloss.NewStyle().Foreground(color).Render("This is the current project.") + "\n")
+	}
+	if t.dryRun {
+		content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render("Dry run: the deletion is only validated") + "\n")
+	}
+	content.WriteString(fmt.Sprintf("\nType the project name to confirm: %s█\n\n", t.deleteProjectInput))
+	content.WriteString("enter: delete • esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
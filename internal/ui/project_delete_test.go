@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestProjectDeleteNeedsTypedName(t *testing.T) {
+	tui := &TUI{
+		App:              models.NewApp("test"),
+		showProjectModal: true,
+		projectList:      []projects.ProjectInfo{{Name: "frontend"}, {Name: "backend"}},
+		selectedProject:  1,
+	}
+
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if tui.deleteProjectName != "backend" {
+		t.Fatalf("Expected ctrl+d to confirm deleting the selected project, got %q", tui.deleteProjectName)
+	}
+
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("back")})
+	if _, cmd := tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil || tui.deletingProject {
+		t.Errorf("Expected no deletion before the whole name is typed")
+	}
+
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("end")})
+	if _, cmd := tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil || !tui.deletingProject {
+		t.Errorf("Expected the typed name to delete the project")
+	}
+}
+
+func TestHandleProjectDeleteFailed(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), deletingProject: true, deleteProjectName: "backend"}
+
+	tui.handleProjectDeleteFailed(ProjectDeleteFailedMsg{Name: "backend", Err: errors.New("forbidden")})
+
+	if tui.deletingProject || tui.deleteProjectName != "" {
+		t.Errorf("Expected the confirmation to close")
+	}
+	if tui.projectErrorTitle != "Delete Failed" || tui.projectError == "" {
+		t.Errorf("Expected the failure in the project modal, got %q: %q", tui.projectErrorTitle, tui.projectError)
+	}
+}
@@ -0,0 +1,225 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// projectQuotasKey opens the quotas and limit ranges of the current project,
+// or of the selected one in the project switcher, where it cannot be part of
+// a search as project names cannot contain %
+const projectQuotasKey = "%"
+
+// quotaBarWidth is the width of the usage bars of the quota view
+const quotaBarWidth = 20
+
+// openProjectQuotas shows the quota view of a project and loads it
+func (t *TUI) openProjectQuotas(name string) tea.Cmd {
+	if !t.connected || t.projectManager == nil || name == "" {
+		return nil
+	}
+	t.showProjectQuotas = true
+	t.quotaProjectName = name
+	t.quotaProject = nil
+	t.quotaError = ""
+	t.quotaScroll = 0
+	t.loadingQuotas = true
+
+	manager := t.projectManager
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		project, err := manager.Get(ctx, name)
+		return messages.ProjectQuotasLoaded{Name: name, Project: project, Err: err}
+	}
+}
+
+// handleProjectQuotasLoaded shows the fetched quotas, unless the view was
+// closed or opened for another project meanwhile
+func (t *TUI) handleProjectQuotasLoaded(msg messages.ProjectQuotasLoaded) {
+	if !t.showProjectQuotas || msg.Name != t.quotaProjectName {
+		return
+	}
+	t.loadingQuotas = false
+	if msg.Err != nil {
+		t.quotaError = msg.Err.Error()
+		return
+	}
+	t.quotaProject = msg.Project
+}
+
+// quotaUsage returns used as a percentage of a hard quota
+func quotaUsage(used, hard string) (int, bool) {
+	hardQuantity, err := resource.ParseQuantity(hard)
+	if err != nil || hardQuantity.Sign() <= 0 {
+		return 0, false
+	}
+	usedQuantity, err := resource.ParseQuantity(used)
+	if err != nil {
+		return 0, false
+	}
+	return int(usedQuantity.AsApproximateFloat64() * 100 / hardQuantity.AsApproximateFloat64()), true
+}
+
+// quotaLevel returns the status of a quota filled to percent
+func quotaLevel(percent int) statusLevel {
+	switch {
+	case percent >= 100:
+		return statusFailed
+	case percent >= 80:
+		return statusWarn
+	}
+	return statusOK
+}
+
+// usageBar renders percent as a bar of width cells
+func usageBar(percent, width int) string {
+	filled := min(max(percent, 0), 100) * width / 100
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+// sortedKeys returns the keys of resource maps in order, each once
+func sortedKeys(maps ...map[string]string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// projectQuotaLines renders the quotas of a project with usage bars and the
+// defaults and bounds of its limit ranges
+func (t *TUI) projectQuotaLines(project *projects.ProjectInfo) []string {
+	orNone := func(value string) string {
+		if value == "" {
+			return "-"
+		}
+		return value
+	}
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render("Resource quotas")}
+	if len(project.ResourceQuotas) == 0 {
+		lines = append(lines, "  none, pods may use what the nodes have")
+	}
+	for _, quota := range project.ResourceQuotas {
+		header := "  " + quota.Name
+		if len(quota.Scopes) > 0 {
+			header += fmt.Sprintf(" (scopes: %s)", strings.Join(quota.Scopes, ", "))
+		}
+		lines = append(lines, header)
+		for _, name := range sortedKeys(quota.Hard) {
+			used, hard := orNone(quota.Used[name]), quota.Hard[name]
+			line := fmt.Sprintf("    %-28s ", truncateString(name, 28))
+			if percent, ok := quotaUsage(used, hard); ok {
+				level := quotaLevel(percent)
+				line += t.statusStyle(level).Render(usageBar(percent, quotaBarWidth)) +
+					fmt.Sprintf(" %s / %s (%s%d%%)", used, hard, t.statusPrefix(level), percent)
+			} else {
+				line += fmt.Sprintf("%s %s / %s", strings.Repeat(" ", quotaBarWidth), used, hard)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	lines = append(lines, "", lipgloss.NewStyle().Bold(true).Render("Limit ranges"))
+	if len(project.LimitRanges) == 0 {
+		lines = append(lines, "  none, containers without requests and limits get no defaults")
+	}
+	for _, limitRange := range project.LimitRanges {
+		lines = append(lines, "  "+limitRange.Name,
+			fmt.Sprintf("    %-22s %-18s %-10s %-10s %-10s %s", "TYPE", "RESOURCE", "REQUEST", "LIMIT", "MIN", "MAX"))
+		for _, item := range limitRange.Limits {
+			for _, name := range sortedKeys(item.DefaultRequest, item.Default, item.Min, item.Max) {
+				lines = append(lines, fmt.Sprintf("    %-22s %-18s %-10s %-10s %-10s %s",
+					truncateString(item.Type, 22), truncateString(name, 18), orNone(item.DefaultRequest[name]),
+					orNone(item.Default[name]), orNone(item.Min[name]), orNone(item.Max[name])))
+			}
+		}
+	}
+	return lines
+}
+
+// quotaVisibleRows returns the number of lines the quota view has room for
+func (t *TUI) quotaVisibleRows() int {
+	return max(t.height-12, 3)
+}
+
+// renderProjectQuotas renders the quota view
+func (t *TUI) renderProjectQuotas() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(110, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("📊 Quotas: "+t.quotaProjectName) + "\n\n")
+
+	switch {
+	case t.loadingQuotas:
+		content.WriteString(fmt.Sprintf("%s Loading quotas and limit ranges...\n", t.getLoadingSpinner()))
+	case t.quotaError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.quotaError, modalWidth-10)) + "\n")
+	case t.quotaProject != nil:
+		lines := t.projectQuotaLines(t.quotaProject)
+		visible := t.quotaVisibleRows()
+		start := min(t.quotaScroll, max(len(lines)-visible, 0))
+		end := min(start+visible, len(lines))
+		for _, line := range lines[start:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d lines]\n", start+1, end, len(lines)))
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: scroll • r: reload • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleProjectQuotasKeys handles key input for the quota view
+func (t *TUI) handleProjectQuotasKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", projectQuotasKey:
+		t.showProjectQuotas = false
+
+	case "r":
+		return t, t.openProjectQuotas(t.quotaProjectName)
+
+	case "j", "down":
+		if t.quotaProject != nil && t.quotaScroll < len(t.projectQuotaLines(t.quotaProject))-t.quotaVisibleRows() {
+			t.quotaScroll++
+		}
+
+	case "k", "up":
+		if t.quotaScroll > 0 {
+			t.quotaScroll--
+		}
+	}
+
+	return t, nil
+}
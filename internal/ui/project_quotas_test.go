@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestProjectQuotas(t *testing.T) {
+	clientset := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		&corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "shop"},
+			Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: apiresource.MustParse("4"),
+				corev1.ResourcePods:        apiresource.MustParse("10"),
+			}},
+			Status: corev1.ResourceQuotaStatus{Used: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: apiresource.MustParse("1"),
+				corev1.ResourcePods:        apiresource.MustParse("10"),
+			}},
+		},
+		&corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "shop"},
+			Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+				Type:           corev1.LimitTypeContainer,
+				Default:        corev1.ResourceList{corev1.ResourceMemory: apiresource.MustParse("512Mi")},
+				DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: apiresource.MustParse("256Mi")},
+			}}},
+		},
+	)
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", height: 40,
+		projectManager: projects.NewKubernetesNamespaceManager(clientset, nil, "")}
+
+	cmd := tui.openProjectQuotas("shop")
+	if cmd == nil || !tui.showProjectQuotas || !tui.loadingQuotas {
+		t.Fatal("Expected the quota view to open and load")
+	}
+	tui.handleProjectQuotasLoaded(cmd().(messages.ProjectQuotasLoaded))
+	if tui.loadingQuotas || tui.quotaProject == nil {
+		t.Fatalf("Expected the quotas to be loaded, got error %q", tui.quotaError)
+	}
+
+	lines := strings.Join(tui.projectQuotaLines(tui.quotaProject), "\n")
+	for _, want := range []string{
+		"requests.cpu                 █████░░░░░░░░░░░░░░░ 1 / 4 (25%)",
+		"pods                         ████████████████████ 10 / 10 (100%)",
+		"Container              memory             256Mi      512Mi      -          -",
+	} {
+		if !strings.Contains(lines, want) {
+			t.Errorf("Expected %q in the quota view, got\n%s", want, lines)
+		}
+	}
+
+	// A reply for a project no longer shown is ignored
+	tui.handleProjectQuotasLoaded(messages.ProjectQuotasLoaded{Name: "other", Project: &projects.ProjectInfo{Name: "other"}})
+	if tui.quotaProject.Name != "shop" {
+		t.Errorf("Expected the quotas of another project to be ignored")
+	}
+
+	tui.handleProjectQuotasKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showProjectQuotas {
+		t.Errorf("Expected esc to close the quota view")
+	}
+}
+
+func TestProjectQuotasFromProjectModal(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, showProjectModal: true,
+		projectManager: projects.NewKubernetesNamespaceManager(fake.NewSimpleClientset(), nil, ""),
+		projectList:    []projects.ProjectInfo{{Name: "frontend"}, {Name: "backend"}}, selectedProject: 1}
+
+	if _, cmd := tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(projectQuotasKey)}); cmd == nil {
+		t.Fatal("Expected % to load the quotas of the selected project")
+	}
+	if !tui.showProjectQuotas || tui.quotaProjectName != "backend" || tui.projectFilter != "" {
+		t.Errorf("Expected the quotas of backend without searching, got %q and search %q", tui.quotaProjectName, tui.projectFilter)
+	}
+}
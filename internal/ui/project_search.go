@@ -0,0 +1,178 @@
+package ui
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+)
+
+// projectMatches reports whether a project matches the project modal search.
+// The search is a case-insensitive substring match on name, display name and description.
+func projectMatches(project projects.ProjectInfo, filter string) bool {
+	if filter == "" {
+		return true
+	}
+	filter = strings.ToLower(filter)
+	return strings.Contains(strings.ToLower(project.Name), filter) ||
+		strings.Contains(strings.ToLower(project.DisplayName), filter) ||
+		strings.Contains(strings.ToLower(project.Description), filter)
+}
+
+// Sections of the project modal, in the order they are listed
+const (
+	projectSectionFavorites = iota
+	projectSectionRecent
+	projectSectionAll
+)
+
+// projectSectionTitles are the headers of the project modal sections
+var projectSectionTitles = [...]string{"★ Favorites", "Recent", "All projects"}
+
+// projectSection returns the section of the project modal listing a project
+func (t *TUI) projectSection(name string) int {
+	switch {
+	case t.config == nil:
+		return projectSectionAll
+	case t.config.IsFavoriteProject(name):
+		return projectSectionFavorites
+	case slices.Contains(t.config.Projects.Recent, name):
+		return projectSectionRecent
+	}
+	return projectSectionAll
+}
+
+// visibleProjects returns the loaded projects matching the current search:
+// the favorites by name, then the recent projects, most recent first, then
+// the others in the order the server listed them
+func (t *TUI) visibleProjects() []projects.ProjectInfo {
+	var matches []projects.ProjectInfo
+	for _, project := range t.projectList {
+		if projectMatches(project, t.projectFilter) {
+			matches = append(matches, project)
+		}
+	}
+	if t.config == nil {
+		return matches
+	}
+
+	slices.SortStableFunc(matches, func(a, b projects.ProjectInfo) int {
+		sectionA, sectionB := t.projectSection(a.Name), t.projectSection(b.Name)
+		switch {
+		case sectionA != sectionB:
+			return cmp.Compare(sectionA, sectionB)
+		case sectionA == projectSectionFavorites:
+			return strings.Compare(a.Name, b.Name)
+		case sectionA == projectSectionRecent:
+			return cmp.Compare(slices.Index(t.config.Projects.Recent, a.Name), slices.Index(t.config.Projects.Recent, b.Name))
+		}
+		return 0
+	})
+	return matches
+}
+
+// toggleFavoriteProject stars or unstars the selected project, keeping it
+// selected as it moves between sections
+func (t *TUI) toggleFavoriteProject() {
+	visible := t.visibleProjects()
+	if t.config == nil || t.selectedProject < 0 || t.selectedProject >= len(visible) {
+		return
+	}
+
+	name := visible[t.selectedProject].Name
+	if t.config.ToggleFavoriteProject(name) {
+		t.logInfo(categoryProject, "Starred project '%s'", name)
+	} else {
+		t.logInfo(categoryProject, "Unstarred project '%s'", name)
+	}
+	if err := t.saveUserConfig(); err != nil {
+		t.logError(categoryProject, "Failed to save favorite projects: %v", err)
+	}
+
+	for i, project := range t.visibleProjects() {
+		if project.Name == name {
+			t.selectedProject = i
+			break
+		}
+	}
+}
+
+// rememberProject records a project switched to as the most recent one
+func (t *TUI) rememberProject(name string) {
+	if t.config == nil {
+		return
+	}
+	t.config.AddRecentProject(name)
+	if err := t.saveUserConfig(); err != nil {
+		t.logWarn(categoryProject, "Failed to save recent projects: %v", err)
+	}
+}
+
+// setProjectFilter updates the search and keeps the selection within the matches
+func (t *TUI) setProjectFilter(filter string) {
+	t.projectFilter = filter
+	t.selectedProject = 0
+}
+
+// maybeLoadMoreProjects fetches the next page of projects when the selection nears
+// the end of what has been loaded, or while a search is active so it covers every project
+func (t *TUI) maybeLoadMoreProjects() tea.Cmd {
+	if t.projectContinue == "" || t.loadingMoreProjects || t.projectManager == nil {
+		return nil
+	}
+	if t.projectFilter == "" && t.selectedProject < len(t.projectList)-constants.ProjectPrefetchThreshold {
+		return nil
+	}
+
+	t.loadingMoreProjects = true
+	token := t.projectContinue
+	return func() tea.Msg {
+		page, err := loadWithRetry(t, "projects", func(ctx context.Context) (*projects.ProjectPage, error) {
+			return t.projectManager.ListPage(ctx, projects.ListOptions{
+				Limit:    constants.ProjectPageSize,
+				Continue: token,
+			})
+		})
+		if err != nil {
+			return ProjectPageLoadedMsg{Err: err}
+		}
+		return ProjectPageLoadedMsg{Projects: page.Items, Continue: page.Continue}
+	}
+}
+
+// handleProjectPageLoaded appends a further page of projects to the project modal
+func (t *TUI) handleProjectPageLoaded(msg ProjectPageLoadedMsg) tea.Cmd {
+	if !t.loadingMoreProjects {
+		// The list was refreshed or the modal closed while the page was loading
+		return nil
+	}
+	t.loadingMoreProjects = false
+
+	if msg.Err != nil {
+		// Continue tokens expire; stop paging and keep what has been loaded
+		t.projectContinue = ""
+		t.logWarn(categoryProject, "Stopped loading more projects: %v", msg.Err)
+		return nil
+	}
+
+	// Favorites and recent projects on the page move to the top, so the
+	// selection follows the selected project rather than its index
+	selected := ""
+	if visible := t.visibleProjects(); t.selectedProject >= 0 && t.selectedProject < len(visible) {
+		selected = visible[t.selectedProject].Name
+	}
+	t.projectList = append(t.projectList, msg.Projects...)
+	t.projectContinue = msg.Continue
+	for i, project := range t.visibleProjects() {
+		if project.Name == selected {
+			t.selectedProject = i
+			break
+		}
+	}
+	return t.maybeLoadMoreProjects()
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestProjectModalSearch(t *testing.T) {
+	tui := &TUI{
+		App:              models.NewApp("test"),
+		showProjectModal: true,
+		projectList: []projects.ProjectInfo{
+			{Name: "billing-dev", Description: "Payments team"},
+			{Name: "frontend"},
+			{Name: "payments-prod", DisplayName: "Payments"},
+		},
+	}
+
+	for _, r := range "pay" {
+		tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if got := len(tui.visibleProjects()); got != 2 {
+		t.Fatalf("Expected 2 projects matching 'pay', got %d", got)
+	}
+
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyDown})
+	if tui.selectedProject != 1 {
+		t.Errorf("Expected selection to move within the matches, got %d", tui.selectedProject)
+	}
+
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyBackspace})
+	if tui.projectFilter != "pa" {
+		t.Errorf("Expected backspace to remove the last character, got %q", tui.projectFilter)
+	}
+
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.projectFilter != "" || !tui.showProjectModal {
+		t.Errorf("Expected esc to clear the search before closing the modal")
+	}
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showProjectModal {
+		t.Errorf("Expected a second esc to close the modal")
+	}
+}
+
+func TestProjectPageLoaded(t *testing.T) {
+	tui := &TUI{
+		App:                 models.NewApp("test"),
+		projectList:         []projects.ProjectInfo{{Name: "a"}},
+		projectContinue:     "token-1",
+		loadingMoreProjects: true,
+	}
+
+	tui.handleProjectPageLoaded(ProjectPageLoadedMsg{Projects: []projects.ProjectInfo{{Name: "b"}}})
+	if len(tui.projectList) != 2 || tui.projectContinue != "" || tui.loadingMoreProjects {
+		t.Errorf("Expected the last page to be appended, got %v (continue %q)", tui.projectList, tui.projectContinue)
+	}
+
+	// Pages arriving after a refresh are dropped
+	tui.handleProjectPageLoaded(ProjectPageLoadedMsg{Projects: []projects.ProjectInfo{{Name: "stale"}}})
+	if len(tui.projectList) != 2 {
+		t.Errorf("Expected stale page to be ignored, got %v", tui.projectList)
+	}
+
+	tui.loadingMoreProjects = true
+	tui.projectContinue = "expired"
+	tui.handleProjectPageLoaded(ProjectPageLoadedMsg{Err: errors.New("410 gone")})
+	if tui.projectContinue != "" {
+		t.Errorf("Expected paging to stop after an error")
+	}
+}
+
+func TestProjectFavoritesAndRecents(t *testing.T) {
+	tui := &TUI{
+		App:        models.NewApp("test"),
+		config:     &config.Config{},
+		configPath: filepath.Join(t.TempDir(), "config.json"),
+		projectList: []projects.ProjectInfo{
+			{Name: "alpha"}, {Name: "beta"}, {Name: "gamma"}, {Name: "delta"},
+		},
+		showProjectModal: true,
+	}
+	tui.config.AddRecentProject("beta")
+	tui.config.AddRecentProject("delta")
+
+	tui.selectedProject = 3 // gamma, after the recent delta and beta and alpha
+	tui.handleProjectModalKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	if tui.projectFilter != "" {
+		t.Errorf("Expected * to star the project rather than search, got %q", tui.projectFilter)
+	}
+
+	var names []string
+	for _, project := range tui.visibleProjects() {
+		names = append(names, project.Name)
+	}
+	if got := strings.Join(names, ","); got != "gamma,delta,beta,alpha" {
+		t.Errorf("Expected favorites, then recents, then the rest, got %s", got)
+	}
+	if tui.selectedProject != 0 {
+		t.Errorf("Expected the selection to follow the starred project, got %d", tui.selectedProject)
+	}
+
+	loaded, err := config.Load(tui.configPath)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if !loaded.IsFavoriteProject("gamma") {
+		t.Errorf("Expected the favorite to be saved to the config file")
+	}
+}
@@ -0,0 +1,24 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// reconnect initializes the client again after a lost connection, a retry or
+// refreshed credentials. Once connected, it returns to the context and
+// namespace of the previous connection instead of the kubeconfig's current
+// ones; the tab, selections and followed logs carry over as they are.
+func (t *TUI) reconnect() tea.Cmd {
+	if t.context != "" {
+		t.startContext = t.context
+		t.startNamespace = t.namespace
+	}
+	return t.InitializeK8sClient(t.KubeconfigPath)
+}
+
+// resumesLogStream reports whether the logs shown are of a pod whose stream
+// was stopped by a reconnect, so that its stream continues where it left off
+// instead of loading the logs afresh
+func (t *TUI) resumesLogStream(podName string) bool {
+	return t.currentPodName == "" && t.logStreamResumePod == podName && len(t.podLogs) > 0
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestReconnectKeepsContextAndNamespace(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), namespace: "default"}
+
+	// The first connection opens the kubeconfig's context and namespace
+	if tui.reconnect(); tui.startContext != "" || tui.startNamespace != "" {
+		t.Errorf("Expected no context before the first connection, got %q and %q", tui.startContext, tui.startNamespace)
+	}
+
+	tui.context, tui.namespace = "prod", "shop"
+	tui.reconnect()
+	if tui.startContext != "prod" || tui.startNamespace != "shop" {
+		t.Errorf("Expected the reconnect to return to prod and shop, got %q and %q", tui.startContext, tui.startNamespace)
+	}
+}
+
+func TestReconnectResumesLogStream(t *testing.T) {
+	pods := []resources.PodInfo{testPod("api-1", "Running", "node-a", 0, time.Hour), testPod("api-2", "Running", "node-a", 0, time.Hour)}
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", pods: pods, selectedPod: 1,
+		podLogs: []string{"started", "listening on :8080"}, logStreamResumePod: "api-2", logStreamLastLineAt: time.Now(),
+		tailMode: false, logScrollOffset: 1}
+
+	// The reconnect stopped the stream, the reloaded pods start it again
+	tui.stopPodLogStream()
+	tui.Update(messages.PodsLoaded{Pods: pods})
+
+	if tui.selectedPod != 1 || tui.currentPodName != "api-2" {
+		t.Fatalf("Expected the selected pod and its stream to carry over, got pod %d streaming %q", tui.selectedPod, tui.currentPodName)
+	}
+	if len(tui.podLogs) != 2 || tui.tailMode || tui.logScrollOffset != 1 || tui.logResumeOverlap == nil {
+		t.Errorf("Expected the logs to resume where they were, got %d lines at %d", len(tui.podLogs), tui.logScrollOffset)
+	}
+
+	// Logs of another pod load afresh
+	tui.stopPodLogStream()
+	tui.selectedPod = 0
+	tui.Update(messages.PodsLoaded{Pods: pods})
+	if len(tui.podLogs) != 0 || tui.currentPodName != "api-1" {
+		t.Errorf("Expected the logs of api-1 to load afresh, got %d lines of %q", len(tui.podLogs), tui.currentPodName)
+	}
+}
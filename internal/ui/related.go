@@ -0,0 +1,196 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// relatedTabs are the tabs listing each kind of related resource
+var relatedTabs = map[string]models.TabType{
+	"Pod":        models.TabPods,
+	"ReplicaSet": models.TabReplicaSets,
+	"Service":    models.TabServices,
+}
+
+// selectedRelatedSource returns the kind, namespace and name of the selected
+// resource whose related resources g jumps to
+func (t *TUI) selectedRelatedSource() (string, string, string, bool) {
+	switch t.ActiveTab {
+	case models.TabServices:
+		if t.selectedService < len(t.services) {
+			svc := t.services[t.selectedService]
+			return "Service", svc.Namespace, svc.Name, true
+		}
+	case models.TabDeployments:
+		if t.selectedDeployment < len(t.deployments) {
+			deploy := t.deployments[t.selectedDeployment]
+			return "Deployment", deploy.Namespace, deploy.Name, true
+		}
+	case models.TabRoutes:
+		if t.selectedRoute < len(t.routes) {
+			route := t.routes[t.selectedRoute]
+			return "Route", route.Namespace, route.Name, true
+		}
+	}
+	return "", "", "", false
+}
+
+// openRelated resolves the resources related to the selected service,
+// deployment or route
+func (t *TUI) openRelated() tea.Cmd {
+	kind, namespace, name, ok := t.selectedRelatedSource()
+	if !ok || !t.connected || t.loadingRelated {
+		return nil
+	}
+	resolver, ok := t.resourceClient.(resources.RelationResolver)
+	if !ok {
+		t.logWarn(categoryAction, "Related resources are not supported by the resource client")
+		return nil
+	}
+
+	t.loadingRelated = true
+	namespace = t.resourceNamespace(namespace)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+		relations, err := resolver.RelatedResources(ctx, kind, namespace, name)
+		return messages.RelatedResourcesLoaded{Kind: kind, Namespace: namespace, Name: name, Relations: relations, Err: err}
+	}
+}
+
+// handleRelatedResourcesLoaded jumps to the related resources, or lets the
+// user pick which kind of them to jump to
+func (t *TUI) handleRelatedResourcesLoaded(msg messages.RelatedResourcesLoaded) tea.Cmd {
+	t.loadingRelated = false
+	source := strings.ToLower(msg.Kind) + "/" + msg.Name
+	if msg.Err != nil {
+		t.logError(categoryAction, "Cannot find the resources related to %s: %v", source, msg.Err)
+		return nil
+	}
+
+	var relations []resources.Relation
+	for _, relation := range msg.Relations {
+		if len(relation.Names) > 0 {
+			relations = append(relations, relation)
+		}
+	}
+
+	switch len(relations) {
+	case 0:
+		t.logWarn(categoryAction, "Nothing is related to %s", source)
+		return nil
+	case 1:
+		return t.navigateRelated(source, relations[0])
+	}
+	t.relatedSource = source
+	t.relatedRelations = relations
+	t.relatedPickerIndex = 0
+	t.showRelatedPicker = true
+	return nil
+}
+
+// relationFilter returns the view filter listing related resources: their
+// labels when they are selected by equality, which keeps pods created later
+// in the list, or else their names
+func relationFilter(relation resources.Relation) string {
+	if relation.Selector != "" {
+		if set, err := labels.ConvertSelectorToLabelsMap(relation.Selector); err == nil && len(set) > 0 {
+			terms := make([]string, 0, len(set))
+			for key, value := range set {
+				terms = append(terms, "label:"+key+"="+value)
+			}
+			sort.Strings(terms)
+			return strings.Join(terms, " ")
+		}
+	}
+	return "name=" + strings.Join(relation.Names, ",")
+}
+
+// navigateRelated switches to the tab of the related resources and lists
+// only them
+func (t *TUI) navigateRelated(source string, relation resources.Relation) tea.Cmd {
+	tab, ok := relatedTabs[relation.Kind]
+	if !ok {
+		return nil
+	}
+
+	filter := relationFilter(relation)
+	if t.allNamespaces {
+		filter += " namespace=" + relation.Namespace
+	}
+
+	t.ActiveTab = tab
+	t.focusedPanel = 0
+	viewCmd := t.setActiveView(&config.SavedView{Name: "related to " + source, Filter: filter})
+	t.logInfo(categoryAction, "Listing %d %s(s) related to %s", len(relation.Names), relation.Kind, source)
+	return tea.Batch(t.handleTabSwitch(), viewCmd)
+}
+
+// renderRelatedPicker renders the choice between the kinds of related
+// resources
+func (t *TUI) renderRelatedPicker() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(70, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🔗 Related to "+t.relatedSource) + "\n\n")
+
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	for i, relation := range t.relatedRelations {
+		line := fmt.Sprintf("  %-16s", relation.Kind+"s")
+		if i == t.relatedPickerIndex {
+			line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(line)
+		}
+		line += " " + dimStyle.Render(truncateString(fmt.Sprintf("%d: %s", len(relation.Names), strings.Join(relation.Names, ", ")), max(modalWidth-26, 10)))
+		content.WriteString(line + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: navigate • enter: jump • esc: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleRelatedPickerKeys handles key input for the related resource picker
+func (t *TUI) handleRelatedPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if len(t.relatedRelations) == 0 {
+		t.showRelatedPicker = false
+		return t, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		t.showRelatedPicker = false
+
+	case "j", "down":
+		t.relatedPickerIndex = (t.relatedPickerIndex + 1) % len(t.relatedRelations)
+
+	case "k", "up":
+		t.relatedPickerIndex = (t.relatedPickerIndex + len(t.relatedRelations) - 1) % len(t.relatedRelations)
+
+	case "enter":
+		t.showRelatedPicker = false
+		return t, t.navigateRelated(t.relatedSource, t.relatedRelations[min(t.relatedPickerIndex, len(t.relatedRelations)-1)])
+	}
+
+	return t, nil
+}
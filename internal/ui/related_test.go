@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestRelationFilter(t *testing.T) {
+	tests := []struct {
+		relation resources.Relation
+		expected string
+	}{
+		{resources.Relation{Kind: "Pod", Selector: "tier=front,app=web", Names: []string{"web-1"}}, "label:app=web label:tier=front"},
+		{resources.Relation{Kind: "Pod", Selector: "app in (web,api)", Names: []string{"web-1", "api-1"}}, "name=web-1,api-1"},
+		{resources.Relation{Kind: "Service", Names: []string{"shop", "shop-canary"}}, "name=shop,shop-canary"},
+	}
+	for _, test := range tests {
+		if got := relationFilter(test.relation); got != test.expected {
+			t.Errorf("relationFilter(%+v) = %q, expected %q", test.relation, got, test.expected)
+		}
+	}
+}
+
+func TestNavigateRelated(t *testing.T) {
+	pods := []resources.PodInfo{
+		testPod("web-1", "Running", "node-a", 0, time.Hour),
+		testPod("web-2", "Running", "node-a", 0, time.Hour),
+		testPod("db-1", "Running", "node-a", 0, time.Hour),
+	}
+	pods[0].Labels = map[string]string{"app": "web"}
+	pods[1].Labels = map[string]string{"app": "web"}
+	replicaSets := []resources.ReplicaSetInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web-7d9f"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "web-5c8b"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "db-6f7a"}},
+	}
+	tui := &TUI{App: models.NewApp("test"), allPods: pods, pods: pods, allReplicaSets: replicaSets, replicaSets: replicaSets,
+		activeViews: make(map[int]config.SavedView), width: 120, height: 40}
+	tui.ActiveTab = models.TabDeployments
+
+	// A deployment has ReplicaSets and pods, the user picks which to list
+	tui.handleRelatedResourcesLoaded(messages.RelatedResourcesLoaded{Kind: "Deployment", Name: "web", Relations: []resources.Relation{
+		{Kind: "ReplicaSet", Names: []string{"web-5c8b", "web-7d9f"}},
+		{Kind: "Pod", Selector: "app=web", Names: []string{"web-1", "web-2"}},
+		{Kind: "Service"},
+	}})
+	if !tui.showRelatedPicker || len(tui.relatedRelations) != 2 {
+		t.Fatalf("Expected a picker of the ReplicaSets and pods, got %+v", tui.relatedRelations)
+	}
+	if view := tui.renderRelatedPicker(); !strings.Contains(view, "deployment/web") || !strings.Contains(view, "ReplicaSets") {
+		t.Errorf("Expected the picker to name the deployment and the kinds, got %q", view)
+	}
+
+	tui.handleRelatedPickerKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if tui.showRelatedPicker || tui.ActiveTab != models.TabReplicaSets {
+		t.Fatalf("Expected enter to jump to the ReplicaSets tab, got tab %d", tui.ActiveTab)
+	}
+	if len(tui.replicaSets) != 2 || tui.replicaSets[0].Name != "web-7d9f" || tui.replicaSets[1].Name != "web-5c8b" {
+		t.Errorf("Expected only the deployment's ReplicaSets, got %+v", tui.replicaSets)
+	}
+
+	// A service has only pods, it jumps straight to them
+	tui.ActiveTab = models.TabServices
+	tui.handleRelatedResourcesLoaded(messages.RelatedResourcesLoaded{Kind: "Service", Name: "web", Relations: []resources.Relation{
+		{Kind: "Pod", Selector: "app=web", Names: []string{"web-1", "web-2"}},
+	}})
+	if tui.showRelatedPicker || tui.ActiveTab != models.TabPods {
+		t.Fatalf("Expected to jump to the pods tab, got tab %d", tui.ActiveTab)
+	}
+	view := tui.activeView(int(models.TabPods))
+	if view == nil || view.Name != "related to service/web" || len(tui.pods) != 2 {
+		t.Errorf("Expected the pods selected by the service, got %+v and %d pods", view, len(tui.pods))
+	}
+}
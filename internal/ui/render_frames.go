@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// renderFrameMsg draws the changes held back since the last frame
+type renderFrameMsg struct{}
+
+// Update implements tea.Model. Bubble Tea redraws the view after every
+// message, so bursts of spinner ticks, streamed log lines and refreshes are
+// coalesced: changes arriving within a frame of the last render are drawn
+// once, at the end of that frame.
+func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(renderFrameMsg); ok {
+		t.framePending = false
+		return t, nil
+	}
+
+	model, cmd := t.update(msg)
+	t.viewDirty = true
+	return model, tea.Batch(cmd, t.scheduleFrame())
+}
+
+// View implements tea.Model. It serves the last frame while nothing changed,
+// or while a frame is scheduled for changes made too soon after it.
+func (t *TUI) View() string {
+	if t.frameInterval <= 0 {
+		return t.render()
+	}
+
+	if t.lastFrame != "" && (!t.viewDirty || t.framePending) {
+		return t.lastFrame
+	}
+
+	t.lastFrame = t.render()
+	t.lastRender = time.Now()
+	t.viewDirty = false
+	return t.lastFrame
+}
+
+// scheduleFrame schedules a render at the end of the current frame when
+// the last render was less than a frame ago
+func (t *TUI) scheduleFrame() tea.Cmd {
+	if t.frameInterval <= 0 || t.framePending {
+		return nil
+	}
+
+	wait := t.frameInterval - time.Since(t.lastRender)
+	if wait <= 0 {
+		return nil
+	}
+
+	t.framePending = true
+	return tea.Tick(wait, func(time.Time) tea.Msg {
+		return renderFrameMsg{}
+	})
+}
+
+// setMaxFPS caps how often the view is rendered, 0 renders on every update
+func (t *TUI) setMaxFPS(fps int) {
+	t.frameInterval = 0
+	if fps > 0 {
+		t.frameInterval = time.Second / time.Duration(fps)
+	}
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/ui/components"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestRenderCoalescing(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "demo", ready: true, width: 120, height: 40, errorDisplay: components.NewErrorDisplayComponent("dark")}
+	tui.setMaxFPS(30)
+
+	tui.Update(tea.FocusMsg{})
+	first := tui.View()
+	rendered := tui.lastRender
+
+	if tui.View() != first || tui.lastRender != rendered {
+		t.Fatalf("Expected the last frame to be reused while nothing changed")
+	}
+
+	// A change right after a render waits for the end of the frame
+	_, cmd := tui.Update(messages.LoadPodsError{Err: errors.New("forbidden")})
+	if cmd == nil || !tui.framePending {
+		t.Fatalf("Expected a frame to be scheduled")
+	}
+	if tui.View() != first {
+		t.Errorf("Expected the change to be held back until the frame")
+	}
+
+	// Further changes within the frame share the scheduled render
+	if _, cmd := tui.Update(messages.LoadPodsError{Err: errors.New("timeout")}); cmd != nil {
+		t.Errorf("Expected no second frame to be scheduled")
+	}
+
+	tui.Update(renderFrameMsg{})
+	if frame := tui.View(); frame == first || tui.framePending {
+		t.Errorf("Expected the frame to draw the latest changes")
+	}
+}
+
+func TestRenderEveryUpdateWithoutFrameLimit(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), ready: true, width: 120, height: 40, errorDisplay: components.NewErrorDisplayComponent("dark")}
+
+	if _, cmd := tui.Update(messages.LoadPodsError{Err: errors.New("forbidden")}); cmd != nil {
+		t.Errorf("Expected no frame to be scheduled without a frame limit")
+	}
+	if tui.lastFrame != "" {
+		t.Errorf("Expected frames not to be kept without a frame limit")
+	}
+}
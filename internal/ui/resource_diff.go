@@ -0,0 +1,294 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/diff"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// resourceDiffContext is the number of unchanged lines shown around each change
+const resourceDiffContext = 3
+
+// diffSideName names a compared resource in the diff header
+func diffSideName(ref resourceRef) string {
+	if ref.Namespace == "" {
+		return ref.Kind + "/" + ref.Name
+	}
+	return ref.Namespace + "/" + ref.Kind + "/" + ref.Name
+}
+
+// markForDiff marks the selected resource to compare, or compares the marked
+// resource with the selected one. Marking the same resource again clears it.
+func (t *TUI) markForDiff() tea.Cmd {
+	ref, ok := t.selectedResource()
+	if !t.connected || !ok {
+		return nil
+	}
+
+	switch {
+	case t.diffBase == nil:
+		t.diffBase = &ref
+		t.logInfo(categoryResource, "Marked %s to compare, press = on another resource to diff them", diffSideName(ref))
+		return nil
+	case *t.diffBase == ref:
+		t.diffBase = nil
+		t.logInfo(categoryResource, "Cleared the resource marked to compare")
+		return nil
+	}
+
+	base := *t.diffBase
+	t.diffBase = nil
+	return t.openResourceDiff(base, ref)
+}
+
+// openResourceDiff opens the diff of the manifests of two resources
+func (t *TUI) openResourceDiff(oldRef, newRef resourceRef) tea.Cmd {
+	title := fmt.Sprintf("%s ↔ %s", diffSideName(oldRef), diffSideName(newRef))
+	t.showResourceDiffView(title)
+
+	// The namespace differs by design when comparing across namespaces
+	dropNamespace := oldRef.Namespace != newRef.Namespace
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		sides := make([]string, 2)
+		for i, ref := range []resourceRef{oldRef, newRef} {
+			content, err := t.fetchResourceYAML(ctx, ref)
+			if err != nil {
+				return messages.ResourceDiffLoaded{Title: title, Err: fmt.Errorf("%s: %w", diffSideName(ref), err)}
+			}
+			if sides[i], err = resources.NormalizeManifest(content, dropNamespace); err != nil {
+				return messages.ResourceDiffLoaded{Title: title, Err: fmt.Errorf("%s: %w", diffSideName(ref), err)}
+			}
+		}
+		return messages.ResourceDiffLoaded{Title: title, Diff: diff.Unified(diffSideName(oldRef), diffSideName(newRef), sides[0], sides[1], resourceDiffContext)}
+	}
+}
+
+// openRevisionDiff opens the diff of the pod template of the selected
+// Deployment against the one of its previous revision
+func (t *TUI) openRevisionDiff() (tea.Cmd, error) {
+	ref, ok := t.selectedResource()
+	if !ok || ref.Kind != "Deployment" {
+		return nil, fmt.Errorf("select a deployment to compare with its previous revision")
+	}
+	lister, ok := t.resourceClient.(resources.RevisionTemplateLister)
+	if !ok {
+		return nil, fmt.Errorf("revisions are not available")
+	}
+
+	title := fmt.Sprintf("%s: previous ↔ current revision", diffSideName(ref))
+	t.showResourceDiffView(title)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		templates, err := lister.ListRevisionTemplates(ctx, ref.Namespace, ref.Name)
+		if err != nil {
+			return messages.ResourceDiffLoaded{Title: title, Err: err}
+		}
+		if len(templates) < 2 {
+			return messages.ResourceDiffLoaded{Title: title, Err: fmt.Errorf("%s has no previous revision", ref.Name)}
+		}
+		previous, current := templates[1], templates[0]
+		return messages.ResourceDiffLoaded{Title: title, Diff: diff.Unified(
+			fmt.Sprintf("revision %d (%s)", previous.Revision, previous.ReplicaSet),
+			fmt.Sprintf("revision %d (%s)", current.Revision, current.ReplicaSet),
+			previous.Template, current.Template, resourceDiffContext)}
+	}, nil
+}
+
+// runDiffCommand runs :diff. Without an argument it compares the resource
+// marked with = with the selected one, "revision" compares the selected
+// Deployment with its previous revision, and a namespace compares the
+// selected resource with the one of the same name there.
+func (t *TUI) runDiffCommand(arg string) (tea.Cmd, error) {
+	if arg == "revision" || arg == "rev" {
+		return t.openRevisionDiff()
+	}
+
+	ref, ok := t.selectedResource()
+	if !ok {
+		return nil, fmt.Errorf("no resource selected")
+	}
+	if arg == "" {
+		if t.diffBase == nil || *t.diffBase == ref {
+			return nil, fmt.Errorf("mark a resource with = first, or give a namespace or revision")
+		}
+		base := *t.diffBase
+		t.diffBase = nil
+		return t.openResourceDiff(base, ref), nil
+	}
+
+	if ref.Namespace == "" {
+		return nil, fmt.Errorf("%s is not namespaced", ref.Kind)
+	}
+	other := ref
+	other.Namespace = arg
+	return t.openResourceDiff(ref, other), nil
+}
+
+// showResourceDiffView opens the diff pane while the diff loads
+func (t *TUI) showResourceDiffView(title string) {
+	t.showResourceDiff = true
+	t.loadingResourceDiff = true
+	t.resourceDiffTitle = title
+	t.resourceDiffLines = nil
+	t.resourceDiffScroll = 0
+	t.resourceDiffError = ""
+}
+
+// handleResourceDiffLoaded stores a computed diff
+func (t *TUI) handleResourceDiffLoaded(msg messages.ResourceDiffLoaded) {
+	if !t.showResourceDiff || msg.Title != t.resourceDiffTitle {
+		return
+	}
+
+	t.loadingResourceDiff = false
+	if msg.Err != nil {
+		t.resourceDiffError = msg.Err.Error()
+		t.logError(categoryResource, "Failed to compare %s: %v", msg.Title, msg.Err)
+		return
+	}
+	if msg.Diff != "" {
+		t.resourceDiffLines = strings.Split(strings.TrimSuffix(msg.Diff, "\n"), "\n")
+	}
+}
+
+// resourceDiffHeight returns the number of diff lines visible at once
+func (t *TUI) resourceDiffHeight() int {
+	return max(t.height-4, 1) // title, separator, footer separator, footer
+}
+
+// renderResourceDiff renders the full-screen diff pane
+func (t *TUI) renderResourceDiff() string {
+	primaryColor, errorColor := t.getThemeColors()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(primaryColor)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("242"))
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render("🔀 "+t.resourceDiffTitle) + "\n")
+	content.WriteString(dimStyle.Render(strings.Repeat("─", max(t.width, 1))) + "\n")
+
+	height := t.resourceDiffHeight()
+	lines := make([]string, 0, height)
+	switch {
+	case t.loadingResourceDiff:
+		lines = append(lines, t.getLoadingSpinner()+" Comparing...")
+	case t.resourceDiffError != "":
+		lines = append(lines, lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.resourceDiffError))
+	case len(t.resourceDiffLines) == 0:
+		lines = append(lines, t.statusStyle(statusOK).Render("✅ No differences"))
+	default:
+		end := min(t.resourceDiffScroll+t.resourceDiffHeight(), len(t.resourceDiffLines))
+		for _, line := range t.resourceDiffLines[t.resourceDiffScroll:end] {
+			if len(line) > t.width {
+				line = line[:max(t.width-1, 0)] + "…"
+			}
+			lines = append(lines, colorizeDiffLine(line))
+		}
+	}
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	content.WriteString(strings.Join(lines, "\n") + "\n")
+
+	position := ""
+	if len(t.resourceDiffLines) > 0 {
+		position = fmt.Sprintf("lines %d-%d of %d • ", t.resourceDiffScroll+1, min(t.resourceDiffScroll+height, len(t.resourceDiffLines)), len(t.resourceDiffLines))
+	}
+	content.WriteString(dimStyle.Render(strings.Repeat("─", max(t.width, 1))) + "\n")
+	content.WriteString(dimStyle.Render(position + "j/k: scroll • pgup/pgdn: page • g/G: top/bottom • n/N: next/previous change • c: copy • esc/q: close"))
+
+	return content.String()
+}
+
+// colorizeDiffLine colors a line of a unified diff by what it is
+func colorizeDiffLine(line string) string {
+	switch {
+	case strings.HasPrefix(line, "--- "), strings.HasPrefix(line, "+++ "):
+		return lipgloss.NewStyle().Bold(true).Render(line)
+	case strings.HasPrefix(line, "@@"):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("14")).Render(line)
+	case strings.HasPrefix(line, "+"):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("10")).Render(line)
+	case strings.HasPrefix(line, "-"):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(line)
+	}
+	return line
+}
+
+// resourceDiffHunk returns the line of the next hunk header after the scroll
+// position, or of the previous one before it, and whether there is one
+func (t *TUI) resourceDiffHunk(forward bool) (int, bool) {
+	if forward {
+		for i := t.resourceDiffScroll + 1; i < len(t.resourceDiffLines); i++ {
+			if strings.HasPrefix(t.resourceDiffLines[i], "@@") {
+				return i, true
+			}
+		}
+		return 0, false
+	}
+	for i := t.resourceDiffScroll - 1; i >= 0; i-- {
+		if strings.HasPrefix(t.resourceDiffLines[i], "@@") {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// handleResourceDiffKeys handles key input for the diff pane
+func (t *TUI) handleResourceDiffKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	maxScroll := max(len(t.resourceDiffLines)-t.resourceDiffHeight(), 0)
+
+	switch msg.String() {
+	case "esc", "q":
+		t.showResourceDiff = false
+		t.resourceDiffLines = nil
+		return t, nil
+
+	case "j", "down":
+		t.resourceDiffScroll = min(t.resourceDiffScroll+1, maxScroll)
+
+	case "k", "up":
+		t.resourceDiffScroll = max(t.resourceDiffScroll-1, 0)
+
+	case "pgdown", "ctrl+f", " ":
+		t.resourceDiffScroll = min(t.resourceDiffScroll+t.resourceDiffHeight(), maxScroll)
+
+	case "pgup", "ctrl+b":
+		t.resourceDiffScroll = max(t.resourceDiffScroll-t.resourceDiffHeight(), 0)
+
+	case "g", "home":
+		t.resourceDiffScroll = 0
+
+	case "G", "end":
+		t.resourceDiffScroll = maxScroll
+
+	case "n":
+		if line, ok := t.resourceDiffHunk(true); ok {
+			t.resourceDiffScroll = min(line, maxScroll)
+		}
+
+	case "N":
+		if line, ok := t.resourceDiffHunk(false); ok {
+			t.resourceDiffScroll = line
+		}
+
+	case "c":
+		if len(t.resourceDiffLines) > 0 {
+			return t, t.copyToClipboard(strings.Join(t.resourceDiffLines, "\n") + "\n")
+		}
+	}
+
+	return t, nil
+}
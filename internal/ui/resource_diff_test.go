@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestResourceDiff(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 12}
+	tui.ActiveTab = models.TabDeployments
+	tui.deployments = []resources.DeploymentInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "shop"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "staging"}},
+	}
+
+	// = marks the selected resource, again on it clears the mark
+	if cmd := tui.markForDiff(); cmd != nil || tui.diffBase == nil {
+		t.Fatal("Expected = to mark the selected deployment")
+	}
+	tui.markForDiff()
+	if tui.diffBase != nil {
+		t.Fatal("Expected = on the marked deployment to clear the mark")
+	}
+
+	tui.markForDiff()
+	tui.selectedDeployment = 1
+	if cmd := tui.markForDiff(); cmd == nil || !tui.showResourceDiff || !tui.loadingResourceDiff || tui.diffBase != nil {
+		t.Fatal("Expected = on another deployment to compare both")
+	}
+	if tui.resourceDiffTitle != "shop/Deployment/web ↔ staging/Deployment/web" {
+		t.Errorf("Unexpected title %q", tui.resourceDiffTitle)
+	}
+
+	// Diffs of an earlier comparison are ignored
+	tui.handleResourceDiffLoaded(messages.ResourceDiffLoaded{Title: "stale", Diff: "--- a\n"})
+	if !tui.loadingResourceDiff {
+		t.Fatal("Expected a stale diff to be ignored")
+	}
+
+	var diff strings.Builder
+	diff.WriteString("--- shop/Deployment/web\n+++ staging/Deployment/web\n@@ -1,3 +1,3 @@\n spec:\n-  replicas: 2\n+  replicas: 1\n")
+	for i := 0; i < 10; i++ {
+		diff.WriteString(" line\n")
+	}
+	diff.WriteString("@@ -20 +20 @@\n-  image: web:1.0\n+  image: web:1.1\n")
+	tui.handleResourceDiffLoaded(messages.ResourceDiffLoaded{Title: tui.resourceDiffTitle, Diff: diff.String()})
+	if rendered := tui.renderResourceDiff(); !strings.Contains(rendered, "+  replicas: 1") || !strings.Contains(rendered, "lines 1-8 of 19") {
+		t.Errorf("Expected the diff, got:\n%s", rendered)
+	}
+
+	key := func(k string) { tui.handleResourceDiffKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}) }
+	key("n")
+	if tui.resourceDiffScroll != 2 {
+		t.Errorf("Expected n to jump to the first change, got line %d", tui.resourceDiffScroll)
+	}
+	key("n")
+	if tui.resourceDiffScroll != 11 {
+		t.Errorf("Expected n to stop at the end, got line %d", tui.resourceDiffScroll)
+	}
+	key("N")
+	if tui.resourceDiffScroll != 2 {
+		t.Errorf("Expected N to jump back to the first change, got line %d", tui.resourceDiffScroll)
+	}
+
+	// Equal manifests have no diff
+	tui.showResourceDiffView("same")
+	tui.handleResourceDiffLoaded(messages.ResourceDiffLoaded{Title: "same"})
+	if rendered := tui.renderResourceDiff(); !strings.Contains(rendered, "No differences") {
+		t.Errorf("Expected no differences, got:\n%s", rendered)
+	}
+
+	key("q")
+	if tui.showResourceDiff {
+		t.Error("Expected q to close the diff")
+	}
+}
+
+func TestRunDiffCommand(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop"}
+	tui.ActiveTab = models.TabDeployments
+	tui.deployments = []resources.DeploymentInfo{{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "shop"}}}
+
+	if _, err := tui.runDiffCommand(""); err == nil {
+		t.Error("Expected :diff without a marked resource to fail")
+	}
+	if cmd, err := tui.runDiffCommand("staging"); err != nil || cmd == nil || tui.resourceDiffTitle != "shop/Deployment/web ↔ staging/Deployment/web" {
+		t.Errorf("Expected web to be compared with staging, got %q, %v", tui.resourceDiffTitle, err)
+	}
+
+	tui.ActiveTab = models.TabServices
+	if _, err := tui.runDiffCommand("revision"); err == nil {
+		t.Error("Expected :diff revision to need a deployment")
+	}
+}
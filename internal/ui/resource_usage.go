@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadNodeUsage samples node usage from the metrics API
+func (t *TUI) loadNodeUsage() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.NodeUsageLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		usage, err := loadWithRetry(t, "node metrics", t.resourceClient.ListNodeUsage)
+		if err != nil {
+			return messages.NodeUsageLoadError{Err: err}
+		}
+
+		return messages.NodeUsageLoaded{Usage: usage}
+	}
+}
+
+// withNodeUsage adds a node usage sample to a load of the nodes tab
+func (t *TUI) withNodeUsage(cmd tea.Cmd) tea.Cmd {
+	return tea.Batch(cmd, t.loadNodeUsage())
+}
+
+// recordNodeUsage keeps the latest usage of each node
+func (t *TUI) recordNodeUsage(msg messages.NodeUsageLoaded) {
+	t.nodeMetricsUnavailable = false
+	t.nodeUsage = make(map[string]resources.NodeUsage, len(msg.Usage))
+	for _, sample := range msg.Usage {
+		t.nodeUsage[sample.Name] = sample
+	}
+	t.updateMainContent()
+}
+
+// handleNodeUsageLoadError notes that node usage is not available, the
+// nodes tab then shows allocatable resources only
+func (t *TUI) handleNodeUsageLoadError(msg messages.NodeUsageLoadError) {
+	if !t.nodeMetricsUnavailable {
+		t.logWarn(categoryResource, "Node metrics unavailable, usage columns stay empty: %v", msg.Err)
+	}
+	t.nodeMetricsUnavailable = true
+}
+
+// podUsage returns the latest CPU and memory usage of a pod, the sum over
+// its containers, and false when the metrics API has no sample of it
+func (t *TUI) podUsage(pod resources.PodInfo) (cpu, memory int64, ok bool) {
+	if pod.Namespace != "" && pod.Namespace != t.namespace {
+		return 0, 0, false
+	}
+
+	for _, container := range pod.ContainerInfo {
+		samples := t.usageHistory[usageKey(pod.Name, container.Name)]
+		if len(samples) == 0 {
+			continue
+		}
+		latest := samples[len(samples)-1]
+		cpu += latest.CPU
+		memory += latest.Memory
+		ok = true
+	}
+	return cpu, memory, ok
+}
+
+// podUsageCells returns the CPU and memory cells of a pod row, "-" when
+// there is no sample
+func (t *TUI) podUsageCells(pod resources.PodInfo) (string, string) {
+	cpu, memory, ok := t.podUsage(pod)
+	if !ok {
+		return "-", "-"
+	}
+	return resources.FormatMillicores(cpu), formatUsageBytes(memory)
+}
+
+// formatUsageBytes renders a memory usage rounded the way kubectl top does,
+// e.g. 129Mi or 3.2Gi
+func formatUsageBytes(bytes int64) string {
+	switch {
+	case bytes >= 1<<30:
+		return fmt.Sprintf("%.1fGi", float64(bytes)/(1<<30))
+	case bytes >= 1<<20:
+		return fmt.Sprintf("%dMi", bytes>>20)
+	default:
+		return fmt.Sprintf("%dKi", bytes>>10)
+	}
+}
+
+// usagePercent returns used as a percentage of an allocatable quantity,
+// e.g. "37%", or "-" when either is unknown
+func usagePercent(used int64, allocatable string, milli bool) string {
+	quantity, err := resource.ParseQuantity(allocatable)
+	if err != nil {
+		return "-"
+	}
+	total := quantity.Value()
+	if milli {
+		total = quantity.MilliValue()
+	}
+	if total <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", used*100/total)
+}
+
+// nodeUsageCells returns the CPU and memory percentage cells of a node row
+func (t *TUI) nodeUsageCells(node resources.NodeInfo) (string, string) {
+	usage, ok := t.nodeUsage[node.Name]
+	if !ok {
+		return "-", "-"
+	}
+	return usagePercent(usage.CPU, node.CPUAllocatable, true), usagePercent(usage.Memory, node.MemoryAllocatable, false)
+}
+
+// renderPodUsage renders the usage section of the pod detail pane
+func (t *TUI) renderPodUsage(pod resources.PodInfo) string {
+	if t.metricsUnavailable {
+		return "\nUsage: metrics API not available\n"
+	}
+	if _, _, ok := t.podUsage(pod); !ok {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\nUsage:\n")
+	for _, container := range pod.ContainerInfo {
+		samples := t.usageHistory[usageKey(pod.Name, container.Name)]
+		if len(samples) == 0 {
+			continue
+		}
+		latest := samples[len(samples)-1]
+		b.WriteString(fmt.Sprintf("  %-20s cpu %-8s memory %s\n",
+			truncateString(container.Name, 20), resources.FormatMillicores(latest.CPU), formatUsageBytes(latest.Memory)))
+	}
+	return b.String()
+}
+
+// renderNodeUsage renders the usage section of the node detail pane
+func (t *TUI) renderNodeUsage(node resources.NodeInfo) string {
+	if t.nodeMetricsUnavailable {
+		return "\nUsage: metrics API not available\n"
+	}
+	usage, ok := t.nodeUsage[node.Name]
+	if !ok {
+		return ""
+	}
+
+	cpu, memory := t.nodeUsageCells(node)
+	var b strings.Builder
+	b.WriteString("\nUsage:\n")
+	b.WriteString(fmt.Sprintf("  CPU:    %s (%s)\n", resources.FormatMillicores(usage.CPU), cpu))
+	b.WriteString(fmt.Sprintf("  Memory: %s (%s)\n", formatUsageBytes(usage.Memory), memory))
+	return b.String()
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestPodUsageCells(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), namespace: "shop"}
+	pod := resources.PodInfo{
+		ResourceInfo:  resources.ResourceInfo{Name: "web-1", Namespace: "shop"},
+		ContainerInfo: []resources.ContainerInfo{{Name: "app"}, {Name: "proxy"}},
+	}
+
+	if cpu, memory := tui.podUsageCells(pod); cpu != "-" || memory != "-" {
+		t.Errorf("Expected empty cells without samples, got %q %q", cpu, memory)
+	}
+
+	tui.usageHistory = map[string][]resources.ContainerUsage{
+		usageKey("web-1", "app"):   {{CPU: 50, Memory: 64 << 20}, {CPU: 200, Memory: 100 << 20}},
+		usageKey("web-1", "proxy"): {{CPU: 50, Memory: 28 << 20}},
+	}
+	if cpu, memory := tui.podUsageCells(pod); cpu != "250m" || memory != "128Mi" {
+		t.Errorf("Expected the latest samples summed over containers, got %q %q", cpu, memory)
+	}
+	if usage := tui.renderPodUsage(pod); !strings.Contains(usage, "proxy") {
+		t.Errorf("Expected a usage line per container, got:\n%s", usage)
+	}
+
+	// Samples are of the current namespace only
+	pod.Namespace = "other"
+	if cpu, _ := tui.podUsageCells(pod); cpu != "-" {
+		t.Errorf("Expected no usage for a pod of another namespace, got %q", cpu)
+	}
+
+	tui.metricsUnavailable = true
+	if usage := tui.renderPodUsage(pod); !strings.Contains(usage, "metrics API not available") {
+		t.Errorf("Expected the missing metrics API to be noted, got %q", usage)
+	}
+}
+
+func TestNodeUsageCells(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+	node := resources.NodeInfo{ResourceInfo: resources.ResourceInfo{Name: "worker-1"}, CPUAllocatable: "4", MemoryAllocatable: "8Gi"}
+
+	tui.recordNodeUsage(messages.NodeUsageLoaded{Usage: []resources.NodeUsage{{Name: "worker-1", CPU: 1000, Memory: 6 << 30}}})
+	if cpu, memory := tui.nodeUsageCells(node); cpu != "25%" || memory != "75%" {
+		t.Errorf("Expected 25%% and 75%%, got %q %q", cpu, memory)
+	}
+	if usage := tui.renderNodeUsage(node); !strings.Contains(usage, "6.0Gi (75%)") {
+		t.Errorf("Expected memory usage in the details, got:\n%s", usage)
+	}
+
+	node.MemoryAllocatable = ""
+	if _, memory := tui.nodeUsageCells(node); memory != "-" {
+		t.Errorf("Expected no percentage without allocatable memory, got %q", memory)
+	}
+
+	tui.handleNodeUsageLoadError(messages.NodeUsageLoadError{Err: errors.New("the server could not find the requested resource")})
+	if usage := tui.renderNodeUsage(node); !strings.Contains(usage, "metrics API not available") {
+		t.Errorf("Expected the missing metrics API to be noted, got %q", usage)
+	}
+}
+
+func TestFormatUsageBytes(t *testing.T) {
+	tests := map[int64]string{
+		512 << 10:       "512Ki",
+		129 << 20:       "129Mi",
+		3<<30 + 200<<20: "3.2Gi",
+	}
+	for bytes, want := range tests {
+		if got := formatUsageBytes(bytes); got != want {
+			t.Errorf("formatUsageBytes(%d) = %q, want %q", bytes, got, want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// restartSample is the restart count of a pod at a refresh
+type restartSample struct {
+	at       time.Time
+	restarts int32
+}
+
+// restartKey identifies a pod in the restart history
+func restartKey(pod resources.PodInfo) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+// recordRestarts appends the restart counts of freshly loaded pods to the
+// restart history. Pods no longer listed are dropped.
+func (t *TUI) recordRestarts(pods []resources.PodInfo, now time.Time) {
+	history := make(map[string][]restartSample, len(pods))
+	for _, pod := range pods {
+		key := restartKey(pod)
+		samples := t.restartHistory[key]
+		// Counts start again when a pod is replaced under the same name
+		if n := len(samples); n > 0 && samples[n-1].restarts > pod.Restarts {
+			samples = nil
+		}
+		samples = append(samples, restartSample{at: now, restarts: pod.Restarts})
+		if len(samples) > constants.MaxRestartSamples {
+			samples = samples[len(samples)-constants.MaxRestartSamples:]
+		}
+		history[key] = samples
+	}
+	t.restartHistory = history
+}
+
+// renderRestartTrend returns a sparkline of the restarts a pod made since
+// it was first sampled this session, or "" before it was sampled twice.
+// Counts are drawn from the first sample so a steady pod stays flat and a
+// crash loop climbs.
+func (t *TUI) renderRestartTrend(pod resources.PodInfo) string {
+	samples := t.restartHistory[restartKey(pod)]
+	if len(samples) < 2 {
+		return ""
+	}
+
+	first := samples[0]
+	values := make([]int64, len(samples))
+	for i, sample := range samples {
+		values[i] = int64(sample.restarts - first.restarts)
+	}
+	added := values[len(values)-1]
+	trend := fmt.Sprintf("%s +%d since %s", sparkline(values, constants.RestartSparklineWidth), added, first.at.Format("15:04"))
+	if added == 0 {
+		return "  " + lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Render(trend)
+	}
+	return "  " + t.statusStyle(statusWarn).Render(t.statusPrefix(statusWarn)+trend)
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestRestartTrend(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+	start := time.Date(2026, 3, 2, 9, 30, 0, 0, time.Local)
+
+	crashing := testPod("api-1", "Running", "node-a", 10, time.Hour)
+	steady := testPod("web-1", "Running", "node-a", 3, time.Hour)
+	gone := testPod("old-1", "Running", "node-a", 0, time.Hour)
+	tui.recordRestarts([]resources.PodInfo{crashing, steady, gone}, start)
+	if trend := tui.renderRestartTrend(crashing); trend != "" {
+		t.Errorf("Expected no trend after a single sample, got %q", trend)
+	}
+
+	for i, restarts := range []int32{10, 11, 13, 17} {
+		crashing.Restarts = restarts
+		tui.recordRestarts([]resources.PodInfo{crashing, steady}, start.Add(time.Duration(i+1)*time.Minute))
+	}
+	if _, ok := tui.restartHistory[restartKey(gone)]; ok {
+		t.Error("Expected a pod no longer listed to be dropped")
+	}
+
+	trend := tui.renderRestartTrend(crashing)
+	if !strings.Contains(trend, "▁▁▂▄█") || !strings.Contains(trend, "+7 since 09:30") {
+		t.Errorf("Expected a climbing trend of 7 restarts, got %q", trend)
+	}
+	if trend := tui.renderRestartTrend(steady); !strings.Contains(trend, "▁▁▁▁▁ +0") {
+		t.Errorf("Expected a flat trend, got %q", trend)
+	}
+
+	// A pod replaced under the same name counts from zero again
+	crashing.Restarts = 0
+	tui.recordRestarts([]resources.PodInfo{crashing}, start.Add(10*time.Minute))
+	if trend := tui.renderRestartTrend(crashing); trend != "" {
+		t.Errorf("Expected no trend for a replaced pod, got %q", trend)
+	}
+	crashing.Restarts = 1
+	tui.recordRestarts([]resources.PodInfo{crashing}, start.Add(11*time.Minute))
+	if trend := tui.renderRestartTrend(crashing); !strings.Contains(trend, "+1 since 09:40") {
+		t.Errorf("Expected the replaced pod's own trend, got %q", trend)
+	}
+
+	for i := 0; i < constants.MaxRestartSamples+5; i++ {
+		tui.recordRestarts([]resources.PodInfo{crashing}, start)
+	}
+	if n := len(tui.restartHistory[restartKey(crashing)]); n != constants.MaxRestartSamples {
+		t.Errorf("Expected the history to be capped at %d samples, got %d", constants.MaxRestartSamples, n)
+	}
+}
@@ -0,0 +1,197 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadPodUsage samples container usage in the current namespace from the
+// metrics API. Samples accumulate while refreshes run and feed the
+// right-sizing suggestions in the workload detail panes.
+func (t *TUI) loadPodUsage() tea.Cmd {
+	namespace := t.namespace
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.PodUsageLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		usage, err := loadWithRetry(t, "metrics", func(ctx context.Context) ([]resources.ContainerUsage, error) {
+			return t.resourceClient.ListPodUsage(ctx, namespace)
+		})
+		if err != nil {
+			return messages.PodUsageLoadError{Err: err}
+		}
+
+		return messages.PodUsageLoaded{Namespace: namespace, Usage: usage}
+	}
+}
+
+// withUsageSample adds a usage sample to a refresh of the pods or a workload tab
+func (t *TUI) withUsageSample(cmd tea.Cmd) tea.Cmd {
+	return tea.Batch(cmd, t.loadPodUsage())
+}
+
+// usageKey identifies a container of a pod in the usage history
+func usageKey(pod, container string) string {
+	return pod + "/" + container
+}
+
+// recordPodUsage appends new samples to the usage history. Containers that no
+// longer report usage are dropped, so the history follows the running pods.
+func (t *TUI) recordPodUsage(msg messages.PodUsageLoaded) {
+	t.metricsUnavailable = false
+	if msg.Namespace != t.namespace {
+		return
+	}
+
+	history := make(map[string][]resources.ContainerUsage, len(msg.Usage))
+	for _, sample := range msg.Usage {
+		key := usageKey(sample.Pod, sample.Container)
+		samples := t.usageHistory[key]
+		// metrics-server only refreshes its samples every few seconds
+		if n := len(samples); n > 0 && samples[n-1].Timestamp.Equal(sample.Timestamp) {
+			history[key] = samples
+			continue
+		}
+		samples = append(samples, sample)
+		if len(samples) > constants.MaxUsageSamples {
+			samples = samples[len(samples)-constants.MaxUsageSamples:]
+		}
+		history[key] = samples
+	}
+	t.usageHistory = history
+
+	// Refresh the detail pane with the new samples
+	t.updateMainContent()
+}
+
+// handlePodUsageLoadError notes that usage is not available, typically
+// because metrics-server is not installed
+func (t *TUI) handlePodUsageLoadError(msg messages.PodUsageLoadError) {
+	if !t.metricsUnavailable {
+		t.logWarn(categoryResource, "Resource metrics unavailable, right-sizing shows requests and limits only: %v", msg.Err)
+	}
+	t.metricsUnavailable = true
+}
+
+// podOwnedBy reports whether a pod name was generated for a workload: pods of
+// a Deployment are named <deployment>-<replicaset hash>-<suffix>, those of
+// other workloads <workload>-<suffix>
+func podOwnedBy(kind, workload, pod string) bool {
+	segments := 1
+	if kind == "Deployment" {
+		segments = 2
+	}
+	name := pod
+	for range segments {
+		i := strings.LastIndex(name, "-")
+		if i < 0 {
+			return false
+		}
+		name = name[:i]
+	}
+	return name == workload
+}
+
+// workloadRightSizing sizes each container of a workload from the requests
+// of its pods and the usage history of all its pods
+func (t *TUI) workloadRightSizing(kind, workload string) []resources.RightSizing {
+	current := make(map[string]resources.ContainerResources)
+	samples := make(map[string][]resources.ContainerUsage)
+	for _, pod := range t.allPods {
+		if !podOwnedBy(kind, workload, pod.Name) {
+			continue
+		}
+		for _, container := range pod.ContainerInfo {
+			current[container.Name] = container.Resources
+			samples[container.Name] = append(samples[container.Name], t.usageHistory[usageKey(pod.Name, container.Name)]...)
+		}
+	}
+
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sizings := make([]resources.RightSizing, 0, len(names))
+	for _, name := range names {
+		sizings = append(sizings, resources.SuggestRightSizing(name, current[name], samples[name]))
+	}
+	return sizings
+}
+
+// formatResourcePair renders a request/limit pair, e.g. "100m / 500m"
+func formatResourcePair(request, limit int64, format func(int64) string) string {
+	value := func(v int64) string {
+		if v == 0 {
+			return "-"
+		}
+		return format(v)
+	}
+	return value(request) + " / " + value(limit)
+}
+
+// sizingVerdictStyle colors a right-sizing verdict
+func sizingVerdictStyle(verdict string) lipgloss.Style {
+	switch verdict {
+	case resources.SizingUnder:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	case resources.SizingOver:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	case resources.SizingOK:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+}
+
+// renderRightSizing renders the requests, limits, observed usage and
+// suggestions for the containers of a workload
+func (t *TUI) renderRightSizing(kind, workload string) string {
+	sizings := t.workloadRightSizing(kind, workload)
+	if len(sizings) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("\nRight-sizing (request / limit):\n")
+	for _, s := range sizings {
+		b.WriteString(fmt.Sprintf("  %s\n", s.Container))
+		b.WriteString(fmt.Sprintf("    CPU:    %s", formatResourcePair(s.Current.CPURequest, s.Current.CPULimit, resources.FormatMillicores)))
+		if s.Samples > 0 {
+			b.WriteString(fmt.Sprintf("  p95 %s  %s", resources.FormatMillicores(s.CPUP95), sizingVerdictStyle(s.CPUVerdict).Render(s.CPUVerdict)))
+		}
+		b.WriteString("\n")
+		b.WriteString(fmt.Sprintf("    Memory: %s", formatResourcePair(s.Current.MemoryRequest, s.Current.MemoryLimit, resources.FormatBytes)))
+		if s.Samples > 0 {
+			b.WriteString(fmt.Sprintf("  peak %s  %s", resources.FormatBytes(s.MemoryPeak), sizingVerdictStyle(s.MemoryVerdict).Render(s.MemoryVerdict)))
+		}
+		b.WriteString("\n")
+
+		if s.NeedsAttention() || s.CPUVerdict == resources.SizingUnset || s.MemoryVerdict == resources.SizingUnset {
+			b.WriteString(fmt.Sprintf("    Suggest: cpu %s, memory %s (limit %s)\n",
+				resources.FormatMillicores(s.Suggested.CPURequest),
+				resources.FormatBytes(s.Suggested.MemoryRequest),
+				resources.FormatBytes(s.Suggested.MemoryLimit)))
+		}
+	}
+
+	switch samples := sizings[0].Samples; {
+	case t.metricsUnavailable:
+		b.WriteString("  Metrics API not available, no usage to compare with\n")
+	case samples == 0:
+		b.WriteString("  Collecting usage samples...\n")
+	default:
+		b.WriteString(fmt.Sprintf("  Based on %d sample(s) since LazyOC started\n", samples))
+	}
+	return b.String()
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestPodOwnedBy(t *testing.T) {
+	tests := []struct {
+		kind, workload, pod string
+		want                bool
+	}{
+		{"Deployment", "web", "web-5d8f7c9b6-x2k4p", true},
+		{"Deployment", "web", "web-api-5d8f7c9b6-x2k4p", false},
+		{"StatefulSet", "db", "db-0", true},
+		{"StatefulSet", "db", "db-cache-0", false},
+		{"DaemonSet", "agent", "agent-7hq2m", true},
+		{"DaemonSet", "agent", "agent", false},
+	}
+	for _, tt := range tests {
+		if got := podOwnedBy(tt.kind, tt.workload, tt.pod); got != tt.want {
+			t.Errorf("podOwnedBy(%q, %q, %q) = %v, want %v", tt.kind, tt.workload, tt.pod, got, tt.want)
+		}
+	}
+}
+
+func TestDeploymentRightSizing(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "demo"}
+	tui.ActiveTab = models.TabDeployments
+
+	app := resources.ContainerInfo{Name: "app", Resources: resources.ContainerResources{CPURequest: 1000, MemoryRequest: 1 << 30}}
+	tui.Update(messages.PodsLoaded{Pods: []resources.PodInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web-5d8f7c9b6-x2k4p", Namespace: "demo"}, ContainerInfo: []resources.ContainerInfo{app}},
+		{ResourceInfo: resources.ResourceInfo{Name: "web-api-5d8f7c9b6-q7w2z", Namespace: "demo"}, ContainerInfo: []resources.ContainerInfo{app}},
+	}})
+	tui.Update(messages.DeploymentsLoaded{Deployments: []resources.DeploymentInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "demo"}, Replicas: 1},
+	}})
+
+	if !strings.Contains(tui.detailContent, "Collecting usage samples") {
+		t.Errorf("Expected right-sizing to wait for samples, got %q", tui.detailContent)
+	}
+
+	start := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	for i := range 3 {
+		tui.Update(messages.PodUsageLoaded{Namespace: "demo", Usage: []resources.ContainerUsage{
+			{Namespace: "demo", Pod: "web-5d8f7c9b6-x2k4p", Container: "app", CPU: 50, Memory: 100 << 20, Timestamp: start.Add(time.Duration(i) * time.Minute)},
+			{Namespace: "demo", Pod: "web-api-5d8f7c9b6-q7w2z", Container: "app", CPU: 900, Memory: 900 << 20, Timestamp: start.Add(time.Duration(i) * time.Minute)},
+		}})
+	}
+	// A repeated sample is only counted once
+	tui.Update(messages.PodUsageLoaded{Namespace: "demo", Usage: []resources.ContainerUsage{
+		{Namespace: "demo", Pod: "web-5d8f7c9b6-x2k4p", Container: "app", CPU: 50, Memory: 100 << 20, Timestamp: start.Add(2 * time.Minute)},
+	}})
+
+	for _, want := range []string{"Right-sizing", "1 / -", "over", "Suggest: cpu 60m, memory 128Mi (limit 160Mi)", "Based on 3 sample(s)"} {
+		if !strings.Contains(tui.detailContent, want) {
+			t.Errorf("Expected deployment details to contain %q, got %q", want, tui.detailContent)
+		}
+	}
+
+	tui.Update(messages.PodUsageLoadError{Err: errors.New("the server could not find the requested resource")})
+	tui.updateMainContent()
+	if !tui.metricsUnavailable || !strings.Contains(tui.detailContent, "Metrics API not available") {
+		t.Errorf("Expected the missing metrics API to be reported, got %q", tui.detailContent)
+	}
+}
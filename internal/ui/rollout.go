@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// selectedDeploymentName returns the selected Deployment's namespace and name, if any
+func (t *TUI) selectedDeploymentName() (string, string, bool) {
+	if t.selectedDeployment < 0 || t.selectedDeployment >= len(t.deployments) {
+		return "", "", false
+	}
+	deploy := t.deployments[t.selectedDeployment]
+	return t.resourceNamespace(deploy.Namespace), deploy.Name, true
+}
+
+// rolloutRestart triggers a rollout restart of the selected Deployment
+func (t *TUI) rolloutRestart() tea.Cmd {
+	if t.ActiveTab != models.TabDeployments || !t.connected || t.resourceClient == nil {
+		return nil
+	}
+	namespace, name, ok := t.selectedDeploymentName()
+	if !ok {
+		return nil
+	}
+
+	t.logInfo(categoryAction, "Restarting rollout of deployment %s...", name)
+
+	return t.runTask(fmt.Sprintf("Rollout restart deployment %s", name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return t.resourceClient.RolloutRestart(ctx, namespace, name)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to restart deployment %s: %v", name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Rollout restart triggered for deployment %s", name)
+			return tea.Batch(t.loadDeployments(), t.startRolloutStatusPoll())
+		})
+}
+
+// loadRolloutStatus fetches the rollout status of a Deployment
+func (t *TUI) loadRolloutStatus(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.RolloutStatusLoadError{Name: name, Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		status, err := t.resourceClient.GetRolloutStatus(ctx, namespace, name)
+		if err != nil {
+			return messages.RolloutStatusLoadError{Name: name, Err: err}
+		}
+		return messages.RolloutStatusLoaded{Status: status}
+	}
+}
+
+// startRolloutStatusPoll starts refreshing the selected Deployment's rollout
+// status unless a refresh chain is already running. Reloaded deployments, a
+// rollout restart and a new selection restart a chain that ended.
+func (t *TUI) startRolloutStatusPoll() tea.Cmd {
+	namespace, name, ok := t.selectedDeploymentName()
+	if t.rolloutPollActive || !ok || t.ActiveTab != models.TabDeployments || t.hibernated {
+		return nil
+	}
+	t.rolloutPollActive = true
+	return t.loadRolloutStatus(namespace, name)
+}
+
+// handleRolloutStatusLoaded stores the status and schedules the next refresh
+// while the Deployments tab stays active, until the selected Deployment's
+// rollout is complete as 'kubectl rollout status' waits
+func (t *TUI) handleRolloutStatusLoaded(msg messages.RolloutStatusLoaded) tea.Cmd {
+	t.rolloutStatus = msg.Status
+	if t.ActiveTab == models.TabDeployments {
+		t.updateDeploymentDisplay()
+	}
+
+	_, selected, _ := t.selectedDeploymentName()
+	complete := msg.Status != nil && msg.Status.Complete && msg.Status.Deployment == selected
+	if !t.connected || t.ActiveTab != models.TabDeployments || t.safeMode || t.hibernated || complete {
+		t.rolloutPollActive = false
+		return nil
+	}
+	return tea.Tick(constants.RolloutStatusPollInterval, func(time.Time) tea.Msg {
+		return messages.RolloutStatusTick{}
+	})
+}
+
+// handleRolloutStatusTick refreshes the rollout status for the current
+// selection. Hibernation ends the chain and resuming restarts it.
+func (t *TUI) handleRolloutStatusTick() tea.Cmd {
+	namespace, name, ok := t.selectedDeploymentName()
+	if !ok || !t.connected || t.ActiveTab != models.TabDeployments || t.hibernated {
+		t.rolloutPollActive = false
+		return nil
+	}
+	return t.loadRolloutStatus(namespace, name)
+}
+
+// followRolloutStatus restarts the rollout status refresh once the selection
+// moved to a Deployment whose status is not shown
+func (t *TUI) followRolloutStatus() tea.Cmd {
+	_, name, ok := t.selectedDeploymentName()
+	if !ok || t.ActiveTab != models.TabDeployments || (t.rolloutStatus != nil && t.rolloutStatus.Deployment == name) {
+		return nil
+	}
+	return t.startRolloutStatusPoll()
+}
+
+// renderRolloutStatus renders the rollout section of the Deployment detail pane
+func (t *TUI) renderRolloutStatus(name string) string {
+	status := t.rolloutStatus
+	if status == nil || status.Deployment != name {
+		return ""
+	}
+
+	var b strings.Builder
+	state := "⏳ In progress"
+	if status.Complete {
+		state = "✅ Complete"
+	}
+
+	b.WriteString(fmt.Sprintf("\nRollout:      %s", state))
+	if status.Revision != "" {
+		b.WriteString(fmt.Sprintf(" (revision %s)", status.Revision))
+	}
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("  Progressing: %s\n", status.Progressing))
+	b.WriteString(fmt.Sprintf("  Available:   %s\n", status.Available))
+	if status.NewReplicaSet != "" {
+		b.WriteString(fmt.Sprintf("  New RS:      %s (%d/%d ready, %d desired)\n",
+			status.NewReplicaSet, status.NewReady, status.NewReplicas, status.Desired))
+	}
+	b.WriteString(fmt.Sprintf("  Old pods:    %d\n", status.OldReplicas))
+	if !status.Complete && status.Message != "" {
+		b.WriteString(fmt.Sprintf("  Message:     %s\n", status.Message))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestRolloutStatusPollEndsWhenComplete(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, rolloutPollActive: true}
+	tui.ActiveTab = models.TabDeployments
+	tui.deployments = []resources.DeploymentInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web"}},
+		{ResourceInfo: resources.ResourceInfo{Name: "worker"}},
+	}
+
+	if cmd := tui.handleRolloutStatusLoaded(messages.RolloutStatusLoaded{Status: &resources.RolloutStatus{Deployment: "web"}}); cmd == nil {
+		t.Fatal("Expected a rollout in progress to be polled again")
+	}
+	if cmd := tui.handleRolloutStatusLoaded(messages.RolloutStatusLoaded{Status: &resources.RolloutStatus{Deployment: "web", Complete: true}}); cmd != nil || tui.rolloutPollActive {
+		t.Fatal("Expected a complete rollout to end the poll")
+	}
+	if cmd := tui.followRolloutStatus(); cmd != nil {
+		t.Error("Expected the shown deployment not to restart the poll")
+	}
+
+	// Another selection restarts it
+	tui.selectedDeployment = 1
+	if cmd := tui.followRolloutStatus(); cmd == nil || !tui.rolloutPollActive {
+		t.Error("Expected a new selection to restart the poll")
+	}
+
+	// A complete status of the previous selection does not end it
+	if cmd := tui.handleRolloutStatusLoaded(messages.RolloutStatusLoaded{Status: &resources.RolloutStatus{Deployment: "web", Complete: true}}); cmd == nil {
+		t.Error("Expected the poll to go on for the new selection")
+	}
+}
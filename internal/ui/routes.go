@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// routeConflictMark is drawn before the name of a route whose host is also
+// claimed by another route
+const routeConflictMark = "⚠"
+
+// routeRouters returns the routers (IngressController shards) that admitted a route
+func routeRouters(route resources.RouteInfo) []string {
+	var routers []string
+	for _, admission := range route.Admissions {
+		if admission.Admitted == "True" {
+			routers = append(routers, admission.RouterName)
+		}
+	}
+	return routers
+}
+
+// routeRouterColumn summarizes route admission for the route list
+func routeRouterColumn(route resources.RouteInfo) string {
+	if routers := routeRouters(route); len(routers) > 0 {
+		return strings.Join(routers, ",")
+	}
+	switch route.Status {
+	case "NoRouter":
+		return "⚠ no router"
+	case "Rejected":
+		return "⚠ rejected"
+	}
+	return "pending"
+}
+
+// renderRouteAdmissions lists the decision of every router about a route and
+// flags routes no router serves, which answer with a 503 from the router
+func renderRouteAdmissions(route resources.RouteInfo) string {
+	var section strings.Builder
+	section.WriteString("\nRouter Admission:\n")
+
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	if len(route.Admissions) == 0 {
+		section.WriteString(warnStyle.Render("  ⚠ Not admitted by any router") + "\n")
+		section.WriteString("    No IngressController shard selects this route, check the\n")
+		section.WriteString("    namespace and route selectors of the IngressControllers\n")
+		return section.String()
+	}
+
+	for _, admission := range route.Admissions {
+		switch admission.Admitted {
+		case "True":
+			line := fmt.Sprintf("  ✓ %s", admission.RouterName)
+			if admission.CanonicalHostname != "" {
+				line += fmt.Sprintf(" (%s)", admission.CanonicalHostname)
+			}
+			section.WriteString(line + "\n")
+		case "False":
+			section.WriteString(warnStyle.Render(fmt.Sprintf("  ✗ %s: %s", admission.RouterName, admission.Reason)) + "\n")
+			if admission.Message != "" {
+				section.WriteString(fmt.Sprintf("    %s\n", truncateString(admission.Message, 70)))
+			}
+		default:
+			section.WriteString(fmt.Sprintf("  … %s: not decided yet\n", admission.RouterName))
+		}
+	}
+
+	if len(routeRouters(route)) == 0 {
+		section.WriteString(warnStyle.Render("  ⚠ No router serves this route, requests to its host fail") + "\n")
+	}
+	return section.String()
+}
+
+// checkRouteHosts compares the hosts of the loaded routes with the routes of
+// every namespace the user can list, or of each of their projects
+func (t *TUI) checkRouteHosts() tea.Cmd {
+	osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+	if !ok || !osClient.IsOpenShift() || len(t.allRoutes) == 0 {
+		return nil
+	}
+
+	namespaces := []string{t.namespace}
+	if len(t.projectList) > 0 {
+		namespaces = namespaces[:0]
+		for _, project := range t.projectList {
+			namespaces = append(namespaces, project.Name)
+		}
+	}
+	scope := t.alertScope()
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		routes, err := resources.NewOpenShiftResourceClient(osClient).ListAccessibleRoutes(ctx, namespaces)
+		if err != nil {
+			return messages.RouteHostsCheckError{Err: err}
+		}
+		return messages.RouteHostsChecked{Scope: scope, Conflicts: resources.RouteHostConflicts(routes)}
+	}
+}
+
+// routeKey identifies a route in the host conflicts
+func routeKey(route resources.RouteInfo) string {
+	return route.Namespace + "/" + route.Name
+}
+
+// handleRouteHostsChecked stores the host conflicts, unless the namespace was
+// switched during the check. Conflicts new to a loaded route are logged.
+func (t *TUI) handleRouteHostsChecked(msg messages.RouteHostsChecked) {
+	if msg.Scope != t.alertScope() {
+		return
+	}
+
+	for _, route := range t.allRoutes {
+		others := msg.Conflicts[routeKey(route)]
+		if len(others) == 0 || len(t.routeConflicts[routeKey(route)]) > 0 {
+			continue
+		}
+		t.logWarn(categoryResource, "Host %s of route %s is also claimed by route %s", route.Host, route.Name, routeKey(others[0]))
+	}
+	t.routeConflicts = msg.Conflicts
+	t.updateMainContent()
+}
+
+// handleRouteHostsCheckError records a failed check. It runs after each
+// load, so it is only written to the log file.
+func (t *TUI) handleRouteHostsCheckError(msg messages.RouteHostsCheckError) {
+	logging.Warn(t.Logger, "Failed to check route hosts: %v", msg.Err)
+}
+
+// routeConflictCell returns the mark of a route whose host is also claimed
+// by another route followed by a space, or ""
+func (t *TUI) routeConflictCell(route resources.RouteInfo) string {
+	if len(t.routeConflicts[routeKey(route)]) == 0 {
+		return ""
+	}
+	return routeConflictMark + " "
+}
+
+// renderRouteConflicts lists the other routes claiming the host of a route.
+// The router keeps the host for the oldest route and rejects the others as
+// HostAlreadyClaimed.
+func (t *TUI) renderRouteConflicts(route resources.RouteInfo) string {
+	others := t.routeConflicts[routeKey(route)]
+	if len(others) == 0 {
+		return ""
+	}
+
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	var section strings.Builder
+	section.WriteString("\nHost Conflicts:\n")
+	claimedFirst := true
+	for _, other := range others {
+		line := fmt.Sprintf("  %s %s (%s%s)", routeConflictMark, routeKey(other), other.Host, other.Path)
+		if other.CreatedAt.Before(route.CreatedAt) {
+			claimedFirst = false
+			section.WriteString(warnStyle.Render(line+", claimed first") + "\n")
+			continue
+		}
+		section.WriteString(line + "\n")
+	}
+	if claimedFirst {
+		section.WriteString("    This route claimed the host first, the others are rejected\n")
+	} else {
+		section.WriteString(warnStyle.Render("    The router rejects this route as HostAlreadyClaimed") + "\n")
+	}
+	return section.String()
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestRouteAdmission(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabRoutes
+
+	tui.Update(messages.RoutesLoaded{Routes: []resources.RouteInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "shop", Namespace: "demo", Status: "Admitted"}, Host: "shop.apps.example.com",
+			Admissions: []resources.RouteAdmission{
+				{RouterName: "default", CanonicalHostname: "router-default.apps.example.com", Admitted: "True"},
+				{RouterName: "internal", Admitted: "False", Reason: "HostAlreadyClaimed", Message: "route api already exposes shop.apps.example.com"},
+			}},
+		{ResourceInfo: resources.ResourceInfo{Name: "orphan", Namespace: "demo", Status: "NoRouter"}, Host: "orphan.apps.example.com"},
+	}})
+
+	if !strings.Contains(tui.mainContent, "ROUTER") || !strings.Contains(tui.mainContent, "⚠ no router") {
+		t.Errorf("Expected the router column, got %q", tui.mainContent)
+	}
+	for _, want := range []string{"✓ default (router-default.apps.example.com)", "✗ internal: HostAlreadyClaimed"} {
+		if !strings.Contains(tui.detailContent, want) {
+			t.Errorf("Expected route details to contain %q, got %q", want, tui.detailContent)
+		}
+	}
+
+	NewNavigator(tui).moveResourceSelection(1)
+	if !strings.Contains(tui.detailContent, "Not admitted by any router") {
+		t.Errorf("Expected the unadmitted route to be flagged, got %q", tui.detailContent)
+	}
+
+	// The builtin view lists routes no router serves
+	rows := viewItems(tui, int(models.TabRoutes), tui.allRoutes, routeViewRow)
+	if len(rows) != 2 {
+		t.Fatalf("Expected both routes without a view, got %d", len(rows))
+	}
+	terms := parseViewFilter(builtinViews("Routes")[0].Filter)
+	for _, term := range terms {
+		if !term.matches(routeViewRow(tui.allRoutes[1])) || term.matches(routeViewRow(tui.allRoutes[0])) {
+			t.Errorf("Expected the not admitted view to match only orphan")
+		}
+	}
+}
+
+func TestRouteHostConflicts(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "demo"}
+	tui.ActiveTab = models.TabRoutes
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	shop := resources.RouteInfo{ResourceInfo: resources.ResourceInfo{Name: "shop", Namespace: "demo", CreatedAt: created}, Host: "shop.apps.example.com"}
+	claimer := resources.RouteInfo{ResourceInfo: resources.ResourceInfo{Name: "web", Namespace: "dev", CreatedAt: created.Add(-time.Hour)}, Host: "shop.apps.example.com", Path: "/dev"}
+	tui.Update(messages.RoutesLoaded{Routes: []resources.RouteInfo{shop}})
+
+	// A check started before the namespace was switched is dropped
+	tui.Update(messages.RouteHostsChecked{Scope: "/dev", Conflicts: map[string][]resources.RouteInfo{"demo/shop": {claimer}}})
+	if strings.Contains(tui.detailContent, "Host Conflicts") {
+		t.Fatalf("Expected the check of another namespace to be dropped")
+	}
+
+	tui.Update(messages.RouteHostsChecked{Scope: tui.alertScope(), Conflicts: map[string][]resources.RouteInfo{"demo/shop": {claimer}}})
+	if !strings.Contains(tui.mainContent, "⚠ shop") {
+		t.Errorf("Expected the route to be marked in the list, got %q", tui.mainContent)
+	}
+	for _, want := range []string{"dev/web (shop.apps.example.com/dev), claimed first", "rejects this route as HostAlreadyClaimed"} {
+		if !strings.Contains(tui.detailContent, want) {
+			t.Errorf("Expected route details to contain %q, got %q", want, tui.detailContent)
+		}
+	}
+
+	tui.routeConflicts = map[string][]resources.RouteInfo{"demo/shop": {{ResourceInfo: resources.ResourceInfo{Name: "copy", Namespace: "demo", CreatedAt: created.Add(time.Hour)}, Host: "shop.apps.example.com"}}}
+	if section := tui.renderRouteConflicts(shop); !strings.Contains(section, "claimed the host first") {
+		t.Errorf("Expected the oldest route to keep the host, got %q", section)
+	}
+}
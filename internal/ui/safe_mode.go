@@ -0,0 +1,49 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// refreshNow reloads the active tab on request. In safe mode nothing else
+// refreshes, so it also reloads the pods behind the log panel, reads the
+// selected pod's new log lines and samples usage for the Top view.
+func (t *TUI) refreshNow() tea.Cmd {
+	t.resetAutoRefreshCountdown()
+	t.logInfo(categoryAction, "Refreshing %s", t.GetTabName(t.ActiveTab))
+	// A locked tab asks again, the permissions may have been granted since
+	if int(t.ActiveTab) < len(tabSummaryResources) {
+		t.accessDenials.forget(tabSummaryResources[t.ActiveTab])
+	}
+	if !t.safeMode {
+		return t.refreshTab(int(t.ActiveTab))
+	}
+
+	cmds := []tea.Cmd{t.refreshTab(int(t.ActiveTab))}
+	if t.ActiveTab != models.TabPods {
+		cmds = append(cmds, t.loadPods())
+	}
+	if t.logViewMode == constants.PodLogViewMode {
+		// The new lines follow the previous ones rather than the status line
+		if n := len(t.podLogs); n > 0 && t.logStreamStatus != "" && t.podLogs[n-1] == t.logStreamStatus {
+			t.podLogs = t.podLogs[:n-1]
+		}
+		t.logStreamStatus = ""
+		cmds = append(cmds, t.startPodLogStream())
+	}
+	if t.showTop {
+		cmds = append(cmds, t.loadPodUsage())
+	}
+	return tea.Batch(cmds...)
+}
+
+// renderSafeModeStatus returns the safe mode indicator for the status bar
+func (t *TUI) renderSafeModeStatus() string {
+	if !t.safeMode {
+		return ""
+	}
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3")).Render("🛡 SAFE MODE")
+}
@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestSafeMode(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, safeMode: true, width: 200, height: 40,
+		pods: []resources.PodInfo{multiContainerPod()}, autoRefreshTabs: defaultAutoRefreshTabs()}
+	tui.logViewMode = constants.PodLogViewMode
+
+	if tui.startAutoRefreshTimer() != nil || tui.startAPILatencyTimer() != nil {
+		t.Error("Expected no auto refresh or latency timers in safe mode")
+	}
+	if status := tui.renderAutoRefreshStatus(); status != "⟳ manual (r)" {
+		t.Errorf("Expected a manual refresh status, got %q", status)
+	}
+	if view := tui.renderSafeModeStatus(); !strings.Contains(view, "SAFE MODE") {
+		t.Errorf("Expected the safe mode marker in the status bar, got %q", view)
+	}
+
+	// Logs read once end without reconnecting
+	tui.podLogs = []string{"started"}
+	if !tui.handleFinishedLogStream("web-1", "app", errLogStreamEnded) {
+		t.Fatal("Expected the read logs to end without reconnecting")
+	}
+	if last := tui.podLogs[len(tui.podLogs)-1]; !strings.Contains(last, "press r to read new lines") {
+		t.Errorf("Expected a safe mode marker, got %q", last)
+	}
+
+	// A refresh reads the new lines after the previous ones
+	if tui.refreshNow() == nil {
+		t.Fatal("Expected a refresh")
+	}
+	if len(tui.podLogs) != 1 || tui.logStreamStatus != "" {
+		t.Errorf("Expected the marker to be dropped, got %v", tui.podLogs)
+	}
+}
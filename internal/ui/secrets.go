@@ -0,0 +1,467 @@
+package ui
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/errors"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// Types offered by the create secret form, named like 'oc create secret'
+const (
+	secretFormOpaque         = "Opaque"
+	secretFormDockerRegistry = "docker-registry"
+	secretFormTLS            = "tls"
+)
+
+// secretFormTypes are the types the create secret form cycles through
+var secretFormTypes = []string{secretFormOpaque, secretFormDockerRegistry, secretFormTLS}
+
+// secretFormField is an input of the create secret form
+type secretFormField struct {
+	label       string
+	placeholder string
+	password    bool
+}
+
+// secretFormFields returns the inputs of a secret type, after the name
+func secretFormFields(secretType string) []secretFormField {
+	switch secretType {
+	case secretFormDockerRegistry:
+		return []secretFormField{
+			{label: "Server", placeholder: "registry (e.g. quay.io)"},
+			{label: "Username", placeholder: "username"},
+			{label: "Password", placeholder: "password or token", password: true},
+			{label: "Email", placeholder: "email (optional)"},
+		}
+	case secretFormTLS:
+		return []secretFormField{
+			{label: "Cert", placeholder: "path to the PEM certificate (chain)"},
+			{label: "Key", placeholder: "path to the PEM private key"},
+		}
+	}
+	return []secretFormField{
+		{label: "Key", placeholder: "key (e.g. password)"},
+		{label: "Value", placeholder: "value, more keys can be added from the secret", password: true},
+	}
+}
+
+// secretFormData validates the values of the create secret form, the name
+// followed by the inputs of the type, and returns the secret to create
+func secretFormData(secretType string, values []string) (corev1.SecretType, map[string][]byte, error) {
+	if errs := validation.IsDNS1123Subdomain(values[0]); len(errs) > 0 {
+		return "", nil, fmt.Errorf("invalid name %q: %s", values[0], errs[0])
+	}
+
+	switch secretType {
+	case secretFormDockerRegistry:
+		server, username, password, email := values[1], values[2], values[3], values[4]
+		if server == "" || username == "" || password == "" {
+			return "", nil, fmt.Errorf("server, username and password are required")
+		}
+		config, err := resources.DockerConfigJSON(server, username, password, email)
+		if err != nil {
+			return "", nil, err
+		}
+		return corev1.SecretTypeDockerConfigJson, map[string][]byte{corev1.DockerConfigJsonKey: config}, nil
+
+	case secretFormTLS:
+		cert, err := os.ReadFile(values[1])
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot read the certificate: %w", err)
+		}
+		key, err := os.ReadFile(values[2])
+		if err != nil {
+			return "", nil, fmt.Errorf("cannot read the private key: %w", err)
+		}
+		if _, err := tls.X509KeyPair(cert, key); err != nil {
+			return "", nil, fmt.Errorf("invalid certificate and key: %w", err)
+		}
+		return corev1.SecretTypeTLS, map[string][]byte{corev1.TLSCertKey: cert, corev1.TLSPrivateKeyKey: key}, nil
+	}
+
+	key := values[1]
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		return "", nil, fmt.Errorf("invalid key %q: %s", key, errs[0])
+	}
+	return corev1.SecretTypeOpaque, map[string][]byte{key: []byte(values[2])}, nil
+}
+
+// secretWriter returns the client creating and applying secrets
+func (t *TUI) secretWriter() (resources.SecretWriter, error) {
+	writer, ok := t.resourceClient.(resources.SecretWriter)
+	if !ok {
+		return nil, fmt.Errorf("resource client does not support writing secrets")
+	}
+	return writer, nil
+}
+
+// loadSecretDataFor loads the decoded data of a secret for the secret modal
+func (t *TUI) loadSecretDataFor(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.SecretDataLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		data, err := t.resourceClient.GetSecretData(ctx, namespace, name)
+		if err != nil {
+			return messages.SecretDataLoadError{Err: fmt.Errorf("failed to get secret data %s: %w", name, err)}
+		}
+
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		return messages.SecretDataLoaded{Namespace: namespace, SecretName: name, Data: data, Keys: keys}
+	}
+}
+
+// handleSecretDataLoaded shows the secret modal. When the same secret is
+// reloaded after an edit the selected key and masking are kept.
+func (t *TUI) handleSecretDataLoaded(msg messages.SecretDataLoaded) {
+	reload := t.showSecretModal && t.secretModalName == msg.SecretName && t.secretModalNamespace == msg.Namespace
+	selected := ""
+	if reload && t.selectedSecretKey < len(t.secretModalKeys) {
+		selected = t.secretModalKeys[t.selectedSecretKey]
+	}
+	if t.secretEditedKey != "" {
+		selected = t.secretEditedKey
+		t.secretEditedKey = ""
+	}
+
+	t.secretModalNamespace = msg.Namespace
+	t.secretModalData = msg.Data
+	t.secretModalName = msg.SecretName
+	t.secretModalKeys = msg.Keys
+	t.selectedSecretKey = 0
+	for i, key := range msg.Keys {
+		if key == selected {
+			t.selectedSecretKey = i
+		}
+	}
+	if !reload {
+		t.secretMasked = true // Start with masked view for security
+	}
+	t.showSecretModal = true
+}
+
+// openSecretKeyPrompt asks for the name of a key to add to the secret
+func (t *TUI) openSecretKeyPrompt() tea.Cmd {
+	input := textinput.New()
+	input.Placeholder = "new key (e.g. api-token)"
+	input.CharLimit = 253
+	input.Width = 50
+	input.Focus()
+	t.secretKeyInput = input
+	t.secretKeyError = ""
+	t.showSecretKeyPrompt = true
+	return textinput.Blink
+}
+
+// handleSecretKeyPromptKeys handles key input for the new key prompt of the
+// secret modal. The value of the key is then written in $EDITOR.
+func (t *TUI) handleSecretKeyPromptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		t.showSecretKeyPrompt = false
+		return t, nil
+
+	case "enter":
+		key := strings.TrimSpace(t.secretKeyInput.Value())
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			t.secretKeyError = fmt.Sprintf("invalid key %q: %s", key, errs[0])
+			return t, nil
+		}
+		if _, exists := t.secretModalData[key]; exists {
+			t.secretKeyError = fmt.Sprintf("key %s already exists, select it and press e to edit it", key)
+			return t, nil
+		}
+		t.showSecretKeyPrompt = false
+		return t, t.editSecretValue(key, "")
+	}
+
+	t.secretKeyError = ""
+	var cmd tea.Cmd
+	t.secretKeyInput, cmd = t.secretKeyInput.Update(msg)
+	return t, cmd
+}
+
+// editSecretValue writes the decoded value of a key to a private temporary
+// file and suspends the TUI while $EDITOR runs
+func (t *TUI) editSecretValue(key, value string) tea.Cmd {
+	// CreateTemp creates the file readable by the user only
+	file, err := os.CreateTemp("", constants.SecretValueTempFilePattern+key)
+	if err != nil {
+		t.logError(categoryAction, "Failed to create temp file: %v", err)
+		return nil
+	}
+	path := file.Name()
+
+	if _, err := file.WriteString(value); err != nil {
+		file.Close()
+		os.Remove(path)
+		t.logError(categoryAction, "Failed to write temp file: %v", err)
+		return nil
+	}
+	file.Close()
+
+	namespace, name := t.secretModalNamespace, t.secretModalName
+	editor := editorCommand()
+	cmd := exec.Command(editor[0], append(editor[1:], path)...)
+	logging.Info(t.Logger, "Opening key %s of secret %s in %s", key, name, editor[0])
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return messages.SecretEditorFinished{
+			Namespace: namespace,
+			Name:      name,
+			Key:       key,
+			Path:      path,
+			Original:  value,
+			Err:       err,
+		}
+	})
+}
+
+// handleSecretEditorFinished applies the secret with the edited value if it
+// changed, then reloads the secret modal. The whole data is applied so that
+// lazyoc keeps owning every key it applied before.
+func (t *TUI) handleSecretEditorFinished(msg messages.SecretEditorFinished) tea.Cmd {
+	defer os.Remove(msg.Path)
+
+	if msg.Err != nil {
+		t.logError(categoryAction, "Editor failed: %v", msg.Err)
+		return nil
+	}
+
+	edited, err := os.ReadFile(msg.Path)
+	if err != nil {
+		t.logError(categoryAction, "Failed to read edited file: %v", err)
+		return nil
+	}
+	if string(edited) == msg.Original {
+		t.logInfo(categoryAction, "Edit cancelled, no changes made to key %s of secret %s", msg.Key, msg.Name)
+		return nil
+	}
+
+	writer, err := t.secretWriter()
+	if err != nil {
+		t.logError(categoryAction, "Cannot edit secret %s: %v", msg.Name, err)
+		return nil
+	}
+	data := make(map[string][]byte, len(t.secretModalData)+1)
+	for key, value := range t.secretModalData {
+		data[key] = []byte(value)
+	}
+	data[msg.Key] = edited
+
+	return t.runTask(fmt.Sprintf("Update key %s of secret %s", msg.Key, msg.Name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return writer.ApplySecretData(ctx, msg.Namespace, msg.Name, data)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to update secret %s: %v", msg.Name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Updated key %s of secret %s", msg.Key, msg.Name)
+			t.secretEditedKey = msg.Key
+			return tea.Batch(t.loadSecretDataFor(msg.Namespace, msg.Name), t.refreshTab(int(models.TabSecrets)))
+		})
+}
+
+// openSecretForm opens the create secret form in the current namespace
+func (t *TUI) openSecretForm() tea.Cmd {
+	if !t.connected || t.allNamespaces {
+		if t.allNamespaces {
+			t.logWarn(categoryAction, "Switch to a namespace to create a secret in it")
+		}
+		return nil
+	}
+
+	t.secretFormType = secretFormOpaque
+	t.secretFormError = ""
+	t.setSecretFormInputs("")
+	t.showSecretForm = true
+	return textinput.Blink
+}
+
+// setSecretFormInputs creates the inputs of the selected type, keeping the name
+func (t *TUI) setSecretFormInputs(name string) {
+	fields := secretFormFields(t.secretFormType)
+	t.secretFormInputs = make([]textinput.Model, len(fields)+1)
+	for i := range t.secretFormInputs {
+		input := textinput.New()
+		input.CharLimit = 2048
+		input.Width = 60
+		if i == 0 {
+			input.Placeholder = "name (e.g. registry-credentials)"
+			input.CharLimit = 253
+			input.SetValue(name)
+		} else {
+			input.Placeholder = fields[i-1].placeholder
+			if fields[i-1].password {
+				input.EchoMode = textinput.EchoPassword
+			}
+		}
+		t.secretFormInputs[i] = input
+	}
+	t.secretFormFocus = 0
+	t.secretFormInputs[0].Focus()
+}
+
+// submitSecretForm creates the secret described by the form. The form stays
+// open with the error when it is invalid.
+func (t *TUI) submitSecretForm() tea.Cmd {
+	values := make([]string, len(t.secretFormInputs))
+	for i, input := range t.secretFormInputs {
+		values[i] = input.Value()
+		if i == 0 || secretFormFields(t.secretFormType)[i-1].label != "Value" {
+			values[i] = strings.TrimSpace(values[i])
+		}
+	}
+	secretType, data, err := secretFormData(t.secretFormType, values)
+	if err != nil {
+		t.secretFormError = err.Error()
+		return nil
+	}
+	writer, err := t.secretWriter()
+	if err != nil {
+		t.secretFormError = err.Error()
+		return nil
+	}
+
+	namespace, name := t.namespace, values[0]
+	t.showSecretForm = false
+	t.secretFormInputs = nil
+	return t.runTask(fmt.Sprintf("Create secret %s", name),
+		func(ctx context.Context, _ func(done, total int)) error {
+			return writer.CreateSecret(ctx, namespace, name, secretType, data)
+		},
+		func(err error) tea.Cmd {
+			if err != nil {
+				userError := errors.MapKubernetesError(err)
+				t.errorDisplay.AddError(userError)
+				t.logError(categoryAction, "Failed to create secret %s: %v", name, err)
+				return nil
+			}
+			t.logSuccess(categoryAction, "Created %s secret %s", secretType, name)
+			return t.refreshTab(int(models.TabSecrets))
+		})
+}
+
+// renderSecretForm renders the create secret form
+func (t *TUI) renderSecretForm() string {
+	primaryColor, errorColor := t.getThemeColors()
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("242"))
+
+	modalWidth := min(90, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🔐 Create secret in "+t.namespace) + "\n\n")
+
+	var types []string
+	for _, secretType := range secretFormTypes {
+		if secretType == t.secretFormType {
+			types = append(types, lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Render("["+secretType+"]"))
+		} else {
+			types = append(types, dimStyle.Render(" "+secretType+" "))
+		}
+	}
+	content.WriteString(fmt.Sprintf("%-9s %s\n\n", "Type", strings.Join(types, " ")))
+
+	fields := secretFormFields(t.secretFormType)
+	for i, input := range t.secretFormInputs {
+		label := "Name"
+		if i > 0 {
+			label = fields[i-1].label
+		}
+		label = fmt.Sprintf("%-9s", label)
+		if i == t.secretFormFocus {
+			label = lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Render(label)
+		}
+		content.WriteString(label + " " + input.View() + "\n")
+	}
+
+	if t.secretFormError != "" {
+		content.WriteString("\n" + lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.secretFormError, modalWidth-10)) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("tab/↑↓: next field • ctrl+t: change type • enter: create • esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleSecretFormKeys handles key input for the create secret form
+func (t *TUI) handleSecretFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	count := len(t.secretFormInputs)
+	switch msg.String() {
+	case "esc":
+		t.showSecretForm = false
+		t.secretFormInputs = nil
+		return t, nil
+
+	case "tab", "down":
+		t.focusSecretFormField((t.secretFormFocus + 1) % count)
+		return t, nil
+
+	case "shift+tab", "up":
+		t.focusSecretFormField((t.secretFormFocus + count - 1) % count)
+		return t, nil
+
+	case "ctrl+t":
+		for i, secretType := range secretFormTypes {
+			if secretType == t.secretFormType {
+				t.secretFormType = secretFormTypes[(i+1)%len(secretFormTypes)]
+				break
+			}
+		}
+		t.secretFormError = ""
+		t.setSecretFormInputs(t.secretFormInputs[0].Value())
+		return t, nil
+
+	case "enter":
+		return t, t.submitSecretForm()
+	}
+
+	t.secretFormError = ""
+	var cmd tea.Cmd
+	t.secretFormInputs[t.secretFormFocus], cmd = t.secretFormInputs[t.secretFormFocus].Update(msg)
+	return t, cmd
+}
+
+// focusSecretFormField moves focus to the given create secret form field
+func (t *TUI) focusSecretFormField(index int) {
+	t.secretFormInputs[t.secretFormFocus].Blur()
+	t.secretFormFocus = index
+	t.secretFormInputs[t.secretFormFocus].Focus()
+}
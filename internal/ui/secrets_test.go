@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestSecretFormData(t *testing.T) {
+	secretType, data, err := secretFormData(secretFormOpaque, []string{"db", "password", "s3cret\n"})
+	if err != nil || secretType != corev1.SecretTypeOpaque || string(data["password"]) != "s3cret\n" {
+		t.Errorf("Expected an Opaque secret keeping the value as typed, got %s %v %v", secretType, data, err)
+	}
+
+	secretType, data, err = secretFormData(secretFormDockerRegistry, []string{"pull", "quay.io", "robot", "token", ""})
+	if err != nil || secretType != corev1.SecretTypeDockerConfigJson || !strings.Contains(string(data[".dockerconfigjson"]), `"quay.io"`) {
+		t.Errorf("Expected a docker config secret, got %s %v %v", secretType, data, err)
+	}
+
+	for _, values := range [][]string{
+		{"Bad_Name", "key", "value"},
+		{"db", "bad key", "value"},
+	} {
+		if _, _, err := secretFormData(secretFormOpaque, values); err == nil {
+			t.Errorf("Expected %v to be rejected", values)
+		}
+	}
+	if _, _, err := secretFormData(secretFormDockerRegistry, []string{"pull", "quay.io", "", "", ""}); err == nil {
+		t.Errorf("Expected the registry credentials to be required")
+	}
+}
+
+func TestSecretFormTLS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "shop.example.com"}, NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	certPath, keyPath := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)
+	os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600)
+
+	secretType, data, err := secretFormData(secretFormTLS, []string{"shop-tls", certPath, keyPath})
+	if err != nil || secretType != corev1.SecretTypeTLS || len(data["tls.crt"]) == 0 || len(data["tls.key"]) == 0 {
+		t.Errorf("Expected a tls secret, got %s %v", secretType, err)
+	}
+	if _, _, err := secretFormData(secretFormTLS, []string{"shop-tls", keyPath, certPath}); err == nil {
+		t.Errorf("Expected swapped files to be rejected")
+	}
+}
+
+func TestSecretForm(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	tui.openSecretForm()
+	tui.handleSecretFormKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("pull")})
+	tui.handleSecretFormKeys(tea.KeyMsg{Type: tea.KeyCtrlT})
+
+	if tui.secretFormType != secretFormDockerRegistry || len(tui.secretFormInputs) != 5 {
+		t.Fatalf("Expected ctrl+t to switch to the docker-registry inputs, got %s with %d inputs", tui.secretFormType, len(tui.secretFormInputs))
+	}
+	if tui.secretFormInputs[0].Value() != "pull" {
+		t.Errorf("Expected the name to be kept when the type changes, got %q", tui.secretFormInputs[0].Value())
+	}
+
+	tui.handleSecretFormKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.showSecretForm || !strings.Contains(tui.renderSecretForm(), "server, username and password are required") {
+		t.Errorf("Expected the form to stay open with the error")
+	}
+
+	tui.handleSecretFormKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showSecretForm {
+		t.Errorf("Expected esc to close the form")
+	}
+}
+
+func TestSecretModalReload(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	tui.handleSecretDataLoaded(messages.SecretDataLoaded{Namespace: "shop", SecretName: "db", Data: map[string]string{"password": "a", "user": "b"}, Keys: []string{"password", "user"}})
+	tui.selectedSecretKey = 1
+	tui.secretMasked = false
+
+	tui.handleSecretDataLoaded(messages.SecretDataLoaded{Namespace: "shop", SecretName: "db", Data: map[string]string{"host": "c", "password": "a", "user": "b"}, Keys: []string{"host", "password", "user"}})
+	if tui.secretModalKeys[tui.selectedSecretKey] != "user" || tui.secretMasked {
+		t.Errorf("Expected the selected key and masking kept on reload, got %s masked=%v", tui.secretModalKeys[tui.selectedSecretKey], tui.secretMasked)
+	}
+
+	// A key added from the prompt must not exist yet
+	tui.openSecretKeyPrompt()
+	tui.secretKeyInput.SetValue("host")
+	tui.handleSecretModalKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.showSecretKeyPrompt || !strings.Contains(tui.secretKeyError, "already exists") {
+		t.Errorf("Expected an existing key to be refused, got %q", tui.secretKeyError)
+	}
+	tui.handleSecretModalKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showSecretKeyPrompt || !tui.showSecretModal {
+		t.Errorf("Expected esc to close the prompt only")
+	}
+}
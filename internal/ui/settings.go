@@ -0,0 +1,438 @@
+package ui
+
+import (
+	"fmt"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// Settings screen rows
+const (
+	settingTheme = iota
+	settingNamespace
+	settingPodRefresh
+	settingResourceRefresh
+	settingMouse
+	settingLogTail
+	settingLogBuffer
+	settingLogSpill
+	settingStatusPalette
+	settingPostmortemDir
+	settingDiffTool
+	settingDebugImage
+	settingHibernate
+	settingTimeFormat
+	settingKubeconfigNamespace
+	settingCount
+)
+
+// applyPreferences puts preferences into effect: the theme, the namespace
+// of the first connection and the last active tab
+func (t *TUI) applyPreferences(prefs config.Preferences) {
+	t.prefs = prefs
+	t.theme = prefs.ThemeName()
+	t.startNamespace = prefs.DefaultNamespace
+	t.mouseEnabled = prefs.MouseEnabled()
+	if tab, ok := t.tabByName(prefs.LastTab); ok {
+		t.ActiveTab = tab
+	}
+}
+
+// overridePreferences applies this session's command line overrides on top
+// of the saved preferences, without saving them
+func (t *TUI) overridePreferences(overrides config.Preferences) {
+	t.applyPreferences(t.prefs.Merge(overrides))
+}
+
+// savePreferences applies a change to the preferences in effect and saves it
+func (t *TUI) savePreferences(change func(*config.Preferences)) {
+	change(&t.prefs)
+	if t.config == nil {
+		return
+	}
+
+	change(&t.config.Preferences)
+	if err := t.saveUserConfig(); err != nil {
+		t.logWarn(categoryAction, "Failed to save settings: %v", err)
+	}
+}
+
+// tabByName returns the tab with the given name, e.g. "Pods"
+func (t *TUI) tabByName(name string) (models.TabType, bool) {
+	for tab := models.TabPods; tab <= models.TabDeploymentConfigs; tab++ {
+		if name != "" && t.GetTabName(tab) == name {
+			return tab, true
+		}
+	}
+	return 0, false
+}
+
+// setTheme switches the theme and remembers it for the next session
+func (t *TUI) setTheme(theme string) {
+	t.theme = theme
+	t.savePreferences(func(p *config.Preferences) { p.Theme = theme })
+}
+
+// saveLastTab remembers the active tab for the next session
+func (t *TUI) saveLastTab() {
+	tab := t.GetTabName(t.ActiveTab)
+	if t.config == nil || t.config.Preferences.LastTab == tab {
+		return
+	}
+	t.savePreferences(func(p *config.Preferences) { p.LastTab = tab })
+}
+
+// openSettings opens the settings screen
+func (t *TUI) openSettings() {
+	t.showSettings = true
+	t.settingsIndex = 0
+	t.settingsEditing = false
+	t.settingsError = ""
+}
+
+// settingLabel returns the label of a settings row
+func settingLabel(row int) string {
+	return [settingCount]string{
+		"Theme",
+		"Default namespace",
+		"Pods refresh",
+		"Resource refresh",
+		"Mouse support",
+		"Log tail",
+		"Log memory cap",
+		"Spill logs to disk",
+		"Status palette",
+		"Postmortem dir",
+		"Diff tool",
+		"Debug image",
+		"Hibernate after",
+		"Time format",
+		"Switch kubeconfig",
+	}[row]
+}
+
+// settingValue returns the value in effect for a settings row
+func (t *TUI) settingValue(row int) string {
+	switch row {
+	case settingTheme:
+		return t.theme
+	case settingStatusPalette:
+		return t.prefs.StatusPaletteName()
+	case settingNamespace:
+		if t.prefs.DefaultNamespace == "" {
+			return "from kubeconfig"
+		}
+		return t.prefs.DefaultNamespace
+	case settingPodRefresh:
+		return t.prefs.PodRefreshInterval().String()
+	case settingResourceRefresh:
+		return t.prefs.ResourceRefreshInterval().String()
+	case settingMouse:
+		if t.mouseEnabled {
+			return "on"
+		}
+		return "off"
+	case settingLogTail:
+		return fmt.Sprintf("%d lines", t.prefs.LogTail())
+	case settingLogBuffer:
+		return fmt.Sprintf("%d MiB", t.prefs.LogBufferBytes()>>20)
+	case settingLogSpill:
+		if t.prefs.LogSpillEnabled() {
+			return "on"
+		}
+		return "off"
+	case settingPostmortemDir:
+		if t.prefs.PostmortemDir == "" {
+			return "off"
+		}
+		return t.prefs.PostmortemDir
+	case settingDiffTool:
+		if t.prefs.DiffTool == "" {
+			return "off"
+		}
+		return t.prefs.DiffTool
+	case settingDebugImage:
+		return t.prefs.DebugImageName()
+	case settingHibernate:
+		if t.prefs.HibernateAfter() == 0 {
+			return "off"
+		}
+		return t.prefs.HibernateAfter().String()
+	case settingTimeFormat:
+		if t.prefs.TimeFormatName() == constants.TimeFormatAbsolute {
+			return fmt.Sprintf("%s (%s)", constants.TimeFormatAbsolute, time.Now().Format(t.prefs.AbsoluteTimeLayout()))
+		}
+		return t.prefs.TimeFormatName()
+	case settingKubeconfigNamespace:
+		if t.prefs.KubeconfigNamespaceEnabled() {
+			return "on"
+		}
+		return "off"
+	}
+	return ""
+}
+
+// editSetting toggles the selected setting, or starts editing its value
+func (t *TUI) editSetting() tea.Cmd {
+	t.settingsError = ""
+
+	switch t.settingsIndex {
+	case settingTheme:
+		theme := "dark"
+		if t.theme == "dark" {
+			theme = "light"
+		}
+		t.setTheme(theme)
+		return nil
+
+	case settingStatusPalette:
+		palette := constants.StatusPaletteColorBlind
+		if t.prefs.StatusPaletteName() == palette {
+			palette = constants.StatusPaletteDefault
+		}
+		t.savePreferences(func(p *config.Preferences) { p.StatusPalette = palette })
+		return nil
+
+	case settingMouse:
+		enabled := !t.mouseEnabled
+		t.mouseEnabled = enabled
+		t.savePreferences(func(p *config.Preferences) { p.Mouse = &enabled })
+		if enabled {
+			return tea.EnableMouseAllMotion
+		}
+		return tea.DisableMouse
+
+	case settingTimeFormat:
+		formats := []string{constants.TimeFormatRelative, constants.TimeFormatAbsolute, constants.TimeFormatISO8601}
+		next := formats[(slices.Index(formats, t.prefs.TimeFormatName())+1)%len(formats)]
+		t.savePreferences(func(p *config.Preferences) { p.TimeFormat = next })
+		t.updateMainContent()
+		return nil
+
+	case settingKubeconfigNamespace:
+		write := !t.prefs.KubeconfigNamespaceEnabled()
+		t.savePreferences(func(p *config.Preferences) { p.KubeconfigNamespace = &write })
+		t.applyProjectSwitching()
+		return nil
+
+	case settingLogSpill:
+		spill := !t.prefs.LogSpillEnabled()
+		t.savePreferences(func(p *config.Preferences) { p.LogSpill = &spill })
+		if !spill {
+			t.closeLogSpill()
+		}
+		return nil
+	}
+
+	var value, placeholder string
+	switch t.settingsIndex {
+	case settingNamespace:
+		value, placeholder = t.prefs.DefaultNamespace, "namespace, empty to use the kubeconfig's"
+	case settingPodRefresh:
+		value, placeholder = strconv.Itoa(int(t.prefs.PodRefreshInterval().Seconds())), "seconds"
+	case settingResourceRefresh:
+		value, placeholder = strconv.Itoa(int(t.prefs.ResourceRefreshInterval().Seconds())), "seconds"
+	case settingLogTail:
+		value, placeholder = strconv.Itoa(t.prefs.LogTail()), "lines"
+	case settingLogBuffer:
+		value, placeholder = strconv.Itoa(t.prefs.LogBufferBytes()>>20), "MiB"
+	case settingPostmortemDir:
+		value, placeholder = t.prefs.PostmortemDir, "directory, e.g. ~/postmortems, empty to turn off"
+	case settingDiffTool:
+		value, placeholder = t.prefs.DiffTool, "command, e.g. delta or meld, empty to turn off"
+	case settingDebugImage:
+		value, placeholder = t.prefs.DebugImage, "image, e.g. nicolaka/netshoot, empty for busybox"
+	case settingHibernate:
+		value, placeholder = strconv.Itoa(int(t.prefs.HibernateAfter().Minutes())), "minutes without input, 0 to turn off"
+	}
+
+	t.settingsInput = textinput.New()
+	t.settingsInput.Placeholder = placeholder
+	t.settingsInput.CharLimit = validation.DNS1123LabelMaxLength
+	if t.settingsIndex == settingPostmortemDir || t.settingsIndex == settingDiffTool || t.settingsIndex == settingDebugImage {
+		t.settingsInput.CharLimit = 512
+	}
+	t.settingsInput.Width = 40
+	t.settingsInput.SetValue(value)
+	t.settingsInput.Focus()
+	t.settingsEditing = true
+	return textinput.Blink
+}
+
+// submitSetting validates and saves the edited setting
+func (t *TUI) submitSetting() {
+	value := strings.TrimSpace(t.settingsInput.Value())
+
+	switch t.settingsIndex {
+	case settingNamespace:
+		if value != "" {
+			if errs := validation.IsDNS1123Label(value); len(errs) > 0 {
+				t.settingsError = fmt.Sprintf("invalid namespace: %s", errs[0])
+				return
+			}
+		}
+		t.savePreferences(func(p *config.Preferences) { p.DefaultNamespace = value })
+
+	case settingPodRefresh, settingResourceRefresh:
+		seconds, err := strconv.Atoi(value)
+		if err != nil || time.Duration(seconds)*time.Second < constants.MinAutoRefreshInterval {
+			t.settingsError = fmt.Sprintf("enter a number of seconds, at least %d", int(constants.MinAutoRefreshInterval.Seconds()))
+			return
+		}
+		if t.settingsIndex == settingPodRefresh {
+			t.savePreferences(func(p *config.Preferences) { p.PodRefreshSeconds = seconds })
+		} else {
+			t.savePreferences(func(p *config.Preferences) { p.ResourceRefreshSeconds = seconds })
+		}
+		t.resetAutoRefreshCountdown()
+
+	case settingLogTail:
+		lines, err := strconv.Atoi(value)
+		if err != nil || lines < 1 || lines > constants.MaxLogLines {
+			t.settingsError = fmt.Sprintf("enter a number of lines between 1 and %d", constants.MaxLogLines)
+			return
+		}
+		t.savePreferences(func(p *config.Preferences) { p.LogTailLines = lines })
+
+	case settingLogBuffer:
+		size, err := strconv.Atoi(value)
+		if err != nil || size < 1 || size > constants.MaxLogBufferMB {
+			t.settingsError = fmt.Sprintf("enter a size in MiB between 1 and %d", constants.MaxLogBufferMB)
+			return
+		}
+		t.savePreferences(func(p *config.Preferences) { p.LogBufferMB = size })
+
+	case settingPostmortemDir:
+		if value != "" {
+			if _, err := expandLogExportPath(value); err != nil {
+				t.settingsError = err.Error()
+				return
+			}
+		}
+		t.savePreferences(func(p *config.Preferences) { p.PostmortemDir = value })
+
+	case settingDiffTool:
+		if value != "" {
+			if _, err := exec.LookPath(strings.Fields(value)[0]); err != nil {
+				t.settingsError = fmt.Sprintf("%s not found in PATH", strings.Fields(value)[0])
+				return
+			}
+		}
+		t.savePreferences(func(p *config.Preferences) { p.DiffTool = value })
+
+	case settingDebugImage:
+		if strings.ContainsAny(value, " \t") {
+			t.settingsError = "enter an image without spaces, e.g. busybox:1.36"
+			return
+		}
+		t.savePreferences(func(p *config.Preferences) { p.DebugImage = value })
+
+	case settingHibernate:
+		minutes, err := strconv.Atoi(value)
+		if err != nil || minutes < 0 {
+			t.settingsError = "enter a number of minutes, 0 to turn off"
+			return
+		}
+		if minutes == 0 {
+			minutes = -1
+		}
+		t.savePreferences(func(p *config.Preferences) { p.HibernateMinutes = minutes })
+	}
+
+	t.settingsEditing = false
+	t.settingsError = ""
+	t.logInfo(categoryAction, "%s set to %s", settingLabel(t.settingsIndex), t.settingValue(t.settingsIndex))
+}
+
+// renderSettings renders the settings screen
+func (t *TUI) renderSettings() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(80, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("⚙️  Settings") + "\n\n")
+	for row := 0; row < settingCount; row++ {
+		prefix := "  "
+		label := fmt.Sprintf("%-18s", settingLabel(row))
+		if row == t.settingsIndex {
+			prefix = "▶ "
+			label = lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Render(label)
+		}
+
+		value := t.settingValue(row)
+		if row == t.settingsIndex && t.settingsEditing {
+			value = t.settingsInput.View()
+		}
+		content.WriteString(prefix + label + " " + value + "\n")
+	}
+
+	if t.settingsError != "" {
+		content.WriteString("\n" + lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.settingsError) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString(dimStyle.Render("The default namespace applies from the next start. The last active tab is restored automatically."))
+	if t.configPath != "" {
+		content.WriteString("\n" + dimStyle.Render("Saved to "+t.configPath))
+	}
+	content.WriteString("\n\n")
+	if t.settingsEditing {
+		content.WriteString("enter: save • esc: cancel")
+	} else {
+		content.WriteString("j/k: select • enter: change • esc: close")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleSettingsKeys handles key input for the settings screen
+func (t *TUI) handleSettingsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.settingsEditing {
+		switch msg.String() {
+		case "esc":
+			t.settingsEditing = false
+			t.settingsError = ""
+			return t, nil
+		case "enter":
+			t.submitSetting()
+			return t, nil
+		}
+
+		var cmd tea.Cmd
+		t.settingsInput, cmd = t.settingsInput.Update(msg)
+		return t, cmd
+	}
+
+	switch msg.String() {
+	case "esc", "q", ",":
+		t.showSettings = false
+		t.settingsError = ""
+	case "j", "down":
+		t.settingsIndex = (t.settingsIndex + 1) % settingCount
+	case "k", "up":
+		t.settingsIndex = (t.settingsIndex + settingCount - 1) % settingCount
+	case "enter", " ":
+		return t, t.editSetting()
+	}
+	return t, nil
+}
@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/projects"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestApplyPreferences(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+	tui.applyPreferences(config.Preferences{Theme: "light", DefaultNamespace: "shop", LastTab: "Routes"})
+	if tui.theme != "light" || tui.startNamespace != "shop" || tui.ActiveTab != models.TabRoutes {
+		t.Errorf("Expected the theme, namespace and tab to be restored, got %s, %s and %d", tui.theme, tui.startNamespace, tui.ActiveTab)
+	}
+
+	tui.overridePreferences(config.Preferences{Theme: "dark", PodRefreshSeconds: 10})
+	if tui.theme != "dark" || tui.autoRefreshInterval(0) != 10*time.Second || tui.startNamespace != "shop" {
+		t.Errorf("Expected the overrides on top of the saved preferences")
+	}
+}
+
+func TestSettingsScreen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	tui := &TUI{App: models.NewApp("test"), theme: "dark", config: &config.Config{}, configPath: path, width: 120, height: 40}
+	tui.ActiveTab = models.TabBuilds
+
+	tui.openSettings()
+	tui.handleSettingsKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if tui.theme != "light" {
+		t.Errorf("Expected enter to toggle the theme")
+	}
+
+	// Pods refresh
+	tui.handleSettingsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	tui.handleSettingsKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	tui.handleSettingsKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.settingsEditing || tui.settingsInput.Value() != "30" {
+		t.Fatalf("Expected to edit the current interval, got %q", tui.settingsInput.Value())
+	}
+
+	tui.settingsInput.SetValue("1")
+	tui.handleSettingsKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.settingsEditing || tui.settingsError == "" {
+		t.Fatalf("Expected an interval below the minimum to be rejected")
+	}
+
+	tui.settingsInput.SetValue("15")
+	tui.handleSettingsKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if tui.settingsEditing || tui.autoRefreshInterval(0) != 15*time.Second {
+		t.Errorf("Expected the new interval to apply, got %s", tui.autoRefreshInterval(0))
+	}
+	if view := tui.renderSettings(); view == "" {
+		t.Errorf("Expected the settings to render")
+	}
+
+	tui.handleSettingsKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showSettings {
+		t.Errorf("Expected esc to close the settings")
+	}
+	tui.saveLastTab()
+
+	saved, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if saved.Preferences.Theme != "light" || saved.Preferences.PodRefreshSeconds != 15 || saved.Preferences.LastTab != "Builds" {
+		t.Errorf("Expected the settings to be saved, got %+v", saved.Preferences)
+	}
+}
+
+func TestKubeconfigNamespaceSetting(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}})
+	manager := projects.NewKubernetesNamespaceManager(clientset, nil, "")
+	tui := &TUI{App: models.NewApp("test"), projectManager: manager, namespace: "shop"}
+
+	// Projects are switched in memory unless the setting writes the kubeconfig
+	tui.applyProjectSwitching()
+	if current, err := manager.GetCurrent(context.Background()); err != nil || current.Name != "shop" {
+		t.Fatalf("Expected the namespace held in memory, got %v, %v", current, err)
+	}
+
+	tui.settingsIndex = settingKubeconfigNamespace
+	tui.editSetting()
+	if !tui.prefs.KubeconfigNamespaceEnabled() || tui.settingValue(settingKubeconfigNamespace) != "on" {
+		t.Fatalf("Expected enter to turn writing the kubeconfig on")
+	}
+	if _, err := manager.GetCurrent(context.Background()); err == nil {
+		t.Errorf("Expected the manager to read the kubeconfig, which has no path here")
+	}
+}
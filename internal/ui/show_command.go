@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// cliName returns the CLI the equivalent commands are written for: oc on
+// OpenShift and for OpenShift kinds, kubectl otherwise
+func (t *TUI) cliName(kind string) string {
+	if isOpenShiftKind(kind) {
+		return "oc"
+	}
+	if osClient, ok := t.k8sClient.(k8s.OpenShiftClient); ok && osClient.IsOpenShift() {
+		return "oc"
+	}
+	return "kubectl"
+}
+
+// namespaceFlag returns the namespace flag of a command on a resource, ""
+// for cluster-scoped resources
+func namespaceFlag(namespace string) string {
+	if namespace == "" {
+		return ""
+	}
+	return " -n " + namespace
+}
+
+// equivalentCommand returns the oc or kubectl command showing what LazyOC
+// shows: the streamed logs, the YAML view, or the selected resource
+func (t *TUI) equivalentCommand() string {
+	if t.showYAMLView && t.yamlRef.Name != "" {
+		ref := t.yamlRef
+		return fmt.Sprintf("%s get %s %s%s -o yaml", t.cliName(ref.Kind), strings.ToLower(ref.Kind), ref.Name, namespaceFlag(ref.Namespace))
+	}
+
+	ref, ok := t.selectedResource()
+	if !ok {
+		return t.listCommand()
+	}
+	cli, kind, ns := t.cliName(ref.Kind), strings.ToLower(ref.Kind), namespaceFlag(ref.Namespace)
+
+	switch {
+	case t.ActiveTab == models.TabPods && t.showLogs && t.logViewMode == constants.PodLogViewMode:
+		target := t.currentLogTarget(t.pods[t.selectedPod])
+		if target.Container == "" {
+			return fmt.Sprintf("%s logs -f %s%s", cli, ref.Name, ns)
+		}
+		if target.Previous {
+			return fmt.Sprintf("%s logs %s -c %s --previous%s", cli, ref.Name, target.Container, ns)
+		}
+		return fmt.Sprintf("%s logs -f %s -c %s%s", cli, ref.Name, target.Container, ns)
+
+	case t.ActiveTab == models.TabBuilds && t.showLogs:
+		return fmt.Sprintf("oc logs -f build/%s%s", ref.Name, ns)
+
+	case ref.Kind == "BuildConfig":
+		return fmt.Sprintf("oc start-build %s --follow%s", ref.Name, ns)
+
+	case ref.Kind == "CronJob":
+		return fmt.Sprintf("%s create job %s-manual --from=cronjob/%s%s", cli, ref.Name, ref.Name, ns)
+	}
+	return fmt.Sprintf("%s describe %s %s%s", cli, kind, ref.Name, ns)
+}
+
+// listCommand returns the command listing the resources of the active tab
+func (t *TUI) listCommand() string {
+	resource := strings.ToLower(t.GetTabName(t.ActiveTab))
+	switch t.ActiveTab {
+	case models.TabStorage:
+		resource = "pvc"
+	case models.TabNodes:
+		return t.cliName("Node") + " get nodes"
+	case models.TabBuildConfigs, models.TabImageStreams, models.TabRoutes, models.TabBuilds, models.TabDeploymentConfigs:
+		return fmt.Sprintf("oc get %s%s", resource, t.listNamespaceFlag())
+	}
+	return fmt.Sprintf("%s get %s%s", t.cliName(""), resource, t.listNamespaceFlag())
+}
+
+// listNamespaceFlag returns the namespace flag of a list command
+func (t *TUI) listNamespaceFlag() string {
+	if t.allNamespaces {
+		return " -A"
+	}
+	return namespaceFlag(t.namespace)
+}
+
+// copyEquivalentCommand copies the command equivalent to the current view
+// to the clipboard
+func (t *TUI) copyEquivalentCommand() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	command := t.equivalentCommand()
+	t.logInfo(categoryAction, "$ %s", command)
+	return t.copyToClipboard(command)
+}
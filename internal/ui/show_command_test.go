@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestEquivalentCommand(t *testing.T) {
+	pod := resources.PodInfo{
+		ResourceInfo:  resources.ResourceInfo{Name: "shop-7d9f", Namespace: "shop"},
+		ContainerInfo: []resources.ContainerInfo{{Name: "app"}, {Name: "proxy"}},
+	}
+	tui := &TUI{App: models.NewApp("test"), namespace: "shop", pods: []resources.PodInfo{pod}}
+
+	if got := tui.equivalentCommand(); got != "kubectl describe pod shop-7d9f -n shop" {
+		t.Errorf("Expected describe for the selected pod, got %q", got)
+	}
+
+	tui.showLogs, tui.logViewMode = true, constants.PodLogViewMode
+	tui.logTargetPod, tui.logTarget = "shop-7d9f", logTarget{Container: "proxy"}
+	if got := tui.equivalentCommand(); got != "kubectl logs -f shop-7d9f -c proxy -n shop" {
+		t.Errorf("Expected the streamed container's logs, got %q", got)
+	}
+
+	tui.ActiveTab = models.TabBuildConfigs
+	tui.buildConfigs = []resources.BuildConfigInfo{{ResourceInfo: resources.ResourceInfo{Name: "shop", Namespace: "shop"}}}
+	if got := tui.equivalentCommand(); got != "oc start-build shop --follow -n shop" {
+		t.Errorf("Expected start-build for a BuildConfig, got %q", got)
+	}
+
+	tui.showYAMLView, tui.yamlRef = true, resourceRef{Kind: "Node", Name: "worker-1"}
+	if got := tui.equivalentCommand(); got != "kubectl get node worker-1 -o yaml" {
+		t.Errorf("Expected get -o yaml without a namespace for a node, got %q", got)
+	}
+
+	tui.showYAMLView = false
+	tui.ActiveTab, tui.allNamespaces = models.TabDeployments, true
+	if got := tui.equivalentCommand(); got != "kubectl get deployments -A" {
+		t.Errorf("Expected the list command without a selection, got %q", got)
+	}
+}
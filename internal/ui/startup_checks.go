@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// enabledStartupChecks returns the startup checks the configuration enables
+func (t *TUI) enabledStartupChecks() []string {
+	if t.config == nil {
+		return resources.StartupChecks
+	}
+	return t.config.EnabledStartupChecks(resources.StartupChecks)
+}
+
+// runStartupChecks runs the enabled startup checks against the active
+// cluster. Manual runs show every result, runs on connect only report
+// failures.
+func (t *TUI) runStartupChecks(manual bool) tea.Cmd {
+	checker, ok := t.resourceClient.(resources.StartupChecker)
+	names := t.enabledStartupChecks()
+	if !ok || len(names) == 0 {
+		if manual {
+			t.logInfo(categoryConnection, "No startup checks enabled")
+		}
+		return nil
+	}
+
+	t.runningStartupChecks = true
+	clusterContext, namespace := t.context, t.namespace
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultRequestTimeout)
+		defer cancel()
+		return messages.StartupChecksCompleted{
+			Context: clusterContext,
+			Results: checker.RunStartupChecks(ctx, namespace, names),
+			Manual:  manual,
+		}
+	}
+}
+
+// openStartupChecks reruns the startup checks and shows every result
+func (t *TUI) openStartupChecks() tea.Cmd {
+	t.showStartupChecks = true
+	t.startupCheckIndex = 0
+	t.startupCheckResults = nil
+	return t.runStartupChecks(true)
+}
+
+// handleStartupChecksCompleted stores the results of the startup checks and
+// opens the warnings panel when a check on connect failed
+func (t *TUI) handleStartupChecksCompleted(msg messages.StartupChecksCompleted) {
+	if msg.Context != t.context {
+		return // The cluster was switched while the checks ran
+	}
+	t.runningStartupChecks = false
+
+	failed := failedStartupChecks(msg.Results)
+	for _, result := range failed {
+		t.logWarn(categoryConnection, "%s: %s", result.Title, result.Message)
+	}
+
+	if msg.Manual {
+		t.startupCheckResults = msg.Results
+		return
+	}
+	if len(failed) > 0 {
+		t.startupCheckResults = failed
+		t.startupCheckIndex = 0
+		t.showStartupChecks = true
+	}
+}
+
+// failedStartupChecks returns the checks that did not pass
+func failedStartupChecks(results []resources.StartupCheckResult) []resources.StartupCheckResult {
+	var failed []resources.StartupCheckResult
+	for _, result := range results {
+		if !result.Passed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// disableSelectedStartupCheck stops the selected check from running on
+// connect and removes it from the panel
+func (t *TUI) disableSelectedStartupCheck() {
+	if t.startupCheckIndex >= len(t.startupCheckResults) {
+		return
+	}
+	result := t.startupCheckResults[t.startupCheckIndex]
+	if t.config == nil {
+		t.logWarn(categoryConnection, "No configuration to disable the %s check in", result.Name)
+		return
+	}
+
+	t.config.DisableStartupCheck(result.Name)
+	if err := t.saveUserConfig(); err != nil {
+		t.logWarn(categoryConnection, "Failed to save settings: %v", err)
+		return
+	}
+	t.logInfo(categoryConnection, "Disabled the %s startup check, :checks runs the others", result.Name)
+
+	t.startupCheckResults = append(t.startupCheckResults[:t.startupCheckIndex:t.startupCheckIndex], t.startupCheckResults[t.startupCheckIndex+1:]...)
+	t.startupCheckIndex = min(t.startupCheckIndex, max(len(t.startupCheckResults)-1, 0))
+	if len(t.startupCheckResults) == 0 {
+		t.showStartupChecks = false
+	}
+}
+
+// renderStartupChecks renders the startup check warnings panel
+func (t *TUI) renderStartupChecks() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(110, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("243"))
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🩺 Cluster Checks") + "\n\n")
+
+	switch {
+	case t.runningStartupChecks && len(t.startupCheckResults) == 0:
+		content.WriteString(fmt.Sprintf("%s Running checks...\n", t.getLoadingSpinner()))
+	case len(t.startupCheckResults) == 0:
+		content.WriteString("No startup checks enabled.\n")
+	}
+
+	for i, result := range t.startupCheckResults {
+		level := statusOK
+		if !result.Passed {
+			level = statusWarn
+		}
+		cursor := "  "
+		if i == t.startupCheckIndex {
+			cursor = "▶ "
+		}
+		content.WriteString(fmt.Sprintf("%s%s %s\n", cursor, t.statusIndicator(level, "●"), lipgloss.NewStyle().Bold(true).Render(result.Title)))
+		content.WriteString(fmt.Sprintf("    %s\n", result.Message))
+		if result.Hint != "" {
+			content.WriteString(hintStyle.Render("    → "+result.Hint) + "\n")
+		}
+		content.WriteString("\n")
+	}
+
+	content.WriteString("j/k: select • x: stop running this check • r: rerun • esc/enter: dismiss")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleStartupChecksKeys handles key input for the startup checks panel
+func (t *TUI) handleStartupChecksKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "enter":
+		t.showStartupChecks = false
+
+	case "j", "down":
+		if t.startupCheckIndex < len(t.startupCheckResults)-1 {
+			t.startupCheckIndex++
+		}
+
+	case "k", "up":
+		if t.startupCheckIndex > 0 {
+			t.startupCheckIndex--
+		}
+
+	case "x":
+		t.disableSelectedStartupCheck()
+
+	case "r":
+		return t, t.openStartupChecks()
+	}
+
+	return t, nil
+}
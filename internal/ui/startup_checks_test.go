@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestStartupChecksPanel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	tui := &TUI{App: models.NewApp("test"), connected: true, context: "dev", config: &config.Config{}, configPath: path, width: 120, height: 40}
+
+	results := []resources.StartupCheckResult{
+		{Name: resources.StartupCheckMetricsServer, Title: "Metrics server", Passed: true, Message: "The resource metrics API is available"},
+		{Name: resources.StartupCheckDefaultStorageClass, Title: "Default storage class", Message: "No storage class is defined", Hint: "Install a storage provisioner"},
+		{Name: resources.StartupCheckImagePullSecrets, Title: "Image pull secrets", Message: "Service account shop/default has no image pull secrets"},
+	}
+
+	tui.handleStartupChecksCompleted(messages.StartupChecksCompleted{Context: "prod", Results: results})
+	if tui.showStartupChecks {
+		t.Fatalf("Expected the checks of another cluster to be ignored")
+	}
+
+	tui.handleStartupChecksCompleted(messages.StartupChecksCompleted{Context: "dev", Results: results})
+	if !tui.showStartupChecks || len(tui.startupCheckResults) != 2 {
+		t.Fatalf("Expected the panel to list the two failures, got %+v", tui.startupCheckResults)
+	}
+	view := tui.renderStartupChecks()
+	if !strings.Contains(view, "Install a storage provisioner") || strings.Contains(view, "Metrics server") {
+		t.Errorf("Expected the failures and their hints only, got %q", view)
+	}
+
+	tui.handleStartupChecksKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	tui.handleStartupChecksKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if len(tui.startupCheckResults) != 1 || tui.startupCheckIndex != 0 {
+		t.Fatalf("Expected the disabled check to leave the panel, got %+v", tui.startupCheckResults)
+	}
+	saved, err := config.Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if got := saved.EnabledStartupChecks(resources.StartupChecks); len(got) != 2 {
+		t.Errorf("Expected the image pull secrets check to be disabled, got %v", got)
+	}
+
+	tui.handleStartupChecksKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showStartupChecks {
+		t.Errorf("Expected esc to dismiss the panel")
+	}
+
+	// Checks run on request list the passes too
+	tui.handleStartupChecksCompleted(messages.StartupChecksCompleted{Context: "dev", Results: results, Manual: true})
+	if len(tui.startupCheckResults) != 3 {
+		t.Errorf("Expected every result of a manual run, got %d", len(tui.startupCheckResults))
+	}
+}
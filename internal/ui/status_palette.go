@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// statusLevel is the health a status indicator shows
+type statusLevel int
+
+const (
+	statusOK statusLevel = iota
+	statusWarn
+	statusFailed
+	statusUnknown
+)
+
+// statusPalette holds the color and mark of each status level. A palette
+// without marks keeps the indicators each view draws.
+type statusPalette struct {
+	colors [4]lipgloss.Color
+	marks  [4]string
+}
+
+// statusPalettes are the palettes selectable in the settings
+var statusPalettes = map[string]statusPalette{
+	constants.StatusPaletteDefault: {
+		colors: [4]lipgloss.Color{"10", "11", "9", "8"}, // green, yellow, red, grey
+	},
+	constants.StatusPaletteColorBlind: {
+		colors: [4]lipgloss.Color{"33", "227", "202", "8"}, // blue, yellow, vermillion, grey
+		marks:  [4]string{"✔", "▲", "✖", "?"},
+	},
+}
+
+// statusPalette returns the palette in effect
+func (t *TUI) statusPalette() statusPalette {
+	return statusPalettes[t.prefs.StatusPaletteName()]
+}
+
+// statusColor returns the color of a status level
+func (t *TUI) statusColor(level statusLevel) lipgloss.Color {
+	return t.statusPalette().colors[level]
+}
+
+// statusStyle returns the style of a status level
+func (t *TUI) statusStyle(level statusLevel) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(t.statusColor(level))
+}
+
+// statusMark returns the mark of a status level, or mark when the palette
+// tells levels apart by color only
+func (t *TUI) statusMark(level statusLevel, mark string) string {
+	if palette := t.statusPalette(); palette.marks[level] != "" {
+		return palette.marks[level]
+	}
+	return mark
+}
+
+// statusPrefix returns the mark of a status level followed by a space for
+// text that is only colored by the default palette, e.g. "▲ api 450ms"
+func (t *TUI) statusPrefix(level statusLevel) string {
+	if mark := t.statusMark(level, ""); mark != "" {
+		return mark + " "
+	}
+	return ""
+}
+
+// statusIndicator returns the colored mark of a status level
+func (t *TUI) statusIndicator(level statusLevel, mark string) string {
+	return t.statusStyle(level).Render(t.statusMark(level, mark))
+}
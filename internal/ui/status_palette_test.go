@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestStatusPalette(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+
+	if mark := tui.statusMark(statusFailed, "✗"); mark != "✗" {
+		t.Errorf("Expected the default palette to keep the view's mark, got %q", mark)
+	}
+	if prefix := tui.statusPrefix(statusWarn); prefix != "" {
+		t.Errorf("Expected no prefix with the default palette, got %q", prefix)
+	}
+
+	tui.prefs = config.Preferences{StatusPalette: constants.StatusPaletteColorBlind}
+	marks := map[string]bool{}
+	for _, level := range []statusLevel{statusOK, statusWarn, statusFailed, statusUnknown} {
+		marks[tui.statusMark(level, "●")] = true
+	}
+	if len(marks) != 4 {
+		t.Errorf("Expected a distinct shape for every level, got %v", marks)
+	}
+	if tui.statusColor(statusOK) == statusPalettes[constants.StatusPaletteDefault].colors[statusOK] {
+		t.Errorf("Expected healthy not to be green with the color-blind palette")
+	}
+
+	tui.connected = true
+	tui.apiLatency = 450 * time.Millisecond
+	if indicator := tui.renderAPILatency(); !strings.Contains(indicator, "▲ api 450ms") {
+		t.Errorf("Expected a slow API to be marked degraded, got %q", indicator)
+	}
+}
+
+func TestStatusPaletteSetting(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+	tui.settingsIndex = settingStatusPalette
+
+	tui.editSetting()
+	if tui.prefs.StatusPaletteName() != constants.StatusPaletteColorBlind || tui.settingsEditing {
+		t.Fatalf("Expected enter to switch to the color-blind palette, got %q", tui.settingValue(settingStatusPalette))
+	}
+	tui.editSetting()
+	if tui.prefs.StatusPaletteName() != constants.StatusPaletteDefault {
+		t.Errorf("Expected enter to switch back to the default palette")
+	}
+}
@@ -0,0 +1,372 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadPersistentVolumeClaims loads the persistentvolumeclaims of the current namespace
+func (t *TUI) loadPersistentVolumeClaims() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.PersistentVolumeClaimsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		list, err := loadAllPages(t, "persistentvolumeclaims", t.listOptions(), t.resourceClient.ListPersistentVolumeClaims)
+		if err != nil {
+			return messages.PersistentVolumeClaimsLoadError{Err: err}
+		}
+
+		return messages.PersistentVolumeClaimsLoaded{Claims: list.Items}
+	}
+}
+
+// loadPersistentVolumes loads the persistentvolumes of the cluster
+func (t *TUI) loadPersistentVolumes() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.PersistentVolumesLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		list, err := loadAllPages(t, "persistentvolumes", resources.ListOptions{}, t.resourceClient.ListPersistentVolumes)
+		if err != nil {
+			return messages.PersistentVolumesLoadError{Err: err}
+		}
+
+		return messages.PersistentVolumesLoaded{Volumes: list.Items}
+	}
+}
+
+// loadStorageClasses loads the storageclasses of the cluster
+func (t *TUI) loadStorageClasses() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.StorageClassesLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		list, err := loadAllPages(t, "storageclasses", resources.ListOptions{}, t.resourceClient.ListStorageClasses)
+		if err != nil {
+			return messages.StorageClassesLoadError{Err: err}
+		}
+
+		return messages.StorageClassesLoaded{StorageClasses: list.Items}
+	}
+}
+
+// handleStorageClassesLoadError records a failed StorageClass load. The
+// Storage tab still works without them, only the pending hints are vaguer.
+func (t *TUI) handleStorageClassesLoadError(msg messages.StorageClassesLoadError) {
+	t.storageClasses = nil
+	t.logWarn(categoryResource, "Failed to load StorageClasses: %v", msg.Err)
+	if t.showClusterStorage {
+		t.clusterStorageError = msg.Err.Error()
+	}
+}
+
+// handlePersistentVolumesLoadError records a failed PersistentVolume load,
+// which usually means cluster-wide read access is missing
+func (t *TUI) handlePersistentVolumesLoadError(msg messages.PersistentVolumesLoadError) {
+	t.persistentVolumes = nil
+	t.loadingClusterStorage = false
+	t.clusterStorageError = msg.Err.Error()
+	t.logError(categoryResource, "Failed to load PersistentVolumes: %v", msg.Err)
+}
+
+// currentPVC returns the selected persistentvolumeclaim, if any
+func (t *TUI) currentPVC() (resources.PersistentVolumeClaimInfo, bool) {
+	if t.selectedPVC < 0 || t.selectedPVC >= len(t.pvcs) {
+		return resources.PersistentVolumeClaimInfo{}, false
+	}
+	return t.pvcs[t.selectedPVC], true
+}
+
+// storageClass looks up a loaded storageclass by name
+func (t *TUI) storageClass(name string) (resources.StorageClassInfo, bool) {
+	for _, sc := range t.storageClasses {
+		if sc.Name == name {
+			return sc, true
+		}
+	}
+	return resources.StorageClassInfo{}, false
+}
+
+// defaultStorageClass returns the default storageclass, if the cluster has one
+func (t *TUI) defaultStorageClass() (resources.StorageClassInfo, bool) {
+	for _, sc := range t.storageClasses {
+		if sc.Default {
+			return sc, true
+		}
+	}
+	return resources.StorageClassInfo{}, false
+}
+
+// pendingReason explains the usual reasons a claim stays Pending, from its
+// storage class
+func (t *TUI) pendingReason(pvc resources.PersistentVolumeClaimInfo) string {
+	if t.storageClasses == nil {
+		return "Waiting for a matching PersistentVolume or for the provisioner"
+	}
+
+	className := pvc.StorageClass
+	if className == "" {
+		if _, ok := t.defaultStorageClass(); !ok {
+			return "No storage class is set and the cluster has no default StorageClass, a PersistentVolume must be bound manually"
+		}
+		return "Waiting for the default StorageClass to provision a volume"
+	}
+
+	sc, ok := t.storageClass(className)
+	switch {
+	case !ok:
+		return fmt.Sprintf("StorageClass %s does not exist", className)
+	case sc.VolumeBindingMode == "WaitForFirstConsumer":
+		return "The volume is provisioned once a pod using this claim is scheduled"
+	case sc.Provisioner == "kubernetes.io/no-provisioner":
+		return fmt.Sprintf("StorageClass %s has no provisioner, a matching PersistentVolume must be created", className)
+	}
+	return fmt.Sprintf("Waiting for %s to provision a volume", sc.Provisioner)
+}
+
+// pvcRowStyle returns the row style for a claim status
+func pvcRowStyle(pvc resources.PersistentVolumeClaimInfo) lipgloss.Style {
+	switch pvc.Status {
+	case "Pending":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	case "Lost":
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	}
+	return lipgloss.NewStyle()
+}
+
+// storageClassName formats the storage class of a claim for display
+func storageClassName(name string) string {
+	if name == "" {
+		return "<none>"
+	}
+	return name
+}
+
+// updatePVCDisplay updates the main content with persistentvolumeclaim information
+func (t *TUI) updatePVCDisplay() {
+	if t.loadingPVCs {
+		t.mainContent = "💾 Storage\n\nLoading PersistentVolumeClaims..."
+		return
+	}
+
+	if len(t.pvcs) == 0 {
+		t.mainContent = "💾 Storage\n\nNo PersistentVolumeClaims found in this namespace\n\nPress 'v' for PersistentVolumes and StorageClasses • Press 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("💾 Storage\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-12s %-24s %-10s %-10s %-18s %s", "NAME", "STATUS", "VOLUME", "CAPACITY", "ACCESS", "STORAGECLASS", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 120))
+	content.WriteString("\n")
+
+	// Claim rows
+	start, end := t.listWindow(t.selectedPVC, len(t.pvcs))
+	for i := start; i < end; i++ {
+		pvc := t.pvcs[i]
+		style := pvcRowStyle(pvc)
+		if i == t.selectedPVC {
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+		}
+
+		capacity := pvc.Capacity
+		if capacity == "" {
+			capacity = "-"
+		}
+		row := t.namespaceCell(pvc.Namespace) + fmt.Sprintf("%-30s %-12s %-24s %-10s %-10s %-18s %s",
+			truncateString(pvc.Name, 30),
+			pvc.Status,
+			truncateString(pvc.Volume, 24),
+			capacity,
+			strings.Join(pvc.AccessModes, ","),
+			truncateString(storageClassName(pvc.StorageClass), 18),
+			t.formatTime(pvc.CreatedAt, pvc.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.pvcs)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'v' for PersistentVolumes and StorageClasses")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected claim info
+	if pvc, ok := t.currentPVC(); ok {
+		t.updatePVCDetails(pvc)
+	}
+}
+
+// updatePVCDetails updates the detail pane with persistentvolumeclaim information
+func (t *TUI) updatePVCDetails(pvc resources.PersistentVolumeClaimInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("💾 PersistentVolumeClaim Details: %s\n\n", pvc.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:     %s\n", pvc.Namespace))
+	details.WriteString(fmt.Sprintf("Status:        %s\n", pvc.Status))
+	if pvc.Volume != "" {
+		details.WriteString(fmt.Sprintf("Volume:        %s\n", pvc.Volume))
+	}
+	details.WriteString(fmt.Sprintf("Requested:     %s\n", pvc.Requested))
+	if pvc.Capacity != "" {
+		details.WriteString(fmt.Sprintf("Capacity:      %s\n", pvc.Capacity))
+	}
+	details.WriteString(fmt.Sprintf("Access Modes:  %s\n", strings.Join(pvc.AccessModes, ", ")))
+	if pvc.VolumeMode != "" {
+		details.WriteString(fmt.Sprintf("Volume Mode:   %s\n", pvc.VolumeMode))
+	}
+	details.WriteString(fmt.Sprintf("Storage Class: %s\n", storageClassName(pvc.StorageClass)))
+	if sc, ok := t.storageClass(pvc.StorageClass); ok {
+		details.WriteString(fmt.Sprintf("  Provisioner: %s\n", sc.Provisioner))
+		details.WriteString(fmt.Sprintf("  Binding:     %s\n", sc.VolumeBindingMode))
+		details.WriteString(fmt.Sprintf("  Reclaim:     %s\n", sc.ReclaimPolicy))
+	}
+	details.WriteString(fmt.Sprintf("Age:           %s\n", t.formatTime(pvc.CreatedAt, pvc.Age)))
+
+	if pvc.Status == "Pending" {
+		hint := lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("⏳ " + t.pendingReason(pvc))
+		details.WriteString(fmt.Sprintf("\n%s\n", hint))
+	}
+
+	writeWorkloadMetadata(&details, nil, pvc.Labels)
+	details.WriteString(t.renderRelatedEvents("PersistentVolumeClaim", pvc.Name))
+
+	t.detailContent = details.String()
+}
+
+// openClusterStorage shows the persistentvolumes and storageclasses of the cluster
+func (t *TUI) openClusterStorage() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showClusterStorage = true
+	return t.reloadClusterStorage()
+}
+
+// reloadClusterStorage reloads the cluster storage view
+func (t *TUI) reloadClusterStorage() tea.Cmd {
+	t.loadingClusterStorage = true
+	t.clusterStorageError = ""
+	t.clusterStorageScroll = 0
+	return tea.Batch(t.loadPersistentVolumes(), t.loadStorageClasses())
+}
+
+// clusterStorageLines renders the persistentvolume and storageclass tables
+func (t *TUI) clusterStorageLines() []string {
+	bold := lipgloss.NewStyle().Bold(true)
+
+	lines := []string{bold.Render(fmt.Sprintf("PersistentVolumes (%d)", len(t.persistentVolumes)))}
+	lines = append(lines, bold.Render(fmt.Sprintf("%-30s %-9s %-7s %-8s %-10s %-28s %s", "NAME", "CAPACITY", "ACCESS", "RECLAIM", "STATUS", "CLAIM", "STORAGECLASS")))
+	for _, pv := range t.persistentVolumes {
+		line := fmt.Sprintf("%-30s %-9s %-7s %-8s %-10s %-28s %s",
+			truncateString(pv.Name, 30),
+			pv.Capacity,
+			strings.Join(pv.AccessModes, ","),
+			pv.ReclaimPolicy,
+			pv.Status,
+			truncateString(pv.Claim, 28),
+			storageClassName(pv.StorageClass),
+		)
+		if pv.Status == "Failed" || pv.Status == "Released" {
+			line = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, "", bold.Render(fmt.Sprintf("StorageClasses (%d)", len(t.storageClasses))))
+	lines = append(lines, bold.Render(fmt.Sprintf("%-30s %-32s %-8s %-22s %s", "NAME", "PROVISIONER", "RECLAIM", "BINDING", "EXPANSION")))
+	for _, sc := range t.storageClasses {
+		name := sc.Name
+		if sc.Default {
+			name += " (default)"
+		}
+		lines = append(lines, fmt.Sprintf("%-30s %-32s %-8s %-22s %t",
+			truncateString(name, 30),
+			truncateString(sc.Provisioner, 32),
+			sc.ReclaimPolicy,
+			sc.VolumeBindingMode,
+			sc.AllowExpansion,
+		))
+	}
+	return lines
+}
+
+// renderClusterStorage renders the cluster storage view
+func (t *TUI) renderClusterStorage() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(130, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("💾 Cluster Storage") + "\n\n")
+
+	switch {
+	case t.loadingClusterStorage:
+		content.WriteString(fmt.Sprintf("%s Loading PersistentVolumes and StorageClasses...\n", t.getLoadingSpinner()))
+	case t.clusterStorageError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.clusterStorageError) + "\n")
+		content.WriteString("Listing PersistentVolumes and StorageClasses needs cluster-wide read access\n")
+	default:
+		lines := t.clusterStorageLines()
+		visible := max(t.height-14, 3)
+		start := min(t.clusterStorageScroll, max(len(lines)-visible, 0))
+		end := min(start+visible, len(lines))
+		for _, line := range lines[start:end] {
+			content.WriteString(line + "\n")
+		}
+		if len(lines) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d lines]\n", start+1, end, len(lines)))
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: scroll • r: refresh • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleClusterStorageKeys handles key input for the cluster storage view
+func (t *TUI) handleClusterStorageKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		t.showClusterStorage = false
+		t.persistentVolumes = nil
+
+	case "j", "down":
+		if t.clusterStorageScroll < len(t.clusterStorageLines())-1 {
+			t.clusterStorageScroll++
+		}
+
+	case "k", "up":
+		if t.clusterStorageScroll > 0 {
+			t.clusterStorageScroll--
+		}
+
+	case "r":
+		return t, t.reloadClusterStorage()
+	}
+
+	return t, nil
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestStorageTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabStorage
+
+	tui.Update(messages.PersistentVolumeClaimsLoaded{Claims: []resources.PersistentVolumeClaimInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "data-db-0", Namespace: "demo", Status: "Bound"}, Volume: "pvc-1234",
+			Capacity: "10Gi", Requested: "10Gi", AccessModes: []string{"RWO"}, StorageClass: "fast"},
+		{ResourceInfo: resources.ResourceInfo{Name: "uploads", Namespace: "demo", Status: "Pending"}, Requested: "5Gi",
+			AccessModes: []string{"RWX"}, StorageClass: "slow"},
+	}})
+
+	if !strings.Contains(tui.mainContent, "pvc-1234") || !strings.Contains(tui.mainContent, "10Gi") {
+		t.Errorf("Expected the claim table, got %q", tui.mainContent)
+	}
+	if !strings.Contains(tui.detailContent, "PersistentVolumeClaim Details: data-db-0") {
+		t.Errorf("Expected details of the first claim, got %q", tui.detailContent)
+	}
+
+	ref, ok := tui.selectedResource()
+	if !ok || ref.Kind != "PersistentVolumeClaim" || ref.Name != "data-db-0" {
+		t.Errorf("Expected PersistentVolumeClaim/data-db-0 as the YAML target, got %+v", ref)
+	}
+
+	// A pending claim explains what it is waiting for
+	tui.Update(messages.StorageClassesLoaded{StorageClasses: []resources.StorageClassInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "fast"}, Provisioner: "ebs.csi.aws.com", VolumeBindingMode: "WaitForFirstConsumer", Default: true},
+	}})
+	NewNavigator(tui).moveResourceSelection(1)
+	if !strings.Contains(tui.detailContent, "StorageClass slow does not exist") {
+		t.Errorf("Expected the missing storage class to be reported, got %q", tui.detailContent)
+	}
+
+	tui.storageClasses = append(tui.storageClasses, resources.StorageClassInfo{
+		ResourceInfo: resources.ResourceInfo{Name: "slow"}, Provisioner: "nfs", VolumeBindingMode: "WaitForFirstConsumer",
+	})
+	tui.updateMainContent()
+	if !strings.Contains(tui.detailContent, "provisioned once a pod using this claim is scheduled") {
+		t.Errorf("Expected the binding mode to be explained, got %q", tui.detailContent)
+	}
+}
+
+func TestClusterStorageView(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 140, height: 40}
+	tui.ActiveTab = models.TabStorage
+
+	tui.openClusterStorage()
+	if !tui.showClusterStorage || !tui.loadingClusterStorage {
+		t.Fatalf("Expected the cluster storage view to open and load")
+	}
+
+	tui.Update(messages.PersistentVolumesLoaded{Volumes: []resources.PersistentVolumeInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "pvc-1234", Status: "Bound"}, Capacity: "10Gi", AccessModes: []string{"RWO"},
+			ReclaimPolicy: "Delete", Claim: "demo/data-db-0", StorageClass: "fast"},
+	}})
+	tui.Update(messages.StorageClassesLoaded{StorageClasses: []resources.StorageClassInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "fast"}, Provisioner: "ebs.csi.aws.com", ReclaimPolicy: "Delete", VolumeBindingMode: "Immediate", Default: true},
+	}})
+
+	rendered := tui.renderClusterStorage()
+	for _, want := range []string{"PersistentVolumes (1)", "demo/data-db-0", "StorageClasses (1)", "fast (default)", "ebs.csi.aws.com"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected cluster storage view to contain %q", want)
+		}
+	}
+
+	tui.Update(messages.PersistentVolumesLoadError{Err: errors.New("forbidden")})
+	if !strings.Contains(tui.renderClusterStorage(), "forbidden") {
+		t.Errorf("Expected the load error to be shown")
+	}
+
+	tui.handleClusterStorageKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showClusterStorage {
+		t.Errorf("Expected esc to close the cluster storage view")
+	}
+}
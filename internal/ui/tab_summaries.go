@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// tabSummaryResources are the resource types counted in the tab labels, in
+// the order of constants.ResourceTabs
+var tabSummaryResources = []string{
+	"pods", "services", "deployments", "configmaps", "secrets", "buildconfigs", "imagestreams", "routes",
+	"builds", "events", "statefulsets", "daemonsets", "replicasets", "jobs", "cronjobs", "nodes",
+	"persistentvolumeclaims", "ingresses", "networkpolicies", "deploymentconfigs",
+}
+
+// tabFailingWords name the failing objects of a resource type in its tab
+// label, e.g. "Deployments (12, 1 unavailable)"
+var tabFailingWords = map[string]string{
+	"pods":                   "failing",
+	"deployments":            "unavailable",
+	"builds":                 "failed",
+	"events":                 "warnings",
+	"statefulsets":           "unavailable",
+	"daemonsets":             "unavailable",
+	"replicasets":            "unavailable",
+	"jobs":                   "failed",
+	"nodes":                  "not ready",
+	"persistentvolumeclaims": "pending",
+	"deploymentconfigs":      "unavailable",
+}
+
+// startTabSummaries watches the objects of the current namespace for the
+// counts of the tab labels, replacing the watches of the previous namespace.
+// Safe mode, hibernation and the all namespaces view do without them.
+func (t *TUI) startTabSummaries() {
+	t.stopTabSummaries()
+	if !t.connected || t.safeMode || t.hibernated || t.allNamespaces || t.program == nil {
+		return
+	}
+	watcher, ok := t.resourceClient.(resources.TabSummaryWatcher)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	namespace, program := t.namespace, t.program
+	err := watcher.WatchTabSummaries(ctx, namespace, func(summaries []resources.TabSummary) {
+		program.Send(messages.TabSummariesUpdated{Namespace: namespace, Summaries: summaries})
+	})
+	if err != nil {
+		cancel()
+		t.logWarn(categoryResource, "Tab counts are not available: %v", err)
+		return
+	}
+	t.tabSummaryCancel = cancel
+	t.tabSummaryNamespace = namespace
+}
+
+// stopTabSummaries stops the watches and drops the counts of the tab labels
+func (t *TUI) stopTabSummaries() {
+	if t.tabSummaryCancel != nil {
+		t.tabSummaryCancel()
+		t.tabSummaryCancel = nil
+	}
+	t.tabSummaries = nil
+	t.tabSummaryNamespace = ""
+}
+
+// handleTabSummariesUpdated stores the counts of the tab labels, unless the
+// namespace was switched since
+func (t *TUI) handleTabSummariesUpdated(msg messages.TabSummariesUpdated) {
+	if t.tabSummaryCancel == nil || msg.Namespace != t.tabSummaryNamespace {
+		return
+	}
+	t.tabSummaries = make(map[string]resources.TabSummary, len(msg.Summaries))
+	for _, summary := range msg.Summaries {
+		t.tabSummaries[summary.Resource] = summary
+	}
+}
+
+// tabLabel returns the label of a tab with its counts, e.g. "Pods (42, 3
+// failing)". Compact labels only count the tabs with failing objects, the
+// tabs the user may not list are locked.
+func (t *TUI) tabLabel(tab int, compact bool) string {
+	name := constants.ResourceTabs[tab]
+	if tab >= len(tabSummaryResources) {
+		return name
+	}
+	if t.tabNoAccess(tab) != nil {
+		return noAccessIcon + " " + name
+	}
+	summary, ok := t.tabSummaries[tabSummaryResources[tab]]
+	if !ok {
+		return name
+	}
+	word, hasHealth := tabFailingWords[summary.Resource]
+	switch {
+	case hasHealth && summary.Failing > 0:
+		return fmt.Sprintf("%s (%d, %d %s)", name, summary.Count, summary.Failing, word)
+	case compact:
+		return name
+	}
+	return fmt.Sprintf("%s (%d)", name, summary.Count)
+}
+
+// tabLabels returns the labels of the tab bar, compact when the counts of
+// every tab do not fit the terminal
+func (t *TUI) tabLabels() []string {
+	labels := make([]string, len(constants.ResourceTabs))
+	width := 0
+	for i := range labels {
+		labels[i] = t.tabLabel(i, false)
+		width += lipgloss.Width(labels[i]) + 2 // padding
+	}
+	if width > t.width {
+		for i := range labels {
+			labels[i] = t.tabLabel(i, true)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestTabLabels(t *testing.T) {
+	tui := &TUI{
+		App:                 models.NewApp("test"),
+		width:               1000,
+		tabSummaryCancel:    func() {},
+		tabSummaryNamespace: "shop",
+	}
+
+	tui.handleTabSummariesUpdated(messages.TabSummariesUpdated{Namespace: "shop", Summaries: []resources.TabSummary{
+		{Resource: "pods", Count: 42, Failing: 3},
+		{Resource: "services", Count: 5},
+		{Resource: "events", Count: 20, Failing: 2},
+	}})
+	// Counts of a namespace switched away from are dropped
+	tui.handleTabSummariesUpdated(messages.TabSummariesUpdated{Namespace: "old", Summaries: []resources.TabSummary{{Resource: "pods", Count: 1}}})
+
+	labels := tui.tabLabels()
+	if labels[0] != "Pods (42, 3 failing)" || labels[1] != "Services (5)" || labels[2] != "Deployments" || labels[9] != "Events (20, 2 warnings)" {
+		t.Errorf("Unexpected tab labels: %s", strings.Join(labels[:10], " | "))
+	}
+
+	// Only the failing counts are kept when the labels do not fit
+	tui.width = 200
+	labels = tui.tabLabels()
+	if labels[0] != "Pods (42, 3 failing)" || labels[1] != "Services" {
+		t.Errorf("Expected compact labels on a narrow terminal, got %q and %q", labels[0], labels[1])
+	}
+
+	tui.stopTabSummaries()
+	if labels := tui.tabLabels(); labels[0] != "Pods" {
+		t.Errorf("Expected plain labels once the watches stopped, got %q", labels[0])
+	}
+}
@@ -0,0 +1,320 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// taskStatus is the lifecycle state of a background task
+type taskStatus int
+
+const (
+	taskRunning taskStatus = iota
+	taskSucceeded
+	taskFailed
+	taskCancelled
+)
+
+// taskFunc performs the work of a background task. It should honor ctx
+// cancellation and may report progress for multi-item actions.
+type taskFunc func(ctx context.Context, progress func(done, total int)) error
+
+// backgroundTask is an action running off the UI thread
+type backgroundTask struct {
+	ID       int
+	Title    string
+	Status   taskStatus
+	Err      error
+	Done     int
+	Total    int
+	Started  time.Time
+	Finished time.Time
+	DryRun   bool // Sent with dryRun=All, so nothing was persisted
+
+	cancel  context.CancelFunc
+	onDone  func(err error) tea.Cmd // Runs on the UI thread when the task finishes
+	preview bool                    // onDone also runs after a successful dry run
+}
+
+// runTask registers a background task and returns the command that executes it.
+// In dry-run mode the task's requests are validated by the server but not
+// persisted, and onDone only runs to report failures.
+func (t *TUI) runTask(title string, fn taskFunc, onDone func(err error) tea.Cmd) tea.Cmd {
+	return t.startTask(title, fn, onDone, false)
+}
+
+// runPreviewTask is runTask for actions whose onDone can show what a dry run
+// would have done; it is called after a successful dry run as well
+func (t *TUI) runPreviewTask(title string, fn taskFunc, onDone func(err error) tea.Cmd) tea.Cmd {
+	return t.startTask(title, fn, onDone, true)
+}
+
+func (t *TUI) startTask(title string, fn taskFunc, onDone func(err error) tea.Cmd, preview bool) tea.Cmd {
+	t.nextTaskID++
+	id := t.nextTaskID
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.BackgroundTaskTimeout)
+	if t.dryRun {
+		ctx = resources.WithDryRun(ctx)
+		title = "[dry run] " + title
+	}
+	task := &backgroundTask{
+		ID:      id,
+		Title:   title,
+		Status:  taskRunning,
+		Started: time.Now(),
+		DryRun:  t.dryRun,
+		cancel:  cancel,
+		onDone:  onDone,
+		preview: preview,
+	}
+	t.tasks = append(t.tasks, task)
+
+	program := t.program
+	progress := func(done, total int) {
+		if program != nil {
+			program.Send(messages.TaskProgress{ID: id, Done: done, Total: total})
+		}
+	}
+
+	return func() tea.Msg {
+		defer cancel()
+		err := fn(ctx, progress)
+		if err == nil && ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return messages.TaskFinished{ID: id, Err: err}
+	}
+}
+
+// findTask returns the task with the given ID
+func (t *TUI) findTask(id int) *backgroundTask {
+	for _, task := range t.tasks {
+		if task.ID == id {
+			return task
+		}
+	}
+	return nil
+}
+
+// handleTaskProgress records progress reported by a task
+func (t *TUI) handleTaskProgress(msg messages.TaskProgress) {
+	if task := t.findTask(msg.ID); task != nil {
+		task.Done = msg.Done
+		task.Total = msg.Total
+	}
+}
+
+// handleTaskFinished records the outcome of a task and runs its completion hook
+func (t *TUI) handleTaskFinished(msg messages.TaskFinished) tea.Cmd {
+	task := t.findTask(msg.ID)
+	if task == nil {
+		return nil
+	}
+
+	task.Finished = time.Now()
+	task.Err = msg.Err
+	switch {
+	case msg.Err == nil:
+		task.Status = taskSucceeded
+	case errors.Is(msg.Err, context.Canceled):
+		task.Status = taskCancelled
+		t.logInfo(categoryAction, "Cancelled: %s", task.Title)
+	default:
+		task.Status = taskFailed
+	}
+
+	t.pruneFinishedTasks()
+
+	if task.DryRun && task.Status == taskSucceeded && !task.preview {
+		t.logSuccess(categoryAction, "Dry run: %s would succeed; nothing was changed", strings.TrimPrefix(task.Title, "[dry run] "))
+		return nil
+	}
+
+	if task.onDone != nil && task.Status != taskCancelled {
+		return task.onDone(msg.Err)
+	}
+	return nil
+}
+
+// pruneFinishedTasks drops the oldest finished tasks beyond the retention limit
+func (t *TUI) pruneFinishedTasks() {
+	finished := 0
+	for _, task := range t.tasks {
+		if task.Status != taskRunning {
+			finished++
+		}
+	}
+
+	kept := t.tasks[:0]
+	for _, task := range t.tasks {
+		if task.Status != taskRunning && finished > constants.MaxFinishedTasks {
+			finished--
+			continue
+		}
+		kept = append(kept, task)
+	}
+	t.tasks = kept
+	if t.selectedTask >= len(t.tasks) {
+		t.selectedTask = max(len(t.tasks)-1, 0)
+	}
+}
+
+// runningTaskCount returns the number of tasks still running
+func (t *TUI) runningTaskCount() int {
+	count := 0
+	for _, task := range t.tasks {
+		if task.Status == taskRunning {
+			count++
+		}
+	}
+	return count
+}
+
+// cancelTask cancels a running task
+func (t *TUI) cancelTask(task *backgroundTask) {
+	if task.Status == taskRunning && task.cancel != nil {
+		task.cancel()
+	}
+}
+
+// clearFinishedTasks removes all finished tasks from the panel
+func (t *TUI) clearFinishedTasks() {
+	kept := t.tasks[:0]
+	for _, task := range t.tasks {
+		if task.Status == taskRunning {
+			kept = append(kept, task)
+		}
+	}
+	t.tasks = kept
+	t.selectedTask = 0
+}
+
+// toggleDryRun switches server-side dry run on or off for mutating actions
+func (t *TUI) toggleDryRun() {
+	t.dryRun = !t.dryRun
+	if t.dryRun {
+		t.logWarn(categoryAction, "Dry run enabled: changes are validated by the server but not saved")
+	} else {
+		t.logInfo(categoryAction, "Dry run disabled: changes are applied to the cluster")
+	}
+}
+
+// renderDryRunStatus returns the status bar indicator for dry-run mode
+func (t *TUI) renderDryRunStatus() string {
+	if !t.dryRun {
+		return ""
+	}
+	return lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3")).Render("🧪 DRY RUN")
+}
+
+// renderTaskIndicator returns the running task count for the status bar
+func (t *TUI) renderTaskIndicator() string {
+	running := t.runningTaskCount()
+	if running == 0 {
+		return ""
+	}
+	return fmt.Sprintf("⚙️ %d task(s)", running)
+}
+
+// renderTaskPanel renders the background task panel
+func (t *TUI) renderTaskPanel() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(90, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("⚙️ Background Tasks (%d running)", t.runningTaskCount())) + "\n\n")
+
+	if len(t.tasks) == 0 {
+		content.WriteString("No background tasks\n")
+	}
+
+	for i, task := range t.tasks {
+		icon, state := taskStatusDisplay(task)
+
+		progress := ""
+		if task.Total > 0 {
+			progress = fmt.Sprintf(" [%d/%d]", task.Done, task.Total)
+		}
+
+		elapsed := time.Since(task.Started)
+		if !task.Finished.IsZero() {
+			elapsed = task.Finished.Sub(task.Started)
+		}
+
+		line := fmt.Sprintf("%s %-40s %-10s%s %s", icon, truncateString(task.Title, 40), state, progress, elapsed.Round(100*time.Millisecond))
+		if i == t.selectedTask {
+			line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(line)
+		}
+		content.WriteString(line + "\n")
+
+		if task.Status == taskFailed && task.Err != nil {
+			errLine := "    " + truncateString(task.Err.Error(), max(modalWidth-10, 10))
+			content.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render(errLine) + "\n")
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: navigate • x: cancel task • C: clear finished • esc/b: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// taskStatusDisplay returns the icon and label for a task status
+func taskStatusDisplay(task *backgroundTask) (string, string) {
+	switch task.Status {
+	case taskSucceeded:
+		return "✅", "done"
+	case taskFailed:
+		return "❌", "failed"
+	case taskCancelled:
+		return "🚫", "cancelled"
+	default:
+		return "⏳", "running"
+	}
+}
+
+// handleTaskPanelKeys handles key input for the background task panel
+func (t *TUI) handleTaskPanelKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "b":
+		t.showTaskPanel = false
+
+	case "j", "down":
+		if len(t.tasks) > 0 {
+			t.selectedTask = (t.selectedTask + 1) % len(t.tasks)
+		}
+
+	case "k", "up":
+		if len(t.tasks) > 0 {
+			t.selectedTask = (t.selectedTask - 1 + len(t.tasks)) % len(t.tasks)
+		}
+
+	case "x":
+		if t.selectedTask < len(t.tasks) {
+			t.cancelTask(t.tasks[t.selectedTask])
+		}
+
+	case "C":
+		t.clearFinishedTasks()
+	}
+
+	return t, nil
+}
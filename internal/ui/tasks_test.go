@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+func TestBackgroundTaskLifecycle(t *testing.T) {
+	tui := &TUI{}
+
+	var completed error
+	hookCalled := false
+	cmd := tui.runTask("succeeds", func(ctx context.Context, _ func(done, total int)) error {
+		return nil
+	}, func(err error) tea.Cmd {
+		hookCalled = true
+		completed = err
+		return nil
+	})
+
+	failErr := errors.New("boom")
+	failCmd := tui.runTask("fails", func(ctx context.Context, _ func(done, total int)) error {
+		return failErr
+	}, nil)
+
+	if tui.runningTaskCount() != 2 {
+		t.Fatalf("Expected 2 running tasks, got %d", tui.runningTaskCount())
+	}
+
+	tui.handleTaskFinished(cmd().(messages.TaskFinished))
+	tui.handleTaskFinished(failCmd().(messages.TaskFinished))
+
+	if !hookCalled || completed != nil {
+		t.Errorf("Expected completion hook to run with nil error, called=%v err=%v", hookCalled, completed)
+	}
+	if tui.tasks[0].Status != taskSucceeded {
+		t.Errorf("Expected first task to succeed, got %v", tui.tasks[0].Status)
+	}
+	if tui.tasks[1].Status != taskFailed || !errors.Is(tui.tasks[1].Err, failErr) {
+		t.Errorf("Expected second task to fail with %v, got %v (%v)", failErr, tui.tasks[1].Status, tui.tasks[1].Err)
+	}
+
+	tui.clearFinishedTasks()
+	if len(tui.tasks) != 0 {
+		t.Errorf("Expected finished tasks to be cleared, got %d", len(tui.tasks))
+	}
+}
+
+func TestBackgroundTaskCancel(t *testing.T) {
+	tui := &TUI{}
+
+	hookCalled := false
+	cmd := tui.runTask("blocks", func(ctx context.Context, _ func(done, total int)) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}, func(err error) tea.Cmd {
+		hookCalled = true
+		return nil
+	})
+
+	tui.cancelTask(tui.tasks[0])
+	tui.handleTaskFinished(cmd().(messages.TaskFinished))
+
+	if tui.tasks[0].Status != taskCancelled {
+		t.Errorf("Expected task to be cancelled, got %v", tui.tasks[0].Status)
+	}
+	if hookCalled {
+		t.Errorf("Expected completion hook to be skipped for cancelled task")
+	}
+}
+
+func TestBackgroundTaskDryRun(t *testing.T) {
+	tui := &TUI{dryRun: true}
+
+	sawDryRun := false
+	hookCalled := false
+	cmd := tui.runTask("Delete pod web", func(ctx context.Context, _ func(done, total int)) error {
+		sawDryRun = resources.IsDryRun(ctx)
+		return nil
+	}, func(err error) tea.Cmd {
+		hookCalled = true
+		return nil
+	})
+
+	previewed := false
+	previewCmd := tui.runPreviewTask("Apply manifests", func(ctx context.Context, _ func(done, total int)) error {
+		return nil
+	}, func(err error) tea.Cmd {
+		previewed = true
+		return nil
+	})
+
+	tui.handleTaskFinished(cmd().(messages.TaskFinished))
+	tui.handleTaskFinished(previewCmd().(messages.TaskFinished))
+
+	if !sawDryRun {
+		t.Errorf("Expected the task context to request a dry run")
+	}
+	if tui.tasks[0].Title != "[dry run] Delete pod web" || !tui.tasks[0].DryRun {
+		t.Errorf("Expected the task to be marked as a dry run, got %q", tui.tasks[0].Title)
+	}
+	if hookCalled {
+		t.Errorf("Expected completion hook to be skipped after a successful dry run")
+	}
+	if !previewed {
+		t.Errorf("Expected preview hook to run after a successful dry run")
+	}
+}
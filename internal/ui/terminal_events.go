@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/logging"
+)
+
+// resizeSettledMsg applies the last terminal size of a burst of resizes
+type resizeSettledMsg struct {
+	seq int
+}
+
+// handleWindowSize applies the first terminal size right away and debounces
+// later resizes, so that dragging a tmux pane lays the screen out once, at
+// the size it settles on
+func (t *TUI) handleWindowSize(msg tea.WindowSizeMsg) tea.Cmd {
+	if !t.ready {
+		t.applyWindowSize(msg.Width, msg.Height)
+		return nil
+	}
+
+	t.resizeWidth, t.resizeHeight = msg.Width, msg.Height
+	t.resizeSeq++
+	seq := t.resizeSeq
+	return tea.Tick(constants.ResizeDebounceInterval, func(time.Time) tea.Msg {
+		return resizeSettledMsg{seq: seq}
+	})
+}
+
+// settleResize applies the size of a burst of resizes once no newer resize
+// arrived, and clears the screen of whatever the terminal reflowed meanwhile
+func (t *TUI) settleResize(msg resizeSettledMsg) tea.Cmd {
+	if msg.seq != t.resizeSeq {
+		return nil
+	}
+
+	t.applyWindowSize(t.resizeWidth, t.resizeHeight)
+	t.lastFrame = ""
+	return tea.ClearScreen
+}
+
+// applyWindowSize lays the screen out for a terminal size
+func (t *TUI) applyWindowSize(width, height int) {
+	t.width = width
+	t.height = height
+	t.ready = true
+	// The visible window of the current list depends on the height
+	if t.connected {
+		t.updateMainContent()
+	}
+	logging.Debug(t.Logger, "Window size: %dx%d", t.width, t.height)
+}
+
+// handleResume redraws the whole screen after lazyoc was suspended with
+// ctrl+z and brought back with fg, and turns mouse reporting back on
+func (t *TUI) handleResume() tea.Cmd {
+	t.lastFrame = ""
+	t.framePending = false
+	if t.mouseEnabled {
+		return tea.Batch(tea.ClearScreen, tea.EnableMouseAllMotion)
+	}
+	return tea.ClearScreen
+}
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestResizeDebounce(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+
+	// The first size is applied right away
+	if cmd := tui.handleWindowSize(tea.WindowSizeMsg{Width: 120, Height: 40}); cmd != nil || !tui.ready || tui.width != 120 {
+		t.Fatalf("Expected the first size to be applied, got %dx%d", tui.width, tui.height)
+	}
+
+	// A burst of resizes keeps the old layout until the last one settles
+	for _, width := range []int{110, 100, 90} {
+		if cmd := tui.handleWindowSize(tea.WindowSizeMsg{Width: width, Height: 30}); cmd == nil {
+			t.Fatalf("Expected the resize to %d to be scheduled", width)
+		}
+	}
+	if tui.width != 120 || tui.height != 40 {
+		t.Errorf("Expected the size to wait for the burst to settle, got %dx%d", tui.width, tui.height)
+	}
+
+	if cmd := tui.settleResize(resizeSettledMsg{seq: 1}); cmd != nil || tui.width != 120 {
+		t.Errorf("Expected a resize superseded by a newer one to be ignored")
+	}
+	tui.lastFrame = "stale"
+	if cmd := tui.settleResize(resizeSettledMsg{seq: tui.resizeSeq}); cmd == nil {
+		t.Errorf("Expected the screen to be cleared once the size settled")
+	}
+	if tui.width != 90 || tui.height != 30 || tui.lastFrame != "" {
+		t.Errorf("Expected the last size to be applied and the frame redrawn, got %dx%d", tui.width, tui.height)
+	}
+}
+
+func TestResumeRedraws(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), lastFrame: "stale", framePending: true}
+
+	if cmd := tui.handleResume(); cmd == nil {
+		t.Fatalf("Expected the screen to be cleared on resume")
+	}
+	if tui.lastFrame != "" || tui.framePending {
+		t.Errorf("Expected the next view to be rendered in full")
+	}
+
+	tui.mouseEnabled = true
+	if _, ok := tui.handleResume()().(tea.BatchMsg); !ok {
+		t.Errorf("Expected mouse reporting to be turned back on")
+	}
+}
+
+func TestCtrlZSuspends(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), showHelp: true}
+	handler := &KeyboardHandler{tui: tui}
+
+	_, cmd := handler.Handle(tea.KeyMsg{Type: tea.KeyCtrlZ})
+	if cmd == nil {
+		t.Fatalf("Expected ctrl+z to suspend")
+	}
+	if _, ok := cmd().(tea.SuspendMsg); !ok {
+		t.Errorf("Expected a suspend message")
+	}
+}
@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// formatTime formats when something happened as the settings say: an age
+// such as 3d, a local timestamp in the configured layout or ISO 8601. Items
+// without a time keep the age the client computed.
+func (t *TUI) formatTime(at time.Time, age string) string {
+	if at.IsZero() {
+		return age
+	}
+	switch t.prefs.TimeFormatName() {
+	case constants.TimeFormatAbsolute:
+		return at.Local().Format(t.prefs.AbsoluteTimeLayout())
+	case constants.TimeFormatISO8601:
+		return at.Local().Format(time.RFC3339)
+	}
+	return resources.FormatAge(time.Since(at))
+}
+
+// timeWidth returns the width of the times formatTime returns, for a list
+// column followed by others
+func (t *TUI) timeWidth() int {
+	switch t.prefs.TimeFormatName() {
+	case constants.TimeFormatAbsolute:
+		return len([]rune(time.Now().Format(t.prefs.AbsoluteTimeLayout())))
+	case constants.TimeFormatISO8601:
+		return len(time.Now().Format(time.RFC3339))
+	}
+	return len("12mo")
+}
+
+// formatTimeAgo formats when something happened for a sentence, e.g.
+// "3d ago" or a timestamp
+func (t *TUI) formatTimeAgo(at time.Time, age string) string {
+	formatted := t.formatTime(at, age)
+	if t.prefs.TimeFormatName() == constants.TimeFormatRelative || at.IsZero() {
+		return formatted + " ago"
+	}
+	return formatted
+}
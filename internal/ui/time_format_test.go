@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestFormatTime(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+	created := time.Now().Add(-45 * 24 * time.Hour)
+
+	if got := tui.formatTime(created, "45d"); got != "6w" {
+		t.Errorf("Expected a relative age in weeks, got %q", got)
+	}
+	if got := tui.formatTimeAgo(created, "45d"); got != "6w ago" {
+		t.Errorf("Expected an age for a sentence, got %q", got)
+	}
+	if got := tui.formatTime(time.Time{}, "3d"); got != "3d" {
+		t.Errorf("Expected an item without a time to keep its age, got %q", got)
+	}
+
+	tui.prefs = config.Preferences{TimeFormat: "absolute", TimeLayout: "02.01.2006 15:04"}
+	if got := tui.formatTimeAgo(created, "45d"); got != created.Local().Format("02.01.2006 15:04") {
+		t.Errorf("Expected a local timestamp in the configured layout, got %q", got)
+	}
+	if tui.timeWidth() != len("02.01.2006 15:04") {
+		t.Errorf("Expected the column to fit the timestamps, got %d", tui.timeWidth())
+	}
+
+	tui.prefs = config.Preferences{TimeFormat: "iso8601"}
+	if got := tui.formatTime(created, "45d"); got != created.Local().Format(time.RFC3339) {
+		t.Errorf("Expected an ISO 8601 timestamp, got %q", got)
+	}
+}
+
+func TestTimeFormatSetting(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), width: 120, height: 40}
+	tui.settingsIndex = settingTimeFormat
+
+	for _, expected := range []string{"absolute", "iso8601", "relative"} {
+		tui.editSetting()
+		if got := tui.settingValue(settingTimeFormat); !strings.HasPrefix(got, expected) {
+			t.Errorf("Expected enter to switch to %s, got %q", expected, got)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+)
+
+// openTLSError shows the certificate error screen when a connection failed
+// because the API server's certificate was not trusted
+func (t *TUI) openTLSError(err error) {
+	problem, ok := auth.CertificateProblem(err)
+	if !ok {
+		return
+	}
+	t.tlsProblem = problem
+	t.tlsErr = err
+	t.editingTLSCAFile = false
+	t.confirmingTLSSkip = false
+	t.tlsInputError = ""
+	t.showTLSError = true
+}
+
+// reconnectWithTLSOverrides closes the certificate error screen and
+// reconnects with the CA file or skipped verification chosen on it
+func (t *TUI) reconnectWithTLSOverrides(overrides auth.TLSOverrides) tea.Cmd {
+	t.tlsOverrides = overrides
+	t.showTLSError = false
+	t.connected = false
+	t.connecting = true
+	switch {
+	case overrides.Insecure:
+		t.logWarn(categoryConnection, "Skipping TLS verification for this session, the API server's identity is not checked")
+	case overrides.CAFile != "":
+		t.logInfo(categoryConnection, "Reconnecting with the CA file %s", overrides.CAFile)
+	default:
+		t.logInfo(categoryConnection, "Reconnecting...")
+	}
+	return tea.Batch(t.reconnect(), t.startSpinnerAnimation())
+}
+
+// editTLSCAFile starts entering the path of a CA file
+func (t *TUI) editTLSCAFile() tea.Cmd {
+	t.tlsCAInput = textinput.New()
+	t.tlsCAInput.Placeholder = "path to the PEM CA file, e.g. ~/ca.crt"
+	t.tlsCAInput.CharLimit = 512
+	t.tlsCAInput.Width = 60
+	t.tlsCAInput.SetValue(t.tlsOverrides.CAFile)
+	t.tlsCAInput.Focus()
+	t.tlsInputError = ""
+	t.editingTLSCAFile = true
+	return textinput.Blink
+}
+
+// submitTLSCAFile validates the entered CA file and reconnects with it
+func (t *TUI) submitTLSCAFile() tea.Cmd {
+	path, err := expandLogExportPath(strings.TrimSpace(t.tlsCAInput.Value()))
+	if err == nil {
+		err = auth.ValidateCAFile(path)
+	}
+	if err != nil {
+		t.tlsInputError = err.Error()
+		return nil
+	}
+	t.editingTLSCAFile = false
+	return t.reconnectWithTLSOverrides(auth.TLSOverrides{CAFile: path})
+}
+
+// renderTLSError renders the certificate error screen
+func (t *TUI) renderTLSError() string {
+	_, errorColor := t.getThemeColors()
+
+	modalWidth := min(90, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(errorColor).
+		Padding(1).
+		Width(modalWidth - 4)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("11")).Bold(true)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Foreground(errorColor).Render("🔒 Untrusted Cluster Certificate") + "\n\n")
+	content.WriteString(t.tlsProblem + "\n\n")
+	content.WriteString(dimStyle.Render(fmt.Sprintf("%v", t.tlsErr)) + "\n\n")
+
+	switch {
+	case t.editingTLSCAFile:
+		content.WriteString("CA file: " + t.tlsCAInput.View() + "\n")
+		if t.tlsInputError != "" {
+			content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+t.tlsInputError) + "\n")
+		}
+		content.WriteString("\nenter: connect with this CA • esc: back")
+
+	case t.confirmingTLSSkip:
+		content.WriteString(warnStyle.Render("⚠ Skip TLS verification for this session?") + "\n\n")
+		content.WriteString("LazyOC will not check who it talks to. Anyone able to intercept the\n")
+		content.WriteString("connection can read your credentials and everything you view or change.\n")
+		content.WriteString("Prefer a CA file; the kubeconfig is not changed either way.\n\n")
+		content.WriteString("y: skip verification • n/esc: back")
+
+	default:
+		content.WriteString("To trust the cluster, get its CA certificate from your administrator, e.g.\n")
+		content.WriteString("  oc get configmap kube-root-ca.crt -n default -o jsonpath='{.data.ca\\.crt}'\n")
+		content.WriteString("from a machine that can connect, and supply it here or with --certificate-authority.\n\n")
+		content.WriteString("c: supply a CA file • s: skip verification for this session • r: retry • esc: close")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleTLSErrorKeys handles key input for the certificate error screen
+func (t *TUI) handleTLSErrorKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.editingTLSCAFile {
+		switch msg.String() {
+		case "esc":
+			t.editingTLSCAFile = false
+			t.tlsInputError = ""
+			return t, nil
+		case "enter":
+			return t, t.submitTLSCAFile()
+		}
+
+		var cmd tea.Cmd
+		t.tlsCAInput, cmd = t.tlsCAInput.Update(msg)
+		return t, cmd
+	}
+
+	if t.confirmingTLSSkip {
+		switch msg.String() {
+		case "y", "Y":
+			t.confirmingTLSSkip = false
+			return t, t.reconnectWithTLSOverrides(auth.TLSOverrides{Insecure: true})
+		case "n", "N", "esc", "q":
+			t.confirmingTLSSkip = false
+		}
+		return t, nil
+	}
+
+	switch msg.String() {
+	case "c":
+		return t, t.editTLSCAFile()
+
+	case "s":
+		t.confirmingTLSSkip = true
+
+	case "r":
+		return t, t.reconnectWithTLSOverrides(t.tlsOverrides)
+
+	case "esc", "q":
+		t.showTLSError = false
+	}
+	return t, nil
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"crypto/x509"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestTLSErrorScreen(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), width: 120, height: 40}
+
+	tui.Update(messages.ConnectionError{Err: fmt.Errorf("connection refused")})
+	if tui.showTLSError {
+		t.Fatal("Expected other connection errors to keep the generic error")
+	}
+
+	tui.Update(messages.ConnectionError{Err: fmt.Errorf("connection test failed: %w", x509.UnknownAuthorityError{})})
+	if !tui.showTLSError {
+		t.Fatal("Expected the certificate error screen")
+	}
+	if view := tui.renderTLSError(); !strings.Contains(view, "signed by a certificate authority") {
+		t.Errorf("Expected the CA problem to be explained, got %q", view)
+	}
+
+	tui.handleTLSErrorKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	tui.tlsCAInput.SetValue(filepath.Join(t.TempDir(), "missing.pem"))
+	tui.handleTLSErrorKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !tui.editingTLSCAFile || tui.tlsInputError == "" || tui.tlsOverrides.CAFile != "" {
+		t.Fatal("Expected a missing CA file to be rejected")
+	}
+	tui.handleTLSErrorKeys(tea.KeyMsg{Type: tea.KeyEsc})
+
+	tui.handleTLSErrorKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	tui.handleTLSErrorKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if tui.tlsOverrides.Insecure || !tui.showTLSError {
+		t.Fatal("Expected declining to keep verification on")
+	}
+
+	tui.handleTLSErrorKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	_, cmd := tui.handleTLSErrorKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if !tui.tlsOverrides.Insecure || tui.showTLSError || !tui.connecting || cmd == nil {
+		t.Error("Expected confirming to reconnect without verification")
+	}
+}
@@ -0,0 +1,234 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// Token expiry sources
+const (
+	tokenSourceJWT         = "JWT"
+	tokenSourceOAuthAccess = "OAuth access token"
+)
+
+// loadTokenExpiry determines when the bearer token in use expires. JWTs are
+// decoded locally; opaque OpenShift tokens are looked up through the OAuth
+// API. Other auth methods (client certificates, exec plugins) have no
+// countdown.
+func (t *TUI) loadTokenExpiry() tea.Cmd {
+	k8sClient := t.k8sClient
+
+	return func() tea.Msg {
+		if k8sClient == nil {
+			return nil
+		}
+
+		token := auth.BearerToken(k8sClient.GetConfig())
+		if token == "" {
+			return nil
+		}
+
+		if expiresAt, ok := auth.JWTExpiry(token); ok {
+			return messages.TokenExpiryLoaded{ExpiresAt: expiresAt, Source: tokenSourceJWT}
+		}
+
+		objectName, ok := auth.OpenShiftTokenObjectName(token)
+		osClient, isOS := k8sClient.(k8s.OpenShiftClient)
+		if !ok || !isOS || !osClient.IsOpenShift() {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		expiresAt, expires, err := resources.NewOpenShiftResourceClient(osClient).TokenExpiry(ctx, objectName)
+		if err != nil {
+			logging.Warn(t.Logger, "Could not determine token expiry: %v", err)
+			return nil
+		}
+		if !expires {
+			return nil
+		}
+		return messages.TokenExpiryLoaded{ExpiresAt: expiresAt, Source: tokenSourceOAuthAccess}
+	}
+}
+
+// handleTokenExpiryLoaded starts the countdown for a newly loaded token
+func (t *TUI) handleTokenExpiryLoaded(msg messages.TokenExpiryLoaded) {
+	t.tokenExpiresAt = msg.ExpiresAt
+	t.tokenExpiryPrompted = false
+	t.showReloginPrompt = false
+	t.logInfo(categoryConnection, "Session %s expires at %s (in %s)",
+		msg.Source, msg.ExpiresAt.Local().Format("2006-01-02 15:04"), formatTokenRemaining(time.Until(msg.ExpiresAt)))
+}
+
+// checkTokenExpiry prompts for re-login once the token is about to expire.
+// It is called on every auto-refresh tick and prompts only once per token.
+func (t *TUI) checkTokenExpiry() {
+	if t.tokenExpiresAt.IsZero() || t.tokenExpiryPrompted {
+		return
+	}
+
+	remaining := time.Until(t.tokenExpiresAt)
+	if remaining > constants.TokenExpiryWarning {
+		return
+	}
+
+	t.tokenExpiryPrompted = true
+	t.showReloginPrompt = true
+	if remaining <= 0 {
+		t.logWarn(categoryConnection, "Session token has expired, log in again to continue")
+	} else {
+		t.logWarn(categoryConnection, "Session token expires in %s, log in again to avoid interruptions", formatTokenRemaining(remaining))
+	}
+}
+
+// formatTokenRemaining formats a token lifetime compactly, e.g. 1d3h, 2h05m or 9m30s
+func formatTokenRemaining(d time.Duration) string {
+	if d <= 0 {
+		return "expired"
+	}
+
+	d = d.Round(time.Second)
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+	}
+}
+
+// renderTokenExpiry returns the status bar countdown for the session token
+func (t *TUI) renderTokenExpiry() string {
+	if t.tokenExpiresAt.IsZero() {
+		return ""
+	}
+
+	remaining := time.Until(t.tokenExpiresAt)
+	text := "🔑 " + formatTokenRemaining(remaining)
+
+	switch {
+	case remaining <= constants.TokenExpiryWarning:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("1")).Render(text)
+	case remaining <= constants.TokenExpiryCaution:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("3")).Render(text)
+	}
+	return text
+}
+
+// loginCommand returns the 'oc login' command for the current cluster, or nil
+// when oc is not installed
+func (t *TUI) loginCommand() *exec.Cmd {
+	ocPath, err := exec.LookPath("oc")
+	if err != nil || t.k8sClient == nil || t.k8sClient.GetConfig() == nil {
+		return nil
+	}
+
+	cmd := exec.Command(ocPath, "login", "--server", t.k8sClient.GetConfig().Host)
+	if t.KubeconfigPath != "" {
+		cmd.Env = append(os.Environ(), "KUBECONFIG="+t.KubeconfigPath)
+	}
+	return cmd
+}
+
+// relogin suspends the TUI while 'oc login' runs in the terminal
+func (t *TUI) relogin() tea.Cmd {
+	cmd := t.loginCommand()
+	if cmd == nil {
+		t.logWarn(categoryConnection, "oc not found in PATH: run 'oc login' in another terminal, then press r in the prompt")
+		return nil
+	}
+
+	t.showReloginPrompt = false
+	logging.Info(t.Logger, "Running %s", strings.Join(cmd.Args, " "))
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return messages.ReloginFinished{Err: err}
+	})
+}
+
+// handleReloginFinished reconnects with the refreshed credentials
+func (t *TUI) handleReloginFinished(msg messages.ReloginFinished) tea.Cmd {
+	if msg.Err != nil {
+		t.logError(categoryConnection, "Login failed: %v", msg.Err)
+		t.tokenExpiryPrompted = false
+		return nil
+	}
+
+	t.logSuccess(categoryConnection, "Logged in again, reconnecting")
+	return t.reconnectWithNewCredentials()
+}
+
+// reconnectWithNewCredentials re-reads the kubeconfig and reconnects
+func (t *TUI) reconnectWithNewCredentials() tea.Cmd {
+	t.showReloginPrompt = false
+	t.stopPodLogStream()
+	t.stopBuildLogStream()
+	t.tokenExpiresAt = time.Time{}
+	t.connected = false
+	t.connecting = true
+	return tea.Batch(t.reconnect(), t.startSpinnerAnimation())
+}
+
+// handleReloginPromptKeys handles key input for the re-login prompt
+func (t *TUI) handleReloginPromptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		return t, t.relogin()
+
+	case "r":
+		return t, t.reconnectWithNewCredentials()
+
+	case "esc", "q":
+		t.showReloginPrompt = false
+	}
+	return t, nil
+}
+
+// renderReloginPrompt renders the token expiry prompt
+func (t *TUI) renderReloginPrompt() string {
+	_, errorColor := t.getThemeColors()
+
+	modalWidth := min(70, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(errorColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	remaining := time.Until(t.tokenExpiresAt)
+	if remaining <= 0 {
+		content.WriteString(lipgloss.NewStyle().Bold(true).Render("🔑 Session expired") + "\n\n")
+		content.WriteString("Your token has expired. Requests will fail with 401 Unauthorized until you log in again.\n")
+	} else {
+		content.WriteString(lipgloss.NewStyle().Bold(true).Render("🔑 Session expiring") + "\n\n")
+		content.WriteString(fmt.Sprintf("Your token for %s expires in %s (at %s).\n",
+			t.obfuscateClusterContext(t.context), formatTokenRemaining(remaining), t.tokenExpiresAt.Local().Format("15:04")))
+	}
+
+	content.WriteString("\n")
+	if t.loginCommand() != nil {
+		content.WriteString("enter: run 'oc login' • r: reconnect • esc: dismiss")
+	} else {
+		content.WriteString("Run 'oc login' in another terminal, then press r to reconnect.\n\n")
+		content.WriteString("r: reconnect • esc: dismiss")
+	}
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
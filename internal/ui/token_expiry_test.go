@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestFormatTokenRemaining(t *testing.T) {
+	tests := []struct {
+		remaining time.Duration
+		expected  string
+	}{
+		{-time.Minute, "expired"},
+		{9*time.Minute + 30*time.Second, "9m30s"},
+		{2*time.Hour + 5*time.Minute, "2h05m"},
+		{27 * time.Hour, "1d3h"},
+	}
+
+	for _, test := range tests {
+		if got := formatTokenRemaining(test.remaining); got != test.expected {
+			t.Errorf("formatTokenRemaining(%v) = %s, expected %s", test.remaining, got, test.expected)
+		}
+	}
+}
+
+func TestCheckTokenExpiry(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test")}
+
+	tui.checkTokenExpiry()
+	if tui.showReloginPrompt {
+		t.Fatalf("Expected no prompt without a token expiry")
+	}
+
+	tui.tokenExpiresAt = time.Now().Add(2 * time.Hour)
+	tui.checkTokenExpiry()
+	if tui.showReloginPrompt {
+		t.Errorf("Expected no prompt while the token is far from expiry")
+	}
+
+	tui.tokenExpiresAt = time.Now().Add(5 * time.Minute)
+	tui.checkTokenExpiry()
+	if !tui.showReloginPrompt {
+		t.Fatalf("Expected a prompt shortly before expiry")
+	}
+
+	// Dismissing the prompt keeps it closed for the same token
+	tui.showReloginPrompt = false
+	tui.checkTokenExpiry()
+	if tui.showReloginPrompt {
+		t.Errorf("Expected the prompt to be shown only once per token")
+	}
+}
@@ -0,0 +1,248 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// sparklineBlocks are the bars of a sparkline, lowest first
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// topRow is a pod of the Top view with its latest usage and usage history,
+// both summed over its containers
+type topRow struct {
+	pod     string
+	cpu     int64
+	memory  int64
+	cpuHist []int64
+	memHist []int64
+}
+
+// openTop shows the pods of the namespace ranked by usage and starts
+// sampling usage on an interval while the view stays open
+func (t *TUI) openTop() tea.Cmd {
+	if !t.connected {
+		return nil
+	}
+	t.showTop = true
+	t.topScroll = 0
+	if t.safeMode {
+		return t.loadPodUsage()
+	}
+	if t.topPollActive {
+		return nil
+	}
+	t.topPollActive = true
+	return tea.Batch(t.loadPodUsage(), t.scheduleTopRefresh())
+}
+
+// scheduleTopRefresh schedules the next usage sample of the Top view
+func (t *TUI) scheduleTopRefresh() tea.Cmd {
+	return tea.Tick(constants.TopRefreshInterval, func(time.Time) tea.Msg {
+		return messages.TopRefreshTick{}
+	})
+}
+
+// handleTopRefreshTick samples usage while the Top view is open and stops
+// the refresh chain once it is closed
+func (t *TUI) handleTopRefreshTick() tea.Cmd {
+	if !t.showTop || !t.connected {
+		t.topPollActive = false
+		return nil
+	}
+	if t.hibernated {
+		return t.scheduleTopRefresh()
+	}
+	return tea.Batch(t.loadPodUsage(), t.scheduleTopRefresh())
+}
+
+// addSeries adds values to a sum of series aligned on their latest value
+func addSeries(sum, values []int64) []int64 {
+	if len(values) > len(sum) {
+		sum = append(make([]int64, len(values)-len(sum)), sum...)
+	}
+	offset := len(sum) - len(values)
+	for i, v := range values {
+		sum[offset+i] += v
+	}
+	return sum
+}
+
+// topRows returns the pods of the namespace with usage samples, largest
+// CPU or memory usage first
+func (t *TUI) topRows() []topRow {
+	byPod := make(map[string]*topRow)
+	for _, samples := range t.usageHistory {
+		if len(samples) == 0 || samples[0].Namespace != t.namespace {
+			continue
+		}
+		name := samples[0].Pod
+		row, ok := byPod[name]
+		if !ok {
+			row = &topRow{pod: name}
+			byPod[name] = row
+		}
+
+		cpu := make([]int64, len(samples))
+		memory := make([]int64, len(samples))
+		for i, sample := range samples {
+			cpu[i] = sample.CPU
+			memory[i] = sample.Memory
+		}
+		row.cpuHist = addSeries(row.cpuHist, cpu)
+		row.memHist = addSeries(row.memHist, memory)
+		row.cpu += cpu[len(cpu)-1]
+		row.memory += memory[len(memory)-1]
+	}
+
+	rows := make([]topRow, 0, len(byPod))
+	for _, row := range byPod {
+		rows = append(rows, *row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		a, b := rows[i].cpu, rows[j].cpu
+		if t.topSortMemory {
+			a, b = rows[i].memory, rows[j].memory
+		}
+		if a != b {
+			return a > b
+		}
+		return rows[i].pod < rows[j].pod
+	})
+	return rows
+}
+
+// sparkline draws the last width values scaled to the largest of them
+func sparkline(values []int64, width int) string {
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	var peak int64
+	for _, v := range values {
+		if v > peak {
+			peak = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		level := 0
+		if peak > 0 {
+			level = int(v * int64(len(sparklineBlocks)-1) / peak)
+		}
+		b.WriteRune(sparklineBlocks[level])
+	}
+	return b.String()
+}
+
+// topLines renders the ranked pods of the Top view
+func (t *TUI) topLines(rows []topRow) []string {
+	cpuTitle, memoryTitle, history := "CPU ▼", "MEMORY", "CPU HISTORY"
+	if t.topSortMemory {
+		cpuTitle, memoryTitle, history = "CPU", "MEMORY ▼", "MEMORY HISTORY"
+	}
+
+	lines := []string{lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%-4s %-45s %-9s %-9s %s", "#", "POD", cpuTitle, memoryTitle, history))}
+	for i, row := range rows {
+		hist := row.cpuHist
+		if t.topSortMemory {
+			hist = row.memHist
+		}
+		lines = append(lines, fmt.Sprintf("%-4d %-45s %-9s %-9s %s",
+			i+1,
+			truncateString(row.pod, 45),
+			resources.FormatMillicores(row.cpu),
+			formatUsageBytes(row.memory),
+			sparkline(hist, constants.TopSparklineWidth),
+		))
+	}
+	return lines
+}
+
+// topVisibleRows returns the number of pods the Top view has room for
+func (t *TUI) topVisibleRows() int {
+	return max(t.height-14, 3)
+}
+
+// renderTop renders the Top view
+func (t *TUI) renderTop() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(110, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("📈 Top: "+t.namespace) + "\n")
+	content.WriteString(fmt.Sprintf("Sampled from the metrics API every %s\n\n", constants.TopRefreshInterval))
+
+	rows := t.topRows()
+	switch {
+	case t.metricsUnavailable:
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ Metrics API not available") + "\n")
+		content.WriteString("Usage needs metrics-server, or the cluster monitoring stack on OpenShift\n")
+	case len(rows) == 0:
+		content.WriteString(fmt.Sprintf("%s Collecting usage samples...\n", t.getLoadingSpinner()))
+	default:
+		lines := t.topLines(rows)
+		visible := t.topVisibleRows()
+		start := min(t.topScroll, max(len(rows)-visible, 0))
+		end := min(start+visible, len(rows))
+		content.WriteString(lines[0] + "\n")
+		for _, line := range lines[1+start : 1+end] {
+			content.WriteString(line + "\n")
+		}
+		if len(rows) > visible {
+			content.WriteString(fmt.Sprintf("\n[%d-%d of %d pods]\n", start+1, end, len(rows)))
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString("c/m: rank by cpu/memory • j/k: scroll • r: refresh • esc/q: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleTopKeys handles key input for the Top view
+func (t *TUI) handleTopKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "u":
+		t.showTop = false
+
+	case "c":
+		t.topSortMemory = false
+		t.topScroll = 0
+
+	case "m":
+		t.topSortMemory = true
+		t.topScroll = 0
+
+	case "j", "down":
+		if t.topScroll < len(t.topRows())-t.topVisibleRows() {
+			t.topScroll++
+		}
+
+	case "k", "up":
+		if t.topScroll > 0 {
+			t.topScroll--
+		}
+
+	case "r":
+		return t, t.loadPodUsage()
+	}
+
+	return t, nil
+}
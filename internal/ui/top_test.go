@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestTopView(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	tui.usageHistory = map[string][]resources.ContainerUsage{
+		usageKey("web-1", "app"): {
+			{Namespace: "shop", Pod: "web-1", Container: "app", CPU: 100, Memory: 64 << 20},
+			{Namespace: "shop", Pod: "web-1", Container: "app", CPU: 300, Memory: 64 << 20},
+		},
+		usageKey("web-1", "proxy"): {{Namespace: "shop", Pod: "web-1", Container: "proxy", CPU: 20, Memory: 16 << 20}},
+		usageKey("db-1", "db"):     {{Namespace: "shop", Pod: "db-1", Container: "db", CPU: 50, Memory: 1 << 30}},
+		usageKey("old-1", "app"):   {{Namespace: "other", Pod: "old-1", Container: "app", CPU: 900, Memory: 1 << 20}},
+	}
+
+	if cmd := tui.openTop(); cmd == nil || !tui.showTop || !tui.topPollActive {
+		t.Fatalf("Expected opening Top to start sampling usage")
+	}
+	if cmd := tui.openTop(); cmd != nil {
+		t.Errorf("Expected reopening Top to keep the running refresh chain")
+	}
+
+	rows := tui.topRows()
+	if len(rows) != 2 || rows[0].pod != "web-1" || rows[0].cpu != 320 {
+		t.Fatalf("Expected web-1 first with 320m summed over its containers, got %+v", rows)
+	}
+	if got := rows[0].cpuHist; len(got) != 2 || got[0] != 100 || got[1] != 320 {
+		t.Errorf("Expected the container histories aligned on the latest sample, got %v", got)
+	}
+
+	tui.handleTopKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	if rows := tui.topRows(); rows[0].pod != "db-1" {
+		t.Errorf("Expected db-1 first when ranked by memory, got %s", rows[0].pod)
+	}
+	if content := strings.Join(tui.topLines(tui.topRows()), "\n"); !strings.Contains(content, "MEMORY ▼") || !strings.Contains(content, "1.0Gi") {
+		t.Errorf("Expected the memory ranking in the table, got:\n%s", content)
+	}
+
+	// Closing the view ends the refresh chain on its next tick
+	tui.handleTopKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd := tui.handleTopRefreshTick(); cmd != nil || tui.topPollActive {
+		t.Errorf("Expected the refresh chain to stop once Top is closed")
+	}
+
+	tui.showTop = true
+	tui.Update(messages.PodUsageLoadError{Err: errors.New("the server could not find the requested resource")})
+	if !strings.Contains(tui.renderTop(), "Metrics API not available") {
+		t.Errorf("Expected the missing metrics API to be noted")
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]int64{0, 50, 100}, 20); got != "▁▄█" {
+		t.Errorf("Expected values scaled to the peak, got %q", got)
+	}
+	if got := sparkline([]int64{0, 0, 7, 1, 2}, 2); got != "▄█" {
+		t.Errorf("Expected the last samples only, got %q", got)
+	}
+	if got := sparkline([]int64{0, 0}, 20); got != "▁▁" {
+		t.Errorf("Expected a flat line without usage, got %q", got)
+	}
+}
@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// topologyLine is one line of a drawn service topology. Lines drawing a
+// hop carry its health, connectors have none.
+type topologyLine struct {
+	prefix string
+	node   *resources.TopologyNode
+}
+
+// loadServiceTopology fetches the topology of a service. On OpenShift the
+// routes to the service are added in front of its ingresses.
+func (t *TUI) loadServiceTopology(namespace, name string) tea.Cmd {
+	client := t.resourceClient
+	k8sClient := t.k8sClient
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		topology, err := client.GetServiceTopology(ctx, namespace, name)
+		if err != nil {
+			return messages.ServiceTopologyLoaded{Namespace: namespace, Name: name, Err: err}
+		}
+
+		// Routes are best effort, the service is still drawn without them
+		if osClient, ok := k8sClient.(k8s.OpenShiftClient); ok && osClient.IsOpenShift() {
+			routes, err := resources.NewOpenShiftResourceClient(osClient).ListRoutes(ctx, resources.ListOptions{Namespace: namespace})
+			if err == nil {
+				topology.Entries = append(resources.RouteTopologyNodes(routes.Items, name), topology.Entries...)
+			}
+		}
+
+		return messages.ServiceTopologyLoaded{Namespace: namespace, Name: name, Topology: topology}
+	}
+}
+
+// openServiceTopology draws the topology of the selected service
+func (t *TUI) openServiceTopology() tea.Cmd {
+	if !t.connected || t.resourceClient == nil || t.ActiveTab != 1 {
+		return nil
+	}
+	ref, ok := t.selectedResource()
+	if !ok {
+		return nil
+	}
+
+	t.showTopology = true
+	t.topologyNamespace = ref.Namespace
+	t.topologyService = ref.Name
+	return t.reloadServiceTopology()
+}
+
+// reloadServiceTopology reloads the topology of the shown service
+func (t *TUI) reloadServiceTopology() tea.Cmd {
+	t.loadingTopology = true
+	t.topology = nil
+	t.topologyError = ""
+	return t.loadServiceTopology(t.topologyNamespace, t.topologyService)
+}
+
+// handleServiceTopologyLoaded stores the loaded topology, ignoring results
+// for a service no longer shown
+func (t *TUI) handleServiceTopologyLoaded(msg messages.ServiceTopologyLoaded) {
+	if !t.showTopology || t.topologyNamespace != msg.Namespace || t.topologyService != msg.Name {
+		return
+	}
+
+	t.loadingTopology = false
+	if msg.Err != nil {
+		t.topologyError = msg.Err.Error()
+		t.logError(categoryResource, "Failed to load topology of service %s: %v", msg.Name, msg.Err)
+		return
+	}
+	t.topology = msg.Topology
+}
+
+// topologyLines draws a topology top down, from the routes and ingresses
+// through the service to its pods and the workloads running them
+func topologyLines(topology *resources.ServiceTopology) []topologyLine {
+	var lines []topologyLine
+	connector := func(prefix string) { lines = append(lines, topologyLine{prefix: prefix}) }
+
+	if len(topology.Entries) == 0 {
+		lines = append(lines, topologyLine{prefix: "(no routes or ingresses, not exposed outside the cluster)"})
+	}
+	for i := range topology.Entries {
+		lines = append(lines, topologyLine{node: &topology.Entries[i]})
+	}
+	connector("  │")
+	connector("  ▼")
+	lines = append(lines, topologyLine{node: &topology.Service})
+
+	if len(topology.Pods) == 0 {
+		return lines
+	}
+	connector("  │")
+	for i := range topology.Pods {
+		if i == constants.MaxTopologyPodsShown {
+			connector(fmt.Sprintf("  └─ … and %d more pods", len(topology.Pods)-i))
+			break
+		}
+		branch := "  ├─▶ "
+		if i == len(topology.Pods)-1 {
+			branch = "  └─▶ "
+		}
+		lines = append(lines, topologyLine{prefix: branch, node: &topology.Pods[i]})
+	}
+
+	connector("  ▼")
+	if len(topology.Workloads) == 0 {
+		connector("(pods not owned by a workload)")
+	}
+	for i := range topology.Workloads {
+		lines = append(lines, topologyLine{node: &topology.Workloads[i]})
+	}
+	return lines
+}
+
+// topologyHealthLevel maps a hop's health to a status level, unknown when
+// the health is not known
+func topologyHealthLevel(health string) statusLevel {
+	switch health {
+	case resources.TopologyHealthy:
+		return statusOK
+	case resources.TopologyDegraded:
+		return statusWarn
+	case resources.TopologyFailed:
+		return statusFailed
+	default:
+		return statusUnknown
+	}
+}
+
+// renderServiceTopology renders the topology mini-map of a service
+func (t *TUI) renderServiceTopology() string {
+	primaryColor, errorColor := t.getThemeColors()
+
+	modalWidth := min(100, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render("🗺️ Topology: "+t.topologyService) + "\n")
+	content.WriteString(fmt.Sprintf("Namespace: %s\n\n", t.topologyNamespace))
+
+	switch {
+	case t.loadingTopology:
+		content.WriteString(fmt.Sprintf("%s Loading topology...\n", t.getLoadingSpinner()))
+	case t.topologyError != "":
+		content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render("❌ "+truncateString(t.topologyError, modalWidth-10)) + "\n")
+	case t.topology != nil:
+		for _, line := range topologyLines(t.topology) {
+			if line.node == nil {
+				content.WriteString(line.prefix + "\n")
+				continue
+			}
+			level := topologyHealthLevel(line.node.Health)
+			hop := fmt.Sprintf("%s/%s", line.node.Kind, line.node.Name)
+			if line.node.Detail != "" {
+				hop += "  " + line.node.Detail
+			}
+			content.WriteString(line.prefix + t.statusStyle(level).Render(t.statusMark(level, "●")+" "+truncateString(hop, modalWidth-10-len([]rune(line.prefix)))) + "\n")
+		}
+	}
+
+	content.WriteString("\n")
+	content.WriteString(t.statusStyle(statusOK).Render(t.statusMark(statusOK, "●")+" healthy") + "  " +
+		t.statusStyle(statusWarn).Render(t.statusMark(statusWarn, "●")+" degraded") + "  " +
+		t.statusStyle(statusFailed).Render(t.statusMark(statusFailed, "●")+" failed") + "\n")
+	content.WriteString("r: reload • esc: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleServiceTopologyKeys handles key input for the service topology
+func (t *TUI) handleServiceTopologyKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "m":
+		t.showTopology = false
+		t.topology = nil
+		return t, nil
+	case "r":
+		if t.loadingTopology {
+			return t, nil
+		}
+		return t, t.reloadServiceTopology()
+	}
+	return t, nil
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestServiceTopologyView(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true, namespace: "shop", width: 120, height: 40}
+	tui.showTopology = true
+	tui.loadingTopology = true
+	tui.topologyNamespace = "shop"
+	tui.topologyService = "web"
+
+	tui.Update(messages.ServiceTopologyLoaded{Namespace: "shop", Name: "api", Err: errors.New("not found")})
+	if !tui.loadingTopology || tui.topologyError != "" {
+		t.Fatalf("Expected results for another service to be ignored")
+	}
+
+	tui.Update(messages.ServiceTopologyLoaded{Namespace: "shop", Name: "web", Topology: &resources.ServiceTopology{
+		Entries: []resources.TopologyNode{{Kind: "Route", Name: "web", Detail: "web.apps.example.com", Health: resources.TopologyHealthy}},
+		Service: resources.TopologyNode{Kind: "Service", Name: "web", Detail: "ClusterIP · 8080 · 1/2 pods ready", Health: resources.TopologyDegraded},
+		Pods: []resources.TopologyNode{
+			{Kind: "Pod", Name: "web-5c9-a", Detail: "Running 1/1", Health: resources.TopologyHealthy},
+			{Kind: "Pod", Name: "web-5c9-b", Detail: "CrashLoopBackOff 0/1", Health: resources.TopologyFailed},
+		},
+		Workloads: []resources.TopologyNode{{Kind: "Deployment", Name: "web", Detail: "1/2 ready", Health: resources.TopologyDegraded}},
+	}})
+	if tui.loadingTopology || tui.topology == nil {
+		t.Fatalf("Expected the topology to be loaded")
+	}
+
+	lines := topologyLines(tui.topology)
+	if len(lines) != 9 || lines[0].node.Kind != "Route" || lines[3].node.Kind != "Service" || lines[8].node.Kind != "Deployment" {
+		t.Fatalf("Expected route, service, pods and deployment top down, got %d lines", len(lines))
+	}
+	if lines[5].prefix != "  ├─▶ " || lines[6].prefix != "  └─▶ " {
+		t.Errorf("Expected the pods to branch off the service, got %q and %q", lines[5].prefix, lines[6].prefix)
+	}
+
+	rendered := tui.renderServiceTopology()
+	for _, want := range []string{"Route/web", "web.apps.example.com", "Service/web", "CrashLoopBackOff 0/1", "Deployment/web"} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the topology to contain %q", want)
+		}
+	}
+
+	tui.handleServiceTopologyKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if tui.showTopology {
+		t.Errorf("Expected esc to close the topology")
+	}
+}
@@ -12,11 +12,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/katyella/lazyoc/internal/k8s"
 	"github.com/katyella/lazyoc/internal/k8s/auth"
+	"github.com/katyella/lazyoc/internal/k8s/helm"
 	"github.com/katyella/lazyoc/internal/k8s/monitor"
 	"github.com/katyella/lazyoc/internal/k8s/projects"
 	"github.com/katyella/lazyoc/internal/k8s/resources"
@@ -25,8 +28,10 @@ import (
 	"github.com/katyella/lazyoc/internal/ui/errors"
 	"github.com/katyella/lazyoc/internal/ui/messages"
 	"github.com/katyella/lazyoc/internal/ui/models"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/katyella/lazyoc/internal/config"
 	"github.com/katyella/lazyoc/internal/constants"
 )
 
@@ -47,19 +52,45 @@ type TUI struct {
 	connecting          bool
 	connectionErr       error
 	namespace           string
+	allNamespaces       bool // List namespaced resources in every namespace
 	context             string
 	clusterVersion      string
+	identity            *resources.UserIdentity
+
+	// Bearer token expiry countdown and re-login prompt
+	tokenExpiresAt      time.Time
+	tokenExpiryPrompted bool
+	showReloginPrompt   bool
 	showFullClusterInfo bool
 
 	// Bubble Tea program reference for sending messages from goroutines
 	program *tea.Program
 
+	// Render coalescing: changes within a frame of the last render are
+	// drawn together at the end of the frame
+	frameInterval time.Duration // 0 renders on every update
+	lastFrame     string
+	lastRender    time.Time
+	viewDirty     bool
+	framePending  bool
+
+	// Terminal resizes are debounced, the last size of a burst is applied
+	resizeWidth  int
+	resizeHeight int
+	resizeSeq    int
+
 	// Resource data
+	allPods     []resources.PodInfo // As loaded, before the active view is applied
 	pods        []resources.PodInfo
 	selectedPod int
 	loadingPods bool
+	podBadge    *podBadgeSource // The service or route whose pods are badged
 
-	// Kubernetes resource data
+	// Kubernetes resource data (all* hold the loaded items before the active view is applied)
+	allServices        []resources.ServiceInfo
+	allDeployments     []resources.DeploymentInfo
+	allConfigMaps      []resources.ConfigMapInfo
+	allSecrets         []resources.SecretInfo
 	services           []resources.ServiceInfo
 	selectedService    int
 	loadingServices    bool
@@ -74,6 +105,10 @@ type TUI struct {
 	loadingSecrets     bool
 
 	// OpenShift resource data
+	allBuildConfigs []resources.BuildConfigInfo
+	allImageStreams []resources.ImageStreamInfo
+	allRoutes       []resources.RouteInfo
+
 	buildConfigs        []resources.BuildConfigInfo
 	selectedBuildConfig int
 	loadingBuildConfigs bool
@@ -86,19 +121,141 @@ type TUI struct {
 	selectedRoute int
 	loadingRoutes bool
 
+	allBuilds     []resources.BuildInfo
+	builds        []resources.BuildInfo
+	selectedBuild int
+	loadingBuilds bool
+
+	// Quick fuzzy filter per tab, narrowing the displayed list by name
+	listFilters       map[int]string
+	editingListFilter bool
+
+	// ':' command prompt and the commands run this session, newest last
+	showCommandPrompt   bool
+	commandInput        textinput.Model
+	commandError        string
+	commandHistory      []string
+	commandHistoryIndex int
+	commandContexts     []string
+
+	allEvents     []resources.EventInfo
+	events        []resources.EventInfo
+	selectedEvent int
+	loadingEvents bool
+
+	allStatefulSets     []resources.StatefulSetInfo
+	statefulSets        []resources.StatefulSetInfo
+	selectedStatefulSet int
+	loadingStatefulSets bool
+
+	allDaemonSets     []resources.DaemonSetInfo
+	daemonSets        []resources.DaemonSetInfo
+	selectedDaemonSet int
+	loadingDaemonSets bool
+
+	allReplicaSets     []resources.ReplicaSetInfo
+	replicaSets        []resources.ReplicaSetInfo
+	selectedReplicaSet int
+	loadingReplicaSets bool
+
+	allJobs     []resources.JobInfo
+	jobs        []resources.JobInfo
+	selectedJob int
+	loadingJobs bool
+
+	allCronJobs     []resources.CronJobInfo
+	cronJobs        []resources.CronJobInfo
+	selectedCronJob int
+	loadingCronJobs bool
+
+	allNodes     []resources.NodeInfo
+	nodes        []resources.NodeInfo
+	selectedNode int
+	loadingNodes bool
+
+	// Cluster autoscaler events, shown on the Nodes tab
+	scalingEvents            []resources.EventInfo
+	scalingEventsUnavailable bool
+
+	allPVCs        []resources.PersistentVolumeClaimInfo
+	pvcs           []resources.PersistentVolumeClaimInfo
+	selectedPVC    int
+	loadingPVCs    bool
+	storageClasses []resources.StorageClassInfo
+
+	allIngresses     []resources.IngressInfo
+	ingresses        []resources.IngressInfo
+	selectedIngress  int
+	loadingIngresses bool
+
+	allNetworkPolicies     []resources.NetworkPolicyInfo
+	networkPolicies        []resources.NetworkPolicyInfo
+	selectedNetworkPolicy  int
+	loadingNetworkPolicies bool
+
+	allDeploymentConfigs     []resources.DeploymentConfigInfo
+	deploymentConfigs        []resources.DeploymentConfigInfo
+	selectedDeploymentConfig int
+	loadingDeploymentConfigs bool
+
+	// Streamed logs of the selected build
+	buildLogs         []string
+	buildLogName      string
+	buildLogStreaming bool
+	buildLogCancel    context.CancelFunc
+
 	// Pod logs data
 	podLogs         []string
 	loadingLogs     bool
 	logScrollOffset int
 	maxLogLines     int
-	userScrolled    bool            // Track if user manually scrolled
-	lastLogTime     string          // Track last log timestamp for streaming
-	tailMode        bool            // True when auto-scrolling to new logs
-	seenLogLines    map[string]bool // Track seen log lines to prevent duplicates
-
-	// Real-time log streaming
-	logStreamCtx    context.Context
-	logStreamCancel context.CancelFunc
+	userScrolled    bool // Track if user manually scrolled
+	tailMode        bool // True when auto-scrolling to new logs
+
+	// Real-time log streaming. Each stream gets a new ID so that lines from
+	// a cancelled stream are dropped; the last line time and overlap set let
+	// a reconnected stream resume without replaying the tail.
+	logStreamCtx        context.Context
+	logStreamCancel     context.CancelFunc
+	logStreamID         int
+	logStreamLastLineAt time.Time
+	logStreamResumePod  string
+	logResumeOverlap    map[string]bool
+
+	// Search within the pod log panel: the query, the matching buffer
+	// indexes and the match that n/N are positioned on
+	logSearchQuery   string
+	editingLogSearch bool
+	logSearchMatches []int
+	logSearchCurrent int
+
+	// Pod log lines trimmed from the buffer: spilled to a temp file when
+	// enabled or otherwise dropped, and the search matches among them
+	logSpill        *logSpill
+	logSpillMatches int
+	podLogsDropped  int
+
+	// Log stream error budget: consecutive failures for the streamed pod and
+	// the consolidated status line shown in the log panel
+	logStreamFailures  int
+	logStreamFailPod   string
+	logStreamStartedAt time.Time
+	logStreamStatus    string
+
+	// Container whose logs are streamed: the chosen target applies to
+	// logTargetPod only; other pods default to their first container
+	logTarget            logTarget
+	logTargetPod         string
+	logStreamContainer   string
+	showContainerPicker  bool
+	containerPickerIndex int
+
+	// Save logs prompt: the file name input and the lines captured when
+	// the prompt was opened
+	showLogExport  bool
+	logExportInput textinput.Model
+	logExportLines []string
+	logExportError string
 	currentPodName  string // Track current pod for stream management
 
 	// Line-based scroll anchoring
@@ -122,22 +279,41 @@ type TUI struct {
 
 	// Content
 	mainContent   string
-	logContent    []string
 	detailContent string
 
+	// Structured app log with an optional category filter
+	appLog       []appLogEntry
+	appLogFilter appLogCategory
+
 	// Visibility
 	showDetails bool
 	showLogs    bool
 
 	// Project switching modal
-	showProjectModal   bool
-	projectList        []projects.ProjectInfo
-	selectedProject    int
-	currentProject     *projects.ProjectInfo
-	loadingProjects    bool
-	switchingProject   bool
-	projectModalHeight int
-	projectError       string
+	showProjectModal    bool
+	projectList         []projects.ProjectInfo
+	selectedProject     int
+	currentProject      *projects.ProjectInfo
+	loadingProjects     bool
+	switchingProject    bool
+	projectModalHeight  int
+	projectError        string
+	projectFilter       string
+	projectContinue     string
+	loadingMoreProjects bool
+	creatingProject     bool
+	creatingProjectName string
+	projectErrorTitle   string
+	projectErrorDetail  []string
+
+	// New project form and delete confirmation of the project modal
+	showProjectForm    bool
+	projectFormInputs  []textinput.Model
+	projectFormFocus   int
+	projectFormError   string
+	deleteProjectName  string // Project whose deletion awaits confirmation
+	deleteProjectInput string // Project name typed to confirm the deletion
+	deletingProject    bool
 
 	// Error handling and recovery
 	errorDisplay    *components.ErrorDisplayComponent
@@ -146,13 +322,434 @@ type TUI struct {
 	retryCount      int
 	maxRetries      int
 
-	// Secret viewing modal
-	showSecretModal   bool
-	secretModalData   map[string]string
-	secretModalName   string
-	secretModalKeys   []string
-	selectedSecretKey int
-	secretMasked      bool
+	// Secret modal, its new key prompt and the create secret form
+	showSecretModal      bool
+	secretModalNamespace string
+	secretModalData      map[string]string
+	secretModalName      string
+	secretModalKeys      []string
+	selectedSecretKey    int
+	secretMasked         bool
+	secretEditedKey      string // Selected when the secret is reloaded after an edit
+	showSecretKeyPrompt  bool
+	secretKeyInput       textinput.Model
+	secretKeyError       string
+	showSecretForm       bool
+	secretFormType       string
+	secretFormInputs     []textinput.Model
+	secretFormFocus      int
+	secretFormError      string
+
+	// ConfigMap data modal
+	showConfigMapModal      bool
+	configMapModalNamespace string
+	configMapModalName      string
+	configMapModalData      map[string]string
+	configMapModalKeys      []string
+	selectedConfigMapKey    int
+	configMapValueScroll    int
+
+	// Control plane health modal
+	showControlPlaneModal bool
+	controlPlaneHealth    []resources.ComponentHealthInfo
+	loadingControlPlane   bool
+	controlPlaneError     string
+
+	// Cluster storage view of PersistentVolumes and StorageClasses
+	showClusterStorage    bool
+	persistentVolumes     []resources.PersistentVolumeInfo
+	loadingClusterStorage bool
+	clusterStorageError   string
+	clusterStorageScroll  int
+
+	// Machine API view of MachineSets and Machines
+	showMachines       bool
+	machineSets        []resources.MachineSetInfo
+	machines           []resources.MachineInfo
+	selectedMachineSet int
+	loadingMachines    bool
+	machinesError      string
+	scalingMachineSet  bool  // A scale of the selected MachineSet is being staged
+	machineSetTarget   int32 // Staged replica count
+
+	// Pod and deployment status notifications
+	notifications      []notification
+	toast              *notification // Latest notification, shown in the status bar
+	toastSeq           int
+	podAlerts          alertSnapshot
+	deploymentAlerts   alertSnapshot
+	postmortemPods     alertSnapshot // Pods whose postmortem is saved
+	showNotifications  bool
+	notificationScroll int
+
+	// BuildConfigs, Deployments and DeploymentConfigs referencing missing
+	// ImageStreamTags, by kind/namespace/name
+	danglingImageRefs map[string][]resources.DanglingImageReference
+
+	// Other routes claiming the host of each route, by namespace/name
+	routeConflicts map[string][]resources.RouteInfo
+
+	// Health checks of route and ingress URLs, by kind/namespace/name
+	urlChecks map[string]urlCheck
+
+	// Change previewed in the diff tool, waiting to be confirmed
+	diffReview     pendingDiff
+	showDiffReview bool
+
+	// Startup checks of the cluster and the warnings panel showing them
+	startupCheckResults  []resources.StartupCheckResult
+	runningStartupChecks bool
+	showStartupChecks    bool
+	startupCheckIndex    int
+
+	// Cluster info view
+	clusterDetails     *resources.ClusterDetails
+	showClusterInfo    bool
+	loadingClusterInfo bool
+	clusterInfoError   string
+
+	// Overview of the current namespace
+	showDashboard    bool
+	dashboard        *dashboard
+	loadingDashboard bool
+	dashboardScroll  int
+
+	// ClusterOperators of an OpenShift cluster, like oc get clusteroperators
+	showClusterOperators      bool
+	clusterOperators          []resources.ClusterOperatorInfo
+	loadingClusterOperators   bool
+	clusterOperatorsError     string
+	selectedClusterOperator   int
+	clusterOperatorsUnhealthy bool
+
+	// Helm releases read from their secrets, the history of one and the
+	// manifest or values of a revision
+	showHelm             bool
+	helmReleases         []helm.Release
+	loadingHelm          bool
+	helmError            string
+	selectedHelmRelease  int
+	helmHistoryName      string
+	helmHistory          []helm.Release
+	selectedHelmRevision int
+	helmTextTitle        string
+	helmText             []string
+	helmScroll           int
+
+	// Quota view of a project
+	showProjectQuotas bool
+	quotaProjectName  string
+	quotaProject      *projects.ProjectInfo
+	loadingQuotas     bool
+	quotaError        string
+	quotaScroll       int
+
+	// Items marked for a batch action, per tab, and the batch being confirmed
+	batchMarks      map[models.TabType]map[string]bool
+	showBatch       bool
+	batchTab        models.TabType
+	batchAction     string // Empty while the action is being chosen
+	batchRefs       []resourceRef
+	batchLabelInput textinput.Model
+	batchError      string
+
+	// Top view ranking the namespace's pods by usage
+	showTop       bool
+	topSortMemory bool // Rank by memory instead of CPU
+	topScroll     int
+	topPollActive bool // A refresh chain of the Top view is running
+
+	// Image inventory report
+	showImageReport    bool
+	imageReport        []resources.ImageUsage
+	imageReportAll     bool // Cover all namespaces instead of the current one
+	loadingImageReport bool
+	imageReportError   string
+	imageReportStatus  string
+	imageReportScroll  int
+
+	// Object count report
+	showObjectReport    bool
+	objectCounts        []resources.ObjectCount
+	storedObjects       []resources.StoredObjectCount
+	objectReportAll     bool // Cover all namespaces instead of the current one
+	loadingObjectReport bool
+	objectReportError   string
+	objectReportScroll  int
+
+	// Container usage samples for right-sizing, keyed by pod/container
+	usageHistory       map[string][]resources.ContainerUsage
+	metricsUnavailable bool
+
+	// Restart counts of the pods sampled on each refresh this session, keyed
+	// by namespace/pod
+	restartHistory map[string][]restartSample
+
+	// Latest node usage from the metrics API, keyed by node name
+	nodeUsage              map[string]resources.NodeUsage
+	nodeMetricsUnavailable bool
+
+	// User configuration
+	config     *config.Config
+	configPath string
+
+	// Preferences in effect, the saved ones with this session's command line
+	// overrides, and the namespace to open on the first connection
+	prefs          config.Preferences
+	startNamespace string
+	mouseEnabled   bool
+
+	// Profiles: the context of the first connection, the profile waiting
+	// for its context to connect and the profile picker
+	startContext       string
+	pendingProfile     string
+	showProfilePicker  bool
+	profilePickerIndex int
+
+	// Related resources of the selected service, deployment or route, picked
+	// from when there are several kinds of them
+	loadingRelated     bool
+	showRelatedPicker  bool
+	relatedSource      string
+	relatedRelations   []resources.Relation
+	relatedPickerIndex int
+
+	// Detail pane tabs: the open tab, how far it is scrolled, the object it
+	// was scrolled for and the manifest of the YAML tab
+	detailTab         int
+	detailScroll      int
+	detailRef         resourceRef
+	detailYAMLRef     resourceRef
+	detailYAMLLines   []string
+	detailYAMLErr     string
+	loadingDetailYAML bool
+
+	// Related detail tab: the ownership chain of the selected object
+	ownerTreeRef      resourceRef
+	ownerChain        []resources.OwnerLink
+	ownerChainErr     error
+	loadingOwnerChain bool
+
+	// TLS verification overrides of this session and the certificate error
+	// screen offering them
+	tlsOverrides      auth.TLSOverrides
+	authHook          auth.ConfigHook
+	showTLSError      bool
+	tlsProblem        string
+	tlsErr            error
+	tlsCAInput        textinput.Model
+	editingTLSCAFile  bool
+	confirmingTLSSkip bool
+	tlsInputError     string
+
+	// Hibernation after a period without input, and whether a build log
+	// stream was stopped by it
+	lastInputAt         time.Time
+	hibernated          bool
+	hibernatedBuildLogs bool
+
+	// Settings screen
+	showSettings    bool
+	settingsIndex   int
+	settingsEditing bool
+	settingsInput   textinput.Model
+	settingsError   string
+
+	// Keyboard macros: the register prefix being typed, the macro being
+	// recorded and the running replay
+	macroPendingKey   string
+	macroRecording    string
+	macroKeys         []string
+	macroReplaying    bool
+	macroReplayKeys   []string
+	macroReplayIndex  int
+	macroReplayRun    int
+	macroWaitingSince time.Time
+	lastMacro         string
+
+	// Saved views (active view per tab index) and the view picker/editor
+	activeViews      map[int]config.SavedView
+	listSorts        map[int]listSort // Column sort per tab index, replacing the view's
+	showViewPicker   bool
+	viewPickerIndex  int
+	showViewForm     bool
+	viewFormInputs   []textinput.Model
+	viewFormFocus    int
+	viewFormError    string
+
+	// Login form, the in-TUI equivalent of 'oc login'
+	showLoginForm bool
+	loginInputs   []textinput.Model
+	loginFocus    int
+	loginError    string
+	loggingIn     bool
+	loginInsecure bool
+
+	// Per-object results of the last manifest apply
+	showApplyResults   bool
+	applyResults       []resources.ApplyResult
+	applyResultsScroll int
+	applyResultsDryRun bool
+
+	// Manifest file picker of the apply workflow, kept in its last directory
+	showApplyPicker    bool
+	applyPickerDir     string
+	applyPickerEntries []applyPickerEntry
+	applyPickerIndex   int
+	applyPickerScroll  int
+	applyPickerError   string
+
+	// Filesystem browser of a container of the selected pod
+	showPodFiles        bool
+	podFilesNamespace   string
+	podFilesPod         string
+	podFilesContainers  []string
+	podFilesContainer   string
+	podFilesDir         string
+	podFilesEntries     []resources.PodFileEntry
+	podFilesIndex       int
+	podFilesScroll      int
+	loadingPodFiles     bool
+	podFilesError       string
+	podFilesUploading   bool // The upload prompt is open
+	podFilesUploadInput textinput.Model
+	podFilesUploadError string
+
+	// Ephemeral debug container of the selected pod: the confirmation, then
+	// the shell prompt once debugContainer runs
+	showDebugContainer bool
+	debugNamespace     string
+	debugPod           string
+	debugTargets       []string
+	debugTarget        string
+	debugImage         string
+	debugContainer     string
+
+	// Full-screen YAML view
+	showYAMLView bool
+	loadingYAML  bool
+	yamlTitle    string
+	yamlLines    []string
+	yamlScroll   int
+	yamlError    string
+	yamlRef      resourceRef
+	// Field managers shown instead of the manifest
+	yamlFieldOwners bool
+
+	// Full-screen diff of two resources or Deployment revisions
+	diffBase            *resourceRef // Marked with =, compared with the next resource
+	showResourceDiff    bool
+	loadingResourceDiff bool
+	resourceDiffTitle   string
+	resourceDiffLines   []string
+	resourceDiffScroll  int
+	resourceDiffError   string
+
+	// Second cluster kept connected next to the active one
+	peerCluster        *clusterSession
+	connectingPeer     string
+	switchOnConnect    bool // Make the second cluster active once connected
+	showClusterPicker  bool
+	clusterContexts    []string
+	clusterPickerIndex int
+	clusterPickerError string
+
+	// Active tab of both clusters side by side
+	showClusterCompare     bool
+	loadingClusterCompare  bool
+	clusterCompareTab      int
+	clusterCompareRows     []messages.ClusterCompareRow
+	clusterCompareError    string
+	clusterCompareScroll   int
+	clusterCompareDiffOnly bool
+
+	// Pod delete dialog: how the pod is deleted and the disruption budgets
+	// covering it
+	showDeletePodModal    bool
+	deletePodName         string
+	deletePodNamespace    string
+	deletePodMode         resources.PodDeleteMode
+	deletePodGrace        *int64
+	deletePodGraceInput   textinput.Model
+	editingDeletePodGrace bool
+	deletePodGraceError   string
+	deletePodBudgets      []resources.DisruptionBudget
+	deletePodBudgetsErr   error
+	loadingPodBudgets     bool
+
+	// Label chip selected in the pod details, remembered for the pod it was
+	// selected on
+	labelChip    int
+	labelChipPod string
+
+	// Workload delete confirmation with a preview of the dependents deleted along with it
+	showCascadeDelete  bool
+	cascadeKind        string
+	cascadeNamespace   string
+	cascadeName        string
+	cascadePlan        *resources.DependentTree
+	cascadePlanErr     error
+	loadingCascadePlan bool
+	cascadePropagation metav1.DeletionPropagation
+
+	// Topology mini-map of the selected service
+	showTopology      bool
+	loadingTopology   bool
+	topologyNamespace string
+	topologyService   string
+	topology          *resources.ServiceTopology
+	topologyError     string
+
+	// Node cordon, uncordon and drain confirmation modal
+	showNodeActionModal bool
+	nodeAction          string // "cordon", "uncordon" or "drain"
+	nodeActionName      string
+	nodeDrainPlan       *resources.DrainPlan
+	nodeDrainPlanErr    error
+	loadingDrainPlan    bool
+	nodeConfirmInput    string // Node name typed to confirm a drain
+
+	// DeploymentConfig rollback confirmation modal
+	showRollbackModal bool
+	rollbackNamespace string
+	rollbackName      string
+	rollbackVersion   int64
+
+	// Background tasks
+	tasks         []*backgroundTask
+	nextTaskID    int
+	showTaskPanel bool
+	selectedTask  int
+	dryRun        bool // Mutating requests are sent with dryRun=All
+
+	// Object counts of the tab labels, kept by watches of the namespace
+	tabSummaries        map[string]resources.TabSummary
+	tabSummaryNamespace string
+	tabSummaryCancel    context.CancelFunc
+
+	// Auto refresh (enabled per tab index) with a global pause
+	autoRefreshTabs   map[int]bool
+	autoRefreshPaused bool
+	nextAutoRefresh   time.Time
+
+	// Safe mode keeps the API calls to the user's actions: no auto refresh,
+	// latency probes or polls, and logs are read once instead of followed
+	safeMode bool
+
+	// API server latency probe
+	apiLatency    time.Duration
+	apiLatencyErr bool
+
+	// Rollout status for the selected Deployment, refreshed while the tab is active
+	rolloutStatus     *resources.RolloutStatus
+	rolloutPollActive bool
+
+	// Loader retry backoff and per-resource circuit breaking
+	retryPolicy resources.BackoffPolicy
+	loadBreaker *resources.CircuitBreaker
+
+	// Resources the user may not list, their tabs are locked
+	accessDenials accessDenials
 
 	// Theme
 	theme string
@@ -183,23 +780,32 @@ func NewTUI(version string, debug bool, showFullClusterInfo bool) *TUI {
 		showLogs:            true,
 		focusedPanel:        constants.DefaultFocusedPanel,
 		mainContent:         "", // Will be set by updateMainContent
-		logContent:          []string{constants.InitialLogMessage},
 		detailContent:       constants.DefaultDetailContent,
 		namespace:           constants.DefaultNamespace,
 		pods:                []resources.PodInfo{},
 		selectedPod:         0,
 		showFullClusterInfo: showFullClusterInfo,
 		// Pod logs
-		podLogs:      []string{},
-		maxLogLines:  constants.MaxLogLines,
-		logViewMode:  constants.DefaultLogViewMode,
-		tailMode:     true, // Start in tail mode by default
-		seenLogLines: make(map[string]bool),
+		podLogs:     []string{},
+		maxLogLines: constants.MaxLogLines,
+		logViewMode: constants.DefaultLogViewMode,
+		tailMode:    true, // Start in tail mode by default
 		// Error handling
 		errorDisplay: components.NewErrorDisplayComponent("dark"),
 		maxRetries:   constants.DefaultRetryAttempts,
+		// Saved views
+		activeViews: make(map[int]config.SavedView),
+		// Auto refresh
+		autoRefreshTabs: defaultAutoRefreshTabs(),
 	}
 
+	// Load user configuration
+	tui.logInfo(categoryConnection, "%s", constants.InitialLogMessage)
+
+	tui.loadUserConfig()
+	tui.initLoaderRetry()
+	tui.applyPreferences(tui.config.Preferences)
+
 	// Initialize event handlers
 	tui.navigator = NewNavigator(tui)
 	tui.focusManager = NewFocusManager(tui)
@@ -276,21 +882,47 @@ func (t *TUI) Init() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
-// Update implements tea.Model
-func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+// update applies a message to the model, see Update for render coalescing
+func (t *TUI) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 
 	case tea.WindowSizeMsg:
-		t.width = msg.Width
-		t.height = msg.Height
-		t.ready = true
-		logging.Debug(t.Logger, "Window size: %dx%d", t.width, t.height)
+		return t, t.handleWindowSize(msg)
+
+	case resizeSettledMsg:
+		return t, t.settleResize(msg)
+
+	case tea.ResumeMsg:
+		return t, t.handleResume()
 
 	case tea.MouseMsg:
-		return t.mouseHandler.Handle(msg)
+		// The mouse resumes a hibernated session like a key, then acts
+		var resume tea.Cmd
+		if t.hibernated {
+			resume = t.resumeFromHibernation()
+		} else {
+			t.recordInput()
+		}
+		model, cmd := t.mouseHandler.Handle(msg)
+		return model, tea.Batch(resume, cmd, t.followDetailTab(), t.followRolloutStatus())
 
 	case tea.KeyMsg:
-		return t.keyboardHandler.Handle(msg)
+		// Any key resumes a hibernated session, without acting on it
+		if t.hibernated {
+			return t, t.resumeFromHibernation()
+		}
+		t.recordInput()
+
+		// Any key typed during a macro replay cancels it
+		if t.macroReplaying {
+			t.cancelMacroReplay()
+			return t, nil
+		}
+		model, cmd := t.keyboardHandler.Handle(msg)
+		return model, tea.Batch(cmd, t.followDetailTab(), t.followRolloutStatus())
+
+	case messages.MacroStep:
+		return t, t.handleMacroStep(msg)
 
 
 	case messages.InitMsg:
@@ -307,35 +939,52 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		t.connectionErr = nil
 		t.context = msg.Context
 		t.namespace = msg.Namespace
+		t.startNamespace = ""
+		t.startContext = ""
 
 		// Reset retry counters on successful connection
 		if t.retryCount > 0 {
-			t.logContent = append(t.logContent, fmt.Sprintf("✨ Connection restored after %d retries", t.retryCount))
+			t.logSuccess(categoryConnection, "Connection restored after %d retries", t.retryCount)
 			t.retryCount = 0
 		} else {
 			obfuscatedContext := t.obfuscateClusterContext(msg.Context)
-		t.logContent = append(t.logContent, fmt.Sprintf("✅ Connected to %s", obfuscatedContext))
+		t.logSuccess(categoryConnection, "Connected to %s", obfuscatedContext)
 		}
 		t.retryInProgress = false
+		t.resetLoaderCircuits()
+		if t.tlsOverrides.Insecure {
+			t.logWarn(categoryConnection, "TLS verification is off for this session")
+		}
 
 		// Initialize project manager after successful connection
 		t.initializeProjectManager()
+		t.startTabSummaries()
 
-		// Load cluster version information and pods
+		// Load cluster version information and pods, and the restored tab
+		var tabCmd tea.Cmd
+		if t.ActiveTab != 0 {
+			tabCmd = t.refreshTab(int(t.ActiveTab))
+		}
 		return t, tea.Batch(
 			t.loadClusterInfo(),
-			t.loadPods(),
-			t.startPodRefreshTimer(),
-			t.startPodLogStream(),
+			t.loadIdentity(),
+			t.loadTokenExpiry(),
+			t.withUsageSample(t.loadPods()),
+			tabCmd,
+			t.startAutoRefreshTimer(),
 			t.startSpinnerAnimation(),
+			t.probeAPILatency(),
+			t.runStartupChecks(false),
 		)
 
 	case messages.ConnectionError:
 		t.connected = false
 		t.connecting = false
 		t.connectionErr = msg.Err
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Connection failed: %v", msg.Err))
+		t.stopTabSummaries()
+		t.logError(categoryConnection, "Connection failed: %v", msg.Err)
 		t.updatePodDisplay()
+		t.openTLSError(msg.Err)
 
 	case messages.PodsLoaded:
 		// Store the previously selected pod name to preserve selection during refresh
@@ -344,13 +993,14 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			previouslySelectedPodName = t.pods[t.selectedPod].Name
 		}
 
-		t.pods = msg.Pods
+		t.allPods = msg.Pods
+		t.pods = viewItems(t, 0, t.allPods, podViewRow)
 		t.loadingPods = false
 
 		// Try to preserve the selected pod after refresh
 		newSelectedPod := 0
 		if previouslySelectedPodName != "" {
-			for i, pod := range msg.Pods {
+			for i, pod := range t.pods {
 				if pod.Name == previouslySelectedPodName {
 					newSelectedPod = i
 					break
@@ -359,19 +1009,27 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		t.selectedPod = newSelectedPod
 
-		// Only clear pod logs if we switched to a different pod or there's no previous selection
-		if previouslySelectedPodName == "" || (len(msg.Pods) > 0 && newSelectedPod < len(msg.Pods) && msg.Pods[newSelectedPod].Name != previouslySelectedPodName) {
-			t.podLogs = []string{}
-			t.logScrollOffset = 0
+		// Follow the selected pod's logs unless we already do; this starts the
+		// first stream after connecting or switching projects
+		var streamCmd tea.Cmd
+		if pod, ok := t.selectedLogPod(); ok && pod.Name != t.currentPodName {
+			if !t.resumesLogStream(pod.Name) {
+				t.clearPodLogs()
+			}
+			streamCmd = t.startPodLogStream()
+		} else if !ok {
+			t.clearPodLogs()
 			t.loadingLogs = false
 		}
 
-		t.updatePodDisplay()
-		t.logContent = append(t.logContent, fmt.Sprintf("Loaded %d pods from namespace %s", len(msg.Pods), t.namespace))
+		t.recordRestarts(msg.Pods, time.Now())
+		t.updateMainContent()
+		t.logInfo(categoryResource, "Loaded %d pods from namespace %s", len(msg.Pods), t.namespace)
+		return t, tea.Batch(t.loadEvents(), streamCmd, t.notifyPodChanges(msg.Pods), t.savePostmortems(msg.Pods))
 
 	case messages.LoadPodsError:
 		t.loadingPods = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load pods: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load pods: %v", msg.Err)
 		t.updatePodDisplay()
 
 	// Kubernetes resource message handlers
@@ -381,12 +1039,13 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if len(t.services) > 0 && t.selectedService < len(t.services) {
 			previouslySelectedServiceName = t.services[t.selectedService].Name
 		}
-		t.services = msg.Services
+		t.allServices = msg.Services
+		t.services = viewItems(t, 1, t.allServices, serviceViewRow)
 		t.loadingServices = false
 		// Try to preserve the selected service after refresh
 		newSelectedService := 0
 		if previouslySelectedServiceName != "" {
-			for i, svc := range msg.Services {
+			for i, svc := range t.services {
 				if svc.Name == previouslySelectedServiceName {
 					newSelectedService = i
 					break
@@ -395,10 +1054,10 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		t.selectedService = newSelectedService
 		t.updateServiceDisplay()
-		t.logContent = append(t.logContent, fmt.Sprintf("Loaded %d services from namespace %s", len(msg.Services), t.namespace))
+		t.logInfo(categoryResource, "Loaded %d services from namespace %s", len(msg.Services), t.namespace)
 	case messages.ServicesLoadError:
 		t.loadingServices = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load services: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load services: %v", msg.Err)
 		t.updateServiceDisplay()
 	case messages.DeploymentsLoaded:
 		// Store the previously selected deployment name to preserve selection during refresh
@@ -406,12 +1065,13 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if len(t.deployments) > 0 && t.selectedDeployment < len(t.deployments) {
 			previouslySelectedDeploymentName = t.deployments[t.selectedDeployment].Name
 		}
-		t.deployments = msg.Deployments
+		t.allDeployments = msg.Deployments
+		t.deployments = viewItems(t, 2, t.allDeployments, deploymentViewRow)
 		t.loadingDeployments = false
 		// Try to preserve the selected deployment after refresh
 		newSelectedDeployment := 0
 		if previouslySelectedDeploymentName != "" {
-			for i, deploy := range msg.Deployments {
+			for i, deploy := range t.deployments {
 				if deploy.Name == previouslySelectedDeploymentName {
 					newSelectedDeployment = i
 					break
@@ -420,10 +1080,11 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		t.selectedDeployment = newSelectedDeployment
 		t.updateDeploymentDisplay()
-		t.logContent = append(t.logContent, fmt.Sprintf("Loaded %d deployments from namespace %s", len(msg.Deployments), t.namespace))
+		t.logInfo(categoryResource, "Loaded %d deployments from namespace %s", len(msg.Deployments), t.namespace)
+		return t, tea.Batch(t.startRolloutStatusPoll(), t.loadEvents(), t.notifyDeploymentChanges(msg.Deployments), t.checkImageReferences())
 	case messages.DeploymentsLoadError:
 		t.loadingDeployments = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load deployments: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load deployments: %v", msg.Err)
 		t.updateDeploymentDisplay()
 	case messages.ConfigMapsLoaded:
 		// Store the previously selected configmap name to preserve selection during refresh
@@ -431,12 +1092,13 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if len(t.configMaps) > 0 && t.selectedConfigMap < len(t.configMaps) {
 			previouslySelectedConfigMapName = t.configMaps[t.selectedConfigMap].Name
 		}
-		t.configMaps = msg.ConfigMaps
+		t.allConfigMaps = msg.ConfigMaps
+		t.configMaps = viewItems(t, 3, t.allConfigMaps, configMapViewRow)
 		t.loadingConfigMaps = false
 		// Try to preserve the selected configmap after refresh
 		newSelectedConfigMap := 0
 		if previouslySelectedConfigMapName != "" {
-			for i, cm := range msg.ConfigMaps {
+			for i, cm := range t.configMaps {
 				if cm.Name == previouslySelectedConfigMapName {
 					newSelectedConfigMap = i
 					break
@@ -445,10 +1107,10 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		t.selectedConfigMap = newSelectedConfigMap
 		t.updateConfigMapDisplay()
-		t.logContent = append(t.logContent, fmt.Sprintf("Loaded %d configmaps from namespace %s", len(msg.ConfigMaps), t.namespace))
+		t.logInfo(categoryResource, "Loaded %d configmaps from namespace %s", len(msg.ConfigMaps), t.namespace)
 	case messages.ConfigMapsLoadError:
 		t.loadingConfigMaps = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load configmaps: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load configmaps: %v", msg.Err)
 		t.updateConfigMapDisplay()
 	case messages.SecretsLoaded:
 		// Store the previously selected secret name to preserve selection during refresh
@@ -456,12 +1118,13 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if len(t.secrets) > 0 && t.selectedSecret < len(t.secrets) {
 			previouslySelectedSecretName = t.secrets[t.selectedSecret].Name
 		}
-		t.secrets = msg.Secrets
+		t.allSecrets = msg.Secrets
+		t.secrets = viewItems(t, 4, t.allSecrets, secretViewRow)
 		t.loadingSecrets = false
 		// Try to preserve the selected secret after refresh
 		newSelectedSecret := 0
 		if previouslySelectedSecretName != "" {
-			for i, secret := range msg.Secrets {
+			for i, secret := range t.secrets {
 				if secret.Name == previouslySelectedSecretName {
 					newSelectedSecret = i
 					break
@@ -470,51 +1133,318 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		t.selectedSecret = newSelectedSecret
 		t.updateSecretDisplay()
-		t.logContent = append(t.logContent, fmt.Sprintf("Loaded %d secrets from namespace %s", len(msg.Secrets), t.namespace))
+		t.logInfo(categoryResource, "Loaded %d secrets from namespace %s", len(msg.Secrets), t.namespace)
 	case messages.SecretsLoadError:
 		t.loadingSecrets = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load secrets: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load secrets: %v", msg.Err)
 		t.updateSecretDisplay()
 
 	// OpenShift resource message handlers
 	case messages.BuildConfigsLoaded:
-		t.buildConfigs = msg.BuildConfigs
+		t.allBuildConfigs = msg.BuildConfigs
+		t.buildConfigs = viewItems(t, 5, t.allBuildConfigs, buildConfigViewRow)
 		t.loadingBuildConfigs = false
 		t.updateMainContent()
+		return t, t.checkImageReferences()
 
 	case messages.BuildConfigsLoadError:
+		t.allBuildConfigs = []resources.BuildConfigInfo{}
 		t.buildConfigs = []resources.BuildConfigInfo{}
 		t.loadingBuildConfigs = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load BuildConfigs: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load BuildConfigs: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.BuildsLoaded:
+		t.allBuilds = msg.Builds
+		t.builds = viewItems(t, 8, t.allBuilds, buildViewRow)
+		t.loadingBuilds = false
+		t.updateMainContent()
+
+	case messages.BuildsLoadError:
+		t.allBuilds = []resources.BuildInfo{}
+		t.builds = []resources.BuildInfo{}
+		t.loadingBuilds = false
+		t.logError(categoryResource, "Failed to load Builds: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.EventsLoaded:
+		selected := selectedName(t.events, t.selectedEvent, func(e resources.EventInfo) string { return e.Name })
+		t.allEvents = msg.Events
+		t.events = viewItems(t, 9, t.allEvents, eventViewRow)
+		t.selectedEvent = indexByName(t.events, selected, func(e resources.EventInfo) string { return e.Name })
+		t.loadingEvents = false
+		// Pods and Deployments show related events in their detail pane
+		t.updateMainContent()
+
+	case messages.EventsLoadError:
+		t.loadingEvents = false
+		t.logError(categoryResource, "Failed to load Events: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.StatefulSetsLoaded:
+		selected := selectedName(t.statefulSets, t.selectedStatefulSet, func(s resources.StatefulSetInfo) string { return s.Name })
+		t.allStatefulSets = msg.StatefulSets
+		t.statefulSets = viewItems(t, 10, t.allStatefulSets, statefulSetViewRow)
+		t.selectedStatefulSet = indexByName(t.statefulSets, selected, func(s resources.StatefulSetInfo) string { return s.Name })
+		t.loadingStatefulSets = false
+		t.updateMainContent()
+
+	case messages.StatefulSetsLoadError:
+		t.allStatefulSets = []resources.StatefulSetInfo{}
+		t.statefulSets = []resources.StatefulSetInfo{}
+		t.loadingStatefulSets = false
+		t.logError(categoryResource, "Failed to load StatefulSets: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.DaemonSetsLoaded:
+		selected := selectedName(t.daemonSets, t.selectedDaemonSet, func(d resources.DaemonSetInfo) string { return d.Name })
+		t.allDaemonSets = msg.DaemonSets
+		t.daemonSets = viewItems(t, 11, t.allDaemonSets, daemonSetViewRow)
+		t.selectedDaemonSet = indexByName(t.daemonSets, selected, func(d resources.DaemonSetInfo) string { return d.Name })
+		t.loadingDaemonSets = false
+		t.updateMainContent()
+
+	case messages.DaemonSetsLoadError:
+		t.allDaemonSets = []resources.DaemonSetInfo{}
+		t.daemonSets = []resources.DaemonSetInfo{}
+		t.loadingDaemonSets = false
+		t.logError(categoryResource, "Failed to load DaemonSets: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.ReplicaSetsLoaded:
+		selected := selectedName(t.replicaSets, t.selectedReplicaSet, func(r resources.ReplicaSetInfo) string { return r.Name })
+		t.allReplicaSets = msg.ReplicaSets
+		t.replicaSets = viewItems(t, 12, t.allReplicaSets, replicaSetViewRow)
+		t.selectedReplicaSet = indexByName(t.replicaSets, selected, func(r resources.ReplicaSetInfo) string { return r.Name })
+		t.loadingReplicaSets = false
+		t.updateMainContent()
+
+	case messages.ReplicaSetsLoadError:
+		t.allReplicaSets = []resources.ReplicaSetInfo{}
+		t.replicaSets = []resources.ReplicaSetInfo{}
+		t.loadingReplicaSets = false
+		t.logError(categoryResource, "Failed to load ReplicaSets: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.JobsLoaded:
+		selected := selectedName(t.jobs, t.selectedJob, func(j resources.JobInfo) string { return j.Name })
+		t.allJobs = msg.Jobs
+		t.jobs = viewItems(t, 13, t.allJobs, jobViewRow)
+		t.selectedJob = indexByName(t.jobs, selected, func(j resources.JobInfo) string { return j.Name })
+		t.loadingJobs = false
+		// CronJobs list the Jobs they created in their detail pane
+		t.updateMainContent()
+
+	case messages.JobsLoadError:
+		t.allJobs = []resources.JobInfo{}
+		t.jobs = []resources.JobInfo{}
+		t.loadingJobs = false
+		t.logError(categoryResource, "Failed to load Jobs: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.CronJobsLoaded:
+		selected := selectedName(t.cronJobs, t.selectedCronJob, func(c resources.CronJobInfo) string { return c.Name })
+		t.allCronJobs = msg.CronJobs
+		t.cronJobs = viewItems(t, 14, t.allCronJobs, cronJobViewRow)
+		t.selectedCronJob = indexByName(t.cronJobs, selected, func(c resources.CronJobInfo) string { return c.Name })
+		t.loadingCronJobs = false
+		t.updateMainContent()
+
+	case messages.CronJobsLoadError:
+		t.allCronJobs = []resources.CronJobInfo{}
+		t.cronJobs = []resources.CronJobInfo{}
+		t.loadingCronJobs = false
+		t.logError(categoryResource, "Failed to load CronJobs: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.NodesLoaded:
+		selected := selectedName(t.nodes, t.selectedNode, func(n resources.NodeInfo) string { return n.Name })
+		t.allNodes = msg.Nodes
+		t.nodes = viewItems(t, 15, t.allNodes, nodeViewRow)
+		t.selectedNode = indexByName(t.nodes, selected, func(n resources.NodeInfo) string { return n.Name })
+		t.loadingNodes = false
+		t.updateMainContent()
+
+	case messages.NodesLoadError:
+		t.allNodes = []resources.NodeInfo{}
+		t.nodes = []resources.NodeInfo{}
+		t.loadingNodes = false
+		t.logError(categoryResource, "Failed to load Nodes: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.ScalingEventsLoaded:
+		t.scalingEvents = msg.Events
+		t.scalingEventsUnavailable = false
+		if t.ActiveTab == models.TabNodes {
+			t.updateMainContent()
+		}
+
+	case messages.ScalingEventsLoadError:
+		t.handleScalingEventsLoadError(msg)
+
+	case messages.PersistentVolumeClaimsLoaded:
+		selected := selectedName(t.pvcs, t.selectedPVC, func(p resources.PersistentVolumeClaimInfo) string { return p.Name })
+		t.allPVCs = msg.Claims
+		t.pvcs = viewItems(t, 16, t.allPVCs, pvcViewRow)
+		t.selectedPVC = indexByName(t.pvcs, selected, func(p resources.PersistentVolumeClaimInfo) string { return p.Name })
+		t.loadingPVCs = false
+		t.updateMainContent()
+
+	case messages.PersistentVolumeClaimsLoadError:
+		t.allPVCs = []resources.PersistentVolumeClaimInfo{}
+		t.pvcs = []resources.PersistentVolumeClaimInfo{}
+		t.loadingPVCs = false
+		t.logError(categoryResource, "Failed to load PersistentVolumeClaims: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.IngressesLoaded:
+		selected := selectedName(t.ingresses, t.selectedIngress, func(i resources.IngressInfo) string { return i.Name })
+		t.allIngresses = msg.Ingresses
+		t.ingresses = viewItems(t, 17, t.allIngresses, ingressViewRow)
+		t.selectedIngress = indexByName(t.ingresses, selected, func(i resources.IngressInfo) string { return i.Name })
+		t.loadingIngresses = false
+		t.updateMainContent()
+
+	case messages.IngressesLoadError:
+		t.allIngresses = []resources.IngressInfo{}
+		t.ingresses = []resources.IngressInfo{}
+		t.loadingIngresses = false
+		t.logError(categoryResource, "Failed to load Ingresses: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.NetworkPoliciesLoaded:
+		selected := selectedName(t.networkPolicies, t.selectedNetworkPolicy, func(n resources.NetworkPolicyInfo) string { return n.Name })
+		t.allNetworkPolicies = msg.NetworkPolicies
+		t.networkPolicies = viewItems(t, 18, t.allNetworkPolicies, networkPolicyViewRow)
+		t.selectedNetworkPolicy = indexByName(t.networkPolicies, selected, func(n resources.NetworkPolicyInfo) string { return n.Name })
+		t.loadingNetworkPolicies = false
+		t.updateMainContent()
+
+	case messages.NetworkPoliciesLoadError:
+		t.allNetworkPolicies = []resources.NetworkPolicyInfo{}
+		t.networkPolicies = []resources.NetworkPolicyInfo{}
+		t.loadingNetworkPolicies = false
+		t.logError(categoryResource, "Failed to load NetworkPolicies: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.DeploymentConfigsLoaded:
+		selected := selectedName(t.deploymentConfigs, t.selectedDeploymentConfig, func(d resources.DeploymentConfigInfo) string { return d.Name })
+		t.allDeploymentConfigs = msg.DeploymentConfigs
+		t.deploymentConfigs = viewItems(t, 19, t.allDeploymentConfigs, deploymentConfigViewRow)
+		t.selectedDeploymentConfig = indexByName(t.deploymentConfigs, selected, func(d resources.DeploymentConfigInfo) string { return d.Name })
+		t.loadingDeploymentConfigs = false
+		t.updateMainContent()
+		return t, t.checkImageReferences()
+
+	case messages.ImageReferencesChecked:
+		t.handleImageReferencesChecked(msg)
+
+	case messages.ImageReferencesCheckError:
+		t.handleImageReferencesCheckError(msg)
+
+	case messages.DeploymentConfigsLoadError:
+		t.allDeploymentConfigs = []resources.DeploymentConfigInfo{}
+		t.deploymentConfigs = []resources.DeploymentConfigInfo{}
+		t.loadingDeploymentConfigs = false
+		t.logError(categoryResource, "Failed to load DeploymentConfigs: %v", msg.Err)
+		t.updateMainContent()
+
+	case messages.StorageClassesLoaded:
+		t.storageClasses = msg.StorageClasses
 		t.updateMainContent()
 
+	case messages.StorageClassesLoadError:
+		t.handleStorageClassesLoadError(msg)
+
+	case messages.PersistentVolumesLoaded:
+		t.persistentVolumes = msg.Volumes
+		t.loadingClusterStorage = false
+
+	case messages.PersistentVolumesLoadError:
+		t.handlePersistentVolumesLoadError(msg)
+
+	case messages.MachinesLoaded:
+		t.handleMachinesLoaded(msg)
+
+	case messages.MachinesLoadError:
+		t.handleMachinesLoadError(msg)
+
+	case messages.NodeDrainPlanLoaded:
+		t.handleNodeDrainPlanLoaded(msg)
+
+	case messages.PodDisruptionBudgetsLoaded:
+		t.handlePodDisruptionBudgetsLoaded(msg)
+
+	case messages.RelatedResourcesLoaded:
+		return t, t.handleRelatedResourcesLoaded(msg)
+
+	case messages.OwnerChainLoaded:
+		t.handleOwnerChainLoaded(msg)
+
+	case messages.CascadePlanLoaded:
+		t.handleCascadePlanLoaded(msg)
+
+	case messages.FieldOwnershipLoaded:
+		t.handleFieldOwnershipLoaded(msg)
+
+	case messages.ServiceTopologyLoaded:
+		t.handleServiceTopologyLoaded(msg)
+
+	case messages.ClusterConnected:
+		return t, t.handleClusterConnected(msg)
+
+	case messages.ClusterCompareLoaded:
+		t.handleClusterCompareLoaded(msg)
+
+	case messages.BuildLogStreamUpdate:
+		t.handleBuildLogLine(msg)
+
+	case messages.BuildLogStreamEnded:
+		return t, t.handleBuildLogEnded(msg)
+
 	case messages.ImageStreamsLoaded:
-		t.imageStreams = msg.ImageStreams
+		t.allImageStreams = msg.ImageStreams
+		t.imageStreams = viewItems(t, 6, t.allImageStreams, imageStreamViewRow)
 		t.loadingImageStreams = false
 		t.updateMainContent()
 
 	case messages.ImageStreamsLoadError:
+		t.allImageStreams = []resources.ImageStreamInfo{}
 		t.imageStreams = []resources.ImageStreamInfo{}
 		t.loadingImageStreams = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load ImageStreams: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load ImageStreams: %v", msg.Err)
 		t.updateMainContent()
 
 	case messages.RoutesLoaded:
-		t.routes = msg.Routes
+		t.allRoutes = msg.Routes
+		t.routes = viewItems(t, 7, t.allRoutes, routeViewRow)
 		t.loadingRoutes = false
 		t.updateMainContent()
+		return t, t.checkRouteHosts()
+
+	case messages.RouteHostsChecked:
+		t.handleRouteHostsChecked(msg)
+
+	case messages.RouteHostsCheckError:
+		t.handleRouteHostsCheckError(msg)
+
+	case messages.URLHealthChecked:
+		t.handleURLHealthChecked(msg)
+
+	case messages.StartupChecksCompleted:
+		t.handleStartupChecksCompleted(msg)
 
 	case messages.RoutesLoadError:
+		t.allRoutes = []resources.RouteInfo{}
 		t.routes = []resources.RouteInfo{}
 		t.loadingRoutes = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load Routes: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load Routes: %v", msg.Err)
 		t.updateMainContent()
 
 	case messages.ServiceLogsLoaded:
 		t.serviceLogs = msg.Logs
 		t.serviceLogPods = msg.Pods
 		t.loadingServiceLogs = false
-		t.logContent = t.serviceLogs
 		t.logScrollOffset = 0 // Reset scroll to top
 		t.userScrolled = false
 
@@ -522,99 +1452,281 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		t.serviceLogs = []string{}
 		t.serviceLogPods = []resources.PodInfo{}
 		t.loadingServiceLogs = false
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load service logs: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load service logs: %v", msg.Err)
 
 	case messages.SecretDataLoaded:
-		t.secretModalData = msg.Data
-		t.secretModalName = msg.SecretName
-		t.secretModalKeys = msg.Keys
-		t.selectedSecretKey = 0
-		t.secretMasked = true // Start with masked view for security
-		t.showSecretModal = true
+		t.handleSecretDataLoaded(msg)
+
+	case messages.SecretEditorFinished:
+		return t, t.handleSecretEditorFinished(msg)
 
 	case messages.SecretDataLoadError:
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load secret data: %v", msg.Err))
+		t.logError(categoryResource, "Failed to load secret data: %v", msg.Err)
+
+	case messages.ConfigMapDataLoaded:
+		t.handleConfigMapDataLoaded(msg)
+
+	case messages.ConfigMapDataLoadError:
+		t.logError(categoryResource, "Failed to load configmap data: %v", msg.Err)
+
+	case messages.ConfigMapEditorFinished:
+		return t, t.handleConfigMapEditorFinished(msg)
+
+	case messages.ResourceYAMLLoaded:
+		t.handleResourceYAMLLoaded(msg)
+
+	case messages.ResourceYAMLLoadError:
+		t.handleResourceYAMLLoadError(msg)
+
+	case messages.RolloutStatusLoaded:
+		return t, t.handleRolloutStatusLoaded(msg)
+
+	case messages.RolloutStatusLoadError:
+		logging.Warn(t.Logger, "Failed to load rollout status for %s: %v", msg.Name, msg.Err)
+		t.rolloutPollActive = false
+
+	case messages.RolloutStatusTick:
+		return t, t.handleRolloutStatusTick()
 
-	case messages.RefreshPods:
-		// Automatically refresh pods and set up next refresh
-		if t.connected && t.ActiveTab == 0 {
-			return t, tea.Batch(t.loadPods(), t.startPodRefreshTimer())
+	case messages.EditManifestReady:
+		return t, t.openEditor(msg)
+
+	case messages.EditorFinished:
+		return t, t.handleEditorFinished(msg)
+
+	case messages.ApplyEditorFinished:
+		return t, t.handleApplyEditorFinished(msg)
+
+	case messages.ManifestPreviewLoaded:
+		return t, t.handleManifestPreviewLoaded(msg)
+
+	case messages.DiffToolFinished:
+		t.handleDiffToolFinished(msg)
+
+	case messages.TaskProgress:
+		t.handleTaskProgress(msg)
+
+	case messages.TaskFinished:
+		return t, t.handleTaskFinished(msg)
+
+	case messages.APILatencyTick:
+		if t.hibernated {
+			return t, t.startAPILatencyTimer()
+		}
+		if t.connected {
+			return t, t.probeAPILatency()
 		}
-		return t, t.startPodRefreshTimer()
+
+	case messages.APILatencyMeasured:
+		if t.connected {
+			t.handleAPILatencyMeasured(msg)
+			return t, t.startAPILatencyTimer()
+		}
+
+	case messages.ControlPlaneHealthLoaded:
+		t.handleControlPlaneHealthLoaded(msg)
+
+	case messages.ImageInventoryLoaded:
+		t.handleImageInventoryLoaded(msg)
+
+	case messages.ImageInventoryLoadError:
+		t.handleImageInventoryLoadError(msg)
+
+	case messages.TabSummariesUpdated:
+		t.handleTabSummariesUpdated(msg)
+
+	case messages.ObjectCountsLoaded:
+		t.handleObjectCountsLoaded(msg)
+
+	case messages.ObjectCountsLoadError:
+		t.handleObjectCountsLoadError(msg)
+
+	case messages.PodUsageLoaded:
+		t.recordPodUsage(msg)
+
+	case messages.PodUsageLoadError:
+		t.handlePodUsageLoadError(msg)
+
+	case toastExpiredMsg:
+		t.expireToast(msg)
+
+	case messages.PostmortemSaved:
+		t.handlePostmortemSaved(msg)
+
+	case messages.TopRefreshTick:
+		return t, t.handleTopRefreshTick()
+
+	case messages.NodeUsageLoaded:
+		t.recordNodeUsage(msg)
+
+	case messages.NodeUsageLoadError:
+		t.handleNodeUsageLoadError(msg)
+
+	case messages.ControlPlaneHealthLoadError:
+		t.handleControlPlaneHealthLoadError(msg)
+
+	case messages.AutoRefreshTick:
+		// Refresh the active tab when its countdown expires and schedule the next tick.
+		// The tick chain stops while disconnected and restarts on reconnect.
+		if !t.connected {
+			return t, nil
+		}
+		if t.checkHibernation(time.Now()) {
+			return t, t.startAutoRefreshTimer()
+		}
+		t.checkTokenExpiry()
+		return t, tea.Batch(t.handleAutoRefreshTick(), t.startAutoRefreshTimer())
 
 	case messages.RefreshPodLogs:
-		// Legacy polling fallback - should not be used with streaming
-		if t.connected && t.logViewMode == constants.PodLogViewMode && len(t.pods) > 0 && t.selectedPod < len(t.pods) {
+		// Reconnect the log stream, unless the user moved to another pod
+		// meanwhile. Resuming from hibernation restarts it.
+		if t.connected && !t.hibernated && t.logViewMode == constants.PodLogViewMode && len(t.pods) > 0 && t.selectedPod < len(t.pods) {
+			if msg.PodName != "" && msg.PodName != t.pods[t.selectedPod].Name {
+				return t, nil
+			}
 			return t, t.startPodLogStream()
 		}
 		return t, nil // No need for polling with streaming
 
-	case messages.PodLogStreamUpdate:
-		// Handle real-time log stream updates
-		if t.connected && t.logViewMode == constants.PodLogViewMode {
-			t.handleLogStreamUpdate(msg.LogLine)
-		}
-
 	case messages.PodLogStreamError:
 		// Handle streaming errors
-		if t.connected && t.logViewMode == constants.PodLogViewMode {
-			t.handleLogStreamError(msg.Err)
+		// Errors from a stream that was already replaced are ignored
+		if t.connected && t.logViewMode == constants.PodLogViewMode && msg.Stream == t.logStreamID {
+			if t.handleFinishedLogStream(msg.PodName, msg.Container, msg.Err) {
+				return t, nil
+			}
+			return t, t.handleLogStreamError(msg.PodName, msg.Err)
 		}
 
 	case messages.NoKubeconfigMsg:
-		t.logContent = append(t.logContent, fmt.Sprintf("⚠️  %s", msg.Message))
-		t.logContent = append(t.logContent, "💡 To connect: Run 'oc login' or use --kubeconfig flag")
+		t.logWarn(categoryConnection, "%s", msg.Message)
+		t.logInfo(categoryConnection, "To connect: log in with the form (ctrl+l), run 'oc login' or use --kubeconfig flag")
 		t.updateMainContent()
+		return t, t.openLoginForm()
+
+	case messages.LoginSucceeded:
+		return t, t.handleLoginSucceeded(msg)
+
+	case messages.LoginFailed:
+		t.handleLoginFailed(msg)
 
 	case messages.ConnectingMsg:
 		t.connecting = true
-		t.logContent = append(t.logContent, fmt.Sprintf("Found kubeconfig at: %s", msg.KubeconfigPath))
-		t.logContent = append(t.logContent, "🔄 Connecting to cluster... (you should see spinner in status bar)")
+		t.logInfo(categoryConnection, "Found kubeconfig at: %s", msg.KubeconfigPath)
+		t.logInfo(categoryConnection, "Connecting to cluster... (you should see spinner in status bar)")
 		// Start spinner animation immediately
 		return t, t.startSpinnerAnimation()
 
 	case messages.ClusterInfoLoaded:
-		t.clusterVersion = msg.Version
-		// Only log if we have a real version (not error messages)
-		if msg.Version != "" && !strings.Contains(msg.Version, "restricted") && !strings.Contains(msg.Version, "not available") {
-			t.logContent = append(t.logContent, fmt.Sprintf("📊 Cluster version: %s", msg.Version))
-		}
+		t.handleClusterInfoLoaded(msg)
 
 	case messages.ClusterInfoError:
-		t.logContent = append(t.logContent, fmt.Sprintf("⚠️ Failed to load cluster info: %v", msg.Err))
+		t.handleClusterInfoError(msg)
+
+	case messages.IdentityLoaded:
+		t.handleIdentityLoaded(msg)
+
+	case messages.TokenExpiryLoaded:
+		t.handleTokenExpiryLoaded(msg)
+
+	case messages.ReloginFinished:
+		return t, t.handleReloginFinished(msg)
+
+	case messages.IdentityLoadError:
+		t.identity = nil
+		t.logWarn(categoryConnection, "Could not determine the current user: %v", msg.Err)
 
 	case ProjectListLoadedMsg:
 		t.loadingProjects = false
 		t.projectList = msg.Projects
+		t.projectContinue = msg.Continue
 		t.selectedProject = 0
 		// Find current project index
-		for i, proj := range t.projectList {
+		for i, proj := range t.visibleProjects() {
 			if t.currentProject != nil && proj.Name == t.currentProject.Name {
 				t.selectedProject = i
 				break
 			}
 		}
+		return t, t.maybeLoadMoreProjects()
+
+	case ProjectPageLoadedMsg:
+		return t, t.handleProjectPageLoaded(msg)
 
 	case ProjectSwitchedMsg:
 		t.showProjectModal = false
 		t.switchingProject = false
+		t.creatingProject = false
 		t.projectError = "" // Clear any errors on successful switch
+		t.projectErrorDetail = nil
 		t.currentProject = &msg.Project
 		t.namespace = msg.Project.Name
-		t.logContent = append(t.logContent, fmt.Sprintf("Switched to %s '%s'", msg.Project.Type, msg.Project.Name))
+		if t.resourceClient != nil {
+			_ = t.resourceClient.SetCurrentNamespace(msg.Project.Name)
+		}
+		t.resetLoaderCircuits()
+		t.logSuccess(categoryProject, "Switched to %s '%s'", msg.Project.Type, msg.Project.Name)
+		t.rememberProject(msg.Project.Name)
+		t.startTabSummaries()
 		// Clear pod logs when switching projects
 		t.clearPodLogs()
 		// Update main content to ensure tabs are visible
 		t.updateMainContent()
-		// Reload pods for the new project
+		// Reload pods for the new project, and the active tab's resources
 		if t.connected {
+			if t.ActiveTab != models.TabPods {
+				return t, tea.Batch(t.loadPods(), t.refreshTab(int(t.ActiveTab)))
+			}
 			return t, t.loadPods()
 		}
 
+	case ProjectCreateFailedMsg:
+		t.handleProjectCreateFailed(msg)
+
+	case ProjectCreateDryRunMsg:
+		t.handleProjectCreateDryRun(msg)
+
+	case messages.DetailYAMLLoaded:
+		t.handleDetailYAMLLoaded(msg)
+
+	case ProjectDeletedMsg:
+		return t, t.handleProjectDeleted(msg)
+
+	case messages.ProjectQuotasLoaded:
+		t.handleProjectQuotasLoaded(msg)
+
+	case messages.DashboardLoaded:
+		t.handleDashboardLoaded(msg)
+
+	case messages.ClusterOperatorsLoaded:
+		t.handleClusterOperatorsLoaded(msg)
+
+	case messages.PodFilesLoaded:
+		t.handlePodFilesLoaded(msg)
+
+	case messages.DebugShellFinished:
+		t.handleDebugShellFinished(msg)
+
+	case messages.ResourceDiffLoaded:
+		t.handleResourceDiffLoaded(msg)
+
+	case messages.HelmReleasesLoaded:
+		t.handleHelmReleasesLoaded(msg)
+
+	case messages.HelmHistoryLoaded:
+		t.handleHelmHistoryLoaded(msg)
+
+	case ProjectDeleteFailedMsg:
+		t.handleProjectDeleteFailed(msg)
+
 	case ProjectErrorMsg:
 		t.loadingProjects = false
 		t.switchingProject = false
+		t.creatingProject = false
+		t.deletingProject = false
 		t.projectError = msg.Error
+		t.projectErrorTitle = ""
+		t.projectErrorDetail = nil
 
 		// Create user-friendly error for project issues
 		projectError := errors.NewUserFriendlyError(
@@ -625,96 +1737,49 @@ func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			nil,
 		)
 		t.errorDisplay.AddError(projectError)
-		t.logContent = append(t.logContent, fmt.Sprintf("Project error: %s", msg.Error))
+		t.logError(categoryProject, "Project error: %s", msg.Error)
 		// Keep modal open to show error
 
 	case messages.SpinnerTick:
 		// Continue spinner animation if we have active loading operations
-		if t.connecting || t.loadingPods || t.loadingProjects || t.switchingProject {
+		if t.connecting || t.loadingPods || t.loadingProjects || t.switchingProject || t.creatingProject {
 			return t, t.startSpinnerAnimation()
 		}
 
 	case AutoRetryMsg:
 		// Automatic retry for connection errors
 		if !t.connected && !t.connecting && t.retryCount <= t.maxRetries {
-			t.logContent = append(t.logContent, fmt.Sprintf("🔄 Attempting reconnection (attempt %d/%d)...", t.retryCount, t.maxRetries))
-			return t, t.InitializeK8sClient(t.KubeconfigPath)
+			t.logInfo(categoryConnection, "Attempting reconnection (attempt %d/%d)...", t.retryCount, t.maxRetries)
+			return t, t.reconnect()
 		}
 
 	case RetrySuccessMsg:
 		// Reset retry counter on successful connection
 		t.retryCount = 0
 		t.retryInProgress = false
-		t.logContent = append(t.logContent, "✨ Connection restored successfully")
+		t.logSuccess(categoryConnection, "Connection restored successfully")
 
 	case ManualRetryMsg:
 		// Manual retry triggered by user
 		t.retryInProgress = true
 		if !t.connected {
-			t.logContent = append(t.logContent, "🔄 Manual reconnection attempt...")
-			return t, t.InitializeK8sClient(t.KubeconfigPath)
-		}
-
-	case PodLogsLoaded:
-		// Pod logs successfully loaded (initial load)
-		t.loadingLogs = false
-		t.podLogs = msg.Logs
-
-		// Extract and store the timestamp from the last log line for future streaming
-		if len(t.podLogs) > 0 {
-			lastLog := t.podLogs[len(t.podLogs)-1]
-			t.lastLogTime = t.extractTimestampFromLogLine(lastLog)
+			t.logInfo(categoryConnection, "Manual reconnection attempt...")
+			return t, t.reconnect()
 		}
 
-		// Limit log lines to prevent memory issues
-		if len(t.podLogs) > t.maxLogLines {
-			t.podLogs = t.podLogs[len(t.podLogs)-t.maxLogLines:] // Keep last N lines
-		}
-		// Auto-scroll to bottom on initial load
-		t.userScrolled = false
-		t.tailMode = true
-		t.logScrollOffset = t.getMaxLogScrollOffset()
-		t.logContent = append(t.logContent, fmt.Sprintf("📋 Loaded %d log lines from %s", len(msg.Logs), msg.PodName))
-
 	case PodLogsRefreshed:
-		// Pod logs refreshed with new content (streaming)
-		if len(msg.Logs) > 0 {
-			// Append new logs to existing logs
-			t.podLogs = append(t.podLogs, msg.Logs...)
-
-			// Update timestamp from the last new log
-			lastLog := msg.Logs[len(msg.Logs)-1]
-			t.lastLogTime = t.extractTimestampFromLogLine(lastLog)
-
-			// Limit total log lines to prevent memory issues
-			if len(t.podLogs) > t.maxLogLines {
-				t.podLogs = t.podLogs[len(t.podLogs)-t.maxLogLines:] // Keep last N lines
-			}
-
-			// Auto-scroll only if in tail mode
-			if t.tailMode {
-				t.logScrollOffset = t.getMaxLogScrollOffset()
-			}
-
-			t.logContent = append(t.logContent, fmt.Sprintf("📋 Added %d new log lines from %s", len(msg.Logs), msg.PodName))
+		// Lines pushed by the pod log stream
+		if t.connected && t.logViewMode == constants.PodLogViewMode {
+			t.handlePodLogsRefreshed(msg)
 		}
 
-	case PodLogsError:
-		// Pod logs loading failed
-		t.loadingLogs = false
-		t.podLogs = []string{fmt.Sprintf("Failed to load logs: %v", msg.Err)}
-		t.logScrollOffset = 0
-		// Create user-friendly error
-		userError := errors.MapKubernetesError(msg.Err)
-		t.errorDisplay.AddError(userError)
-		t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to load logs from %s: %s", msg.PodName, userError.GetDisplayMessage()))
 	}
 
 	return t, nil
 }
 
-// View implements tea.Model
-func (t *TUI) View() string {
+// render draws the whole interface, see View for frame coalescing
+func (t *TUI) render() string {
 	// Don't render until we have dimensions
 	if !t.ready || t.width == 0 || t.height == 0 {
 		return constants.InitializingMessage
@@ -725,29 +1790,235 @@ func (t *TUI) View() string {
 		return t.renderHelp()
 	}
 
+	// Show full-screen YAML view if active
+	if t.showYAMLView {
+		return t.renderYAMLView()
+	}
+
+	// Show the diff of two resources if active
+	if t.showResourceDiff {
+		return t.renderResourceDiff()
+	}
+
+	// Show both clusters side by side if active
+	if t.showClusterCompare {
+		return t.renderClusterCompare()
+	}
+
+	// Show second cluster picker if active
+	if t.showClusterPicker {
+		return t.renderClusterPicker()
+	}
+
+	// Show the quota view if active, also over the project modal
+	if t.showProjectQuotas {
+		return t.renderProjectQuotas()
+	}
+
 	// Show project modal if active
 	if t.showProjectModal {
 		return t.renderProjectModal()
 	}
 
 	// Show secret modal if active
+	if t.showSecretForm {
+		return t.renderSecretForm()
+	}
 	if t.showSecretModal {
 		return t.renderSecretModal()
 	}
 
+	// Show configmap data modal if active
+	if t.showConfigMapModal {
+		return t.renderConfigMapModal()
+	}
+
+	// Show pod delete confirmation if active
+	if t.showDeletePodModal {
+		return t.renderDeletePodModal()
+	}
+
+	// Show workload delete confirmation if active
+	if t.showCascadeDelete {
+		return t.renderCascadeDelete()
+	}
+
+	// Show service topology if active
+	if t.showTopology {
+		return t.renderServiceTopology()
+	}
+
+	// Show node action confirmation if active
+	if t.showNodeActionModal {
+		return t.renderNodeActionModal()
+	}
+
+	// Show the confirmation of a change previewed in the diff tool
+	if t.showDiffReview {
+		return t.renderDiffReview()
+	}
+
+	// Show DeploymentConfig rollback confirmation if active
+	if t.showRollbackModal {
+		return t.renderRollbackModal()
+	}
+
+	// Show background task panel if active
+	if t.showTaskPanel {
+		return t.renderTaskPanel()
+	}
+
+	// Show login form if active
+	if t.showLoginForm {
+		return t.renderLoginForm()
+	}
+
+	// Show settings if active
+	if t.showSettings {
+		return t.renderSettings()
+	}
+
+	// Show saved view picker or editor if active
+	if t.showViewForm {
+		return t.renderViewForm()
+	}
+	if t.showViewPicker {
+		return t.renderViewPicker()
+	}
+
+	// Show the file browser of a pod if active
+	if t.showPodFiles {
+		return t.renderPodFiles()
+	}
+
+	// Show the debug container launcher if active
+	if t.showDebugContainer {
+		return t.renderDebugContainer()
+	}
+
+	// Show the manifest file picker if active
+	if t.showApplyPicker {
+		return t.renderApplyPicker()
+	}
+
+	// Show apply results panel if active
+	if t.showApplyResults {
+		return t.renderApplyResults()
+	}
+
+	// Show re-login prompt when the session token is about to expire
+	if t.showReloginPrompt {
+		return t.renderReloginPrompt()
+	}
+
+	// Show container log picker if active
+	if t.showContainerPicker {
+		return t.renderContainerPicker()
+	}
+
+	// Show save logs prompt if active
+	if t.showLogExport {
+		return t.renderLogExport()
+	}
+
+	// Show control plane modal if active
+	if t.showControlPlaneModal {
+		return t.renderControlPlaneModal()
+	}
+
+	// Show cluster storage view if active
+	if t.showClusterStorage {
+		return t.renderClusterStorage()
+	}
+
+	// Show the machines view if active
+	if t.showMachines {
+		return t.renderMachines()
+	}
+
+	// Show the certificate error screen if active
+	if t.showTLSError {
+		return t.renderTLSError()
+	}
+
+	// Show the profile picker if active
+	if t.showProfilePicker {
+		return t.renderProfilePicker()
+	}
+
+	// Show the related resource picker if active
+	if t.showRelatedPicker {
+		return t.renderRelatedPicker()
+	}
+
+	// Show the startup check warnings if active
+	if t.showStartupChecks {
+		return t.renderStartupChecks()
+	}
+
+	// Show the cluster info view if active
+	if t.showClusterInfo {
+		return t.renderClusterInfoView()
+	}
+
+	// Show the overview if active
+	if t.showDashboard {
+		return t.renderDashboard()
+	}
+
+	// Show the cluster operators if active
+	if t.showClusterOperators {
+		return t.renderClusterOperators()
+	}
+
+	// Show the Helm browser if active
+	if t.showHelm {
+		return t.renderHelm()
+	}
+
+	// Show the batch menu or confirmation if active
+	if t.showBatch {
+		return t.renderBatch()
+	}
+
+	// Show the notification history if active
+	if t.showNotifications {
+		return t.renderNotifications()
+	}
+
+	// Show the Top view if active
+	if t.showTop {
+		return t.renderTop()
+	}
+
+	// Show image inventory report if active
+	if t.showImageReport {
+		return t.renderImageReport()
+	}
+
+	// Show object count report if active
+	if t.showObjectReport {
+		return t.renderObjectReport()
+	}
+
 	// Render main interface
 	return t.renderMain()
 }
 
+// headerHeight returns the number of header lines, one on short terminals
+func (t *TUI) headerHeight() int {
+	if t.height < constants.SingleLineHeaderHeightThreshold {
+		return 1
+	}
+	return 2
+}
+
 // renderMain renders the main interface using direct rendering
 func (t *TUI) renderMain() string {
 	var sections []string
 
 	// Header (1-2 lines based on height)
-	headerHeight := 2
-	if t.height < constants.SingleLineHeaderHeightThreshold {
-		headerHeight = 1
-	}
+	headerHeight := t.headerHeight()
 	sections = append(sections, t.renderHeader(headerHeight))
 
 	// Tabs (1 line)
@@ -842,7 +2113,7 @@ func (t *TUI) renderHeader(height int) string {
 			status = " - " + constants.ConnectingStatus
 		} else if t.connected {
 			projectInfo := t.getProjectDisplayInfo()
-			status = fmt.Sprintf(" - ● %s (%s)", t.context, projectInfo)
+			status = fmt.Sprintf(" - ● %s (%s)%s%s", t.context, projectInfo, t.identitySuffix(), t.peerClusterSuffix())
 		} else {
 			status = " - ○ Disconnected"
 		}
@@ -862,8 +2133,8 @@ func (t *TUI) renderHeader(height int) string {
 	} else if t.connected {
 		projectInfo := t.getProjectDisplayInfo()
 		obfuscatedContext := t.obfuscateClusterContext(t.context)
-		statusText = fmt.Sprintf("● Connected to %s (%s)", obfuscatedContext, projectInfo)
-		statusColor = lipgloss.Color("2") // green
+		statusText = fmt.Sprintf("%s Connected to %s (%s)%s%s", t.statusMark(statusOK, "●"), obfuscatedContext, projectInfo, t.identitySuffix(), t.peerClusterSuffix())
+		statusColor = t.statusColor(statusOK)
 	} else {
 		statusText = constants.NotConnectedMessage
 		statusColor = errorColor
@@ -880,10 +2151,9 @@ func (t *TUI) renderHeader(height int) string {
 
 // renderTabs renders the tab bar
 func (t *TUI) renderTabs() string {
-	tabs := constants.ResourceTabs
 	var tabViews []string
 
-	for i, tab := range tabs {
+	for i, tab := range t.tabLabels() {
 		style := lipgloss.NewStyle().Padding(0, 1)
 		if i == int(t.ActiveTab) {
 			style = style.
@@ -910,6 +2180,27 @@ const (
 	logHeaderOverhead = 2 // header line + separator line
 )
 
+// logPanelHeight returns the height of the log panel, or 0 when it is hidden
+// or does not fit the content area
+func (t *TUI) logPanelHeight(availableHeight int) int {
+	if !t.showLogs || availableHeight <= constants.MinMainContentLines {
+		return 0
+	}
+
+	// Reserve at least 10 lines for main content and detail panel
+	maxAllowedLogHeight := availableHeight - constants.MinMainContentLines
+
+	// Target log height is 1/3 of available or 15 lines, whichever is smaller
+	targetLogHeight := min(int(float64(availableHeight)*constants.LogHeightRatio), constants.DefaultLogHeight)
+	logHeight := min(targetLogHeight, maxAllowedLogHeight)
+
+	// Ensure minimum log height includes overhead, at least 2 lines of content
+	if logHeight < borderOverhead+paddingOverhead+logHeaderOverhead+constants.MinLogContentLines {
+		return 0 // Don't show logs if we can't meet minimum
+	}
+	return logHeight
+}
+
 // renderContent renders the main content area
 func (t *TUI) renderContent(availableHeight int) string {
 	// Calculate dimensions
@@ -921,33 +2212,13 @@ func (t *TUI) renderContent(availableHeight int) string {
 	// Calculate log panel's total overhead
 	logPanelTotalOverhead := borderOverhead + paddingOverhead + logHeaderOverhead
 
-	logHeight := 0
+	logHeight := t.logPanelHeight(availableHeight)
 	maxLogContentLines := 0
-	if t.showLogs && availableHeight > constants.MinMainContentLines {
-		// Reserve at least 10 lines for main content and detail panel
-		minMainContentHeight := constants.MinMainContentLines
-
-		// Calculate maximum allowed log height
-		maxAllowedLogHeight := availableHeight - minMainContentHeight
-
-		// Target log height is 1/3 of available or 15 lines, whichever is smaller
-		targetLogHeight := min(int(float64(availableHeight)*constants.LogHeightRatio), constants.DefaultLogHeight)
-
-		// Apply constraints
-		logHeight = min(targetLogHeight, maxAllowedLogHeight)
-
-		// Ensure minimum log height includes overhead
-		minLogHeight := logPanelTotalOverhead + constants.MinLogContentLines // At least 2 lines of content
-		if logHeight < minLogHeight {
-			logHeight = 0 // Don't show logs if we can't meet minimum
-		}
-
+	if logHeight > 0 {
 		// Calculate actual visible lines for log content
-		if logHeight > 0 {
-			maxLogContentLines = logHeight - logPanelTotalOverhead
-			if maxLogContentLines < 1 {
-				maxLogContentLines = 1
-			}
+		maxLogContentLines = logHeight - logPanelTotalOverhead
+		if maxLogContentLines < 1 {
+			maxLogContentLines = 1
 		}
 	}
 
@@ -967,7 +2238,13 @@ func (t *TUI) renderContent(availableHeight int) string {
 		BorderForeground(borderColor).
 		Padding(1)
 
-	mainPanel := mainStyle.Render(t.mainContent)
+	// Lists are windowed to the panel when built, content built for a larger
+	// panel (before the log panel opened) is clipped until the next rebuild
+	mainContent := t.mainContent
+	if err := t.tabNoAccess(int(t.ActiveTab)); err != nil {
+		mainContent = t.renderNoAccess(int(t.ActiveTab), err)
+	}
+	mainPanel := mainStyle.Render(clipLines(t.renderViewBanner()+t.renderListFilterBar()+mainContent, mainHeight-borderOverhead-paddingOverhead))
 
 	// Detail panel
 	var detailPanel string
@@ -985,7 +2262,7 @@ func (t *TUI) renderContent(availableHeight int) string {
 			BorderForeground(detailBorderColor).
 			Padding(1)
 
-		detailPanel = detailStyle.Render(t.detailContent)
+		detailPanel = detailStyle.Render(t.renderDetailPane(detailWidth-borderOverhead-paddingOverhead, mainHeight-borderOverhead-paddingOverhead))
 	}
 
 	// Combine main and detail panels
@@ -1045,8 +2322,8 @@ func (t *TUI) renderContent(availableHeight int) string {
 				totalLines := 0
 				logWidth := t.width - constants.LogWidthPadding // Account for borders and padding
 
-				for _, line := range visibleLogs {
-					colored := t.colorizePodLog(line)
+				for i, line := range visibleLogs {
+					colored := t.renderPodLogLine(line, start+i)
 
 					// Count how many actual lines this log entry will render as
 					// This includes both explicit newlines and wrapped lines
@@ -1079,7 +2356,8 @@ func (t *TUI) renderContent(availableHeight int) string {
 					if t.tailMode {
 						tailIndicator = " [TAIL]"
 					}
-					logHeader = fmt.Sprintf("📋 Pod Logs: %s%s", t.pods[t.selectedPod].Name, tailIndicator)
+					pod := t.pods[t.selectedPod]
+					logHeader = fmt.Sprintf("📋 Pod Logs: %s%s%s%s%s", pod.Name, t.logTargetSuffix(pod), tailIndicator, t.logSearchHeader(), t.logHistoryHeader())
 				} else {
 					logHeader = "📋 Pod Logs"
 				}
@@ -1088,13 +2366,16 @@ func (t *TUI) renderContent(availableHeight int) string {
 				if len(t.pods) > 0 && t.selectedPod < len(t.pods) {
 					selectedPodName := t.pods[t.selectedPod].Name
 					logText = fmt.Sprintf("📋 No logs available for pod '%s'", selectedPodName)
-					logHeader = fmt.Sprintf("📋 Pod Logs: %s (No logs)", selectedPodName)
+					logHeader = fmt.Sprintf("📋 Pod Logs: %s%s (No logs)", selectedPodName, t.logTargetSuffix(t.pods[t.selectedPod]))
 				} else {
 					logText = "📋 No pod selected"
 					logHeader = "📋 Pod Logs (No pod selected)"
 				}
 			}
 		
+		case constants.BuildLogViewMode:
+			logText, logHeader = t.renderBuildLogs(maxLogContentLines)
+
 		case constants.ServiceLogViewMode:
 			// Service logs mode - aggregated logs from all pods behind a service
 			if t.loadingServiceLogs {
@@ -1146,18 +2427,19 @@ func (t *TUI) renderContent(availableHeight int) string {
 				}
 			}
 		
-		default: // App logs mode (legacy)
+		default: // App logs mode
 			// Get recent logs but account for multiline entries
-			startIdx := max(0, len(t.logContent)-constants.LastNAppLogEntries) // Start with last 100 entries
-			recentLogs := t.logContent[startIdx:]
+			entries := t.filteredAppLog()
+			startIdx := max(0, len(entries)-constants.LastNAppLogEntries) // Start with last 100 entries
+			recentLogs := entries[startIdx:]
 
 			// Apply coloring and count actual rendered lines
 			coloredAppLogs := []string{}
 			totalLines := 0
 			logWidth := t.width - 6 // Account for borders and padding
 
-			for _, line := range recentLogs {
-				colored := t.colorizeAppLog(line)
+			for _, entry := range recentLogs {
+				colored := renderAppLogEntry(entry)
 
 				// Count how many actual lines this log entry will render as
 				lineCount := 0
@@ -1181,7 +2463,7 @@ func (t *TUI) renderContent(availableHeight int) string {
 				}
 			}
 			logText = strings.Join(coloredAppLogs, "\n")
-			logHeader = "📱 App Logs"
+			logHeader = t.appLogHeader()
 		}
 
 		// Color the header based on log type with distinctive colors
@@ -1212,6 +2494,13 @@ func (t *TUI) renderContent(availableHeight int) string {
 
 // renderStatusBar renders the status bar with enhanced connection information
 func (t *TUI) renderStatusBar() string {
+	if t.showCommandPrompt {
+		return t.renderCommandPrompt()
+	}
+	if t.hibernated {
+		return t.renderHibernationBanner()
+	}
+
 	// Style hints with different colors
 	hintsStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("242"))         // Dimmer gray
 	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Bold(true) // White bold
@@ -1232,6 +2521,11 @@ func (t *TUI) renderStatusBar() string {
 		keyStyle.Render("L"), hintsStyle.Render("•"),
 		keyStyle.Render("q"))
 
+	// A fresh notification replaces the hints until it expires
+	if t.toast != nil {
+		hints = t.renderToast()
+	}
+
 	// Enhanced left section with connection status
 	left := t.renderConnectionStatus()
 
@@ -1293,26 +2587,26 @@ func (t *TUI) renderConnectionStatus() string {
 	if t.connecting {
 		statusIcon = t.getLoadingSpinner()
 		statusText = "Connecting"
-		statusColor = lipgloss.Color("11") // Yellow
+		statusColor = t.statusColor(statusWarn)
 	} else if t.connected {
 		// Show refresh status when loading pods
 		if t.loadingPods {
 			statusIcon = t.getLoadingSpinner()
 			statusText = "Refreshing"
-			statusColor = lipgloss.Color("11") // Yellow for refreshing
+			statusColor = t.statusColor(statusWarn)
 		} else {
-			statusIcon = "✅"
+			statusIcon = t.statusMark(statusOK, "✅")
 			statusText = "Connected"
-			statusColor = lipgloss.Color("10") // Green
+			statusColor = t.statusColor(statusOK)
 		}
 	} else if t.connectionErr != nil {
-		statusIcon = "❌"
+		statusIcon = t.statusMark(statusFailed, "❌")
 		statusText = "Failed"
-		statusColor = lipgloss.Color("9") // Red
+		statusColor = t.statusColor(statusFailed)
 	} else {
-		statusIcon = "⚪"
+		statusIcon = t.statusMark(statusUnknown, "⚪")
 		statusText = "Disconnected"
-		statusColor = lipgloss.Color("8") // Gray
+		statusColor = t.statusColor(statusUnknown)
 	}
 
 	connectionStyle := lipgloss.NewStyle().
@@ -1331,7 +2625,13 @@ func (t *TUI) renderConnectionStatus() string {
 		}
 	}
 
-	return fmt.Sprintf("%s • %s%s", focusStyle.Render(focusIndicator), connectionInfo, errorIndicator)
+	// Add API latency indicator once the first probe has completed
+	latencyIndicator := ""
+	if latency := t.renderAPILatency(); latency != "" {
+		latencyIndicator = " • " + latency
+	}
+
+	return fmt.Sprintf("%s • %s%s%s", focusStyle.Render(focusIndicator), connectionInfo, latencyIndicator, errorIndicator)
 }
 
 // renderClusterInfo returns cluster and project information
@@ -1360,12 +2660,50 @@ func (t *TUI) renderClusterInfo() string {
 	} else if t.namespace != "" {
 		parts = append(parts, fmt.Sprintf("📦 %s", t.namespace))
 	}
+	if t.allNamespaces {
+		parts = append(parts, "🌐 all namespaces")
+	}
+
+	// Authenticated user, so it is obvious which identity actions run as
+	if user := t.renderIdentity(); user != "" {
+		parts = append(parts, user)
+	}
+
+	// Session token countdown
+	if expiry := t.renderTokenExpiry(); expiry != "" {
+		parts = append(parts, expiry)
+	}
 
-	// Cluster version info (only show if we have actual version, not error messages)
-	if t.clusterVersion != "" && !strings.Contains(t.clusterVersion, "restricted") && !strings.Contains(t.clusterVersion, "not available") {
+	// Cluster version info
+	if t.clusterVersion != "" {
 		parts = append(parts, fmt.Sprintf("⚙️ %s", t.clusterVersion))
 	}
 
+	// Dry-run mode, so it is obvious that changes are not saved
+	if dryRun := t.renderDryRunStatus(); dryRun != "" {
+		parts = append(parts, dryRun)
+	}
+
+	// Safe mode, so it is obvious that nothing refreshes by itself
+	if safeMode := t.renderSafeModeStatus(); safeMode != "" {
+		parts = append(parts, safeMode)
+	}
+
+	// Macro recording or replay in progress
+	if macro := t.renderMacroStatus(); macro != "" {
+		parts = append(parts, macro)
+	}
+
+	// Running background tasks
+	if tasks := t.renderTaskIndicator(); tasks != "" {
+		parts = append(parts, tasks)
+	}
+
+	// Auto-refresh countdown for the active tab
+	if status := t.renderAutoRefreshStatus(); status != "" {
+		parts = append(parts, status)
+	}
+
 	// Loading indicators for ongoing operations (project loading only - pod loading shows in connection status)
 	if t.loadingProjects {
 		parts = append(parts, fmt.Sprintf("%s Loading projects", t.getLoadingSpinner()))
@@ -1417,36 +2755,6 @@ func (t *TUI) getLoadingSpinner() string {
 
 // renderHelp renders a simple help overlay
 func (t *TUI) renderHelp() string {
-	helpText := `📖 LazyOC Help
-
-Navigation:
-  tab        Next panel
-  shift+tab  Previous panel
-  j/k        Move down/up in pod list OR scroll logs
-  h/l        Previous/Next tab (in main panel)
-  arrow keys Navigate tabs/list
-  1/2/3      Jump to main/detail/log panel
-  
-Log Scrolling (when in log panel):
-  j/k        Scroll up/down line by line
-  PgUp/PgDn  Scroll up/down page by page
-  Home/End   Jump to top/bottom of logs
-  T          Toggle tail mode (auto-scroll to new logs)
-  
-Commands:
-  ?          Toggle help  
-  enter      Show details OR view secret data (in secrets tab)
-  l          Toggle app/pod logs (when in log panel) OR navigate tabs
-  ctrl+p     Switch project/namespace
-  d          Toggle details panel
-  L          Toggle log panel (shift+l)
-  r          Retry connection / Refresh
-  e          Show error details (when errors exist)
-  t          Toggle theme
-  q          Quit
-  
-Press ? or ESC to close`
-
 	// Simple centered help box with better styling
 	helpStyle := lipgloss.NewStyle().
 		Width(constants.HelpModalWidth).
@@ -1457,7 +2765,7 @@ Press ? or ESC to close`
 		Padding(1, 2).
 		Align(lipgloss.Left)
 
-	help := helpStyle.Render(helpText)
+	help := helpStyle.Render(helpText())
 
 	// Center in screen
 	return lipgloss.Place(
@@ -1478,8 +2786,9 @@ func (t *TUI) updateMainContent() {
 ❌ Not connected to any cluster
 
 To connect to a cluster:
-1. Run 'oc login <cluster-url>' in your terminal
-2. Or start LazyOC with: lazyoc --kubeconfig /path/to/config
+1. Press ctrl+l to log in with a token or a username and password
+2. Run 'oc login <cluster-url>' in your terminal
+3. Or start LazyOC with: lazyoc --kubeconfig /path/to/config
 
 Press 'q' to quit`, tabName)
 		return
@@ -1502,9 +2811,34 @@ Press 'q' to quit`, tabName)
 		t.updateImageStreamDisplay()
 	case 7: // Routes tab
 		t.updateRouteDisplay()
+	case 8: // Builds tab
+		t.updateBuildDisplay()
+	case 9: // Events tab
+		t.updateEventDisplay()
+	case 10: // StatefulSets tab
+		t.updateStatefulSetDisplay()
+	case 11: // DaemonSets tab
+		t.updateDaemonSetDisplay()
+	case 12: // ReplicaSets tab
+		t.updateReplicaSetDisplay()
+	case 13: // Jobs tab
+		t.updateJobDisplay()
+	case 14: // CronJobs tab
+		t.updateCronJobDisplay()
+	case 15: // Nodes tab
+		t.updateNodeDisplay()
+	case 16: // Storage tab
+		t.updatePVCDisplay()
+	case 17: // Ingresses tab
+		t.updateIngressDisplay()
+	case 18: // NetworkPolicies tab
+		t.updateNetworkPolicyDisplay()
+	case 19: // DeploymentConfigs tab
+		t.updateDeploymentConfigDisplay()
 	default:
 		t.mainContent = fmt.Sprintf("📦 %s Resources\n\n%s\n\nUse h/l or arrow keys to navigate tabs\nPress ? for help", tabName, constants.ComingSoonMessage)
 	}
+
 }
 
 // getThemeColors returns primary and error colors based on current theme
@@ -1533,11 +2867,12 @@ func (t *TUI) InitializeK8sClient(kubeconfigPath string) tea.Cmd {
 
 		// Create auth provider
 		logging.Info(t.Logger, "📝 Creating auth provider")
-		t.authProvider = auth.NewKubeconfigProvider(kubeconfigPath)
+		t.authProvider = newAuthProvider(kubeconfigPath, t.startContext, t.authHook)
 
 		// Authenticate with shorter timeout to avoid hanging
-		logging.Info(t.Logger, "🔐 Starting authentication (timeout: 5s)")
-		ctx, cancel := context.WithTimeout(context.Background(), constants.AuthenticationTimeout)
+		timeout := authTimeout(t.authHook)
+		logging.Info(t.Logger, "🔐 Starting authentication (timeout: %s)", timeout)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
 		config, err := t.authProvider.Authenticate(ctx)
@@ -1546,6 +2881,7 @@ func (t *TUI) InitializeK8sClient(kubeconfigPath string) tea.Cmd {
 			return messages.ConnectionError{Err: fmt.Errorf("authentication failed: %w", err)}
 		}
 		logging.Info(t.Logger, "✅ Authentication successful")
+		t.tlsOverrides.Apply(config)
 
 		// Create clientset directly (no need for duplicate client factory)
 		logging.Info(t.Logger, "🔧 Creating Kubernetes clientset")
@@ -1575,6 +2911,12 @@ func (t *TUI) InitializeK8sClient(kubeconfigPath string) tea.Cmd {
 		logging.Info(t.Logger, "📦 Getting namespace and context info")
 		namespace := t.authProvider.GetNamespace()
 		clusterContext := t.authProvider.GetContext()
+		if t.startNamespace != "" {
+			namespace = t.startNamespace
+		} else if t.projectsInMemory() && t.namespace != "" && clusterContext == t.context {
+			// A reconnect keeps the project switched to in memory
+			namespace = t.namespace
+		}
 		logging.Info(t.Logger, "📍 Namespace: %s, Context: %s", namespace, clusterContext)
 
 		logging.Info(t.Logger, "🔗 Creating project-aware resource client")
@@ -1589,6 +2931,7 @@ func (t *TUI) InitializeK8sClient(kubeconfigPath string) tea.Cmd {
 			// Fallback to basic resource client without project manager
 			resourceClient = resources.NewK8sResourceClientWithConfig(clientset, config, namespace)
 		} else {
+			projectFactory.SetInMemory(t.projectsInMemory(), namespace)
 			// Create project manager with auto-detection
 			projectManager, err := projectFactory.CreateAutoDetectManager(context.Background())
 			if err != nil {
@@ -1641,14 +2984,9 @@ func (t *TUI) loadPods() tea.Cmd {
 
 		t.loadingPods = true
 
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		opts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		opts := t.listOptions()
 
-		podList, err := t.resourceClient.ListPods(ctx, opts)
+		podList, err := loadAllPages(t, "pods", opts, t.resourceClient.ListPods)
 		if err != nil {
 			t.loadingPods = false
 			return messages.LoadPodsError{Err: err}
@@ -1668,14 +3006,9 @@ func (t *TUI) loadServices() tea.Cmd {
 
 		t.loadingServices = true
 
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		opts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		opts := t.listOptions()
 
-		serviceList, err := t.resourceClient.ListServices(ctx, opts)
+		serviceList, err := loadAllPages(t, "services", opts, t.resourceClient.ListServices)
 		if err != nil {
 			t.loadingServices = false
 			return messages.ServicesLoadError{Err: err}
@@ -1695,14 +3028,9 @@ func (t *TUI) loadDeployments() tea.Cmd {
 
 		t.loadingDeployments = true
 
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		opts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		opts := t.listOptions()
 
-		deploymentList, err := t.resourceClient.ListDeployments(ctx, opts)
+		deploymentList, err := loadAllPages(t, "deployments", opts, t.resourceClient.ListDeployments)
 		if err != nil {
 			t.loadingDeployments = false
 			return messages.DeploymentsLoadError{Err: err}
@@ -1732,7 +3060,7 @@ func (t *TUI) loadServiceLogs() tea.Cmd {
 		defer cancel()
 
 		// Get pods for the selected service
-		pods, err := t.resourceClient.GetPodsForService(ctx, t.namespace, selectedService.Name)
+		pods, err := t.resourceClient.GetPodsForService(ctx, t.resourceNamespace(selectedService.Namespace), selectedService.Name)
 		if err != nil {
 			t.loadingServiceLogs = false
 			return messages.ServiceLogsLoadError{Err: fmt.Errorf("failed to get pods for service %s: %w", selectedService.Name, err)}
@@ -1790,14 +3118,9 @@ func (t *TUI) loadConfigMaps() tea.Cmd {
 
 		t.loadingConfigMaps = true
 
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		opts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		opts := t.listOptions()
 
-		configMapList, err := t.resourceClient.ListConfigMaps(ctx, opts)
+		configMapList, err := loadAllPages(t, "configmaps", opts, t.resourceClient.ListConfigMaps)
 		if err != nil {
 			t.loadingConfigMaps = false
 			return messages.ConfigMapsLoadError{Err: err}
@@ -1817,14 +3140,9 @@ func (t *TUI) loadSecrets() tea.Cmd {
 
 		t.loadingSecrets = true
 
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		opts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		opts := t.listOptions()
 
-		secretList, err := t.resourceClient.ListSecrets(ctx, opts)
+		secretList, err := loadAllPages(t, "secrets", opts, t.resourceClient.ListSecrets)
 		if err != nil {
 			t.loadingSecrets = false
 			return messages.SecretsLoadError{Err: err}
@@ -1837,44 +3155,23 @@ func (t *TUI) loadSecrets() tea.Cmd {
 
 // loadSecretData loads the data for the selected secret
 func (t *TUI) loadSecretData() tea.Cmd {
-	return func() tea.Msg {
-		if !t.connected || t.resourceClient == nil {
-			return messages.SecretDataLoadError{Err: fmt.Errorf("not connected to cluster")}
-		}
-
-		if len(t.secrets) == 0 || t.selectedSecret >= len(t.secrets) {
+	if len(t.secrets) == 0 || t.selectedSecret >= len(t.secrets) {
+		return func() tea.Msg {
 			return messages.SecretDataLoadError{Err: fmt.Errorf("no secret selected")}
 		}
-
-		selectedSecret := t.secrets[t.selectedSecret]
-
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		// Get the actual secret data
-		secretData, err := t.resourceClient.GetSecretData(ctx, t.namespace, selectedSecret.Name)
-		if err != nil {
-			return messages.SecretDataLoadError{Err: fmt.Errorf("failed to get secret data %s: %w", selectedSecret.Name, err)}
-		}
-
-		// Get keys for navigation
-		var keys []string
-		for key := range secretData {
-			keys = append(keys, key)
-		}
-
-		return messages.SecretDataLoaded{
-			SecretName: selectedSecret.Name,
-			Data:       secretData,
-			Keys:       keys,
-		}
 	}
+
+	selectedSecret := t.secrets[t.selectedSecret]
+	return t.loadSecretDataFor(t.resourceNamespace(selectedSecret.Namespace), selectedSecret.Name)
 }
 
-// startPodRefreshTimer returns a command that sets up automatic pod refresh
-func (t *TUI) startPodRefreshTimer() tea.Cmd {
-	return tea.Tick(constants.PodRefreshInterval, func(time.Time) tea.Msg {
-		return messages.RefreshPods{}
+// startAutoRefreshTimer returns a command that drives the auto-refresh countdown
+func (t *TUI) startAutoRefreshTimer() tea.Cmd {
+	if t.safeMode {
+		return nil
+	}
+	return tea.Tick(constants.AutoRefreshTickInterval, func(time.Time) tea.Msg {
+		return messages.AutoRefreshTick{}
 	})
 }
 
@@ -1885,15 +3182,14 @@ func (t *TUI) startSpinnerAnimation() tea.Cmd {
 	})
 }
 
-// loadClusterInfo fetches cluster version and server information
-func (t *TUI) loadClusterInfo() tea.Cmd {
-	return func() tea.Msg {
-		// Debug: Always send this message first
-		return messages.ClusterInfoLoaded{
-			Version:    "OpenShift (version API restricted)",
-			ServerInfo: map[string]interface{}{"debug": "cluster info called"},
-		}
-	}
+// quit stops the log stream and the tab count watches, removes the log
+// spill file and remembers the active tab before quitting
+func (t *TUI) quit() tea.Cmd {
+	t.stopPodLogStream()
+	t.stopTabSummaries()
+	t.closeLogSpill()
+	t.saveLastTab()
+	return tea.Quit
 }
 
 // clearPodLogs clears the current pod logs and sets loading state
@@ -1904,135 +3200,16 @@ func (t *TUI) clearPodLogs() {
 	t.podLogs = []string{}
 	t.logScrollOffset = 0
 	t.loadingLogs = true
-	t.userScrolled = false                 // Reset scroll tracking
-	t.lastLogTime = ""                     // Reset timestamp tracking
-	t.tailMode = true                      // Reset to tail mode
-	t.seenLogLines = make(map[string]bool) // Clear seen logs map
-	t.clearScrollAnchor()                  // Clear line anchor
-}
-
-// loadPodLogs fetches logs from the currently selected pod
-func (t *TUI) loadPodLogs() tea.Cmd {
-	return t.loadPodLogsInternal(false)
-}
-
-// loadPodLogsInternal fetches logs with option for streaming (append mode)
-func (t *TUI) loadPodLogsInternal(isRefresh bool) tea.Cmd {
-	return func() tea.Msg {
-		if !t.connected || t.resourceClient == nil || len(t.pods) == 0 || t.selectedPod >= len(t.pods) {
-			return PodLogsError{Err: fmt.Errorf("no pod selected or not connected"), PodName: ""}
-		}
-
-		selectedPod := t.pods[t.selectedPod]
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		// Get current project/namespace
-		namespace := t.resourceClient.GetCurrentProject()
-		if namespace == "" {
-			namespace = t.namespace
-		}
-
-		// Use first container if available
-		containerName := ""
-		if len(selectedPod.ContainerInfo) > 0 {
-			containerName = selectedPod.ContainerInfo[0].Name
-		}
-
-		// Set up log options based on whether this is initial load or refresh
-		var logOpts resources.LogOptions
-		if isRefresh && t.lastLogTime != "" {
-			// For refresh, get logs since last timestamp using SinceSeconds
-			// Parse last timestamp to calculate seconds ago
-			sinceSeconds := int64(30) // Default to 30 seconds if parsing fails
-			if lastTime, err := t.parseLogTimestamp(t.lastLogTime); err == nil {
-				secondsAgo := int64(time.Since(lastTime).Seconds())
-				if secondsAgo > 0 {
-					sinceSeconds = secondsAgo + 1 // Add 1 second buffer to avoid duplicates
-				}
-			}
-
-			logOpts = resources.LogOptions{
-				SinceSeconds: &sinceSeconds,
-				Timestamps:   true,
-			}
-		} else {
-			// Initial load - get last 100 lines
-			tailLines := int64(constants.DefaultPodLogTailLines)
-			logOpts = resources.LogOptions{
-				TailLines:  &tailLines,
-				Timestamps: true,
-			}
-		}
-
-		// Fetch logs
-		logsStr, err := t.resourceClient.GetPodLogs(ctx, namespace, selectedPod.Name, containerName, logOpts)
-		if err != nil {
-			return PodLogsError{Err: err, PodName: selectedPod.Name}
-		}
-
-		// Split logs into lines and deduplicate
-		var logLines []string
-		if logsStr != "" {
-			lines := strings.Split(strings.TrimSpace(logsStr), "\n")
-			for _, line := range lines {
-				if line != "" {
-					// For refresh mode, check for duplicates
-					if isRefresh {
-						if !t.seenLogLines[line] {
-							logLines = append(logLines, line)
-							t.seenLogLines[line] = true
-						}
-					} else {
-						// For initial load, accept all lines and mark as seen
-						logLines = append(logLines, line)
-						t.seenLogLines[line] = true
-					}
-				}
-			}
-		}
-
-		// Return appropriate message based on load type
-		if isRefresh {
-			return PodLogsRefreshed{Logs: logLines, PodName: selectedPod.Name}
-		} else {
-			if len(logLines) == 0 {
-				logLines = []string{constants.NoLogsAvailableMessage}
-			}
-			return PodLogsLoaded{Logs: logLines, PodName: selectedPod.Name}
-		}
-	}
-}
-
-// parseLogTimestamp parses a timestamp from a log line
-func (t *TUI) parseLogTimestamp(timestamp string) (time.Time, error) {
-	// Common Kubernetes log timestamp formats
-	layouts := []string{
-		"2006-01-02T15:04:05.999999999Z07:00", // RFC3339 with nanoseconds
-		"2006-01-02T15:04:05.999999Z07:00",    // RFC3339 with microseconds
-		"2006-01-02T15:04:05.999Z07:00",       // RFC3339 with milliseconds
-		"2006-01-02T15:04:05Z07:00",           // RFC3339 basic
-		"2006-01-02 15:04:05.999999999",       // Space-separated with nanoseconds
-		"2006-01-02 15:04:05.999999",          // Space-separated with microseconds
-		"2006-01-02 15:04:05.999",             // Space-separated with milliseconds
-		"2006-01-02 15:04:05",                 // Space-separated basic
-	}
-
-	for _, layout := range layouts {
-		if parsed, err := time.Parse(layout, timestamp); err == nil {
-			return parsed, nil
-		}
-	}
-
-	return time.Time{}, fmt.Errorf("unable to parse timestamp: %s", timestamp)
-}
-
-// extractTimestampFromLogLine extracts timestamp from a log line
-func (t *TUI) extractTimestampFromLogLine(logLine string) string {
-	// Use the same pattern as in colorizePodLog
-	timestampPattern := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}[\.\d]*[Z]?[+-]?\d{0,2}:?\d{0,2}`)
-	matches := timestampPattern.FindString(logLine)
-	return matches
+	t.userScrolled = false              // Reset scroll tracking
+	t.tailMode = true                   // Reset to tail mode
+	t.logStreamLastLineAt = time.Time{} // Next stream starts from the tail
+	t.logResumeOverlap = nil
+	t.clearScrollAnchor() // Clear line anchor
+	// Keep the search query, its matches are found again in the new logs
+	t.logSearchMatches = nil
+	t.logSearchCurrent = -1
+	t.resetLogStreamBudget()
+	t.closeLogSpill()
 }
 
 // updatePodDisplay updates the main content with pod information
@@ -2043,8 +3220,9 @@ func (t *TUI) updatePodDisplay() {
 ❌ Not connected to any cluster
 
 To connect to a cluster:
-1. Run 'oc login <cluster-url>' in your terminal
-2. Or start LazyOC with: lazyoc --kubeconfig /path/to/config
+1. Press ctrl+l to log in with a token or a username and password
+2. Run 'oc login <cluster-url>' in your terminal
+3. Or start LazyOC with: lazyoc --kubeconfig /path/to/config
 
 Press 'q' to quit`
 		return
@@ -2057,7 +3235,9 @@ Press 'q' to quit`
 
 	if len(t.pods) == 0 {
 		// Use project-aware display for no pods message
-		if t.resourceClient != nil {
+		if t.allNamespaces {
+			t.mainContent = "📦 Pods in all namespaces\n\nNo pods found in any namespace."
+		} else if t.resourceClient != nil {
 			currentProject := t.resourceClient.GetCurrentProject()
 			if currentProject != "" {
 				t.mainContent = fmt.Sprintf("📦 Pods in %s\n\nNo pods found in this project.", currentProject)
@@ -2074,41 +3254,65 @@ Press 'q' to quit`
 	var content strings.Builder
 
 	// Use project-aware display if resource client supports it
-	if t.resourceClient != nil {
+	badges := t.podBadgeSelector()
+	legend := t.batchLegend(models.TabPods) + t.podBadgeLegend(badges)
+	if t.allNamespaces {
+		content.WriteString("📦 Pods in all namespaces" + legend + "\n\n")
+	} else if t.resourceClient != nil {
 		currentProject := t.resourceClient.GetCurrentProject()
 		if currentProject != "" {
-			content.WriteString(fmt.Sprintf("📦 Pods in %s\n\n", currentProject))
+			content.WriteString(fmt.Sprintf("📦 Pods in %s%s\n\n", currentProject, legend))
 		} else {
-			content.WriteString(fmt.Sprintf("📦 Pods in %s\n\n", t.namespace))
+			content.WriteString(fmt.Sprintf("📦 Pods in %s%s\n\n", t.namespace, legend))
 		}
 	} else {
-		content.WriteString(fmt.Sprintf("📦 Pods in %s\n\n", t.namespace))
+		content.WriteString(fmt.Sprintf("📦 Pods in %s%s\n\n", t.namespace, legend))
+	}
+
+	// Render with the active view's columns and grouping if it defines them
+	if view := t.activeView(0); view != nil && (len(view.Columns) > 0 || view.GroupBy != "") {
+		t.renderPodViewTable(&content, view, badges)
+		t.mainContent = content.String()
+		if t.selectedPod < len(t.pods) && t.selectedPod >= 0 {
+			t.updatePodDetails(t.pods[t.selectedPod])
+		}
+		return
 	}
 
 	// Header
-	content.WriteString("NAME                                    STATUS    READY   AGE\n")
-	content.WriteString("────────────────────────────────────    ──────    ─────   ───\n")
+	content.WriteString(t.namespaceHeader() + fmt.Sprintf("%-40s%-10s%-8s%-10s%-7s%-9s%s\n",
+		t.sortTitle(0, "name", "NAME"), t.sortTitle(0, "status", "STATUS"), t.sortTitle(0, "ready", "READY"),
+		t.sortTitle(0, "restarts", "RESTARTS"), "CPU", "MEMORY", t.sortTitle(0, "age", "AGE")))
+	if t.allNamespaces {
+		content.WriteString(strings.Repeat("─", namespaceColumnWidth) + " ")
+	}
+	content.WriteString("────────────────────────────────────    ──────    ─────   ────────  ───    ──────   ───\n")
 
 	// Pod rows
-	for i, pod := range t.pods {
+	start, end := t.listWindow(t.selectedPod, len(t.pods))
+	for i := start; i < end; i++ {
+		pod := t.pods[i]
 		// Highlight selected pod
 		prefix := "  "
 		if i == t.selectedPod && t.focusedPanel == 0 {
 			prefix = "▶ "
 		}
 
-		// Truncate name if too long
-		name := pod.Name
+		// Mark the pods marked for a batch action, badge the pods behind the
+		// service or route selected last, and truncate the name if too long
+		name := []rune(t.batchMarkCell(models.TabPods, pod.Namespace, pod.Name) + t.podBadgeCell(badges, pod) + pod.Name)
 		if len(name) > constants.PodNameTruncateLength {
-			name = name[:constants.PodNameTruncateLengthCompact] + "..."
+			name = append(name[:constants.PodNameTruncateLengthCompact], []rune("...")...)
 		}
 
 		// Add status indicator with emoji
 		statusIndicator := t.getPodStatusIndicator(pod.Phase)
 
-		content.WriteString(fmt.Sprintf("%s%-38s  %s%-7s  %-5s   %s\n",
-			prefix, name, statusIndicator, pod.Phase, pod.Ready, pod.Age))
+		cpu, memory := t.podUsageCells(pod)
+		content.WriteString(fmt.Sprintf("%s%s%s  %s%-7s  %-5s   %-8d  %-6s %-8s %s\n",
+			prefix, t.namespaceCell(pod.Namespace), t.highlightListFilter(fmt.Sprintf("%-38s", string(name))), statusIndicator, pod.Phase, pod.Ready, pod.Restarts, cpu, memory, t.formatTime(pod.CreatedAt, pod.Age)))
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.pods)))
 
 	t.mainContent = content.String()
 
@@ -2144,22 +3348,36 @@ func (t *TUI) updatePodDetails(pod resources.PodInfo) {
 	details.WriteString(fmt.Sprintf("Namespace:  %s\n", pod.Namespace))
 	details.WriteString(fmt.Sprintf("Status:     %s\n", pod.Phase))
 	details.WriteString(fmt.Sprintf("Ready:      %s\n", pod.Ready))
-	details.WriteString(fmt.Sprintf("Restarts:   %d\n", pod.Restarts))
-	details.WriteString(fmt.Sprintf("Age:        %s\n", pod.Age))
+	details.WriteString(fmt.Sprintf("Restarts:   %d%s\n", pod.Restarts, t.renderRestartTrend(pod)))
+	details.WriteString(fmt.Sprintf("Age:        %s\n", t.formatTime(pod.CreatedAt, pod.Age)))
 	details.WriteString(fmt.Sprintf("Node:       %s\n", pod.Node))
 	details.WriteString(fmt.Sprintf("IP:         %s\n", pod.IP))
+	details.WriteString(t.renderLabelChips(pod))
 
 	if len(pod.ContainerInfo) > 0 {
 		details.WriteString("\nContainers:\n")
 		for _, container := range pod.ContainerInfo {
-			status := "🟢"
+			status := t.statusIndicator(statusOK, "🟢")
 			if !container.Ready {
-				status = "🔴"
+				status = t.statusIndicator(statusFailed, "🔴")
 			}
-			details.WriteString(fmt.Sprintf("  %s %s (%s)\n", status, container.Name, container.State))
+			details.WriteString(fmt.Sprintf("  %s %s (%s)%s\n", status, container.Name, container.State, restartSuffix(container)))
 		}
 	}
 
+	if len(pod.InitContainers) > 0 {
+		details.WriteString("\nInit Containers:\n")
+		for _, container := range pod.InitContainers {
+			status := t.statusIndicator(statusOK, "🟢")
+			if container.State != "Terminated" || container.Reason != "Completed" {
+				status = t.statusIndicator(statusFailed, "🔴")
+			}
+			details.WriteString(fmt.Sprintf("  %s %s (%s)%s\n", status, container.Name, container.State, restartSuffix(container)))
+		}
+	}
+
+	details.WriteString(t.renderRelatedEvents("Pod", pod.Name))
+
 	t.detailContent = details.String()
 }
 
@@ -2171,7 +3389,7 @@ func (t *TUI) updateBuildConfigDetails(bc resources.BuildConfigInfo) {
 	details.WriteString(fmt.Sprintf("Namespace:  %s\n", bc.Namespace))
 	details.WriteString(fmt.Sprintf("Status:     %s\n", bc.Status))
 	details.WriteString(fmt.Sprintf("Strategy:   %s\n", bc.Strategy))
-	details.WriteString(fmt.Sprintf("Age:        %s\n", bc.Age))
+	details.WriteString(fmt.Sprintf("Age:        %s\n", t.formatTime(bc.CreatedAt, bc.Age)))
 
 	// Source information
 	details.WriteString("\nSource:\n")
@@ -2189,6 +3407,7 @@ func (t *TUI) updateBuildConfigDetails(bc resources.BuildConfigInfo) {
 	// Output information
 	details.WriteString("\nOutput:\n")
 	details.WriteString(fmt.Sprintf("  To:       %s\n", bc.Output.To))
+	details.WriteString(t.renderDanglingImageRefs("BuildConfig", bc.Namespace, bc.Name))
 
 	// Build statistics
 	details.WriteString("\nBuilds:\n")
@@ -2211,7 +3430,7 @@ func (t *TUI) updateImageStreamDetails(is resources.ImageStreamInfo) {
 
 	details.WriteString(fmt.Sprintf("Namespace:    %s\n", is.Namespace))
 	details.WriteString(fmt.Sprintf("Status:       %s\n", is.Status))
-	details.WriteString(fmt.Sprintf("Age:          %s\n", is.Age))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(is.CreatedAt, is.Age)))
 	details.WriteString(fmt.Sprintf("Repository:   %s\n", is.DockerImageRepository))
 
 	if is.PublicDockerImageRepository != "" {
@@ -2243,7 +3462,7 @@ func (t *TUI) updateRouteDetails(route resources.RouteInfo) {
 	details.WriteString(fmt.Sprintf("Namespace:    %s\n", route.Namespace))
 	details.WriteString(fmt.Sprintf("Status:       %s\n", route.Status))
 	details.WriteString(fmt.Sprintf("Host:         %s\n", route.Host))
-	details.WriteString(fmt.Sprintf("Age:          %s\n", route.Age))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(route.CreatedAt, route.Age)))
 
 	if route.Path != "" {
 		details.WriteString(fmt.Sprintf("Path:         %s\n", route.Path))
@@ -2273,6 +3492,10 @@ func (t *TUI) updateRouteDetails(route resources.RouteInfo) {
 		details.WriteString(fmt.Sprintf("Wildcard:     %s\n", route.WildcardPolicy))
 	}
 
+	details.WriteString(renderRouteAdmissions(route))
+	details.WriteString(t.renderRouteConflicts(route))
+	details.WriteString(t.renderURLHealthCheck(urlCheckKey("Route", route.Namespace, route.Name)))
+
 	t.detailContent = details.String()
 }
 
@@ -2285,7 +3508,7 @@ func (t *TUI) updateServiceDetails(svc resources.ServiceInfo) {
 	details.WriteString(fmt.Sprintf("Status:       %s\n", svc.Status))
 	details.WriteString(fmt.Sprintf("Type:         %s\n", svc.Type))
 	details.WriteString(fmt.Sprintf("Cluster IP:   %s\n", svc.ClusterIP))
-	details.WriteString(fmt.Sprintf("Age:          %s\n", svc.Age))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(svc.CreatedAt, svc.Age)))
 
 	if len(svc.ExternalIPs) > 0 {
 		details.WriteString(fmt.Sprintf("External IPs: %s\n", strings.Join(svc.ExternalIPs, ", ")))
@@ -2315,7 +3538,7 @@ func (t *TUI) updateDeploymentDetails(deploy resources.DeploymentInfo) {
 	details.WriteString(fmt.Sprintf("Namespace:    %s\n", deploy.Namespace))
 	details.WriteString(fmt.Sprintf("Status:       %s\n", deploy.Status))
 	details.WriteString(fmt.Sprintf("Strategy:     %s\n", deploy.Strategy))
-	details.WriteString(fmt.Sprintf("Age:          %s\n", deploy.Age))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(deploy.CreatedAt, deploy.Age)))
 
 	// Replica information
 	details.WriteString("\nReplicas:\n")
@@ -2329,6 +3552,12 @@ func (t *TUI) updateDeploymentDetails(deploy resources.DeploymentInfo) {
 		details.WriteString(fmt.Sprintf("\nCondition:    %s\n", deploy.Condition))
 	}
 
+	details.WriteString(t.renderDanglingImageRefs("Deployment", deploy.Namespace, deploy.Name))
+
+	details.WriteString(t.renderRolloutStatus(deploy.Name))
+	details.WriteString(t.renderRightSizing("Deployment", deploy.Name))
+	details.WriteString(t.renderRelatedEvents("Deployment", deploy.Name))
+
 	t.detailContent = details.String()
 }
 
@@ -2340,7 +3569,7 @@ func (t *TUI) updateConfigMapDetails(cm resources.ConfigMapInfo) {
 	details.WriteString(fmt.Sprintf("Namespace:    %s\n", cm.Namespace))
 	details.WriteString(fmt.Sprintf("Status:       %s\n", cm.Status))
 	details.WriteString(fmt.Sprintf("Data Count:   %d\n", cm.DataCount))
-	details.WriteString(fmt.Sprintf("Age:          %s\n", cm.Age))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(cm.CreatedAt, cm.Age)))
 
 	// Labels information
 	if len(cm.Labels) > 0 {
@@ -2374,7 +3603,7 @@ func (t *TUI) updateSecretDetails(secret resources.SecretInfo) {
 	details.WriteString(fmt.Sprintf("Status:       %s\n", secret.Status))
 	details.WriteString(fmt.Sprintf("Type:         %s\n", secret.Type))
 	details.WriteString(fmt.Sprintf("Data Count:   %d\n", secret.DataCount))
-	details.WriteString(fmt.Sprintf("Age:          %s\n", secret.Age))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(secret.CreatedAt, secret.Age)))
 
 	// Security notice for secrets
 	details.WriteString("\n🔒 Security:\n")
@@ -2407,6 +3636,14 @@ func (t *TUI) updateSecretDetails(secret resources.SecretInfo) {
 // Project-related message types
 type ProjectListLoadedMsg struct {
 	Projects []projects.ProjectInfo
+	Continue string
+}
+
+// ProjectPageLoadedMsg carries a further page of projects for the project modal
+type ProjectPageLoadedMsg struct {
+	Projects []projects.ProjectInfo
+	Continue string
+	Err      error
 }
 
 type ProjectSwitchedMsg struct {
@@ -2426,22 +3663,12 @@ type RetrySuccessMsg struct{}
 // ManualRetryMsg is sent when user manually triggers retry
 type ManualRetryMsg struct{}
 
-// PodLogsLoaded is sent when pod logs are successfully loaded
-type PodLogsLoaded struct {
-	Logs    []string
-	PodName string
-}
-
-// PodLogsRefreshed is sent when pod logs are refreshed with new content
+// PodLogsRefreshed is sent by the pod log stream as new lines arrive
 type PodLogsRefreshed struct {
-	Logs    []string
-	PodName string
-}
-
-// PodLogsError is sent when pod logs loading fails
-type PodLogsError struct {
-	Err     error
-	PodName string
+	Logs      []string
+	PodName   string
+	Container string
+	Stream    int // ID of the stream that produced the lines
 }
 
 // openProjectModal opens the project switching modal
@@ -2450,6 +3677,13 @@ func (t *TUI) openProjectModal() tea.Cmd {
 	t.loadingProjects = true
 	t.switchingProject = false
 	t.projectError = ""                                                                                   // Clear any previous errors
+	t.projectFilter = ""
+	t.projectErrorDetail = nil
+	t.creatingProject = false
+	t.closeProjectForm()
+	t.closeProjectDelete()
+	t.projectContinue = ""
+	t.loadingMoreProjects = false
 	t.projectModalHeight = min(t.height-constants.ProjectModalMinHeight, constants.ProjectModalMaxHeight) // Leave space for borders and headers
 
 	return tea.Batch(
@@ -2465,18 +3699,20 @@ func (t *TUI) loadProjectList() tea.Cmd {
 			return ProjectErrorMsg{Error: "Project manager not initialized"}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
-		defer cancel()
-
-		projectList, err := t.projectManager.List(ctx, projects.ListOptions{
-			IncludeQuotas: false, // Don't load quotas for the list view
-			IncludeLimits: false,
+		// Only the first page is loaded up front; the rest is fetched lazily so
+		// clusters with thousands of projects don't block the modal
+		page, err := loadWithRetry(t, "projects", func(ctx context.Context) (*projects.ProjectPage, error) {
+			return t.projectManager.ListPage(ctx, projects.ListOptions{
+				IncludeQuotas: false, // Don't load quotas for the list view
+				IncludeLimits: false,
+				Limit:         constants.ProjectPageSize,
+			})
 		})
 		if err != nil {
 			return ProjectErrorMsg{Error: fmt.Sprintf("Failed to load projects: %v", err)}
 		}
 
-		return ProjectListLoadedMsg{Projects: projectList}
+		return ProjectListLoadedMsg{Projects: page.Items, Continue: page.Continue}
 	})
 }
 
@@ -2501,55 +3737,113 @@ func (t *TUI) getCurrentProject() tea.Cmd {
 
 // handleProjectModalKeys handles keyboard input when the project modal is open
 func (t *TUI) handleProjectModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	if t.loadingProjects || t.switchingProject {
-		// Only allow escape while loading or switching
+	if t.loadingProjects || t.switchingProject || t.creatingProject || t.deletingProject {
+		// Only allow escape while loading, switching, creating or deleting
 		if msg.String() == "esc" {
 			t.showProjectModal = false
 			t.loadingProjects = false
 			t.switchingProject = false
+			t.creatingProject = false
+			t.deletingProject = false
+			t.closeProjectForm()
+			t.closeProjectDelete()
 			t.updateMainContent() // Ensure tabs are visible when modal closes
 			return t, nil
 		}
 		return t, nil
 	}
+	if t.showProjectForm {
+		return t.handleProjectFormKeys(msg)
+	}
+	if t.deleteProjectName != "" {
+		return t.handleProjectDeleteKeys(msg)
+	}
+
+	visible := t.visibleProjects()
 
 	switch msg.String() {
 	case "esc":
+		// Clear the search first, close on a second esc
+		if t.projectFilter != "" {
+			t.setProjectFilter("")
+			return t, nil
+		}
 		t.showProjectModal = false
 		t.updateMainContent() // Ensure tabs are visible when modal closes
 		return t, nil
 
 	case "enter":
+		// Request a new project when the search matches nothing
+		if t.canCreateProject() {
+			return t, t.createProject(strings.TrimSpace(t.projectFilter), projects.CreateOptions{})
+		}
 		// Switch to selected project (prevent double-switching)
-		if !t.switchingProject && len(t.projectList) > 0 && t.selectedProject >= 0 && t.selectedProject < len(t.projectList) {
+		if !t.switchingProject && len(visible) > 0 && t.selectedProject >= 0 && t.selectedProject < len(visible) {
 			t.switchingProject = true
 			t.projectError = "" // Clear error when attempting a switch
-			return t, t.switchToProject(t.projectList[t.selectedProject])
+			t.projectErrorDetail = nil
+			return t, t.switchToProject(visible[t.selectedProject])
 		}
 		return t, nil
 
-	case "j", "down":
-		if len(t.projectList) > 0 {
-			t.selectedProject = (t.selectedProject + 1) % len(t.projectList)
+	case "down", "ctrl+n":
+		if len(visible) > 0 {
+			t.selectedProject = (t.selectedProject + 1) % len(visible)
 			// Don't clear error - let user navigate while seeing the error
 		}
-		return t, nil
+		return t, t.maybeLoadMoreProjects()
 
-	case "k", "up":
-		if len(t.projectList) > 0 {
+	case "up", "ctrl+p":
+		if len(visible) > 0 {
 			t.selectedProject = t.selectedProject - 1
 			if t.selectedProject < 0 {
-				t.selectedProject = len(t.projectList) - 1
+				t.selectedProject = len(visible) - 1
 			}
 			// Don't clear error - let user navigate while seeing the error
 		}
 		return t, nil
 
-	case "r":
+	case "+":
+		// Project names cannot contain +, so it is never part of a search
+		return t, t.openProjectForm()
+
+	case "*":
+		// Like +, * cannot be part of a project name
+		t.toggleFavoriteProject()
+		return t, nil
+
+	case projectQuotasKey:
+		if len(visible) > 0 && t.selectedProject >= 0 && t.selectedProject < len(visible) {
+			return t, t.openProjectQuotas(visible[t.selectedProject].Name)
+		}
+		return t, nil
+
+	case "ctrl+d":
+		if len(visible) > 0 && t.selectedProject >= 0 && t.selectedProject < len(visible) {
+			t.openProjectDelete(visible[t.selectedProject].Name)
+		}
+		return t, nil
+
+	case "ctrl+r":
 		// Refresh project list and clear errors
 		t.loadingProjects = true
+		t.loadingMoreProjects = false
+		t.projectContinue = ""
 		t.projectError = ""
 		return t, t.loadProjectList()
+
+	case "backspace":
+		if t.projectFilter != "" {
+			runes := []rune(t.projectFilter)
+			t.setProjectFilter(string(runes[:len(runes)-1]))
+		}
+		return t, nil
+	}
+
+	// Any other printable input narrows the list
+	if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+		t.setProjectFilter(t.projectFilter + string(msg.Runes))
+		return t, t.maybeLoadMoreProjects()
 	}
 
 	return t, nil
@@ -2595,6 +3889,13 @@ func (t *TUI) switchToProject(project projects.ProjectInfo) tea.Cmd {
 
 // renderProjectModal renders the project switching modal
 func (t *TUI) renderProjectModal() string {
+	if t.showProjectForm {
+		return t.renderProjectForm()
+	}
+	if t.deleteProjectName != "" {
+		return t.renderProjectDelete()
+	}
+
 	modalWidth := min(t.width-constants.ProjectModalMinWidth, constants.ProjectModalMaxWidth)
 	modalHeight := t.projectModalHeight
 
@@ -2632,33 +3933,81 @@ func (t *TUI) renderProjectModal() string {
 			errorMsg = errorMsg[:maxErrorLen-3] + "..."
 		}
 
-		content.WriteString("❌ Switch Failed\n\n")
+		title := t.projectErrorTitle
+		if title == "" {
+			title = "Switch Failed"
+		}
+		content.WriteString(fmt.Sprintf("❌ %s\n\n", title))
 		content.WriteString(fmt.Sprintf("%s\n\n", errorMsg))
 
+		// Explain what is needed, e.g. the role required to request projects
+		for _, line := range t.projectErrorDetail {
+			content.WriteString(fmt.Sprintf("%s\n", truncateString(line, maxErrorLen)))
+		}
+		if len(t.projectErrorDetail) > 0 {
+			content.WriteString("\n")
+		}
+
 		// Still show project list even with error so user can try another project
 		if len(t.projectList) > 0 {
 			content.WriteString("Select a different project:\n\n")
 		}
 	}
 
+	visible := t.visibleProjects()
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+
+	// Search line
+	if !t.loadingProjects && !t.switchingProject && !t.creatingProject {
+		if t.projectFilter != "" {
+			content.WriteString(fmt.Sprintf("🔍 %s▏\n\n", t.projectFilter))
+		} else {
+			content.WriteString(dimStyle.Render("🔍 type to search") + "\n\n")
+		}
+	}
+
 	if t.loadingProjects {
 		content.WriteString("Loading projects...")
 	} else if t.switchingProject {
 		selectedProject := ""
-		if len(t.projectList) > 0 && t.selectedProject >= 0 && t.selectedProject < len(t.projectList) {
-			selectedProject = t.projectList[t.selectedProject].Name
+		if len(visible) > 0 && t.selectedProject >= 0 && t.selectedProject < len(visible) {
+			selectedProject = visible[t.selectedProject].Name
 		}
 		content.WriteString(fmt.Sprintf("Switching to: %s\n\nPlease wait...", selectedProject))
+	} else if t.creatingProject {
+		content.WriteString(fmt.Sprintf("Requesting project: %s\n\nPlease wait...", t.creatingProjectName))
 	} else if len(t.projectList) == 0 && t.projectError == "" {
 		content.WriteString("No projects found")
-	} else if len(t.projectList) > 0 {
+	} else if len(visible) == 0 && t.projectFilter != "" {
+		if t.loadingMoreProjects {
+			content.WriteString("No matches yet, loading more projects...")
+		} else {
+			content.WriteString(fmt.Sprintf("No projects match '%s'", t.projectFilter))
+			if t.canCreateProject() {
+				content.WriteString(fmt.Sprintf("\n\nPress enter to request a new project '%s'", strings.TrimSpace(t.projectFilter)))
+			}
+		}
+	} else if len(visible) > 0 {
 		// List projects
-		maxItems := modalHeight - 6 // Account for header, footer, padding
+		maxItems := modalHeight - 10 // Account for header, search, description, footer, padding
+		// Favorites and recent projects are listed under section headers
+		sectioned := t.projectSection(visible[0].Name) != projectSectionAll
+		if sectioned {
+			maxItems -= len(projectSectionTitles)
+		}
+		maxItems = max(maxItems, 1)
 		startIdx := max(0, t.selectedProject-maxItems/2)
-		endIdx := min(len(t.projectList), startIdx+maxItems)
+		endIdx := min(len(visible), startIdx+maxItems)
 
+		section := -1
 		for i := startIdx; i < endIdx; i++ {
-			project := t.projectList[i]
+			project := visible[i]
+			if sectioned {
+				if s := t.projectSection(project.Name); s != section {
+					section = s
+					content.WriteString(dimStyle.Render(projectSectionTitles[s]) + "\n")
+				}
+			}
 
 			prefix := "  "
 			if i == t.selectedProject {
@@ -2685,9 +4034,22 @@ func (t *TUI) renderProjectModal() string {
 			content.WriteString(line + "\n")
 		}
 
+		// Description of the selected project
+		if t.selectedProject >= 0 && t.selectedProject < len(visible) && visible[t.selectedProject].Description != "" {
+			description := truncateString(visible[t.selectedProject].Description, modalWidth-6)
+			content.WriteString("\n" + dimStyle.Render(description) + "\n")
+		}
+
 		// Show scroll indicator if needed
-		if len(t.projectList) > maxItems {
-			content.WriteString(fmt.Sprintf("\n[%d/%d projects]", t.selectedProject+1, len(t.projectList)))
+		more := ""
+		if t.projectContinue != "" {
+			more = "+"
+		}
+		if len(visible) > maxItems || more != "" {
+			content.WriteString(fmt.Sprintf("\n[%d/%d%s projects]", t.selectedProject+1, len(visible), more))
+		}
+		if t.loadingMoreProjects {
+			content.WriteString(dimStyle.Render(" loading more..."))
 		}
 	}
 
@@ -2697,10 +4059,12 @@ func (t *TUI) renderProjectModal() string {
 		content.WriteString("Press 'esc' to cancel")
 	} else if t.switchingProject {
 		content.WriteString("Switching project... • esc: cancel")
+	} else if t.creatingProject {
+		content.WriteString("Requesting project... • esc: close")
 	} else if t.projectError != "" {
-		content.WriteString("↑↓/j,k: select different • enter: try selected • r: refresh • esc: cancel")
+		content.WriteString("type: search • ↑↓: select different • enter: try selected • *: star • +: new • %: quotas • ctrl+d: delete • ctrl+r: refresh • esc: cancel")
 	} else {
-		content.WriteString("↑↓/j,k: navigate • enter: switch • r: refresh • esc: cancel")
+		content.WriteString("type: search • ↑↓: navigate • enter: switch • *: star • +: new • %: quotas • ctrl+d: delete • ctrl+r: refresh • esc: clear/cancel")
 	}
 
 	modal := modalStyle.Render(content.String())
@@ -2711,11 +4075,11 @@ func (t *TUI) renderProjectModal() string {
 
 // renderSecretModal renders the secret data viewing modal
 func (t *TUI) renderSecretModal() string {
-	if t.secretModalData == nil || len(t.secretModalKeys) == 0 {
+	if t.secretModalData == nil {
 		return t.renderMain()
 	}
 
-	primaryColor, _ := t.getThemeColors()
+	primaryColor, errorColor := t.getThemeColors()
 
 	// Modal dimensions
 	modalWidth := min(80, t.width-4)
@@ -2739,6 +4103,9 @@ func (t *TUI) renderSecretModal() string {
 		title += " (visible - press 'm' to mask)"
 	}
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(title) + "\n\n")
+	if len(t.secretModalKeys) == 0 {
+		content.WriteString("No data\n")
+	}
 
 	// Keys and values
 	maxDisplayKeys := modalHeight - 8 // Leave room for title, instructions, etc.
@@ -2777,8 +4144,16 @@ func (t *TUI) renderSecretModal() string {
 
 	// Instructions
 	content.WriteString("\n")
-	content.WriteString("j/k: navigate • m: toggle mask • c: copy selected • C: copy all as JSON\n")
-	content.WriteString("esc/q: close")
+	if t.showSecretKeyPrompt {
+		content.WriteString("New key: " + t.secretKeyInput.View() + "\n")
+		if t.secretKeyError != "" {
+			content.WriteString(lipgloss.NewStyle().Foreground(errorColor).Render(truncateString(t.secretKeyError, modalWidth-10)) + "\n")
+		}
+		content.WriteString("enter: write its value in $EDITOR • esc: cancel")
+	} else {
+		content.WriteString("j/k: navigate • m: toggle mask • c: copy selected • C: copy all as JSON\n")
+		content.WriteString("e: edit value in $EDITOR • n: new key • esc/q: close")
+	}
 
 	modal := modalStyle.Render(content.String())
 	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
@@ -2823,6 +4198,7 @@ func (t *TUI) initializeProjectManager() {
 	}
 
 	t.projectManager = manager
+	t.applyProjectSwitching()
 	logging.Info(t.Logger, "✅ Project manager initialized for %s", manager.GetClusterType())
 
 	// Load current project info
@@ -2838,6 +4214,23 @@ func (t *TUI) initializeProjectManager() {
 	}()
 }
 
+// projectsInMemory reports whether project switches leave the kubeconfig
+// file alone, which the settings turn off
+func (t *TUI) projectsInMemory() bool {
+	return !t.prefs.KubeconfigNamespaceEnabled()
+}
+
+// applyProjectSwitching makes the project manager switch projects in memory,
+// from the current namespace, or in the kubeconfig as the settings say
+func (t *TUI) applyProjectSwitching() {
+	if t.projectFactory != nil {
+		t.projectFactory.SetInMemory(t.projectsInMemory(), t.namespace)
+	}
+	if switcher, ok := t.projectManager.(projects.InMemorySwitcher); ok {
+		switcher.SetInMemory(t.projectsInMemory(), t.namespace)
+	}
+}
+
 // getProjectDisplayInfo returns formatted project context information for display
 func (t *TUI) getProjectDisplayInfo() string {
 	if t.currentProject == nil {
@@ -2908,8 +4301,8 @@ func (t *TUI) executeRecoveryAction(action *errors.RecoveryAction) tea.Cmd {
 		// Close modal and trigger reconnection
 		t.showErrorModal = false
 		if !t.connected && !t.connecting {
-			t.logContent = append(t.logContent, "🔄 Manual reconnection initiated...")
-			return t.InitializeK8sClient(t.KubeconfigPath)
+			t.logInfo(categoryConnection, "Manual reconnection initiated...")
+			return t.reconnect()
 		}
 
 	case "Refresh Resources":
@@ -2942,7 +4335,7 @@ func (t *TUI) executeRecoveryAction(action *errors.RecoveryAction) tea.Cmd {
 				t.loadProjectList(),
 			)
 		} else {
-			return t.InitializeK8sClient(t.KubeconfigPath)
+			return t.reconnect()
 		}
 	}
 
@@ -2996,29 +4389,6 @@ func (t *TUI) getLogPageSize() int {
 
 // Log coloring helper functions
 
-// colorizeAppLog applies color to app log messages based on content
-func (t *TUI) colorizeAppLog(logLine string) string {
-	// Define brighter, more readable color styles
-	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true) // Bright red + bold
-	warnStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))             // Orange
-	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("46"))           // Bright green
-	infoStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("81"))              // Bright blue
-
-	// Apply colors based on content patterns
-	switch {
-	case strings.Contains(logLine, "❌") || strings.Contains(logLine, "Failed") || strings.Contains(logLine, "Error"):
-		return errorStyle.Render(logLine)
-	case strings.Contains(logLine, "⟳") || strings.Contains(logLine, "retry") || strings.Contains(logLine, "Retry"):
-		return warnStyle.Render(logLine)
-	case strings.Contains(logLine, "✓") || strings.Contains(logLine, "Connected") || strings.Contains(logLine, "Loaded") || strings.Contains(logLine, "Switched"):
-		return successStyle.Render(logLine)
-	case strings.Contains(logLine, "🔄") || strings.Contains(logLine, "●") || strings.Contains(logLine, "Loading"):
-		return infoStyle.Render(logLine)
-	default:
-		return logLine // No coloring for neutral messages
-	}
-}
-
 // colorizePodLog applies color to pod log lines based on log level patterns
 func (t *TUI) colorizePodLog(logLine string) string {
 	// Define brighter, more readable color styles
@@ -3124,14 +4494,16 @@ func (t *TUI) updateBuildConfigDisplay() {
 	content.WriteString("🔨 BuildConfigs\n\n")
 
 	// Header
-	header := fmt.Sprintf("%-30s %-20s %-15s %-10s %s", "NAME", "SOURCE", "STRATEGY", "BUILDS", "AGE")
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-20s %-15s %-10s %s", "NAME", "SOURCE", "STRATEGY", "BUILDS", "AGE")
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("-", 90))
 	content.WriteString("\n")
 
 	// BuildConfig rows
-	for i, bc := range t.buildConfigs {
+	start, end := t.listWindow(t.selectedBuildConfig, len(t.buildConfigs))
+	for i := start; i < end; i++ {
+		bc := t.buildConfigs[i]
 		style := lipgloss.NewStyle()
 		if i == t.selectedBuildConfig {
 			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
@@ -3144,17 +4516,18 @@ func (t *TUI) updateBuildConfigDisplay() {
 
 		buildsInfo := fmt.Sprintf("%d/%d", bc.SuccessBuilds, bc.SuccessBuilds+bc.FailedBuilds)
 
-		row := fmt.Sprintf("%-30s %-20s %-15s %-10s %s",
-			truncateString(bc.Name, 30),
+		row := t.namespaceCell(bc.Namespace) + fmt.Sprintf("%-30s %-20s %-15s %-10s %s",
+			truncateString(t.danglingImageCell("BuildConfig", bc.Namespace, bc.Name)+bc.Name, 30),
 			truncateString(sourceType, 20),
 			truncateString(bc.Strategy, 15),
 			buildsInfo,
-			bc.Age,
+			t.formatTime(bc.CreatedAt, bc.Age),
 		)
 
-		content.WriteString(style.Render(row))
+		content.WriteString(style.Render(t.highlightListFilter(row)))
 		content.WriteString("\n")
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.buildConfigs)))
 
 	// Instructions
 	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to trigger build • Press 'r' to refresh")
@@ -3182,14 +4555,16 @@ func (t *TUI) updateImageStreamDisplay() {
 	content.WriteString("🖼️ ImageStreams\n\n")
 
 	// Header
-	header := fmt.Sprintf("%-35s %-40s %-8s %s", "NAME", "DOCKER REPOSITORY", "TAGS", "AGE")
+	header := t.namespaceHeader() + fmt.Sprintf("%-35s %-40s %-8s %s", "NAME", "DOCKER REPOSITORY", "TAGS", "AGE")
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("-", 95))
 	content.WriteString("\n")
 
 	// ImageStream rows
-	for i, is := range t.imageStreams {
+	start, end := t.listWindow(t.selectedImageStream, len(t.imageStreams))
+	for i := start; i < end; i++ {
+		is := t.imageStreams[i]
 		style := lipgloss.NewStyle()
 		if i == t.selectedImageStream {
 			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
@@ -3198,16 +4573,17 @@ func (t *TUI) updateImageStreamDisplay() {
 		tagCount := len(is.Tags)
 		repo := truncateString(is.DockerImageRepository, 40)
 
-		row := fmt.Sprintf("%-35s %-40s %-8d %s",
+		row := t.namespaceCell(is.Namespace) + fmt.Sprintf("%-35s %-40s %-8d %s",
 			truncateString(is.Name, 35),
 			repo,
 			tagCount,
-			is.Age,
+			t.formatTime(is.CreatedAt, is.Age),
 		)
 
-		content.WriteString(style.Render(row))
+		content.WriteString(style.Render(t.highlightListFilter(row)))
 		content.WriteString("\n")
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.imageStreams)))
 
 	// Instructions
 	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for tag details • Press 'r' to refresh")
@@ -3235,17 +4611,22 @@ func (t *TUI) updateRouteDisplay() {
 	content.WriteString("🛣️ Routes\n\n")
 
 	// Header
-	header := fmt.Sprintf("%-25s %-40s %-20s %-8s %s", "NAME", "HOST", "SERVICE", "TLS", "AGE")
+	header := t.namespaceHeader() + fmt.Sprintf("%-25s %-40s %-20s %-8s %-16s %s", "NAME", "HOST", "SERVICE", "TLS", "ROUTER", "AGE")
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("-", 100))
 	content.WriteString("\n")
 
 	// Route rows
-	for i, route := range t.routes {
+	start, end := t.listWindow(t.selectedRoute, len(t.routes))
+	for i := start; i < end; i++ {
+		route := t.routes[i]
 		style := lipgloss.NewStyle()
+		if route.Status != "Admitted" {
+			style = style.Foreground(lipgloss.Color("9"))
+		}
 		if i == t.selectedRoute {
-			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+			style = lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
 		}
 
 		tlsStatus := "None"
@@ -3253,17 +4634,19 @@ func (t *TUI) updateRouteDisplay() {
 			tlsStatus = route.TLS.Termination
 		}
 
-		row := fmt.Sprintf("%-25s %-40s %-20s %-8s %s",
-			truncateString(route.Name, 25),
+		row := t.namespaceCell(route.Namespace) + fmt.Sprintf("%-25s %-40s %-20s %-8s %-16s %s",
+			truncateString(t.routeConflictCell(route)+route.Name, 25),
 			truncateString(route.Host, 40),
 			truncateString(route.Service.Name, 20),
 			tlsStatus,
-			route.Age,
+			truncateString(routeRouterColumn(route), 16),
+			t.formatTime(route.CreatedAt, route.Age),
 		)
 
-		content.WriteString(style.Render(row))
+		content.WriteString(style.Render(t.highlightListFilter(row)))
 		content.WriteString("\n")
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.routes)))
 
 	// Instructions
 	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'r' to refresh")
@@ -3273,6 +4656,7 @@ func (t *TUI) updateRouteDisplay() {
 	// Update detail panel with selected Route info
 	if t.selectedRoute < len(t.routes) && t.selectedRoute >= 0 {
 		t.updateRouteDetails(t.routes[t.selectedRoute])
+		t.badgeRoutePods(t.routes[t.selectedRoute])
 	}
 }
 
@@ -3292,14 +4676,17 @@ func (t *TUI) updateServiceDisplay() {
 	content.WriteString("🔗 Services\n\n")
 
 	// Header
-	header := fmt.Sprintf("%-30s %-15s %-20s %-30s %s", "NAME", "TYPE", "CLUSTER-IP", "PORTS", "AGE")
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-15s %-20s %-30s %s",
+		t.sortTitle(1, "name", "NAME"), t.sortTitle(1, "type", "TYPE"), "CLUSTER-IP", "PORTS", t.sortTitle(1, "age", "AGE"))
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("-", 100))
 	content.WriteString("\n")
 
 	// Service rows
-	for i, svc := range t.services {
+	start, end := t.listWindow(t.selectedService, len(t.services))
+	for i := start; i < end; i++ {
+		svc := t.services[i]
 		style := lipgloss.NewStyle()
 		if i == t.selectedService {
 			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
@@ -3310,17 +4697,18 @@ func (t *TUI) updateServiceDisplay() {
 			ports = ports[:27] + "..."
 		}
 
-		row := fmt.Sprintf("%-30s %-15s %-20s %-30s %s",
+		row := t.namespaceCell(svc.Namespace) + fmt.Sprintf("%-30s %-15s %-20s %-30s %s",
 			truncateString(svc.Name, 30),
 			svc.Type,
 			truncateString(svc.ClusterIP, 20),
 			ports,
-			svc.Age,
+			t.formatTime(svc.CreatedAt, svc.Age),
 		)
 
-		content.WriteString(style.Render(row))
+		content.WriteString(style.Render(t.highlightListFilter(row)))
 		content.WriteString("\n")
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.services)))
 
 	// Instructions
 	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'r' to refresh")
@@ -3330,6 +4718,7 @@ func (t *TUI) updateServiceDisplay() {
 	// Update detail panel with selected Service info
 	if t.selectedService < len(t.services) && t.selectedService >= 0 {
 		t.updateServiceDetails(t.services[t.selectedService])
+		t.badgeServicePods(t.services[t.selectedService])
 	}
 }
 
@@ -3346,17 +4735,20 @@ func (t *TUI) updateDeploymentDisplay() {
 	}
 
 	var content strings.Builder
-	content.WriteString("🚀 Deployments\n\n")
+	content.WriteString("🚀 Deployments" + t.batchLegend(models.TabDeployments) + "\n\n")
 
 	// Header
-	header := fmt.Sprintf("%-30s %-10s %-10s %-10s %-15s %s", "NAME", "READY", "UP-TO-DATE", "AVAILABLE", "STRATEGY", "AGE")
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-10s %-10s %-10s %-15s %s",
+		t.sortTitle(2, "name", "NAME"), t.sortTitle(2, "ready", "READY"), "UP-TO-DATE", "AVAILABLE", "STRATEGY", t.sortTitle(2, "age", "AGE"))
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("-", 95))
 	content.WriteString("\n")
 
 	// Deployment rows
-	for i, deploy := range t.deployments {
+	start, end := t.listWindow(t.selectedDeployment, len(t.deployments))
+	for i := start; i < end; i++ {
+		deploy := t.deployments[i]
 		style := lipgloss.NewStyle()
 		if i == t.selectedDeployment {
 			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
@@ -3364,21 +4756,22 @@ func (t *TUI) updateDeploymentDisplay() {
 
 		ready := fmt.Sprintf("%d/%d", deploy.ReadyReplicas, deploy.Replicas)
 
-		row := fmt.Sprintf("%-30s %-10s %-10d %-10d %-15s %s",
-			truncateString(deploy.Name, 30),
+		row := t.namespaceCell(deploy.Namespace) + fmt.Sprintf("%-30s %-10s %-10d %-10d %-15s %s",
+			truncateString(t.batchMarkCell(models.TabDeployments, deploy.Namespace, deploy.Name)+t.danglingImageCell("Deployment", deploy.Namespace, deploy.Name)+deploy.Name, 30),
 			ready,
 			deploy.UpdatedReplicas,
 			deploy.AvailableReplicas,
 			truncateString(deploy.Strategy, 15),
-			deploy.Age,
+			t.formatTime(deploy.CreatedAt, deploy.Age),
 		)
 
-		content.WriteString(style.Render(row))
+		content.WriteString(style.Render(t.highlightListFilter(row)))
 		content.WriteString("\n")
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.deployments)))
 
 	// Instructions
-	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'r' to refresh")
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'R' to rollout restart")
 
 	t.mainContent = content.String()
 
@@ -3404,28 +4797,31 @@ func (t *TUI) updateConfigMapDisplay() {
 	content.WriteString("⚙️ ConfigMaps\n\n")
 
 	// Header
-	header := fmt.Sprintf("%-30s %-10s %s", "NAME", "DATA", "AGE")
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-10s %s", "NAME", "DATA", "AGE")
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("-", 50))
 	content.WriteString("\n")
 
 	// ConfigMap rows
-	for i, cm := range t.configMaps {
+	start, end := t.listWindow(t.selectedConfigMap, len(t.configMaps))
+	for i := start; i < end; i++ {
+		cm := t.configMaps[i]
 		style := lipgloss.NewStyle()
 		if i == t.selectedConfigMap {
 			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
 		}
 
-		row := fmt.Sprintf("%-30s %-10d %s",
+		row := t.namespaceCell(cm.Namespace) + fmt.Sprintf("%-30s %-10d %s",
 			truncateString(cm.Name, 30),
 			cm.DataCount,
-			cm.Age,
+			t.formatTime(cm.CreatedAt, cm.Age),
 		)
 
-		content.WriteString(style.Render(row))
+		content.WriteString(style.Render(t.highlightListFilter(row)))
 		content.WriteString("\n")
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.configMaps)))
 
 	// Instructions
 	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'r' to refresh")
@@ -3454,29 +4850,32 @@ func (t *TUI) updateSecretDisplay() {
 	content.WriteString("🔐 Secrets\n\n")
 
 	// Header
-	header := fmt.Sprintf("%-30s %-20s %-10s %s", "NAME", "TYPE", "DATA", "AGE")
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-20s %-10s %s", "NAME", "TYPE", "DATA", "AGE")
 	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("-", 70))
 	content.WriteString("\n")
 
 	// Secret rows
-	for i, secret := range t.secrets {
+	start, end := t.listWindow(t.selectedSecret, len(t.secrets))
+	for i := start; i < end; i++ {
+		secret := t.secrets[i]
 		style := lipgloss.NewStyle()
 		if i == t.selectedSecret {
 			style = style.Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
 		}
 
-		row := fmt.Sprintf("%-30s %-20s %-10d %s",
+		row := t.namespaceCell(secret.Namespace) + fmt.Sprintf("%-30s %-20s %-10d %s",
 			truncateString(secret.Name, 30),
 			truncateString(secret.Type, 20),
 			secret.DataCount,
-			secret.Age,
+			t.formatTime(secret.CreatedAt, secret.Age),
 		)
 
-		content.WriteString(style.Render(row))
+		content.WriteString(style.Render(t.highlightListFilter(row)))
 		content.WriteString("\n")
 	}
+	content.WriteString(listWindowFooter(start, end, len(t.secrets)))
 
 	// Instructions
 	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' for details • Press 'r' to refresh")
@@ -3489,17 +4888,19 @@ func (t *TUI) updateSecretDisplay() {
 	}
 }
 
-// Helper function to truncate strings
+// truncateString shortens s to maxLen terminal cells, ending it with "...",
+// without splitting multi-byte or wide characters
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	if ansi.StringWidth(s) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	return ansi.Truncate(s, maxLen, "...")
 }
 
 // handleTabSwitch handles tab switching and auto-loading
 func (t *TUI) handleTabSwitch() tea.Cmd {
 	t.updateMainContent()
+	t.resetAutoRefreshCountdown()
 
 	// Set appropriate log mode based on current tab
 	switch t.ActiveTab {
@@ -3507,48 +4908,113 @@ func (t *TUI) handleTabSwitch() tea.Cmd {
 		if t.showLogs {
 			t.logViewMode = constants.ServiceLogViewMode
 		}
+	case 8: // Builds tab - show the streamed build logs
+		t.logViewMode = constants.BuildLogViewMode
 	default: // All other tabs - use pod logs mode
 		t.logViewMode = constants.PodLogViewMode
 	}
 
-	// Auto-load data for resource tabs if needed
-	if t.connected {
+	// Auto-load data for resource tabs if needed, locked tabs wait for r
+	if t.connected && t.tabNoAccess(int(t.ActiveTab)) == nil {
 		switch t.ActiveTab {
 		case 1: // Services
-			if len(t.services) == 0 && !t.loadingServices {
+			if len(t.allServices) == 0 && !t.loadingServices {
 				t.loadingServices = true
 				return t.loadServices()
 			}
 		case 2: // Deployments
-			if len(t.deployments) == 0 && !t.loadingDeployments {
+			if len(t.allDeployments) == 0 && !t.loadingDeployments {
 				t.loadingDeployments = true
 				return t.loadDeployments()
 			}
+			return t.startRolloutStatusPoll()
 		case 3: // ConfigMaps
-			if len(t.configMaps) == 0 && !t.loadingConfigMaps {
+			if len(t.allConfigMaps) == 0 && !t.loadingConfigMaps {
 				t.loadingConfigMaps = true
 				return t.loadConfigMaps()
 			}
 		case 4: // Secrets
-			if len(t.secrets) == 0 && !t.loadingSecrets {
+			if len(t.allSecrets) == 0 && !t.loadingSecrets {
 				t.loadingSecrets = true
 				return t.loadSecrets()
 			}
 		case 5: // BuildConfigs
-			if len(t.buildConfigs) == 0 && !t.loadingBuildConfigs {
+			if len(t.allBuildConfigs) == 0 && !t.loadingBuildConfigs {
 				t.loadingBuildConfigs = true
 				return t.loadBuildConfigs()
 			}
 		case 6: // ImageStreams
-			if len(t.imageStreams) == 0 && !t.loadingImageStreams {
+			if len(t.allImageStreams) == 0 && !t.loadingImageStreams {
 				t.loadingImageStreams = true
 				return t.loadImageStreams()
 			}
 		case 7: // Routes
-			if len(t.routes) == 0 && !t.loadingRoutes {
+			if len(t.allRoutes) == 0 && !t.loadingRoutes {
 				t.loadingRoutes = true
 				return t.loadRoutes()
 			}
+		case 8: // Builds
+			if len(t.allBuilds) == 0 && !t.loadingBuilds {
+				t.loadingBuilds = true
+				return t.loadBuilds()
+			}
+		case 9: // Events
+			if len(t.allEvents) == 0 && !t.loadingEvents {
+				t.loadingEvents = true
+				return t.loadEvents()
+			}
+		case 10: // StatefulSets
+			if len(t.allStatefulSets) == 0 && !t.loadingStatefulSets {
+				t.loadingStatefulSets = true
+				return t.loadStatefulSets()
+			}
+		case 11: // DaemonSets
+			if len(t.allDaemonSets) == 0 && !t.loadingDaemonSets {
+				t.loadingDaemonSets = true
+				return t.loadDaemonSets()
+			}
+		case 12: // ReplicaSets
+			if len(t.allReplicaSets) == 0 && !t.loadingReplicaSets {
+				t.loadingReplicaSets = true
+				return t.loadReplicaSets()
+			}
+		case 13: // Jobs
+			if len(t.allJobs) == 0 && !t.loadingJobs {
+				t.loadingJobs = true
+				return t.loadJobs()
+			}
+		case 14: // CronJobs
+			if len(t.allCronJobs) == 0 && !t.loadingCronJobs {
+				t.loadingCronJobs = true
+				// Jobs are loaded too, the detail pane lists the ones each CronJob created
+				return tea.Batch(t.loadCronJobs(), t.loadJobs())
+			}
+		case 15: // Nodes
+			if len(t.allNodes) == 0 && !t.loadingNodes {
+				t.loadingNodes = true
+				return t.withNodeUsage(t.withScalingEvents(t.loadNodes()))
+			}
+		case 16: // Storage
+			if len(t.allPVCs) == 0 && !t.loadingPVCs {
+				t.loadingPVCs = true
+				// StorageClasses explain why a claim is still pending
+				return tea.Batch(t.loadPersistentVolumeClaims(), t.loadStorageClasses())
+			}
+		case 17: // Ingresses
+			if len(t.allIngresses) == 0 && !t.loadingIngresses {
+				t.loadingIngresses = true
+				return t.loadIngresses()
+			}
+		case 18: // NetworkPolicies
+			if len(t.allNetworkPolicies) == 0 && !t.loadingNetworkPolicies {
+				t.loadingNetworkPolicies = true
+				return t.loadNetworkPolicies()
+			}
+		case 19: // DeploymentConfigs
+			if len(t.allDeploymentConfigs) == 0 && !t.loadingDeploymentConfigs {
+				t.loadingDeploymentConfigs = true
+				return t.loadDeploymentConfigs()
+			}
 		}
 	}
 
@@ -3569,11 +5035,9 @@ func (t *TUI) loadBuildConfigs() tea.Cmd {
 		resourceClient := resources.NewOpenShiftResourceClient(osClient)
 
 		// Load BuildConfigs
-		listOpts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		listOpts := t.listOptions()
 
-		buildConfigList, err := resourceClient.ListBuildConfigs(context.Background(), listOpts)
+		buildConfigList, err := loadAllPages(t, "buildconfigs", listOpts, resourceClient.ListBuildConfigs)
 		if err != nil {
 			return messages.BuildConfigsLoadError{Err: err}
 		}
@@ -3594,11 +5058,9 @@ func (t *TUI) loadImageStreams() tea.Cmd {
 		resourceClient := resources.NewOpenShiftResourceClient(osClient)
 
 		// Load ImageStreams
-		listOpts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		listOpts := t.listOptions()
 
-		imageStreamList, err := resourceClient.ListImageStreams(context.Background(), listOpts)
+		imageStreamList, err := loadAllPages(t, "imagestreams", listOpts, resourceClient.ListImageStreams)
 		if err != nil {
 			return messages.ImageStreamsLoadError{Err: err}
 		}
@@ -3619,11 +5081,9 @@ func (t *TUI) loadRoutes() tea.Cmd {
 		resourceClient := resources.NewOpenShiftResourceClient(osClient)
 
 		// Load Routes
-		listOpts := resources.ListOptions{
-			Namespace: t.namespace,
-		}
+		listOpts := t.listOptions()
 
-		routeList, err := resourceClient.ListRoutes(context.Background(), listOpts)
+		routeList, err := loadAllPages(t, "routes", listOpts, resourceClient.ListRoutes)
 		if err != nil {
 			return messages.RoutesLoadError{Err: err}
 		}
@@ -3634,6 +5094,10 @@ func (t *TUI) loadRoutes() tea.Cmd {
 
 // handleSecretModalKeys handles key input for the secret modal
 func (t *TUI) handleSecretModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if t.showSecretKeyPrompt {
+		return t.handleSecretKeyPromptKeys(msg)
+	}
+
 	switch msg.String() {
 	case "esc", "q":
 		// Close secret modal
@@ -3675,6 +5139,18 @@ func (t *TUI) handleSecretModalKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "C":
 		// Copy all secret data to clipboard as JSON
 		return t, t.copySecretAsJSON()
+
+	case "e":
+		// Edit the decoded value of the selected key in $EDITOR
+		if len(t.secretModalKeys) > 0 && t.selectedSecretKey < len(t.secretModalKeys) {
+			key := t.secretModalKeys[t.selectedSecretKey]
+			return t, t.editSecretValue(key, t.secretModalData[key])
+		}
+		return t, nil
+
+	case "n":
+		// Add a key, its value is written in $EDITOR
+		return t, t.openSecretKeyPrompt()
 	}
 
 	return t, nil
@@ -3693,7 +5169,7 @@ func (t *TUI) copyToClipboard(text string) tea.Cmd {
 			} else if _, err := exec.LookPath("xsel"); err == nil {
 				cmd = exec.Command("xsel", "--clipboard", "--input")
 			} else {
-				t.logContent = append(t.logContent, "❌ No clipboard tool found (xclip or xsel required)")
+				t.logError(categoryAction, "No clipboard tool found (xclip or xsel required)")
 				return nil
 			}
 		case "darwin":
@@ -3701,16 +5177,16 @@ func (t *TUI) copyToClipboard(text string) tea.Cmd {
 		case "windows":
 			cmd = exec.Command("clip")
 		default:
-			t.logContent = append(t.logContent, "❌ Clipboard not supported on this OS")
+			t.logError(categoryAction, "Clipboard not supported on this OS")
 			return nil
 		}
 
 		if cmd != nil {
 			cmd.Stdin = strings.NewReader(text)
 			if err := cmd.Run(); err != nil {
-				t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to copy to clipboard: %v", err))
+				t.logError(categoryAction, "Failed to copy to clipboard: %v", err)
 			} else {
-				t.logContent = append(t.logContent, "✅ Copied to clipboard")
+				t.logSuccess(categoryAction, "Copied to clipboard")
 			}
 		}
 		return nil
@@ -3726,7 +5202,7 @@ func (t *TUI) copySecretAsJSON() tea.Cmd {
 
 		jsonData, err := json.MarshalIndent(t.secretModalData, "", "  ")
 		if err != nil {
-			t.logContent = append(t.logContent, fmt.Sprintf("❌ Failed to serialize secret as JSON: %v", err))
+			t.logError(categoryAction, "Failed to serialize secret as JSON: %v", err)
 			return nil
 		}
 
@@ -3744,134 +5220,6 @@ func (t *TUI) getHeaderHeight() int {
 	return 2
 }
 
-// Real-time log streaming methods
-
-// startPodLogStream initiates real-time log streaming for the current pod
-func (t *TUI) startPodLogStream() tea.Cmd {
-	return func() tea.Msg {
-		if !t.connected || t.resourceClient == nil || len(t.pods) == 0 || t.selectedPod >= len(t.pods) {
-			return messages.PodLogStreamError{
-				PodName:   "",
-				Container: "",
-				Err:       fmt.Errorf("no pod selected or not connected"),
-			}
-		}
-
-		pod := t.pods[t.selectedPod]
-		containerName := ""
-		if len(pod.ContainerInfo) > 0 {
-			containerName = pod.ContainerInfo[0].Name
-		}
-
-		// Stop any existing stream
-		t.stopPodLogStream()
-
-		// Create new context for this stream
-		t.logStreamCtx, t.logStreamCancel = context.WithCancel(context.Background())
-		t.currentPodName = pod.Name
-
-		// Start streaming
-		logChan, err := t.resourceClient.StreamPodLogs(t.logStreamCtx, pod.Namespace, pod.Name, containerName, resources.LogOptions{
-			TailLines: func() *int64 { i := int64(constants.MaxLogLines); return &i }(),
-			Follow:    true,
-		})
-		if err != nil {
-			return messages.PodLogStreamError{
-				PodName:   pod.Name,
-				Container: containerName,
-				Err:       err,
-			}
-		}
-
-		// Start listener goroutine
-		go t.listenForLogUpdates(logChan, pod.Name, containerName)
-
-		return nil // No immediate message needed
-	}
-}
-
-// stopPodLogStream stops the current log stream
-func (t *TUI) stopPodLogStream() {
-	if t.logStreamCancel != nil {
-		t.logStreamCancel()
-		t.logStreamCancel = nil
-		t.logStreamCtx = nil
-		t.currentPodName = ""
-	}
-}
-
-// listenForLogUpdates listens for log updates and sends them as messages
-func (t *TUI) listenForLogUpdates(logChan <-chan string, podName, containerName string) {
-	for {
-		select {
-		case <-t.logStreamCtx.Done():
-			return
-		case logLine, ok := <-logChan:
-			if !ok {
-				// Channel closed, stream ended
-				return
-			}
-			// Send log update message to TUI
-			t.program.Send(messages.PodLogStreamUpdate{
-				PodName:   podName,
-				Container: containerName,
-				LogLine:   logLine,
-			})
-		}
-	}
-}
-
-// handleLogStreamUpdate processes a new log line from the stream
-func (t *TUI) handleLogStreamUpdate(logLine string) {
-	if logLine == "" {
-		return
-	}
-
-	// Add to pod logs with duplicate prevention
-	if !t.seenLogLines[logLine] {
-		t.seenLogLines[logLine] = true
-		t.podLogs = append(t.podLogs, logLine)
-
-		// Maintain maximum log lines
-		if len(t.podLogs) > constants.MaxLogLines {
-			// Remove oldest logs and update seen map
-			removed := t.podLogs[:len(t.podLogs)-constants.MaxLogLines]
-			for _, removedLine := range removed {
-				delete(t.seenLogLines, removedLine)
-			}
-			t.podLogs = t.podLogs[len(t.podLogs)-constants.MaxLogLines:]
-		}
-
-		// Handle scroll behavior based on mode
-		if t.tailMode {
-			// In tail mode, ALWAYS stay at bottom regardless of userScrolled flag
-			t.logScrollOffset = t.getMaxLogScrollOffset()
-			t.userScrolled = false // Ensure we stay in tail mode
-		} else if t.userScrolled && t.anchorLogLine != "" {
-			// Maintain anchor position when user has manually scrolled
-			t.adjustScrollForAnchor()
-		}
-		// If no anchor, scroll position naturally preserves
-
-		// Clear loading state
-		t.loadingLogs = false
-	}
-}
-
-// handleLogStreamError processes streaming errors
-func (t *TUI) handleLogStreamError(err error) {
-	t.loadingLogs = false
-	t.podLogs = append(t.podLogs, fmt.Sprintf("❌ Log streaming error: %v", err))
-	// Try to restart streaming after a delay
-	go func() {
-		time.Sleep(2 * time.Second)
-		if t.program != nil {
-			t.program.Send(messages.RefreshPodLogs{}) // Fallback to polling
-		}
-	}()
-}
-
-
 // Line-based scroll anchoring methods
 
 // updateScrollAnchor sets the anchor to the currently visible top line
@@ -0,0 +1,159 @@
+// Package uitest drives a Bubble Tea program in end-to-end tests: it runs the
+// program without a terminal, sends it keys and waits for text to appear in
+// the rendered output.
+package uitest
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DefaultTimeout bounds WaitFor and Quit
+const DefaultTimeout = 30 * time.Second
+
+// pollInterval is how often WaitFor re-reads the output
+const pollInterval = 20 * time.Millisecond
+
+// ansiSequence matches the escape sequences written by the renderer
+var ansiSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]|\x1b\][^\x07]*\x07`)
+
+// output collects everything the program renders
+type output struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (o *output) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.Write(p)
+}
+
+func (o *output) String() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.String()
+}
+
+// Driver runs a program for a test
+type Driver struct {
+	t       testing.TB
+	program *tea.Program
+	out     *output
+
+	done       chan struct{}
+	finalModel tea.Model
+	err        error
+}
+
+// Start runs the program returned by newProgram, which must pass the given
+// options to tea.NewProgram, and sizes its window to width x height. The
+// program is killed when the test ends if it is still running.
+func Start(t testing.TB, newProgram func(opts ...tea.ProgramOption) *tea.Program, width, height int) *Driver {
+	t.Helper()
+
+	d := &Driver{
+		t:    t,
+		out:  &output{},
+		done: make(chan struct{}),
+	}
+	d.program = newProgram(
+		tea.WithInput(nil),
+		tea.WithOutput(d.out),
+		tea.WithoutSignals(),
+	)
+
+	go func() {
+		defer close(d.done)
+		d.finalModel, d.err = d.program.Run()
+	}()
+	t.Cleanup(func() {
+		d.program.Kill()
+		<-d.done
+	})
+
+	d.program.Send(tea.WindowSizeMsg{Width: width, Height: height})
+	return d
+}
+
+// Send delivers a message to the program
+func (d *Driver) Send(msg tea.Msg) {
+	d.program.Send(msg)
+}
+
+// Type sends every rune of s as a key press
+func (d *Driver) Type(s string) {
+	for _, r := range s {
+		d.program.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+// Press sends special keys such as tea.KeyEnter or tea.KeyTab
+func (d *Driver) Press(keys ...tea.KeyType) {
+	for _, key := range keys {
+		d.program.Send(tea.KeyMsg{Type: key})
+	}
+}
+
+// Output returns everything rendered so far with escape sequences removed
+func (d *Driver) Output() string {
+	return ansiSequence.ReplaceAllString(d.out.String(), "")
+}
+
+// WaitFor waits until condition holds for the rendered output, failing the
+// test after DefaultTimeout
+func (d *Driver) WaitFor(what string, condition func(output string) bool) {
+	d.t.Helper()
+
+	deadline := time.Now().Add(DefaultTimeout)
+	for {
+		if condition(d.Output()) {
+			return
+		}
+		select {
+		case <-d.done:
+			d.t.Fatalf("Program exited while waiting for %s (err: %v)", what, d.err)
+		default:
+		}
+		if time.Now().After(deadline) {
+			d.t.Fatalf("Timed out waiting for %s; last output:\n%s", what, lastLines(d.Output(), 40))
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// WaitForText waits until text appears in the rendered output
+func (d *Driver) WaitForText(text string) {
+	d.t.Helper()
+	d.WaitFor("\""+text+"\"", func(output string) bool {
+		return strings.Contains(output, text)
+	})
+}
+
+// Quit asks the program to quit and returns its final model
+func (d *Driver) Quit() tea.Model {
+	d.t.Helper()
+
+	d.program.Quit()
+	select {
+	case <-d.done:
+	case <-time.After(DefaultTimeout):
+		d.t.Fatalf("Program did not quit within %s", DefaultTimeout)
+	}
+	if d.err != nil {
+		d.t.Fatalf("Program failed: %v", d.err)
+	}
+	return d.finalModel
+}
+
+// lastLines returns the last n lines of s for failure messages
+func lastLines(s string, n int) string {
+	lines := strings.Split(s, "\n")
+	return strings.Join(lines[max(len(lines)-n, 0):], "\n")
+}
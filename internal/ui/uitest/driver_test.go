@@ -0,0 +1,60 @@
+package uitest
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// counter is a minimal model: + increments, enter marks it done
+type counter struct {
+	count int
+	width int
+	done  bool
+}
+
+func (c counter) Init() tea.Cmd { return nil }
+
+func (c counter) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		c.width = msg.Width
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyEnter:
+			c.done = true
+		case tea.KeyRunes:
+			if msg.String() == "+" {
+				c.count++
+			}
+		}
+	}
+	return c, nil
+}
+
+func (c counter) View() string {
+	if c.done {
+		return fmt.Sprintf("done at %d", c.count)
+	}
+	return fmt.Sprintf("count=%d width=%d", c.count, c.width)
+}
+
+func TestDriver(t *testing.T) {
+	d := Start(t, func(opts ...tea.ProgramOption) *tea.Program {
+		return tea.NewProgram(counter{}, opts...)
+	}, 80, 24)
+
+	d.WaitForText("width=80")
+
+	d.Type("+++")
+	d.WaitForText("count=3")
+
+	d.Press(tea.KeyEnter)
+	d.WaitForText("done at 3")
+
+	final, ok := d.Quit().(counter)
+	if !ok || !final.done || final.count != 3 {
+		t.Errorf("Unexpected final model %+v", final)
+	}
+}
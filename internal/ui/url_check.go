@@ -0,0 +1,234 @@
+package ui
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// urlCheck is the latest health check of a route or ingress URL
+type urlCheck struct {
+	pending bool
+	result  messages.URLHealthChecked
+	at      time.Time
+}
+
+// routeURL returns the URL a route serves, or "" for wildcard routes
+func routeURL(route resources.RouteInfo) string {
+	if route.Host == "" || strings.HasPrefix(route.Host, "*") {
+		return ""
+	}
+	scheme := "http"
+	if route.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + route.Host + route.Path
+}
+
+// ingressURL returns the URL of the first rule of an ingress with a host,
+// or "" when every rule matches any host
+func ingressURL(ing resources.IngressInfo) string {
+	for _, rule := range ing.Rules {
+		if rule.Host == "" || strings.HasPrefix(rule.Host, "*") {
+			continue
+		}
+		scheme := "http"
+		if ingressTLS(ing, rule.Host) {
+			scheme = "https"
+		}
+		return scheme + "://" + rule.Host + rule.Path
+	}
+	return ""
+}
+
+// urlCheckKey is the key of the health check of a route or ingress
+func urlCheckKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// selectedURL returns the key and URL of the selected route or ingress
+func (t *TUI) selectedURL() (string, string, bool) {
+	switch t.ActiveTab {
+	case models.TabRoutes:
+		if t.selectedRoute >= 0 && t.selectedRoute < len(t.routes) {
+			route := t.routes[t.selectedRoute]
+			return urlCheckKey("Route", route.Namespace, route.Name), routeURL(route), true
+		}
+	case models.TabIngresses:
+		if t.selectedIngress >= 0 && t.selectedIngress < len(t.ingresses) {
+			ing := t.ingresses[t.selectedIngress]
+			return urlCheckKey("Ingress", ing.Namespace, ing.Name), ingressURL(ing), true
+		}
+	}
+	return "", "", false
+}
+
+// browserCommand returns the command opening a URL in the system browser
+func browserCommand(url string) (*exec.Cmd, error) {
+	switch runtime.GOOS {
+	case "linux", "freebsd", "openbsd":
+		return exec.Command("xdg-open", url), nil
+	case "darwin":
+		return exec.Command("open", url), nil
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url), nil
+	}
+	return nil, fmt.Errorf("opening a browser is not supported on %s", runtime.GOOS)
+}
+
+// openSelectedURL opens the URL of the selected route or ingress in the
+// system browser
+func (t *TUI) openSelectedURL() tea.Cmd {
+	_, url, ok := t.selectedURL()
+	if !ok {
+		return nil
+	}
+	if url == "" {
+		t.logWarn(categoryAction, "No host to open, wildcard hosts cannot be opened")
+		return nil
+	}
+
+	return func() tea.Msg {
+		cmd, err := browserCommand(url)
+		if err != nil {
+			t.logError(categoryAction, "Failed to open %s: %v", url, err)
+			return nil
+		}
+		if err := cmd.Start(); err != nil {
+			t.logError(categoryAction, "Failed to open %s: %v", url, err)
+			return nil
+		}
+		// The browser may keep running, only reap the launcher
+		go cmd.Wait()
+		t.logInfo(categoryAction, "Opened %s", url)
+		return nil
+	}
+}
+
+// checkURL sends a GET to url and returns the response status and the time
+// until the response headers arrived
+func checkURL(ctx context.Context, url string, insecure bool) (int, string, time.Duration, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	client := &http.Client{Transport: transport}
+	defer transport.CloseIdleConnections()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, "", 0, err
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, "", 0, err
+	}
+	latency := time.Since(start)
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+	return resp.StatusCode, resp.Status, latency, nil
+}
+
+// checkSelectedURL runs an HTTP health check of the selected route or
+// ingress. Certificates the system does not trust, common for cluster
+// default certificates, are reported and the check is retried without them.
+func (t *TUI) checkSelectedURL() tea.Cmd {
+	key, url, ok := t.selectedURL()
+	if !ok {
+		return nil
+	}
+	if url == "" {
+		t.logWarn(categoryAction, "No host to check, wildcard hosts cannot be checked")
+		return nil
+	}
+
+	if t.urlChecks == nil {
+		t.urlChecks = make(map[string]urlCheck)
+	}
+	t.urlChecks[key] = urlCheck{pending: true, result: messages.URLHealthChecked{Key: key, URL: url}}
+	t.updateMainContent()
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.URLHealthCheckTimeout)
+		defer cancel()
+
+		result := messages.URLHealthChecked{Key: key, URL: url}
+		code, status, latency, err := checkURL(ctx, url, false)
+		var certErr *tls.CertificateVerificationError
+		if errors.As(err, &certErr) {
+			result.Untrusted = true
+			code, status, latency, err = checkURL(ctx, url, true)
+		}
+		result.StatusCode, result.Status, result.Latency, result.Err = code, status, latency, err
+		return result
+	}
+}
+
+// handleURLHealthChecked stores the result of a health check
+func (t *TUI) handleURLHealthChecked(msg messages.URLHealthChecked) {
+	if t.urlChecks == nil {
+		t.urlChecks = make(map[string]urlCheck)
+	}
+	t.urlChecks[msg.Key] = urlCheck{result: msg, at: time.Now()}
+
+	switch {
+	case msg.Err != nil:
+		t.logError(categoryAction, "Health check of %s failed: %v", msg.URL, msg.Err)
+	case msg.StatusCode >= 500:
+		t.logWarn(categoryAction, "Health check of %s: %s in %s", msg.URL, msg.Status, msg.Latency.Round(time.Millisecond))
+	default:
+		t.logInfo(categoryAction, "Health check of %s: %s in %s", msg.URL, msg.Status, msg.Latency.Round(time.Millisecond))
+	}
+	t.updateMainContent()
+}
+
+// renderURLHealthCheck renders the health check section of the detail pane
+// of a route or ingress, or "" when it was not checked
+func (t *TUI) renderURLHealthCheck(key string) string {
+	check, ok := t.urlChecks[key]
+	if !ok {
+		return ""
+	}
+
+	var section strings.Builder
+	section.WriteString("\nHealth Check:\n")
+	result := check.result
+	switch {
+	case check.pending:
+		section.WriteString(fmt.Sprintf("  %s Checking %s...\n", t.getLoadingSpinner(), result.URL))
+		return section.String()
+	case result.Err != nil:
+		section.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("  ✗ "+truncateString(result.Err.Error(), 70)) + "\n")
+	default:
+		style := lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+		icon := "✓"
+		switch {
+		case result.StatusCode >= 500:
+			style, icon = lipgloss.NewStyle().Foreground(lipgloss.Color("9")), "✗"
+		case result.StatusCode >= 400:
+			style, icon = lipgloss.NewStyle().Foreground(lipgloss.Color("214")), "⚠"
+		}
+		section.WriteString(style.Render(fmt.Sprintf("  %s %s in %s", icon, result.Status, result.Latency.Round(time.Millisecond))) + "\n")
+	}
+	if result.Untrusted {
+		section.WriteString("  ⚠ Certificate not trusted by this machine\n")
+	}
+	section.WriteString(fmt.Sprintf("  %s, %s\n", truncateString(result.URL, 60), check.at.Format("15:04:05")))
+	return section.String()
+}
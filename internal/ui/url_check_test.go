@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestRouteAndIngressURL(t *testing.T) {
+	route := resources.RouteInfo{Host: "shop.apps.example.com", Path: "/api", TLS: &resources.TLSConfig{Termination: "edge"}}
+	if got := routeURL(route); got != "https://shop.apps.example.com/api" {
+		t.Errorf("Expected the https URL of the route, got %s", got)
+	}
+	if got := routeURL(resources.RouteInfo{Host: "*.apps.example.com"}); got != "" {
+		t.Errorf("Expected no URL for a wildcard route, got %s", got)
+	}
+
+	ing := resources.IngressInfo{
+		Rules: []resources.IngressPath{{Path: "/"}, {Host: "shop.example.com", Path: "/cart"}},
+		TLS:   []resources.IngressTLS{{Hosts: []string{"shop.example.com"}}},
+	}
+	if got := ingressURL(ing); got != "https://shop.example.com/cart" {
+		t.Errorf("Expected the first rule with a host, got %s", got)
+	}
+}
+
+func TestURLHealthCheck(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tui := &TUI{App: models.NewApp("test"), connected: true, width: 120, height: 40}
+	tui.ActiveTab = models.TabRoutes
+	tui.routes = []resources.RouteInfo{{ResourceInfo: resources.ResourceInfo{Name: "shop", Namespace: "demo"}, Host: strings.TrimPrefix(server.URL, "https://"), TLS: &resources.TLSConfig{Termination: "edge"}}}
+
+	cmd := tui.checkSelectedURL()
+	if cmd == nil || !strings.Contains(tui.detailContent, "Checking "+server.URL) {
+		t.Fatalf("Expected the check to start, got %q", tui.detailContent)
+	}
+
+	msg, ok := cmd().(messages.URLHealthChecked)
+	if !ok || msg.Err != nil || msg.StatusCode != http.StatusServiceUnavailable || !msg.Untrusted {
+		t.Fatalf("Expected a 503 through the untrusted certificate, got %+v", msg)
+	}
+	tui.Update(msg)
+	for _, want := range []string{"✗ 503 Service Unavailable", "Certificate not trusted"} {
+		if !strings.Contains(tui.detailContent, want) {
+			t.Errorf("Expected %q in the details, got %q", want, tui.detailContent)
+		}
+	}
+}
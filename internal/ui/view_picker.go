@@ -0,0 +1,394 @@
+package ui
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/logging"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// viewPickerEntry is a selectable entry in the saved view picker
+type viewPickerEntry struct {
+	view    *config.SavedView // nil clears the active view
+	builtin bool
+}
+
+// View form field indexes
+const (
+	viewFormName = iota
+	viewFormFilter
+	viewFormSort
+	viewFormGroup
+	viewFormColumns
+	viewFormFieldCount
+)
+
+// loadUserConfig loads the persistent user configuration, falling back to defaults
+func (t *TUI) loadUserConfig() {
+	path, err := config.DefaultPath()
+	if err != nil {
+		logging.Warn(t.Logger, "Cannot determine config path: %v", err)
+		t.config = &config.Config{Views: make(map[string][]config.SavedView)}
+		return
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		logging.Warn(t.Logger, "Failed to load config, using defaults: %v", err)
+	}
+	t.config = cfg
+	t.configPath = path
+}
+
+// saveUserConfig persists the user configuration
+func (t *TUI) saveUserConfig() error {
+	if t.config == nil || t.configPath == "" {
+		return fmt.Errorf("no configuration path available")
+	}
+	return t.config.Save(t.configPath)
+}
+
+// viewPickerEntries returns the picker entries for the current tab
+func (t *TUI) viewPickerEntries() []viewPickerEntry {
+	tab := t.GetTabName(t.ActiveTab)
+	entries := []viewPickerEntry{{view: nil}}
+
+	for _, v := range builtinViews(tab) {
+		view := v
+		entries = append(entries, viewPickerEntry{view: &view, builtin: true})
+	}
+	if t.config != nil {
+		for _, v := range t.config.ViewsFor(tab) {
+			view := v
+			entries = append(entries, viewPickerEntry{view: &view})
+		}
+	}
+
+	return entries
+}
+
+// openViewPicker opens the saved view picker for the current tab
+func (t *TUI) openViewPicker() {
+	t.showViewPicker = true
+	t.viewPickerIndex = 0
+
+	// Start on the active view if there is one
+	if active := t.activeView(int(t.ActiveTab)); active != nil {
+		for i, entry := range t.viewPickerEntries() {
+			if entry.view != nil && entry.view.Name == active.Name {
+				t.viewPickerIndex = i
+				break
+			}
+		}
+	}
+}
+
+// renderViewPicker renders the saved view picker modal
+func (t *TUI) renderViewPicker() string {
+	primaryColor, _ := t.getThemeColors()
+	entries := t.viewPickerEntries()
+
+	modalWidth := min(80, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("👁️ Views: %s", t.GetTabName(t.ActiveTab))) + "\n\n")
+
+	active := t.activeView(int(t.ActiveTab))
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	for i, entry := range entries {
+		name := "(default - all items)"
+		summary := ""
+		if entry.view != nil {
+			name = entry.view.Name
+			summary = viewSummary(*entry.view)
+			if entry.builtin {
+				name += " [built-in]"
+			}
+		}
+
+		marker := "  "
+		if (active == nil && entry.view == nil) || (active != nil && entry.view != nil && active.Name == entry.view.Name) {
+			marker = "● "
+		}
+
+		line := fmt.Sprintf("%s%-30s", marker, truncateString(name, 30))
+		if i == t.viewPickerIndex {
+			line = lipgloss.NewStyle().Background(primaryColor).Foreground(lipgloss.Color("0")).Render(line)
+		}
+		if summary != "" {
+			line += " " + dimStyle.Render(truncateString(summary, max(modalWidth-40, 10)))
+		}
+		content.WriteString(line + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("j/k: navigate • enter: apply • n: new • e: edit • x: delete • esc: close")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// viewSummary returns a short description of a view's settings
+func viewSummary(v config.SavedView) string {
+	var parts []string
+	if v.Filter != "" {
+		parts = append(parts, "filter: "+v.Filter)
+	}
+	if v.SortBy != "" {
+		sortBy := v.SortBy
+		if v.SortDesc {
+			sortBy = "-" + sortBy
+		}
+		parts = append(parts, "sort: "+sortBy)
+	}
+	if v.GroupBy != "" {
+		parts = append(parts, "group: "+v.GroupBy)
+	}
+	if len(v.Columns) > 0 {
+		parts = append(parts, "cols: "+strings.Join(v.Columns, ","))
+	}
+	return strings.Join(parts, " • ")
+}
+
+// handleViewPickerKeys handles key input for the saved view picker
+func (t *TUI) handleViewPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	entries := t.viewPickerEntries()
+
+	switch msg.String() {
+	case "esc", "q", "V":
+		t.showViewPicker = false
+		return t, nil
+
+	case "j", "down":
+		t.viewPickerIndex = (t.viewPickerIndex + 1) % len(entries)
+		return t, nil
+
+	case "k", "up":
+		t.viewPickerIndex--
+		if t.viewPickerIndex < 0 {
+			t.viewPickerIndex = len(entries) - 1
+		}
+		return t, nil
+
+	case "enter":
+		t.showViewPicker = false
+		if t.viewPickerIndex < len(entries) {
+			return t, t.setActiveView(entries[t.viewPickerIndex].view)
+		}
+		return t, nil
+
+	case "n":
+		// New view, seeded from the active view
+		t.openViewForm(t.activeView(int(t.ActiveTab)), true)
+		return t, textinput.Blink
+
+	case "e":
+		if t.viewPickerIndex < len(entries) && entries[t.viewPickerIndex].view != nil {
+			t.openViewForm(entries[t.viewPickerIndex].view, entries[t.viewPickerIndex].builtin)
+			return t, textinput.Blink
+		}
+		return t, nil
+
+	case "x":
+		if t.viewPickerIndex >= len(entries) {
+			return t, nil
+		}
+		entry := entries[t.viewPickerIndex]
+		if entry.view == nil || entry.builtin {
+			t.logWarn(categoryAction, "Built-in views cannot be deleted")
+			return t, nil
+		}
+		tab := t.GetTabName(t.ActiveTab)
+		if t.config.DeleteView(tab, entry.view.Name) {
+			if err := t.saveUserConfig(); err != nil {
+				t.logError(categoryAction, "Failed to save config: %v", err)
+			} else {
+				t.logSuccess(categoryAction, "Deleted view '%s'", entry.view.Name)
+			}
+		}
+		if t.viewPickerIndex >= len(t.viewPickerEntries()) {
+			t.viewPickerIndex = len(t.viewPickerEntries()) - 1
+		}
+		if active := t.activeView(int(t.ActiveTab)); active != nil && active.Name == entry.view.Name {
+			return t, t.setActiveView(nil)
+		}
+		return t, nil
+	}
+
+	return t, nil
+}
+
+// openViewForm opens the view editor. When asNew is set the name is cleared so
+// saving creates a new view instead of overwriting the source.
+func (t *TUI) openViewForm(source *config.SavedView, asNew bool) {
+	placeholders := [viewFormFieldCount]string{
+		"name (e.g. canary-pods)",
+		"filter (e.g. status:CrashLoopBackOff restarts>3 labels:track=canary)",
+		"sort field, prefix with - for descending (e.g. -restarts)",
+		"group by field (e.g. node)",
+		"columns, comma separated (e.g. name,status,restarts,node)",
+	}
+
+	t.viewFormInputs = make([]textinput.Model, viewFormFieldCount)
+	for i := range t.viewFormInputs {
+		input := textinput.New()
+		input.Placeholder = placeholders[i]
+		input.CharLimit = 256
+		input.Width = 60
+		t.viewFormInputs[i] = input
+	}
+
+	if source != nil {
+		if !asNew {
+			t.viewFormInputs[viewFormName].SetValue(source.Name)
+		}
+		t.viewFormInputs[viewFormFilter].SetValue(source.Filter)
+		sortBy := source.SortBy
+		if sortBy != "" && source.SortDesc {
+			sortBy = "-" + sortBy
+		}
+		t.viewFormInputs[viewFormSort].SetValue(sortBy)
+		t.viewFormInputs[viewFormGroup].SetValue(source.GroupBy)
+		t.viewFormInputs[viewFormColumns].SetValue(strings.Join(source.Columns, ","))
+	}
+
+	t.viewFormFocus = viewFormName
+	t.viewFormInputs[viewFormName].Focus()
+	t.viewFormError = ""
+	t.showViewPicker = false
+	t.showViewForm = true
+}
+
+// viewFromForm builds a saved view from the editor inputs
+func (t *TUI) viewFromForm() (config.SavedView, error) {
+	view := config.SavedView{
+		Name:    strings.TrimSpace(t.viewFormInputs[viewFormName].Value()),
+		Filter:  strings.TrimSpace(t.viewFormInputs[viewFormFilter].Value()),
+		GroupBy: strings.ToLower(strings.TrimSpace(t.viewFormInputs[viewFormGroup].Value())),
+	}
+	if view.Name == "" {
+		return view, fmt.Errorf("name is required")
+	}
+	for _, builtin := range builtinViews(t.GetTabName(t.ActiveTab)) {
+		if builtin.Name == view.Name {
+			return view, fmt.Errorf("'%s' is a built-in view name", view.Name)
+		}
+	}
+
+	sortBy := strings.ToLower(strings.TrimSpace(t.viewFormInputs[viewFormSort].Value()))
+	if strings.HasPrefix(sortBy, "-") {
+		view.SortDesc = true
+		sortBy = strings.TrimPrefix(sortBy, "-")
+	}
+	view.SortBy = sortBy
+
+	for _, col := range strings.Split(t.viewFormInputs[viewFormColumns].Value(), ",") {
+		if col = strings.ToLower(strings.TrimSpace(col)); col != "" {
+			view.Columns = append(view.Columns, col)
+		}
+	}
+	// Only the pods table is drawn from the view's columns
+	if len(view.Columns) > 0 && t.ActiveTab != models.TabPods {
+		return view, fmt.Errorf("columns can only be chosen on the Pods tab")
+	}
+	for _, col := range view.Columns {
+		if _, ok := podColumns[col]; !ok {
+			return view, fmt.Errorf("unknown column '%s', use %s", col, strings.Join(slices.Sorted(maps.Keys(podColumns)), ", "))
+		}
+	}
+
+	return view, nil
+}
+
+// renderViewForm renders the saved view editor
+func (t *TUI) renderViewForm() string {
+	primaryColor, _ := t.getThemeColors()
+
+	modalWidth := min(90, t.width-4)
+	modalStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1).
+		Width(modalWidth - 4)
+
+	labels := [viewFormFieldCount]string{"Name", "Filter", "Sort", "Group", "Columns"}
+
+	var content strings.Builder
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("👁️ Save View: %s", t.GetTabName(t.ActiveTab))) + "\n\n")
+	for i, input := range t.viewFormInputs {
+		label := fmt.Sprintf("%-8s", labels[i])
+		if i == t.viewFormFocus {
+			label = lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Render(label)
+		}
+		content.WriteString(label + " " + input.View() + "\n")
+	}
+
+	if t.viewFormError != "" {
+		content.WriteString("\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("❌ "+t.viewFormError) + "\n")
+	}
+
+	content.WriteString("\n")
+	content.WriteString("tab/↑↓: next field • enter: save and apply • esc: cancel")
+
+	modal := modalStyle.Render(content.String())
+	return lipgloss.Place(t.width, t.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// handleViewFormKeys handles key input for the saved view editor
+func (t *TUI) handleViewFormKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		t.showViewForm = false
+		t.showViewPicker = true
+		return t, nil
+
+	case "tab", "down":
+		t.focusViewFormField((t.viewFormFocus + 1) % viewFormFieldCount)
+		return t, nil
+
+	case "shift+tab", "up":
+		t.focusViewFormField((t.viewFormFocus + viewFormFieldCount - 1) % viewFormFieldCount)
+		return t, nil
+
+	case "enter":
+		view, err := t.viewFromForm()
+		if err != nil {
+			t.viewFormError = err.Error()
+			return t, nil
+		}
+
+		t.config.SetView(t.GetTabName(t.ActiveTab), view)
+		if err := t.saveUserConfig(); err != nil {
+			t.logError(categoryAction, "Failed to save config: %v", err)
+		} else {
+			t.logSuccess(categoryAction, "Saved view '%s'", view.Name)
+		}
+
+		t.showViewForm = false
+		return t, t.setActiveView(&view)
+	}
+
+	var cmd tea.Cmd
+	t.viewFormInputs[t.viewFormFocus], cmd = t.viewFormInputs[t.viewFormFocus].Update(msg)
+	return t, cmd
+}
+
+// focusViewFormField moves focus to the given editor field
+func (t *TUI) focusViewFormField(index int) {
+	t.viewFormInputs[t.viewFormFocus].Blur()
+	t.viewFormFocus = index
+	t.viewFormInputs[t.viewFormFocus].Focus()
+}
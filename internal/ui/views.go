@@ -0,0 +1,875 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+// viewRow exposes a resource's fields to saved view filtering, sorting and grouping
+type viewRow struct {
+	fields  map[string]string
+	created time.Time
+}
+
+// filterTerm is a single parsed term of a view filter expression
+type filterTerm struct {
+	field  string // empty matches against the name
+	op     byte   // ':' substring, '=' exact, '>' greater than, '<' less than
+	value  string
+	negate bool
+}
+
+// parseViewFilter parses a filter expression. Terms are separated by spaces and
+// must all match. Supported forms:
+//
+//	web              name contains "web"
+//	status:Running   field contains value (case-insensitive)
+//	restarts>3       numeric comparison (also <)
+//	label:app=web    has exactly the label app=web (label:app has the key)
+//	name=web-1,web-2 field is exactly one of the values
+//	!node:worker-1   negation
+func parseViewFilter(expr string) []filterTerm {
+	var terms []filterTerm
+
+	for _, raw := range strings.Fields(expr) {
+		term := filterTerm{op: ':'}
+		if strings.HasPrefix(raw, "!") {
+			term.negate = true
+			raw = raw[1:]
+		}
+
+		if i := strings.IndexAny(raw, ":<>="); i > 0 {
+			term.field = strings.ToLower(raw[:i])
+			term.op = raw[i]
+			term.value = raw[i+1:]
+		} else {
+			term.value = raw
+		}
+
+		if term.value == "" && term.field == "" {
+			continue
+		}
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+// matches reports whether a row satisfies a term
+func (f filterTerm) matches(row viewRow) bool {
+	field := f.field
+	if field == "" {
+		field = "name"
+	}
+	actual := row.fields[field]
+
+	var ok bool
+	switch f.op {
+	case '>', '<':
+		a, errA := strconv.ParseFloat(actual, 64)
+		b, errB := strconv.ParseFloat(f.value, 64)
+		if errA != nil || errB != nil {
+			ok = false
+		} else if f.op == '>' {
+			ok = a > b
+		} else {
+			ok = a < b
+		}
+	case '=':
+		ok = slices.Contains(strings.Split(f.value, ","), actual)
+	default:
+		if field == "label" {
+			ok = hasLabel(row.fields["labels"], f.value)
+			break
+		}
+		ok = strings.Contains(strings.ToLower(actual), strings.ToLower(f.value))
+	}
+
+	return ok != f.negate
+}
+
+// hasLabel reports whether flattened labels hold the label "k=v" exactly, or
+// any value of the key when want has no "="
+func hasLabel(flattened, want string) bool {
+	key, _, withValue := strings.Cut(want, "=")
+	for _, pair := range strings.Split(flattened, ",") {
+		if pair == want || (!withValue && strings.HasPrefix(pair, key+"=")) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareViewRows compares two rows by field, returning -1, 0 or 1
+func compareViewRows(a, b viewRow, field string) int {
+	if field == "age" {
+		// Older resources have a larger age
+		switch {
+		case a.created.Before(b.created):
+			return 1
+		case a.created.After(b.created):
+			return -1
+		default:
+			return 0
+		}
+	}
+
+	av, bv := a.fields[field], b.fields[field]
+	if an, errA := strconv.ParseFloat(av, 64); errA == nil {
+		if bn, errB := strconv.ParseFloat(bv, 64); errB == nil {
+			switch {
+			case an < bn:
+				return -1
+			case an > bn:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	return strings.Compare(strings.ToLower(av), strings.ToLower(bv))
+}
+
+// applyView filters, sorts and groups items according to a saved view.
+// Grouped items are ordered by group so rows of a group are contiguous.
+func applyView[T any](items []T, view *config.SavedView, toRow func(T) viewRow) []T {
+	if view == nil {
+		return items
+	}
+
+	terms := parseViewFilter(view.Filter)
+	type entry struct {
+		item T
+		row  viewRow
+	}
+
+	entries := make([]entry, 0, len(items))
+	for _, item := range items {
+		row := toRow(item)
+		matched := true
+		for _, term := range terms {
+			if !term.matches(row) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			entries = append(entries, entry{item: item, row: row})
+		}
+	}
+
+	sortBy := strings.ToLower(view.SortBy)
+	groupBy := strings.ToLower(view.GroupBy)
+	sort.SliceStable(entries, func(i, j int) bool {
+		if groupBy != "" {
+			if c := compareViewRows(entries[i].row, entries[j].row, groupBy); c != 0 {
+				return c < 0
+			}
+		}
+		if sortBy == "" {
+			return false
+		}
+		c := compareViewRows(entries[i].row, entries[j].row, sortBy)
+		if view.SortDesc {
+			return c > 0
+		}
+		return c < 0
+	})
+
+	result := make([]T, len(entries))
+	for i, e := range entries {
+		result[i] = e.item
+	}
+	return result
+}
+
+// labelsField flattens labels into a sorted "k=v,k=v" string
+func labelsField(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// podDisplayStatus returns the most specific status for a pod, preferring a
+// container waiting/terminated reason (e.g. CrashLoopBackOff) over the phase
+func podDisplayStatus(pod resources.PodInfo) string {
+	for _, c := range pod.ContainerInfo {
+		if c.Reason != "" && c.State != "Running" {
+			return c.Reason
+		}
+	}
+	return pod.Phase
+}
+
+func podViewRow(pod resources.PodInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      pod.Name,
+			"namespace": pod.Namespace,
+			"status":    podDisplayStatus(pod),
+			"phase":     pod.Phase,
+			"ready":     pod.Ready,
+			"restarts":  strconv.Itoa(int(pod.Restarts)),
+			"age":       pod.Age,
+			"node":      pod.Node,
+			"ip":        pod.IP,
+			"labels":    labelsField(pod.Labels),
+		},
+		created: pod.CreatedAt,
+	}
+}
+
+func serviceViewRow(svc resources.ServiceInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      svc.Name,
+			"namespace": svc.Namespace,
+			"type":      svc.Type,
+			"clusterip": svc.ClusterIP,
+			"ports":     strings.Join(svc.Ports, ","),
+			"selector":  svc.Selector,
+			"age":       svc.Age,
+			"labels":    labelsField(svc.Labels),
+		},
+		created: svc.CreatedAt,
+	}
+}
+
+func deploymentViewRow(d resources.DeploymentInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      d.Name,
+			"namespace": d.Namespace,
+			"replicas":  strconv.Itoa(int(d.Replicas)),
+			"ready":     strconv.Itoa(int(d.ReadyReplicas)),
+			"available": strconv.Itoa(int(d.AvailableReplicas)),
+			"strategy":  d.Strategy,
+			"status":    d.Condition,
+			"age":       d.Age,
+			"labels":    labelsField(d.Labels),
+		},
+		created: d.CreatedAt,
+	}
+}
+
+func configMapViewRow(cm resources.ConfigMapInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      cm.Name,
+			"namespace": cm.Namespace,
+			"data":      strconv.Itoa(cm.DataCount),
+			"age":       cm.Age,
+			"labels":    labelsField(cm.Labels),
+		},
+		created: cm.CreatedAt,
+	}
+}
+
+func secretViewRow(s resources.SecretInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      s.Name,
+			"namespace": s.Namespace,
+			"type":      s.Type,
+			"data":      strconv.Itoa(s.DataCount),
+			"age":       s.Age,
+			"labels":    labelsField(s.Labels),
+		},
+		created: s.CreatedAt,
+	}
+}
+
+func buildConfigViewRow(bc resources.BuildConfigInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      bc.Name,
+			"namespace": bc.Namespace,
+			"strategy":  bc.Strategy,
+			"source":    bc.Source.Type,
+			"builds":    strconv.Itoa(bc.SuccessBuilds),
+			"age":       bc.Age,
+			"labels":    labelsField(bc.Labels),
+		},
+		created: bc.CreatedAt,
+	}
+}
+
+func imageStreamViewRow(is resources.ImageStreamInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":       is.Name,
+			"namespace":  is.Namespace,
+			"repository": is.DockerImageRepository,
+			"tags":       strconv.Itoa(len(is.Tags)),
+			"age":        is.Age,
+			"labels":     labelsField(is.Labels),
+		},
+		created: is.CreatedAt,
+	}
+}
+
+func routeViewRow(r resources.RouteInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      r.Name,
+			"namespace": r.Namespace,
+			"host":      r.Host,
+			"path":      r.Path,
+			"service":   r.Service.Name,
+			"status":    r.Status,
+			"router":    strings.Join(routeRouters(r), ","),
+			"age":       r.Age,
+			"labels":    labelsField(r.Labels),
+		},
+		created: r.CreatedAt,
+	}
+}
+
+func buildViewRow(b resources.BuildInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":        b.Name,
+			"namespace":   b.Namespace,
+			"phase":       b.Phase,
+			"status":      b.Phase,
+			"buildconfig": b.BuildConfig,
+			"duration":    b.Duration,
+			"commit":      b.Commit,
+			"age":         b.Age,
+			"labels":      labelsField(b.Labels),
+		},
+		created: b.CreatedAt,
+	}
+}
+
+func eventViewRow(e resources.EventInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      e.Name,
+			"namespace": e.Namespace,
+			"type":      e.Type,
+			"status":    e.Type,
+			"reason":    e.Reason,
+			"object":    e.InvolvedKind + "/" + e.InvolvedName,
+			"kind":      e.InvolvedKind,
+			"message":   e.Message,
+			"source":    e.Source,
+			"count":     strconv.Itoa(int(e.Count)),
+			"age":       e.Age,
+			"labels":    labelsField(e.Labels),
+		},
+		created: e.LastSeen,
+	}
+}
+
+func statefulSetViewRow(s resources.StatefulSetInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      s.Name,
+			"namespace": s.Namespace,
+			"replicas":  strconv.Itoa(int(s.Replicas)),
+			"ready":     strconv.Itoa(int(s.ReadyReplicas)),
+			"updated":   strconv.Itoa(int(s.UpdatedReplicas)),
+			"service":   s.ServiceName,
+			"strategy":  s.UpdateStrategy,
+			"status":    s.Status,
+			"image":     strings.Join(s.Images, ","),
+			"age":       s.Age,
+			"labels":    labelsField(s.Labels),
+		},
+		created: s.CreatedAt,
+	}
+}
+
+func daemonSetViewRow(d resources.DaemonSetInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      d.Name,
+			"namespace": d.Namespace,
+			"desired":   strconv.Itoa(int(d.Desired)),
+			"ready":     strconv.Itoa(int(d.Ready)),
+			"available": strconv.Itoa(int(d.Available)),
+			"strategy":  d.UpdateStrategy,
+			"status":    d.Status,
+			"image":     strings.Join(d.Images, ","),
+			"age":       d.Age,
+			"labels":    labelsField(d.Labels),
+		},
+		created: d.CreatedAt,
+	}
+}
+
+func replicaSetViewRow(r resources.ReplicaSetInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      r.Name,
+			"namespace": r.Namespace,
+			"replicas":  strconv.Itoa(int(r.Replicas)),
+			"ready":     strconv.Itoa(int(r.ReadyReplicas)),
+			"available": strconv.Itoa(int(r.AvailableReplicas)),
+			"owner":     r.Owner,
+			"status":    r.Status,
+			"image":     strings.Join(r.Images, ","),
+			"age":       r.Age,
+			"labels":    labelsField(r.Labels),
+		},
+		created: r.CreatedAt,
+	}
+}
+
+func jobViewRow(j resources.JobInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":        j.Name,
+			"namespace":   j.Namespace,
+			"status":      j.Status,
+			"completions": strconv.Itoa(int(j.Completions)),
+			"succeeded":   strconv.Itoa(int(j.Succeeded)),
+			"failed":      strconv.Itoa(int(j.Failed)),
+			"active":      strconv.Itoa(int(j.Active)),
+			"duration":    j.Duration,
+			"cronjob":     j.CronJob,
+			"image":       strings.Join(j.Images, ","),
+			"age":         j.Age,
+			"labels":      labelsField(j.Labels),
+		},
+		created: j.CreatedAt,
+	}
+}
+
+func cronJobViewRow(c resources.CronJobInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":      c.Name,
+			"namespace": c.Namespace,
+			"schedule":  c.Schedule,
+			"status":    c.Status,
+			"suspended": strconv.FormatBool(c.Suspended),
+			"active":    strconv.Itoa(c.ActiveJobs),
+			"last":      c.LastRun,
+			"image":     strings.Join(c.Images, ","),
+			"age":       c.Age,
+			"labels":    labelsField(c.Labels),
+		},
+		created: c.CreatedAt,
+	}
+}
+
+func nodeViewRow(n resources.NodeInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":          n.Name,
+			"status":        n.Status,
+			"roles":         strings.Join(n.Roles, ","),
+			"version":       n.KubeletVersion,
+			"ip":            n.InternalIP,
+			"cpu":           n.CPUAllocatable,
+			"memory":        n.MemoryAllocatable,
+			"pods":          strconv.Itoa(n.PodCount),
+			"unschedulable": strconv.FormatBool(n.Unschedulable),
+			"taints":        strings.Join(n.Taints, ","),
+			"age":           n.Age,
+			"labels":        labelsField(n.Labels),
+		},
+		created: n.CreatedAt,
+	}
+}
+
+func pvcViewRow(p resources.PersistentVolumeClaimInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":         p.Name,
+			"status":       p.Status,
+			"volume":       p.Volume,
+			"capacity":     p.Capacity,
+			"requested":    p.Requested,
+			"access":       strings.Join(p.AccessModes, ","),
+			"storageclass": p.StorageClass,
+			"age":          p.Age,
+			"labels":       labelsField(p.Labels),
+		},
+		created: p.CreatedAt,
+	}
+}
+
+func ingressViewRow(i resources.IngressInfo) viewRow {
+	hosts, backends := make([]string, 0, len(i.Rules)), make([]string, 0, len(i.Rules))
+	for _, rule := range i.Rules {
+		hosts = append(hosts, rule.Host)
+		backends = append(backends, rule.Backend)
+	}
+	return viewRow{
+		fields: map[string]string{
+			"name":    i.Name,
+			"status":  i.Status,
+			"class":   i.IngressClass,
+			"host":    strings.Join(hosts, ","),
+			"backend": strings.Join(backends, ","),
+			"tls":     strconv.FormatBool(len(i.TLS) > 0),
+			"age":     i.Age,
+			"labels":  labelsField(i.Labels),
+		},
+		created: i.CreatedAt,
+	}
+}
+
+func networkPolicyViewRow(n resources.NetworkPolicyInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":   n.Name,
+			"pods":   n.PodSelector,
+			"types":  strings.Join(n.PolicyTypes, ","),
+			"age":    n.Age,
+			"labels": labelsField(n.Labels),
+		},
+		created: n.CreatedAt,
+	}
+}
+
+func deploymentConfigViewRow(d resources.DeploymentConfigInfo) viewRow {
+	return viewRow{
+		fields: map[string]string{
+			"name":     d.Name,
+			"status":   d.Status,
+			"ready":    fmt.Sprintf("%d/%d", d.ReadyReplicas, d.Replicas),
+			"version":  strconv.FormatInt(d.LatestVersion, 10),
+			"triggers": deploymentConfigTriggers(d),
+			"image":    strings.Join(d.Images, ","),
+			"age":      d.Age,
+			"labels":   labelsField(d.Labels),
+		},
+		created: d.CreatedAt,
+	}
+}
+
+// builtinViews returns the predefined views for a tab
+func builtinViews(tab string) []config.SavedView {
+	switch tab {
+	case "Pods":
+		return []config.SavedView{
+			{Name: "crashlooping", Filter: "status:CrashLoopBackOff", SortBy: "restarts", SortDesc: true, Columns: []string{"name", "status", "restarts", "age", "node"}},
+			{Name: "restarting", Filter: "restarts>0", SortBy: "restarts", SortDesc: true},
+			{Name: "not-running", Filter: "!phase:Running !phase:Succeeded"},
+			{Name: "by-node", GroupBy: "node", Columns: []string{"name", "status", "ready", "age", "node"}},
+		}
+	case "Deployments":
+		return []config.SavedView{
+			{Name: "unavailable", Filter: "available<1"},
+		}
+	case "Builds":
+		return []config.SavedView{
+			{Name: "failed", Filter: "!phase:Complete !phase:Running !phase:Pending !phase:New"},
+		}
+	case "Events":
+		return []config.SavedView{
+			{Name: "warnings", Filter: "type:Warning"},
+		}
+	case "ReplicaSets":
+		return []config.SavedView{
+			{Name: "active", Filter: "replicas>0"},
+		}
+	case "Jobs":
+		return []config.SavedView{
+			{Name: "failed", Filter: "status:Failed"},
+		}
+	case "CronJobs":
+		return []config.SavedView{
+			{Name: "suspended", Filter: "suspended:true"},
+		}
+	case "Nodes":
+		return []config.SavedView{
+			{Name: "not ready", Filter: "status:NotReady"},
+			{Name: "cordoned", Filter: "unschedulable:true"},
+		}
+	case "Storage":
+		return []config.SavedView{
+			{Name: "pending", Filter: "status:Pending"},
+		}
+	case "Routes":
+		return []config.SavedView{
+			{Name: "not admitted", Filter: "!status:Admitted"},
+		}
+	case "Ingresses":
+		return []config.SavedView{
+			{Name: "no address", Filter: "status:Pending"},
+			{Name: "without tls", Filter: "tls:false"},
+		}
+	case "DeploymentConfigs":
+		return []config.SavedView{
+			{Name: "not ready", Filter: "!status:Ready !status:Scaled"},
+		}
+	}
+	return nil
+}
+
+// activeView returns the active view for the given tab, or nil
+func (t *TUI) activeView(tab int) *config.SavedView {
+	if view, ok := t.activeViews[tab]; ok {
+		return &view
+	}
+	return nil
+}
+
+// reapplyView re-derives the displayed list of a tab from its loaded items,
+// preserving the selection by name where possible
+func (t *TUI) reapplyView(tab int) {
+	switch tab {
+	case 0:
+		selected := selectedName(t.pods, t.selectedPod, func(p resources.PodInfo) string { return p.Name })
+		t.pods = viewItems(t, tab, t.allPods, podViewRow)
+		t.selectedPod = indexByName(t.pods, selected, func(p resources.PodInfo) string { return p.Name })
+	case 1:
+		selected := selectedName(t.services, t.selectedService, func(s resources.ServiceInfo) string { return s.Name })
+		t.services = viewItems(t, tab, t.allServices, serviceViewRow)
+		t.selectedService = indexByName(t.services, selected, func(s resources.ServiceInfo) string { return s.Name })
+	case 2:
+		selected := selectedName(t.deployments, t.selectedDeployment, func(d resources.DeploymentInfo) string { return d.Name })
+		t.deployments = viewItems(t, tab, t.allDeployments, deploymentViewRow)
+		t.selectedDeployment = indexByName(t.deployments, selected, func(d resources.DeploymentInfo) string { return d.Name })
+	case 3:
+		selected := selectedName(t.configMaps, t.selectedConfigMap, func(c resources.ConfigMapInfo) string { return c.Name })
+		t.configMaps = viewItems(t, tab, t.allConfigMaps, configMapViewRow)
+		t.selectedConfigMap = indexByName(t.configMaps, selected, func(c resources.ConfigMapInfo) string { return c.Name })
+	case 4:
+		selected := selectedName(t.secrets, t.selectedSecret, func(s resources.SecretInfo) string { return s.Name })
+		t.secrets = viewItems(t, tab, t.allSecrets, secretViewRow)
+		t.selectedSecret = indexByName(t.secrets, selected, func(s resources.SecretInfo) string { return s.Name })
+	case 5:
+		selected := selectedName(t.buildConfigs, t.selectedBuildConfig, func(b resources.BuildConfigInfo) string { return b.Name })
+		t.buildConfigs = viewItems(t, tab, t.allBuildConfigs, buildConfigViewRow)
+		t.selectedBuildConfig = indexByName(t.buildConfigs, selected, func(b resources.BuildConfigInfo) string { return b.Name })
+	case 6:
+		selected := selectedName(t.imageStreams, t.selectedImageStream, func(i resources.ImageStreamInfo) string { return i.Name })
+		t.imageStreams = viewItems(t, tab, t.allImageStreams, imageStreamViewRow)
+		t.selectedImageStream = indexByName(t.imageStreams, selected, func(i resources.ImageStreamInfo) string { return i.Name })
+	case 7:
+		selected := selectedName(t.routes, t.selectedRoute, func(r resources.RouteInfo) string { return r.Name })
+		t.routes = viewItems(t, tab, t.allRoutes, routeViewRow)
+		t.selectedRoute = indexByName(t.routes, selected, func(r resources.RouteInfo) string { return r.Name })
+	case 8:
+		selected := selectedName(t.builds, t.selectedBuild, func(b resources.BuildInfo) string { return b.Name })
+		t.builds = viewItems(t, tab, t.allBuilds, buildViewRow)
+		t.selectedBuild = indexByName(t.builds, selected, func(b resources.BuildInfo) string { return b.Name })
+	case 9:
+		selected := selectedName(t.events, t.selectedEvent, func(e resources.EventInfo) string { return e.Name })
+		t.events = viewItems(t, tab, t.allEvents, eventViewRow)
+		t.selectedEvent = indexByName(t.events, selected, func(e resources.EventInfo) string { return e.Name })
+	case 10:
+		selected := selectedName(t.statefulSets, t.selectedStatefulSet, func(s resources.StatefulSetInfo) string { return s.Name })
+		t.statefulSets = viewItems(t, tab, t.allStatefulSets, statefulSetViewRow)
+		t.selectedStatefulSet = indexByName(t.statefulSets, selected, func(s resources.StatefulSetInfo) string { return s.Name })
+	case 11:
+		selected := selectedName(t.daemonSets, t.selectedDaemonSet, func(d resources.DaemonSetInfo) string { return d.Name })
+		t.daemonSets = viewItems(t, tab, t.allDaemonSets, daemonSetViewRow)
+		t.selectedDaemonSet = indexByName(t.daemonSets, selected, func(d resources.DaemonSetInfo) string { return d.Name })
+	case 12:
+		selected := selectedName(t.replicaSets, t.selectedReplicaSet, func(r resources.ReplicaSetInfo) string { return r.Name })
+		t.replicaSets = viewItems(t, tab, t.allReplicaSets, replicaSetViewRow)
+		t.selectedReplicaSet = indexByName(t.replicaSets, selected, func(r resources.ReplicaSetInfo) string { return r.Name })
+	case 13:
+		selected := selectedName(t.jobs, t.selectedJob, func(j resources.JobInfo) string { return j.Name })
+		t.jobs = viewItems(t, tab, t.allJobs, jobViewRow)
+		t.selectedJob = indexByName(t.jobs, selected, func(j resources.JobInfo) string { return j.Name })
+	case 14:
+		selected := selectedName(t.cronJobs, t.selectedCronJob, func(c resources.CronJobInfo) string { return c.Name })
+		t.cronJobs = viewItems(t, tab, t.allCronJobs, cronJobViewRow)
+		t.selectedCronJob = indexByName(t.cronJobs, selected, func(c resources.CronJobInfo) string { return c.Name })
+	case 15:
+		selected := selectedName(t.nodes, t.selectedNode, func(n resources.NodeInfo) string { return n.Name })
+		t.nodes = viewItems(t, tab, t.allNodes, nodeViewRow)
+		t.selectedNode = indexByName(t.nodes, selected, func(n resources.NodeInfo) string { return n.Name })
+	case 16:
+		selected := selectedName(t.pvcs, t.selectedPVC, func(p resources.PersistentVolumeClaimInfo) string { return p.Name })
+		t.pvcs = viewItems(t, tab, t.allPVCs, pvcViewRow)
+		t.selectedPVC = indexByName(t.pvcs, selected, func(p resources.PersistentVolumeClaimInfo) string { return p.Name })
+	case 17:
+		selected := selectedName(t.ingresses, t.selectedIngress, func(i resources.IngressInfo) string { return i.Name })
+		t.ingresses = viewItems(t, tab, t.allIngresses, ingressViewRow)
+		t.selectedIngress = indexByName(t.ingresses, selected, func(i resources.IngressInfo) string { return i.Name })
+	case 18:
+		selected := selectedName(t.networkPolicies, t.selectedNetworkPolicy, func(n resources.NetworkPolicyInfo) string { return n.Name })
+		t.networkPolicies = viewItems(t, tab, t.allNetworkPolicies, networkPolicyViewRow)
+		t.selectedNetworkPolicy = indexByName(t.networkPolicies, selected, func(n resources.NetworkPolicyInfo) string { return n.Name })
+	case 19:
+		selected := selectedName(t.deploymentConfigs, t.selectedDeploymentConfig, func(d resources.DeploymentConfigInfo) string { return d.Name })
+		t.deploymentConfigs = viewItems(t, tab, t.allDeploymentConfigs, deploymentConfigViewRow)
+		t.selectedDeploymentConfig = indexByName(t.deploymentConfigs, selected, func(d resources.DeploymentConfigInfo) string { return d.Name })
+	}
+}
+
+// selectedName returns the name of the selected item, or "" if out of range
+func selectedName[T any](items []T, selected int, name func(T) string) string {
+	if selected >= 0 && selected < len(items) {
+		return name(items[selected])
+	}
+	return ""
+}
+
+// indexByName returns the index of the named item, or 0 if not found
+func indexByName[T any](items []T, target string, name func(T) string) int {
+	if target == "" {
+		return 0
+	}
+	for i, item := range items {
+		if name(item) == target {
+			return i
+		}
+	}
+	return 0
+}
+
+// setActiveView activates a view (nil clears it) for the current tab and refreshes
+// the display. On the pods tab the log stream follows the selection if it changed.
+func (t *TUI) setActiveView(view *config.SavedView) tea.Cmd {
+	tab := int(t.ActiveTab)
+	previousPod := selectedName(t.pods, t.selectedPod, func(p resources.PodInfo) string { return p.Name })
+
+	if view == nil {
+		delete(t.activeViews, tab)
+		t.logInfo(categoryAction, "Cleared view on %s", t.GetTabName(t.ActiveTab))
+	} else {
+		t.activeViews[tab] = *view
+		t.logInfo(categoryAction, "Applied view '%s' on %s", view.Name, t.GetTabName(t.ActiveTab))
+		if len(view.Columns) > 0 && t.ActiveTab != models.TabPods {
+			t.logWarn(categoryAction, "View '%s' chooses columns, which only the Pods tab shows", view.Name)
+		}
+	}
+	t.reapplyView(tab)
+	t.updateMainContent()
+
+	if tab == 0 && selectedName(t.pods, t.selectedPod, func(p resources.PodInfo) string { return p.Name }) != previousPod {
+		t.clearPodLogs()
+		return t.startPodLogStream()
+	}
+	return nil
+}
+
+// renderViewBanner returns a one-line summary of the active view for the current tab
+func (t *TUI) renderViewBanner() string {
+	view := t.activeView(int(t.ActiveTab))
+	if view == nil {
+		return ""
+	}
+
+	parts := []string{fmt.Sprintf("👁️ View: %s", view.Name)}
+	if view.Filter != "" {
+		parts = append(parts, fmt.Sprintf("filter=%q", view.Filter))
+	}
+	// A column sort replaces the view's sort
+	if sorted := t.sortedView(int(t.ActiveTab)); sorted.SortBy != "" {
+		dir := "asc"
+		if sorted.SortDesc {
+			dir = "desc"
+		}
+		parts = append(parts, fmt.Sprintf("sort=%s %s", sorted.SortBy, dir))
+	}
+	if view.GroupBy != "" {
+		parts = append(parts, fmt.Sprintf("group=%s", view.GroupBy))
+	}
+
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("13")).Render(strings.Join(parts, " • ")) + "\n"
+}
+
+// podColumn describes a selectable column of the pod table
+type podColumn struct {
+	title string
+	width int
+}
+
+// podColumns lists the selectable pod table columns
+var podColumns = map[string]podColumn{
+	"name":      {"NAME", 38},
+	"namespace": {"NAMESPACE", 20},
+	"status":    {"STATUS", 18},
+	"phase":     {"PHASE", 10},
+	"ready":     {"READY", 6},
+	"restarts":  {"RESTARTS", 8},
+	"age":       {"AGE", 6},
+	"node":      {"NODE", 24},
+	"ip":        {"IP", 15},
+}
+
+// renderPodViewTable renders the pod table using the active view's columns and grouping
+func (t *TUI) renderPodViewTable(content *strings.Builder, view *config.SavedView, badges labels.Selector) {
+	columns := view.Columns
+	if len(columns) == 0 {
+		columns = []string{"name", "status", "ready", "age"}
+	}
+	if t.allNamespaces && !slices.Contains(columns, "namespace") {
+		columns = append([]string{"namespace"}, columns...)
+	}
+
+	var header, separator []string
+	var valid []string
+	for _, col := range columns {
+		col = strings.ToLower(strings.TrimSpace(col))
+		def, ok := podColumns[col]
+		if !ok {
+			continue
+		}
+		valid = append(valid, col)
+		header = append(header, fmt.Sprintf("%-*s", def.width, t.sortTitle(0, col, def.title)))
+		separator = append(separator, strings.Repeat("─", def.width))
+	}
+
+	content.WriteString("  " + strings.Join(header, "  ") + "\n")
+	content.WriteString("  " + strings.Join(separator, "  ") + "\n")
+
+	groupBy := strings.ToLower(view.GroupBy)
+	groupStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
+	lastGroup := ""
+	start, end := t.listWindow(t.selectedPod, len(t.pods))
+	for i := start; i < end; i++ {
+		pod := t.pods[i]
+		row := podViewRow(pod)
+
+		if groupBy != "" {
+			group := row.fields[groupBy]
+			if i == start || group != lastGroup {
+				label := group
+				if label == "" {
+					label = "<none>"
+				}
+				content.WriteString(groupStyle.Render(fmt.Sprintf("%s: %s", groupBy, label)) + "\n")
+				lastGroup = group
+			}
+		}
+
+		prefix := "  "
+		if i == t.selectedPod && t.focusedPanel == 0 {
+			prefix = "▶ "
+		}
+
+		cells := make([]string, 0, len(valid))
+		for _, col := range valid {
+			def := podColumns[col]
+			value := row.fields[col]
+			if col == "name" {
+				value = t.batchMarkCell(models.TabPods, pod.Namespace, pod.Name) + t.podBadgeCell(badges, pod) + value
+			}
+			cell := fmt.Sprintf("%-*s", def.width, truncateString(value, def.width))
+			if col == "name" {
+				cell = t.highlightListFilter(cell)
+			}
+			cells = append(cells, cell)
+		}
+		content.WriteString(prefix + strings.Join(cells, "  ") + "\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.pods)))
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/katyella/lazyoc/internal/config"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func testPod(name, phase, node string, restarts int32, age time.Duration) resources.PodInfo {
+	return resources.PodInfo{
+		ResourceInfo: resources.ResourceInfo{Name: name, CreatedAt: time.Now().Add(-age)},
+		Phase:        phase,
+		Node:         node,
+		Restarts:     restarts,
+	}
+}
+
+func TestApplyView(t *testing.T) {
+	pods := []resources.PodInfo{
+		testPod("web-1", "Running", "node-b", 0, time.Hour),
+		testPod("web-2", "Running", "node-a", 5, 2*time.Hour),
+		testPod("api-1", "Pending", "node-a", 2, 3*time.Hour),
+	}
+	crashing := testPod("api-2", "Running", "node-b", 9, 4*time.Hour)
+	crashing.ContainerInfo = []resources.ContainerInfo{{State: "Waiting", Reason: "CrashLoopBackOff"}}
+	pods = append(pods, crashing)
+
+	tests := []struct {
+		name     string
+		view     *config.SavedView
+		expected []string
+	}{
+		{"no view", nil, []string{"web-1", "web-2", "api-1", "api-2"}},
+		{"name filter", &config.SavedView{Filter: "web"}, []string{"web-1", "web-2"}},
+		{"field filter", &config.SavedView{Filter: "phase:pending"}, []string{"api-1"}},
+		{"container reason status", &config.SavedView{Filter: "status:CrashLoopBackOff"}, []string{"api-2"}},
+		{"numeric filter", &config.SavedView{Filter: "restarts>1"}, []string{"web-2", "api-1", "api-2"}},
+		{"exact filter", &config.SavedView{Filter: "name=web-1,api-2"}, []string{"web-1", "api-2"}},
+		{"negated filter", &config.SavedView{Filter: "!node:node-a"}, []string{"web-1", "api-2"}},
+		{"sort desc", &config.SavedView{SortBy: "restarts", SortDesc: true}, []string{"api-2", "web-2", "api-1", "web-1"}},
+		{"sort by age", &config.SavedView{SortBy: "age"}, []string{"web-1", "web-2", "api-1", "api-2"}},
+		{"group then sort", &config.SavedView{GroupBy: "node", SortBy: "name"}, []string{"api-1", "web-2", "api-2", "web-1"}},
+	}
+
+	for _, test := range tests {
+		result := applyView(pods, test.view, podViewRow)
+		if len(result) != len(test.expected) {
+			t.Errorf("%s: expected %d pods, got %d", test.name, len(test.expected), len(result))
+			continue
+		}
+		for i, pod := range result {
+			if pod.Name != test.expected[i] {
+				t.Errorf("%s: position %d = %s, expected %s", test.name, i, pod.Name, test.expected[i])
+			}
+		}
+	}
+}
+
+func TestViewFormColumns(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), activeViews: make(map[int]config.SavedView)}
+	form := func(tab models.TabType, columns string) error {
+		tui.ActiveTab = tab
+		tui.openViewForm(nil, false)
+		tui.viewFormInputs[viewFormName].SetValue("mine")
+		tui.viewFormInputs[viewFormColumns].SetValue(columns)
+		_, err := tui.viewFromForm()
+		return err
+	}
+
+	if err := form(models.TabPods, "name, node"); err != nil {
+		t.Errorf("Expected pod columns to be accepted, got %v", err)
+	}
+	if err := form(models.TabPods, "name,colour"); err == nil || !strings.Contains(err.Error(), "colour") {
+		t.Errorf("Expected an unknown column to be refused, got %v", err)
+	}
+	if err := form(models.TabDeployments, "name,ready"); err == nil || !strings.Contains(err.Error(), "Pods tab") {
+		t.Errorf("Expected columns to be refused on the Deployments tab, got %v", err)
+	}
+	if err := form(models.TabDeployments, ""); err != nil {
+		t.Errorf("Expected a Deployments view without columns to be accepted, got %v", err)
+	}
+
+	// A view with columns from the config file is applied with a warning
+	tui.setActiveView(&config.SavedView{Name: "wide", Columns: []string{"name", "ready"}})
+	if last := tui.appLog[len(tui.appLog)-1]; last.Level != levelWarn || !strings.Contains(last.Message, "only the Pods tab") {
+		t.Errorf("Expected a warning about the ignored columns, got %+v", last)
+	}
+}
@@ -0,0 +1,340 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// loadStatefulSets loads StatefulSets from the current namespace
+func (t *TUI) loadStatefulSets() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.StatefulSetsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		opts := t.listOptions()
+
+		list, err := loadAllPages(t, "statefulsets", opts, t.resourceClient.ListStatefulSets)
+		if err != nil {
+			return messages.StatefulSetsLoadError{Err: err}
+		}
+
+		return messages.StatefulSetsLoaded{StatefulSets: list.Items}
+	}
+}
+
+// loadDaemonSets loads DaemonSets from the current namespace
+func (t *TUI) loadDaemonSets() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.DaemonSetsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		opts := t.listOptions()
+
+		list, err := loadAllPages(t, "daemonsets", opts, t.resourceClient.ListDaemonSets)
+		if err != nil {
+			return messages.DaemonSetsLoadError{Err: err}
+		}
+
+		return messages.DaemonSetsLoaded{DaemonSets: list.Items}
+	}
+}
+
+// loadReplicaSets loads ReplicaSets from the current namespace
+func (t *TUI) loadReplicaSets() tea.Cmd {
+	return func() tea.Msg {
+		if !t.connected || t.resourceClient == nil {
+			return messages.ReplicaSetsLoadError{Err: fmt.Errorf("not connected to cluster")}
+		}
+
+		opts := t.listOptions()
+
+		list, err := loadAllPages(t, "replicasets", opts, t.resourceClient.ListReplicaSets)
+		if err != nil {
+			return messages.ReplicaSetsLoadError{Err: err}
+		}
+
+		return messages.ReplicaSetsLoaded{ReplicaSets: list.Items}
+	}
+}
+
+// workloadRowStyle returns the row style of a workload, highlighting those
+// that are not fully ready
+func workloadRowStyle(selected bool, desired, ready int32) lipgloss.Style {
+	switch {
+	case selected:
+		return lipgloss.NewStyle().Background(lipgloss.Color("8")).Foreground(lipgloss.Color("15"))
+	case ready < desired:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	}
+	return lipgloss.NewStyle()
+}
+
+// writeWorkloadMetadata writes the images and labels sections shared by the
+// workload detail panes
+func writeWorkloadMetadata(details *strings.Builder, images []string, labels map[string]string) {
+	if len(images) > 0 {
+		details.WriteString("\nImages:\n")
+		for _, image := range images {
+			details.WriteString(fmt.Sprintf("  %s\n", image))
+		}
+	}
+
+	if len(labels) > 0 {
+		keys := make([]string, 0, len(labels))
+		for key := range labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		details.WriteString("\nLabels:\n")
+		for _, key := range keys {
+			details.WriteString(fmt.Sprintf("  %s: %s\n", key, labels[key]))
+		}
+	}
+}
+
+// updateStatefulSetDisplay updates the main content with StatefulSet information
+func (t *TUI) updateStatefulSetDisplay() {
+	if t.loadingStatefulSets {
+		t.mainContent = "🗄️ StatefulSets\n\nLoading StatefulSets..."
+		return
+	}
+
+	if len(t.statefulSets) == 0 {
+		t.mainContent = "🗄️ StatefulSets\n\nNo StatefulSets found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🗄️ StatefulSets\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-10s %-10s %-25s %s", "NAME", "READY", "UP-TO-DATE", "SERVICE", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 85))
+	content.WriteString("\n")
+
+	// StatefulSet rows
+	start, end := t.listWindow(t.selectedStatefulSet, len(t.statefulSets))
+	for i := start; i < end; i++ {
+		sts := t.statefulSets[i]
+		style := workloadRowStyle(i == t.selectedStatefulSet, sts.Replicas, sts.ReadyReplicas)
+
+		row := t.namespaceCell(sts.Namespace) + fmt.Sprintf("%-30s %-10s %-10d %-25s %s",
+			truncateString(sts.Name, 30),
+			fmt.Sprintf("%d/%d", sts.ReadyReplicas, sts.Replicas),
+			sts.UpdatedReplicas,
+			truncateString(sts.ServiceName, 25),
+			t.formatTime(sts.CreatedAt, sts.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.statefulSets)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to toggle details • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected StatefulSet info
+	if t.selectedStatefulSet < len(t.statefulSets) && t.selectedStatefulSet >= 0 {
+		t.updateStatefulSetDetails(t.statefulSets[t.selectedStatefulSet])
+	}
+}
+
+// updateStatefulSetDetails updates the detail pane with StatefulSet information
+func (t *TUI) updateStatefulSetDetails(sts resources.StatefulSetInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🗄️ StatefulSet Details: %s\n\n", sts.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", sts.Namespace))
+	details.WriteString(fmt.Sprintf("Status:       %s\n", sts.Status))
+	details.WriteString(fmt.Sprintf("Service:      %s\n", sts.ServiceName))
+	details.WriteString(fmt.Sprintf("Strategy:     %s\n", sts.UpdateStrategy))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(sts.CreatedAt, sts.Age)))
+
+	details.WriteString("\nReplicas:\n")
+	details.WriteString(fmt.Sprintf("  Desired:    %d\n", sts.Replicas))
+	details.WriteString(fmt.Sprintf("  Ready:      %d\n", sts.ReadyReplicas))
+	details.WriteString(fmt.Sprintf("  Current:    %d\n", sts.CurrentReplicas))
+	details.WriteString(fmt.Sprintf("  Updated:    %d\n", sts.UpdatedReplicas))
+
+	writeWorkloadMetadata(&details, sts.Images, sts.Labels)
+	details.WriteString(t.renderRightSizing("StatefulSet", sts.Name))
+	details.WriteString(t.renderRelatedEvents("StatefulSet", sts.Name))
+
+	t.detailContent = details.String()
+}
+
+// updateDaemonSetDisplay updates the main content with DaemonSet information
+func (t *TUI) updateDaemonSetDisplay() {
+	if t.loadingDaemonSets {
+		t.mainContent = "👾 DaemonSets\n\nLoading DaemonSets..."
+		return
+	}
+
+	if len(t.daemonSets) == 0 {
+		t.mainContent = "👾 DaemonSets\n\nNo DaemonSets found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("👾 DaemonSets\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-30s %-8s %-8s %-8s %-10s %-10s %s", "NAME", "DESIRED", "CURRENT", "READY", "UP-TO-DATE", "AVAILABLE", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 90))
+	content.WriteString("\n")
+
+	// DaemonSet rows
+	start, end := t.listWindow(t.selectedDaemonSet, len(t.daemonSets))
+	for i := start; i < end; i++ {
+		ds := t.daemonSets[i]
+		style := workloadRowStyle(i == t.selectedDaemonSet, ds.Desired, ds.Ready)
+
+		row := t.namespaceCell(ds.Namespace) + fmt.Sprintf("%-30s %-8d %-8d %-8d %-10d %-10d %s",
+			truncateString(ds.Name, 30),
+			ds.Desired,
+			ds.Current,
+			ds.Ready,
+			ds.UpToDate,
+			ds.Available,
+			t.formatTime(ds.CreatedAt, ds.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.daemonSets)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to toggle details • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected DaemonSet info
+	if t.selectedDaemonSet < len(t.daemonSets) && t.selectedDaemonSet >= 0 {
+		t.updateDaemonSetDetails(t.daemonSets[t.selectedDaemonSet])
+	}
+}
+
+// updateDaemonSetDetails updates the detail pane with DaemonSet information
+func (t *TUI) updateDaemonSetDetails(ds resources.DaemonSetInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("👾 DaemonSet Details: %s\n\n", ds.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", ds.Namespace))
+	details.WriteString(fmt.Sprintf("Status:       %s\n", ds.Status))
+	details.WriteString(fmt.Sprintf("Strategy:     %s\n", ds.UpdateStrategy))
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(ds.CreatedAt, ds.Age)))
+
+	details.WriteString("\nScheduled Pods:\n")
+	details.WriteString(fmt.Sprintf("  Desired:    %d\n", ds.Desired))
+	details.WriteString(fmt.Sprintf("  Current:    %d\n", ds.Current))
+	details.WriteString(fmt.Sprintf("  Ready:      %d\n", ds.Ready))
+	details.WriteString(fmt.Sprintf("  Up-to-date: %d\n", ds.UpToDate))
+	details.WriteString(fmt.Sprintf("  Available:  %d\n", ds.Available))
+
+	if len(ds.NodeSelector) > 0 {
+		details.WriteString("\nNode Selector:\n")
+		for key, value := range ds.NodeSelector {
+			details.WriteString(fmt.Sprintf("  %s: %s\n", key, value))
+		}
+	}
+
+	writeWorkloadMetadata(&details, ds.Images, ds.Labels)
+	details.WriteString(t.renderRightSizing("DaemonSet", ds.Name))
+	details.WriteString(t.renderRelatedEvents("DaemonSet", ds.Name))
+
+	t.detailContent = details.String()
+}
+
+// updateReplicaSetDisplay updates the main content with ReplicaSet information
+func (t *TUI) updateReplicaSetDisplay() {
+	if t.loadingReplicaSets {
+		t.mainContent = "🧬 ReplicaSets\n\nLoading ReplicaSets..."
+		return
+	}
+
+	if len(t.replicaSets) == 0 {
+		t.mainContent = "🧬 ReplicaSets\n\nNo ReplicaSets found in current namespace.\n\nPress 'r' to refresh"
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString("🧬 ReplicaSets\n\n")
+
+	// Header
+	header := t.namespaceHeader() + fmt.Sprintf("%-35s %-8s %-8s %-8s %-30s %s", "NAME", "DESIRED", "READY", "AVAIL", "OWNER", "AGE")
+	content.WriteString(lipgloss.NewStyle().Bold(true).Render(header))
+	content.WriteString("\n")
+	content.WriteString(strings.Repeat("-", 100))
+	content.WriteString("\n")
+
+	// ReplicaSet rows
+	start, end := t.listWindow(t.selectedReplicaSet, len(t.replicaSets))
+	for i := start; i < end; i++ {
+		rs := t.replicaSets[i]
+		style := workloadRowStyle(i == t.selectedReplicaSet, rs.Replicas, rs.ReadyReplicas)
+
+		row := t.namespaceCell(rs.Namespace) + fmt.Sprintf("%-35s %-8d %-8d %-8d %-30s %s",
+			truncateString(rs.Name, 35),
+			rs.Replicas,
+			rs.ReadyReplicas,
+			rs.AvailableReplicas,
+			truncateString(rs.Owner, 30),
+			t.formatTime(rs.CreatedAt, rs.Age),
+		)
+
+		content.WriteString(style.Render(t.highlightListFilter(row)))
+		content.WriteString("\n")
+	}
+	content.WriteString(listWindowFooter(start, end, len(t.replicaSets)))
+
+	// Instructions
+	content.WriteString("\nUse j/k or ↑↓ to navigate • Press 'enter' to toggle details • Press 'r' to refresh")
+
+	t.mainContent = content.String()
+
+	// Update detail panel with selected ReplicaSet info
+	if t.selectedReplicaSet < len(t.replicaSets) && t.selectedReplicaSet >= 0 {
+		t.updateReplicaSetDetails(t.replicaSets[t.selectedReplicaSet])
+	}
+}
+
+// updateReplicaSetDetails updates the detail pane with ReplicaSet information
+func (t *TUI) updateReplicaSetDetails(rs resources.ReplicaSetInfo) {
+	var details strings.Builder
+	details.WriteString(fmt.Sprintf("🧬 ReplicaSet Details: %s\n\n", rs.Name))
+
+	details.WriteString(fmt.Sprintf("Namespace:    %s\n", rs.Namespace))
+	details.WriteString(fmt.Sprintf("Status:       %s\n", rs.Status))
+	if rs.Owner != "" {
+		details.WriteString(fmt.Sprintf("Owner:        %s\n", rs.Owner))
+	}
+	details.WriteString(fmt.Sprintf("Age:          %s\n", t.formatTime(rs.CreatedAt, rs.Age)))
+
+	details.WriteString("\nReplicas:\n")
+	details.WriteString(fmt.Sprintf("  Desired:    %d\n", rs.Replicas))
+	details.WriteString(fmt.Sprintf("  Ready:      %d\n", rs.ReadyReplicas))
+	details.WriteString(fmt.Sprintf("  Available:  %d\n", rs.AvailableReplicas))
+
+	writeWorkloadMetadata(&details, rs.Images, rs.Labels)
+	details.WriteString(t.renderRelatedEvents("ReplicaSet", rs.Name))
+
+	t.detailContent = details.String()
+}
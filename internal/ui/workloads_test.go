@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestStatefulSetsTab(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabStatefulSets
+
+	tui.Update(messages.StatefulSetsLoaded{StatefulSets: []resources.StatefulSetInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "db", Namespace: "demo", Status: "Progressing"}, Replicas: 3, ReadyReplicas: 2, ServiceName: "db-headless"},
+		{ResourceInfo: resources.ResourceInfo{Name: "cache", Namespace: "demo", Status: "Ready"}, Replicas: 1, ReadyReplicas: 1},
+	}})
+
+	if !strings.Contains(tui.mainContent, "db-headless") || !strings.Contains(tui.mainContent, "2/3") {
+		t.Errorf("Expected the statefulset table, got %q", tui.mainContent)
+	}
+	if !strings.Contains(tui.detailContent, "StatefulSet Details: db") {
+		t.Errorf("Expected details of the first statefulset, got %q", tui.detailContent)
+	}
+
+	NewNavigator(tui).moveResourceSelection(1)
+	if tui.selectedStatefulSet != 1 || !strings.Contains(tui.detailContent, "StatefulSet Details: cache") {
+		t.Errorf("Expected cache to be selected, got %d: %q", tui.selectedStatefulSet, tui.detailContent)
+	}
+
+	ref, ok := tui.selectedResource()
+	if !ok || ref.Kind != "StatefulSet" || ref.Name != "cache" {
+		t.Errorf("Expected StatefulSet/cache as the YAML target, got %+v", ref)
+	}
+}
+
+func TestReplicaSetsKeepSelectionOnReload(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), connected: true}
+	tui.ActiveTab = models.TabReplicaSets
+
+	sets := []resources.ReplicaSetInfo{
+		{ResourceInfo: resources.ResourceInfo{Name: "web-1"}, Owner: "Deployment/web"},
+		{ResourceInfo: resources.ResourceInfo{Name: "web-2"}, Owner: "Deployment/web"},
+	}
+	tui.Update(messages.ReplicaSetsLoaded{ReplicaSets: sets})
+	tui.selectedReplicaSet = 1
+
+	tui.Update(messages.ReplicaSetsLoaded{ReplicaSets: append([]resources.ReplicaSetInfo{{ResourceInfo: resources.ResourceInfo{Name: "web-0"}}}, sets...)})
+	if tui.replicaSets[tui.selectedReplicaSet].Name != "web-2" {
+		t.Errorf("Expected web-2 to stay selected, got %s", tui.replicaSets[tui.selectedReplicaSet].Name)
+	}
+	if !strings.Contains(tui.detailContent, "Owner:        Deployment/web") {
+		t.Errorf("Expected the owner in the details, got %q", tui.detailContent)
+	}
+}
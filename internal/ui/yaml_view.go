@@ -0,0 +1,322 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+	"github.com/katyella/lazyoc/internal/k8s"
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+	"github.com/katyella/lazyoc/internal/ui/messages"
+)
+
+// resourceRef identifies a single resource in the cluster
+type resourceRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// selectedResource returns a reference to the resource selected in the active tab
+func (t *TUI) selectedResource() (resourceRef, bool) {
+	ref := resourceRef{Namespace: t.namespace}
+
+	switch t.ActiveTab {
+	case 0:
+		if t.selectedPod >= len(t.pods) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Pod", t.resourceNamespace(t.pods[t.selectedPod].Namespace), t.pods[t.selectedPod].Name
+	case 1:
+		if t.selectedService >= len(t.services) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Service", t.resourceNamespace(t.services[t.selectedService].Namespace), t.services[t.selectedService].Name
+	case 2:
+		if t.selectedDeployment >= len(t.deployments) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Deployment", t.resourceNamespace(t.deployments[t.selectedDeployment].Namespace), t.deployments[t.selectedDeployment].Name
+	case 3:
+		if t.selectedConfigMap >= len(t.configMaps) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "ConfigMap", t.resourceNamespace(t.configMaps[t.selectedConfigMap].Namespace), t.configMaps[t.selectedConfigMap].Name
+	case 4:
+		if t.selectedSecret >= len(t.secrets) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Secret", t.resourceNamespace(t.secrets[t.selectedSecret].Namespace), t.secrets[t.selectedSecret].Name
+	case 5:
+		if t.selectedBuildConfig >= len(t.buildConfigs) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "BuildConfig", t.resourceNamespace(t.buildConfigs[t.selectedBuildConfig].Namespace), t.buildConfigs[t.selectedBuildConfig].Name
+	case 6:
+		if t.selectedImageStream >= len(t.imageStreams) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "ImageStream", t.resourceNamespace(t.imageStreams[t.selectedImageStream].Namespace), t.imageStreams[t.selectedImageStream].Name
+	case 7:
+		if t.selectedRoute >= len(t.routes) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Route", t.resourceNamespace(t.routes[t.selectedRoute].Namespace), t.routes[t.selectedRoute].Name
+	case 8:
+		if t.selectedBuild >= len(t.builds) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Build", t.resourceNamespace(t.builds[t.selectedBuild].Namespace), t.builds[t.selectedBuild].Name
+	case 9:
+		if t.selectedEvent >= len(t.events) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Event", t.resourceNamespace(t.events[t.selectedEvent].Namespace), t.events[t.selectedEvent].Name
+	case 10:
+		if t.selectedStatefulSet >= len(t.statefulSets) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "StatefulSet", t.resourceNamespace(t.statefulSets[t.selectedStatefulSet].Namespace), t.statefulSets[t.selectedStatefulSet].Name
+	case 11:
+		if t.selectedDaemonSet >= len(t.daemonSets) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "DaemonSet", t.resourceNamespace(t.daemonSets[t.selectedDaemonSet].Namespace), t.daemonSets[t.selectedDaemonSet].Name
+	case 12:
+		if t.selectedReplicaSet >= len(t.replicaSets) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "ReplicaSet", t.resourceNamespace(t.replicaSets[t.selectedReplicaSet].Namespace), t.replicaSets[t.selectedReplicaSet].Name
+	case 13:
+		if t.selectedJob >= len(t.jobs) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Job", t.resourceNamespace(t.jobs[t.selectedJob].Namespace), t.jobs[t.selectedJob].Name
+	case 14:
+		if t.selectedCronJob >= len(t.cronJobs) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "CronJob", t.resourceNamespace(t.cronJobs[t.selectedCronJob].Namespace), t.cronJobs[t.selectedCronJob].Name
+	case 15:
+		if t.selectedNode >= len(t.nodes) {
+			return ref, false
+		}
+		// Nodes are cluster-scoped
+		ref.Kind, ref.Namespace, ref.Name = "Node", "", t.nodes[t.selectedNode].Name
+	case 16:
+		if t.selectedPVC >= len(t.pvcs) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "PersistentVolumeClaim", t.resourceNamespace(t.pvcs[t.selectedPVC].Namespace), t.pvcs[t.selectedPVC].Name
+	case 17:
+		if t.selectedIngress >= len(t.ingresses) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "Ingress", t.resourceNamespace(t.ingresses[t.selectedIngress].Namespace), t.ingresses[t.selectedIngress].Name
+	case 18:
+		if t.selectedNetworkPolicy >= len(t.networkPolicies) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "NetworkPolicy", t.resourceNamespace(t.networkPolicies[t.selectedNetworkPolicy].Namespace), t.networkPolicies[t.selectedNetworkPolicy].Name
+	case 19:
+		if t.selectedDeploymentConfig >= len(t.deploymentConfigs) {
+			return ref, false
+		}
+		ref.Kind, ref.Namespace, ref.Name = "DeploymentConfig", t.resourceNamespace(t.deploymentConfigs[t.selectedDeploymentConfig].Namespace), t.deploymentConfigs[t.selectedDeploymentConfig].Name
+	default:
+		return ref, false
+	}
+
+	return ref, ref.Name != ""
+}
+
+// isOpenShiftKind reports whether a kind is served by the OpenShift resource client
+func isOpenShiftKind(kind string) bool {
+	switch kind {
+	case "BuildConfig", "Build", "ImageStream", "Route", "DeploymentConfig":
+		return true
+	}
+	return false
+}
+
+// fetchResourceYAML returns the manifest of a resource
+func (t *TUI) fetchResourceYAML(ctx context.Context, ref resourceRef) (string, error) {
+	if !t.connected || t.resourceClient == nil {
+		return "", fmt.Errorf("not connected")
+	}
+	if isOpenShiftKind(ref.Kind) {
+		osClient, ok := t.k8sClient.(k8s.OpenShiftClient)
+		if !ok || !osClient.IsOpenShift() {
+			return "", fmt.Errorf("not connected to an OpenShift cluster")
+		}
+		return resources.NewOpenShiftResourceClient(osClient).GetYAML(ctx, ref.Kind, ref.Namespace, ref.Name)
+	}
+	return t.resourceClient.GetYAML(ctx, ref.Kind, ref.Namespace, ref.Name)
+}
+
+// loadResourceYAML fetches the manifest of a resource
+func (t *TUI) loadResourceYAML(ref resourceRef) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultOperationTimeout)
+		defer cancel()
+
+		content, err := t.fetchResourceYAML(ctx, ref)
+		if err != nil {
+			return messages.ResourceYAMLLoadError{Kind: ref.Kind, Name: ref.Name, Err: err}
+		}
+
+		return messages.ResourceYAMLLoaded{Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name, Content: content}
+	}
+}
+
+// openYAMLView opens the full-screen manifest view for the selected resource
+func (t *TUI) openYAMLView() tea.Cmd {
+	ref, ok := t.selectedResource()
+	if !t.connected || !ok {
+		return nil
+	}
+
+	t.showYAMLView = true
+	t.loadingYAML = true
+	t.yamlTitle = fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+	t.yamlLines = nil
+	t.yamlScroll = 0
+	t.yamlError = ""
+	t.yamlRef = ref
+	t.yamlFieldOwners = false
+	return t.loadResourceYAML(ref)
+}
+
+// handleResourceYAMLLoaded stores a fetched manifest
+func (t *TUI) handleResourceYAMLLoaded(msg messages.ResourceYAMLLoaded) {
+	if t.yamlFieldOwners {
+		return
+	}
+	t.loadingYAML = false
+	t.yamlLines = strings.Split(strings.TrimRight(msg.Content, "\n"), "\n")
+	t.yamlScroll = 0
+}
+
+// handleResourceYAMLLoadError records a failed manifest fetch
+func (t *TUI) handleResourceYAMLLoadError(msg messages.ResourceYAMLLoadError) {
+	if t.yamlFieldOwners {
+		return
+	}
+	t.loadingYAML = false
+	t.yamlError = msg.Err.Error()
+	t.logError(categoryResource, "Failed to load YAML for %s %s: %v", msg.Kind, msg.Name, msg.Err)
+}
+
+// yamlViewHeight returns the number of manifest lines visible at once
+func (t *TUI) yamlViewHeight() int {
+	return max(t.height-4, 1) // title, separator, footer separator, footer
+}
+
+// renderYAMLView renders the full-screen manifest view
+func (t *TUI) renderYAMLView() string {
+	primaryColor, _ := t.getThemeColors()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(primaryColor)
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("242"))
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render("📄 "+t.yamlTitle) + "\n")
+	content.WriteString(dimStyle.Render(strings.Repeat("─", max(t.width, 1))) + "\n")
+
+	height := t.yamlViewHeight()
+	lines := make([]string, 0, height)
+	switch {
+	case t.loadingYAML:
+		lines = append(lines, "⏳ Loading...")
+	case t.yamlError != "":
+		lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Render("❌ "+t.yamlError))
+	default:
+		end := min(t.yamlScroll+height, len(t.yamlLines))
+		for _, line := range t.yamlLines[t.yamlScroll:end] {
+			lines = append(lines, colorizeYAMLLine(truncateString(line, t.width)))
+		}
+	}
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+	content.WriteString(strings.Join(lines, "\n") + "\n")
+
+	position := ""
+	if len(t.yamlLines) > 0 {
+		position = fmt.Sprintf("lines %d-%d of %d • ", t.yamlScroll+1, min(t.yamlScroll+height, len(t.yamlLines)), len(t.yamlLines))
+	}
+	content.WriteString(dimStyle.Render(strings.Repeat("─", max(t.width, 1))) + "\n")
+	content.WriteString(dimStyle.Render(position + "j/k: scroll • pgup/pgdn: page • g/G: top/bottom • m: field managers • c: copy • Y: copy command • esc/q: close"))
+
+	return content.String()
+}
+
+// colorizeYAMLLine highlights the key of a YAML line and dims comments
+func colorizeYAMLLine(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if strings.HasPrefix(trimmed, "#") {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("242")).Render(line)
+	}
+
+	indent := line[:len(line)-len(trimmed)]
+	prefix := ""
+	if strings.HasPrefix(trimmed, "- ") {
+		prefix = "- "
+		trimmed = trimmed[2:]
+	}
+
+	key, rest, found := strings.Cut(trimmed, ":")
+	if !found || strings.ContainsAny(key, " \"'") {
+		return line
+	}
+
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("14"))
+	return indent + prefix + keyStyle.Render(key) + ":" + rest
+}
+
+// handleYAMLViewKeys handles key input for the manifest view
+func (t *TUI) handleYAMLViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	maxScroll := max(len(t.yamlLines)-t.yamlViewHeight(), 0)
+
+	switch msg.String() {
+	case "esc", "q", "y":
+		t.showYAMLView = false
+		t.yamlLines = nil
+		t.yamlFieldOwners = false
+		return t, nil
+
+	case "m":
+		return t, t.toggleFieldOwners()
+
+	case "j", "down":
+		t.yamlScroll = min(t.yamlScroll+1, maxScroll)
+
+	case "k", "up":
+		t.yamlScroll = max(t.yamlScroll-1, 0)
+
+	case "pgdown", "ctrl+f", " ":
+		t.yamlScroll = min(t.yamlScroll+t.yamlViewHeight(), maxScroll)
+
+	case "pgup", "ctrl+b":
+		t.yamlScroll = max(t.yamlScroll-t.yamlViewHeight(), 0)
+
+	case "g", "home":
+		t.yamlScroll = 0
+
+	case "G", "end":
+		t.yamlScroll = maxScroll
+
+	case "c":
+		if len(t.yamlLines) > 0 {
+			return t, t.copyToClipboard(strings.Join(t.yamlLines, "\n"))
+		}
+
+	case "Y":
+		return t, t.copyEquivalentCommand()
+	}
+
+	return t, nil
+}
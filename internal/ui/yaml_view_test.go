@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/ui/models"
+)
+
+func TestYAMLViewTruncatesByWidth(t *testing.T) {
+	tui := &TUI{App: models.NewApp("test"), width: 20, height: 10}
+	tui.showYAMLView = true
+	tui.yamlTitle = "ConfigMap shop/greetings"
+	tui.yamlLines = []string{
+		"  note: " + strings.Repeat("é", 30),
+		"  wide: " + strings.Repeat("日本", 10),
+	}
+
+	for i, line := range strings.Split(tui.renderYAMLView(), "\n")[2:4] {
+		if !utf8.ValidString(line) {
+			t.Errorf("Expected line %d to stay valid UTF-8, got %q", i, line)
+		}
+		if width := lipgloss.Width(line); width > tui.width {
+			t.Errorf("Expected line %d to fit %d cells, got %d: %q", i, tui.width, width, line)
+		}
+		if !strings.Contains(line, "...") {
+			t.Errorf("Expected line %d to be marked as cut, got %q", i, line)
+		}
+	}
+
+	if got := truncateString("日本語のテキスト", 9); got != "日本語..." {
+		t.Errorf("Expected wide characters to count two cells, got %q", got)
+	}
+	if got := truncateString("short", 9); got != "short" {
+		t.Errorf("Expected a short string to be kept, got %q", got)
+	}
+}
@@ -0,0 +1,65 @@
+package lazyoc
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/katyella/lazyoc/internal/ui"
+)
+
+// Options configures the full LazyOC application
+type Options struct {
+	Version             string
+	Debug               bool // Write a debug log to lazyoc.log
+	AltScreen           bool
+	MouseSupport        bool
+	KubeConfig          string // Kubeconfig file, the standard loading rules when empty
+	ShowFullClusterInfo bool
+	DryRun              bool // Start with server-side dry run enabled
+	SafeMode            bool // No background refreshes, probes or followed logs, for fragile API servers
+
+	// AuthHook supplies the connection in place of the kubeconfig's
+	// credentials. Nil runs the auth hook command of the saved preferences,
+	// if any.
+	AuthHook AuthHook
+
+	// Profile is the name of the configured profile to open, "" for none
+	Profile string
+
+	// TeaOptions are passed to the Bubble Tea program after the options
+	// above
+	TeaOptions []tea.ProgramOption
+}
+
+// DefaultOptions returns the options the lazyoc command starts with
+func DefaultOptions() Options {
+	defaults := ui.DefaultProgramOptions()
+	return Options{
+		Version:             defaults.Version,
+		Debug:               defaults.Debug,
+		AltScreen:           defaults.AltScreen,
+		MouseSupport:        defaults.MouseSupport,
+		ShowFullClusterInfo: defaults.ShowFullClusterInfo,
+	}
+}
+
+// programOptions returns the options of the TUI program for opts
+func (opts Options) programOptions() ui.ProgramOptions {
+	programOpts := ui.DefaultProgramOptions()
+	programOpts.Version = opts.Version
+	programOpts.Debug = opts.Debug
+	programOpts.AltScreen = opts.AltScreen
+	programOpts.MouseSupport = opts.MouseSupport
+	programOpts.KubeConfig = opts.KubeConfig
+	programOpts.ShowFullClusterInfo = opts.ShowFullClusterInfo
+	programOpts.DryRun = opts.DryRun
+	programOpts.SafeMode = opts.SafeMode
+	programOpts.AuthHook = opts.AuthHook
+	programOpts.Profile = opts.Profile
+	programOpts.TeaOptions = opts.TeaOptions
+	return programOpts
+}
+
+// Run runs the full LazyOC application until the user quits
+func Run(opts Options) error {
+	return ui.RunTUI(opts.programOptions())
+}
@@ -0,0 +1,21 @@
+package lazyoc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestProgramOptions(t *testing.T) {
+	opts := DefaultOptions()
+	opts.KubeConfig = "/tmp/kubeconfig"
+	opts.SafeMode = true
+	opts.AuthHook = AuthHookFunc(func(context.Context, AuthRequest) (*AuthResult, error) { return nil, nil })
+
+	programOpts := opts.programOptions()
+	if programOpts.KubeConfig != "/tmp/kubeconfig" || !programOpts.SafeMode || programOpts.AuthHook == nil {
+		t.Errorf("Expected the options to reach the program, got %+v", programOpts)
+	}
+	if programOpts.MaxFPS == 0 || !programOpts.AltScreen {
+		t.Errorf("Expected the program defaults to be kept, got %+v", programOpts)
+	}
+}
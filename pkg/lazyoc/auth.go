@@ -0,0 +1,33 @@
+package lazyoc
+
+import "github.com/katyella/lazyoc/internal/k8s/auth"
+
+// AuthHook supplies the REST config LazyOC connects with, in place of the
+// kubeconfig's credentials. Set it as Options.AuthHook to integrate an
+// in-house login, e.g. an SSO flow issuing short-lived certificates:
+//
+//	opts := lazyoc.DefaultOptions()
+//	opts.AuthHook = lazyoc.AuthHookFunc(func(ctx context.Context, req lazyoc.AuthRequest) (*lazyoc.AuthResult, error) {
+//		config, err := sso.Login(ctx, req.Context)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return &lazyoc.AuthResult{Config: config}, nil
+//	})
+//	return lazyoc.Run(opts)
+//
+// The hook is called for every connection and reconnection.
+type AuthHook = auth.ConfigHook
+
+// Types of an AuthHook
+type (
+	AuthHookFunc = auth.ConfigHookFunc
+	AuthRequest  = auth.HookRequest
+	AuthResult   = auth.HookResult
+)
+
+// ExecAuthHook returns an AuthHook running a command that prints the API
+// server and credentials as JSON, as the --auth-hook flag does
+func ExecAuthHook(command ...string) AuthHook {
+	return auth.NewExecHook(command...)
+}
@@ -0,0 +1,81 @@
+package lazyoc
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/katyella/lazyoc/internal/k8s/resources"
+)
+
+// ResourceClient lists and inspects the resources of a namespace. It is a
+// subset of the client the LazyOC TUI uses, so methods the TUI gains do not
+// break implementations and fakes of this interface.
+type ResourceClient interface {
+	ListPods(ctx context.Context, opts ListOptions) (*ResourceList[PodInfo], error)
+	GetPod(ctx context.Context, namespace, name string) (*PodInfo, error)
+	GetPodLogs(ctx context.Context, namespace, podName, containerName string, opts LogOptions) (string, error)
+	ListServices(ctx context.Context, opts ListOptions) (*ResourceList[ServiceInfo], error)
+	ListDeployments(ctx context.Context, opts ListOptions) (*ResourceList[DeploymentInfo], error)
+	ListConfigMaps(ctx context.Context, opts ListOptions) (*ResourceList[ConfigMapInfo], error)
+	ListSecrets(ctx context.Context, opts ListOptions) (*ResourceList[SecretInfo], error)
+	ListEvents(ctx context.Context, opts ListOptions) (*ResourceList[EventInfo], error)
+
+	// GetCurrentNamespace returns the namespace listed by default
+	GetCurrentNamespace() string
+	// TestConnection checks that the API server can be reached
+	TestConnection(ctx context.Context) error
+}
+
+// Resource types returned by ResourceClient
+type (
+	ResourceInfo   = resources.ResourceInfo
+	PodInfo        = resources.PodInfo
+	ContainerInfo  = resources.ContainerInfo
+	ServiceInfo    = resources.ServiceInfo
+	DeploymentInfo = resources.DeploymentInfo
+	ConfigMapInfo  = resources.ConfigMapInfo
+	SecretInfo     = resources.SecretInfo
+	EventInfo      = resources.EventInfo
+	ListOptions    = resources.ListOptions
+	LogOptions     = resources.LogOptions
+)
+
+// ResourceList is a page of resources returned by ResourceClient
+type ResourceList[T any] = resources.ResourceList[T]
+
+// Connect creates a ResourceClient from a kubeconfig file. An empty path uses
+// the standard loading rules ($KUBECONFIG, then ~/.kube/config) and an empty
+// namespace uses the namespace of the current context.
+func Connect(kubeconfigPath, namespace string) (ResourceClient, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfigPath != "" {
+		rules.ExplicitPath = kubeconfigPath
+	}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{})
+
+	config, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	if namespace == "" {
+		if namespace, _, err = clientConfig.Namespace(); err != nil {
+			return nil, fmt.Errorf("failed to determine namespace: %w", err)
+		}
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return NewResourceClient(clientset, config, namespace), nil
+}
+
+// NewResourceClient creates a ResourceClient from an existing clientset and
+// REST config, for programs that already manage their cluster connection
+func NewResourceClient(clientset *kubernetes.Clientset, config *rest.Config, namespace string) ResourceClient {
+	return resources.NewK8sResourceClientWithConfig(clientset, config, namespace)
+}
@@ -0,0 +1,21 @@
+// Package lazyoc is the public API for embedding LazyOC in other Go programs.
+//
+// It exposes the resource client used by the LazyOC TUI, the theme colors and
+// Bubble Tea panels that list resources, so that another TUI can show e.g. a
+// pods panel without forking LazyOC:
+//
+//	client, err := lazyoc.Connect("", "my-namespace")
+//	if err != nil {
+//		return err
+//	}
+//	pods := lazyoc.NewPodsPanel(client, lazyoc.DarkTheme())
+//	pods.SetSize(80, 20)
+//	pods.Focus()
+//	// call pods.Init(), pods.Update(msg) and pods.View() from your model
+//
+// Types exported here are covered by semantic versioning. Everything under
+// internal/ may change at any time. ResourceClient and Options are owned by
+// this package and adapted to the TUI's internal ones, so the TUI can grow
+// without breaking embedders; the resource types are aliases that only gain
+// fields.
+package lazyoc
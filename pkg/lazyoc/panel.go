@@ -0,0 +1,269 @@
+package lazyoc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// Column describes one column of a ListPanel
+type Column[T any] struct {
+	Title string
+	Width int // 0 gives the column the width left over by the others
+	Value func(T) string
+	Style func(T) lipgloss.Style // Optional per-cell style
+}
+
+// LoadFunc loads the items a ListPanel shows
+type LoadFunc[T any] func(ctx context.Context) ([]T, error)
+
+// panelIDs gives every panel an ID so load results reach the panel that
+// requested them when several panels share one program
+var panelIDs atomic.Int64
+
+// panelLoadedMsg carries the result of a panel's load
+type panelLoadedMsg struct {
+	panel int64
+	items any
+	err   error
+}
+
+// ListPanel is a Bubble Tea component that lists resources in a bordered,
+// scrollable table. Embed it in a model by calling Init, Update and View.
+type ListPanel[T any] struct {
+	id      int64
+	title   string
+	columns []Column[T]
+	load    LoadFunc[T]
+	theme   Theme
+
+	items    []T
+	selected int
+	offset   int
+	loading  bool
+	err      error
+
+	width   int
+	height  int
+	focused bool
+}
+
+// NewListPanel creates a panel that lists the items returned by load
+func NewListPanel[T any](title string, columns []Column[T], load LoadFunc[T], theme Theme) *ListPanel[T] {
+	return &ListPanel[T]{
+		id:      panelIDs.Add(1),
+		title:   title,
+		columns: columns,
+		load:    load,
+		theme:   theme,
+		width:   80,
+		height:  10,
+	}
+}
+
+// Init loads the panel's items
+func (p *ListPanel[T]) Init() tea.Cmd {
+	return p.Refresh()
+}
+
+// Refresh reloads the panel's items
+func (p *ListPanel[T]) Refresh() tea.Cmd {
+	p.loading = true
+	id, load := p.id, p.load
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultRequestTimeout)
+		defer cancel()
+		items, err := load(ctx)
+		return panelLoadedMsg{panel: id, items: items, err: err}
+	}
+}
+
+// Update handles load results and, while the panel is focused, navigation
+// keys: j/k or arrows move, g/G jump to the top/bottom and r refreshes
+func (p *ListPanel[T]) Update(msg tea.Msg) (*ListPanel[T], tea.Cmd) {
+	switch msg := msg.(type) {
+	case panelLoadedMsg:
+		if msg.panel != p.id {
+			return p, nil
+		}
+		p.loading = false
+		p.err = msg.err
+		if msg.err == nil {
+			p.items, _ = msg.items.([]T)
+			p.selected = min(p.selected, max(len(p.items)-1, 0))
+			p.scrollToSelection()
+		}
+
+	case tea.KeyMsg:
+		if !p.focused {
+			return p, nil
+		}
+		switch msg.String() {
+		case "j", "down":
+			p.Select(p.selected + 1)
+		case "k", "up":
+			p.Select(p.selected - 1)
+		case "g", "home":
+			p.Select(0)
+		case "G", "end":
+			p.Select(len(p.items) - 1)
+		case "r":
+			return p, p.Refresh()
+		}
+	}
+	return p, nil
+}
+
+// View renders the panel
+func (p *ListPanel[T]) View() string {
+	borderColor := p.theme.Muted
+	if p.focused {
+		borderColor = p.theme.Primary
+	}
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		Width(max(p.width-2, 1)).
+		Height(max(p.height-2, 1))
+
+	innerWidth := max(p.width-4, 1)
+	var b strings.Builder
+	b.WriteString(lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s (%d)", p.title, len(p.items))) + "\n")
+
+	switch {
+	case p.err != nil:
+		b.WriteString(lipgloss.NewStyle().Foreground(p.theme.Error).Render("Error: " + p.err.Error()))
+	case p.loading && len(p.items) == 0:
+		b.WriteString("Loading...")
+	case len(p.items) == 0:
+		b.WriteString(lipgloss.NewStyle().Foreground(p.theme.Muted).Render("No resources found"))
+	default:
+		widths := p.columnWidths(innerWidth)
+		header := make([]string, len(p.columns))
+		for i, column := range p.columns {
+			header[i] = fit(column.Title, widths[i])
+		}
+		b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(p.theme.Header).Render(strings.Join(header, " ")))
+
+		end := min(p.offset+p.visibleRows(), len(p.items))
+		for i := p.offset; i < end; i++ {
+			b.WriteString("\n" + p.renderRow(p.items[i], widths, i == p.selected))
+		}
+	}
+
+	return style.Render(b.String())
+}
+
+// renderRow renders one item; the selected row is highlighted
+func (p *ListPanel[T]) renderRow(item T, widths []int, selected bool) string {
+	cells := make([]string, len(p.columns))
+	for i, column := range p.columns {
+		cell := fit(column.Value(item), widths[i])
+		if column.Style != nil && !selected {
+			cell = column.Style(item).Render(cell)
+		}
+		cells[i] = cell
+	}
+	row := strings.Join(cells, " ")
+	if selected {
+		return lipgloss.NewStyle().Background(p.theme.Primary).Foreground(lipgloss.Color(constants.ColorBlack)).Render(row)
+	}
+	return row
+}
+
+// columnWidths distributes width over the columns, one space between each
+func (p *ListPanel[T]) columnWidths(width int) []int {
+	widths := make([]int, len(p.columns))
+	fixed, flexible := len(p.columns)-1, 0
+	for i, column := range p.columns {
+		widths[i] = column.Width
+		fixed += column.Width
+		if column.Width == 0 {
+			flexible++
+		}
+	}
+
+	if flexible > 0 {
+		share := max((width-fixed)/flexible, 1)
+		for i := range widths {
+			if widths[i] == 0 {
+				widths[i] = share
+			}
+		}
+	}
+	return widths
+}
+
+// visibleRows returns how many rows fit below the title and column header
+func (p *ListPanel[T]) visibleRows() int {
+	return max(p.height-4, 1)
+}
+
+// scrollToSelection keeps the selected row in view
+func (p *ListPanel[T]) scrollToSelection() {
+	rows := p.visibleRows()
+	if p.selected < p.offset {
+		p.offset = p.selected
+	} else if p.selected >= p.offset+rows {
+		p.offset = p.selected - rows + 1
+	}
+	p.offset = max(min(p.offset, len(p.items)-rows), 0)
+}
+
+// Select moves the selection to index, clamped to the list
+func (p *ListPanel[T]) Select(index int) {
+	p.selected = max(min(index, len(p.items)-1), 0)
+	p.scrollToSelection()
+}
+
+// Selected returns the selected item
+func (p *ListPanel[T]) Selected() (T, bool) {
+	if p.selected >= len(p.items) {
+		var zero T
+		return zero, false
+	}
+	return p.items[p.selected], true
+}
+
+// Items returns the loaded items
+func (p *ListPanel[T]) Items() []T { return p.items }
+
+// Err returns the error of the last load, if it failed
+func (p *ListPanel[T]) Err() error { return p.err }
+
+// SetSize sets the outer size of the panel, including its border
+func (p *ListPanel[T]) SetSize(width, height int) {
+	p.width, p.height = width, height
+	p.scrollToSelection()
+}
+
+// Focus makes the panel handle navigation keys
+func (p *ListPanel[T]) Focus() { p.focused = true }
+
+// Blur stops the panel from handling navigation keys
+func (p *ListPanel[T]) Blur() { p.focused = false }
+
+// Focused reports whether the panel handles navigation keys
+func (p *ListPanel[T]) Focused() bool { return p.focused }
+
+// fit truncates or pads s to exactly width cells
+func fit(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	if len(runes) > width {
+		if width == 1 {
+			return "…"
+		}
+		return string(runes[:width-1]) + "…"
+	}
+	return s + strings.Repeat(" ", width-len(runes))
+}
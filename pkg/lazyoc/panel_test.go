@@ -0,0 +1,97 @@
+package lazyoc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type item struct{ name, status string }
+
+func newTestPanel(items []item, err error) *ListPanel[item] {
+	columns := []Column[item]{
+		{Title: "NAME", Value: func(i item) string { return i.name }},
+		{Title: "STATUS", Width: 10, Value: func(i item) string { return i.status }},
+	}
+	return NewListPanel("Items", columns, func(context.Context) ([]item, error) {
+		return items, err
+	}, DarkTheme())
+}
+
+func TestListPanel(t *testing.T) {
+	panel := newTestPanel([]item{{"api", "Running"}, {"db", "Pending"}, {"worker", "Failed"}}, nil)
+	panel.SetSize(60, 10)
+
+	panel, _ = panel.Update(panel.Init()())
+	if len(panel.Items()) != 3 {
+		t.Fatalf("Expected 3 items, got %d", len(panel.Items()))
+	}
+	view := panel.View()
+	for _, want := range []string{"Items (3)", "NAME", "STATUS", "worker"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected view to contain %q:\n%s", want, view)
+		}
+	}
+
+	// Keys only move the selection while the panel is focused
+	panel, _ = panel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if selected, _ := panel.Selected(); selected.name != "api" {
+		t.Errorf("Expected an unfocused panel to ignore keys, got %q", selected.name)
+	}
+	panel.Focus()
+	panel, _ = panel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	if selected, _ := panel.Selected(); selected.name != "worker" {
+		t.Errorf("Expected G to select the last item, got %q", selected.name)
+	}
+
+	// Results of other panels are ignored
+	other := newTestPanel(nil, nil)
+	panel, _ = panel.Update(other.Refresh()())
+	if len(panel.Items()) != 3 {
+		t.Errorf("Expected another panel's load to be ignored")
+	}
+}
+
+func TestListPanelLoadError(t *testing.T) {
+	panel := newTestPanel(nil, errors.New("forbidden"))
+	panel, _ = panel.Update(panel.Init()())
+
+	if panel.Err() == nil || !strings.Contains(panel.View(), "forbidden") {
+		t.Errorf("Expected the load error to be shown, got:\n%s", panel.View())
+	}
+	if _, ok := panel.Selected(); ok {
+		t.Errorf("Expected no selection without items")
+	}
+}
+
+func TestFit(t *testing.T) {
+	if got := fit("deployment", 6); got != "deplo…" {
+		t.Errorf("Expected truncation, got %q", got)
+	}
+	if got := fit("db", 4); got != "db  " {
+		t.Errorf("Expected padding, got %q", got)
+	}
+}
+
+// fakeClient implements only the public ResourceClient
+type fakeClient struct {
+	ResourceClient
+	pods []PodInfo
+}
+
+func (c fakeClient) ListPods(context.Context, ListOptions) (*ResourceList[PodInfo], error) {
+	return &ResourceList[PodInfo]{Items: c.pods}, nil
+}
+
+func TestPodsPanelWithFakeClient(t *testing.T) {
+	client := fakeClient{pods: []PodInfo{{ResourceInfo: ResourceInfo{Name: "api-1"}, Phase: "Running"}}}
+	panel := NewPodsPanel(client, DarkTheme())
+	panel, _ = panel.Update(panel.Init()())
+
+	if selected, ok := panel.Selected(); !ok || selected.Name != "api-1" {
+		t.Errorf("Expected the fake's pod to be listed, got %+v", panel.Items())
+	}
+}
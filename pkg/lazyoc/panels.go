@@ -0,0 +1,64 @@
+package lazyoc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// NewPodsPanel creates a panel listing the pods of the client's namespace
+func NewPodsPanel(client ResourceClient, theme Theme) *ListPanel[PodInfo] {
+	columns := []Column[PodInfo]{
+		{Title: "NAME", Value: func(p PodInfo) string { return p.Name }},
+		{Title: "READY", Width: 7, Value: func(p PodInfo) string { return p.Ready }},
+		{Title: "STATUS", Width: 18, Value: func(p PodInfo) string { return p.Phase },
+			Style: func(p PodInfo) lipgloss.Style { return theme.StatusStyle(p.Phase) }},
+		{Title: "RESTARTS", Width: 8, Value: func(p PodInfo) string { return fmt.Sprint(p.Restarts) }},
+		{Title: "AGE", Width: 6, Value: func(p PodInfo) string { return p.Age }},
+	}
+	return NewListPanel("Pods", columns, func(ctx context.Context) ([]PodInfo, error) {
+		list, err := client.ListPods(ctx, ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, theme)
+}
+
+// NewServicesPanel creates a panel listing the services of the client's namespace
+func NewServicesPanel(client ResourceClient, theme Theme) *ListPanel[ServiceInfo] {
+	columns := []Column[ServiceInfo]{
+		{Title: "NAME", Value: func(s ServiceInfo) string { return s.Name }},
+		{Title: "TYPE", Width: 12, Value: func(s ServiceInfo) string { return s.Type }},
+		{Title: "CLUSTER-IP", Width: 15, Value: func(s ServiceInfo) string { return s.ClusterIP }},
+		{Title: "AGE", Width: 6, Value: func(s ServiceInfo) string { return s.Age }},
+	}
+	return NewListPanel("Services", columns, func(ctx context.Context) ([]ServiceInfo, error) {
+		list, err := client.ListServices(ctx, ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, theme)
+}
+
+// NewDeploymentsPanel creates a panel listing the deployments of the client's namespace
+func NewDeploymentsPanel(client ResourceClient, theme Theme) *ListPanel[DeploymentInfo] {
+	columns := []Column[DeploymentInfo]{
+		{Title: "NAME", Value: func(d DeploymentInfo) string { return d.Name }},
+		{Title: "READY", Width: 7, Value: func(d DeploymentInfo) string {
+			return fmt.Sprintf("%d/%d", d.ReadyReplicas, d.Replicas)
+		}},
+		{Title: "UP-TO-DATE", Width: 10, Value: func(d DeploymentInfo) string { return fmt.Sprint(d.UpdatedReplicas) }},
+		{Title: "AVAILABLE", Width: 9, Value: func(d DeploymentInfo) string { return fmt.Sprint(d.AvailableReplicas) }},
+		{Title: "AGE", Width: 6, Value: func(d DeploymentInfo) string { return d.Age }},
+	}
+	return NewListPanel("Deployments", columns, func(ctx context.Context) ([]DeploymentInfo, error) {
+		list, err := client.ListDeployments(ctx, ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	}, theme)
+}
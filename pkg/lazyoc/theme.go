@@ -0,0 +1,58 @@
+package lazyoc
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/katyella/lazyoc/internal/constants"
+)
+
+// Theme holds the colors LazyOC renders with
+type Theme struct {
+	Primary lipgloss.Color // Borders and selection of focused panels
+	Error   lipgloss.Color
+	Muted   lipgloss.Color // Borders of unfocused panels and secondary text
+	Header  lipgloss.Color // Column headers
+
+	Running lipgloss.Color // Healthy resources
+	Pending lipgloss.Color // Resources that are starting or degraded
+	Failed  lipgloss.Color // Failed resources
+}
+
+// DarkTheme returns the colors of LazyOC's default dark theme
+func DarkTheme() Theme {
+	return Theme{
+		Primary: lipgloss.Color(constants.ColorBlue),
+		Error:   lipgloss.Color(constants.ColorRed),
+		Muted:   lipgloss.Color(constants.ColorDarkGray),
+		Header:  lipgloss.Color(constants.ColorCyan),
+		Running: lipgloss.Color(constants.ColorGreen),
+		Pending: lipgloss.Color(constants.ColorYellow),
+		Failed:  lipgloss.Color(constants.ColorRed),
+	}
+}
+
+// LightTheme returns the colors of LazyOC's light theme
+func LightTheme() Theme {
+	return Theme{
+		Primary: lipgloss.Color(constants.ColorDarkBlue),
+		Error:   lipgloss.Color(constants.ColorDarkRed),
+		Muted:   lipgloss.Color(constants.ColorMediumGray),
+		Header:  lipgloss.Color(constants.ColorDarkCyan),
+		Running: lipgloss.Color(constants.ColorDarkGreen),
+		Pending: lipgloss.Color(constants.ColorDarkYellow),
+		Failed:  lipgloss.Color(constants.ColorDarkRed),
+	}
+}
+
+// StatusStyle colors a resource status such as a pod phase
+func (th Theme) StatusStyle(status string) lipgloss.Style {
+	switch status {
+	case constants.PodStatusRunning, constants.PodStatusSucceeded, constants.PodStatusCompleted, "Available", "Active":
+		return lipgloss.NewStyle().Foreground(th.Running)
+	case constants.PodStatusPending, "ContainerCreating", "Progressing":
+		return lipgloss.NewStyle().Foreground(th.Pending)
+	case constants.PodStatusFailed, constants.PodStatusError, constants.PodStatusCrashLoopBackOff, "ImagePullBackOff", "ErrImagePull":
+		return lipgloss.NewStyle().Foreground(th.Failed)
+	}
+	return lipgloss.NewStyle().Foreground(th.Muted)
+}